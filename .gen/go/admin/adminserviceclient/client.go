@@ -196,6 +196,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RestoreWorkflow(
+		ctx context.Context,
+		Request *admin.AdminRestoreWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	SearchArchivedHistory(
+		ctx context.Context,
+		Request *shared.ListArchivedWorkflowExecutionsRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListArchivedWorkflowExecutionsResponse, error)
+
 	UpdateDynamicConfig(
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
@@ -959,6 +971,62 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) RestoreWorkflow(
+	ctx context.Context,
+	_Request *admin.AdminRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_RestoreWorkflow_Result
+	args := admin.AdminService_RestoreWorkflow_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_RestoreWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) SearchArchivedHistory(
+	ctx context.Context,
+	_Request *shared.ListArchivedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListArchivedWorkflowExecutionsResponse, err error) {
+
+	var result admin.AdminService_SearchArchivedHistory_Result
+	args := admin.AdminService_SearchArchivedHistory_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_SearchArchivedHistory_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDynamicConfig(
 	ctx context.Context,
 	_Request *admin.UpdateDynamicConfigRequest,
@@ -251,6 +251,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RestoreWorkflow(
+		ctx context.Context,
+		Request *history.RestoreWorkflowRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -1303,6 +1309,34 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) RestoreWorkflow(
+	ctx context.Context,
+	_Request *history.RestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_RestoreWorkflow_Result
+	args := history.HistoryService_RestoreWorkflow_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_RestoreWorkflow_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -3284,6 +3284,226 @@ func (m *DynamicConfigFilter) GetValue() *v1.DataBlob {
 	return nil
 }
 
+type RestoreWorkflowRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RestoreWorkflowRequest) Reset()         { *m = RestoreWorkflowRequest{} }
+func (m *RestoreWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreWorkflowRequest) ProtoMessage()    {}
+func (*RestoreWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{57}
+}
+func (m *RestoreWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkflowRequest.Merge(m, src)
+}
+func (m *RestoreWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreWorkflowRequest proto.InternalMessageInfo
+
+func (m *RestoreWorkflowRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RestoreWorkflowRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type RestoreWorkflowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreWorkflowResponse) Reset()         { *m = RestoreWorkflowResponse{} }
+func (m *RestoreWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreWorkflowResponse) ProtoMessage()    {}
+func (*RestoreWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{58}
+}
+func (m *RestoreWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkflowResponse.Merge(m, src)
+}
+func (m *RestoreWorkflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreWorkflowResponse proto.InternalMessageInfo
+
+type SearchArchivedHistoryRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken        []byte   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Query                string   `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchArchivedHistoryRequest) Reset()         { *m = SearchArchivedHistoryRequest{} }
+func (m *SearchArchivedHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchArchivedHistoryRequest) ProtoMessage()    {}
+func (*SearchArchivedHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{59}
+}
+func (m *SearchArchivedHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchArchivedHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchArchivedHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchArchivedHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchArchivedHistoryRequest.Merge(m, src)
+}
+func (m *SearchArchivedHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchArchivedHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchArchivedHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchArchivedHistoryRequest proto.InternalMessageInfo
+
+func (m *SearchArchivedHistoryRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SearchArchivedHistoryRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *SearchArchivedHistoryRequest) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
+func (m *SearchArchivedHistoryRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+type SearchArchivedHistoryResponse struct {
+	Executions           []*v1.WorkflowExecutionInfo `protobuf:"bytes,1,rep,name=executions" json:"executions,omitempty"`
+	NextPageToken        []byte                      `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *SearchArchivedHistoryResponse) Reset()         { *m = SearchArchivedHistoryResponse{} }
+func (m *SearchArchivedHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchArchivedHistoryResponse) ProtoMessage()    {}
+func (*SearchArchivedHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{60}
+}
+func (m *SearchArchivedHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SearchArchivedHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SearchArchivedHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SearchArchivedHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchArchivedHistoryResponse.Merge(m, src)
+}
+func (m *SearchArchivedHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SearchArchivedHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchArchivedHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchArchivedHistoryResponse proto.InternalMessageInfo
+
+func (m *SearchArchivedHistoryResponse) GetExecutions() []*v1.WorkflowExecutionInfo {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+func (m *SearchArchivedHistoryResponse) GetNextPageToken() []byte {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.admin.v1.DescribeWorkflowExecutionRequest")
 	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.admin.v1.DescribeWorkflowExecutionResponse")
@@ -3348,6 +3568,10 @@ func init() {
 	proto.RegisterType((*DynamicConfigEntry)(nil), "uber.cadence.admin.v1.DynamicConfigEntry")
 	proto.RegisterType((*DynamicConfigValue)(nil), "uber.cadence.admin.v1.DynamicConfigValue")
 	proto.RegisterType((*DynamicConfigFilter)(nil), "uber.cadence.admin.v1.DynamicConfigFilter")
+	proto.RegisterType((*RestoreWorkflowRequest)(nil), "uber.cadence.admin.v1.RestoreWorkflowRequest")
+	proto.RegisterType((*RestoreWorkflowResponse)(nil), "uber.cadence.admin.v1.RestoreWorkflowResponse")
+	proto.RegisterType((*SearchArchivedHistoryRequest)(nil), "uber.cadence.admin.v1.SearchArchivedHistoryRequest")
+	proto.RegisterType((*SearchArchivedHistoryResponse)(nil), "uber.cadence.admin.v1.SearchArchivedHistoryResponse")
 }
 
 func init() {
@@ -3355,186 +3579,193 @@ func init() {
 }
 
 var fileDescriptor_c6fc96d64a8b67fd = []byte{
-	// 2864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xc9, 0x6f, 0x1c, 0xc7,
-	0xd5, 0x77, 0xcf, 0x90, 0x14, 0xf9, 0x46, 0xa4, 0xc4, 0x12, 0xc5, 0xa5, 0x29, 0xd1, 0x54, 0xdb,
-	0xb2, 0xe8, 0x6d, 0x68, 0x0d, 0x6d, 0x7f, 0xb6, 0x05, 0x7f, 0x36, 0x35, 0x94, 0x28, 0xda, 0x92,
-	0x25, 0xb5, 0x64, 0x39, 0x08, 0x82, 0x74, 0x7a, 0xa6, 0x8b, 0x64, 0x87, 0x33, 0xdd, 0xad, 0xae,
-	0x9a, 0x91, 0x69, 0x18, 0x89, 0x11, 0x38, 0xa7, 0xec, 0xc8, 0x21, 0xc7, 0x1c, 0x12, 0xf8, 0x90,
-	0x1c, 0x82, 0xdc, 0x73, 0x0e, 0x72, 0x74, 0xfe, 0x83, 0x40, 0x07, 0x5f, 0x1c, 0x04, 0x08, 0x72,
-	0xc9, 0x31, 0xa8, 0xa5, 0xa7, 0xd7, 0x9a, 0x85, 0x51, 0x20, 0xc3, 0x37, 0x76, 0xd5, 0xdb, 0xea,
-	0x57, 0xaf, 0xde, 0x7b, 0xf5, 0x6a, 0x08, 0x4f, 0x75, 0x1a, 0x38, 0x5c, 0x6f, 0xda, 0x0e, 0xf6,
-	0x9a, 0x78, 0xdd, 0x76, 0xda, 0xae, 0xb7, 0xde, 0xbd, 0xb8, 0x4e, 0x70, 0xd8, 0x75, 0x9b, 0xb8,
-	0x1a, 0x84, 0x3e, 0xf5, 0xd1, 0x69, 0x46, 0x54, 0x95, 0x44, 0x55, 0x4e, 0x54, 0xed, 0x5e, 0xd4,
-	0x9f, 0xdc, 0xf3, 0xfd, 0xbd, 0x16, 0x5e, 0xe7, 0x44, 0x8d, 0xce, 0xee, 0x3a, 0x75, 0xdb, 0x98,
-	0x50, 0xbb, 0x1d, 0x08, 0x3e, 0x7d, 0x25, 0x4b, 0xf0, 0x20, 0xb4, 0x83, 0x00, 0x87, 0x44, 0xce,
-	0xaf, 0xa6, 0x95, 0x07, 0x2e, 0x53, 0xdd, 0xf4, 0xdb, 0x6d, 0xdf, 0x93, 0x14, 0x4f, 0x17, 0x51,
-	0x74, 0x5d, 0xe2, 0x36, 0xdc, 0x96, 0x4b, 0x0f, 0x0b, 0xa9, 0xc8, 0xbe, 0x1d, 0x62, 0x87, 0x8b,
-	0x6a, 0x75, 0x08, 0xc5, 0xe1, 0x00, 0xaa, 0x7d, 0x97, 0x50, 0x3f, 0x8c, 0x64, 0x19, 0x0a, 0xaa,
-	0xfb, 0x1d, 0xdc, 0x91, 0x78, 0xe8, 0x6b, 0x0a, 0x9a, 0x10, 0x07, 0x2d, 0xb7, 0x69, 0x53, 0x37,
-	0xb2, 0xdf, 0xf8, 0x85, 0x06, 0xab, 0x5b, 0x98, 0x34, 0x43, 0xb7, 0x81, 0x3f, 0xf0, 0xc3, 0x83,
-	0xdd, 0x96, 0xff, 0xe0, 0xca, 0x87, 0xb8, 0xd9, 0x61, 0x34, 0x26, 0xbe, 0xdf, 0xc1, 0x84, 0xa2,
-	0x79, 0x98, 0x70, 0xfc, 0xb6, 0xed, 0x7a, 0x8b, 0xda, 0xaa, 0xb6, 0x36, 0x65, 0xca, 0x2f, 0xf4,
-	0x3e, 0xa0, 0x07, 0x92, 0xc7, 0xc2, 0x11, 0xd3, 0x62, 0x69, 0x55, 0x5b, 0xab, 0xd4, 0x9e, 0xa9,
-	0xa6, 0xf7, 0x24, 0x70, 0xab, 0xdd, 0x8b, 0xd5, 0xbc, 0x8a, 0xd9, 0x07, 0xd9, 0x21, 0xe3, 0xaf,
-	0x1a, 0x9c, 0xeb, 0x63, 0x13, 0x09, 0x7c, 0x8f, 0x60, 0xb4, 0x04, 0x93, 0x6c, 0x61, 0x8e, 0xe5,
-	0x3a, 0xdc, 0xac, 0x71, 0xf3, 0x18, 0xff, 0xde, 0x71, 0xd0, 0x39, 0x38, 0x2e, 0x31, 0xb3, 0x6c,
-	0xc7, 0x09, 0xb9, 0x45, 0x53, 0x66, 0x45, 0x8e, 0x6d, 0x3a, 0x4e, 0x88, 0x36, 0x60, 0xbe, 0xdd,
-	0xa1, 0x76, 0xa3, 0x85, 0x2d, 0x42, 0x6d, 0x8a, 0x2d, 0xd7, 0xb3, 0x9a, 0x76, 0x73, 0x1f, 0x2f,
-	0x96, 0x39, 0xf1, 0x29, 0x39, 0x7b, 0x87, 0x4d, 0xee, 0x78, 0x75, 0x36, 0x85, 0x5e, 0x87, 0xa5,
-	0x1c, 0x93, 0x63, 0x53, 0xbb, 0x61, 0x13, 0xbc, 0x38, 0xc6, 0xf9, 0xe6, 0xd3, 0x7c, 0x5b, 0x72,
-	0xd6, 0xf8, 0xb3, 0x06, 0x7a, 0xb4, 0xa6, 0x6b, 0xc2, 0x8e, 0x6b, 0x3e, 0xa1, 0x11, 0xc2, 0x4f,
-	0xc1, 0xf1, 0x7d, 0x9f, 0x50, 0x6e, 0x2e, 0x26, 0x44, 0xe0, 0x7c, 0xed, 0x09, 0xb3, 0xc2, 0x46,
-	0x37, 0xc5, 0x20, 0x5a, 0x4e, 0xac, 0x98, 0x2d, 0x69, 0xfc, 0xda, 0x13, 0xf1, 0x9a, 0x3f, 0x28,
-	0xdc, 0x8b, 0xf2, 0x28, 0x7b, 0x71, 0xed, 0x89, 0x82, 0xdd, 0xb8, 0x3c, 0x0d, 0x15, 0x47, 0x1a,
-	0x6e, 0x35, 0x0e, 0x8d, 0x6f, 0xc4, 0xfe, 0x72, 0x87, 0xa9, 0xde, 0x72, 0x09, 0x0d, 0xdd, 0x46,
-	0xca, 0x5f, 0x96, 0x61, 0x2a, 0xb0, 0xf7, 0xb0, 0x45, 0xdc, 0x8f, 0xb0, 0xdc, 0x9b, 0x49, 0x36,
-	0x70, 0xc7, 0xfd, 0x08, 0xa3, 0x05, 0x38, 0xc6, 0x27, 0xa3, 0x45, 0x98, 0x13, 0xec, 0x73, 0xc7,
-	0x31, 0xbe, 0x48, 0x6c, 0x7b, 0x81, 0x68, 0xb9, 0xed, 0x6b, 0x70, 0xd2, 0xeb, 0xb4, 0x1b, 0x38,
-	0xb4, 0xfc, 0x5d, 0x8b, 0x2f, 0x9e, 0x48, 0x15, 0x33, 0x62, 0xfc, 0xe6, 0x2e, 0x67, 0x26, 0xe8,
-	0x5b, 0x30, 0x21, 0xe7, 0x4b, 0xab, 0xe5, 0xb5, 0x4a, 0x6d, 0xab, 0x5a, 0x18, 0x25, 0xaa, 0x03,
-	0x75, 0x56, 0x85, 0xc0, 0x2b, 0x1e, 0x0d, 0x0f, 0x4d, 0x29, 0x53, 0x7f, 0x1d, 0x2a, 0x89, 0x61,
-	0x74, 0x12, 0xca, 0x07, 0xf8, 0x50, 0x5a, 0xc2, 0xfe, 0x44, 0x73, 0x30, 0xde, 0xb5, 0x5b, 0x1d,
-	0x2c, 0xbd, 0x4f, 0x7c, 0xbc, 0x51, 0x7a, 0x4d, 0x33, 0x7e, 0x50, 0x82, 0xe5, 0x42, 0x5f, 0x18,
-	0x79, 0x89, 0xcb, 0x30, 0x15, 0x79, 0x84, 0x58, 0xe5, 0xb8, 0x39, 0x29, 0x1d, 0x82, 0xa0, 0x77,
-	0xe0, 0xb8, 0x38, 0xa7, 0x09, 0xc7, 0xae, 0xd4, 0x2e, 0xa4, 0x51, 0x10, 0xb1, 0x81, 0xc3, 0xc0,
-	0x69, 0xb9, 0xa3, 0xef, 0x78, 0xbb, 0xbe, 0x59, 0x71, 0xe2, 0x01, 0xf4, 0x2a, 0x2c, 0x08, 0x45,
-	0x4d, 0xdf, 0xa3, 0xa1, 0xdf, 0x6a, 0xe1, 0x90, 0x1f, 0x81, 0x0e, 0x91, 0x7e, 0x7f, 0x9a, 0x4f,
-	0xd7, 0x7b, 0xb3, 0x77, 0xf8, 0x24, 0x5a, 0x84, 0x63, 0x91, 0x4b, 0x8f, 0x73, 0xba, 0xe8, 0xd3,
-	0xa8, 0xc2, 0x6c, 0xbd, 0xe5, 0x13, 0x81, 0x7a, 0xe4, 0x38, 0xea, 0x33, 0x6d, 0xcc, 0x01, 0x4a,
-	0xd2, 0x0b, 0xa8, 0x8c, 0x7f, 0x68, 0x30, 0x6b, 0xe2, 0xb6, 0xdf, 0xc5, 0x77, 0x6d, 0x72, 0x30,
-	0x58, 0x0c, 0x7a, 0x13, 0xa6, 0xa8, 0x4d, 0x0e, 0x2c, 0x7a, 0x18, 0x88, 0x9d, 0x99, 0xa9, 0xad,
-	0xaa, 0x10, 0x61, 0x22, 0xef, 0x1e, 0x06, 0xd8, 0x9c, 0xa4, 0xf2, 0x2f, 0xe6, 0xbc, 0x9c, 0xdd,
-	0x75, 0x38, 0x9c, 0x65, 0x73, 0x82, 0x7d, 0xee, 0x38, 0xa8, 0x0e, 0x27, 0xe2, 0xa8, 0x6f, 0xb1,
-	0x3c, 0xc3, 0x81, 0xa9, 0xd4, 0xf4, 0xaa, 0xc8, 0x31, 0xd5, 0x28, 0xc7, 0x54, 0xef, 0x46, 0x49,
-	0xc8, 0x9c, 0x89, 0x59, 0xd8, 0x20, 0x8b, 0x5b, 0x32, 0x23, 0x58, 0x9e, 0xdd, 0xc6, 0x12, 0xb2,
-	0x8a, 0x1c, 0x7b, 0xcf, 0x6e, 0x63, 0x06, 0x43, 0x72, 0xbd, 0x12, 0x86, 0x9f, 0x73, 0x18, 0x08,
-	0xa6, 0xb7, 0x59, 0x12, 0x18, 0x02, 0x86, 0xac, 0xa6, 0x52, 0x4e, 0x53, 0x1a, 0xa9, 0xf2, 0xa8,
-	0x48, 0x09, 0x43, 0x63, 0x8b, 0xa4, 0xa1, 0xbf, 0xd4, 0x60, 0x2e, 0x72, 0xfd, 0xaf, 0x8e, 0xad,
-	0x37, 0xe1, 0x74, 0xc6, 0x28, 0x79, 0x12, 0x5f, 0x85, 0x85, 0x20, 0xf4, 0x9b, 0x98, 0x10, 0xd7,
-	0xdb, 0xb3, 0x78, 0x86, 0x15, 0x91, 0x9f, 0x1d, 0xc8, 0x32, 0x73, 0xfb, 0x78, 0x9a, 0x73, 0xf2,
-	0xb0, 0x4f, 0x8c, 0x7f, 0x95, 0xe0, 0xc2, 0x36, 0xa6, 0xf9, 0xe4, 0x65, 0x3f, 0x90, 0x07, 0xfe,
-	0x5e, 0xed, 0xf1, 0x24, 0x57, 0xf4, 0x2e, 0x54, 0x08, 0xb5, 0x43, 0x6a, 0xe1, 0x2e, 0xf6, 0xa8,
-	0x0c, 0x0a, 0xcf, 0xa9, 0xc0, 0xba, 0x87, 0x43, 0xc2, 0x32, 0x83, 0x30, 0x7a, 0x87, 0xe2, 0xb6,
-	0x09, 0x9c, 0xfd, 0x0a, 0xe3, 0x46, 0xdb, 0x30, 0x85, 0x3d, 0x47, 0x8a, 0x1a, 0x1b, 0x59, 0xd4,
-	0x24, 0xf6, 0x1c, 0x21, 0x28, 0x95, 0x31, 0xc6, 0x33, 0x19, 0xe3, 0x19, 0x38, 0xe1, 0xe1, 0x0f,
-	0xa9, 0xc5, 0x29, 0xa8, 0x7f, 0x80, 0xbd, 0xc5, 0x89, 0x55, 0x6d, 0xed, 0xb8, 0x39, 0xcd, 0x86,
-	0x6f, 0xd9, 0x7b, 0xf8, 0x2e, 0x1b, 0x34, 0xbe, 0xd4, 0x60, 0x6d, 0x30, 0xea, 0x72, 0x6b, 0x0b,
-	0x84, 0x6a, 0x05, 0x42, 0xd1, 0x55, 0x38, 0x11, 0xd5, 0x12, 0x0d, 0x9b, 0x36, 0xf7, 0x71, 0x94,
-	0x4e, 0xce, 0x16, 0xee, 0x01, 0x4b, 0xf8, 0x97, 0x5b, 0x7e, 0xc3, 0x9c, 0x91, 0x5c, 0x97, 0x05,
-	0x13, 0xba, 0x09, 0x27, 0xba, 0x02, 0x01, 0x4b, 0xce, 0x14, 0x27, 0x67, 0x15, 0x60, 0xe6, 0x4c,
-	0x37, 0xf5, 0x6d, 0x7c, 0xaa, 0xc1, 0xd9, 0x6d, 0x4c, 0xcd, 0xb8, 0xa4, 0xbb, 0x81, 0x09, 0xb1,
-	0xf7, 0x30, 0x89, 0x3c, 0xeb, 0x6d, 0x98, 0xe0, 0x0b, 0x13, 0xce, 0x5a, 0xa9, 0xad, 0xa9, 0x34,
-	0x25, 0x64, 0xf0, 0x45, 0x9b, 0x92, 0x6f, 0x88, 0xa3, 0x67, 0x7c, 0x52, 0x82, 0x15, 0x95, 0x19,
-	0x12, 0x6a, 0x1f, 0x66, 0xc4, 0xd9, 0x6e, 0xcb, 0x19, 0x69, 0xcf, 0x35, 0x45, 0x42, 0xee, 0x2f,
-	0x4e, 0x64, 0xe3, 0x68, 0x54, 0x24, 0xe5, 0x69, 0x92, 0x1c, 0xd3, 0xdb, 0x80, 0xf2, 0x44, 0x05,
-	0x29, 0x7a, 0x33, 0x99, 0xa2, 0x2b, 0xb5, 0xe7, 0x87, 0xc0, 0xa7, 0x67, 0x4d, 0x22, 0x9f, 0x7b,
-	0xb0, 0xba, 0x8d, 0xe9, 0xd6, 0xf5, 0xdb, 0x7d, 0xf6, 0xe2, 0x1d, 0x00, 0x91, 0x38, 0xbc, 0x5d,
-	0x3f, 0x5a, 0xff, 0x30, 0xfa, 0x58, 0xb4, 0xe2, 0xe9, 0x98, 0x07, 0x38, 0xf6, 0x17, 0x31, 0x0e,
-	0xe1, 0x5c, 0x1f, 0x7d, 0x12, 0xf4, 0xbb, 0x30, 0x9b, 0xa8, 0xf6, 0x2d, 0xc6, 0x1d, 0xe9, 0xbd,
-	0x30, 0xa4, 0x5e, 0xf3, 0x64, 0x98, 0x1e, 0x20, 0xc6, 0xbf, 0x35, 0x78, 0x8a, 0xe9, 0xe6, 0x21,
-	0xaa, 0xcf, 0x72, 0xef, 0xc1, 0x52, 0xcb, 0x26, 0xd4, 0x0a, 0x31, 0x0d, 0x5d, 0xdc, 0xc5, 0xbd,
-	0xbd, 0x8f, 0xe2, 0x7b, 0xa5, 0xb6, 0x9c, 0x4b, 0x8c, 0x3b, 0x1e, 0x7d, 0xf5, 0xe5, 0x7b, 0x0c,
-	0x56, 0x73, 0x9e, 0x71, 0x9b, 0x11, 0xb3, 0x94, 0xbe, 0xe3, 0xf4, 0xe4, 0xca, 0xb0, 0x9b, 0x96,
-	0x5b, 0x1a, 0x52, 0xee, 0xad, 0x88, 0x39, 0x96, 0x9b, 0x75, 0xf4, 0x72, 0xde, 0xd1, 0x7d, 0x78,
-	0xba, 0xff, 0xca, 0x25, 0xf0, 0xdb, 0x30, 0x99, 0xf0, 0xf3, 0x91, 0xfd, 0xaa, 0xc7, 0x6c, 0xfc,
-	0x49, 0x83, 0x39, 0x13, 0xdb, 0x41, 0xd0, 0x3a, 0xe4, 0x41, 0x92, 0x3c, 0xa6, 0x8c, 0xf1, 0x0a,
-	0x4c, 0xf0, 0x00, 0x4f, 0x64, 0xc0, 0x1a, 0x10, 0xf8, 0x24, 0xb1, 0xb1, 0x00, 0xa7, 0x33, 0xd6,
-	0xcb, 0x1a, 0xe0, 0xd7, 0x25, 0x58, 0xda, 0x74, 0x9c, 0x3b, 0xd8, 0x0e, 0x9b, 0xfb, 0x9b, 0x54,
-	0x94, 0xdb, 0xbd, 0x42, 0x20, 0x80, 0x93, 0x84, 0xcf, 0x58, 0x76, 0x34, 0x25, 0xdd, 0xf6, 0x8a,
-	0x22, 0x5c, 0x28, 0x65, 0x55, 0x33, 0xc3, 0x22, 0x56, 0x9c, 0x20, 0xe9, 0x51, 0x74, 0x1e, 0x66,
-	0x08, 0x6e, 0x76, 0x42, 0x5e, 0xb8, 0xf1, 0x44, 0x20, 0xc2, 0xdc, 0x74, 0x34, 0xca, 0x63, 0xa2,
-	0xee, 0xc2, 0x5c, 0x91, 0xbc, 0x64, 0x58, 0x99, 0x12, 0x61, 0xe5, 0x52, 0x32, 0xac, 0xcc, 0xd4,
-	0xce, 0x17, 0xe2, 0xb5, 0xe3, 0x39, 0xf8, 0x43, 0xec, 0x70, 0xb7, 0xe4, 0xe5, 0x48, 0x22, 0xa0,
-	0x9c, 0x01, 0xbd, 0x68, 0x51, 0x12, 0xbf, 0x45, 0x98, 0x8f, 0xaa, 0x95, 0xba, 0xf0, 0x4f, 0xb9,
-	0x5e, 0xe3, 0x8f, 0x65, 0x58, 0xc8, 0x4d, 0x49, 0xb7, 0xdc, 0x87, 0x25, 0xd2, 0x09, 0x02, 0x3f,
-	0xa4, 0xd8, 0xb1, 0x9a, 0x2d, 0x17, 0x7b, 0xd4, 0x92, 0x19, 0x25, 0xf2, 0xd3, 0x17, 0x0a, 0x0d,
-	0xbd, 0x13, 0x71, 0xd5, 0x39, 0x93, 0xcc, 0x4a, 0xc4, 0x5c, 0x20, 0xc5, 0x13, 0x2c, 0xd3, 0xb5,
-	0x31, 0xbb, 0xa6, 0x90, 0x7d, 0x37, 0xe0, 0x01, 0xaf, 0xd8, 0x07, 0xe3, 0x73, 0x70, 0xa3, 0x47,
-	0xce, 0x43, 0xdd, 0x4c, 0x3b, 0xf5, 0x8d, 0x3c, 0x38, 0x19, 0x30, 0xe1, 0x84, 0x32, 0x3e, 0x21,
-	0xb1, 0xcc, 0x5d, 0xa2, 0x3e, 0xe0, 0x4a, 0x97, 0x01, 0xa1, 0x7a, 0x2b, 0x16, 0xc3, 0x24, 0x4b,
-	0x87, 0x08, 0xd2, 0xa3, 0xfa, 0x01, 0xcc, 0x15, 0x11, 0x16, 0xec, 0xf4, 0x9b, 0xe9, 0x04, 0xa2,
-	0x0c, 0xac, 0x19, 0x71, 0xc9, 0xbd, 0xfe, 0x5d, 0x09, 0xe6, 0x4d, 0x6c, 0x3b, 0x5b, 0xd7, 0x6f,
-	0x67, 0x83, 0xe8, 0x06, 0x8c, 0xf1, 0x82, 0x56, 0xe3, 0x6e, 0xf4, 0xa4, 0xf2, 0xe2, 0x76, 0xfd,
-	0x36, 0x77, 0x20, 0x4e, 0x9c, 0x2a, 0xa4, 0x4b, 0xe9, 0x42, 0x9a, 0x39, 0xba, 0xdf, 0x09, 0x9b,
-	0xd8, 0x92, 0x71, 0x4d, 0x86, 0xb9, 0x69, 0x31, 0x2a, 0xc1, 0x42, 0x77, 0x61, 0xd1, 0xf5, 0x18,
-	0x85, 0xdb, 0xc5, 0x16, 0x2b, 0xef, 0x12, 0x21, 0x76, 0x6c, 0x70, 0x88, 0x3d, 0xdd, 0x63, 0xbe,
-	0xe2, 0x25, 0x22, 0xec, 0x23, 0xa9, 0xf0, 0xfe, 0x50, 0x82, 0x85, 0x1c, 0x58, 0xd2, 0xc1, 0x8f,
-	0x84, 0x56, 0x61, 0x96, 0x2c, 0xfd, 0x97, 0x59, 0x12, 0xd9, 0x30, 0x9f, 0x93, 0x9a, 0x74, 0xdb,
-	0x91, 0x12, 0xff, 0x5c, 0x56, 0x3c, 0x3f, 0x13, 0x05, 0x88, 0x8d, 0x15, 0x21, 0xf6, 0x85, 0x06,
-	0x0b, 0xb7, 0x3a, 0xe1, 0x1e, 0xfe, 0x9a, 0xfb, 0x97, 0xa1, 0xc3, 0x62, 0x7e, 0x9d, 0x32, 0x62,
-	0xfe, 0xbe, 0x04, 0x0b, 0x37, 0xf0, 0xd7, 0x1f, 0x84, 0x47, 0x73, 0xc8, 0x2e, 0xc3, 0x62, 0x1e,
-	0xac, 0xd1, 0x6e, 0x4d, 0xc6, 0x8f, 0x35, 0x58, 0x36, 0xf1, 0x6e, 0x88, 0xc9, 0x7e, 0x54, 0x63,
-	0x70, 0xdf, 0x7d, 0x4c, 0x1d, 0xe5, 0x15, 0x38, 0x53, 0x6c, 0x8d, 0x74, 0x90, 0xcf, 0x4b, 0x70,
-	0xd6, 0xc4, 0x04, 0x7b, 0x4e, 0xe6, 0x04, 0x92, 0x44, 0x4b, 0x53, 0x36, 0xd3, 0x64, 0x01, 0x3b,
-	0x65, 0x4e, 0x8a, 0x81, 0x1d, 0xe7, 0x7f, 0x55, 0x78, 0x9d, 0x87, 0x99, 0x10, 0xb7, 0x7d, 0x9a,
-	0x73, 0x25, 0x31, 0x1a, 0xb9, 0x52, 0xe6, 0x46, 0x3f, 0xf6, 0xe8, 0x6e, 0xf4, 0xe3, 0x47, 0xbf,
-	0xd1, 0x1b, 0xab, 0xb0, 0xa2, 0x42, 0x54, 0x82, 0x6e, 0xc3, 0xf2, 0x36, 0xa6, 0xf5, 0xd0, 0x27,
-	0x44, 0x2e, 0x25, 0x8b, 0x78, 0xdc, 0xdb, 0xd4, 0x32, 0xbd, 0xcd, 0xf3, 0x30, 0x43, 0xed, 0x70,
-	0x0f, 0xd3, 0x1e, 0x34, 0xb2, 0x66, 0x13, 0xa3, 0x52, 0x9e, 0xf1, 0xcf, 0x32, 0x9c, 0x29, 0xd6,
-	0x21, 0xfd, 0xf9, 0x80, 0xc9, 0x61, 0xd1, 0xb9, 0x71, 0x28, 0x3a, 0xad, 0x03, 0x6a, 0xcd, 0x7e,
-	0xc2, 0x78, 0x67, 0x89, 0x5c, 0x3e, 0xe4, 0x57, 0x4f, 0x51, 0x5a, 0x1c, 0xa7, 0x89, 0x21, 0xf4,
-	0x3d, 0x38, 0xbd, 0x6b, 0xbb, 0x2d, 0x56, 0x7f, 0xd9, 0x1d, 0x82, 0x63, 0x9d, 0x22, 0xe1, 0xbc,
-	0x7b, 0x14, 0x9d, 0x57, 0xb9, 0xc0, 0x3a, 0x93, 0x97, 0xd2, 0x8c, 0x76, 0x73, 0x13, 0xfa, 0x7d,
-	0x98, 0xcd, 0x99, 0x58, 0x70, 0x2b, 0xbe, 0x9a, 0x2e, 0x6a, 0x5e, 0x52, 0x6d, 0x7f, 0xd6, 0x28,
-	0xb9, 0x71, 0xc9, 0xab, 0xb1, 0x7e, 0x1f, 0x16, 0x14, 0x16, 0x16, 0x28, 0x7e, 0x3b, 0x5d, 0x37,
-	0x2b, 0xfd, 0x6e, 0x1b, 0x53, 0xa6, 0x2f, 0x21, 0x38, 0x59, 0x50, 0x7d, 0xa9, 0xc1, 0x9a, 0x80,
-	0xc7, 0xc9, 0xc1, 0x56, 0xf7, 0xdb, 0x41, 0x0b, 0x53, 0x3c, 0x44, 0xc3, 0x79, 0x48, 0x17, 0x43,
-	0x1f, 0x08, 0x0f, 0xb2, 0x42, 0xb9, 0x23, 0x44, 0xe6, 0xf8, 0x11, 0x60, 0x13, 0x8c, 0x4c, 0x70,
-	0xfc, 0x45, 0xd0, 0xd3, 0x30, 0xbd, 0x8b, 0x69, 0x73, 0xff, 0x3d, 0x2c, 0x82, 0x15, 0x3f, 0xd8,
-	0x93, 0x66, 0x7a, 0xd0, 0x20, 0xf0, 0xec, 0x10, 0x8b, 0x95, 0xde, 0x7e, 0x15, 0xc6, 0xa3, 0x3e,
-	0xc0, 0x11, 0x77, 0x96, 0xb3, 0x1b, 0x9f, 0x68, 0xb0, 0xc0, 0xee, 0xc2, 0x87, 0x9e, 0xdd, 0x76,
-	0x9b, 0x75, 0xdf, 0xdb, 0x75, 0xf7, 0x22, 0x44, 0x9f, 0x84, 0x4a, 0x93, 0x0f, 0x88, 0x8b, 0xb4,
-	0x08, 0x95, 0x20, 0x86, 0x78, 0xaf, 0x76, 0x0b, 0x8e, 0xed, 0xba, 0x2d, 0x8a, 0xc3, 0xa8, 0xd0,
-	0x7a, 0x4e, 0x55, 0xc4, 0x27, 0xc5, 0x5f, 0xe5, 0x2c, 0x66, 0xc4, 0x6a, 0xdc, 0x84, 0xc5, 0xbc,
-	0x05, 0xbd, 0x4a, 0x50, 0xfa, 0x91, 0x36, 0xcc, 0x7d, 0x55, 0xd0, 0x1a, 0x3f, 0xd1, 0x40, 0x7f,
-	0x3f, 0x70, 0x6c, 0x8a, 0x8f, 0xb6, 0xac, 0xf7, 0x60, 0x5a, 0x12, 0x70, 0x79, 0xd1, 0xe2, 0x9e,
-	0x1d, 0x66, 0x71, 0x22, 0xa7, 0x1f, 0x6f, 0xc6, 0x1f, 0xc4, 0x38, 0x0b, 0xcb, 0x85, 0xe6, 0xc8,
-	0xe0, 0xf9, 0x29, 0x4f, 0xb0, 0x2c, 0xf0, 0xe2, 0xc7, 0xb9, 0x0d, 0x3c, 0xb1, 0x16, 0x59, 0x21,
-	0xcd, 0xfc, 0x91, 0xc6, 0xae, 0xb2, 0x6d, 0xd7, 0xdb, 0xc2, 0xcc, 0x15, 0xa3, 0xb4, 0xf7, 0x98,
-	0xca, 0x80, 0xdf, 0x6a, 0xb0, 0x5c, 0x68, 0x8d, 0x74, 0x9c, 0x0b, 0x71, 0xaf, 0xd7, 0xe1, 0x14,
-	0x22, 0x28, 0x4c, 0xf6, 0x9a, 0xb9, 0x82, 0xcf, 0x41, 0x2f, 0x02, 0xea, 0x99, 0x45, 0x7a, 0xb4,
-	0x25, 0x4e, 0x3b, 0x1b, 0xcf, 0x24, 0xc8, 0x13, 0x8f, 0x43, 0x11, 0x79, 0x59, 0x90, 0xc7, 0x33,
-	0x92, 0x9c, 0xb9, 0xe2, 0x19, 0x6e, 0xe6, 0x0d, 0xdb, 0xf5, 0xa8, 0xed, 0x7a, 0x8f, 0x19, 0xb6,
-	0xcf, 0x34, 0x38, 0xab, 0xb0, 0xe7, 0xab, 0x05, 0xdc, 0x25, 0x58, 0xbc, 0xee, 0x92, 0xa3, 0xc5,
-	0x25, 0xe3, 0x3b, 0xb0, 0x54, 0xc0, 0x2c, 0x17, 0x58, 0x87, 0x63, 0xd8, 0xa3, 0xa1, 0xdb, 0xeb,
-	0x5d, 0x0f, 0x75, 0xae, 0x45, 0x2a, 0x8e, 0x38, 0x8d, 0x03, 0x40, 0xf9, 0x69, 0x84, 0x60, 0x2c,
-	0x61, 0x11, 0xff, 0x1b, 0x6d, 0xc2, 0x84, 0x8c, 0x22, 0xe5, 0x51, 0xa3, 0x88, 0x64, 0x34, 0x7e,
-	0xa6, 0x65, 0xb4, 0xf1, 0xe9, 0x23, 0xc5, 0xc6, 0x47, 0x14, 0x2b, 0xbe, 0x0d, 0xa7, 0x0a, 0xe6,
-	0x0b, 0xd7, 0xbf, 0x91, 0x2e, 0x41, 0x86, 0xb2, 0xb2, 0xf6, 0x77, 0x1d, 0x26, 0xb9, 0x9b, 0x6e,
-	0xde, 0xda, 0x41, 0x3f, 0xd5, 0x60, 0x49, 0xf9, 0x1b, 0x12, 0xf4, 0x7f, 0x03, 0xfa, 0x46, 0xaa,
-	0x5f, 0xc2, 0xe8, 0xaf, 0x8d, 0xce, 0x28, 0x3d, 0xe8, 0x63, 0x38, 0x55, 0xf0, 0xe6, 0x8f, 0x2e,
-	0x0e, 0x10, 0x98, 0xff, 0xad, 0x88, 0x5e, 0x1b, 0x85, 0x45, 0x6a, 0x4f, 0xc2, 0x91, 0xfb, 0x9d,
-	0xc3, 0x40, 0x38, 0x54, 0x3f, 0xf4, 0x18, 0x08, 0x87, 0xfa, 0x67, 0x1c, 0x36, 0x40, 0xfc, 0x9c,
-	0x8f, 0xd6, 0x14, 0x72, 0x72, 0xbf, 0x10, 0xd0, 0x9f, 0x1d, 0x82, 0x32, 0x56, 0x11, 0x3f, 0x95,
-	0x2b, 0x55, 0xe4, 0x7e, 0x3d, 0xa0, 0x54, 0x91, 0x7f, 0x77, 0x17, 0x2a, 0xa2, 0x47, 0xee, 0x3e,
-	0x2a, 0x32, 0x2f, 0xf3, 0x7d, 0x54, 0x64, 0x5f, 0xcc, 0xd1, 0x77, 0x61, 0x3a, 0xf5, 0x36, 0x8d,
-	0x9e, 0x1f, 0x80, 0x79, 0x4a, 0xd1, 0x0b, 0xc3, 0x11, 0x4b, 0x5d, 0xbf, 0xd1, 0xf8, 0x4b, 0x56,
-	0xdf, 0x07, 0x54, 0xf4, 0xff, 0xea, 0x6b, 0xca, 0x30, 0xef, 0xdd, 0xfa, 0x5b, 0x47, 0xe6, 0x97,
-	0x56, 0xfe, 0x50, 0x83, 0xf9, 0xe2, 0x27, 0x42, 0xf4, 0xf2, 0x88, 0x2f, 0x8a, 0xc2, 0xa2, 0x57,
-	0x8e, 0xf4, 0x0e, 0xc9, 0xcf, 0x94, 0xf2, 0x1d, 0x4e, 0x79, 0xa6, 0x06, 0xbd, 0x14, 0x2a, 0xcf,
-	0xd4, 0xe0, 0x27, 0xbf, 0x5f, 0x69, 0xfc, 0xb6, 0xab, 0x7c, 0xa2, 0x42, 0x6f, 0xf4, 0x11, 0x3d,
-	0xe0, 0x45, 0x4f, 0xbf, 0x74, 0x24, 0xde, 0xd8, 0x89, 0x53, 0x6f, 0x41, 0x4a, 0x27, 0x2e, 0x7a,
-	0xef, 0x52, 0x3a, 0x71, 0xe1, 0xf3, 0x12, 0x3a, 0x04, 0x94, 0x7f, 0x3c, 0x41, 0x2f, 0x8d, 0xfa,
-	0x78, 0xa4, 0x5f, 0x1c, 0x81, 0x43, 0xaa, 0x0e, 0xe0, 0x44, 0xe6, 0xe5, 0x01, 0xbd, 0x38, 0xec,
-	0x0b, 0x85, 0x50, 0x5a, 0x1d, 0xed, 0x41, 0x83, 0x69, 0xcc, 0xf4, 0xc3, 0x95, 0x1a, 0x8b, 0x1f,
-	0x19, 0x94, 0x1a, 0x55, 0x6d, 0x76, 0x02, 0x27, 0xb3, 0x7d, 0x56, 0xa4, 0x92, 0xa1, 0x68, 0x3c,
-	0xeb, 0xeb, 0x43, 0xd3, 0xc7, 0x4a, 0xb3, 0x2d, 0x49, 0xa5, 0x52, 0x45, 0xa3, 0x57, 0xa9, 0x54,
-	0xd9, 0xeb, 0xfc, 0x3e, 0xcc, 0x15, 0x35, 0x0d, 0x51, 0x4d, 0x89, 0x98, 0xb2, 0xdf, 0xa9, 0x6f,
-	0x8c, 0xc4, 0x93, 0x08, 0x74, 0xc5, 0x3d, 0x34, 0x65, 0xa0, 0xeb, 0xdb, 0xc4, 0x54, 0x06, 0xba,
-	0xfe, 0x8d, 0x3a, 0x06, 0x44, 0x51, 0x0f, 0x4a, 0x09, 0x44, 0x9f, 0xae, 0x9e, 0x12, 0x88, 0xbe,
-	0x5d, 0xba, 0xcf, 0x34, 0x38, 0x37, 0xb0, 0xcb, 0x81, 0xde, 0x52, 0xaf, 0x6e, 0xa8, 0x66, 0x90,
-	0xfe, 0xf6, 0xd1, 0x05, 0xc4, 0x7e, 0x9a, 0xed, 0x4a, 0x28, 0xfd, 0x54, 0xd1, 0x40, 0x51, 0xfa,
-	0xa9, 0xb2, 0xdd, 0xf1, 0x31, 0x9c, 0x2a, 0xe8, 0x14, 0x28, 0x2b, 0x4b, 0x75, 0x93, 0x43, 0x59,
-	0x59, 0xf6, 0x69, 0x44, 0x88, 0x53, 0x92, 0xef, 0x00, 0xf4, 0x39, 0x25, 0xca, 0xa6, 0x45, 0x9f,
-	0x53, 0xa2, 0x6e, 0x31, 0xa0, 0x2e, 0xcc, 0xe6, 0xee, 0x6d, 0x48, 0x05, 0xa2, 0xea, 0x7a, 0xa8,
-	0xbf, 0x34, 0x3c, 0x83, 0xd4, 0xfb, 0x00, 0x66, 0xd2, 0x6d, 0x04, 0xa4, 0xce, 0x18, 0xaa, 0x06,
-	0x88, 0x12, 0xf1, 0x7e, 0x5d, 0x8a, 0x4f, 0x35, 0x58, 0x88, 0x6e, 0xe2, 0x75, 0x3f, 0x0c, 0x3b,
-	0x41, 0xaf, 0x70, 0x42, 0x1b, 0xfd, 0xe4, 0x29, 0xda, 0x09, 0xfa, 0xcb, 0xa3, 0x31, 0x09, 0x33,
-	0x2e, 0x6f, 0xfe, 0xe5, 0xe1, 0x8a, 0xf6, 0xf9, 0xc3, 0x15, 0xed, 0x6f, 0x0f, 0x57, 0xb4, 0x6f,
-	0x6e, 0xec, 0xb9, 0x74, 0xbf, 0xd3, 0xa8, 0x36, 0xfd, 0xf6, 0x7a, 0xea, 0x9f, 0x0f, 0xaa, 0x7b,
-	0xd8, 0x13, 0xff, 0x5f, 0xd1, 0xfb, 0xe7, 0x8d, 0x4b, 0xfc, 0x8f, 0xee, 0xc5, 0xc6, 0x04, 0x1f,
-	0xdf, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf6, 0x40, 0x3d, 0xe4, 0x31, 0x00, 0x00,
+	// 2969 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x1b, 0x4b, 0x6f, 0x1b, 0xc7,
+	0xb9, 0x4b, 0x4a, 0xb2, 0xf4, 0xd1, 0x92, 0xa5, 0x89, 0x1e, 0xd4, 0xca, 0xb2, 0xe5, 0xb5, 0x13,
+	0xcb, 0x4e, 0x42, 0xd9, 0x94, 0x93, 0x26, 0x31, 0xd2, 0x58, 0xa2, 0xfc, 0x90, 0xe3, 0xe7, 0xca,
+	0xb1, 0x8b, 0xa2, 0x28, 0xbb, 0x22, 0x47, 0xd2, 0x56, 0xe4, 0x2e, 0xb3, 0xbb, 0x94, 0xc2, 0xa2,
+	0x68, 0x83, 0x20, 0x45, 0x0f, 0x7d, 0x06, 0x3d, 0xf4, 0xd8, 0x43, 0x8b, 0x1e, 0xda, 0x43, 0xd1,
+	0x7b, 0xcf, 0x45, 0x8f, 0xe9, 0x3f, 0x28, 0x5a, 0x20, 0x97, 0x02, 0x05, 0x8a, 0x5e, 0x7a, 0xec,
+	0xbc, 0x96, 0xfb, 0x9c, 0x25, 0xa9, 0xba, 0x50, 0x90, 0x83, 0x00, 0xee, 0xcc, 0xf7, 0x9e, 0x6f,
+	0xbe, 0xef, 0x9b, 0x6f, 0x46, 0x70, 0xbe, 0xbd, 0x8d, 0x9d, 0x95, 0x9a, 0x51, 0xc7, 0x56, 0x0d,
+	0xaf, 0x18, 0xf5, 0xa6, 0x69, 0xad, 0x1c, 0x5c, 0x5d, 0x71, 0xb1, 0x73, 0x60, 0xd6, 0x70, 0xa9,
+	0xe5, 0xd8, 0x9e, 0x8d, 0x66, 0x28, 0x50, 0x49, 0x00, 0x95, 0x18, 0x50, 0xe9, 0xe0, 0xaa, 0x7a,
+	0x76, 0xd7, 0xb6, 0x77, 0x1b, 0x78, 0x85, 0x01, 0x6d, 0xb7, 0x77, 0x56, 0x3c, 0xb3, 0x89, 0x5d,
+	0xcf, 0x68, 0xb6, 0x38, 0x9e, 0x7a, 0x26, 0x0e, 0x70, 0xe8, 0x18, 0xad, 0x16, 0x76, 0x5c, 0x31,
+	0xbf, 0x14, 0x65, 0xde, 0x32, 0x29, 0xeb, 0x9a, 0xdd, 0x6c, 0xda, 0x96, 0x80, 0xb8, 0x90, 0x06,
+	0x71, 0x60, 0xba, 0xe6, 0xb6, 0xd9, 0x30, 0xbd, 0x8e, 0x80, 0xd2, 0xd2, 0xa0, 0x0e, 0x6d, 0x67,
+	0x7f, 0xa7, 0x61, 0x1f, 0xa6, 0x52, 0x72, 0xf7, 0x0c, 0x07, 0xd7, 0x19, 0xbb, 0x46, 0xdb, 0xf5,
+	0x88, 0x76, 0xd9, 0x50, 0x7b, 0xa6, 0xeb, 0xd9, 0x4e, 0x3a, 0xbf, 0x00, 0xea, 0xfd, 0x36, 0x6e,
+	0x0b, 0x9b, 0xa9, 0xcb, 0x12, 0x18, 0x07, 0xb7, 0x1a, 0x66, 0xcd, 0xf0, 0x4c, 0x5f, 0x47, 0xed,
+	0x13, 0x05, 0x96, 0x36, 0xb0, 0x5b, 0x73, 0xcc, 0x6d, 0xfc, 0x4c, 0x08, 0x7d, 0xf3, 0x03, 0x5c,
+	0x6b, 0x53, 0x18, 0x1d, 0x13, 0xa2, 0xae, 0x87, 0x66, 0x61, 0xa4, 0x6e, 0x37, 0x0d, 0xd3, 0x2a,
+	0x2a, 0x4b, 0xca, 0xf2, 0x98, 0x2e, 0xbe, 0xd0, 0x7b, 0x80, 0x7c, 0x45, 0xab, 0xd8, 0x47, 0x2a,
+	0xe6, 0x08, 0x4c, 0xa1, 0xfc, 0x52, 0x29, 0xba, 0x6e, 0x2d, 0x93, 0xac, 0x5a, 0x29, 0xc9, 0x62,
+	0xea, 0x30, 0x3e, 0xa4, 0xfd, 0x45, 0x81, 0x73, 0x19, 0x32, 0xb9, 0x2d, 0xdb, 0x72, 0x31, 0x9a,
+	0x87, 0x51, 0xaa, 0x58, 0xbd, 0x6a, 0xd6, 0x99, 0x58, 0xc3, 0xfa, 0x09, 0xf6, 0xbd, 0x59, 0x47,
+	0xe7, 0xe0, 0xa4, 0xb0, 0x59, 0xd5, 0xa8, 0xd7, 0x1d, 0x26, 0xd1, 0x98, 0x5e, 0x10, 0x63, 0x6b,
+	0x64, 0x08, 0xad, 0xc2, 0x6c, 0xb3, 0xed, 0x19, 0xdb, 0x0d, 0x5c, 0x25, 0x4e, 0xe3, 0xe1, 0xaa,
+	0x69, 0x55, 0x6b, 0x46, 0x6d, 0x0f, 0x17, 0xf3, 0x0c, 0xf8, 0x05, 0x31, 0xbb, 0x45, 0x27, 0x37,
+	0xad, 0x0a, 0x9d, 0x42, 0x6f, 0xc2, 0x7c, 0x02, 0xa9, 0x6e, 0x90, 0x01, 0xc3, 0xc5, 0xc5, 0x21,
+	0x86, 0x37, 0x1b, 0xc5, 0xdb, 0x10, 0xb3, 0xda, 0x9f, 0x14, 0x50, 0x7d, 0x9d, 0xee, 0x70, 0x39,
+	0xee, 0xd8, 0xae, 0xe7, 0x5b, 0xf8, 0x3c, 0x91, 0x98, 0x7c, 0x32, 0x71, 0xb1, 0xeb, 0x72, 0x3b,
+	0xdf, 0xf9, 0x12, 0x91, 0x99, 0x8c, 0xae, 0xf1, 0x41, 0xb4, 0x10, 0xd2, 0x98, 0xaa, 0x34, 0x4c,
+	0x00, 0xba, 0x3a, 0x3f, 0x4b, 0x5d, 0x8b, 0xfc, 0x20, 0x6b, 0x41, 0xc8, 0x25, 0x57, 0x63, 0x7d,
+	0x1c, 0x0a, 0x75, 0x21, 0x78, 0x75, 0xbb, 0xa3, 0x7d, 0x35, 0xf0, 0x97, 0x2d, 0xca, 0x7a, 0x83,
+	0x28, 0x43, 0x3e, 0x22, 0xfe, 0xb2, 0x00, 0x63, 0x2d, 0x63, 0x97, 0x18, 0xc9, 0xfc, 0x36, 0x16,
+	0x6b, 0x33, 0x4a, 0x07, 0xb6, 0xc8, 0x37, 0x9a, 0x83, 0x13, 0x6c, 0xd2, 0x57, 0x42, 0x1f, 0xa1,
+	0x9f, 0x9b, 0x75, 0xed, 0xb3, 0xd0, 0xb2, 0xa7, 0x90, 0x16, 0xcb, 0xbe, 0x0c, 0x93, 0x56, 0xbb,
+	0x49, 0xd4, 0xa9, 0xda, 0x3b, 0x55, 0xa6, 0xbc, 0x2b, 0x58, 0x4c, 0xf0, 0xf1, 0x87, 0x3b, 0x0c,
+	0xd9, 0x45, 0x5f, 0x87, 0x11, 0x31, 0x9f, 0x5b, 0xca, 0x13, 0x2b, 0x6c, 0x94, 0x52, 0x23, 0x49,
+	0xa9, 0x27, 0xcf, 0x12, 0x27, 0x78, 0xd3, 0xf2, 0x9c, 0x8e, 0x2e, 0x68, 0xaa, 0x6f, 0x42, 0x21,
+	0x34, 0x8c, 0x26, 0x21, 0xbf, 0x8f, 0x3b, 0x42, 0x12, 0xfa, 0x13, 0x4d, 0xc3, 0xf0, 0x81, 0xd1,
+	0x68, 0x63, 0xe1, 0x7d, 0xfc, 0xe3, 0xad, 0xdc, 0x1b, 0x8a, 0xf6, 0x51, 0x0e, 0x16, 0x52, 0x7d,
+	0x61, 0x60, 0x15, 0x89, 0xa1, 0x7d, 0x8f, 0xe0, 0x5a, 0x12, 0x43, 0x0b, 0x87, 0x70, 0xd1, 0x5d,
+	0x38, 0xc9, 0xf7, 0x69, 0xc8, 0xb1, 0x0b, 0xe5, 0x8b, 0x51, 0x2b, 0xf0, 0xd8, 0xc0, 0xcc, 0xc0,
+	0x60, 0x99, 0xa3, 0x6f, 0x5a, 0x3b, 0xb6, 0x5e, 0xa8, 0x07, 0x03, 0xe8, 0x75, 0x98, 0xe3, 0x8c,
+	0x6a, 0x36, 0x51, 0xd7, 0x6e, 0x34, 0x88, 0x70, 0x74, 0x0b, 0xb4, 0x5d, 0xe1, 0xf7, 0x33, 0x6c,
+	0xba, 0xd2, 0x9d, 0xdd, 0x62, 0x93, 0xa8, 0x08, 0x27, 0x7c, 0x97, 0x1e, 0x66, 0x70, 0xfe, 0xa7,
+	0x56, 0x82, 0xa9, 0x4a, 0xc3, 0x76, 0xb9, 0xd5, 0x7d, 0xc7, 0x91, 0xef, 0x69, 0x6d, 0x1a, 0x50,
+	0x18, 0x9e, 0x9b, 0x4a, 0xfb, 0xa7, 0x02, 0x53, 0x3a, 0x6e, 0xda, 0x07, 0xf8, 0x89, 0xe1, 0xee,
+	0xf7, 0x26, 0x83, 0xde, 0x86, 0x31, 0x8f, 0x40, 0x56, 0xbd, 0x4e, 0x8b, 0xaf, 0xcc, 0x44, 0x79,
+	0x49, 0x66, 0x11, 0x4a, 0xf2, 0x09, 0x81, 0xd3, 0x47, 0x3d, 0xf1, 0x8b, 0x3a, 0x2f, 0x43, 0x27,
+	0x84, 0xa9, 0x39, 0xf3, 0xfa, 0x08, 0xfd, 0x24, 0x74, 0x2b, 0x70, 0x2a, 0xc8, 0x0c, 0x55, 0x9a,
+	0x8b, 0x98, 0x61, 0x0a, 0x65, 0xb5, 0xc4, 0xf3, 0x50, 0xc9, 0xcf, 0x43, 0xa5, 0x27, 0x7e, 0xa2,
+	0xd2, 0x27, 0x02, 0x14, 0x3a, 0x48, 0xe3, 0x96, 0xc8, 0x08, 0x55, 0xcb, 0x20, 0x14, 0xb8, 0xc9,
+	0x0a, 0x62, 0xec, 0x01, 0x19, 0xa2, 0x66, 0x08, 0xeb, 0x2b, 0xcc, 0xf0, 0x33, 0x66, 0x06, 0x17,
+	0x7b, 0x8f, 0x69, 0x12, 0xe8, 0xc3, 0x0c, 0x71, 0x4e, 0xb9, 0x04, 0xa7, 0xa8, 0xa5, 0xf2, 0x83,
+	0x5a, 0x8a, 0x0b, 0x1a, 0x48, 0x24, 0x04, 0xfd, 0xb9, 0x02, 0xd3, 0xbe, 0xeb, 0x7f, 0x7e, 0x64,
+	0x7d, 0x08, 0x33, 0x31, 0xa1, 0xc4, 0x4e, 0x24, 0x6e, 0x4f, 0x96, 0xad, 0x46, 0xfc, 0xd5, 0xb4,
+	0x76, 0xab, 0x2c, 0xc3, 0xf2, 0xc8, 0x4f, 0x37, 0x64, 0x9e, 0xba, 0x7d, 0x30, 0xcd, 0x30, 0x59,
+	0xd8, 0x77, 0xb5, 0x7f, 0xe7, 0xe0, 0xe2, 0x6d, 0xec, 0x25, 0x93, 0x97, 0x71, 0x28, 0x36, 0xfc,
+	0xd3, 0xf2, 0xf1, 0x24, 0x57, 0xf4, 0x2e, 0x14, 0x88, 0x06, 0x8e, 0x57, 0xc5, 0x07, 0xd8, 0xf2,
+	0x44, 0x50, 0xb8, 0x2c, 0x33, 0xd6, 0x53, 0x52, 0x2f, 0xd1, 0xcc, 0xc0, 0x85, 0xde, 0xf4, 0x70,
+	0x53, 0x07, 0x86, 0x7e, 0x93, 0x62, 0xa3, 0xdb, 0x30, 0x86, 0xad, 0xba, 0x20, 0x35, 0x34, 0x30,
+	0xa9, 0x51, 0x82, 0xcc, 0x09, 0x45, 0x32, 0xc6, 0x70, 0x2c, 0x63, 0xbc, 0x04, 0xa7, 0x2c, 0xfc,
+	0x81, 0x57, 0x65, 0x10, 0x9e, 0xbd, 0x8f, 0xad, 0xe2, 0x08, 0x01, 0x39, 0xa9, 0x8f, 0xd3, 0xe1,
+	0x47, 0x64, 0xf4, 0x09, 0x1d, 0xd4, 0xfe, 0xa1, 0xc0, 0x72, 0x6f, 0xab, 0x8b, 0xa5, 0x4d, 0x21,
+	0xaa, 0xa4, 0x10, 0x45, 0xb7, 0xe0, 0x94, 0x5f, 0x4b, 0x6c, 0x1b, 0x1e, 0x89, 0x85, 0x7e, 0x3a,
+	0x59, 0x4c, 0x5d, 0x03, 0x9a, 0xf0, 0xd7, 0x1b, 0xf6, 0xb6, 0x3e, 0x21, 0xb0, 0xd6, 0x39, 0x12,
+	0x7a, 0x48, 0x02, 0x04, 0xb7, 0x40, 0x55, 0xcc, 0xa4, 0x27, 0x67, 0x99, 0xc1, 0x48, 0xb0, 0x88,
+	0x7c, 0x6b, 0x1f, 0x2b, 0xb0, 0x48, 0xb4, 0xd5, 0x83, 0x92, 0xee, 0x3e, 0x71, 0x44, 0x22, 0xb7,
+	0xeb, 0x7b, 0xd6, 0x0d, 0x18, 0x61, 0x8a, 0x71, 0x67, 0x2d, 0x94, 0x97, 0x65, 0x9c, 0x42, 0x34,
+	0x98, 0xd2, 0xba, 0xc0, 0xeb, 0x63, 0xeb, 0x69, 0x1f, 0xe6, 0xe0, 0x8c, 0x4c, 0x0c, 0x61, 0x6a,
+	0x1b, 0x26, 0xf8, 0xde, 0x6e, 0x8a, 0x19, 0x21, 0xcf, 0x1d, 0x49, 0x42, 0xce, 0x26, 0xc7, 0xb3,
+	0xb1, 0x3f, 0xca, 0x93, 0xf2, 0xb8, 0x1b, 0x1e, 0x53, 0x9b, 0x80, 0x92, 0x40, 0x29, 0x29, 0x7a,
+	0x2d, 0x9c, 0xa2, 0x0b, 0xe5, 0x97, 0xfb, 0xb0, 0x4f, 0x57, 0x9a, 0x50, 0x3e, 0xb7, 0x60, 0x89,
+	0x88, 0xbc, 0x71, 0xef, 0x71, 0xc6, 0x5a, 0xdc, 0x05, 0xe0, 0x89, 0x83, 0xa4, 0x56, 0x5f, 0xff,
+	0x7e, 0xf8, 0xd1, 0x68, 0xc5, 0xd2, 0x31, 0x0b, 0x70, 0xf4, 0x97, 0xab, 0x75, 0xe0, 0x5c, 0x06,
+	0x3f, 0x61, 0xf4, 0x27, 0x30, 0x15, 0xaa, 0xf6, 0xab, 0x14, 0xdb, 0xe7, 0x7b, 0xb1, 0x4f, 0xbe,
+	0xfa, 0xa4, 0x13, 0x1d, 0x70, 0xb5, 0xff, 0x28, 0x70, 0x9e, 0xf2, 0x66, 0x21, 0x2a, 0x43, 0xdd,
+	0xa7, 0x30, 0xdf, 0x30, 0x48, 0x3d, 0xeb, 0x60, 0x52, 0x4d, 0x91, 0xf0, 0xd0, 0x5d, 0x7b, 0x3f,
+	0xbe, 0x17, 0xca, 0x0b, 0x89, 0xc4, 0xb8, 0x69, 0x79, 0xaf, 0x5f, 0x7b, 0x4a, 0xcd, 0xaa, 0xcf,
+	0x52, 0x6c, 0xdd, 0x47, 0x16, 0xd4, 0x49, 0x2e, 0xf0, 0xe9, 0x8a, 0xb0, 0x1b, 0xa5, 0x9b, 0xeb,
+	0x93, 0xee, 0x23, 0x1f, 0x39, 0xa0, 0x1b, 0x77, 0xf4, 0x7c, 0xd2, 0xd1, 0x6d, 0xb8, 0x90, 0xad,
+	0xb9, 0x30, 0xfc, 0x6d, 0x18, 0x0d, 0xf9, 0xf9, 0xc0, 0x7e, 0xd5, 0x45, 0xd6, 0xfe, 0x48, 0x72,
+	0xa5, 0x8e, 0xc9, 0x99, 0xb5, 0xd1, 0x61, 0x41, 0xd2, 0x3d, 0xa6, 0x8c, 0xf1, 0x1a, 0x8c, 0xb0,
+	0x00, 0xef, 0x8a, 0x80, 0xd5, 0x23, 0xf0, 0x09, 0x60, 0x6d, 0x0e, 0x66, 0x62, 0xd2, 0x8b, 0x1a,
+	0xe0, 0x97, 0x39, 0x98, 0x27, 0x47, 0x9a, 0x2d, 0x6c, 0x38, 0xb5, 0xbd, 0x35, 0x8f, 0x97, 0xdb,
+	0xdd, 0x42, 0xa0, 0x05, 0x93, 0x2e, 0x9b, 0xa9, 0x1a, 0xfe, 0x94, 0x70, 0xdb, 0x9b, 0x92, 0x70,
+	0x21, 0xa5, 0x55, 0x8a, 0x0d, 0xf3, 0x58, 0x71, 0xca, 0x8d, 0x8e, 0xa2, 0x17, 0x49, 0x78, 0x22,
+	0xba, 0x3a, 0xac, 0x70, 0x63, 0x89, 0x80, 0x87, 0xb9, 0x71, 0x7f, 0x94, 0xc5, 0x44, 0xd5, 0x84,
+	0xe9, 0x34, 0x7a, 0xe1, 0xb0, 0x32, 0xc6, 0xc3, 0xca, 0xf5, 0x70, 0x58, 0x99, 0x28, 0xbf, 0x98,
+	0x6a, 0xaf, 0x4d, 0xab, 0x4e, 0xd6, 0xa8, 0xce, 0xdc, 0x92, 0x95, 0x23, 0xa1, 0x80, 0x72, 0x1a,
+	0xd4, 0x34, 0xa5, 0x84, 0xfd, 0x8a, 0x30, 0xeb, 0x57, 0x2b, 0x15, 0xee, 0x9f, 0x42, 0x5f, 0xed,
+	0x0f, 0x79, 0x98, 0x4b, 0x4c, 0x09, 0xb7, 0xdc, 0x83, 0x79, 0xb7, 0xdd, 0x6a, 0xd9, 0x8e, 0x47,
+	0x36, 0x4d, 0xad, 0x61, 0x92, 0x25, 0xa9, 0x8a, 0x8c, 0xe2, 0xfb, 0xe9, 0x2b, 0xa9, 0x82, 0x6e,
+	0xf9, 0x58, 0x15, 0x86, 0x24, 0xb2, 0x92, 0xab, 0xcf, 0xb9, 0xe9, 0x13, 0x34, 0xd3, 0x35, 0x31,
+	0x3d, 0xa6, 0xb8, 0x7b, 0x66, 0x8b, 0x05, 0xbc, 0x74, 0x1f, 0x0c, 0xf6, 0xc1, 0xfd, 0x2e, 0x38,
+	0x0b, 0x75, 0x13, 0xcd, 0xc8, 0x37, 0xb2, 0x60, 0x92, 0xf6, 0x6d, 0x48, 0xde, 0xa3, 0x78, 0x9c,
+	0x62, 0x9e, 0xb9, 0x44, 0xa5, 0xc7, 0x91, 0x2e, 0x66, 0x84, 0xd2, 0xa3, 0x80, 0x0c, 0xa5, 0x2c,
+	0x1c, 0xa2, 0x15, 0x1d, 0x55, 0xf7, 0x61, 0x3a, 0x0d, 0x30, 0x65, 0xa5, 0xdf, 0x8e, 0x26, 0x10,
+	0x69, 0x60, 0x8d, 0x91, 0x0b, 0xaf, 0xf5, 0x6f, 0x73, 0x30, 0x4b, 0xf6, 0x49, 0x9d, 0x84, 0xf3,
+	0x78, 0x10, 0x5d, 0x85, 0x21, 0x56, 0xd0, 0x2a, 0xcc, 0x8d, 0xce, 0x4a, 0x0f, 0x6e, 0xf7, 0x1e,
+	0x33, 0x07, 0x62, 0xc0, 0x91, 0x42, 0x3a, 0x17, 0x2d, 0xa4, 0xa9, 0xa3, 0xdb, 0x6d, 0x87, 0x98,
+	0x50, 0xc4, 0x35, 0x11, 0xe6, 0xc6, 0xf9, 0xa8, 0x30, 0x16, 0xc9, 0x1c, 0x45, 0xd3, 0xa2, 0x10,
+	0xe6, 0x01, 0xae, 0xd2, 0xf2, 0x2e, 0x14, 0x62, 0x87, 0x7a, 0x87, 0xd8, 0x99, 0x2e, 0xf2, 0x4d,
+	0x2b, 0x14, 0x61, 0x9f, 0x4b, 0x85, 0xf7, 0xfb, 0x1c, 0xcc, 0x25, 0x8c, 0x25, 0x1c, 0xfc, 0x48,
+	0xd6, 0x4a, 0xcd, 0x92, 0xb9, 0xff, 0x31, 0x4b, 0x22, 0x03, 0x66, 0x13, 0x54, 0xc3, 0x6e, 0x3b,
+	0x50, 0xe2, 0x9f, 0x8e, 0x93, 0x67, 0x7b, 0x22, 0xc5, 0x62, 0x43, 0x69, 0x16, 0xfb, 0x4c, 0x81,
+	0xb9, 0x47, 0x6d, 0x67, 0x17, 0x7f, 0xc1, 0xfd, 0x4b, 0x53, 0xa1, 0x98, 0xd4, 0x53, 0x44, 0xcc,
+	0xdf, 0x11, 0xb7, 0xb9, 0x8f, 0xbf, 0xf8, 0x46, 0x78, 0x3e, 0x9b, 0x6c, 0x1d, 0x8a, 0x49, 0x63,
+	0x0d, 0x76, 0x6a, 0xd2, 0x7e, 0xa4, 0xc0, 0x82, 0x8e, 0x77, 0x1c, 0xec, 0xee, 0xf9, 0x35, 0x06,
+	0xf3, 0xdd, 0x63, 0xea, 0x28, 0x9f, 0x81, 0xd3, 0xe9, 0xd2, 0x08, 0x07, 0xf9, 0x34, 0x07, 0x8b,
+	0xb4, 0x5b, 0x61, 0xd5, 0x63, 0x3b, 0xd0, 0x0d, 0xb5, 0x34, 0x45, 0x33, 0x4d, 0x14, 0xb0, 0x63,
+	0xfa, 0x28, 0x1f, 0x20, 0x66, 0xff, 0x3f, 0x15, 0x5e, 0xc4, 0x95, 0x1c, 0xdc, 0xb4, 0xbd, 0x84,
+	0x2b, 0xf1, 0x51, 0xdf, 0x95, 0x62, 0x27, 0xfa, 0xa1, 0xe7, 0x77, 0xa2, 0x1f, 0x3e, 0xfa, 0x89,
+	0x5e, 0x5b, 0x82, 0x33, 0x32, 0x8b, 0x0a, 0xa3, 0x1b, 0xb0, 0x40, 0x0a, 0xea, 0x8a, 0x63, 0xbb,
+	0xae, 0x50, 0x25, 0x6e, 0xf1, 0xa0, 0xb7, 0xa9, 0xc4, 0x7a, 0x9b, 0xc4, 0x34, 0x44, 0xe6, 0x5d,
+	0xec, 0x75, 0x4d, 0x23, 0x6a, 0x36, 0x3e, 0x2a, 0xe8, 0x69, 0xff, 0xca, 0xc3, 0xe9, 0x74, 0x1e,
+	0xc2, 0x9f, 0xf7, 0x29, 0x1d, 0x1a, 0x9d, 0xb7, 0x3b, 0xbc, 0xd3, 0xda, 0xa3, 0xd6, 0xcc, 0x22,
+	0xc6, 0x3a, 0x4b, 0xee, 0x7a, 0x87, 0x1d, 0x3d, 0x79, 0x69, 0x71, 0xd2, 0x0b, 0x0d, 0xa1, 0xef,
+	0xc2, 0xcc, 0x8e, 0x61, 0x36, 0x68, 0xfd, 0x65, 0xb4, 0x5d, 0x1c, 0xf0, 0xe4, 0x09, 0xe7, 0xdd,
+	0xa3, 0xf0, 0xbc, 0xc5, 0x08, 0x56, 0x28, 0xbd, 0x08, 0x67, 0xb4, 0x93, 0x98, 0x50, 0xdf, 0x87,
+	0xa9, 0x84, 0x88, 0x29, 0xa7, 0xe2, 0x5b, 0xd1, 0xa2, 0xe6, 0x8a, 0x6c, 0xf9, 0xe3, 0x42, 0x89,
+	0x85, 0x0b, 0x1f, 0x8d, 0x09, 0xcb, 0x39, 0x89, 0x84, 0x29, 0x8c, 0x6f, 0x44, 0xeb, 0x66, 0xa9,
+	0xdf, 0x11, 0x83, 0x50, 0x7e, 0x21, 0xc2, 0xe1, 0x82, 0x8a, 0x76, 0x81, 0xb8, 0x79, 0xea, 0x09,
+	0xb3, 0x55, 0xec, 0x66, 0xab, 0x81, 0x49, 0xc9, 0xda, 0x47, 0xdb, 0xb1, 0x3f, 0x17, 0x43, 0xcf,
+	0xb8, 0x07, 0x91, 0x93, 0x2e, 0x5f, 0x11, 0x57, 0xe4, 0xf8, 0x01, 0xcc, 0xc6, 0x11, 0x29, 0xe1,
+	0xe0, 0xcb, 0x45, 0x17, 0x60, 0x7c, 0x07, 0x7b, 0xb5, 0xbd, 0x07, 0x98, 0x07, 0x2b, 0xb6, 0xb1,
+	0x47, 0xf5, 0xe8, 0xa0, 0xe6, 0xc2, 0xa5, 0x3e, 0x94, 0x15, 0xde, 0x4e, 0x56, 0xd6, 0xef, 0x03,
+	0x1c, 0x71, 0x65, 0x19, 0xba, 0xf6, 0x21, 0x29, 0x2a, 0xe8, 0x59, 0xb8, 0x43, 0xce, 0xca, 0x66,
+	0xad, 0x62, 0x5b, 0x3b, 0xe6, 0xae, 0x6f, 0xd1, 0xb3, 0x50, 0xa8, 0xb1, 0x01, 0x7e, 0x90, 0xe6,
+	0xa1, 0x12, 0xf8, 0x10, 0xeb, 0xd5, 0x6e, 0xc0, 0x89, 0x1d, 0xb3, 0x41, 0x28, 0xfb, 0x85, 0xd6,
+	0x65, 0x59, 0x11, 0x1f, 0x26, 0x7f, 0x8b, 0xa1, 0xe8, 0x3e, 0xaa, 0xf6, 0x10, 0x8a, 0x49, 0x09,
+	0xba, 0x95, 0xa0, 0xf0, 0x23, 0xa5, 0x9f, 0xf3, 0x2a, 0x87, 0xd5, 0x7e, 0xac, 0x80, 0xfa, 0x5e,
+	0xab, 0x6e, 0x78, 0xf8, 0x68, 0x6a, 0x3d, 0x80, 0x71, 0x01, 0xc0, 0xe8, 0xf9, 0xca, 0x5d, 0xea,
+	0x47, 0x39, 0x9e, 0xd3, 0x4f, 0xd6, 0x82, 0x0f, 0x57, 0x5b, 0x84, 0x85, 0x54, 0x71, 0x44, 0xf0,
+	0xfc, 0x98, 0x25, 0x58, 0x1a, 0x78, 0xf1, 0x71, 0x2e, 0x03, 0x4b, 0xac, 0x69, 0x52, 0x08, 0x31,
+	0x7f, 0xa8, 0xd0, 0xa3, 0x2c, 0xa1, 0xb4, 0x81, 0xa9, 0x2b, 0xfa, 0x69, 0xef, 0x98, 0xca, 0x80,
+	0x5f, 0x13, 0xa3, 0xa5, 0x4a, 0x23, 0x1c, 0xe7, 0x62, 0xd0, 0xeb, 0xad, 0x33, 0x08, 0x1e, 0x14,
+	0x46, 0xbb, 0xcd, 0x5c, 0x8e, 0x57, 0x47, 0xaf, 0x02, 0xea, 0x8a, 0xe5, 0x76, 0x61, 0x73, 0x0c,
+	0x76, 0x2a, 0x98, 0x09, 0x81, 0x87, 0x2e, 0x87, 0x7c, 0xf0, 0x3c, 0x07, 0x0f, 0x66, 0x04, 0x38,
+	0x75, 0xc5, 0xd3, 0x4c, 0xcc, 0xfb, 0xc4, 0x16, 0x1e, 0xf9, 0x3b, 0x66, 0xb3, 0xfd, 0x46, 0x81,
+	0x45, 0x89, 0x3c, 0x9f, 0x2f, 0xc3, 0x5d, 0x87, 0xe2, 0x3d, 0xc2, 0xef, 0x48, 0x1b, 0x42, 0xfb,
+	0x26, 0xcc, 0xa7, 0x20, 0x0b, 0x05, 0x2b, 0x70, 0x82, 0x14, 0x35, 0x8e, 0xd9, 0xed, 0x5d, 0xf7,
+	0xb5, 0xaf, 0x79, 0x2a, 0xf6, 0x31, 0xb5, 0x7d, 0x40, 0xc9, 0x69, 0x84, 0x60, 0x28, 0x24, 0x11,
+	0xfb, 0x8d, 0xd6, 0x60, 0x44, 0x44, 0x91, 0xfc, 0xa0, 0x51, 0x44, 0x20, 0x6a, 0x3f, 0x55, 0x62,
+	0xdc, 0xd8, 0xf4, 0x91, 0x62, 0xe3, 0x73, 0x8a, 0x15, 0xdf, 0x80, 0x17, 0x52, 0xe6, 0x53, 0xf5,
+	0x5f, 0x8d, 0x96, 0x20, 0xfd, 0x45, 0xf0, 0x1f, 0x28, 0xb4, 0x93, 0xc2, 0x82, 0xd1, 0x31, 0x6f,
+	0x98, 0x79, 0xda, 0xa5, 0x88, 0x09, 0x22, 0x02, 0xe2, 0x27, 0x64, 0x6f, 0x8b, 0xc6, 0x1e, 0xf9,
+	0x23, 0xe7, 0xb7, 0xba, 0x7f, 0xbf, 0xd3, 0x43, 0xd4, 0xc8, 0xd1, 0x2e, 0xd7, 0xfb, 0x68, 0x97,
+	0x4f, 0xbb, 0xcc, 0x9a, 0x86, 0x61, 0xc2, 0xc5, 0xe9, 0x88, 0x4b, 0x7b, 0xfe, 0x41, 0x2f, 0x65,
+	0x17, 0x25, 0x32, 0x09, 0xf7, 0xbf, 0x0b, 0x10, 0x6c, 0x4e, 0xb1, 0x03, 0x2e, 0xf7, 0x67, 0x1f,
+	0xd6, 0xc3, 0x08, 0x61, 0xa7, 0xc9, 0x9a, 0x4b, 0x91, 0xb5, 0xfc, 0xf7, 0xd3, 0x30, 0xca, 0xa2,
+	0xce, 0xda, 0xa3, 0x4d, 0xf4, 0x13, 0x05, 0xe6, 0xa5, 0x4f, 0x82, 0xd0, 0x97, 0x7b, 0xb4, 0x01,
+	0x65, 0x0f, 0x9b, 0xd4, 0x37, 0x06, 0x47, 0x14, 0x16, 0xf9, 0x0e, 0x71, 0xe6, 0xe4, 0x13, 0x0e,
+	0x74, 0xb5, 0x07, 0xc1, 0xe4, 0xd3, 0x1f, 0xb5, 0x3c, 0x08, 0x8a, 0xe0, 0x1e, 0x36, 0x47, 0xe2,
+	0xd9, 0x4a, 0x4f, 0x73, 0xc8, 0xde, 0xed, 0xf4, 0x34, 0x87, 0xfc, 0x55, 0x8e, 0x01, 0x10, 0xbc,
+	0xce, 0x40, 0xcb, 0x12, 0x3a, 0x89, 0x07, 0x1f, 0xea, 0xa5, 0x3e, 0x20, 0x03, 0x16, 0xc1, 0xcb,
+	0x07, 0x29, 0x8b, 0xc4, 0x63, 0x10, 0x29, 0x8b, 0xe4, 0x33, 0x0a, 0xce, 0xc2, 0x7f, 0xb3, 0x90,
+	0xc1, 0x22, 0xf6, 0xd0, 0x22, 0x83, 0x45, 0xfc, 0x01, 0x04, 0xfa, 0x16, 0x8c, 0x47, 0x9e, 0x1a,
+	0xa0, 0x97, 0x7b, 0xd8, 0x3c, 0xc2, 0xe8, 0x95, 0xfe, 0x80, 0x05, 0xaf, 0x5f, 0x29, 0xec, 0x62,
+	0x32, 0xf3, 0x3e, 0x1c, 0x7d, 0x45, 0x7e, 0xea, 0xec, 0xe7, 0xf9, 0x82, 0xfa, 0xce, 0x91, 0xf1,
+	0x85, 0x94, 0xdf, 0x27, 0x61, 0x3b, 0xfd, 0xc6, 0x17, 0x5d, 0x1b, 0xf0, 0x82, 0x98, 0x4b, 0xf4,
+	0xda, 0x91, 0xae, 0x95, 0xd9, 0x9e, 0x92, 0x5e, 0xab, 0x4a, 0xf7, 0x54, 0xaf, 0x8b, 0x5f, 0xe9,
+	0x9e, 0xea, 0x7d, 0x83, 0xfb, 0x0b, 0x85, 0x35, 0x2f, 0xa4, 0x37, 0x8e, 0xe8, 0xad, 0x0c, 0xd2,
+	0x3d, 0x2e, 0x68, 0xd5, 0xeb, 0x47, 0xc2, 0x0d, 0x9c, 0x38, 0x72, 0xb5, 0x27, 0x75, 0xe2, 0xb4,
+	0xeb, 0x4b, 0xa9, 0x13, 0xa7, 0xde, 0x16, 0xa2, 0x0e, 0xa0, 0xe4, 0x5d, 0x18, 0xba, 0x32, 0xe8,
+	0x5d, 0xa0, 0x7a, 0x75, 0x00, 0x0c, 0xc1, 0xba, 0x05, 0xa7, 0x62, 0x17, 0x49, 0xe8, 0xd5, 0x7e,
+	0x2f, 0x9c, 0x38, 0xd3, 0xd2, 0x60, 0xf7, 0x53, 0x94, 0x63, 0xec, 0x7a, 0x43, 0xca, 0x31, 0xfd,
+	0xce, 0x48, 0xca, 0x51, 0x76, 0x6b, 0xe2, 0xc2, 0x64, 0xbc, 0x6d, 0x8e, 0x64, 0x34, 0x24, 0xf7,
+	0x08, 0xea, 0x4a, 0xdf, 0xf0, 0x01, 0xd3, 0x78, 0x87, 0x59, 0xca, 0x54, 0xd2, 0xb7, 0x97, 0x32,
+	0x95, 0xb6, 0xae, 0xbf, 0x47, 0x6f, 0xd3, 0x93, 0x3d, 0x60, 0x54, 0x96, 0x5a, 0x4c, 0xda, 0xbe,
+	0x56, 0x57, 0x07, 0xc2, 0x09, 0x05, 0xba, 0xf4, 0x96, 0xa8, 0x34, 0xd0, 0x65, 0xf6, 0xa4, 0xa5,
+	0x81, 0x2e, 0xbb, 0xef, 0x4a, 0x0d, 0x91, 0xd6, 0x52, 0x94, 0x1a, 0x22, 0xa3, 0x49, 0x2b, 0x35,
+	0x44, 0x66, 0xd3, 0x95, 0x9c, 0x27, 0xcf, 0xf5, 0x6c, 0x5a, 0xa1, 0x77, 0xe4, 0xda, 0xf5, 0xd5,
+	0xdb, 0x53, 0x6f, 0x1c, 0x9d, 0x40, 0xe0, 0xa7, 0xf1, 0x26, 0x93, 0xd4, 0x4f, 0x25, 0xfd, 0x30,
+	0xa9, 0x9f, 0x4a, 0xbb, 0x57, 0xa4, 0xb2, 0x4c, 0x69, 0xfc, 0x48, 0x2b, 0x4b, 0x79, 0xcf, 0x4a,
+	0x5a, 0x59, 0x66, 0xf4, 0x95, 0xf8, 0x2e, 0x49, 0x36, 0x74, 0x32, 0x76, 0x89, 0xb4, 0x07, 0x95,
+	0xb1, 0x4b, 0xe4, 0x1d, 0x23, 0x74, 0x00, 0x53, 0x89, 0x63, 0x38, 0x92, 0x19, 0x51, 0x76, 0xda,
+	0x57, 0xaf, 0xf4, 0x8f, 0x20, 0xf8, 0x1e, 0xc2, 0x44, 0xb4, 0x2b, 0x84, 0xe4, 0x19, 0x43, 0xd6,
+	0xcf, 0x92, 0x5a, 0x3c, 0xab, 0xe9, 0xf4, 0xb1, 0x02, 0x73, 0x7e, 0x63, 0xa5, 0x62, 0x3b, 0x4e,
+	0xbb, 0xd5, 0x2d, 0x9c, 0xd0, 0x6a, 0x16, 0x3d, 0x49, 0x77, 0x48, 0xbd, 0x36, 0x18, 0x52, 0x38,
+	0xf5, 0x44, 0xce, 0xac, 0x19, 0xa9, 0x27, 0xed, 0x90, 0x9d, 0x91, 0x7a, 0x52, 0x8f, 0xc2, 0xe8,
+	0x23, 0x05, 0x66, 0x52, 0x8f, 0x9d, 0x52, 0xb5, 0xb3, 0x0e, 0xce, 0x52, 0xb5, 0x33, 0x4f, 0xb6,
+	0xeb, 0x6b, 0x7f, 0xfe, 0xdb, 0x19, 0xe5, 0x53, 0xf2, 0xf7, 0x57, 0xf2, 0xf7, 0xb5, 0xd5, 0x5d,
+	0xd3, 0xdb, 0x6b, 0x6f, 0x97, 0x6a, 0x76, 0x73, 0x25, 0xf2, 0x2f, 0x34, 0xa5, 0x5d, 0x6c, 0xf1,
+	0xff, 0x24, 0xea, 0xfe, 0x9b, 0xd2, 0x75, 0xf6, 0xe3, 0xe0, 0xea, 0xf6, 0x08, 0x1b, 0x5f, 0xfd,
+	0x2f, 0x2b, 0x09, 0xb2, 0xe3, 0xce, 0x34, 0x00, 0x00,
 }
 
 func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -6250,77 +6481,251 @@ func (m *DynamicConfigFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RestoreWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *RestoreWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestoreWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.HistoryAddr)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.MutableStateInCache)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.MutableStateInDatabase)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SearchArchivedHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchArchivedHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchArchivedHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DescribeBy != nil {
-		n += m.DescribeBy.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SearchArchivedHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SearchArchivedHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SearchArchivedHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.HistoryAddr)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.MutableStateInCache)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.MutableStateInDatabase)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DescribeBy != nil {
+		n += m.DescribeBy.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *DescribeHistoryHostRequest_HostAddress) Size() (n int) {
@@ -7485,6 +7890,87 @@ func (m *DynamicConfigFilter) Size() (n int) {
 	return n
 }
 
+func (m *RestoreWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchArchivedHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SearchArchivedHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -14527,6 +15013,463 @@ func (m *DynamicConfigFilter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestoreWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchArchivedHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchArchivedHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchArchivedHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchArchivedHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SearchArchivedHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SearchArchivedHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, &v1.WorkflowExecutionInfo{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -71,6 +71,8 @@ type AdminAPIYARPCClient interface {
 	ListDynamicConfig(context.Context, *ListDynamicConfigRequest, ...yarpc.CallOption) (*ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*AdminMaintainWorkflowResponse, error)
+	RestoreWorkflow(context.Context, *RestoreWorkflowRequest, ...yarpc.CallOption) (*RestoreWorkflowResponse, error)
+	SearchArchivedHistory(context.Context, *SearchArchivedHistoryRequest, ...yarpc.CallOption) (*SearchArchivedHistoryResponse, error)
 }
 
 func newAdminAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminAPIYARPCClient {
@@ -118,6 +120,8 @@ type AdminAPIYARPCServer interface {
 	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *AdminDeleteWorkflowRequest) (*AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *AdminMaintainWorkflowRequest) (*AdminMaintainWorkflowResponse, error)
+	RestoreWorkflow(context.Context, *RestoreWorkflowRequest) (*RestoreWorkflowResponse, error)
+	SearchArchivedHistory(context.Context, *SearchArchivedHistoryRequest) (*SearchArchivedHistoryResponse, error)
 }
 
 type buildAdminAPIYARPCProceduresParams struct {
@@ -401,6 +405,26 @@ func buildAdminAPIYARPCProcedures(params buildAdminAPIYARPCProceduresParams) []t
 						},
 					),
 				},
+				{
+					MethodName: "RestoreWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RestoreWorkflow,
+							NewRequest:  newAdminAPIServiceRestoreWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "SearchArchivedHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.SearchArchivedHistory,
+							NewRequest:  newAdminAPIServiceSearchArchivedHistoryYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -837,6 +861,30 @@ func (c *_AdminAPIYARPCCaller) MaintainCorruptWorkflow(ctx context.Context, requ
 	return response, err
 }
 
+func (c *_AdminAPIYARPCCaller) RestoreWorkflow(ctx context.Context, request *RestoreWorkflowRequest, options ...yarpc.CallOption) (*RestoreWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RestoreWorkflow", request, newAdminAPIServiceRestoreWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RestoreWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceRestoreWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) SearchArchivedHistory(ctx context.Context, request *SearchArchivedHistoryRequest, options ...yarpc.CallOption) (*SearchArchivedHistoryResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SearchArchivedHistory", request, newAdminAPIServiceSearchArchivedHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SearchArchivedHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceSearchArchivedHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _AdminAPIYARPCHandler struct {
 	server AdminAPIYARPCServer
 }
@@ -1273,6 +1321,38 @@ func (h *_AdminAPIYARPCHandler) MaintainCorruptWorkflow(ctx context.Context, req
 	return response, err
 }
 
+func (h *_AdminAPIYARPCHandler) RestoreWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RestoreWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RestoreWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceRestoreWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RestoreWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) SearchArchivedHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SearchArchivedHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SearchArchivedHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceSearchArchivedHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SearchArchivedHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
 	return &DescribeWorkflowExecutionRequest{}
 }
@@ -1489,6 +1569,22 @@ func newAdminAPIServiceMaintainCorruptWorkflowYARPCResponse() proto.Message {
 	return &AdminMaintainWorkflowResponse{}
 }
 
+func newAdminAPIServiceRestoreWorkflowYARPCRequest() proto.Message {
+	return &RestoreWorkflowRequest{}
+}
+
+func newAdminAPIServiceRestoreWorkflowYARPCResponse() proto.Message {
+	return &RestoreWorkflowResponse{}
+}
+
+func newAdminAPIServiceSearchArchivedHistoryYARPCRequest() proto.Message {
+	return &SearchArchivedHistoryRequest{}
+}
+
+func newAdminAPIServiceSearchArchivedHistoryYARPCResponse() proto.Message {
+	return &SearchArchivedHistoryResponse{}
+}
+
 var (
 	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest          = &DescribeWorkflowExecutionRequest{}
 	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse         = &DescribeWorkflowExecutionResponse{}
@@ -1544,189 +1640,200 @@ var (
 	emptyAdminAPIServiceDeleteWorkflowYARPCResponse                    = &AdminDeleteWorkflowResponse{}
 	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCRequest            = &AdminMaintainWorkflowRequest{}
 	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCResponse           = &AdminMaintainWorkflowResponse{}
+	emptyAdminAPIServiceRestoreWorkflowYARPCRequest                    = &RestoreWorkflowRequest{}
+	emptyAdminAPIServiceRestoreWorkflowYARPCResponse                   = &RestoreWorkflowResponse{}
+	emptyAdminAPIServiceSearchArchivedHistoryYARPCRequest              = &SearchArchivedHistoryRequest{}
+	emptyAdminAPIServiceSearchArchivedHistoryYARPCResponse             = &SearchArchivedHistoryResponse{}
 )
 
 var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 	// uber/cadence/admin/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xcb, 0x73, 0x1c, 0x47,
-		0x19, 0xcf, 0xec, 0x4a, 0xb2, 0xf4, 0xad, 0x25, 0x5b, 0x6d, 0x59, 0x8f, 0x91, 0xed, 0xc8, 0x93,
-		0x38, 0x56, 0x5e, 0xab, 0x78, 0x95, 0x84, 0x24, 0xae, 0x90, 0xc8, 0x2b, 0x5b, 0x56, 0x62, 0xc7,
-		0xf6, 0xd8, 0x71, 0x28, 0x8a, 0x62, 0x98, 0xdd, 0x69, 0x49, 0x83, 0x76, 0x67, 0xc6, 0xd3, 0xbd,
-		0xeb, 0x6c, 0x2a, 0x05, 0x29, 0xca, 0x9c, 0x78, 0x17, 0x07, 0x8e, 0x1c, 0xa0, 0x72, 0x80, 0x03,
-		0xc5, 0x9d, 0x33, 0x67, 0xf8, 0x27, 0x72, 0x09, 0x45, 0x15, 0xc5, 0x85, 0x23, 0xd5, 0x8f, 0xd9,
-		0x79, 0xf6, 0x3e, 0x84, 0x29, 0xa7, 0x72, 0xd3, 0x74, 0x7f, 0xaf, 0xfe, 0xf5, 0xd7, 0xdf, 0xf7,
-		0xf5, 0xd7, 0x2b, 0x78, 0xa6, 0xd3, 0xc0, 0xe1, 0x46, 0xd3, 0x76, 0xb0, 0xd7, 0xc4, 0x1b, 0xb6,
-		0xd3, 0x76, 0xbd, 0x8d, 0xee, 0xa5, 0x0d, 0x82, 0xc3, 0xae, 0xdb, 0xc4, 0xd5, 0x20, 0xf4, 0xa9,
-		0x8f, 0x4e, 0x33, 0xa2, 0xaa, 0x24, 0xaa, 0x72, 0xa2, 0x6a, 0xf7, 0x92, 0xfe, 0xf4, 0xbe, 0xef,
-		0xef, 0xb7, 0xf0, 0x06, 0x27, 0x6a, 0x74, 0xf6, 0x36, 0xa8, 0xdb, 0xc6, 0x84, 0xda, 0xed, 0x40,
-		0xf0, 0xe9, 0xe7, 0xb2, 0x04, 0x0f, 0x43, 0x3b, 0x08, 0x70, 0x48, 0xe4, 0xfc, 0x5a, 0x5a, 0x79,
-		0xe0, 0x32, 0xd5, 0x4d, 0xbf, 0xdd, 0xf6, 0x3d, 0x49, 0xf1, 0x6c, 0x11, 0x45, 0xd7, 0x25, 0x6e,
-		0xc3, 0x6d, 0xb9, 0xb4, 0x57, 0x48, 0x45, 0x0e, 0xec, 0x10, 0x3b, 0x5c, 0x54, 0xab, 0x43, 0x28,
-		0x0e, 0x87, 0x50, 0x1d, 0xb8, 0x84, 0xfa, 0x61, 0x24, 0xcb, 0x50, 0x50, 0x3d, 0xe8, 0xe0, 0x8e,
-		0xc4, 0x43, 0x5f, 0x57, 0xd0, 0x84, 0x38, 0x68, 0xb9, 0x4d, 0x9b, 0xba, 0x91, 0xfd, 0xc6, 0xaf,
-		0x34, 0x58, 0xdb, 0xc6, 0xa4, 0x19, 0xba, 0x0d, 0xfc, 0x91, 0x1f, 0x1e, 0xee, 0xb5, 0xfc, 0x87,
-		0x57, 0x3f, 0xc6, 0xcd, 0x0e, 0xa3, 0x31, 0xf1, 0x83, 0x0e, 0x26, 0x14, 0x2d, 0xc2, 0x94, 0xe3,
-		0xb7, 0x6d, 0xd7, 0x5b, 0xd6, 0xd6, 0xb4, 0xf5, 0x19, 0x53, 0x7e, 0xa1, 0x0f, 0x01, 0x3d, 0x94,
-		0x3c, 0x16, 0x8e, 0x98, 0x96, 0x4b, 0x6b, 0xda, 0x7a, 0xa5, 0xf6, 0x5c, 0x35, 0xbd, 0x27, 0x81,
-		0x5b, 0xed, 0x5e, 0xaa, 0xe6, 0x55, 0xcc, 0x3f, 0xcc, 0x0e, 0x19, 0x7f, 0xd7, 0xe0, 0xfc, 0x00,
-		0x9b, 0x48, 0xe0, 0x7b, 0x04, 0xa3, 0x15, 0x98, 0x66, 0x0b, 0x73, 0x2c, 0xd7, 0xe1, 0x66, 0x4d,
-		0x9a, 0xc7, 0xf8, 0xf7, 0xae, 0x83, 0xce, 0xc3, 0x71, 0x89, 0x99, 0x65, 0x3b, 0x4e, 0xc8, 0x2d,
-		0x9a, 0x31, 0x2b, 0x72, 0x6c, 0xcb, 0x71, 0x42, 0xb4, 0x09, 0x8b, 0xed, 0x0e, 0xb5, 0x1b, 0x2d,
-		0x6c, 0x11, 0x6a, 0x53, 0x6c, 0xb9, 0x9e, 0xd5, 0xb4, 0x9b, 0x07, 0x78, 0xb9, 0xcc, 0x89, 0x4f,
-		0xc9, 0xd9, 0xbb, 0x6c, 0x72, 0xd7, 0xab, 0xb3, 0x29, 0xf4, 0x26, 0xac, 0xe4, 0x98, 0x1c, 0x9b,
-		0xda, 0x0d, 0x9b, 0xe0, 0xe5, 0x09, 0xce, 0xb7, 0x98, 0xe6, 0xdb, 0x96, 0xb3, 0xc6, 0x5f, 0x35,
-		0xd0, 0xa3, 0x35, 0x5d, 0x17, 0x76, 0x5c, 0xf7, 0x09, 0x8d, 0x10, 0x7e, 0x06, 0x8e, 0x1f, 0xf8,
-		0x84, 0x72, 0x73, 0x31, 0x21, 0x02, 0xe7, 0xeb, 0x4f, 0x99, 0x15, 0x36, 0xba, 0x25, 0x06, 0xd1,
-		0x6a, 0x62, 0xc5, 0x6c, 0x49, 0x93, 0xd7, 0x9f, 0x8a, 0xd7, 0xfc, 0x51, 0xe1, 0x5e, 0x94, 0xc7,
-		0xd9, 0x8b, 0xeb, 0x4f, 0x15, 0xec, 0xc6, 0x95, 0x59, 0xa8, 0x38, 0xd2, 0x70, 0xab, 0xd1, 0x33,
-		0xbe, 0x15, 0xfb, 0xcb, 0x5d, 0xa6, 0x7a, 0xdb, 0x25, 0x34, 0x74, 0x1b, 0x29, 0x7f, 0x59, 0x85,
-		0x99, 0xc0, 0xde, 0xc7, 0x16, 0x71, 0x3f, 0xc1, 0x72, 0x6f, 0xa6, 0xd9, 0xc0, 0x5d, 0xf7, 0x13,
-		0x8c, 0x96, 0xe0, 0x18, 0x9f, 0x8c, 0x16, 0x61, 0x4e, 0xb1, 0xcf, 0x5d, 0xc7, 0xf8, 0x22, 0xb1,
-		0xed, 0x05, 0xa2, 0xe5, 0xb6, 0xaf, 0xc3, 0x49, 0xaf, 0xd3, 0x6e, 0xe0, 0xd0, 0xf2, 0xf7, 0x2c,
-		0xbe, 0x78, 0x22, 0x55, 0xcc, 0x89, 0xf1, 0x5b, 0x7b, 0x9c, 0x99, 0xa0, 0xef, 0xc0, 0x94, 0x9c,
-		0x2f, 0xad, 0x95, 0xd7, 0x2b, 0xb5, 0xed, 0x6a, 0x61, 0x94, 0xa8, 0x0e, 0xd5, 0x59, 0x15, 0x02,
-		0xaf, 0x7a, 0x34, 0xec, 0x99, 0x52, 0xa6, 0xfe, 0x26, 0x54, 0x12, 0xc3, 0xe8, 0x24, 0x94, 0x0f,
-		0x71, 0x4f, 0x5a, 0xc2, 0xfe, 0x44, 0x0b, 0x30, 0xd9, 0xb5, 0x5b, 0x1d, 0x2c, 0xbd, 0x4f, 0x7c,
-		0xbc, 0x55, 0x7a, 0x43, 0x33, 0x7e, 0x54, 0x82, 0xd5, 0x42, 0x5f, 0x18, 0x7b, 0x89, 0xab, 0x30,
-		0x13, 0x79, 0x84, 0x58, 0xe5, 0xa4, 0x39, 0x2d, 0x1d, 0x82, 0xa0, 0xf7, 0xe0, 0xb8, 0x38, 0xa7,
-		0x09, 0xc7, 0xae, 0xd4, 0x2e, 0xa6, 0x51, 0x10, 0xb1, 0x81, 0xc3, 0xc0, 0x69, 0xb9, 0xa3, 0xef,
-		0x7a, 0x7b, 0xbe, 0x59, 0x71, 0xe2, 0x01, 0xf4, 0x3a, 0x2c, 0x09, 0x45, 0x4d, 0xdf, 0xa3, 0xa1,
-		0xdf, 0x6a, 0xe1, 0x90, 0x1f, 0x81, 0x0e, 0x91, 0x7e, 0x7f, 0x9a, 0x4f, 0xd7, 0xfb, 0xb3, 0x77,
-		0xf9, 0x24, 0x5a, 0x86, 0x63, 0x91, 0x4b, 0x4f, 0x72, 0xba, 0xe8, 0xd3, 0xa8, 0xc2, 0x7c, 0xbd,
-		0xe5, 0x13, 0x81, 0x7a, 0xe4, 0x38, 0xea, 0x33, 0x6d, 0x2c, 0x00, 0x4a, 0xd2, 0x0b, 0xa8, 0x8c,
-		0x7f, 0x6a, 0x30, 0x6f, 0xe2, 0xb6, 0xdf, 0xc5, 0xf7, 0x6c, 0x72, 0x38, 0x5c, 0x0c, 0x7a, 0x1b,
-		0x66, 0xa8, 0x4d, 0x0e, 0x2d, 0xda, 0x0b, 0xc4, 0xce, 0xcc, 0xd5, 0xd6, 0x54, 0x88, 0x30, 0x91,
-		0xf7, 0x7a, 0x01, 0x36, 0xa7, 0xa9, 0xfc, 0x8b, 0x39, 0x2f, 0x67, 0x77, 0x1d, 0x0e, 0x67, 0xd9,
-		0x9c, 0x62, 0x9f, 0xbb, 0x0e, 0xaa, 0xc3, 0x89, 0x38, 0xea, 0x5b, 0x2c, 0xcf, 0x70, 0x60, 0x2a,
-		0x35, 0xbd, 0x2a, 0x72, 0x4c, 0x35, 0xca, 0x31, 0xd5, 0x7b, 0x51, 0x12, 0x32, 0xe7, 0x62, 0x16,
-		0x36, 0xc8, 0xe2, 0x96, 0xcc, 0x08, 0x96, 0x67, 0xb7, 0xb1, 0x84, 0xac, 0x22, 0xc7, 0x3e, 0xb0,
-		0xdb, 0x98, 0xc1, 0x90, 0x5c, 0xaf, 0x84, 0xe1, 0x97, 0x1c, 0x06, 0x82, 0xe9, 0x1d, 0x96, 0x04,
-		0x46, 0x80, 0x21, 0xab, 0xa9, 0x94, 0xd3, 0x94, 0x46, 0xaa, 0x3c, 0x2e, 0x52, 0xc2, 0xd0, 0xd8,
-		0x22, 0x69, 0xe8, 0xaf, 0x35, 0x58, 0x88, 0x5c, 0xff, 0xab, 0x63, 0xeb, 0x2d, 0x38, 0x9d, 0x31,
-		0x4a, 0x9e, 0xc4, 0xd7, 0x61, 0x29, 0x08, 0xfd, 0x26, 0x26, 0xc4, 0xf5, 0xf6, 0x2d, 0x9e, 0x61,
-		0x45, 0xe4, 0x67, 0x07, 0xb2, 0xcc, 0xdc, 0x3e, 0x9e, 0xe6, 0x9c, 0x3c, 0xec, 0x13, 0xe3, 0xdf,
-		0x25, 0xb8, 0xb8, 0x83, 0x69, 0x3e, 0x79, 0xd9, 0x0f, 0xe5, 0x81, 0xbf, 0x5f, 0x7b, 0x32, 0xc9,
-		0x15, 0xbd, 0x0f, 0x15, 0x42, 0xed, 0x90, 0x5a, 0xb8, 0x8b, 0x3d, 0x2a, 0x83, 0xc2, 0x0b, 0x2a,
-		0xb0, 0xee, 0xe3, 0x90, 0xb0, 0xcc, 0x20, 0x8c, 0xde, 0xa5, 0xb8, 0x6d, 0x02, 0x67, 0xbf, 0xca,
-		0xb8, 0xd1, 0x0e, 0xcc, 0x60, 0xcf, 0x91, 0xa2, 0x26, 0xc6, 0x16, 0x35, 0x8d, 0x3d, 0x47, 0x08,
-		0x4a, 0x65, 0x8c, 0xc9, 0x4c, 0xc6, 0x78, 0x0e, 0x4e, 0x78, 0xf8, 0x63, 0x6a, 0x71, 0x0a, 0xea,
-		0x1f, 0x62, 0x6f, 0x79, 0x6a, 0x4d, 0x5b, 0x3f, 0x6e, 0xce, 0xb2, 0xe1, 0xdb, 0xf6, 0x3e, 0xbe,
-		0xc7, 0x06, 0x8d, 0x2f, 0x35, 0x58, 0x1f, 0x8e, 0xba, 0xdc, 0xda, 0x02, 0xa1, 0x5a, 0x81, 0x50,
-		0x74, 0x0d, 0x4e, 0x44, 0xb5, 0x44, 0xc3, 0xa6, 0xcd, 0x03, 0x1c, 0xa5, 0x93, 0xb3, 0x85, 0x7b,
-		0xc0, 0x12, 0xfe, 0x95, 0x96, 0xdf, 0x30, 0xe7, 0x24, 0xd7, 0x15, 0xc1, 0x84, 0x6e, 0xc1, 0x89,
-		0xae, 0x40, 0xc0, 0x92, 0x33, 0xc5, 0xc9, 0x59, 0x05, 0x98, 0x39, 0xd7, 0x4d, 0x7d, 0x1b, 0x8f,
-		0x34, 0x38, 0xbb, 0x83, 0xa9, 0x19, 0x97, 0x74, 0x37, 0x31, 0x21, 0xf6, 0x3e, 0x26, 0x91, 0x67,
-		0xbd, 0x0b, 0x53, 0x7c, 0x61, 0xc2, 0x59, 0x2b, 0xb5, 0x75, 0x95, 0xa6, 0x84, 0x0c, 0xbe, 0x68,
-		0x53, 0xf2, 0x8d, 0x70, 0xf4, 0x8c, 0xcf, 0x4a, 0x70, 0x4e, 0x65, 0x86, 0x84, 0xda, 0x87, 0x39,
-		0x71, 0xb6, 0xdb, 0x72, 0x46, 0xda, 0x73, 0x5d, 0x91, 0x90, 0x07, 0x8b, 0x13, 0xd9, 0x38, 0x1a,
-		0x15, 0x49, 0x79, 0x96, 0x24, 0xc7, 0xf4, 0x36, 0xa0, 0x3c, 0x51, 0x41, 0x8a, 0xde, 0x4a, 0xa6,
-		0xe8, 0x4a, 0xed, 0xc5, 0x11, 0xf0, 0xe9, 0x5b, 0x93, 0xc8, 0xe7, 0x1e, 0xac, 0xed, 0x60, 0xba,
-		0x7d, 0xe3, 0xce, 0x80, 0xbd, 0x78, 0x0f, 0x40, 0x24, 0x0e, 0x6f, 0xcf, 0x8f, 0xd6, 0x3f, 0x8a,
-		0x3e, 0x16, 0xad, 0x78, 0x3a, 0xe6, 0x01, 0x8e, 0xfd, 0x45, 0x8c, 0x1e, 0x9c, 0x1f, 0xa0, 0x4f,
-		0x82, 0x7e, 0x0f, 0xe6, 0x13, 0xd5, 0xbe, 0xc5, 0xb8, 0x23, 0xbd, 0x17, 0x47, 0xd4, 0x6b, 0x9e,
-		0x0c, 0xd3, 0x03, 0xc4, 0xf8, 0x8f, 0x06, 0xcf, 0x30, 0xdd, 0x3c, 0x44, 0x0d, 0x58, 0xee, 0x7d,
-		0x58, 0x69, 0xd9, 0x84, 0x5a, 0x21, 0xa6, 0xa1, 0x8b, 0xbb, 0xb8, 0xbf, 0xf7, 0x51, 0x7c, 0xaf,
-		0xd4, 0x56, 0x73, 0x89, 0x71, 0xd7, 0xa3, 0xaf, 0xbf, 0x7a, 0x9f, 0xc1, 0x6a, 0x2e, 0x32, 0x6e,
-		0x33, 0x62, 0x96, 0xd2, 0x77, 0x9d, 0xbe, 0x5c, 0x19, 0x76, 0xd3, 0x72, 0x4b, 0x23, 0xca, 0xbd,
-		0x1d, 0x31, 0xc7, 0x72, 0xb3, 0x8e, 0x5e, 0xce, 0x3b, 0xba, 0x0f, 0xcf, 0x0e, 0x5e, 0xb9, 0x04,
-		0x7e, 0x07, 0xa6, 0x13, 0x7e, 0x3e, 0xb6, 0x5f, 0xf5, 0x99, 0x8d, 0xbf, 0x68, 0xb0, 0x60, 0x62,
-		0x3b, 0x08, 0x5a, 0x3d, 0x1e, 0x24, 0xc9, 0x13, 0xca, 0x18, 0xaf, 0xc1, 0x14, 0x0f, 0xf0, 0x44,
-		0x06, 0xac, 0x21, 0x81, 0x4f, 0x12, 0x1b, 0x4b, 0x70, 0x3a, 0x63, 0xbd, 0xac, 0x01, 0x7e, 0x5b,
-		0x82, 0x95, 0x2d, 0xc7, 0xb9, 0x8b, 0xed, 0xb0, 0x79, 0xb0, 0x45, 0x45, 0xb9, 0xdd, 0x2f, 0x04,
-		0x02, 0x38, 0x49, 0xf8, 0x8c, 0x65, 0x47, 0x53, 0xd2, 0x6d, 0xaf, 0x2a, 0xc2, 0x85, 0x52, 0x56,
-		0x35, 0x33, 0x2c, 0x62, 0xc5, 0x09, 0x92, 0x1e, 0x45, 0x17, 0x60, 0x8e, 0xe0, 0x66, 0x27, 0xe4,
-		0x85, 0x1b, 0x4f, 0x04, 0x22, 0xcc, 0xcd, 0x46, 0xa3, 0x3c, 0x26, 0xea, 0x2e, 0x2c, 0x14, 0xc9,
-		0x4b, 0x86, 0x95, 0x19, 0x11, 0x56, 0x2e, 0x27, 0xc3, 0xca, 0x5c, 0xed, 0x42, 0x21, 0x5e, 0xbb,
-		0x9e, 0x83, 0x3f, 0xc6, 0x0e, 0x77, 0x4b, 0x5e, 0x8e, 0x24, 0x02, 0xca, 0x19, 0xd0, 0x8b, 0x16,
-		0x25, 0xf1, 0x5b, 0x86, 0xc5, 0xa8, 0x5a, 0xa9, 0x0b, 0xff, 0x94, 0xeb, 0x35, 0xfe, 0x5c, 0x86,
-		0xa5, 0xdc, 0x94, 0x74, 0xcb, 0x03, 0x58, 0x21, 0x9d, 0x20, 0xf0, 0x43, 0x8a, 0x1d, 0xab, 0xd9,
-		0x72, 0xb1, 0x47, 0x2d, 0x99, 0x51, 0x22, 0x3f, 0x7d, 0xa9, 0xd0, 0xd0, 0xbb, 0x11, 0x57, 0x9d,
-		0x33, 0xc9, 0xac, 0x44, 0xcc, 0x25, 0x52, 0x3c, 0xc1, 0x32, 0x5d, 0x1b, 0xb3, 0x6b, 0x0a, 0x39,
-		0x70, 0x03, 0x1e, 0xf0, 0x8a, 0x7d, 0x30, 0x3e, 0x07, 0x37, 0xfb, 0xe4, 0x3c, 0xd4, 0xcd, 0xb5,
-		0x53, 0xdf, 0xc8, 0x83, 0x93, 0x01, 0x13, 0x4e, 0x28, 0xe3, 0x13, 0x12, 0xcb, 0xdc, 0x25, 0xea,
-		0x43, 0xae, 0x74, 0x19, 0x10, 0xaa, 0xb7, 0x63, 0x31, 0x4c, 0xb2, 0x74, 0x88, 0x20, 0x3d, 0xaa,
-		0x1f, 0xc2, 0x42, 0x11, 0x61, 0xc1, 0x4e, 0xbf, 0x9d, 0x4e, 0x20, 0xca, 0xc0, 0x9a, 0x11, 0x97,
-		0xdc, 0xeb, 0x3f, 0x94, 0x60, 0xd1, 0xc4, 0xb6, 0xb3, 0x7d, 0xe3, 0x4e, 0x36, 0x88, 0x6e, 0xc2,
-		0x04, 0x2f, 0x68, 0x35, 0xee, 0x46, 0x4f, 0x2b, 0x2f, 0x6e, 0x37, 0xee, 0x70, 0x07, 0xe2, 0xc4,
-		0xa9, 0x42, 0xba, 0x94, 0x2e, 0xa4, 0x99, 0xa3, 0xfb, 0x9d, 0xb0, 0x89, 0x2d, 0x19, 0xd7, 0x64,
-		0x98, 0x9b, 0x15, 0xa3, 0x12, 0x2c, 0x74, 0x0f, 0x96, 0x5d, 0x8f, 0x51, 0xb8, 0x5d, 0x6c, 0xb1,
-		0xf2, 0x2e, 0x11, 0x62, 0x27, 0x86, 0x87, 0xd8, 0xd3, 0x7d, 0xe6, 0xab, 0x5e, 0x22, 0xc2, 0x3e,
-		0x96, 0x0a, 0xef, 0x4f, 0x25, 0x58, 0xca, 0x81, 0x25, 0x1d, 0xfc, 0x48, 0x68, 0x15, 0x66, 0xc9,
-		0xd2, 0xff, 0x98, 0x25, 0x91, 0x0d, 0x8b, 0x39, 0xa9, 0x49, 0xb7, 0x1d, 0x2b, 0xf1, 0x2f, 0x64,
-		0xc5, 0xf3, 0x33, 0x51, 0x80, 0xd8, 0x44, 0x11, 0x62, 0x5f, 0x68, 0xb0, 0x74, 0xbb, 0x13, 0xee,
-		0xe3, 0xaf, 0xb9, 0x7f, 0x19, 0x3a, 0x2c, 0xe7, 0xd7, 0x29, 0x23, 0xe6, 0x1f, 0x4b, 0xb0, 0x74,
-		0x13, 0x7f, 0xfd, 0x41, 0x78, 0x3c, 0x87, 0xec, 0x0a, 0x2c, 0xe7, 0xc1, 0x1a, 0xef, 0xd6, 0x64,
-		0xfc, 0x54, 0x83, 0x55, 0x13, 0xef, 0x85, 0x98, 0x1c, 0x44, 0x35, 0x06, 0xf7, 0xdd, 0x27, 0xd4,
-		0x51, 0x3e, 0x07, 0x67, 0x8a, 0xad, 0x91, 0x0e, 0xf2, 0xb7, 0x12, 0x9c, 0x35, 0x31, 0xc1, 0x9e,
-		0x93, 0x39, 0x81, 0x24, 0xd1, 0xd2, 0x94, 0xcd, 0x34, 0x59, 0xc0, 0xce, 0x98, 0xd3, 0x62, 0x60,
-		0xd7, 0xf9, 0x7f, 0x15, 0x5e, 0x17, 0x60, 0x2e, 0xc4, 0x6d, 0x9f, 0xe6, 0x5c, 0x49, 0x8c, 0x46,
-		0xae, 0x94, 0xb9, 0xd1, 0x4f, 0x3c, 0xbe, 0x1b, 0xfd, 0xe4, 0xd1, 0x6f, 0xf4, 0xc6, 0x1a, 0x9c,
-		0x53, 0x21, 0x2a, 0x41, 0xb7, 0x61, 0x75, 0x07, 0xd3, 0x7a, 0xe8, 0x13, 0x22, 0x97, 0x92, 0x45,
-		0x3c, 0xee, 0x6d, 0x6a, 0x99, 0xde, 0xe6, 0x05, 0x98, 0xa3, 0x76, 0xb8, 0x8f, 0x69, 0x1f, 0x1a,
-		0x59, 0xb3, 0x89, 0x51, 0x29, 0xcf, 0xf8, 0x57, 0x19, 0xce, 0x14, 0xeb, 0x90, 0xfe, 0x7c, 0xc8,
-		0xe4, 0xb0, 0xe8, 0xdc, 0xe8, 0x89, 0x4e, 0xeb, 0x90, 0x5a, 0x73, 0x90, 0x30, 0xde, 0x59, 0x22,
-		0x57, 0x7a, 0xfc, 0xea, 0x29, 0x4a, 0x8b, 0xe3, 0x34, 0x31, 0x84, 0x7e, 0x00, 0xa7, 0xf7, 0x6c,
-		0xb7, 0xc5, 0xea, 0x2f, 0xbb, 0x43, 0x70, 0xac, 0x53, 0x24, 0x9c, 0xf7, 0x8f, 0xa2, 0xf3, 0x1a,
-		0x17, 0x58, 0x67, 0xf2, 0x52, 0x9a, 0xd1, 0x5e, 0x6e, 0x42, 0x7f, 0x00, 0xf3, 0x39, 0x13, 0x0b,
-		0x6e, 0xc5, 0xd7, 0xd2, 0x45, 0xcd, 0x2b, 0xaa, 0xed, 0xcf, 0x1a, 0x25, 0x37, 0x2e, 0x79, 0x35,
-		0xd6, 0x1f, 0xc0, 0x92, 0xc2, 0xc2, 0x02, 0xc5, 0xef, 0xa6, 0xeb, 0x66, 0xa5, 0xdf, 0xed, 0x60,
-		0xca, 0xf4, 0x25, 0x04, 0x27, 0x0b, 0xaa, 0x2f, 0x35, 0x58, 0x17, 0xf0, 0x38, 0x39, 0xd8, 0xea,
-		0x7e, 0x3b, 0x68, 0x61, 0x8a, 0x47, 0x68, 0x38, 0x8f, 0xe8, 0x62, 0xe8, 0x23, 0xe1, 0x41, 0x56,
-		0x28, 0x77, 0x84, 0xc8, 0x1c, 0x3f, 0x06, 0x6c, 0x82, 0x91, 0x09, 0x8e, 0xbf, 0x08, 0x7a, 0x16,
-		0x66, 0xf7, 0x30, 0x6d, 0x1e, 0x7c, 0x80, 0x45, 0xb0, 0xe2, 0x07, 0x7b, 0xda, 0x4c, 0x0f, 0x1a,
-		0x04, 0x9e, 0x1f, 0x61, 0xb1, 0xd2, 0xdb, 0xaf, 0xc1, 0x64, 0xd4, 0x07, 0x38, 0xe2, 0xce, 0x72,
-		0x76, 0xe3, 0x33, 0x0d, 0x96, 0xd8, 0x5d, 0xb8, 0xe7, 0xd9, 0x6d, 0xb7, 0x59, 0xf7, 0xbd, 0x3d,
-		0x77, 0x3f, 0x42, 0xf4, 0x69, 0xa8, 0x34, 0xf9, 0x80, 0xb8, 0x48, 0x8b, 0x50, 0x09, 0x62, 0x88,
-		0xf7, 0x6a, 0xb7, 0xe1, 0xd8, 0x9e, 0xdb, 0xa2, 0x38, 0x8c, 0x0a, 0xad, 0x17, 0x54, 0x45, 0x7c,
-		0x52, 0xfc, 0x35, 0xce, 0x62, 0x46, 0xac, 0xc6, 0x2d, 0x58, 0xce, 0x5b, 0xd0, 0xaf, 0x04, 0xa5,
-		0x1f, 0x69, 0xa3, 0xdc, 0x57, 0x05, 0xad, 0xf1, 0x33, 0x0d, 0xf4, 0x0f, 0x03, 0xc7, 0xa6, 0xf8,
-		0x68, 0xcb, 0xfa, 0x00, 0x66, 0x25, 0x01, 0x97, 0x17, 0x2d, 0xee, 0xf9, 0x51, 0x16, 0x27, 0x72,
-		0xfa, 0xf1, 0x66, 0xfc, 0x41, 0x8c, 0xb3, 0xb0, 0x5a, 0x68, 0x8e, 0x0c, 0x9e, 0x8f, 0x78, 0x82,
-		0x65, 0x81, 0x17, 0x3f, 0xc9, 0x6d, 0xe0, 0x89, 0xb5, 0xc8, 0x0a, 0x69, 0xe6, 0x4f, 0x34, 0x76,
-		0x95, 0x6d, 0xbb, 0xde, 0x36, 0x66, 0xae, 0x18, 0xa5, 0xbd, 0x27, 0x54, 0x06, 0xfc, 0x5e, 0x83,
-		0xd5, 0x42, 0x6b, 0xa4, 0xe3, 0x5c, 0x8c, 0x7b, 0xbd, 0x0e, 0xa7, 0x10, 0x41, 0x61, 0xba, 0xdf,
-		0xcc, 0x15, 0x7c, 0x0e, 0x7a, 0x19, 0x50, 0xdf, 0x2c, 0xd2, 0xa7, 0x2d, 0x71, 0xda, 0xf9, 0x78,
-		0x26, 0x41, 0x9e, 0x78, 0x1c, 0x8a, 0xc8, 0xcb, 0x82, 0x3c, 0x9e, 0x91, 0xe4, 0xcc, 0x15, 0xcf,
-		0x70, 0x33, 0x6f, 0xda, 0xae, 0x47, 0x6d, 0xd7, 0x7b, 0xc2, 0xb0, 0x7d, 0xae, 0xc1, 0x59, 0x85,
-		0x3d, 0x5f, 0x2d, 0xe0, 0x2e, 0xc3, 0xf2, 0x0d, 0x97, 0x1c, 0x2d, 0x2e, 0x19, 0xdf, 0x83, 0x95,
-		0x02, 0x66, 0xb9, 0xc0, 0x3a, 0x1c, 0xc3, 0x1e, 0x0d, 0xdd, 0x7e, 0xef, 0x7a, 0xa4, 0x73, 0x2d,
-		0x52, 0x71, 0xc4, 0x69, 0x1c, 0x02, 0xca, 0x4f, 0x23, 0x04, 0x13, 0x09, 0x8b, 0xf8, 0xdf, 0x68,
-		0x0b, 0xa6, 0x64, 0x14, 0x29, 0x8f, 0x1b, 0x45, 0x24, 0xa3, 0xf1, 0x0b, 0x2d, 0xa3, 0x8d, 0x4f,
-		0x1f, 0x29, 0x36, 0x3e, 0xa6, 0x58, 0xf1, 0x5d, 0x38, 0x55, 0x30, 0x5f, 0xb8, 0xfe, 0xcd, 0x74,
-		0x09, 0x32, 0x92, 0x95, 0xb5, 0x7f, 0xe8, 0x30, 0xcd, 0xdd, 0x74, 0xeb, 0xf6, 0x2e, 0xfa, 0xb9,
-		0x06, 0x2b, 0xca, 0xdf, 0x90, 0xa0, 0x6f, 0x0c, 0xe9, 0x1b, 0xa9, 0x7e, 0x09, 0xa3, 0xbf, 0x31,
-		0x3e, 0xa3, 0xf4, 0xa0, 0x4f, 0xe1, 0x54, 0xc1, 0x9b, 0x3f, 0xba, 0x34, 0x44, 0x60, 0xfe, 0xb7,
-		0x22, 0x7a, 0x6d, 0x1c, 0x16, 0xa9, 0x3d, 0x09, 0x47, 0xee, 0x77, 0x0e, 0x43, 0xe1, 0x50, 0xfd,
-		0xd0, 0x63, 0x28, 0x1c, 0xea, 0x9f, 0x71, 0xd8, 0x00, 0xf1, 0x73, 0x3e, 0x5a, 0x57, 0xc8, 0xc9,
-		0xfd, 0x42, 0x40, 0x7f, 0x7e, 0x04, 0xca, 0x58, 0x45, 0xfc, 0x54, 0xae, 0x54, 0x91, 0xfb, 0xf5,
-		0x80, 0x52, 0x45, 0xfe, 0xdd, 0x5d, 0xa8, 0x88, 0x1e, 0xb9, 0x07, 0xa8, 0xc8, 0xbc, 0xcc, 0x0f,
-		0x50, 0x91, 0x7d, 0x31, 0x47, 0xdf, 0x87, 0xd9, 0xd4, 0xdb, 0x34, 0x7a, 0x71, 0x08, 0xe6, 0x29,
-		0x45, 0x2f, 0x8d, 0x46, 0x2c, 0x75, 0xfd, 0x4e, 0xe3, 0x2f, 0x59, 0x03, 0x1f, 0x50, 0xd1, 0x37,
-		0xd5, 0xd7, 0x94, 0x51, 0xde, 0xbb, 0xf5, 0x77, 0x8e, 0xcc, 0x2f, 0xad, 0xfc, 0xb1, 0x06, 0x8b,
-		0xc5, 0x4f, 0x84, 0xe8, 0xd5, 0x31, 0x5f, 0x14, 0x85, 0x45, 0xaf, 0x1d, 0xe9, 0x1d, 0x92, 0x9f,
-		0x29, 0xe5, 0x3b, 0x9c, 0xf2, 0x4c, 0x0d, 0x7b, 0x29, 0x54, 0x9e, 0xa9, 0xe1, 0x4f, 0x7e, 0xbf,
-		0xd1, 0xf8, 0x6d, 0x57, 0xf9, 0x44, 0x85, 0xde, 0x1a, 0x20, 0x7a, 0xc8, 0x8b, 0x9e, 0x7e, 0xf9,
-		0x48, 0xbc, 0xb1, 0x13, 0xa7, 0xde, 0x82, 0x94, 0x4e, 0x5c, 0xf4, 0xde, 0xa5, 0x74, 0xe2, 0xc2,
-		0xe7, 0x25, 0xd4, 0x03, 0x94, 0x7f, 0x3c, 0x41, 0xaf, 0x8c, 0xfb, 0x78, 0xa4, 0x5f, 0x1a, 0x83,
-		0x43, 0xaa, 0x0e, 0xe0, 0x44, 0xe6, 0xe5, 0x01, 0xbd, 0x3c, 0xea, 0x0b, 0x85, 0x50, 0x5a, 0x1d,
-		0xef, 0x41, 0x83, 0x69, 0xcc, 0xf4, 0xc3, 0x95, 0x1a, 0x8b, 0x1f, 0x19, 0x94, 0x1a, 0x55, 0x6d,
-		0x76, 0x02, 0x27, 0xb3, 0x7d, 0x56, 0xa4, 0x92, 0xa1, 0x68, 0x3c, 0xeb, 0x1b, 0x23, 0xd3, 0xc7,
-		0x4a, 0xb3, 0x2d, 0x49, 0xa5, 0x52, 0x45, 0xa3, 0x57, 0xa9, 0x54, 0xd9, 0xeb, 0xfc, 0x21, 0x2c,
-		0x14, 0x35, 0x0d, 0x51, 0x4d, 0x89, 0x98, 0xb2, 0xdf, 0xa9, 0x6f, 0x8e, 0xc5, 0x93, 0x08, 0x74,
-		0xc5, 0x3d, 0x34, 0x65, 0xa0, 0x1b, 0xd8, 0xc4, 0x54, 0x06, 0xba, 0xc1, 0x8d, 0x3a, 0x06, 0x44,
-		0x51, 0x0f, 0x4a, 0x09, 0xc4, 0x80, 0xae, 0x9e, 0x12, 0x88, 0x81, 0x5d, 0xba, 0xcf, 0x35, 0x38,
-		0x3f, 0xb4, 0xcb, 0x81, 0xde, 0x51, 0xaf, 0x6e, 0xa4, 0x66, 0x90, 0xfe, 0xee, 0xd1, 0x05, 0xc4,
-		0x7e, 0x9a, 0xed, 0x4a, 0x28, 0xfd, 0x54, 0xd1, 0x40, 0x51, 0xfa, 0xa9, 0xb2, 0xdd, 0xf1, 0x29,
-		0x9c, 0x2a, 0xe8, 0x14, 0x28, 0x2b, 0x4b, 0x75, 0x93, 0x43, 0x59, 0x59, 0x0e, 0x68, 0x44, 0x88,
-		0x53, 0x92, 0xef, 0x00, 0x0c, 0x38, 0x25, 0xca, 0xa6, 0xc5, 0x80, 0x53, 0xa2, 0x6e, 0x31, 0xa0,
-		0x2e, 0xcc, 0xe7, 0xee, 0x6d, 0x48, 0x05, 0xa2, 0xea, 0x7a, 0xa8, 0xbf, 0x32, 0x3a, 0x83, 0xd4,
-		0xfb, 0x10, 0xe6, 0xd2, 0x6d, 0x04, 0xa4, 0xce, 0x18, 0xaa, 0x06, 0x88, 0x12, 0xf1, 0x41, 0x5d,
-		0x8a, 0x47, 0x1a, 0x2c, 0x45, 0x37, 0xf1, 0xba, 0x1f, 0x86, 0x9d, 0xa0, 0x5f, 0x38, 0xa1, 0xcd,
-		0x41, 0xf2, 0x14, 0xed, 0x04, 0xfd, 0xd5, 0xf1, 0x98, 0x84, 0x19, 0x57, 0x5e, 0xfb, 0xf6, 0xe6,
-		0xbe, 0x4b, 0x0f, 0x3a, 0x8d, 0x6a, 0xd3, 0x6f, 0x6f, 0xa4, 0xfe, 0xe1, 0xa0, 0xba, 0x8f, 0x3d,
-		0xf1, 0x3f, 0x15, 0xfd, 0x7f, 0xd8, 0xb8, 0xcc, 0xff, 0xe8, 0x5e, 0x6a, 0x4c, 0xf1, 0xf1, 0xcd,
-		0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x58, 0xdd, 0x9d, 0x67, 0xd8, 0x31, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdd, 0x1b, 0x4b, 0x6f, 0x1b, 0xc7,
+		0xb9, 0x4b, 0x4a, 0xb2, 0xf4, 0xd1, 0x92, 0xa5, 0x89, 0x1e, 0xd4, 0xca, 0xb2, 0xe5, 0xb5, 0x13,
+		0xcb, 0x4e, 0x42, 0xd9, 0x94, 0x9d, 0x26, 0x31, 0xd2, 0x58, 0xa2, 0xfc, 0x90, 0xe3, 0xe7, 0xca,
+		0xb1, 0x8b, 0xa2, 0x28, 0xbb, 0x22, 0x47, 0xd2, 0x56, 0xe4, 0x2e, 0xb3, 0xbb, 0x94, 0xc2, 0xa2,
+		0x68, 0x83, 0xc0, 0x45, 0x0f, 0x7d, 0x06, 0x3d, 0xf4, 0xd8, 0x43, 0x8b, 0x1e, 0xda, 0x43, 0xd1,
+		0x7b, 0xcf, 0x3d, 0xb7, 0xbf, 0xa1, 0x40, 0x2e, 0x05, 0x0a, 0x14, 0xbd, 0xf4, 0xd8, 0x79, 0x2d,
+		0xf7, 0x39, 0x4b, 0x52, 0x75, 0xa1, 0x20, 0x07, 0x01, 0xdc, 0x99, 0xef, 0x3d, 0xdf, 0x7c, 0xdf,
+		0x37, 0xdf, 0x8c, 0xe0, 0x7c, 0x7b, 0x1b, 0x3b, 0x2b, 0x35, 0xa3, 0x8e, 0xad, 0x1a, 0x5e, 0x31,
+		0xea, 0x4d, 0xd3, 0x5a, 0x39, 0xb8, 0xba, 0xe2, 0x62, 0xe7, 0xc0, 0xac, 0xe1, 0x52, 0xcb, 0xb1,
+		0x3d, 0x1b, 0xcd, 0x50, 0xa0, 0x92, 0x00, 0x2a, 0x31, 0xa0, 0xd2, 0xc1, 0x55, 0xf5, 0xec, 0xae,
+		0x6d, 0xef, 0x36, 0xf0, 0x0a, 0x03, 0xda, 0x6e, 0xef, 0xac, 0x78, 0x66, 0x13, 0xbb, 0x9e, 0xd1,
+		0x6c, 0x71, 0x3c, 0xf5, 0x4c, 0x1c, 0xe0, 0xd0, 0x31, 0x5a, 0x2d, 0xec, 0xb8, 0x62, 0x7e, 0x29,
+		0xca, 0xbc, 0x65, 0x52, 0xd6, 0x35, 0xbb, 0xd9, 0xb4, 0x2d, 0x01, 0x71, 0x21, 0x0d, 0xe2, 0xc0,
+		0x74, 0xcd, 0x6d, 0xb3, 0x61, 0x7a, 0x1d, 0x01, 0xa5, 0xa5, 0x41, 0x1d, 0xda, 0xce, 0xfe, 0x4e,
+		0xc3, 0x3e, 0x4c, 0xa5, 0xe4, 0xee, 0x19, 0x0e, 0xae, 0x33, 0x76, 0x8d, 0xb6, 0xeb, 0x11, 0xed,
+		0xb2, 0xa1, 0xf6, 0x4c, 0xd7, 0xb3, 0x9d, 0x74, 0x7e, 0x01, 0xd4, 0x47, 0x6d, 0xdc, 0x16, 0x36,
+		0x53, 0x97, 0x25, 0x30, 0x0e, 0x6e, 0x35, 0xcc, 0x9a, 0xe1, 0x99, 0xbe, 0x8e, 0xda, 0x67, 0x0a,
+		0x2c, 0x6d, 0x60, 0xb7, 0xe6, 0x98, 0xdb, 0xf8, 0xb9, 0x10, 0xfa, 0xd6, 0xc7, 0xb8, 0xd6, 0xa6,
+		0x30, 0x3a, 0x26, 0x44, 0x5d, 0x0f, 0xcd, 0xc2, 0x48, 0xdd, 0x6e, 0x1a, 0xa6, 0x55, 0x54, 0x96,
+		0x94, 0xe5, 0x31, 0x5d, 0x7c, 0xa1, 0x0f, 0x01, 0xf9, 0x8a, 0x56, 0xb1, 0x8f, 0x54, 0xcc, 0x11,
+		0x98, 0x42, 0xf9, 0xb5, 0x52, 0x74, 0xdd, 0x5a, 0x26, 0x59, 0xb5, 0x52, 0x92, 0xc5, 0xd4, 0x61,
+		0x7c, 0x48, 0xfb, 0x9b, 0x02, 0xe7, 0x32, 0x64, 0x72, 0x5b, 0xb6, 0xe5, 0x62, 0x34, 0x0f, 0xa3,
+		0x54, 0xb1, 0x7a, 0xd5, 0xac, 0x33, 0xb1, 0x86, 0xf5, 0x13, 0xec, 0x7b, 0xb3, 0x8e, 0xce, 0xc1,
+		0x49, 0x61, 0xb3, 0xaa, 0x51, 0xaf, 0x3b, 0x4c, 0xa2, 0x31, 0xbd, 0x20, 0xc6, 0xd6, 0xc8, 0x10,
+		0x5a, 0x85, 0xd9, 0x66, 0xdb, 0x33, 0xb6, 0x1b, 0xb8, 0x4a, 0x9c, 0xc6, 0xc3, 0x55, 0xd3, 0xaa,
+		0xd6, 0x8c, 0xda, 0x1e, 0x2e, 0xe6, 0x19, 0xf0, 0x2b, 0x62, 0x76, 0x8b, 0x4e, 0x6e, 0x5a, 0x15,
+		0x3a, 0x85, 0xde, 0x81, 0xf9, 0x04, 0x52, 0xdd, 0x20, 0x03, 0x86, 0x8b, 0x8b, 0x43, 0x0c, 0x6f,
+		0x36, 0x8a, 0xb7, 0x21, 0x66, 0xb5, 0xbf, 0x28, 0xa0, 0xfa, 0x3a, 0xdd, 0xe5, 0x72, 0xdc, 0xb5,
+		0x5d, 0xcf, 0xb7, 0xf0, 0x79, 0x22, 0x31, 0xf9, 0x64, 0xe2, 0x62, 0xd7, 0xe5, 0x76, 0xbe, 0xfb,
+		0x15, 0x22, 0x33, 0x19, 0x5d, 0xe3, 0x83, 0x68, 0x21, 0xa4, 0x31, 0x55, 0x69, 0x98, 0x00, 0x74,
+		0x75, 0x7e, 0x9e, 0xba, 0x16, 0xf9, 0x41, 0xd6, 0x82, 0x90, 0x4b, 0xae, 0xc6, 0xfa, 0x38, 0x14,
+		0xea, 0x42, 0xf0, 0xea, 0x76, 0x47, 0xfb, 0x7a, 0xe0, 0x2f, 0x5b, 0x94, 0xf5, 0x06, 0x51, 0x86,
+		0x7c, 0x44, 0xfc, 0x65, 0x01, 0xc6, 0x5a, 0xc6, 0x2e, 0x31, 0x92, 0xf9, 0x5d, 0x2c, 0xd6, 0x66,
+		0x94, 0x0e, 0x6c, 0x91, 0x6f, 0x34, 0x07, 0x27, 0xd8, 0xa4, 0xaf, 0x84, 0x3e, 0x42, 0x3f, 0x37,
+		0xeb, 0xda, 0xe7, 0xa1, 0x65, 0x4f, 0x21, 0x2d, 0x96, 0x7d, 0x19, 0x26, 0xad, 0x76, 0x93, 0xa8,
+		0x53, 0xb5, 0x77, 0xaa, 0x4c, 0x79, 0x57, 0xb0, 0x98, 0xe0, 0xe3, 0x8f, 0x76, 0x18, 0xb2, 0x8b,
+		0xbe, 0x09, 0x23, 0x62, 0x3e, 0xb7, 0x94, 0x27, 0x56, 0xd8, 0x28, 0xa5, 0x46, 0x92, 0x52, 0x4f,
+		0x9e, 0x25, 0x4e, 0xf0, 0x96, 0xe5, 0x39, 0x1d, 0x5d, 0xd0, 0x54, 0xdf, 0x81, 0x42, 0x68, 0x18,
+		0x4d, 0x42, 0x7e, 0x1f, 0x77, 0x84, 0x24, 0xf4, 0x27, 0x9a, 0x86, 0xe1, 0x03, 0xa3, 0xd1, 0xc6,
+		0xc2, 0xfb, 0xf8, 0xc7, 0xbb, 0xb9, 0xb7, 0x15, 0xed, 0xd3, 0x1c, 0x2c, 0xa4, 0xfa, 0xc2, 0xc0,
+		0x2a, 0x12, 0x43, 0xfb, 0x1e, 0xc1, 0xb5, 0x24, 0x86, 0x16, 0x0e, 0xe1, 0xa2, 0x7b, 0x70, 0x92,
+		0xef, 0xd3, 0x90, 0x63, 0x17, 0xca, 0x17, 0xa3, 0x56, 0xe0, 0xb1, 0x81, 0x99, 0x81, 0xc1, 0x32,
+		0x47, 0xdf, 0xb4, 0x76, 0x6c, 0xbd, 0x50, 0x0f, 0x06, 0xd0, 0x5b, 0x30, 0xc7, 0x19, 0xd5, 0x6c,
+		0xa2, 0xae, 0xdd, 0x68, 0x10, 0xe1, 0xe8, 0x16, 0x68, 0xbb, 0xc2, 0xef, 0x67, 0xd8, 0x74, 0xa5,
+		0x3b, 0xbb, 0xc5, 0x26, 0x51, 0x11, 0x4e, 0xf8, 0x2e, 0x3d, 0xcc, 0xe0, 0xfc, 0x4f, 0xad, 0x04,
+		0x53, 0x95, 0x86, 0xed, 0x72, 0xab, 0xfb, 0x8e, 0x23, 0xdf, 0xd3, 0xda, 0x34, 0xa0, 0x30, 0x3c,
+		0x37, 0x95, 0xf6, 0x4f, 0x05, 0xa6, 0x74, 0xdc, 0xb4, 0x0f, 0xf0, 0x53, 0xc3, 0xdd, 0xef, 0x4d,
+		0x06, 0xbd, 0x07, 0x63, 0x1e, 0x81, 0xac, 0x7a, 0x9d, 0x16, 0x5f, 0x99, 0x89, 0xf2, 0x92, 0xcc,
+		0x22, 0x94, 0xe4, 0x53, 0x02, 0xa7, 0x8f, 0x7a, 0xe2, 0x17, 0x75, 0x5e, 0x86, 0x4e, 0x08, 0x53,
+		0x73, 0xe6, 0xf5, 0x11, 0xfa, 0x49, 0xe8, 0x56, 0xe0, 0x54, 0x90, 0x19, 0xaa, 0x34, 0x17, 0x31,
+		0xc3, 0x14, 0xca, 0x6a, 0x89, 0xe7, 0xa1, 0x92, 0x9f, 0x87, 0x4a, 0x4f, 0xfd, 0x44, 0xa5, 0x4f,
+		0x04, 0x28, 0x74, 0x90, 0xc6, 0x2d, 0x91, 0x11, 0xaa, 0x96, 0x41, 0x28, 0x70, 0x93, 0x15, 0xc4,
+		0xd8, 0x43, 0x32, 0x44, 0xcd, 0x10, 0xd6, 0x57, 0x98, 0xe1, 0x17, 0xcc, 0x0c, 0x2e, 0xf6, 0x9e,
+		0xd0, 0x24, 0xd0, 0x87, 0x19, 0xe2, 0x9c, 0x72, 0x09, 0x4e, 0x51, 0x4b, 0xe5, 0x07, 0xb5, 0x14,
+		0x17, 0x34, 0x90, 0x48, 0x08, 0xfa, 0x4b, 0x05, 0xa6, 0x7d, 0xd7, 0xff, 0xe2, 0xc8, 0xfa, 0x08,
+		0x66, 0x62, 0x42, 0x89, 0x9d, 0x48, 0xdc, 0x9e, 0x2c, 0x5b, 0x8d, 0xf8, 0xab, 0x69, 0xed, 0x56,
+		0x59, 0x86, 0xe5, 0x91, 0x9f, 0x6e, 0xc8, 0x3c, 0x75, 0xfb, 0x60, 0x9a, 0x61, 0xb2, 0xb0, 0xef,
+		0x6a, 0xff, 0xce, 0xc1, 0xc5, 0x3b, 0xd8, 0x4b, 0x26, 0x2f, 0xe3, 0x50, 0x6c, 0xf8, 0x67, 0xe5,
+		0xe3, 0x49, 0xae, 0xe8, 0x03, 0x28, 0x10, 0x0d, 0x1c, 0xaf, 0x8a, 0x0f, 0xb0, 0xe5, 0x89, 0xa0,
+		0x70, 0x59, 0x66, 0xac, 0x67, 0xa4, 0x5e, 0xa2, 0x99, 0x81, 0x0b, 0xbd, 0xe9, 0xe1, 0xa6, 0x0e,
+		0x0c, 0xfd, 0x16, 0xc5, 0x46, 0x77, 0x60, 0x0c, 0x5b, 0x75, 0x41, 0x6a, 0x68, 0x60, 0x52, 0xa3,
+		0x04, 0x99, 0x13, 0x8a, 0x64, 0x8c, 0xe1, 0x58, 0xc6, 0x78, 0x0d, 0x4e, 0x59, 0xf8, 0x63, 0xaf,
+		0xca, 0x20, 0x3c, 0x7b, 0x1f, 0x5b, 0xc5, 0x11, 0x02, 0x72, 0x52, 0x1f, 0xa7, 0xc3, 0x8f, 0xc9,
+		0xe8, 0x53, 0x3a, 0xa8, 0xfd, 0x43, 0x81, 0xe5, 0xde, 0x56, 0x17, 0x4b, 0x9b, 0x42, 0x54, 0x49,
+		0x21, 0x8a, 0x6e, 0xc3, 0x29, 0xbf, 0x96, 0xd8, 0x36, 0x3c, 0x12, 0x0b, 0xfd, 0x74, 0xb2, 0x98,
+		0xba, 0x06, 0x34, 0xe1, 0xaf, 0x37, 0xec, 0x6d, 0x7d, 0x42, 0x60, 0xad, 0x73, 0x24, 0xf4, 0x88,
+		0x04, 0x08, 0x6e, 0x81, 0xaa, 0x98, 0x49, 0x4f, 0xce, 0x32, 0x83, 0x91, 0x60, 0x11, 0xf9, 0xd6,
+		0x5e, 0x28, 0xb0, 0x48, 0xb4, 0xd5, 0x83, 0x92, 0xee, 0x01, 0x71, 0x44, 0x22, 0xb7, 0xeb, 0x7b,
+		0xd6, 0x4d, 0x18, 0x61, 0x8a, 0x71, 0x67, 0x2d, 0x94, 0x97, 0x65, 0x9c, 0x42, 0x34, 0x98, 0xd2,
+		0xba, 0xc0, 0xeb, 0x63, 0xeb, 0x69, 0x9f, 0xe4, 0xe0, 0x8c, 0x4c, 0x0c, 0x61, 0x6a, 0x1b, 0x26,
+		0xf8, 0xde, 0x6e, 0x8a, 0x19, 0x21, 0xcf, 0x5d, 0x49, 0x42, 0xce, 0x26, 0xc7, 0xb3, 0xb1, 0x3f,
+		0xca, 0x93, 0xf2, 0xb8, 0x1b, 0x1e, 0x53, 0x9b, 0x80, 0x92, 0x40, 0x29, 0x29, 0x7a, 0x2d, 0x9c,
+		0xa2, 0x0b, 0xe5, 0xd7, 0xfb, 0xb0, 0x4f, 0x57, 0x9a, 0x50, 0x3e, 0xb7, 0x60, 0x89, 0x88, 0xbc,
+		0x71, 0xff, 0x49, 0xc6, 0x5a, 0xdc, 0x03, 0xe0, 0x89, 0x83, 0xa4, 0x56, 0x5f, 0xff, 0x7e, 0xf8,
+		0xd1, 0x68, 0xc5, 0xd2, 0x31, 0x0b, 0x70, 0xf4, 0x97, 0xab, 0x75, 0xe0, 0x5c, 0x06, 0x3f, 0x61,
+		0xf4, 0xa7, 0x30, 0x15, 0xaa, 0xf6, 0xab, 0x14, 0xdb, 0xe7, 0x7b, 0xb1, 0x4f, 0xbe, 0xfa, 0xa4,
+		0x13, 0x1d, 0x70, 0xb5, 0xff, 0x28, 0x70, 0x9e, 0xf2, 0x66, 0x21, 0x2a, 0x43, 0xdd, 0x67, 0x30,
+		0xdf, 0x30, 0x48, 0x3d, 0xeb, 0x60, 0x52, 0x4d, 0x91, 0xf0, 0xd0, 0x5d, 0x7b, 0x3f, 0xbe, 0x17,
+		0xca, 0x0b, 0x89, 0xc4, 0xb8, 0x69, 0x79, 0x6f, 0x5d, 0x7b, 0x46, 0xcd, 0xaa, 0xcf, 0x52, 0x6c,
+		0xdd, 0x47, 0x16, 0xd4, 0x49, 0x2e, 0xf0, 0xe9, 0x8a, 0xb0, 0x1b, 0xa5, 0x9b, 0xeb, 0x93, 0xee,
+		0x63, 0x1f, 0x39, 0xa0, 0x1b, 0x77, 0xf4, 0x7c, 0xd2, 0xd1, 0x6d, 0xb8, 0x90, 0xad, 0xb9, 0x30,
+		0xfc, 0x1d, 0x18, 0x0d, 0xf9, 0xf9, 0xc0, 0x7e, 0xd5, 0x45, 0xd6, 0xfe, 0x4c, 0x72, 0xa5, 0x8e,
+		0xc9, 0x99, 0xb5, 0xd1, 0x61, 0x41, 0xd2, 0x3d, 0xa6, 0x8c, 0x71, 0x1d, 0x46, 0x58, 0x80, 0x77,
+		0x45, 0xc0, 0xea, 0x11, 0xf8, 0x04, 0xb0, 0x36, 0x07, 0x33, 0x31, 0xe9, 0x45, 0x0d, 0xf0, 0xeb,
+		0x1c, 0xcc, 0x93, 0x23, 0xcd, 0x16, 0x36, 0x9c, 0xda, 0xde, 0x9a, 0xc7, 0xcb, 0xed, 0x6e, 0x21,
+		0xd0, 0x82, 0x49, 0x97, 0xcd, 0x54, 0x0d, 0x7f, 0x4a, 0xb8, 0xed, 0x2d, 0x49, 0xb8, 0x90, 0xd2,
+		0x2a, 0xc5, 0x86, 0x79, 0xac, 0x38, 0xe5, 0x46, 0x47, 0xd1, 0xab, 0x24, 0x3c, 0x11, 0x5d, 0x1d,
+		0x56, 0xb8, 0xb1, 0x44, 0xc0, 0xc3, 0xdc, 0xb8, 0x3f, 0xca, 0x62, 0xa2, 0x6a, 0xc2, 0x74, 0x1a,
+		0xbd, 0x70, 0x58, 0x19, 0xe3, 0x61, 0xe5, 0x46, 0x38, 0xac, 0x4c, 0x94, 0x5f, 0x4d, 0xb5, 0xd7,
+		0xa6, 0x55, 0x27, 0x6b, 0x54, 0x67, 0x6e, 0xc9, 0xca, 0x91, 0x50, 0x40, 0x39, 0x0d, 0x6a, 0x9a,
+		0x52, 0xc2, 0x7e, 0x45, 0x98, 0xf5, 0xab, 0x95, 0x0a, 0xf7, 0x4f, 0xa1, 0xaf, 0xf6, 0xa7, 0x3c,
+		0xcc, 0x25, 0xa6, 0x84, 0x5b, 0xee, 0xc1, 0xbc, 0xdb, 0x6e, 0xb5, 0x6c, 0xc7, 0x23, 0x9b, 0xa6,
+		0xd6, 0x30, 0xc9, 0x92, 0x54, 0x45, 0x46, 0xf1, 0xfd, 0xf4, 0x8d, 0x54, 0x41, 0xb7, 0x7c, 0xac,
+		0x0a, 0x43, 0x12, 0x59, 0xc9, 0xd5, 0xe7, 0xdc, 0xf4, 0x09, 0x9a, 0xe9, 0x9a, 0x98, 0x1e, 0x53,
+		0xdc, 0x3d, 0xb3, 0xc5, 0x02, 0x5e, 0xba, 0x0f, 0x06, 0xfb, 0xe0, 0x41, 0x17, 0x9c, 0x85, 0xba,
+		0x89, 0x66, 0xe4, 0x1b, 0x59, 0x30, 0x49, 0xfb, 0x36, 0x24, 0xef, 0x51, 0x3c, 0x4e, 0x31, 0xcf,
+		0x5c, 0xa2, 0xd2, 0xe3, 0x48, 0x17, 0x33, 0x42, 0xe9, 0x71, 0x40, 0x86, 0x52, 0x16, 0x0e, 0xd1,
+		0x8a, 0x8e, 0xaa, 0xfb, 0x30, 0x9d, 0x06, 0x98, 0xb2, 0xd2, 0xef, 0x45, 0x13, 0x88, 0x34, 0xb0,
+		0xc6, 0xc8, 0x85, 0xd7, 0xfa, 0xf7, 0x39, 0x98, 0x25, 0xfb, 0xa4, 0x4e, 0xc2, 0x79, 0x3c, 0x88,
+		0xae, 0xc2, 0x10, 0x2b, 0x68, 0x15, 0xe6, 0x46, 0x67, 0xa5, 0x07, 0xb7, 0xfb, 0x4f, 0x98, 0x03,
+		0x31, 0xe0, 0x48, 0x21, 0x9d, 0x8b, 0x16, 0xd2, 0xd4, 0xd1, 0xed, 0xb6, 0x43, 0x4c, 0x28, 0xe2,
+		0x9a, 0x08, 0x73, 0xe3, 0x7c, 0x54, 0x18, 0x8b, 0x64, 0x8e, 0xa2, 0x69, 0x51, 0x08, 0xf3, 0x00,
+		0x57, 0x69, 0x79, 0x17, 0x0a, 0xb1, 0x43, 0xbd, 0x43, 0xec, 0x4c, 0x17, 0xf9, 0x96, 0x15, 0x8a,
+		0xb0, 0x2f, 0xa5, 0xc2, 0xfb, 0x63, 0x0e, 0xe6, 0x12, 0xc6, 0x12, 0x0e, 0x7e, 0x24, 0x6b, 0xa5,
+		0x66, 0xc9, 0xdc, 0xff, 0x98, 0x25, 0x91, 0x01, 0xb3, 0x09, 0xaa, 0x61, 0xb7, 0x1d, 0x28, 0xf1,
+		0x4f, 0xc7, 0xc9, 0xb3, 0x3d, 0x91, 0x62, 0xb1, 0xa1, 0x34, 0x8b, 0x7d, 0xae, 0xc0, 0xdc, 0xe3,
+		0xb6, 0xb3, 0x8b, 0xbf, 0xe4, 0xfe, 0xa5, 0xa9, 0x50, 0x4c, 0xea, 0x29, 0x22, 0xe6, 0x1f, 0x88,
+		0xdb, 0x3c, 0xc0, 0x5f, 0x7e, 0x23, 0xbc, 0x9c, 0x4d, 0xb6, 0x0e, 0xc5, 0xa4, 0xb1, 0x06, 0x3b,
+		0x35, 0x69, 0x3f, 0x51, 0x60, 0x41, 0xc7, 0x3b, 0x0e, 0x76, 0xf7, 0xfc, 0x1a, 0x83, 0xf9, 0xee,
+		0x31, 0x75, 0x94, 0xcf, 0xc0, 0xe9, 0x74, 0x69, 0x84, 0x83, 0xfc, 0x35, 0x07, 0x8b, 0xb4, 0x5b,
+		0x61, 0xd5, 0x63, 0x3b, 0xd0, 0x0d, 0xb5, 0x34, 0x45, 0x33, 0x4d, 0x14, 0xb0, 0x63, 0xfa, 0x28,
+		0x1f, 0x20, 0x66, 0xff, 0x3f, 0x15, 0x5e, 0xc4, 0x95, 0x1c, 0xdc, 0xb4, 0xbd, 0x84, 0x2b, 0xf1,
+		0x51, 0xdf, 0x95, 0x62, 0x27, 0xfa, 0xa1, 0x97, 0x77, 0xa2, 0x1f, 0x3e, 0xfa, 0x89, 0x5e, 0x5b,
+		0x82, 0x33, 0x32, 0x8b, 0x0a, 0xa3, 0x1b, 0xb0, 0x40, 0x0a, 0xea, 0x8a, 0x63, 0xbb, 0xae, 0x50,
+		0x25, 0x6e, 0xf1, 0xa0, 0xb7, 0xa9, 0xc4, 0x7a, 0x9b, 0xc4, 0x34, 0x44, 0xe6, 0x5d, 0xec, 0x75,
+		0x4d, 0x23, 0x6a, 0x36, 0x3e, 0x2a, 0xe8, 0x69, 0xff, 0xca, 0xc3, 0xe9, 0x74, 0x1e, 0xc2, 0x9f,
+		0xf7, 0x29, 0x1d, 0x1a, 0x9d, 0xb7, 0x3b, 0xbc, 0xd3, 0xda, 0xa3, 0xd6, 0xcc, 0x22, 0xc6, 0x3a,
+		0x4b, 0xee, 0x7a, 0x87, 0x1d, 0x3d, 0x79, 0x69, 0x71, 0xd2, 0x0b, 0x0d, 0xa1, 0xef, 0xc3, 0xcc,
+		0x8e, 0x61, 0x36, 0x68, 0xfd, 0x65, 0xb4, 0x5d, 0x1c, 0xf0, 0xe4, 0x09, 0xe7, 0x83, 0xa3, 0xf0,
+		0xbc, 0xcd, 0x08, 0x56, 0x28, 0xbd, 0x08, 0x67, 0xb4, 0x93, 0x98, 0x50, 0x3f, 0x82, 0xa9, 0x84,
+		0x88, 0x29, 0xa7, 0xe2, 0xdb, 0xd1, 0xa2, 0xe6, 0x8a, 0x6c, 0xf9, 0xe3, 0x42, 0x89, 0x85, 0x0b,
+		0x1f, 0x8d, 0x09, 0xcb, 0x39, 0x89, 0x84, 0x29, 0x8c, 0x6f, 0x46, 0xeb, 0x66, 0xa9, 0xdf, 0x11,
+		0x83, 0x50, 0x7e, 0x21, 0xc2, 0xe1, 0x82, 0x8a, 0x76, 0x81, 0xb8, 0x79, 0xea, 0x09, 0xb3, 0x55,
+		0xec, 0x66, 0xab, 0x81, 0x49, 0xc9, 0xda, 0x47, 0xdb, 0xb1, 0x3f, 0x17, 0x43, 0xcf, 0xb9, 0x07,
+		0x91, 0x93, 0x2e, 0x5f, 0x11, 0x57, 0xe4, 0xf8, 0x01, 0xcc, 0xc6, 0x11, 0x29, 0xe1, 0xe0, 0xcb,
+		0x45, 0x17, 0x60, 0x7c, 0x07, 0x7b, 0xb5, 0xbd, 0x87, 0x98, 0x07, 0x2b, 0xb6, 0xb1, 0x47, 0xf5,
+		0xe8, 0xa0, 0xe6, 0xc2, 0xa5, 0x3e, 0x94, 0x15, 0xde, 0x4e, 0x56, 0xd6, 0xef, 0x03, 0x1c, 0x71,
+		0x65, 0x19, 0xba, 0xf6, 0x09, 0x29, 0x2a, 0xe8, 0x59, 0xb8, 0x43, 0xce, 0xca, 0x66, 0xad, 0x62,
+		0x5b, 0x3b, 0xe6, 0xae, 0x6f, 0xd1, 0xb3, 0x50, 0xa8, 0xb1, 0x01, 0x7e, 0x90, 0xe6, 0xa1, 0x12,
+		0xf8, 0x10, 0xeb, 0xd5, 0x6e, 0xc0, 0x89, 0x1d, 0xb3, 0x41, 0x28, 0xfb, 0x85, 0xd6, 0x65, 0x59,
+		0x11, 0x1f, 0x26, 0x7f, 0x9b, 0xa1, 0xe8, 0x3e, 0xaa, 0xf6, 0x08, 0x8a, 0x49, 0x09, 0xba, 0x95,
+		0xa0, 0xf0, 0x23, 0xa5, 0x9f, 0xf3, 0x2a, 0x87, 0xd5, 0x7e, 0xaa, 0x80, 0xfa, 0x61, 0xab, 0x6e,
+		0x78, 0xf8, 0x68, 0x6a, 0x3d, 0x84, 0x71, 0x01, 0xc0, 0xe8, 0xf9, 0xca, 0x5d, 0xea, 0x47, 0x39,
+		0x9e, 0xd3, 0x4f, 0xd6, 0x82, 0x0f, 0x57, 0x5b, 0x84, 0x85, 0x54, 0x71, 0x44, 0xf0, 0x7c, 0xc1,
+		0x12, 0x2c, 0x0d, 0xbc, 0xf8, 0x38, 0x97, 0x81, 0x25, 0xd6, 0x34, 0x29, 0x84, 0x98, 0x3f, 0x56,
+		0xe8, 0x51, 0x96, 0x50, 0xda, 0xc0, 0xd4, 0x15, 0xfd, 0xb4, 0x77, 0x4c, 0x65, 0xc0, 0x6f, 0x89,
+		0xd1, 0x52, 0xa5, 0x11, 0x8e, 0x73, 0x31, 0xe8, 0xf5, 0xd6, 0x19, 0x04, 0x0f, 0x0a, 0xa3, 0xdd,
+		0x66, 0x2e, 0xc7, 0xab, 0xa3, 0x37, 0x01, 0x75, 0xc5, 0x72, 0xbb, 0xb0, 0x39, 0x06, 0x3b, 0x15,
+		0xcc, 0x84, 0xc0, 0x43, 0x97, 0x43, 0x3e, 0x78, 0x9e, 0x83, 0x07, 0x33, 0x02, 0x9c, 0xba, 0xe2,
+		0x69, 0x26, 0xe6, 0x03, 0x62, 0x0b, 0x8f, 0xfc, 0x1d, 0xb3, 0xd9, 0x7e, 0xa7, 0xc0, 0xa2, 0x44,
+		0x9e, 0x2f, 0x96, 0xe1, 0x6e, 0x40, 0xf1, 0x3e, 0xe1, 0x77, 0xa4, 0x0d, 0xa1, 0x7d, 0x1b, 0xe6,
+		0x53, 0x90, 0x85, 0x82, 0x15, 0x38, 0x41, 0x8a, 0x1a, 0xc7, 0xec, 0xf6, 0xae, 0xfb, 0xda, 0xd7,
+		0x3c, 0x15, 0xfb, 0x98, 0xda, 0x3e, 0xa0, 0xe4, 0x34, 0x42, 0x30, 0x14, 0x92, 0x88, 0xfd, 0x46,
+		0x6b, 0x30, 0x22, 0xa2, 0x48, 0x7e, 0xd0, 0x28, 0x22, 0x10, 0xb5, 0x9f, 0x2b, 0x31, 0x6e, 0x6c,
+		0xfa, 0x48, 0xb1, 0xf1, 0x25, 0xc5, 0x8a, 0x6f, 0xc1, 0x2b, 0x29, 0xf3, 0xa9, 0xfa, 0xaf, 0x46,
+		0x4b, 0x90, 0xfe, 0x22, 0xf8, 0x8f, 0x14, 0xda, 0x49, 0x61, 0xc1, 0xe8, 0x98, 0x37, 0xcc, 0x3c,
+		0xed, 0x52, 0xc4, 0x04, 0x11, 0x01, 0xf1, 0x33, 0xb2, 0xb7, 0x45, 0x63, 0x8f, 0xfc, 0x91, 0xf3,
+		0x5b, 0xdd, 0xbf, 0xdf, 0xe9, 0x21, 0x6a, 0xe4, 0x68, 0x97, 0xeb, 0x7d, 0xb4, 0xcb, 0xa7, 0x5d,
+		0x66, 0x4d, 0xc3, 0x30, 0xe1, 0xe2, 0x74, 0xc4, 0xa5, 0x3d, 0xff, 0xa0, 0x97, 0xb2, 0x8b, 0x12,
+		0x99, 0x84, 0xfb, 0xdf, 0x03, 0x08, 0x36, 0xa7, 0xd8, 0x01, 0x97, 0xfb, 0xb3, 0x0f, 0xeb, 0x61,
+		0x84, 0xb0, 0xd3, 0x64, 0xcd, 0xa5, 0xc8, 0x5a, 0xfe, 0xfb, 0x69, 0x18, 0x65, 0x51, 0x67, 0xed,
+		0xf1, 0x26, 0xfa, 0x99, 0x02, 0xf3, 0xd2, 0x27, 0x41, 0xe8, 0xab, 0x3d, 0xda, 0x80, 0xb2, 0x87,
+		0x4d, 0xea, 0xdb, 0x83, 0x23, 0x0a, 0x8b, 0x7c, 0x8f, 0x38, 0x73, 0xf2, 0x09, 0x07, 0xba, 0xda,
+		0x83, 0x60, 0xf2, 0xe9, 0x8f, 0x5a, 0x1e, 0x04, 0x45, 0x70, 0x0f, 0x9b, 0x23, 0xf1, 0x6c, 0xa5,
+		0xa7, 0x39, 0x64, 0xef, 0x76, 0x7a, 0x9a, 0x43, 0xfe, 0x2a, 0xc7, 0x00, 0x08, 0x5e, 0x67, 0xa0,
+		0x65, 0x09, 0x9d, 0xc4, 0x83, 0x0f, 0xf5, 0x52, 0x1f, 0x90, 0x01, 0x8b, 0xe0, 0xe5, 0x83, 0x94,
+		0x45, 0xe2, 0x31, 0x88, 0x94, 0x45, 0xf2, 0x19, 0x05, 0x67, 0xe1, 0xbf, 0x59, 0xc8, 0x60, 0x11,
+		0x7b, 0x68, 0x91, 0xc1, 0x22, 0xfe, 0x00, 0x02, 0x7d, 0x07, 0xc6, 0x23, 0x4f, 0x0d, 0xd0, 0xeb,
+		0x3d, 0x6c, 0x1e, 0x61, 0xf4, 0x46, 0x7f, 0xc0, 0x82, 0xd7, 0x6f, 0x14, 0x76, 0x31, 0x99, 0x79,
+		0x1f, 0x8e, 0xbe, 0x26, 0x3f, 0x75, 0xf6, 0xf3, 0x7c, 0x41, 0x7d, 0xff, 0xc8, 0xf8, 0x42, 0xca,
+		0x1f, 0x92, 0xb0, 0x9d, 0x7e, 0xe3, 0x8b, 0xae, 0x0d, 0x78, 0x41, 0xcc, 0x25, 0xba, 0x7e, 0xa4,
+		0x6b, 0x65, 0xb6, 0xa7, 0xa4, 0xd7, 0xaa, 0xd2, 0x3d, 0xd5, 0xeb, 0xe2, 0x57, 0xba, 0xa7, 0x7a,
+		0xdf, 0xe0, 0xfe, 0x4a, 0x61, 0xcd, 0x0b, 0xe9, 0x8d, 0x23, 0x7a, 0x37, 0x83, 0x74, 0x8f, 0x0b,
+		0x5a, 0xf5, 0xc6, 0x91, 0x70, 0x03, 0x27, 0x8e, 0x5c, 0xed, 0x49, 0x9d, 0x38, 0xed, 0xfa, 0x52,
+		0xea, 0xc4, 0xa9, 0xb7, 0x85, 0xa8, 0x03, 0x28, 0x79, 0x17, 0x86, 0xae, 0x0c, 0x7a, 0x17, 0xa8,
+		0x5e, 0x1d, 0x00, 0x43, 0xb0, 0x6e, 0xc1, 0xa9, 0xd8, 0x45, 0x12, 0x7a, 0xb3, 0xdf, 0x0b, 0x27,
+		0xce, 0xb4, 0x34, 0xd8, 0xfd, 0x14, 0xe5, 0x18, 0xbb, 0xde, 0x90, 0x72, 0x4c, 0xbf, 0x33, 0x92,
+		0x72, 0x94, 0xdd, 0x9a, 0xb8, 0x30, 0x19, 0x6f, 0x9b, 0x23, 0x19, 0x0d, 0xc9, 0x3d, 0x82, 0xba,
+		0xd2, 0x37, 0x7c, 0xc0, 0x34, 0xde, 0x61, 0x96, 0x32, 0x95, 0xf4, 0xed, 0xa5, 0x4c, 0xa5, 0xad,
+		0xeb, 0x1f, 0xd0, 0xdb, 0xf4, 0x64, 0x0f, 0x18, 0x95, 0xa5, 0x16, 0x93, 0xb6, 0xaf, 0xd5, 0xd5,
+		0x81, 0x70, 0x42, 0x81, 0x2e, 0xbd, 0x25, 0x2a, 0x0d, 0x74, 0x99, 0x3d, 0x69, 0x69, 0xa0, 0xcb,
+		0xee, 0xbb, 0x52, 0x43, 0xa4, 0xb5, 0x14, 0xa5, 0x86, 0xc8, 0x68, 0xd2, 0x4a, 0x0d, 0x91, 0xd9,
+		0x74, 0x25, 0xe7, 0xc9, 0x73, 0x3d, 0x9b, 0x56, 0xe8, 0x7d, 0xb9, 0x76, 0x7d, 0xf5, 0xf6, 0xd4,
+		0x9b, 0x47, 0x27, 0x10, 0xf8, 0x69, 0xbc, 0xc9, 0x24, 0xf5, 0x53, 0x49, 0x3f, 0x4c, 0xea, 0xa7,
+		0xd2, 0xee, 0x15, 0xa9, 0x2c, 0x53, 0x1a, 0x3f, 0xd2, 0xca, 0x52, 0xde, 0xb3, 0x92, 0x56, 0x96,
+		0x19, 0x7d, 0x25, 0xbe, 0x4b, 0x92, 0x0d, 0x9d, 0x8c, 0x5d, 0x22, 0xed, 0x41, 0x65, 0xec, 0x12,
+		0x79, 0xc7, 0x08, 0x1d, 0xc0, 0x54, 0xe2, 0x18, 0x8e, 0x64, 0x46, 0x94, 0x9d, 0xf6, 0xd5, 0x2b,
+		0xfd, 0x23, 0x08, 0xbe, 0x87, 0x30, 0x11, 0xed, 0x0a, 0x21, 0x79, 0xc6, 0x90, 0xf5, 0xb3, 0xa4,
+		0x16, 0xcf, 0x6a, 0x3a, 0xbd, 0x50, 0x60, 0xce, 0x6f, 0xac, 0x54, 0x6c, 0xc7, 0x69, 0xb7, 0xba,
+		0x85, 0x13, 0x5a, 0xcd, 0xa2, 0x27, 0xe9, 0x0e, 0xa9, 0xd7, 0x06, 0x43, 0x0a, 0xa7, 0x9e, 0xc8,
+		0x99, 0x35, 0x23, 0xf5, 0xa4, 0x1d, 0xb2, 0x33, 0x52, 0x4f, 0xea, 0x51, 0x18, 0x7d, 0xaa, 0xc0,
+		0x4c, 0xea, 0xb1, 0x53, 0xaa, 0x76, 0xd6, 0xc1, 0x59, 0xaa, 0x76, 0xe6, 0xc9, 0x76, 0xfd, 0xfa,
+		0x37, 0x56, 0x77, 0x4d, 0x6f, 0xaf, 0xbd, 0x5d, 0xaa, 0xd9, 0xcd, 0x95, 0xc8, 0xbf, 0xcd, 0x94,
+		0x76, 0xb1, 0xc5, 0xff, 0x7b, 0xa8, 0xfb, 0xaf, 0x49, 0x37, 0xd8, 0x8f, 0x83, 0xab, 0xdb, 0x23,
+		0x6c, 0x7c, 0xf5, 0xbf, 0xd2, 0xbd, 0x51, 0xe7, 0xc2, 0x34, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
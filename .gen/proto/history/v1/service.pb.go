@@ -5186,6 +5186,108 @@ func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
 	return nil
 }
 
+type RestoreWorkflowRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	DomainId             string                `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,3,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RestoreWorkflowRequest) Reset()         { *m = RestoreWorkflowRequest{} }
+func (m *RestoreWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreWorkflowRequest) ProtoMessage()    {}
+func (*RestoreWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
+}
+func (m *RestoreWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkflowRequest.Merge(m, src)
+}
+func (m *RestoreWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreWorkflowRequest proto.InternalMessageInfo
+
+func (m *RestoreWorkflowRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *RestoreWorkflowRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *RestoreWorkflowRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type RestoreWorkflowResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreWorkflowResponse) Reset()         { *m = RestoreWorkflowResponse{} }
+func (m *RestoreWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreWorkflowResponse) ProtoMessage()    {}
+func (*RestoreWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
+}
+func (m *RestoreWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreWorkflowResponse.Merge(m, src)
+}
+func (m *RestoreWorkflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreWorkflowResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
 	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
@@ -5276,6 +5378,8 @@ func init() {
 	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
 	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
 	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
+	proto.RegisterType((*RestoreWorkflowRequest)(nil), "uber.cadence.history.v1.RestoreWorkflowRequest")
+	proto.RegisterType((*RestoreWorkflowResponse)(nil), "uber.cadence.history.v1.RestoreWorkflowResponse")
 }
 
 func init() {
@@ -5283,303 +5387,303 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc9,
-	0x56, 0x56, 0x7b, 0xe2, 0xbf, 0x63, 0x7b, 0x6c, 0x57, 0xfc, 0x33, 0x1e, 0x27, 0x8e, 0xdd, 0x77,
-	0x93, 0x78, 0xb3, 0xbb, 0x93, 0x8d, 0xb3, 0x9b, 0x64, 0xb3, 0xbb, 0x77, 0x37, 0xb1, 0x93, 0xec,
-	0x44, 0xf9, 0x6d, 0x9b, 0x04, 0x10, 0x4a, 0xab, 0x3d, 0x5d, 0x63, 0x37, 0x9e, 0xe9, 0x9e, 0x74,
-	0xf7, 0xd8, 0x99, 0x7d, 0x40, 0x17, 0xb8, 0x42, 0xba, 0x57, 0x08, 0x2e, 0x70, 0x41, 0x48, 0x48,
-	0x57, 0x42, 0x17, 0xe9, 0xc2, 0xf2, 0xc0, 0x03, 0xbc, 0x21, 0x9e, 0x78, 0x81, 0x07, 0x24, 0x5e,
-	0x79, 0x02, 0xad, 0xae, 0x78, 0x00, 0x89, 0x17, 0x10, 0x8f, 0x08, 0xd5, 0x4f, 0xff, 0x4d, 0x57,
-	0x57, 0xf7, 0xd8, 0x48, 0xd9, 0xbb, 0xec, 0x9b, 0xa7, 0xaa, 0xce, 0xa9, 0x53, 0xa7, 0x4e, 0x7d,
-	0x75, 0xea, 0x9c, 0xd3, 0x86, 0xf3, 0xdd, 0x5d, 0xec, 0x5e, 0x6e, 0x18, 0x26, 0xb6, 0x1b, 0xf8,
-	0xf2, 0xbe, 0xe5, 0xf9, 0x8e, 0xdb, 0xbb, 0x7c, 0x78, 0xe5, 0xb2, 0x87, 0xdd, 0x43, 0xab, 0x81,
-	0x6b, 0x1d, 0xd7, 0xf1, 0x1d, 0xb4, 0x48, 0x86, 0xd5, 0xf8, 0xb0, 0x1a, 0x1f, 0x56, 0x3b, 0xbc,
-	0x52, 0x5d, 0xd9, 0x73, 0x9c, 0xbd, 0x16, 0xbe, 0x4c, 0x87, 0xed, 0x76, 0x9b, 0x97, 0xcd, 0xae,
-	0x6b, 0xf8, 0x96, 0x63, 0x33, 0xc2, 0xea, 0xb9, 0xfe, 0x7e, 0xdf, 0x6a, 0x63, 0xcf, 0x37, 0xda,
-	0x1d, 0x3e, 0x20, 0xc5, 0xe0, 0xc8, 0x35, 0x3a, 0x1d, 0xec, 0x7a, 0xbc, 0x7f, 0x35, 0x21, 0xa0,
-	0xd1, 0xb1, 0x88, 0x70, 0x0d, 0xa7, 0xdd, 0x0e, 0xa7, 0x58, 0x13, 0x8d, 0x08, 0x44, 0xe4, 0x52,
-	0x88, 0x86, 0xbc, 0xec, 0xe2, 0x70, 0x80, 0x2a, 0x1a, 0xe0, 0x1b, 0xde, 0x41, 0xcb, 0xf2, 0x7c,
-	0xd9, 0x98, 0x23, 0xc7, 0x3d, 0x68, 0xb6, 0x9c, 0x23, 0x3e, 0xe6, 0x92, 0x68, 0x0c, 0x57, 0xa5,
-	0xde, 0x37, 0x76, 0x3d, 0x6f, 0x2c, 0x76, 0xf9, 0xc8, 0x37, 0x12, 0x23, 0xbd, 0x7d, 0xc3, 0xc5,
-	0x26, 0x55, 0x43, 0xab, 0xeb, 0xf9, 0xb9, 0xa3, 0x92, 0xaa, 0x50, 0x33, 0x46, 0xbd, 0xec, 0xe2,
-	0x2e, 0x16, 0x4a, 0x16, 0x8d, 0x71, 0x71, 0xa7, 0x65, 0x35, 0xe2, 0xdb, 0x7b, 0x3e, 0x63, 0x64,
-	0x72, 0xa9, 0xea, 0x9f, 0x0e, 0xc3, 0xd9, 0x6d, 0xdf, 0x70, 0xfd, 0xe7, 0xbc, 0xfd, 0xce, 0x2b,
-	0xdc, 0xe8, 0x12, 0x3e, 0x1a, 0x7e, 0xd9, 0xc5, 0x9e, 0x8f, 0x1e, 0xc0, 0xa8, 0xcb, 0xfe, 0xac,
-	0x28, 0xab, 0xca, 0xfa, 0xc4, 0xc6, 0x46, 0x2d, 0x61, 0x72, 0x46, 0xc7, 0xaa, 0x1d, 0x5e, 0xa9,
-	0x49, 0x99, 0x68, 0x01, 0x0b, 0xb4, 0x0c, 0xe3, 0xa6, 0xd3, 0x36, 0x2c, 0x5b, 0xb7, 0xcc, 0xca,
-	0xd0, 0xaa, 0xb2, 0x3e, 0xae, 0x8d, 0xb1, 0x86, 0xba, 0x89, 0x7e, 0x09, 0xe6, 0x3b, 0x86, 0x8b,
-	0x6d, 0x5f, 0xc7, 0x01, 0x03, 0xdd, 0xb2, 0x9b, 0x4e, 0xa5, 0x44, 0x27, 0x5e, 0x17, 0x4e, 0xfc,
-	0x84, 0x52, 0x84, 0x33, 0xd6, 0xed, 0xa6, 0xa3, 0x9d, 0xee, 0xa4, 0x1b, 0x51, 0x05, 0x46, 0x0d,
-	0xdf, 0xc7, 0xed, 0x8e, 0x5f, 0x39, 0xb5, 0xaa, 0xac, 0x0f, 0x6b, 0xc1, 0x4f, 0xb4, 0x09, 0xd3,
-	0xf8, 0x55, 0xc7, 0x62, 0xc7, 0x43, 0x27, 0xe7, 0xa0, 0x32, 0x4c, 0x67, 0xac, 0xd6, 0xd8, 0x19,
-	0xa8, 0x05, 0x67, 0xa0, 0xb6, 0x13, 0x1c, 0x12, 0xad, 0x1c, 0x91, 0x90, 0x46, 0xd4, 0x84, 0xa5,
-	0x86, 0x63, 0xfb, 0x96, 0xdd, 0xc5, 0xba, 0xe1, 0xe9, 0x36, 0x3e, 0xd2, 0x2d, 0xdb, 0xf2, 0x2d,
-	0xc3, 0x77, 0xdc, 0xca, 0xc8, 0xaa, 0xb2, 0x5e, 0xde, 0x78, 0x4b, 0xb8, 0x80, 0x4d, 0x4e, 0x75,
-	0xcb, 0x7b, 0x84, 0x8f, 0xea, 0x01, 0x89, 0xb6, 0xd0, 0x10, 0xb6, 0xa3, 0x3a, 0xcc, 0x06, 0x3d,
-	0xa6, 0xde, 0x34, 0xac, 0x56, 0xd7, 0xc5, 0x95, 0x51, 0x2a, 0xee, 0x19, 0x21, 0xff, 0xbb, 0x6c,
-	0x8c, 0x36, 0x13, 0x92, 0xf1, 0x16, 0xa4, 0xc1, 0x42, 0xcb, 0xf0, 0x7c, 0xbd, 0xe1, 0xb4, 0x3b,
-	0x2d, 0x4c, 0x17, 0xef, 0x62, 0xaf, 0xdb, 0xf2, 0x2b, 0x63, 0x12, 0x7e, 0x4f, 0x8c, 0x5e, 0xcb,
-	0x31, 0x4c, 0x6d, 0x8e, 0xd0, 0x6e, 0x86, 0xa4, 0x1a, 0xa5, 0x44, 0x3f, 0x0f, 0xcb, 0x4d, 0xcb,
-	0xf5, 0x7c, 0xdd, 0xc4, 0x0d, 0xcb, 0xa3, 0xfa, 0x34, 0xbc, 0x03, 0x7d, 0xd7, 0x68, 0x1c, 0x38,
-	0xcd, 0x66, 0x65, 0x9c, 0x32, 0x5e, 0x4a, 0xe9, 0x75, 0x8b, 0x83, 0x93, 0x56, 0xa1, 0xd4, 0x5b,
-	0x9c, 0x78, 0xc7, 0xf0, 0x0e, 0x6e, 0x33, 0x52, 0xf5, 0x3a, 0xac, 0x64, 0x19, 0x99, 0xd7, 0x71,
-	0x6c, 0x0f, 0xa3, 0x79, 0x18, 0x71, 0xbb, 0xd4, 0xb2, 0x14, 0x6a, 0x59, 0xc3, 0x6e, 0xd7, 0xae,
-	0x9b, 0xea, 0x9f, 0x0c, 0xc1, 0xca, 0xb6, 0xb5, 0x67, 0x1b, 0xad, 0x4c, 0x23, 0x7f, 0xd8, 0x6f,
-	0xe4, 0x57, 0xc5, 0x46, 0x2e, 0xe5, 0x52, 0xd0, 0xca, 0x9b, 0xb0, 0x8c, 0x5f, 0xf9, 0xd8, 0xb5,
-	0x8d, 0x56, 0x08, 0x3c, 0x91, 0xc1, 0x73, 0x5b, 0xbf, 0x20, 0x9c, 0x3f, 0x3d, 0xf3, 0x52, 0xc0,
-	0x2a, 0xd5, 0x85, 0x6a, 0x70, 0xba, 0xb1, 0x6f, 0xb5, 0xcc, 0x68, 0x12, 0xc7, 0x6e, 0xf5, 0xa8,
-	0xed, 0x8f, 0x69, 0xb3, 0xb4, 0x2b, 0x20, 0x7a, 0x6c, 0xb7, 0x7a, 0xea, 0x1a, 0x9c, 0xcb, 0x5c,
-	0x1f, 0x53, 0xb0, 0xfa, 0x23, 0x05, 0x2e, 0xf2, 0x31, 0x96, 0xbf, 0x2f, 0xc7, 0x8d, 0x67, 0xfd,
-	0x2a, 0xfd, 0x48, 0xa6, 0xd2, 0x3c, 0x76, 0xc5, 0x74, 0xab, 0xde, 0x82, 0xf5, 0x7c, 0x86, 0x72,
-	0x6b, 0xf9, 0xbe, 0x02, 0x67, 0x35, 0xec, 0xe1, 0x13, 0x23, 0xa2, 0x94, 0x49, 0xc1, 0xf5, 0x5c,
-	0x87, 0x95, 0x2c, 0x36, 0xf2, 0x55, 0x7c, 0x31, 0x04, 0x6b, 0x3b, 0xd8, 0x6d, 0x5b, 0xb6, 0xe1,
-	0xe3, 0xcc, 0x95, 0x3c, 0xe9, 0x5f, 0xc9, 0x35, 0xe1, 0x4a, 0x72, 0x19, 0xfd, 0x8c, 0x5b, 0xfe,
-	0x1b, 0xa0, 0xca, 0x96, 0xc8, 0x8d, 0xff, 0x07, 0x0a, 0xac, 0x6e, 0x61, 0xaf, 0xe1, 0x5a, 0xbb,
-	0xd9, 0x1a, 0x7d, 0xdc, 0xaf, 0xd1, 0xf7, 0x85, 0xcb, 0xc9, 0xe3, 0x53, 0xd0, 0x3c, 0xfe, 0xa7,
-	0x04, 0x6b, 0x12, 0x56, 0xdc, 0x44, 0x5a, 0xb0, 0x18, 0xdd, 0xa7, 0x0d, 0xc7, 0x6e, 0x5a, 0x7b,
-	0x1c, 0x6d, 0xa5, 0x60, 0x97, 0x62, 0xb8, 0x19, 0x27, 0xd5, 0x16, 0xb0, 0xb0, 0x1d, 0xed, 0xc2,
-	0x62, 0x7a, 0x6f, 0xd9, 0x35, 0x3e, 0x44, 0x67, 0xbb, 0x54, 0x6c, 0x36, 0x7a, 0x91, 0xcf, 0x1f,
-	0x89, 0x9a, 0xd1, 0x73, 0x40, 0x1d, 0x6c, 0x9b, 0x96, 0xbd, 0xa7, 0x1b, 0x0d, 0xdf, 0x3a, 0xb4,
-	0x7c, 0x0b, 0x7b, 0x95, 0xd2, 0x6a, 0x29, 0xdb, 0x4b, 0x60, 0xc3, 0x6f, 0xb1, 0xd1, 0x3d, 0xca,
-	0x7c, 0xb6, 0x93, 0x68, 0xb4, 0xb0, 0x87, 0x7e, 0x01, 0x66, 0x02, 0xc6, 0xd4, 0x4c, 0x5c, 0x6c,
-	0x57, 0x4e, 0x51, 0xb6, 0x35, 0x19, 0xdb, 0x4d, 0x32, 0x36, 0x29, 0xf9, 0x74, 0x27, 0xd6, 0xe5,
-	0x62, 0x1b, 0x6d, 0x47, 0xac, 0x83, 0xab, 0x91, 0x7b, 0x19, 0x52, 0x89, 0x83, 0x9b, 0x30, 0xc1,
-	0x34, 0x68, 0x54, 0x5f, 0xc1, 0xdc, 0x53, 0xe2, 0x2c, 0x07, 0xda, 0x0b, 0xcc, 0x70, 0xb3, 0xdf,
-	0x0c, 0xdf, 0x14, 0xce, 0x21, 0xa2, 0x2d, 0x68, 0x7a, 0x3f, 0x56, 0x60, 0xbe, 0x8f, 0x9c, 0x9b,
-	0xdb, 0x27, 0x30, 0x49, 0x1d, 0xf8, 0xc0, 0x97, 0x50, 0x0a, 0xf8, 0x12, 0x13, 0x94, 0x82, 0xbb,
-	0x10, 0x75, 0x28, 0x07, 0x0c, 0x7e, 0x19, 0x37, 0x7c, 0x6c, 0x72, 0xc3, 0x51, 0xb3, 0xd7, 0xa0,
-	0xf1, 0x91, 0xda, 0xd4, 0xcb, 0xf8, 0x4f, 0xf5, 0xbb, 0x0a, 0x54, 0x29, 0x80, 0x6e, 0xfb, 0x56,
-	0xe3, 0xa0, 0x47, 0xdc, 0x89, 0x07, 0x96, 0xe7, 0x07, 0x6a, 0xaa, 0xf7, 0xab, 0xe9, 0x72, 0x36,
-	0x92, 0x0b, 0x39, 0x14, 0x54, 0xd6, 0x59, 0x58, 0x16, 0xf2, 0xe0, 0xc8, 0xf2, 0x9f, 0x0a, 0x2c,
-	0xdc, 0xc3, 0xfe, 0xc3, 0xae, 0x6f, 0xec, 0xb6, 0xf0, 0xb6, 0x6f, 0xf8, 0x58, 0x13, 0xb1, 0x55,
-	0xfa, 0xf0, 0xf4, 0xe7, 0x00, 0x09, 0x60, 0x74, 0x68, 0x20, 0x18, 0x9d, 0x4d, 0x9d, 0x30, 0x74,
-	0x15, 0x16, 0xf0, 0xab, 0x0e, 0x55, 0xa0, 0x6e, 0xe3, 0x57, 0xbe, 0x8e, 0x0f, 0x89, 0x4f, 0x6e,
-	0x99, 0x14, 0xa1, 0x4b, 0xda, 0xe9, 0xa0, 0xf7, 0x11, 0x7e, 0xe5, 0xdf, 0x21, 0x7d, 0x75, 0x13,
-	0xbd, 0x0b, 0x73, 0x8d, 0xae, 0x4b, 0x9d, 0xf7, 0x5d, 0xd7, 0xb0, 0x1b, 0xfb, 0xba, 0xef, 0x1c,
-	0xd0, 0xd3, 0xa3, 0xac, 0x4f, 0x6a, 0x88, 0xf7, 0xdd, 0xa6, 0x5d, 0x3b, 0xa4, 0x47, 0xfd, 0xe1,
-	0x38, 0x2c, 0xa6, 0x56, 0xcd, 0x6d, 0x48, 0xbc, 0x32, 0xe5, 0xa4, 0x2b, 0xbb, 0x0b, 0x53, 0x21,
-	0x5b, 0xbf, 0xd7, 0xc1, 0x5c, 0x57, 0x6b, 0x52, 0x8e, 0x3b, 0xbd, 0x0e, 0xd6, 0x26, 0x8f, 0x62,
-	0xbf, 0x90, 0x0a, 0x53, 0x22, 0xc5, 0x4c, 0xd8, 0x31, 0x85, 0x3c, 0x83, 0xa5, 0x8e, 0x8b, 0x0f,
-	0x2d, 0xa7, 0xeb, 0xe9, 0x1e, 0xf1, 0x44, 0xb0, 0x19, 0x8d, 0x3f, 0x45, 0xe7, 0x5d, 0x4e, 0xb9,
-	0xc1, 0x75, 0xdb, 0xbf, 0xf6, 0xde, 0x33, 0xa3, 0xd5, 0xc5, 0xda, 0x42, 0x40, 0xbd, 0xcd, 0x88,
-	0x03, 0xbe, 0xef, 0xc0, 0x69, 0xea, 0xb4, 0x33, 0x2f, 0x3b, 0xe4, 0x38, 0x4c, 0x25, 0x98, 0x21,
-	0x5d, 0x77, 0x49, 0x4f, 0x30, 0xfc, 0x26, 0x8c, 0x53, 0x07, 0x9c, 0x3c, 0x97, 0xe9, 0x33, 0x64,
-	0x62, 0xe3, 0xac, 0xf8, 0x92, 0x0f, 0xac, 0x72, 0xcc, 0xe7, 0x7f, 0xa1, 0x7b, 0x30, 0xe3, 0x51,
-	0x8b, 0xd5, 0x23, 0x16, 0xa3, 0x45, 0x58, 0x94, 0xbd, 0x84, 0xa1, 0xa3, 0xf7, 0x60, 0xa1, 0xd1,
-	0xb2, 0x88, 0xa4, 0x2d, 0x6b, 0xd7, 0x35, 0xdc, 0x9e, 0x7e, 0x88, 0x5d, 0x8a, 0x80, 0x63, 0xd4,
-	0xa4, 0xe7, 0x58, 0xef, 0x03, 0xd6, 0xf9, 0x8c, 0xf5, 0xc5, 0xa8, 0x9a, 0xd8, 0xf0, 0xbb, 0x2e,
-	0x0e, 0xa9, 0xc6, 0xe3, 0x54, 0x77, 0x59, 0x67, 0x40, 0x75, 0x0e, 0x26, 0x38, 0x95, 0xd5, 0xee,
-	0xb4, 0x2a, 0x40, 0x87, 0x02, 0x6b, 0xaa, 0xb7, 0x3b, 0x2d, 0xe4, 0xc1, 0xa5, 0xfe, 0x55, 0xe9,
-	0x5e, 0x63, 0x1f, 0x9b, 0xdd, 0x16, 0xd6, 0x7d, 0x87, 0x6d, 0x16, 0x7d, 0x05, 0x3a, 0x5d, 0xbf,
-	0x32, 0x91, 0xf7, 0x60, 0x79, 0x23, 0xb9, 0xd6, 0x6d, 0xce, 0x69, 0xc7, 0xa1, 0xfb, 0xb6, 0xc3,
-	0xd8, 0x10, 0x97, 0x84, 0x6d, 0x15, 0x79, 0xf1, 0x47, 0x0b, 0x99, 0xa4, 0x0f, 0xd1, 0x59, 0xda,
-	0xb5, 0x4d, 0x7a, 0x82, 0x55, 0x64, 0x1d, 0xa7, 0xa9, 0xac, 0xe3, 0x84, 0x1e, 0x40, 0x39, 0xb4,
-	0x6d, 0x8f, 0x1c, 0xa6, 0x4a, 0x99, 0x3e, 0x3a, 0xcf, 0x27, 0xb7, 0x8a, 0x45, 0x02, 0xe2, 0xf6,
-	0xcd, 0x4e, 0x5e, 0x78, 0x30, 0xe8, 0x4f, 0xd4, 0x80, 0xb9, 0x90, 0x5b, 0xa3, 0xe5, 0x78, 0x98,
-	0xf3, 0x9c, 0xa6, 0x3c, 0xaf, 0x14, 0x74, 0x18, 0x08, 0x21, 0xe1, 0xd7, 0xf5, 0xb4, 0xf0, 0x3c,
-	0x87, 0x8d, 0xe4, 0x94, 0xcf, 0x72, 0x45, 0xe8, 0x2c, 0x14, 0x42, 0x6e, 0xf1, 0x19, 0xd1, 0x9d,
-	0x18, 0x49, 0xcd, 0x15, 0xf4, 0x59, 0x30, 0x5e, 0x9b, 0x39, 0xec, 0x6b, 0x41, 0x1f, 0xc1, 0xb2,
-	0x45, 0xce, 0x5c, 0xdf, 0x1e, 0x63, 0x9b, 0xe0, 0x8c, 0x59, 0x99, 0xa5, 0x6e, 0xe0, 0xa2, 0xe5,
-	0x25, 0xd1, 0xf8, 0x0e, 0xeb, 0x56, 0xff, 0x4b, 0x81, 0xc5, 0x27, 0x4e, 0xab, 0xf5, 0xff, 0x0c,
-	0x8d, 0x7f, 0x32, 0x06, 0x95, 0xf4, 0xb2, 0xbf, 0x81, 0xe3, 0x6f, 0xe0, 0xf8, 0xeb, 0x08, 0xc7,
-	0x59, 0xe7, 0x63, 0x32, 0x13, 0x5e, 0x85, 0x58, 0x35, 0x75, 0x62, 0xac, 0xfa, 0xd9, 0x43, 0x6d,
-	0xf5, 0x6f, 0x87, 0x60, 0x55, 0xc3, 0x0d, 0xc7, 0x35, 0xe3, 0x51, 0x3a, 0x7e, 0x2c, 0x5e, 0x27,
-	0x52, 0x9e, 0x83, 0x89, 0xd0, 0x70, 0x42, 0x10, 0x80, 0xa0, 0xa9, 0x6e, 0xa2, 0x45, 0x18, 0xa5,
-	0x36, 0xc6, 0x4f, 0x7c, 0x49, 0x1b, 0x21, 0x3f, 0xeb, 0x26, 0x3a, 0x0b, 0xc0, 0xfd, 0xf8, 0xe0,
-	0xec, 0x8e, 0x6b, 0xe3, 0xbc, 0xa5, 0x6e, 0x22, 0x0d, 0x26, 0x3b, 0x4e, 0xab, 0xa5, 0x07, 0x6f,
-	0x85, 0x11, 0xc9, 0x5b, 0x81, 0x60, 0xe8, 0x5d, 0xc7, 0x8d, 0xab, 0x26, 0x78, 0x2b, 0x4c, 0x10,
-	0x26, 0xfc, 0x87, 0xfa, 0xcf, 0xa3, 0xb0, 0x26, 0xd1, 0x22, 0x07, 0xde, 0x14, 0x42, 0x2a, 0xc7,
-	0x43, 0x48, 0x29, 0xfa, 0x0d, 0x1d, 0x1f, 0xfd, 0xde, 0x06, 0x14, 0xe8, 0xd7, 0xec, 0x87, 0xdf,
-	0x99, 0xb0, 0x27, 0x18, 0xbd, 0x4e, 0x00, 0x4c, 0x00, 0xbd, 0x25, 0x82, 0x50, 0x09, 0xbe, 0x29,
-	0x44, 0x1f, 0x4e, 0x23, 0x7a, 0x2c, 0x9e, 0x3f, 0x92, 0x8c, 0xe7, 0xdf, 0x80, 0x0a, 0x87, 0x94,
-	0x28, 0x00, 0x11, 0xdc, 0xfe, 0xa3, 0xf4, 0xf6, 0x5f, 0x60, 0xfd, 0xa1, 0xed, 0xf0, 0xcb, 0x1f,
-	0x69, 0x30, 0x15, 0xc6, 0xad, 0x69, 0xc8, 0x82, 0x05, 0xc2, 0xdf, 0xc9, 0x3a, 0x8d, 0x3b, 0xae,
-	0x61, 0x7b, 0x04, 0xca, 0x12, 0xcf, 0xf4, 0x49, 0x33, 0xf6, 0x0b, 0xbd, 0x80, 0x33, 0x82, 0x80,
-	0x48, 0x04, 0xe1, 0xe3, 0x45, 0x20, 0x7c, 0x29, 0x65, 0xee, 0x21, 0x9a, 0x67, 0xb8, 0x96, 0x90,
-	0xe5, 0x5a, 0xae, 0xc1, 0x64, 0x02, 0xf3, 0x26, 0x28, 0xe6, 0x4d, 0xec, 0xc6, 0xc0, 0xee, 0x16,
-	0x94, 0xa3, 0x6d, 0xa5, 0xf9, 0x90, 0xc9, 0xdc, 0x7c, 0xc8, 0x54, 0x48, 0x41, 0xd3, 0x21, 0x1f,
-	0xc3, 0x64, 0xb0, 0xd7, 0x94, 0xc1, 0x54, 0x2e, 0x83, 0x09, 0x3e, 0x9e, 0x92, 0x1b, 0x30, 0x4a,
-	0x5e, 0xf2, 0x04, 0x64, 0xcb, 0x34, 0xfe, 0x72, 0xaf, 0x96, 0x91, 0xe8, 0xac, 0xe5, 0x9e, 0x22,
-	0x1a, 0x22, 0xb0, 0xb0, 0x77, 0xc7, 0xf6, 0xdd, 0x9e, 0x16, 0xf0, 0xad, 0xbe, 0x80, 0xc9, 0x78,
-	0x07, 0x9a, 0x81, 0xd2, 0x01, 0xee, 0x71, 0xb0, 0x22, 0x7f, 0xa2, 0x1b, 0x30, 0x7c, 0x48, 0xcc,
-	0x5f, 0x1a, 0x7f, 0x08, 0x4e, 0x1d, 0x8b, 0x43, 0x30, 0x82, 0x9b, 0x43, 0x37, 0x94, 0x18, 0x4e,
-	0x06, 0x51, 0xa7, 0x6f, 0x70, 0x32, 0x85, 0x93, 0x71, 0xd5, 0x08, 0x71, 0xf2, 0xa7, 0xa5, 0x00,
-	0x27, 0x85, 0x5a, 0xe4, 0x38, 0x79, 0x1f, 0xa6, 0xfb, 0x70, 0x48, 0x8a, 0x94, 0xec, 0xfe, 0xed,
-	0x51, 0x24, 0xd1, 0xca, 0x49, 0x9c, 0x4a, 0x59, 0xee, 0xd0, 0x60, 0x96, 0x1b, 0x83, 0xa5, 0x52,
-	0x12, 0x96, 0x5e, 0xc0, 0x4a, 0xf2, 0x54, 0xe9, 0x4e, 0x53, 0xf7, 0xf7, 0x2d, 0x4f, 0x8f, 0xe7,
-	0x25, 0xe5, 0x53, 0x55, 0x13, 0xa7, 0xec, 0x71, 0x73, 0x67, 0xdf, 0xf2, 0x6e, 0x71, 0xfe, 0x75,
-	0x98, 0xdd, 0xc7, 0x86, 0xeb, 0xef, 0x62, 0xc3, 0xd7, 0x4d, 0xec, 0x1b, 0x56, 0xcb, 0xe3, 0x21,
-	0x46, 0x79, 0xf4, 0x6d, 0x26, 0x24, 0xdb, 0x62, 0x54, 0xe9, 0x7b, 0x67, 0xe4, 0x78, 0xf7, 0xce,
-	0x45, 0x98, 0x0e, 0xf9, 0x30, 0xb3, 0xa6, 0x00, 0x3c, 0xae, 0x85, 0x5e, 0xcf, 0x16, 0x6d, 0x55,
-	0xff, 0x40, 0x81, 0x6f, 0xb1, 0xdd, 0x4c, 0x9c, 0x64, 0x9e, 0x5e, 0x8c, 0xce, 0x8b, 0xd6, 0x1f,
-	0xb1, 0xbb, 0x91, 0x15, 0xb1, 0xcb, 0x63, 0x55, 0x30, 0x74, 0xf7, 0x97, 0x25, 0x78, 0x43, 0xce,
-	0x8d, 0x9b, 0x20, 0x8e, 0x2e, 0x37, 0x97, 0xb7, 0x71, 0x11, 0x6f, 0x1e, 0x1f, 0xba, 0xb4, 0x69,
-	0xaf, 0xcf, 0xd2, 0x7f, 0xac, 0xc0, 0x4a, 0x14, 0xf3, 0x26, 0x0e, 0xb2, 0x69, 0x79, 0x1d, 0xc3,
-	0x6f, 0xec, 0xeb, 0x2d, 0xa7, 0x61, 0xb4, 0x5a, 0xbd, 0xca, 0x10, 0x05, 0xcc, 0x17, 0x92, 0x59,
-	0xf3, 0x97, 0x53, 0x8b, 0x82, 0xe2, 0x3b, 0xce, 0x16, 0x9f, 0xe1, 0x01, 0x9b, 0x80, 0xe1, 0xe8,
-	0xb2, 0x91, 0x3d, 0xa2, 0xfa, 0x2b, 0xb0, 0x9a, 0xc7, 0x40, 0x80, 0xb7, 0x5b, 0x49, 0xbc, 0x15,
-	0x87, 0xdc, 0x03, 0x18, 0xa0, 0xbc, 0x02, 0xc6, 0xf4, 0xda, 0x8d, 0x61, 0xef, 0x0f, 0x14, 0x82,
-	0xbd, 0xa9, 0x65, 0xde, 0x35, 0xac, 0x56, 0x64, 0x4b, 0x05, 0x73, 0x35, 0x79, 0x7c, 0x0a, 0x1a,
-	0xd2, 0xb7, 0x08, 0x8e, 0x65, 0x72, 0xe2, 0x91, 0xe0, 0x1f, 0x2a, 0xa0, 0xa6, 0xd1, 0xee, 0xb3,
-	0xe0, 0x78, 0x06, 0x92, 0x3f, 0xed, 0x97, 0xfc, 0x7a, 0x86, 0xe4, 0x79, 0x9c, 0x0a, 0xca, 0xfe,
-	0x84, 0x1c, 0x4e, 0x09, 0x2f, 0x6e, 0x9b, 0x6f, 0xc2, 0x4c, 0xc3, 0xb0, 0x1b, 0x38, 0xbc, 0x01,
-	0x30, 0xbb, 0xd3, 0xc6, 0xb4, 0x69, 0xd6, 0xae, 0x05, 0xcd, 0xf1, 0xf3, 0x1e, 0xe7, 0x79, 0xc2,
-	0xf3, 0x2e, 0x63, 0x55, 0x70, 0xa9, 0x17, 0xc2, 0xe3, 0x9e, 0xc1, 0x2c, 0x96, 0x0d, 0x14, 0x0c,
-	0x3c, 0x89, 0x85, 0x65, 0xf2, 0x19, 0xd8, 0xc2, 0x44, 0x9c, 0x12, 0x16, 0x96, 0x5e, 0x20, 0xdd,
-	0x9f, 0x48, 0xf2, 0xc2, 0x16, 0x96, 0xc7, 0xa9, 0xa0, 0xec, 0xe7, 0xc5, 0xe6, 0x10, 0xf2, 0xe2,
-	0xd2, 0xff, 0x95, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0e, 0x31, 0x4b, 0xf3, 0x7f, 0x55, 0x82, 0x74,
-	0x49, 0xc7, 0xa8, 0xd4, 0xe7, 0x18, 0xa9, 0x2a, 0xb1, 0x95, 0x2c, 0xa9, 0xf9, 0xd2, 0xfe, 0x7a,
-	0x08, 0xce, 0xf3, 0x25, 0xb0, 0x65, 0x67, 0xe6, 0x98, 0xa5, 0x0b, 0x34, 0xa0, 0x9c, 0x3c, 0x83,
-	0x7c, 0x71, 0x37, 0x33, 0xf6, 0xaf, 0xc0, 0x84, 0xda, 0x54, 0xe2, 0xf4, 0xa2, 0x5d, 0x58, 0x0c,
-	0xd3, 0xf8, 0xc2, 0x42, 0x2d, 0x71, 0x86, 0xf7, 0x0e, 0xa7, 0xe9, 0xcb, 0xf0, 0x62, 0x51, 0xf3,
-	0xc0, 0x29, 0xfc, 0x75, 0xb8, 0x90, 0xb7, 0x16, 0xae, 0xe7, 0xbf, 0x51, 0x60, 0x39, 0x88, 0x0a,
-	0x09, 0x5e, 0xe9, 0xaf, 0xc5, 0x7c, 0x2e, 0xc1, 0xac, 0xe5, 0xe9, 0xc9, 0xba, 0x29, 0xaa, 0xcb,
-	0x31, 0x6d, 0xda, 0xf2, 0xee, 0xc6, 0x2b, 0xa2, 0xd4, 0x15, 0x38, 0x23, 0x16, 0x9f, 0xaf, 0xef,
-	0xa7, 0x43, 0x04, 0xc1, 0x08, 0x58, 0x27, 0xb3, 0xd2, 0x29, 0x68, 0x7d, 0x1d, 0x0b, 0x5d, 0x83,
-	0x49, 0x5e, 0x14, 0x87, 0xcd, 0x58, 0xa0, 0x36, 0x6c, 0xab, 0x9b, 0xe8, 0x39, 0x9c, 0x6e, 0x04,
-	0xa2, 0xc6, 0xa6, 0x3e, 0x35, 0xd0, 0xd4, 0x28, 0x64, 0x11, 0xcd, 0xfd, 0x00, 0x66, 0x62, 0x85,
-	0x6e, 0xec, 0x91, 0x30, 0x5c, 0xf4, 0x91, 0x30, 0x1d, 0x91, 0xd2, 0x06, 0xf5, 0x22, 0x39, 0xad,
-	0x52, 0x2d, 0xf3, 0xfd, 0xf8, 0xb7, 0x21, 0xa8, 0x68, 0xbc, 0x3c, 0x13, 0x53, 0x5a, 0xef, 0xd9,
-	0xc6, 0xeb, 0xdc, 0x83, 0x17, 0x30, 0x9f, 0x8c, 0x64, 0xf6, 0x74, 0xcb, 0xc7, 0xed, 0xa0, 0x7e,
-	0xe2, 0x52, 0xa1, 0x68, 0x66, 0xaf, 0xee, 0xe3, 0xb6, 0x76, 0xfa, 0x30, 0xd5, 0xe6, 0xa1, 0xf7,
-	0x61, 0x84, 0x2a, 0xd7, 0xe3, 0x7b, 0x26, 0x8e, 0x6c, 0x6c, 0x19, 0xbe, 0x71, 0xbb, 0xe5, 0xec,
-	0x6a, 0x7c, 0x30, 0xda, 0x84, 0xb2, 0x8d, 0x8f, 0x74, 0xb7, 0xcb, 0xf7, 0x26, 0x78, 0xba, 0xe4,
-	0x90, 0x4f, 0xda, 0xf8, 0x48, 0xeb, 0xb2, 0x4d, 0xf1, 0xd4, 0x65, 0x58, 0x12, 0xe8, 0x9a, 0xef,
-	0xc4, 0xf7, 0x15, 0x58, 0xd8, 0xee, 0xd9, 0x8d, 0xed, 0x7d, 0xc3, 0x35, 0x79, 0x80, 0x93, 0xef,
-	0xc3, 0x79, 0x28, 0x7b, 0x4e, 0xd7, 0x6d, 0x60, 0x9d, 0x57, 0xee, 0xf2, 0xcd, 0x98, 0x62, 0xad,
-	0x9b, 0xac, 0x11, 0x2d, 0xc1, 0x18, 0xd1, 0x87, 0x19, 0xdc, 0x60, 0xc3, 0xda, 0x28, 0xfd, 0x5d,
-	0x37, 0x51, 0x0d, 0x4e, 0xd1, 0xd7, 0x62, 0x29, 0xf7, 0x09, 0x47, 0xc7, 0xa9, 0x4b, 0xb0, 0x98,
-	0x92, 0x85, 0xcb, 0xf9, 0xf7, 0xc3, 0x70, 0x9a, 0xf4, 0x05, 0x37, 0xe1, 0xeb, 0x34, 0x96, 0x0a,
-	0x8c, 0x06, 0x01, 0x25, 0x76, 0x56, 0x83, 0x9f, 0xe4, 0x28, 0x47, 0xaf, 0xd9, 0x30, 0x52, 0x10,
-	0x46, 0x16, 0x88, 0x4e, 0xd2, 0x61, 0xa4, 0xe1, 0x41, 0xc3, 0x48, 0x67, 0x01, 0x82, 0x57, 0x95,
-	0x65, 0xd2, 0x57, 0x68, 0x49, 0x1b, 0xe7, 0x2d, 0x75, 0x33, 0xf5, 0x56, 0x1f, 0x1d, 0xec, 0xad,
-	0x7e, 0x9f, 0x27, 0x6f, 0xa2, 0x67, 0x33, 0xe5, 0x32, 0x96, 0xcb, 0x65, 0x96, 0x90, 0x85, 0x0e,
-	0x30, 0xe5, 0x75, 0x0d, 0x46, 0x83, 0x37, 0xf7, 0x78, 0x81, 0x37, 0x77, 0x30, 0x38, 0x1e, 0x2f,
-	0x80, 0x64, 0xbc, 0xe0, 0x13, 0x98, 0x64, 0xa9, 0x25, 0x5e, 0xe4, 0x3b, 0x51, 0xa0, 0xc8, 0x77,
-	0x82, 0x66, 0x9c, 0x78, 0x7d, 0xef, 0xbb, 0x40, 0x6b, 0x74, 0x79, 0xc9, 0xba, 0x6e, 0x99, 0xd8,
-	0xf6, 0x2d, 0xbf, 0x47, 0x83, 0x79, 0xe3, 0x1a, 0x22, 0x7d, 0xcf, 0x69, 0x57, 0x9d, 0xf7, 0xa0,
-	0xc7, 0x30, 0xdd, 0x87, 0x0d, 0x3c, 0x70, 0x77, 0xa1, 0x18, 0x2a, 0x68, 0xe5, 0x24, 0x22, 0xa8,
-	0x0b, 0x30, 0x97, 0x34, 0x65, 0x6e, 0xe3, 0xbf, 0xa3, 0xc0, 0x72, 0x50, 0xb9, 0xf6, 0x15, 0x71,
-	0xe2, 0xd4, 0xdf, 0x52, 0xe0, 0x8c, 0x58, 0x26, 0xfe, 0xbe, 0xb9, 0x0a, 0x0b, 0x6d, 0xd6, 0xce,
-	0xf2, 0x2a, 0xba, 0x65, 0xeb, 0x0d, 0xa3, 0xb1, 0x8f, 0xb9, 0x84, 0xa7, 0xdb, 0x31, 0xaa, 0xba,
-	0xbd, 0x49, 0xba, 0xd0, 0x07, 0xb0, 0x94, 0x22, 0x32, 0x0d, 0xdf, 0xd8, 0x35, 0x3c, 0xcc, 0xdd,
-	0xe0, 0x85, 0x24, 0xdd, 0x16, 0xef, 0x55, 0xcf, 0x40, 0x35, 0x90, 0x87, 0xeb, 0xf3, 0x33, 0x27,
-	0x2c, 0x3d, 0x52, 0x7f, 0x6d, 0x28, 0x52, 0x61, 0xa2, 0x9b, 0x4b, 0xbb, 0x0e, 0x33, 0x76, 0xb7,
-	0xbd, 0x8b, 0x5d, 0xdd, 0x69, 0xea, 0x14, 0xa6, 0x3c, 0x2a, 0xe7, 0xb0, 0x56, 0x66, 0xed, 0x8f,
-	0x9b, 0x14, 0x7d, 0x3c, 0xa2, 0xec, 0x00, 0xd6, 0x3c, 0x1a, 0x3d, 0x18, 0xd6, 0xc6, 0x38, 0xae,
-	0x79, 0xe8, 0x3e, 0x4c, 0xf2, 0x9d, 0x60, 0x4b, 0x65, 0x00, 0x77, 0x31, 0xcb, 0x1e, 0x58, 0x3c,
-	0x87, 0x2e, 0x9d, 0xfa, 0x77, 0x13, 0x66, 0xd4, 0x80, 0xae, 0xc1, 0x22, 0x9b, 0xa8, 0xe1, 0xd8,
-	0xbe, 0xeb, 0xb4, 0x5a, 0xd8, 0xa5, 0x4a, 0xe9, 0xb2, 0xbb, 0x62, 0x5c, 0x9b, 0xa7, 0xdd, 0x9b,
-	0x61, 0x2f, 0x43, 0x46, 0x7a, 0x46, 0x4c, 0xd3, 0xc5, 0x9e, 0xc7, 0x83, 0x8e, 0xc1, 0x4f, 0xb5,
-	0x06, 0xb3, 0x2c, 0x35, 0x45, 0xe8, 0x02, 0xe3, 0x89, 0xc3, 0xb4, 0x92, 0x80, 0x69, 0x75, 0x0e,
-	0x50, 0x7c, 0x3c, 0xb7, 0xc6, 0xff, 0x50, 0x60, 0x96, 0x39, 0xe8, 0x71, 0x4f, 0x30, 0x9b, 0x0d,
-	0xfa, 0x98, 0xa7, 0x71, 0xc3, 0xac, 0x75, 0x79, 0x63, 0x35, 0x33, 0x47, 0x60, 0x78, 0x07, 0x34,
-	0x34, 0x46, 0x33, 0xb9, 0x34, 0x2c, 0x16, 0x0b, 0xb0, 0x96, 0x12, 0x01, 0xd6, 0x4d, 0x98, 0x3e,
-	0xb4, 0x3c, 0x6b, 0xd7, 0x6a, 0x59, 0x7e, 0x8f, 0x81, 0x51, 0x7e, 0x4c, 0xb0, 0x1c, 0x91, 0x50,
-	0x24, 0x5a, 0x83, 0x49, 0x7e, 0x8b, 0xe9, 0xb6, 0xc1, 0x41, 0x77, 0x5c, 0x9b, 0xe0, 0x6d, 0x8f,
-	0x8c, 0x36, 0x26, 0x6a, 0x88, 0xaf, 0x37, 0x7a, 0xd3, 0xce, 0xd2, 0x3a, 0xb5, 0xa7, 0x5d, 0xdc,
-	0xc5, 0x05, 0xd4, 0xd0, 0x3f, 0xd3, 0x50, 0x6a, 0xa6, 0xa4, 0xa6, 0x4a, 0x83, 0x6a, 0x8a, 0x09,
-	0x1a, 0x49, 0xc4, 0x05, 0xfd, 0x3d, 0x05, 0xe6, 0x02, 0xd3, 0xff, 0xea, 0xc8, 0xfa, 0x18, 0xe6,
-	0xfb, 0x84, 0xe2, 0x27, 0xf1, 0x1a, 0x2c, 0x76, 0x5c, 0xa7, 0x81, 0x3d, 0xcf, 0xb2, 0xf7, 0x74,
-	0xfa, 0x3d, 0x0f, 0xc3, 0x02, 0x72, 0x20, 0x4b, 0xc4, 0xec, 0xa3, 0x6e, 0x4a, 0x49, 0x81, 0xc0,
-	0x53, 0xbf, 0xab, 0xc0, 0xd9, 0x7b, 0xd8, 0xd7, 0xa2, 0x8f, 0x7b, 0x1e, 0x62, 0xcf, 0x33, 0xf6,
-	0x70, 0xe8, 0xb7, 0x7c, 0x0a, 0x23, 0x34, 0x89, 0xc3, 0x18, 0x49, 0x52, 0xd1, 0x31, 0x1e, 0x34,
-	0xc5, 0xa3, 0x71, 0xba, 0x02, 0x6a, 0x21, 0x40, 0xb3, 0x92, 0x25, 0x06, 0x5f, 0xe1, 0x4b, 0x28,
-	0x33, 0xbd, 0xb7, 0x79, 0x0f, 0x97, 0xe7, 0x7e, 0x66, 0x10, 0x52, 0xce, 0xb0, 0x46, 0xcf, 0x67,
-	0xd0, 0xca, 0x02, 0x8e, 0x53, 0x5e, 0xbc, 0xad, 0xda, 0x06, 0x94, 0x1e, 0x14, 0x0f, 0x2a, 0x0e,
-	0xb3, 0xa0, 0xe2, 0xad, 0x64, 0x50, 0xf1, 0xad, 0x02, 0x1a, 0x0a, 0xa5, 0x89, 0x45, 0x14, 0x6d,
-	0x58, 0xbd, 0x87, 0xfd, 0xad, 0x07, 0x4f, 0x25, 0xbb, 0x71, 0x1f, 0x80, 0x1d, 0x6b, 0xbb, 0xe9,
-	0x04, 0x1a, 0x28, 0x32, 0x1f, 0xb1, 0x25, 0x0a, 0x96, 0xd4, 0xfc, 0xc8, 0x5f, 0x9e, 0xda, 0x83,
-	0x35, 0xc9, 0x7c, 0x5c, 0xed, 0x3b, 0x30, 0x1b, 0xfb, 0xf2, 0x8b, 0xe6, 0x14, 0x83, 0x79, 0x2f,
-	0x16, 0x9c, 0x57, 0x9b, 0x71, 0x93, 0x0d, 0x9e, 0xfa, 0x4f, 0x0a, 0xcc, 0x69, 0xd8, 0xe8, 0x74,
-	0x5a, 0xec, 0xf1, 0x13, 0xae, 0x6f, 0x01, 0x46, 0x78, 0x10, 0x9f, 0xdd, 0x77, 0xfc, 0x97, 0xbc,
-	0xe8, 0x5f, 0x7c, 0x59, 0x97, 0x4e, 0xea, 0x98, 0x1e, 0xef, 0x95, 0xa1, 0x2e, 0xc2, 0x7c, 0xdf,
-	0xd2, 0x38, 0xa4, 0xfc, 0x99, 0x02, 0xcb, 0x1a, 0x6e, 0xba, 0xd8, 0xdb, 0x0f, 0xf3, 0x19, 0x44,
-	0x1b, 0x5f, 0xc1, 0xb5, 0xab, 0x2b, 0x70, 0x46, 0x2c, 0x2a, 0x5f, 0xcb, 0x17, 0x43, 0xb0, 0xa0,
-	0x61, 0xc3, 0xdc, 0x7a, 0xf0, 0xb4, 0xdf, 0x44, 0xaf, 0xc2, 0xa9, 0xb0, 0x8e, 0xa0, 0xbc, 0x71,
-	0x2e, 0xf3, 0x16, 0x7f, 0xf0, 0x94, 0x82, 0x1b, 0x1d, 0x2c, 0x7b, 0xf6, 0xa4, 0x1f, 0x4e, 0x25,
-	0xd1, 0xc3, 0x69, 0x07, 0x2a, 0x96, 0x4d, 0x46, 0x58, 0x87, 0x58, 0xc7, 0x76, 0x88, 0x13, 0x05,
-	0x8b, 0xaf, 0xe6, 0x43, 0xe2, 0x3b, 0x76, 0x70, 0xe0, 0xeb, 0x26, 0xd1, 0x7d, 0x87, 0x30, 0xf1,
-	0xac, 0xcf, 0xd9, 0x2d, 0x37, 0xac, 0x8d, 0x91, 0x86, 0x6d, 0xeb, 0x73, 0x8c, 0x2e, 0xc0, 0x34,
-	0x2d, 0x21, 0xa0, 0x23, 0x58, 0xa6, 0x7b, 0x84, 0x66, 0xba, 0x69, 0x65, 0xc1, 0x13, 0x63, 0x0f,
-	0xb3, 0xc2, 0xb7, 0xbf, 0x18, 0x82, 0xc5, 0x94, 0xb2, 0x42, 0x87, 0xef, 0x18, 0xda, 0x12, 0x1e,
-	0xca, 0xa1, 0x13, 0x1e, 0x4a, 0x64, 0xc0, 0x42, 0x8a, 0x6b, 0x10, 0x73, 0x1b, 0x18, 0x67, 0xe6,
-	0xfa, 0xd9, 0xd3, 0x98, 0x9b, 0x40, 0x63, 0xa7, 0x44, 0x1a, 0xfb, 0x57, 0x05, 0x16, 0x9f, 0x74,
-	0xdd, 0x3d, 0xfc, 0x35, 0xb7, 0x2f, 0xb5, 0x0a, 0x95, 0xf4, 0x3a, 0xf9, 0x19, 0xfb, 0xf3, 0x21,
-	0x58, 0x7c, 0x88, 0xbf, 0xfe, 0x4a, 0xf8, 0xbf, 0x39, 0x64, 0xb7, 0xa1, 0x92, 0x56, 0x16, 0x3f,
-	0x64, 0x02, 0x1e, 0x8a, 0x88, 0xc7, 0xaf, 0x2a, 0x70, 0xe6, 0x91, 0xe3, 0x5b, 0xcd, 0x1e, 0x79,
-	0xdf, 0x3a, 0x87, 0xd8, 0x7d, 0x68, 0x90, 0xc7, 0x6b, 0xa8, 0x76, 0x03, 0x16, 0x9a, 0xbc, 0x47,
-	0x6f, 0xd3, 0x2e, 0x3d, 0xe1, 0x1c, 0x65, 0x1e, 0x91, 0x24, 0x3f, 0xe6, 0x1f, 0xcd, 0x35, 0xd3,
-	0x8d, 0x9e, 0x7a, 0x0e, 0xce, 0x66, 0x88, 0xc0, 0xcd, 0xc2, 0x80, 0xe5, 0x7b, 0xd8, 0xdf, 0x74,
-	0x1d, 0xcf, 0xe3, 0xdb, 0x92, 0xb8, 0x45, 0x12, 0x2f, 0x2d, 0xa5, 0xef, 0xa5, 0x75, 0x1e, 0xca,
-	0xbe, 0xe1, 0xee, 0x61, 0x3f, 0xdc, 0x66, 0x76, 0x9f, 0x4c, 0xb1, 0x56, 0xce, 0x4f, 0xfd, 0xef,
-	0x12, 0x9c, 0x11, 0xcf, 0xc1, 0x15, 0xda, 0x26, 0x7c, 0x08, 0x3c, 0xec, 0xf6, 0xd8, 0xbb, 0x8f,
-	0xaf, 0xff, 0x9e, 0xcc, 0x19, 0xcb, 0x64, 0x47, 0x3d, 0x5d, 0xef, 0x76, 0x8f, 0x3a, 0x5b, 0xcc,
-	0x13, 0x9b, 0xf4, 0x63, 0x4d, 0xe8, 0x3b, 0x0a, 0xcc, 0x37, 0x69, 0x92, 0x49, 0x6f, 0x18, 0x5d,
-	0x0f, 0x47, 0xd3, 0x32, 0xd0, 0x7b, 0x78, 0xbc, 0x69, 0x59, 0xde, 0x6a, 0x93, 0x70, 0x4c, 0x4c,
-	0x8e, 0x9a, 0xa9, 0x8e, 0xea, 0x4b, 0x98, 0x4d, 0x49, 0x29, 0x70, 0x05, 0xef, 0x26, 0x5d, 0xc1,
-	0x77, 0xb3, 0xec, 0xa1, 0x5f, 0x28, 0xbe, 0x7b, 0x71, 0x7f, 0xb0, 0xfa, 0x12, 0x16, 0x33, 0x24,
-	0x14, 0x4c, 0xfc, 0x69, 0x7c, 0xe2, 0x72, 0x76, 0x88, 0xf5, 0x1e, 0xf6, 0xa3, 0x94, 0x1d, 0x65,
-	0x1c, 0x77, 0x41, 0xff, 0x5d, 0x81, 0x75, 0x9e, 0x24, 0x4b, 0xa9, 0x2d, 0x15, 0xdd, 0x97, 0xbc,
-	0x84, 0x8a, 0xd9, 0x19, 0x7a, 0xce, 0xcc, 0x28, 0xac, 0x66, 0x08, 0x02, 0xc4, 0x03, 0xa8, 0x8d,
-	0x17, 0x31, 0x4c, 0xf9, 0xb1, 0x5f, 0x1e, 0x7a, 0x03, 0xa6, 0x9a, 0xd8, 0x6f, 0xec, 0x3f, 0xc2,
-	0xcc, 0x6f, 0xe1, 0x59, 0x9d, 0x64, 0xa3, 0xea, 0xc1, 0x9b, 0x05, 0x16, 0x1b, 0xd6, 0x49, 0x0e,
-	0x07, 0xce, 0xef, 0x31, 0x77, 0x96, 0x92, 0xab, 0xef, 0xd3, 0x0f, 0xb1, 0x82, 0xc3, 0x4d, 0xef,
-	0xca, 0x02, 0x01, 0x29, 0xd5, 0xa7, 0x5f, 0x32, 0x25, 0xc9, 0xb8, 0x64, 0x1b, 0x30, 0x1f, 0xa5,
-	0x33, 0x82, 0xe0, 0x47, 0x97, 0xd7, 0x27, 0x0d, 0x6b, 0x51, 0xae, 0x63, 0x9b, 0x45, 0x3e, 0xba,
-	0x36, 0x8d, 0x46, 0x07, 0x9f, 0x0a, 0xf2, 0xb8, 0x0d, 0x0b, 0xca, 0x4c, 0xf1, 0x56, 0x16, 0xb6,
-	0xd9, 0xf8, 0x87, 0xb7, 0x01, 0x78, 0xe0, 0xe7, 0xd6, 0x93, 0x3a, 0xfa, 0x9e, 0x02, 0x0b, 0xe2,
-	0x4f, 0x9e, 0xd1, 0xb5, 0xcc, 0x23, 0x28, 0xfd, 0xe8, 0xba, 0x7a, 0x7d, 0x60, 0x3a, 0xbe, 0xea,
-	0xdf, 0x54, 0x60, 0x31, 0xe3, 0x63, 0x72, 0x24, 0x61, 0x2a, 0xfd, 0xbc, 0xbe, 0x7a, 0x63, 0x70,
-	0x42, 0x2e, 0xce, 0x4f, 0x14, 0x58, 0xcd, 0xfb, 0x2e, 0x1c, 0x7d, 0x9a, 0xc7, 0x3e, 0xef, 0x1b,
-	0xf5, 0xea, 0xad, 0x13, 0x70, 0xe0, 0x92, 0x92, 0x4d, 0x14, 0x7f, 0xf1, 0x2d, 0xd9, 0x44, 0xe9,
-	0x97, 0xe6, 0x92, 0x4d, 0xcc, 0xf9, 0xb4, 0xfc, 0xf7, 0x15, 0xa8, 0x66, 0x7f, 0x17, 0x8d, 0xb2,
-	0xcb, 0x9a, 0x72, 0xbf, 0x17, 0xaf, 0x7e, 0x78, 0x2c, 0x5a, 0x2e, 0xd7, 0xef, 0x2a, 0xb0, 0x94,
-	0xf9, 0xd5, 0x33, 0xfa, 0x20, 0x93, 0x75, 0xde, 0x47, 0xd7, 0xd5, 0x9b, 0xc7, 0x21, 0xe5, 0x42,
-	0xd9, 0x30, 0x95, 0xf8, 0x1c, 0x16, 0xbd, 0x93, 0xc9, 0x4c, 0xf4, 0xd5, 0x6d, 0xb5, 0x56, 0x74,
-	0x38, 0x9f, 0xef, 0x3b, 0x0a, 0x9c, 0x16, 0x7c, 0x53, 0x8a, 0xae, 0xca, 0x77, 0x5b, 0xf8, 0x15,
-	0x6b, 0xf5, 0xbd, 0xc1, 0x88, 0xb8, 0x08, 0x3e, 0x4c, 0xf7, 0x7d, 0xbf, 0x89, 0x2e, 0xcb, 0xee,
-	0x7a, 0x41, 0x9c, 0xbf, 0xfa, 0x6e, 0x71, 0x02, 0x3e, 0xeb, 0x11, 0xcc, 0xf4, 0x7f, 0xa7, 0x84,
-	0xb2, 0xb9, 0x64, 0x7c, 0xc9, 0x55, 0xbd, 0x32, 0x00, 0x45, 0xcc, 0xec, 0x32, 0x0b, 0xf6, 0x24,
-	0x66, 0x97, 0xf7, 0xad, 0x44, 0xf5, 0x04, 0xf5, 0x81, 0xe8, 0x8f, 0x14, 0x38, 0x23, 0xab, 0xe7,
-	0x43, 0x1f, 0x1d, 0xb3, 0x0c, 0x90, 0x89, 0xf6, 0xf1, 0x89, 0x8a, 0x08, 0xb9, 0xca, 0x32, 0x8a,
-	0xde, 0xa4, 0x2a, 0x93, 0x97, 0xdc, 0x49, 0x55, 0x96, 0x53, 0x63, 0x17, 0xdb, 0x47, 0x41, 0x45,
-	0x71, 0xee, 0x3e, 0x66, 0xd7, 0x72, 0xe7, 0xee, 0xa3, 0xac, 0x80, 0x39, 0xb6, 0x8f, 0xc2, 0xba,
-	0xb3, 0xfc, 0x7d, 0x94, 0xd5, 0xbe, 0xe5, 0xef, 0xa3, 0xb4, 0xd8, 0x2d, 0xbe, 0x8f, 0xe9, 0xd2,
-	0xb2, 0xfc, 0x7d, 0xcc, 0x2c, 0x6c, 0xcb, 0xdf, 0xc7, 0xec, 0x4a, 0x36, 0xf4, 0x87, 0x34, 0x62,
-	0x97, 0x59, 0x33, 0x86, 0x3e, 0x1c, 0x68, 0xcd, 0xc9, 0xaa, 0xb5, 0xea, 0x47, 0xc7, 0x23, 0x4e,
-	0x88, 0x96, 0x59, 0x30, 0x29, 0x15, 0x2d, 0xaf, 0x64, 0x53, 0x2a, 0x5a, 0x7e, 0x8d, 0xe6, 0x1f,
-	0x2b, 0xb0, 0x22, 0xaf, 0x94, 0x42, 0xdf, 0x96, 0x4c, 0x50, 0xa0, 0x5c, 0xac, 0xfa, 0xc9, 0xb1,
-	0xe9, 0xb9, 0x8c, 0xbf, 0xad, 0x40, 0x25, 0xab, 0x5e, 0x0e, 0xdd, 0x90, 0x70, 0x97, 0x16, 0x06,
-	0x56, 0x3f, 0x38, 0x06, 0x25, 0x97, 0xe8, 0xd7, 0x15, 0x98, 0x13, 0x55, 0x5d, 0xa1, 0xec, 0x9b,
-	0x53, 0x52, 0x63, 0x56, 0x7d, 0x7f, 0x40, 0x2a, 0x2e, 0xc5, 0x8f, 0xe8, 0xbf, 0x26, 0x92, 0x14,
-	0x1d, 0xa1, 0x8f, 0x73, 0x6c, 0x43, 0x5e, 0x12, 0x56, 0xfd, 0xf6, 0x71, 0xc9, 0xb9, 0x80, 0x9f,
-	0xc3, 0x6c, 0xaa, 0xfc, 0x06, 0x5d, 0x91, 0x30, 0x15, 0x97, 0x45, 0x55, 0x37, 0x06, 0x21, 0x89,
-	0xbc, 0x91, 0xbe, 0x82, 0x1a, 0x89, 0x37, 0x22, 0x2e, 0x03, 0x92, 0x78, 0x23, 0x19, 0xb5, 0x3a,
-	0xe8, 0x00, 0x26, 0xe3, 0xf5, 0x0d, 0xe8, 0x6d, 0x29, 0x87, 0xbe, 0x8a, 0x9e, 0xea, 0x3b, 0x05,
-	0x47, 0xc7, 0xac, 0x50, 0x54, 0xa0, 0x20, 0xb1, 0x42, 0x49, 0x8d, 0x85, 0xc4, 0x0a, 0xa5, 0x55,
-	0x10, 0xc4, 0xf3, 0x14, 0xd4, 0x1d, 0x48, 0x3c, 0xcf, 0xec, 0x22, 0x86, 0xea, 0x7b, 0x83, 0x11,
-	0x85, 0xdf, 0x5a, 0x40, 0x94, 0xc5, 0x47, 0x97, 0x32, 0x79, 0xa4, 0x4a, 0x03, 0xaa, 0x6f, 0x15,
-	0x1a, 0x1b, 0x4d, 0x13, 0x65, 0xc9, 0x25, 0xd3, 0xa4, 0x4a, 0x07, 0x24, 0xd3, 0xa4, 0xd3, 0xee,
-	0x6c, 0x9a, 0x20, 0xc7, 0x2d, 0x9d, 0xa6, 0x2f, 0x35, 0x2f, 0x9d, 0xa6, 0x3f, 0x69, 0x4e, 0x5e,
-	0x28, 0x89, 0xf4, 0xb4, 0xe4, 0x85, 0x22, 0xca, 0xad, 0x4b, 0x5e, 0x28, 0xe2, 0xac, 0xf7, 0xf7,
-	0xd8, 0x7f, 0xb5, 0x11, 0xe4, 0x2f, 0x25, 0x4f, 0x59, 0x69, 0xba, 0x5b, 0xf2, 0x94, 0xcd, 0xc9,
-	0x4f, 0x13, 0x07, 0x26, 0x33, 0x9d, 0x2a, 0x71, 0x60, 0xf2, 0x52, 0xbe, 0x12, 0x07, 0x26, 0x3f,
-	0x7b, 0x6b, 0xc3, 0x54, 0x22, 0x17, 0x29, 0xd9, 0x10, 0x51, 0x3a, 0x56, 0xb2, 0x21, 0xc2, 0x14,
-	0x27, 0x85, 0x0f, 0x51, 0xde, 0x10, 0xc9, 0x9e, 0x7f, 0x99, 0x19, 0x51, 0x09, 0x7c, 0xc8, 0x92,
-	0x93, 0x04, 0xa7, 0xfb, 0xd2, 0x6d, 0x12, 0x9c, 0x16, 0x67, 0x31, 0x25, 0x38, 0x9d, 0x95, 0xc9,
-	0x23, 0xaf, 0xc6, 0xbe, 0x54, 0x8e, 0xec, 0xd5, 0x28, 0xce, 0x6e, 0xc9, 0x5e, 0x8d, 0x19, 0x79,
-	0x22, 0x32, 0x71, 0x7f, 0xe6, 0x43, 0x32, 0x71, 0x46, 0x46, 0x49, 0x32, 0x71, 0x66, 0x5a, 0xe5,
-	0x37, 0x14, 0x98, 0x17, 0xe6, 0x2a, 0x50, 0xf6, 0xc6, 0xc9, 0xd2, 0x2b, 0xd5, 0x6b, 0x83, 0x92,
-	0xc5, 0xcc, 0x4e, 0x14, 0xe9, 0x97, 0x98, 0x9d, 0x24, 0x85, 0x22, 0x31, 0x3b, 0x69, 0x52, 0xe4,
-	0x0b, 0x25, 0xfc, 0x3a, 0x26, 0x3b, 0x9e, 0x8c, 0x6e, 0xe5, 0xb9, 0xfd, 0xb9, 0x81, 0xf7, 0xea,
-	0xed, 0x93, 0xb0, 0x48, 0x44, 0x56, 0xe2, 0xf1, 0x64, 0x79, 0x64, 0x45, 0x10, 0xb0, 0x96, 0x47,
-	0x56, 0x44, 0xa1, 0xea, 0xdb, 0x77, 0xfe, 0xee, 0xcb, 0x15, 0xe5, 0x1f, 0xbf, 0x5c, 0x51, 0xfe,
-	0xe5, 0xcb, 0x15, 0xe5, 0x17, 0xaf, 0xef, 0x59, 0xfe, 0x7e, 0x77, 0xb7, 0xd6, 0x70, 0xda, 0x97,
-	0x13, 0xff, 0x4b, 0xb8, 0xb6, 0x87, 0x6d, 0xf6, 0x4f, 0xa1, 0x63, 0xff, 0x95, 0xfa, 0x43, 0xfe,
-	0xe7, 0xe1, 0x95, 0xdd, 0x11, 0xda, 0x77, 0xf5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xed,
-	0x19, 0x44, 0xc1, 0x5a, 0x00, 0x00,
+	// 4731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x3c, 0x4b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xe8, 0x19, 0xf1, 0xf7, 0x48, 0x0e, 0xc9, 0x16, 0x3f, 0xc3, 0xa1, 0xbe, 0x6d, 0x7d, 0xb8,
+	0x5a, 0x6b, 0xb8, 0xa2, 0x76, 0xb5, 0x5a, 0xed, 0xae, 0x77, 0x29, 0x52, 0xd2, 0x72, 0xa1, 0x6f,
+	0x93, 0x91, 0xec, 0x20, 0xd0, 0xa0, 0x39, 0x53, 0x43, 0x76, 0x34, 0x9c, 0x9e, 0x9d, 0xee, 0xa1,
+	0x34, 0x3e, 0x04, 0xf9, 0x21, 0xc0, 0x1a, 0x86, 0x9d, 0x8f, 0x6d, 0x04, 0x08, 0x60, 0xc0, 0xb0,
+	0x01, 0x3b, 0x9b, 0x43, 0x0e, 0xf1, 0xcd, 0xc8, 0xc9, 0x97, 0xe4, 0x96, 0x6b, 0x4e, 0x09, 0x02,
+	0x23, 0x87, 0x18, 0xf0, 0x25, 0x41, 0x8e, 0x86, 0xeb, 0xdb, 0xdf, 0xea, 0xea, 0x1e, 0x32, 0x80,
+	0xe4, 0xcd, 0x1e, 0x08, 0xb0, 0xab, 0xea, 0xbd, 0x7a, 0xf5, 0xea, 0xbd, 0x57, 0xaf, 0xde, 0x7b,
+	0x35, 0x70, 0xbe, 0xb7, 0x83, 0xba, 0x2b, 0x75, 0xab, 0x81, 0xda, 0x75, 0xb4, 0xb2, 0x67, 0xbb,
+	0x9e, 0xd3, 0xed, 0xaf, 0x1c, 0x5c, 0x59, 0x71, 0x51, 0xf7, 0xc0, 0xae, 0xa3, 0x6a, 0xa7, 0xeb,
+	0x78, 0x8e, 0xbe, 0x40, 0x86, 0x55, 0xf9, 0xb0, 0x2a, 0x1f, 0x56, 0x3d, 0xb8, 0x52, 0x39, 0xb5,
+	0xeb, 0x38, 0xbb, 0x2d, 0xb4, 0x42, 0x87, 0xed, 0xf4, 0x9a, 0x2b, 0x8d, 0x5e, 0xd7, 0xf2, 0x6c,
+	0xa7, 0xcd, 0x00, 0x2b, 0xa7, 0xe3, 0xfd, 0x9e, 0xbd, 0x8f, 0x5c, 0xcf, 0xda, 0xef, 0xf0, 0x01,
+	0x09, 0x04, 0xcf, 0xbb, 0x56, 0xa7, 0x83, 0xba, 0x2e, 0xef, 0x3f, 0x13, 0x21, 0xd0, 0xea, 0xd8,
+	0x84, 0xb8, 0xba, 0xb3, 0xbf, 0xef, 0x4f, 0x71, 0x56, 0x36, 0x42, 0x90, 0xc8, 0xa9, 0x90, 0x0d,
+	0xf9, 0xa4, 0x87, 0xfc, 0x01, 0x86, 0x6c, 0x80, 0x67, 0xb9, 0xcf, 0x5a, 0x18, 0x8f, 0x6a, 0xcc,
+	0x73, 0xa7, 0xfb, 0xac, 0xd9, 0x72, 0x9e, 0xf3, 0x31, 0x97, 0x64, 0x63, 0x38, 0x2b, 0x6b, 0xb1,
+	0xb1, 0xcb, 0x59, 0x63, 0x31, 0xc7, 0xd9, 0xc8, 0x73, 0x91, 0x91, 0xee, 0x9e, 0xd5, 0x45, 0x0d,
+	0xca, 0x86, 0x56, 0xcf, 0xf5, 0x32, 0x47, 0x45, 0x59, 0x61, 0xa4, 0x8c, 0xc2, 0xdc, 0xe8, 0x21,
+	0x29, 0x65, 0xc1, 0x98, 0x2e, 0xea, 0xb4, 0xec, 0x7a, 0x78, 0x7b, 0xcf, 0xa7, 0x8c, 0x8c, 0x2e,
+	0xd5, 0xf8, 0xc9, 0x10, 0x9c, 0xdc, 0xf2, 0xac, 0xae, 0xf7, 0x84, 0xb7, 0xdf, 0x7a, 0x81, 0xea,
+	0x3d, 0x82, 0xc7, 0x44, 0x78, 0x62, 0xd7, 0xd3, 0xef, 0xc2, 0x48, 0x97, 0xfd, 0x5b, 0xd6, 0xce,
+	0x68, 0xcb, 0xe3, 0xab, 0xab, 0xd5, 0x88, 0xc8, 0x61, 0xf6, 0x60, 0x71, 0xab, 0x2a, 0x91, 0x98,
+	0x02, 0x85, 0xbe, 0x04, 0x63, 0x0d, 0x67, 0xdf, 0xb2, 0xdb, 0x35, 0xbb, 0x51, 0x2e, 0x60, 0x7c,
+	0x63, 0xe6, 0x28, 0x6b, 0xd8, 0x6c, 0xe8, 0xbf, 0x07, 0x73, 0x1d, 0x4c, 0x67, 0xdb, 0xab, 0x21,
+	0x81, 0xa0, 0x66, 0xb7, 0x9b, 0x4e, 0xb9, 0x48, 0x27, 0x5e, 0x96, 0x4e, 0xfc, 0x90, 0x42, 0xf8,
+	0x33, 0x6e, 0xe2, 0xf1, 0xe6, 0xf1, 0x4e, 0xb2, 0x51, 0x2f, 0xc3, 0x88, 0xe5, 0x79, 0x68, 0xbf,
+	0xe3, 0x95, 0x8f, 0x61, 0x7c, 0x43, 0xa6, 0xf8, 0xd4, 0xd7, 0x61, 0x0a, 0xbd, 0xe8, 0xd8, 0x4c,
+	0x3d, 0x6a, 0x44, 0x0f, 0xca, 0x43, 0x74, 0xc6, 0x4a, 0x95, 0xe9, 0x40, 0x55, 0xe8, 0x40, 0x75,
+	0x5b, 0x28, 0x89, 0x59, 0x0a, 0x40, 0x48, 0xa3, 0xde, 0x84, 0xc5, 0xba, 0xd3, 0xf6, 0xec, 0x76,
+	0x0f, 0xd5, 0x2c, 0xb7, 0xd6, 0x46, 0xcf, 0x31, 0xed, 0xb6, 0x67, 0x5b, 0x78, 0x8b, 0xcb, 0xc3,
+	0x18, 0x5d, 0x69, 0xf5, 0x75, 0xe9, 0x02, 0xd6, 0x39, 0xd4, 0x9a, 0x7b, 0x1f, 0x3d, 0xdf, 0x14,
+	0x20, 0xe6, 0x7c, 0x5d, 0xda, 0xae, 0x6f, 0xc2, 0x8c, 0xe8, 0x69, 0xd4, 0x9a, 0x96, 0xdd, 0xea,
+	0x75, 0x51, 0x79, 0x84, 0x92, 0x7b, 0x42, 0x8a, 0xff, 0x36, 0x1b, 0x63, 0x4e, 0xfb, 0x60, 0xbc,
+	0x45, 0x37, 0x61, 0xbe, 0x65, 0xb9, 0x5e, 0x0d, 0x2b, 0x6d, 0xa7, 0x85, 0xe8, 0xe2, 0xbb, 0xc8,
+	0xed, 0xb5, 0xbc, 0xf2, 0xa8, 0x02, 0xdf, 0x43, 0xab, 0xdf, 0x72, 0xac, 0x86, 0x39, 0x4b, 0x60,
+	0xd7, 0x7d, 0x50, 0x93, 0x42, 0xea, 0x5f, 0x85, 0xa5, 0xa6, 0xdd, 0xc5, 0x48, 0x1b, 0xa8, 0x6e,
+	0xbb, 0x94, 0x9f, 0x58, 0x59, 0x6b, 0x3b, 0x56, 0xfd, 0x99, 0xd3, 0x6c, 0x96, 0xc7, 0x28, 0xe2,
+	0xc5, 0x04, 0x5f, 0x37, 0xb8, 0x71, 0x32, 0xcb, 0x14, 0x7a, 0x83, 0x03, 0x6f, 0x63, 0xd8, 0x9b,
+	0x0c, 0xd4, 0x78, 0x1b, 0x4e, 0xa5, 0x09, 0x99, 0xdb, 0x71, 0xda, 0x2e, 0xd2, 0xe7, 0x60, 0xb8,
+	0xdb, 0xa3, 0x92, 0xa5, 0x51, 0xc9, 0x1a, 0xc2, 0x5f, 0x9b, 0x0d, 0xe3, 0x47, 0x05, 0x0c, 0x69,
+	0xef, 0xb6, 0xad, 0x56, 0xaa, 0x90, 0xdf, 0x8b, 0x0b, 0xf9, 0x55, 0xb9, 0x90, 0x2b, 0xb1, 0xe4,
+	0x94, 0xf2, 0x26, 0x2c, 0xa1, 0x17, 0xd8, 0x38, 0x60, 0x4c, 0xbe, 0xe1, 0x09, 0x04, 0x9e, 0xcb,
+	0xfa, 0x05, 0xe9, 0xfc, 0xc9, 0x99, 0x17, 0x05, 0xaa, 0x44, 0x97, 0x5e, 0x85, 0xe3, 0xf5, 0x3d,
+	0xbb, 0xd5, 0x08, 0x26, 0x71, 0xda, 0xad, 0x3e, 0x95, 0xfd, 0x51, 0x73, 0x86, 0x76, 0x09, 0xa0,
+	0x07, 0xb8, 0xc3, 0x38, 0x0b, 0xa7, 0x53, 0xd7, 0xc7, 0x18, 0x6c, 0x7c, 0x5f, 0x83, 0x8b, 0x7c,
+	0x8c, 0xed, 0xed, 0xa9, 0xed, 0xc6, 0xe3, 0x38, 0x4b, 0xdf, 0x53, 0xb1, 0x34, 0x0b, 0x5d, 0x3e,
+	0xde, 0x1a, 0x6b, 0xb0, 0x9c, 0x8d, 0x50, 0x2d, 0x2d, 0xdf, 0xd0, 0xe0, 0x24, 0x1e, 0x83, 0x8e,
+	0x6c, 0x11, 0x95, 0x48, 0x72, 0xae, 0x07, 0xcb, 0x7c, 0x1a, 0x1a, 0xf5, 0x2a, 0x3e, 0x2b, 0xc0,
+	0xd9, 0x6d, 0xd4, 0xdd, 0xb7, 0xdb, 0x96, 0x87, 0x52, 0x57, 0xf2, 0x30, 0xbe, 0x92, 0x6b, 0xd2,
+	0x95, 0x64, 0x22, 0xfa, 0x2d, 0x97, 0xfc, 0x73, 0x60, 0xa8, 0x96, 0xc8, 0x85, 0xff, 0xcf, 0x35,
+	0x38, 0xb3, 0x81, 0xdc, 0x7a, 0xd7, 0xde, 0x49, 0xe7, 0xe8, 0x83, 0x38, 0x47, 0xdf, 0x92, 0x2e,
+	0x27, 0x0b, 0x4f, 0x4e, 0xf1, 0xf8, 0x75, 0x11, 0xce, 0x2a, 0x50, 0x71, 0x11, 0x69, 0xc1, 0x42,
+	0x70, 0x9e, 0xe2, 0x43, 0xa0, 0x69, 0xef, 0x72, 0x6b, 0xab, 0x34, 0x76, 0x09, 0x84, 0xeb, 0x61,
+	0x50, 0x73, 0x1e, 0x49, 0xdb, 0xf5, 0x1d, 0x58, 0x48, 0xee, 0x2d, 0x3b, 0xc6, 0x0b, 0x74, 0xb6,
+	0x4b, 0xf9, 0x66, 0xa3, 0x07, 0xf9, 0xdc, 0x73, 0x59, 0xb3, 0xfe, 0x04, 0xf4, 0x0e, 0x6a, 0x37,
+	0xec, 0xf6, 0x6e, 0xcd, 0xaa, 0x7b, 0xf6, 0x01, 0x3e, 0x1b, 0x91, 0x8b, 0xe5, 0xa7, 0x98, 0xee,
+	0x25, 0xb0, 0xe1, 0x6b, 0x6c, 0x74, 0x9f, 0x22, 0x9f, 0xe9, 0x44, 0x1a, 0x31, 0x0a, 0xfd, 0x6b,
+	0x30, 0x2d, 0x10, 0x53, 0x31, 0xc1, 0x5e, 0x04, 0x16, 0x1b, 0x82, 0xb6, 0xaa, 0x42, 0xbb, 0x4e,
+	0xc6, 0x46, 0x29, 0x9f, 0xea, 0x84, 0xba, 0x30, 0x1a, 0x7d, 0x2b, 0x40, 0x2d, 0x8e, 0x46, 0xee,
+	0x65, 0x28, 0x29, 0x16, 0x27, 0x61, 0x04, 0xa9, 0x68, 0x34, 0x5e, 0xc0, 0xec, 0x23, 0xe2, 0x2c,
+	0x0b, 0xee, 0x09, 0x31, 0x5c, 0x8f, 0x8b, 0xe1, 0x6b, 0xd2, 0x39, 0x64, 0xb0, 0x39, 0x45, 0xef,
+	0x87, 0x1a, 0xcc, 0xc5, 0xc0, 0xb9, 0xb8, 0x7d, 0x00, 0x13, 0xd4, 0x81, 0x17, 0xbe, 0x84, 0x96,
+	0xc3, 0x97, 0x18, 0xa7, 0x10, 0xdc, 0x85, 0xd8, 0x84, 0x92, 0x40, 0xf0, 0xfb, 0xa8, 0xee, 0xa1,
+	0x06, 0x17, 0x1c, 0x23, 0x7d, 0x0d, 0x26, 0x1f, 0x69, 0x4e, 0x7e, 0x12, 0xfe, 0x34, 0xfe, 0x54,
+	0x83, 0x0a, 0x35, 0xa0, 0x5b, 0x9e, 0x5d, 0x7f, 0xd6, 0x27, 0xee, 0xc4, 0x5d, 0xec, 0x74, 0x0b,
+	0x36, 0x6d, 0xc6, 0xd9, 0xb4, 0x92, 0x6e, 0xc9, 0xa5, 0x18, 0x72, 0x32, 0xeb, 0x24, 0x2c, 0x49,
+	0x71, 0x70, 0xcb, 0xf2, 0xdf, 0x1a, 0xcc, 0xdf, 0x41, 0xde, 0xbd, 0x9e, 0x67, 0xed, 0xb4, 0x10,
+	0x3e, 0xb6, 0x3c, 0x64, 0xca, 0xd0, 0x6a, 0x31, 0x7b, 0xfa, 0x3b, 0xa0, 0x4b, 0xcc, 0x68, 0x61,
+	0x20, 0x33, 0x3a, 0x93, 0xd0, 0x30, 0xfd, 0x2a, 0x60, 0xdd, 0xee, 0x50, 0x06, 0x62, 0x37, 0xf6,
+	0x05, 0xf6, 0xc6, 0x0f, 0x88, 0x4f, 0x8e, 0x09, 0x20, 0x16, 0xba, 0x68, 0x1e, 0x17, 0xbd, 0xf7,
+	0x71, 0xe7, 0x2d, 0xd2, 0x87, 0x69, 0x79, 0x03, 0x66, 0xeb, 0xbd, 0x2e, 0x75, 0xde, 0x77, 0xba,
+	0x56, 0xbb, 0xbe, 0x57, 0xf3, 0x9c, 0x67, 0x54, 0x7b, 0xb4, 0xe5, 0x09, 0x53, 0xe7, 0x7d, 0x37,
+	0x69, 0xd7, 0x36, 0xe9, 0x31, 0xbe, 0x33, 0x06, 0x0b, 0x89, 0x55, 0x73, 0x19, 0x92, 0xaf, 0x4c,
+	0x3b, 0xea, 0xca, 0x6e, 0xc3, 0xa4, 0x8f, 0xd6, 0xeb, 0x77, 0x10, 0xe7, 0xd5, 0x59, 0x25, 0xc6,
+	0x6d, 0x3c, 0xd0, 0x9c, 0x78, 0x1e, 0xfa, 0xd2, 0x0d, 0x98, 0x94, 0x31, 0x66, 0xbc, 0x1d, 0x62,
+	0xc8, 0x63, 0x58, 0xec, 0x74, 0xd1, 0x81, 0xed, 0xf4, 0xdc, 0x9a, 0x4b, 0x3c, 0x11, 0xcc, 0x4d,
+	0x7f, 0xfc, 0x31, 0x3a, 0xef, 0x52, 0xc2, 0x0d, 0xde, 0x6c, 0x7b, 0xd7, 0xde, 0x7c, 0x6c, 0xb5,
+	0x7a, 0xc8, 0x9c, 0x17, 0xd0, 0x5b, 0x0c, 0x58, 0xe0, 0xbd, 0x0c, 0xc7, 0xa9, 0xd3, 0xce, 0xbc,
+	0x6c, 0x1f, 0xe3, 0x10, 0xa5, 0x60, 0x9a, 0x74, 0xdd, 0x26, 0x3d, 0x62, 0xf8, 0x0d, 0x18, 0xa3,
+	0x0e, 0x38, 0xb9, 0x2e, 0xd3, 0x6b, 0xc8, 0xf8, 0xea, 0x49, 0xf9, 0x21, 0x2f, 0xa4, 0x72, 0xd4,
+	0xe3, 0xff, 0xe9, 0x77, 0x60, 0xda, 0xa5, 0x12, 0x5b, 0x0b, 0x50, 0x8c, 0xe4, 0x41, 0x51, 0x72,
+	0x23, 0x82, 0xae, 0xbf, 0x09, 0xf3, 0xf5, 0x96, 0x4d, 0x28, 0x6d, 0xd9, 0x58, 0x3a, 0xb0, 0x6a,
+	0x1f, 0xa0, 0x2e, 0xb5, 0x80, 0xa3, 0x54, 0xa4, 0x67, 0x59, 0xef, 0x5d, 0xd6, 0xf9, 0x98, 0xf5,
+	0x85, 0xa0, 0x9a, 0xc8, 0xf2, 0xf0, 0x85, 0xc5, 0x87, 0x1a, 0x0b, 0x43, 0xdd, 0x66, 0x9d, 0x02,
+	0xea, 0x34, 0x8c, 0x73, 0x28, 0x1b, 0x5f, 0x4d, 0xca, 0x40, 0x87, 0x02, 0x6b, 0xda, 0xc4, 0x2d,
+	0xba, 0x0b, 0x97, 0xe2, 0xab, 0xaa, 0xb9, 0xf5, 0x3d, 0xd4, 0xe8, 0xb5, 0x10, 0x16, 0x5a, 0xb6,
+	0x59, 0xf4, 0x16, 0xe8, 0xf4, 0xbc, 0xf2, 0x78, 0xd6, 0x85, 0xe5, 0x5c, 0x74, 0xad, 0x5b, 0x1c,
+	0xd3, 0xb6, 0x43, 0xf7, 0x6d, 0x9b, 0xa1, 0x21, 0x2e, 0x09, 0xdb, 0x2a, 0x72, 0xe3, 0x0f, 0x16,
+	0x32, 0x41, 0x2f, 0xa2, 0x33, 0xb4, 0x6b, 0x8b, 0xf4, 0x88, 0x55, 0xa4, 0xa9, 0xd3, 0x64, 0x9a,
+	0x3a, 0x61, 0xaf, 0xb4, 0xe4, 0xcb, 0xb6, 0x4b, 0x94, 0xa9, 0x5c, 0xa2, 0x97, 0xce, 0xf3, 0xd1,
+	0xad, 0x62, 0x91, 0x80, 0xb0, 0x7c, 0x33, 0xcd, 0xf3, 0x15, 0x83, 0x7e, 0xea, 0x75, 0x98, 0xf5,
+	0xb1, 0xd5, 0x5b, 0x8e, 0x8b, 0x38, 0xce, 0x29, 0x8a, 0xf3, 0x4a, 0x4e, 0x87, 0x81, 0x00, 0x12,
+	0x7c, 0x3d, 0xd7, 0xf4, 0xf5, 0xd9, 0x6f, 0x24, 0x5a, 0x3e, 0xc3, 0x19, 0x51, 0x63, 0xa1, 0x10,
+	0x72, 0x8a, 0x4f, 0xcb, 0xce, 0xc4, 0x80, 0x6a, 0xce, 0xa0, 0x8f, 0xc4, 0x78, 0x73, 0xfa, 0x20,
+	0xd6, 0xa2, 0xbf, 0x07, 0x4b, 0x36, 0xd1, 0xb9, 0xd8, 0x1e, 0xa3, 0x36, 0xb1, 0x33, 0x8d, 0xf2,
+	0x0c, 0x75, 0x03, 0x17, 0x6c, 0x37, 0x6a, 0x8d, 0x6f, 0xb1, 0x6e, 0xe3, 0x7f, 0x34, 0x58, 0x78,
+	0xe8, 0xb4, 0x5a, 0xff, 0xcf, 0xac, 0xf1, 0x8f, 0x47, 0xa1, 0x9c, 0x5c, 0xf6, 0x17, 0xe6, 0xf8,
+	0x0b, 0x73, 0xfc, 0x79, 0x34, 0xc7, 0x69, 0xfa, 0x31, 0x91, 0x6a, 0x5e, 0xa5, 0xb6, 0x6a, 0xf2,
+	0xc8, 0xb6, 0xea, 0xb7, 0xcf, 0x6a, 0x1b, 0x3f, 0x2f, 0xc0, 0x19, 0x13, 0xd5, 0x9d, 0x6e, 0x23,
+	0x1c, 0xa5, 0xe3, 0x6a, 0xf1, 0x32, 0x2d, 0x25, 0x16, 0x35, 0x5f, 0x70, 0x7c, 0x23, 0x00, 0xa2,
+	0x09, 0xcf, 0xbb, 0x00, 0x23, 0x54, 0xc6, 0xb8, 0xc6, 0x17, 0xcd, 0x61, 0xf2, 0x89, 0x3b, 0x4e,
+	0x02, 0x70, 0x3f, 0x5e, 0xe8, 0xee, 0x98, 0x39, 0xc6, 0x5b, 0x70, 0xb7, 0x09, 0x13, 0x1d, 0x6c,
+	0x1a, 0x6b, 0xe2, 0xae, 0x30, 0xac, 0xb8, 0x2b, 0x10, 0x1b, 0x7a, 0xdb, 0xe9, 0x86, 0x59, 0x23,
+	0xee, 0x0a, 0xe3, 0x04, 0x09, 0xff, 0x30, 0xfe, 0x6d, 0x04, 0xce, 0x2a, 0xb8, 0xc8, 0x0d, 0x6f,
+	0xc2, 0x42, 0x6a, 0x87, 0xb3, 0x90, 0x4a, 0xeb, 0x57, 0x38, 0xbc, 0xf5, 0xfb, 0x32, 0xe8, 0x82,
+	0xbf, 0x8d, 0xb8, 0xf9, 0x9d, 0xf6, 0x7b, 0xc4, 0xe8, 0x65, 0x62, 0xc0, 0x24, 0xa6, 0xb7, 0x48,
+	0x2c, 0x54, 0x04, 0x6f, 0xc2, 0xa2, 0x0f, 0x25, 0x2d, 0x7a, 0x28, 0x9e, 0x3f, 0x1c, 0x8d, 0xe7,
+	0x5f, 0x87, 0x32, 0x37, 0x29, 0x41, 0x00, 0x42, 0x9c, 0xfe, 0x23, 0xf4, 0xf4, 0x9f, 0x67, 0xfd,
+	0xbe, 0xec, 0xf0, 0xc3, 0x1f, 0xef, 0xf4, 0xa4, 0x1f, 0xb7, 0xa6, 0x21, 0x0b, 0x16, 0x08, 0xbf,
+	0x9c, 0xa6, 0x8d, 0xdb, 0xd8, 0x42, 0xb8, 0xc4, 0x94, 0x45, 0xae, 0xe9, 0x13, 0x8d, 0xd0, 0x97,
+	0xfe, 0x14, 0x4e, 0x48, 0x02, 0x22, 0x81, 0x09, 0x1f, 0xcb, 0x63, 0xc2, 0x17, 0x13, 0xe2, 0xee,
+	0x5b, 0xf3, 0x14, 0xd7, 0x12, 0xd2, 0x5c, 0xcb, 0xb3, 0x30, 0x11, 0xb1, 0x79, 0xe3, 0xd4, 0xe6,
+	0x8d, 0xef, 0x84, 0x8c, 0xdd, 0x1a, 0x94, 0x82, 0x6d, 0xa5, 0xf9, 0x90, 0x89, 0xcc, 0x7c, 0xc8,
+	0xa4, 0x0f, 0x41, 0xd3, 0x21, 0xef, 0xc3, 0x84, 0xd8, 0x6b, 0x8a, 0x60, 0x32, 0x13, 0xc1, 0x38,
+	0x1f, 0x4f, 0xc1, 0x2d, 0x18, 0x21, 0x37, 0x79, 0x62, 0x64, 0x4b, 0x34, 0xfe, 0x72, 0xa7, 0x9a,
+	0x92, 0xe8, 0xac, 0x66, 0x6a, 0x11, 0x0d, 0x11, 0x60, 0x4c, 0xb7, 0xda, 0x5e, 0xb7, 0x6f, 0x0a,
+	0xbc, 0x95, 0xa7, 0x30, 0x11, 0xee, 0xd0, 0xa7, 0xa1, 0xf8, 0x0c, 0xf5, 0xb9, 0xb1, 0x22, 0xff,
+	0x62, 0x39, 0x1a, 0x3a, 0x20, 0xe2, 0xaf, 0x8c, 0x3f, 0x08, 0xad, 0x63, 0x71, 0x08, 0x06, 0x70,
+	0xa3, 0x70, 0x5d, 0x0b, 0xd9, 0x49, 0x11, 0x75, 0xfa, 0xc2, 0x4e, 0x26, 0xec, 0x64, 0x98, 0x35,
+	0x52, 0x3b, 0xf9, 0x8b, 0xa2, 0xb0, 0x93, 0x52, 0x2e, 0x72, 0x3b, 0xf9, 0x31, 0x4c, 0xc5, 0xec,
+	0x90, 0xd2, 0x52, 0xb2, 0xf3, 0xb7, 0x4f, 0x2d, 0x89, 0x59, 0x8a, 0xda, 0xa9, 0x84, 0xe4, 0x16,
+	0x06, 0x93, 0xdc, 0x90, 0x59, 0x2a, 0x46, 0xcd, 0xd2, 0x53, 0x38, 0x15, 0xd5, 0xaa, 0x9a, 0xd3,
+	0xac, 0x79, 0x58, 0x92, 0x6b, 0xe1, 0xbc, 0xa4, 0x7a, 0xaa, 0x4a, 0x44, 0xcb, 0x1e, 0x34, 0xb7,
+	0x31, 0xf8, 0x1a, 0xc7, 0xbf, 0x09, 0x33, 0x7b, 0x08, 0x13, 0xb2, 0x83, 0x3d, 0xb0, 0x5a, 0x03,
+	0x79, 0x96, 0xdd, 0x72, 0x79, 0x88, 0x51, 0x1d, 0x7d, 0x9b, 0xf6, 0xc1, 0x36, 0x18, 0x54, 0xf2,
+	0xdc, 0x19, 0x3e, 0xdc, 0xb9, 0x73, 0x11, 0xa6, 0x7c, 0x3c, 0x4c, 0xac, 0xa9, 0x01, 0x1e, 0x33,
+	0x7d, 0xaf, 0x67, 0x83, 0xb6, 0x1a, 0xdf, 0xd3, 0xe0, 0x4b, 0x6c, 0x37, 0x23, 0x9a, 0xcc, 0xd3,
+	0x8b, 0x81, 0xbe, 0x98, 0xf1, 0x88, 0xdd, 0xf5, 0xb4, 0x88, 0x5d, 0x16, 0xaa, 0x9c, 0xa1, 0xbb,
+	0x7f, 0x28, 0xc2, 0x39, 0x35, 0x36, 0x2e, 0x82, 0x28, 0x38, 0xdc, 0xba, 0xbc, 0x8d, 0x93, 0x78,
+	0xe3, 0xf0, 0xa6, 0xcb, 0x9c, 0x72, 0x63, 0x92, 0xfe, 0x43, 0x0d, 0x4e, 0x05, 0x31, 0x6f, 0xe2,
+	0x20, 0x37, 0x6c, 0xb7, 0x63, 0x79, 0xd8, 0x9c, 0xb7, 0x9c, 0xba, 0xd5, 0x6a, 0xf5, 0xf1, 0x12,
+	0x88, 0xc1, 0x7c, 0xaa, 0x98, 0x35, 0x7b, 0x39, 0xd5, 0x20, 0x28, 0xbe, 0xed, 0x6c, 0xf0, 0x19,
+	0xee, 0xb2, 0x09, 0x98, 0x1d, 0x5d, 0xb2, 0xd2, 0x47, 0x54, 0xfe, 0x00, 0xce, 0x64, 0x21, 0x90,
+	0xd8, 0xdb, 0x8d, 0xa8, 0xbd, 0x95, 0x87, 0xdc, 0x85, 0x19, 0xa0, 0xb8, 0x04, 0x62, 0x7a, 0xec,
+	0x86, 0x6c, 0x2f, 0xc9, 0xd5, 0x48, 0x96, 0x49, 0x12, 0xdf, 0x81, 0x2c, 0xe5, 0xcc, 0xd5, 0x64,
+	0xe1, 0xc9, 0x29, 0x48, 0x5f, 0x22, 0x76, 0x2c, 0x15, 0x13, 0x8f, 0x04, 0x7f, 0x47, 0x03, 0x23,
+	0x69, 0xed, 0x3e, 0x12, 0xea, 0x29, 0x28, 0x7f, 0x14, 0xa7, 0xfc, 0xed, 0x14, 0xca, 0xb3, 0x30,
+	0xe5, 0xa4, 0xfd, 0x21, 0x51, 0x4e, 0x05, 0x2e, 0x2e, 0x9b, 0xaf, 0xc1, 0x74, 0x1d, 0x3b, 0x11,
+	0xc8, 0x3f, 0x01, 0x10, 0x3b, 0xd3, 0x46, 0xcd, 0x29, 0xd6, 0x6e, 0x8a, 0xe6, 0xb0, 0xbe, 0x87,
+	0x71, 0x1e, 0x51, 0xdf, 0x55, 0xa8, 0x72, 0x2e, 0xf5, 0x82, 0xaf, 0xee, 0x29, 0xc8, 0x42, 0xd9,
+	0x40, 0xc9, 0xc0, 0xa3, 0x48, 0x58, 0x2a, 0x9e, 0x81, 0x25, 0x4c, 0x86, 0x29, 0x22, 0x61, 0xc9,
+	0x05, 0xd2, 0xfd, 0x09, 0x28, 0xcf, 0x2d, 0x61, 0x59, 0x98, 0x72, 0xd2, 0x7e, 0x5e, 0x2e, 0x0e,
+	0x3e, 0x2e, 0x4e, 0xfd, 0x4f, 0x35, 0x38, 0x6d, 0xa2, 0x7d, 0xe7, 0x00, 0xb1, 0x34, 0xff, 0xab,
+	0x12, 0xa4, 0x8b, 0x3a, 0x46, 0xc5, 0x98, 0x63, 0x64, 0x18, 0x44, 0x56, 0xd2, 0xa8, 0xe6, 0x4b,
+	0xfb, 0x59, 0x01, 0xce, 0xf3, 0x25, 0xb0, 0x65, 0xa7, 0xe6, 0x98, 0x95, 0x0b, 0xb4, 0xa0, 0x14,
+	0xd5, 0x41, 0xbe, 0xb8, 0x1b, 0x29, 0xfb, 0x97, 0x63, 0x42, 0x73, 0x32, 0xa2, 0xbd, 0x24, 0xc3,
+	0xeb, 0xa7, 0xf1, 0xa5, 0x85, 0x5a, 0xf2, 0x0c, 0xef, 0x2d, 0x0e, 0x13, 0xcb, 0xf0, 0x22, 0x59,
+	0xf3, 0xc0, 0x29, 0xfc, 0x65, 0xb8, 0x90, 0xb5, 0x16, 0xce, 0xe7, 0x7f, 0xd4, 0x60, 0x49, 0x44,
+	0x85, 0x24, 0xb7, 0xf4, 0x97, 0x22, 0x3e, 0x97, 0x60, 0x06, 0x7b, 0x81, 0xd1, 0xba, 0x29, 0xca,
+	0x4b, 0x6c, 0x39, 0x6d, 0xf7, 0x76, 0xb8, 0x22, 0xca, 0x38, 0x05, 0x27, 0xe4, 0xe4, 0xf3, 0xf5,
+	0xfd, 0xa2, 0x40, 0x2c, 0x18, 0x31, 0xd6, 0xd1, 0xac, 0x74, 0xc2, 0xb4, 0xbe, 0x8c, 0x85, 0xe2,
+	0xbb, 0x27, 0x2f, 0x8a, 0xc3, 0x6e, 0x52, 0x10, 0xa8, 0xf5, 0xdb, 0xf0, 0xcc, 0x4f, 0xf0, 0xce,
+	0x0b, 0x52, 0x43, 0x53, 0x1f, 0x1b, 0x68, 0x6a, 0xdd, 0x47, 0x11, 0xcc, 0x7d, 0x17, 0x9f, 0x4e,
+	0x41, 0xa1, 0x1b, 0xbb, 0x24, 0x0c, 0xe5, 0xbd, 0x24, 0x4c, 0x05, 0xa0, 0xb4, 0xc1, 0xb8, 0x48,
+	0xb4, 0x55, 0xc9, 0x65, 0xbe, 0x1f, 0xff, 0x55, 0x80, 0xb2, 0xc9, 0xcb, 0x33, 0x11, 0x85, 0x75,
+	0x1f, 0xaf, 0xbe, 0xcc, 0x3d, 0x78, 0x0a, 0x73, 0xd1, 0x48, 0x66, 0xbf, 0x66, 0xe3, 0x0b, 0x84,
+	0xa8, 0x9f, 0xb8, 0x94, 0x2b, 0x9a, 0xd9, 0xdf, 0xc4, 0x20, 0xe6, 0xf1, 0x83, 0x44, 0x9b, 0xab,
+	0xbf, 0x05, 0xc3, 0x94, 0xb9, 0x2e, 0xdf, 0x33, 0x79, 0x64, 0x63, 0xc3, 0xf2, 0xac, 0x9b, 0x2d,
+	0x67, 0xc7, 0xe4, 0x83, 0xf5, 0x75, 0x28, 0x91, 0x9a, 0x49, 0x52, 0xcc, 0xc4, 0xc1, 0x87, 0xf2,
+	0x80, 0x4f, 0x60, 0x20, 0xb3, 0xc7, 0x36, 0xc5, 0x35, 0x96, 0x60, 0x51, 0xc2, 0x6b, 0xbe, 0x13,
+	0xdf, 0xd0, 0x60, 0x7e, 0xab, 0xdf, 0xae, 0x6f, 0xe1, 0x25, 0x35, 0x78, 0x80, 0x93, 0xef, 0xc3,
+	0x79, 0x28, 0xb9, 0x4e, 0xaf, 0x5b, 0x47, 0x35, 0x5e, 0xb9, 0xcb, 0x37, 0x63, 0x92, 0xb5, 0xae,
+	0xb3, 0x46, 0x7d, 0x11, 0x46, 0x09, 0x3f, 0x1a, 0xe2, 0x04, 0xc3, 0x97, 0x3b, 0xfa, 0x8d, 0x37,
+	0xab, 0x0a, 0xc7, 0xe8, 0x6d, 0xb1, 0x98, 0x79, 0x85, 0xa3, 0xe3, 0x8c, 0x45, 0x58, 0x48, 0xd0,
+	0xc2, 0xe9, 0xfc, 0xe7, 0x21, 0x38, 0x4e, 0xfa, 0xc4, 0x49, 0xf8, 0x32, 0x85, 0x05, 0xdf, 0x66,
+	0x45, 0x40, 0x89, 0xe9, 0xaa, 0xf8, 0x24, 0xaa, 0x1c, 0xdc, 0x66, 0xfd, 0x48, 0x81, 0x1f, 0x59,
+	0x20, 0x3c, 0x49, 0x86, 0x91, 0x86, 0x06, 0x0d, 0x23, 0xe1, 0x83, 0x55, 0xdc, 0xaa, 0xf0, 0x1c,
+	0xc3, 0x74, 0x8e, 0x31, 0xde, 0x82, 0x67, 0x88, 0xdf, 0xd5, 0x47, 0x06, 0xbb, 0xab, 0x7f, 0xcc,
+	0x93, 0x37, 0xc1, 0xb5, 0x99, 0x62, 0x19, 0xcd, 0xc4, 0x32, 0x43, 0xc0, 0x7c, 0x07, 0x98, 0xe2,
+	0xba, 0x06, 0x23, 0xe2, 0xce, 0x3d, 0x96, 0xe3, 0xce, 0x2d, 0x06, 0x87, 0xe3, 0x05, 0x10, 0x8d,
+	0x17, 0x7c, 0x00, 0x13, 0x2c, 0xb5, 0xc4, 0x8b, 0x7c, 0xc7, 0x73, 0x14, 0xf9, 0x8e, 0xd3, 0x8c,
+	0x13, 0xaf, 0xef, 0x7d, 0x03, 0x68, 0x8d, 0x2e, 0x2f, 0x59, 0xc7, 0x0c, 0xc4, 0x0a, 0x81, 0xe5,
+	0x89, 0x06, 0xf3, 0xc6, 0x4c, 0x9d, 0xf4, 0x3d, 0xa1, 0x5d, 0x9b, 0xbc, 0x07, 0x3b, 0xac, 0x53,
+	0x31, 0xdb, 0xc0, 0x03, 0x77, 0x17, 0xf2, 0x59, 0x05, 0xb3, 0x14, 0xb5, 0x08, 0xc6, 0x3c, 0xcc,
+	0x46, 0x45, 0x99, 0xcb, 0xf8, 0x5f, 0xe0, 0x53, 0x58, 0x54, 0xae, 0xbd, 0x22, 0x4e, 0x9c, 0xf1,
+	0x2d, 0x0d, 0x4e, 0xc8, 0x69, 0xe2, 0xf7, 0x9b, 0xab, 0x30, 0xbf, 0xcf, 0xda, 0x59, 0x5e, 0x05,
+	0xfb, 0x3c, 0xb5, 0xba, 0x85, 0xe5, 0x95, 0x53, 0x78, 0x7c, 0x3f, 0x04, 0xb5, 0xd9, 0x5e, 0x27,
+	0x5d, 0xfa, 0x3b, 0xb0, 0x98, 0x00, 0x6a, 0x60, 0xeb, 0xb5, 0x63, 0xb9, 0x88, 0xbb, 0xc1, 0xf3,
+	0x51, 0xb8, 0x0d, 0xde, 0x6b, 0x9c, 0x80, 0x8a, 0xa0, 0x87, 0xf3, 0xf3, 0x23, 0xc7, 0x2f, 0x3d,
+	0x32, 0xfe, 0xb8, 0x10, 0xb0, 0x30, 0xd2, 0xcd, 0xa9, 0x5d, 0x86, 0xe9, 0x76, 0x6f, 0x1f, 0x33,
+	0x83, 0x84, 0x99, 0xa8, 0x99, 0x72, 0x29, 0x9d, 0x43, 0x66, 0x89, 0xb5, 0x3f, 0x68, 0x52, 0xeb,
+	0xe3, 0x12, 0x66, 0x0b, 0xb3, 0xe6, 0xd2, 0xe8, 0xc1, 0x90, 0x39, 0xca, 0xed, 0x9a, 0x8b, 0x75,
+	0x64, 0x82, 0xef, 0x04, 0x5b, 0x2a, 0x33, 0x70, 0x17, 0xd3, 0xe4, 0x81, 0xc5, 0x73, 0xe8, 0xd2,
+	0xa9, 0x7f, 0x37, 0xde, 0x08, 0x1a, 0xb0, 0x8e, 0x2c, 0xb0, 0x89, 0x48, 0x29, 0x7a, 0xd7, 0x69,
+	0xb5, 0x30, 0x71, 0x2e, 0x35, 0x7e, 0xd4, 0x7c, 0x8c, 0x99, 0x73, 0xb4, 0x7b, 0xdd, 0xef, 0x65,
+	0x96, 0x91, 0xea, 0x48, 0xa3, 0xd1, 0x45, 0xae, 0xcb, 0x83, 0x8e, 0xe2, 0xd3, 0xa8, 0xc2, 0x0c,
+	0x4b, 0x4d, 0x11, 0x38, 0x21, 0x3c, 0x61, 0x33, 0xad, 0x45, 0xcc, 0xb4, 0x31, 0x0b, 0x7a, 0x78,
+	0x3c, 0x97, 0xc6, 0x5f, 0x69, 0x30, 0xc3, 0x1c, 0xf4, 0xb0, 0x27, 0x98, 0x8e, 0x06, 0xdb, 0x1d,
+	0x96, 0xc6, 0xf5, 0xb3, 0xd6, 0xa5, 0xd5, 0x33, 0xa9, 0x39, 0x02, 0x3c, 0x90, 0x86, 0xc6, 0x68,
+	0x26, 0x97, 0x86, 0xc5, 0x42, 0x01, 0xd6, 0x62, 0x24, 0xc0, 0xba, 0x8e, 0xf5, 0x0f, 0xbb, 0x74,
+	0x3b, 0x76, 0x0b, 0x2b, 0x0b, 0x33, 0x46, 0xd9, 0x31, 0xc1, 0x52, 0x00, 0x42, 0x2d, 0x11, 0xb6,
+	0xcc, 0xfc, 0x14, 0xab, 0xb5, 0x2d, 0x6e, 0x74, 0xc7, 0xcc, 0x71, 0xde, 0x76, 0x1f, 0x37, 0x11,
+	0x36, 0x84, 0xd7, 0x1b, 0xdc, 0x69, 0x67, 0x68, 0x9d, 0xda, 0x23, 0xf2, 0xe4, 0x24, 0x07, 0x1b,
+	0xe2, 0x33, 0x15, 0x12, 0x33, 0x45, 0x39, 0x55, 0x1c, 0x94, 0x53, 0x8c, 0xd0, 0x80, 0x22, 0x4e,
+	0xe8, 0x5f, 0x69, 0x30, 0x2b, 0x44, 0xff, 0xd5, 0xa1, 0xf5, 0x01, 0xcc, 0xc5, 0x88, 0xe2, 0x9a,
+	0x88, 0xc5, 0x1e, 0x6f, 0x5b, 0x1d, 0xcb, 0x2b, 0xa9, 0xfe, 0xa4, 0xef, 0x79, 0x98, 0x2d, 0x20,
+	0x0a, 0x59, 0x24, 0x62, 0x1f, 0x74, 0x53, 0x48, 0x6a, 0x08, 0x5c, 0x52, 0xbd, 0x78, 0xf2, 0x0e,
+	0xf2, 0xcc, 0xe0, 0x71, 0xcf, 0x3d, 0x3c, 0xc8, 0xda, 0x45, 0xbe, 0xdf, 0xf2, 0x21, 0x0c, 0xd3,
+	0x24, 0x0e, 0x43, 0xa4, 0x48, 0x45, 0x87, 0x70, 0xd0, 0x14, 0x8f, 0xc9, 0xe1, 0x72, 0xb0, 0x85,
+	0x18, 0x9a, 0x53, 0x69, 0x64, 0xf0, 0x15, 0x7e, 0x82, 0x4f, 0x7a, 0xca, 0xf7, 0x7d, 0xde, 0xc3,
+	0xe9, 0xf9, 0x38, 0x35, 0x08, 0xa9, 0x46, 0x58, 0xa5, 0xfa, 0x29, 0x5a, 0x59, 0xc0, 0x71, 0xd2,
+	0x0d, 0xb7, 0x55, 0xf6, 0x41, 0x4f, 0x0e, 0x0a, 0x07, 0x15, 0x87, 0x58, 0x50, 0x71, 0x2d, 0x1a,
+	0x54, 0x7c, 0x3d, 0x07, 0x87, 0x7c, 0x6a, 0x42, 0x11, 0xc5, 0x36, 0x9c, 0xc1, 0x24, 0x6f, 0xdc,
+	0x7d, 0xa4, 0xd8, 0x8d, 0x8f, 0x01, 0x98, 0x5a, 0x63, 0xc3, 0x27, 0x38, 0x90, 0x67, 0x3e, 0x22,
+	0x4b, 0xd4, 0x58, 0x52, 0xf1, 0x23, 0xff, 0xb9, 0x46, 0x1f, 0xce, 0x2a, 0xe6, 0xe3, 0x6c, 0xdf,
+	0x86, 0x99, 0xd0, 0xcb, 0x2f, 0x9a, 0x53, 0x14, 0xf3, 0x5e, 0xcc, 0x39, 0xaf, 0x39, 0xdd, 0x8d,
+	0x36, 0xb8, 0xc6, 0xbf, 0x62, 0xed, 0x32, 0x91, 0xd5, 0xe9, 0xb4, 0xd8, 0xe5, 0xc7, 0x5f, 0xdf,
+	0x3c, 0x0c, 0xf3, 0x20, 0x3e, 0x3b, 0xef, 0xf8, 0x97, 0xba, 0xe8, 0x5f, 0x7e, 0x58, 0x17, 0x8f,
+	0xea, 0x98, 0x1e, 0xee, 0x96, 0x61, 0x2c, 0xc0, 0x5c, 0x6c, 0x69, 0xdc, 0xa4, 0xfc, 0xad, 0x46,
+	0x6a, 0x74, 0x9b, 0xf8, 0x50, 0xd9, 0xf3, 0xf3, 0x19, 0x84, 0x1b, 0xaf, 0xe0, 0xda, 0x49, 0x08,
+	0x40, 0x4e, 0x2a, 0x5f, 0xcb, 0x67, 0x05, 0x98, 0xc7, 0xab, 0x6c, 0x60, 0xe9, 0x89, 0x8b, 0xe8,
+	0x55, 0x7c, 0x4d, 0x11, 0x75, 0x04, 0xa5, 0xd5, 0xd3, 0xa9, 0xa7, 0xf8, 0xdd, 0x47, 0xd4, 0xb8,
+	0xd1, 0xc1, 0xaa, 0x6b, 0x4f, 0xf2, 0xe2, 0x54, 0x94, 0x5d, 0x9c, 0xb6, 0xa1, 0x6c, 0xb7, 0xc9,
+	0x08, 0xfb, 0x00, 0xd5, 0x50, 0xdb, 0xb7, 0x13, 0x39, 0x8b, 0xaf, 0xe6, 0x7c, 0xe0, 0x5b, 0x6d,
+	0xa1, 0xf0, 0x78, 0x72, 0xcc, 0xfb, 0x0e, 0x41, 0xe2, 0xda, 0x5f, 0x67, 0xa7, 0x1c, 0xf6, 0x5b,
+	0x48, 0xc3, 0x16, 0xfe, 0xd6, 0x2f, 0xc0, 0x14, 0x2d, 0x21, 0xa0, 0x23, 0x58, 0xa6, 0x7b, 0x98,
+	0x66, 0xba, 0x69, 0x65, 0xc1, 0x43, 0xdc, 0xca, 0x0a, 0xdf, 0xfe, 0xbe, 0x00, 0x0b, 0x09, 0x66,
+	0xf9, 0x0e, 0xdf, 0x21, 0xb8, 0x25, 0x55, 0xca, 0xc2, 0x11, 0x95, 0x52, 0xb7, 0x60, 0x3e, 0x81,
+	0x55, 0xc4, 0xdc, 0x06, 0xb6, 0x33, 0xb3, 0x71, 0xf4, 0x34, 0xe6, 0x26, 0xe1, 0xd8, 0x31, 0x19,
+	0xc7, 0xfe, 0x93, 0x54, 0x48, 0xf6, 0xba, 0xbb, 0xe8, 0x73, 0x2e, 0x5f, 0x46, 0x05, 0xca, 0xc9,
+	0x75, 0x72, 0x1d, 0xfb, 0x3b, 0x2c, 0x36, 0xf7, 0xd0, 0xe7, 0x9f, 0x09, 0xff, 0x37, 0x4a, 0x76,
+	0x13, 0xca, 0x49, 0x66, 0x71, 0x25, 0x93, 0xe0, 0xd0, 0x64, 0x38, 0xfe, 0x08, 0x5f, 0xcf, 0xee,
+	0x3b, 0x9e, 0xdd, 0xec, 0x93, 0xfb, 0x2d, 0xf6, 0x5d, 0xbb, 0xf7, 0x2c, 0x72, 0x79, 0xf5, 0xd9,
+	0x8e, 0x55, 0xa4, 0xc9, 0x7b, 0x6a, 0xfb, 0xb4, 0xab, 0x16, 0x71, 0x8e, 0x52, 0x55, 0x24, 0x8a,
+	0x8f, 0xf9, 0x47, 0xb3, 0xcd, 0x64, 0xa3, 0x6b, 0x9c, 0x86, 0x93, 0x29, 0x24, 0x70, 0xb1, 0xb0,
+	0x60, 0x09, 0x1f, 0xdb, 0xeb, 0x5d, 0xc7, 0x75, 0xf9, 0xb6, 0x44, 0x4e, 0x91, 0xc8, 0x4d, 0x4b,
+	0x8b, 0xdd, 0xb4, 0xf0, 0x36, 0x7b, 0x16, 0x66, 0x92, 0xe7, 0x6f, 0x33, 0x3b, 0x4f, 0x26, 0x59,
+	0x2b, 0xc7, 0x67, 0xfc, 0x6f, 0x11, 0x4e, 0xc8, 0xe7, 0xe0, 0x0c, 0xdd, 0x27, 0x78, 0x88, 0x79,
+	0xd8, 0xe9, 0xb3, 0x7b, 0x1f, 0x5f, 0xff, 0x1d, 0x95, 0x33, 0x96, 0x8a, 0x8e, 0x7a, 0xba, 0xee,
+	0xcd, 0x3e, 0x75, 0xb6, 0x98, 0x27, 0x36, 0xe1, 0x85, 0x9a, 0xf4, 0x3f, 0xd4, 0x60, 0xae, 0x49,
+	0x93, 0x4c, 0xf8, 0x86, 0xd8, 0x73, 0x51, 0x30, 0x2d, 0x33, 0x7a, 0xf7, 0x0e, 0x37, 0x2d, 0xcb,
+	0x5b, 0xad, 0x13, 0x8c, 0x91, 0xc9, 0xf5, 0x66, 0xa2, 0xa3, 0xf2, 0x09, 0xcc, 0x24, 0xa8, 0x94,
+	0xb8, 0x82, 0xb7, 0xa3, 0xae, 0xe0, 0x1b, 0x69, 0xf2, 0x10, 0x27, 0x8a, 0xef, 0x5e, 0xd8, 0x1f,
+	0xc4, 0x53, 0x2e, 0xa4, 0x50, 0x28, 0x99, 0xf8, 0xc3, 0xf0, 0xc4, 0xa5, 0xf4, 0x10, 0x2b, 0x66,
+	0x48, 0x90, 0xb2, 0xa3, 0x88, 0xc3, 0x2e, 0xe8, 0x2f, 0x35, 0x58, 0xe6, 0x49, 0xb2, 0x04, 0xdb,
+	0x12, 0xd1, 0x7d, 0xc5, 0x4d, 0x28, 0x9f, 0x9c, 0xe9, 0x4f, 0x98, 0x18, 0xf9, 0xd5, 0x0c, 0x22,
+	0x40, 0x3c, 0x00, 0xdb, 0x78, 0x11, 0xc3, 0xa4, 0x17, 0xfa, 0x72, 0xf5, 0x73, 0x30, 0xd9, 0x44,
+	0x5e, 0x7d, 0xef, 0x3e, 0x62, 0x7e, 0x0b, 0xcf, 0xea, 0x44, 0x1b, 0x0d, 0x17, 0x5e, 0xcb, 0xb1,
+	0x58, 0xbf, 0x4e, 0x72, 0x48, 0x38, 0xbf, 0x87, 0xdc, 0x59, 0x0a, 0x6e, 0xbc, 0x45, 0x1f, 0x62,
+	0x09, 0xe5, 0xa6, 0x67, 0x65, 0x8e, 0x80, 0x94, 0xe1, 0xd1, 0x97, 0x4c, 0x51, 0x30, 0x4e, 0xd9,
+	0x2a, 0xcc, 0x05, 0xe9, 0x0c, 0x11, 0xfc, 0xe8, 0xf1, 0xfa, 0xa4, 0x21, 0x33, 0xc8, 0x75, 0x6c,
+	0xb1, 0xc8, 0x07, 0xee, 0x22, 0x1b, 0x24, 0x9e, 0x0a, 0xf2, 0xb8, 0x0d, 0x0b, 0xca, 0x4c, 0xf2,
+	0x56, 0x16, 0xb6, 0x31, 0x7e, 0xa4, 0x11, 0x37, 0x8f, 0x56, 0xfd, 0xc5, 0xdf, 0xff, 0xbd, 0x4a,
+	0xde, 0xea, 0x22, 0xf1, 0xaf, 0x62, 0x54, 0x32, 0xe6, 0xac, 0xfe, 0xfc, 0x32, 0x00, 0x0f, 0x5d,
+	0xad, 0x3d, 0xdc, 0xd4, 0x3f, 0x25, 0x01, 0x7a, 0xe9, 0xa3, 0x6d, 0xfd, 0x5a, 0xaa, 0x11, 0x51,
+	0x3e, 0x1b, 0xaf, 0xbc, 0x3d, 0x30, 0x1c, 0xdf, 0xb7, 0x6f, 0x62, 0x27, 0x27, 0xe5, 0x39, 0xbc,
+	0xae, 0x40, 0xaa, 0xfc, 0x81, 0x80, 0xca, 0xf5, 0xc1, 0x01, 0x39, 0x39, 0x3f, 0xd6, 0xe0, 0x4c,
+	0xd6, 0xcb, 0x76, 0xfd, 0xc3, 0x2c, 0xf4, 0x59, 0xaf, 0xec, 0x2b, 0x6b, 0x47, 0xc0, 0xc0, 0x29,
+	0xfd, 0x94, 0x49, 0x25, 0x1a, 0x68, 0x13, 0x95, 0x6f, 0xe5, 0x15, 0x9b, 0x98, 0xf1, 0x38, 0xfe,
+	0xbb, 0x1a, 0x54, 0xd2, 0x5f, 0x76, 0xeb, 0xe9, 0x85, 0x59, 0x99, 0x2f, 0xde, 0x2b, 0xef, 0x1e,
+	0x0a, 0x96, 0xd3, 0xf5, 0x97, 0x1a, 0x2c, 0xa6, 0xbe, 0xdb, 0xd6, 0xdf, 0x49, 0x45, 0x9d, 0xf5,
+	0x6c, 0xbc, 0x72, 0xe3, 0x30, 0xa0, 0x9c, 0xa8, 0x36, 0x4c, 0x46, 0x1e, 0xf4, 0xea, 0x97, 0x53,
+	0x91, 0xc9, 0xde, 0x0d, 0x57, 0xaa, 0x79, 0x87, 0xf3, 0xf9, 0xb0, 0xdf, 0x70, 0x5c, 0xf2, 0x2a,
+	0x56, 0xbf, 0xaa, 0xde, 0x6d, 0xe9, 0x3b, 0xdc, 0xca, 0x9b, 0x83, 0x01, 0x71, 0x12, 0x3c, 0x98,
+	0x8a, 0xbd, 0x40, 0xd5, 0x57, 0x54, 0xde, 0x8a, 0x24, 0x53, 0x51, 0x79, 0x23, 0x3f, 0x00, 0x9f,
+	0xf5, 0x39, 0x4c, 0xc7, 0x5f, 0x5a, 0xe9, 0xe9, 0x58, 0x52, 0xde, 0xa2, 0x55, 0xae, 0x0c, 0x00,
+	0x11, 0x12, 0xbb, 0xd4, 0x92, 0x43, 0x85, 0xd8, 0x65, 0xbd, 0xf6, 0xa8, 0x1c, 0xa1, 0xc2, 0x51,
+	0xff, 0x1b, 0x8d, 0x44, 0x33, 0xd2, 0x2b, 0x12, 0xf5, 0xf7, 0x0e, 0x59, 0xc8, 0xc8, 0x48, 0x7b,
+	0xff, 0x48, 0x65, 0x90, 0x9c, 0x65, 0x29, 0x65, 0x7b, 0x4a, 0x96, 0xa9, 0x8b, 0x06, 0x95, 0x2c,
+	0xcb, 0xa8, 0x12, 0x0c, 0xed, 0xa3, 0xa4, 0x26, 0x3a, 0x73, 0x1f, 0xd3, 0xab, 0xd1, 0x33, 0xf7,
+	0x51, 0x55, 0x82, 0x1d, 0xda, 0x47, 0x69, 0xe5, 0x5c, 0xf6, 0x3e, 0xaa, 0xaa, 0xf7, 0xb2, 0xf7,
+	0x51, 0x59, 0xae, 0x17, 0xde, 0xc7, 0x64, 0x71, 0x5c, 0xf6, 0x3e, 0xa6, 0x96, 0xe6, 0x65, 0xef,
+	0x63, 0x7a, 0x2d, 0x9e, 0xfe, 0xd7, 0x34, 0xe6, 0x98, 0x5a, 0xf5, 0xa6, 0xbf, 0x3b, 0xd0, 0x9a,
+	0xa3, 0x75, 0x77, 0x95, 0xf7, 0x0e, 0x07, 0x1c, 0x21, 0x2d, 0xb5, 0xe4, 0x53, 0x49, 0x5a, 0x56,
+	0xd1, 0xa9, 0x92, 0xb4, 0xec, 0x2a, 0xd3, 0x1f, 0x68, 0xe4, 0x47, 0x71, 0x54, 0xb5, 0x5e, 0xfa,
+	0x57, 0x14, 0x13, 0xe4, 0x28, 0x78, 0xab, 0x7c, 0x70, 0x68, 0x78, 0x4e, 0xe3, 0xb7, 0x35, 0x52,
+	0xf4, 0x23, 0xaf, 0xf8, 0xd3, 0xaf, 0x2b, 0xb0, 0x2b, 0x4b, 0x1b, 0x2b, 0xef, 0x1c, 0x02, 0x92,
+	0x53, 0xf4, 0x27, 0x1a, 0xcc, 0xca, 0xea, 0xc6, 0xf4, 0xf4, 0x93, 0x53, 0x51, 0x25, 0x57, 0x79,
+	0x6b, 0x40, 0x28, 0x4e, 0xc5, 0xf7, 0xe9, 0x8f, 0x2b, 0x29, 0xca, 0xa6, 0xf4, 0xf7, 0x33, 0x64,
+	0x43, 0x5d, 0xd4, 0x56, 0xf9, 0xca, 0x61, 0xc1, 0x39, 0x81, 0x5f, 0x27, 0x19, 0xd0, 0x58, 0x01,
+	0x91, 0x7e, 0x45, 0x81, 0x54, 0x5e, 0xd8, 0x55, 0x59, 0x1d, 0x04, 0x24, 0xf0, 0x46, 0x62, 0x25,
+	0x41, 0x0a, 0x6f, 0x44, 0x5e, 0xc8, 0xa4, 0xf0, 0x46, 0x52, 0xaa, 0x8d, 0xf4, 0x67, 0x30, 0x11,
+	0xae, 0xd0, 0xd0, 0xbf, 0xac, 0xc4, 0x10, 0xab, 0x49, 0xaa, 0x5c, 0xce, 0x39, 0x3a, 0x24, 0x85,
+	0xb2, 0x12, 0x0b, 0x85, 0x14, 0x2a, 0xaa, 0x44, 0x14, 0x52, 0xa8, 0xac, 0xe3, 0x20, 0x9e, 0xa7,
+	0xa4, 0x72, 0x42, 0xe1, 0x79, 0xa6, 0x97, 0x61, 0x54, 0xde, 0x1c, 0x0c, 0xc8, 0x7f, 0x2d, 0x02,
+	0x41, 0x1d, 0x82, 0x7e, 0x29, 0x15, 0x47, 0xa2, 0xb8, 0xa1, 0xf2, 0x7a, 0xae, 0xb1, 0xc1, 0x34,
+	0x41, 0x9e, 0x5f, 0x31, 0x4d, 0xa2, 0xf8, 0x41, 0x31, 0x4d, 0xb2, 0x70, 0x80, 0x4d, 0x23, 0xb2,
+	0xf4, 0xca, 0x69, 0x62, 0xc5, 0x05, 0xca, 0x69, 0xe2, 0x69, 0x7f, 0x72, 0x43, 0x89, 0x24, 0xd8,
+	0x15, 0x37, 0x14, 0x59, 0x75, 0x80, 0xe2, 0x86, 0x22, 0xcf, 0xdb, 0x7f, 0xca, 0x7e, 0x97, 0x47,
+	0x92, 0x81, 0x55, 0x5c, 0x65, 0x95, 0x09, 0x7b, 0xc5, 0x55, 0x36, 0x23, 0xc3, 0x4e, 0x1c, 0x98,
+	0xd4, 0x84, 0xb0, 0xc2, 0x81, 0xc9, 0x4a, 0x5a, 0x2b, 0x1c, 0x98, 0xec, 0xfc, 0x33, 0xde, 0x90,
+	0x48, 0x36, 0x55, 0xb1, 0x21, 0xb2, 0x84, 0xb2, 0x62, 0x43, 0xa4, 0x49, 0x5a, 0x6a, 0x3e, 0x64,
+	0x99, 0x4f, 0x5d, 0x75, 0xfd, 0x4b, 0xcd, 0xe9, 0x2a, 0xcc, 0x87, 0x2a, 0xbd, 0x4a, 0xec, 0x74,
+	0x2c, 0x61, 0xa8, 0xb0, 0xd3, 0xf2, 0x3c, 0xac, 0xc2, 0x4e, 0xa7, 0xe5, 0x22, 0xc9, 0xad, 0x31,
+	0x96, 0x8c, 0x52, 0xdd, 0x1a, 0xe5, 0xf9, 0x39, 0xd5, 0xad, 0x31, 0x25, 0xd3, 0x45, 0x26, 0x8e,
+	0xe7, 0x6e, 0x14, 0x13, 0xa7, 0xe4, 0xc4, 0x14, 0x13, 0xa7, 0x26, 0x86, 0xfe, 0x4c, 0x83, 0x39,
+	0x69, 0xb6, 0x45, 0x4f, 0xdf, 0x38, 0x55, 0x82, 0xa8, 0x72, 0x6d, 0x50, 0xb0, 0x90, 0xd8, 0xc9,
+	0x72, 0x15, 0x0a, 0xb1, 0x53, 0x24, 0x81, 0x14, 0x62, 0xa7, 0x4c, 0xeb, 0x7c, 0xa6, 0xf9, 0xef,
+	0x7b, 0xd2, 0x23, 0xe2, 0xfa, 0x5a, 0x96, 0xdb, 0x9f, 0x99, 0x3a, 0xa8, 0xdc, 0x3c, 0x0a, 0x8a,
+	0x48, 0x64, 0x25, 0x1c, 0x11, 0x57, 0x47, 0x56, 0x24, 0x21, 0x77, 0x75, 0x64, 0x45, 0x1a, 0x6c,
+	0xa7, 0x9a, 0x19, 0x09, 0x35, 0x2b, 0x35, 0x53, 0x16, 0x3a, 0x57, 0x6a, 0xa6, 0x34, 0x8a, 0x7d,
+	0xf3, 0xd6, 0x3f, 0xfd, 0xc7, 0x29, 0xed, 0x5f, 0xf0, 0xdf, 0xbf, 0xe3, 0xbf, 0xdf, 0x7d, 0x7b,
+	0xd7, 0xf6, 0xf6, 0x7a, 0x3b, 0xd5, 0xba, 0xb3, 0xbf, 0x12, 0xf9, 0x0d, 0xe6, 0xea, 0x2e, 0x6a,
+	0xb3, 0x1f, 0xd3, 0x0e, 0xfd, 0x9a, 0xf7, 0xbb, 0xfc, 0xdf, 0x83, 0x2b, 0x3b, 0xc3, 0xb4, 0xef,
+	0xea, 0x6f, 0x00, 0x18, 0x6f, 0xcf, 0xf4, 0xf9, 0x5b, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -10001,6 +10105,86 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RestoreWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -11950,6 +12134,42 @@ func (m *GetFailoverInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *RestoreWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -23776,6 +23996,208 @@ func (m *GetFailoverInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RestoreWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
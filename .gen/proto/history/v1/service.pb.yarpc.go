@@ -86,6 +86,7 @@ type HistoryAPIYARPCClient interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest, ...yarpc.CallOption) (*GetFailoverInfoResponse, error)
+	RestoreWorkflow(context.Context, *RestoreWorkflowRequest, ...yarpc.CallOption) (*RestoreWorkflowResponse, error)
 }
 
 func newHistoryAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) HistoryAPIYARPCClient {
@@ -148,6 +149,7 @@ type HistoryAPIYARPCServer interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest) (*GetFailoverInfoResponse, error)
+	RestoreWorkflow(context.Context, *RestoreWorkflowRequest) (*RestoreWorkflowResponse, error)
 }
 
 type buildHistoryAPIYARPCProceduresParams struct {
@@ -581,6 +583,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "RestoreWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RestoreWorkflow,
+							NewRequest:  newHistoryAPIServiceRestoreWorkflowYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -1197,6 +1209,18 @@ func (c *_HistoryAPIYARPCCaller) GetFailoverInfo(ctx context.Context, request *G
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) RestoreWorkflow(ctx context.Context, request *RestoreWorkflowRequest, options ...yarpc.CallOption) (*RestoreWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RestoreWorkflow", request, newHistoryAPIServiceRestoreWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RestoreWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceRestoreWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _HistoryAPIYARPCHandler struct {
 	server HistoryAPIYARPCServer
 }
@@ -1873,6 +1897,22 @@ func (h *_HistoryAPIYARPCHandler) GetFailoverInfo(ctx context.Context, requestMe
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) RestoreWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RestoreWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RestoreWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceRestoreWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RestoreWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newHistoryAPIServiceStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &StartWorkflowExecutionRequest{}
 }
@@ -2209,6 +2249,14 @@ func newHistoryAPIServiceGetFailoverInfoYARPCResponse() proto.Message {
 	return &GetFailoverInfoResponse{}
 }
 
+func newHistoryAPIServiceRestoreWorkflowYARPCRequest() proto.Message {
+	return &RestoreWorkflowRequest{}
+}
+
+func newHistoryAPIServiceRestoreWorkflowYARPCResponse() proto.Message {
+	return &RestoreWorkflowResponse{}
+}
+
 var (
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCRequest             = &StartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCResponse            = &StartWorkflowExecutionResponse{}
@@ -2294,306 +2342,308 @@ var (
 	emptyHistoryAPIServiceRespondCrossClusterTasksCompletedYARPCResponse = &RespondCrossClusterTasksCompletedResponse{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCRequest                    = &GetFailoverInfoRequest{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCResponse                   = &GetFailoverInfoResponse{}
+	emptyHistoryAPIServiceRestoreWorkflowYARPCRequest                    = &RestoreWorkflowRequest{}
+	emptyHistoryAPIServiceRestoreWorkflowYARPCResponse                   = &RestoreWorkflowResponse{}
 )
 
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdd, 0x6f, 0x1c, 0xc9,
-		0x56, 0x57, 0x7b, 0xe2, 0xaf, 0x63, 0x7b, 0x6c, 0x57, 0xfc, 0x31, 0x1e, 0x27, 0x8e, 0xdd, 0x37,
-		0x1f, 0xde, 0xec, 0xee, 0x64, 0xe3, 0xec, 0x26, 0xd9, 0xec, 0xee, 0xdd, 0x4d, 0xec, 0x24, 0x3b,
-		0x51, 0x3e, 0xdb, 0x26, 0x01, 0x84, 0xd2, 0x6a, 0x4f, 0xd7, 0xd8, 0x8d, 0x67, 0xba, 0x27, 0xdd,
-		0x3d, 0x76, 0x66, 0x1f, 0xd0, 0x05, 0xae, 0x90, 0xee, 0x15, 0x82, 0x0b, 0x5c, 0x10, 0x12, 0xd2,
-		0x95, 0xd0, 0x45, 0xba, 0xb0, 0x3c, 0xf0, 0x00, 0x6f, 0x88, 0x27, 0x9e, 0x78, 0xe0, 0x0f, 0xe0,
-		0x89, 0x97, 0x2b, 0x1e, 0x40, 0xe2, 0x05, 0xc4, 0x23, 0x42, 0xf5, 0xd1, 0x5f, 0xd3, 0xd5, 0xd5,
-		0x3d, 0x36, 0x52, 0x76, 0xf7, 0xee, 0x9b, 0xa7, 0xaa, 0xce, 0xa9, 0x53, 0xa7, 0x4e, 0xfd, 0xea,
-		0xd4, 0x39, 0xa7, 0x0d, 0x17, 0xba, 0xbb, 0xd8, 0xbd, 0xd2, 0x30, 0x4c, 0x6c, 0x37, 0xf0, 0x95,
-		0x7d, 0xcb, 0xf3, 0x1d, 0xb7, 0x77, 0xe5, 0xf0, 0xea, 0x15, 0x0f, 0xbb, 0x87, 0x56, 0x03, 0xd7,
-		0x3a, 0xae, 0xe3, 0x3b, 0x68, 0x91, 0x0c, 0xab, 0xf1, 0x61, 0x35, 0x3e, 0xac, 0x76, 0x78, 0xb5,
-		0xba, 0xb2, 0xe7, 0x38, 0x7b, 0x2d, 0x7c, 0x85, 0x0e, 0xdb, 0xed, 0x36, 0xaf, 0x98, 0x5d, 0xd7,
-		0xf0, 0x2d, 0xc7, 0x66, 0x84, 0xd5, 0x73, 0xfd, 0xfd, 0xbe, 0xd5, 0xc6, 0x9e, 0x6f, 0xb4, 0x3b,
-		0x7c, 0x40, 0x8a, 0xc1, 0x91, 0x6b, 0x74, 0x3a, 0xd8, 0xf5, 0x78, 0xff, 0x6a, 0x42, 0x40, 0xa3,
-		0x63, 0x11, 0xe1, 0x1a, 0x4e, 0xbb, 0x1d, 0x4e, 0xb1, 0x26, 0x1a, 0x11, 0x88, 0xc8, 0xa5, 0x10,
-		0x0d, 0x79, 0xd5, 0xc5, 0xe1, 0x00, 0x55, 0x34, 0xc0, 0x37, 0xbc, 0x83, 0x96, 0xe5, 0xf9, 0xb2,
-		0x31, 0x47, 0x8e, 0x7b, 0xd0, 0x6c, 0x39, 0x47, 0x7c, 0xcc, 0x65, 0xd1, 0x18, 0xae, 0x4a, 0xbd,
-		0x6f, 0xec, 0x7a, 0xde, 0x58, 0xec, 0xf2, 0x91, 0xe7, 0x13, 0x23, 0xbd, 0x7d, 0xc3, 0xc5, 0x26,
-		0x55, 0x43, 0xab, 0xeb, 0xf9, 0xb9, 0xa3, 0x92, 0xaa, 0x50, 0x33, 0x46, 0xbd, 0xea, 0xe2, 0x2e,
-		0x16, 0x4a, 0x16, 0x8d, 0x71, 0x71, 0xa7, 0x65, 0x35, 0xe2, 0xdb, 0x7b, 0x21, 0x63, 0x64, 0x72,
-		0xa9, 0xea, 0x5f, 0x0e, 0xc3, 0xd9, 0x6d, 0xdf, 0x70, 0xfd, 0x17, 0xbc, 0xfd, 0xee, 0x6b, 0xdc,
-		0xe8, 0x12, 0x3e, 0x1a, 0x7e, 0xd5, 0xc5, 0x9e, 0x8f, 0x1e, 0xc2, 0xa8, 0xcb, 0xfe, 0xac, 0x28,
-		0xab, 0xca, 0xfa, 0xc4, 0xc6, 0x46, 0x2d, 0x61, 0x72, 0x46, 0xc7, 0xaa, 0x1d, 0x5e, 0xad, 0x49,
-		0x99, 0x68, 0x01, 0x0b, 0xb4, 0x0c, 0xe3, 0xa6, 0xd3, 0x36, 0x2c, 0x5b, 0xb7, 0xcc, 0xca, 0xd0,
-		0xaa, 0xb2, 0x3e, 0xae, 0x8d, 0xb1, 0x86, 0xba, 0x89, 0x7e, 0x0d, 0xe6, 0x3b, 0x86, 0x8b, 0x6d,
-		0x5f, 0xc7, 0x01, 0x03, 0xdd, 0xb2, 0x9b, 0x4e, 0xa5, 0x44, 0x27, 0x5e, 0x17, 0x4e, 0xfc, 0x94,
-		0x52, 0x84, 0x33, 0xd6, 0xed, 0xa6, 0xa3, 0x9d, 0xee, 0xa4, 0x1b, 0x51, 0x05, 0x46, 0x0d, 0xdf,
-		0xc7, 0xed, 0x8e, 0x5f, 0x39, 0xb5, 0xaa, 0xac, 0x0f, 0x6b, 0xc1, 0x4f, 0xb4, 0x09, 0xd3, 0xf8,
-		0x75, 0xc7, 0x62, 0xc7, 0x43, 0x27, 0xe7, 0xa0, 0x32, 0x4c, 0x67, 0xac, 0xd6, 0xd8, 0x19, 0xa8,
-		0x05, 0x67, 0xa0, 0xb6, 0x13, 0x1c, 0x12, 0xad, 0x1c, 0x91, 0x90, 0x46, 0xd4, 0x84, 0xa5, 0x86,
-		0x63, 0xfb, 0x96, 0xdd, 0xc5, 0xba, 0xe1, 0xe9, 0x36, 0x3e, 0xd2, 0x2d, 0xdb, 0xf2, 0x2d, 0xc3,
-		0x77, 0xdc, 0xca, 0xc8, 0xaa, 0xb2, 0x5e, 0xde, 0x78, 0x5b, 0xb8, 0x80, 0x4d, 0x4e, 0x75, 0xdb,
-		0x7b, 0x8c, 0x8f, 0xea, 0x01, 0x89, 0xb6, 0xd0, 0x10, 0xb6, 0xa3, 0x3a, 0xcc, 0x06, 0x3d, 0xa6,
-		0xde, 0x34, 0xac, 0x56, 0xd7, 0xc5, 0x95, 0x51, 0x2a, 0xee, 0x19, 0x21, 0xff, 0x7b, 0x6c, 0x8c,
-		0x36, 0x13, 0x92, 0xf1, 0x16, 0xa4, 0xc1, 0x42, 0xcb, 0xf0, 0x7c, 0xbd, 0xe1, 0xb4, 0x3b, 0x2d,
-		0x4c, 0x17, 0xef, 0x62, 0xaf, 0xdb, 0xf2, 0x2b, 0x63, 0x12, 0x7e, 0x4f, 0x8d, 0x5e, 0xcb, 0x31,
-		0x4c, 0x6d, 0x8e, 0xd0, 0x6e, 0x86, 0xa4, 0x1a, 0xa5, 0x44, 0xbf, 0x0c, 0xcb, 0x4d, 0xcb, 0xf5,
-		0x7c, 0xdd, 0xc4, 0x0d, 0xcb, 0xa3, 0xfa, 0x34, 0xbc, 0x03, 0x7d, 0xd7, 0x68, 0x1c, 0x38, 0xcd,
-		0x66, 0x65, 0x9c, 0x32, 0x5e, 0x4a, 0xe9, 0x75, 0x8b, 0x83, 0x93, 0x56, 0xa1, 0xd4, 0x5b, 0x9c,
-		0x78, 0xc7, 0xf0, 0x0e, 0xee, 0x30, 0x52, 0xf5, 0x06, 0xac, 0x64, 0x19, 0x99, 0xd7, 0x71, 0x6c,
-		0x0f, 0xa3, 0x79, 0x18, 0x71, 0xbb, 0xd4, 0xb2, 0x14, 0x6a, 0x59, 0xc3, 0x6e, 0xd7, 0xae, 0x9b,
-		0xea, 0x5f, 0x0c, 0xc1, 0xca, 0xb6, 0xb5, 0x67, 0x1b, 0xad, 0x4c, 0x23, 0x7f, 0xd4, 0x6f, 0xe4,
-		0xd7, 0xc4, 0x46, 0x2e, 0xe5, 0x52, 0xd0, 0xca, 0x9b, 0xb0, 0x8c, 0x5f, 0xfb, 0xd8, 0xb5, 0x8d,
-		0x56, 0x08, 0x3c, 0x91, 0xc1, 0x73, 0x5b, 0xbf, 0x28, 0x9c, 0x3f, 0x3d, 0xf3, 0x52, 0xc0, 0x2a,
-		0xd5, 0x85, 0x6a, 0x70, 0xba, 0xb1, 0x6f, 0xb5, 0xcc, 0x68, 0x12, 0xc7, 0x6e, 0xf5, 0xa8, 0xed,
-		0x8f, 0x69, 0xb3, 0xb4, 0x2b, 0x20, 0x7a, 0x62, 0xb7, 0x7a, 0xea, 0x1a, 0x9c, 0xcb, 0x5c, 0x1f,
-		0x53, 0xb0, 0xfa, 0x13, 0x05, 0x2e, 0xf1, 0x31, 0x96, 0xbf, 0x2f, 0xc7, 0x8d, 0xe7, 0xfd, 0x2a,
-		0xfd, 0x58, 0xa6, 0xd2, 0x3c, 0x76, 0xc5, 0x74, 0xab, 0xde, 0x86, 0xf5, 0x7c, 0x86, 0x72, 0x6b,
-		0xf9, 0xa1, 0x02, 0x67, 0x35, 0xec, 0xe1, 0x13, 0x23, 0xa2, 0x94, 0x49, 0xc1, 0xf5, 0xdc, 0x80,
-		0x95, 0x2c, 0x36, 0xf2, 0x55, 0x7c, 0x39, 0x04, 0x6b, 0x3b, 0xd8, 0x6d, 0x5b, 0xb6, 0xe1, 0xe3,
-		0xcc, 0x95, 0x3c, 0xed, 0x5f, 0xc9, 0x75, 0xe1, 0x4a, 0x72, 0x19, 0x7d, 0xcd, 0x2d, 0xff, 0x3c,
-		0xa8, 0xb2, 0x25, 0x72, 0xe3, 0xff, 0x91, 0x02, 0xab, 0x5b, 0xd8, 0x6b, 0xb8, 0xd6, 0x6e, 0xb6,
-		0x46, 0x9f, 0xf4, 0x6b, 0xf4, 0x03, 0xe1, 0x72, 0xf2, 0xf8, 0x14, 0x34, 0x8f, 0xff, 0x2d, 0xc1,
-		0x9a, 0x84, 0x15, 0x37, 0x91, 0x16, 0x2c, 0x46, 0xf7, 0x69, 0xc3, 0xb1, 0x9b, 0xd6, 0x1e, 0x47,
-		0x5b, 0x29, 0xd8, 0xa5, 0x18, 0x6e, 0xc6, 0x49, 0xb5, 0x05, 0x2c, 0x6c, 0x47, 0xbb, 0xb0, 0x98,
-		0xde, 0x5b, 0x76, 0x8d, 0x0f, 0xd1, 0xd9, 0x2e, 0x17, 0x9b, 0x8d, 0x5e, 0xe4, 0xf3, 0x47, 0xa2,
-		0x66, 0xf4, 0x02, 0x50, 0x07, 0xdb, 0xa6, 0x65, 0xef, 0xe9, 0x46, 0xc3, 0xb7, 0x0e, 0x2d, 0xdf,
-		0xc2, 0x5e, 0xa5, 0xb4, 0x5a, 0xca, 0xf6, 0x12, 0xd8, 0xf0, 0xdb, 0x6c, 0x74, 0x8f, 0x32, 0x9f,
-		0xed, 0x24, 0x1a, 0x2d, 0xec, 0xa1, 0x5f, 0x81, 0x99, 0x80, 0x31, 0x35, 0x13, 0x17, 0xdb, 0x95,
-		0x53, 0x94, 0x6d, 0x4d, 0xc6, 0x76, 0x93, 0x8c, 0x4d, 0x4a, 0x3e, 0xdd, 0x89, 0x75, 0xb9, 0xd8,
-		0x46, 0xdb, 0x11, 0xeb, 0xe0, 0x6a, 0xe4, 0x5e, 0x86, 0x54, 0xe2, 0xe0, 0x26, 0x4c, 0x30, 0x0d,
-		0x1a, 0xd5, 0xd7, 0x30, 0xf7, 0x8c, 0x38, 0xcb, 0x81, 0xf6, 0x02, 0x33, 0xdc, 0xec, 0x37, 0xc3,
-		0xb7, 0x84, 0x73, 0x88, 0x68, 0x0b, 0x9a, 0xde, 0x4f, 0x15, 0x98, 0xef, 0x23, 0xe7, 0xe6, 0xf6,
-		0x29, 0x4c, 0x52, 0x07, 0x3e, 0xf0, 0x25, 0x94, 0x02, 0xbe, 0xc4, 0x04, 0xa5, 0xe0, 0x2e, 0x44,
-		0x1d, 0xca, 0x01, 0x83, 0x5f, 0xc7, 0x0d, 0x1f, 0x9b, 0xdc, 0x70, 0xd4, 0xec, 0x35, 0x68, 0x7c,
-		0xa4, 0x36, 0xf5, 0x2a, 0xfe, 0x53, 0xfd, 0xbe, 0x02, 0x55, 0x0a, 0xa0, 0xdb, 0xbe, 0xd5, 0x38,
-		0xe8, 0x11, 0x77, 0xe2, 0xa1, 0xe5, 0xf9, 0x81, 0x9a, 0xea, 0xfd, 0x6a, 0xba, 0x92, 0x8d, 0xe4,
-		0x42, 0x0e, 0x05, 0x95, 0x75, 0x16, 0x96, 0x85, 0x3c, 0x38, 0xb2, 0xfc, 0x97, 0x02, 0x0b, 0xf7,
-		0xb1, 0xff, 0xa8, 0xeb, 0x1b, 0xbb, 0x2d, 0xbc, 0xed, 0x1b, 0x3e, 0xd6, 0x44, 0x6c, 0x95, 0x3e,
-		0x3c, 0xfd, 0x25, 0x40, 0x02, 0x18, 0x1d, 0x1a, 0x08, 0x46, 0x67, 0x53, 0x27, 0x0c, 0x5d, 0x83,
-		0x05, 0xfc, 0xba, 0x43, 0x15, 0xa8, 0xdb, 0xf8, 0xb5, 0xaf, 0xe3, 0x43, 0xe2, 0x93, 0x5b, 0x26,
-		0x45, 0xe8, 0x92, 0x76, 0x3a, 0xe8, 0x7d, 0x8c, 0x5f, 0xfb, 0x77, 0x49, 0x5f, 0xdd, 0x44, 0xef,
-		0xc1, 0x5c, 0xa3, 0xeb, 0x52, 0xe7, 0x7d, 0xd7, 0x35, 0xec, 0xc6, 0xbe, 0xee, 0x3b, 0x07, 0xf4,
-		0xf4, 0x28, 0xeb, 0x93, 0x1a, 0xe2, 0x7d, 0x77, 0x68, 0xd7, 0x0e, 0xe9, 0x51, 0x7f, 0x3c, 0x0e,
-		0x8b, 0xa9, 0x55, 0x73, 0x1b, 0x12, 0xaf, 0x4c, 0x39, 0xe9, 0xca, 0xee, 0xc1, 0x54, 0xc8, 0xd6,
-		0xef, 0x75, 0x30, 0xd7, 0xd5, 0x9a, 0x94, 0xe3, 0x4e, 0xaf, 0x83, 0xb5, 0xc9, 0xa3, 0xd8, 0x2f,
-		0xa4, 0xc2, 0x94, 0x48, 0x31, 0x13, 0x76, 0x4c, 0x21, 0xcf, 0x61, 0xa9, 0xe3, 0xe2, 0x43, 0xcb,
-		0xe9, 0x7a, 0xba, 0x47, 0x3c, 0x11, 0x6c, 0x46, 0xe3, 0x4f, 0xd1, 0x79, 0x97, 0x53, 0x6e, 0x70,
-		0xdd, 0xf6, 0xaf, 0xbf, 0xff, 0xdc, 0x68, 0x75, 0xb1, 0xb6, 0x10, 0x50, 0x6f, 0x33, 0xe2, 0x80,
-		0xef, 0xbb, 0x70, 0x9a, 0x3a, 0xed, 0xcc, 0xcb, 0x0e, 0x39, 0x0e, 0x53, 0x09, 0x66, 0x48, 0xd7,
-		0x3d, 0xd2, 0x13, 0x0c, 0xbf, 0x05, 0xe3, 0xd4, 0x01, 0x27, 0xcf, 0x65, 0xfa, 0x0c, 0x99, 0xd8,
-		0x38, 0x2b, 0xbe, 0xe4, 0x03, 0xab, 0x1c, 0xf3, 0xf9, 0x5f, 0xe8, 0x3e, 0xcc, 0x78, 0xd4, 0x62,
-		0xf5, 0x88, 0xc5, 0x68, 0x11, 0x16, 0x65, 0x2f, 0x61, 0xe8, 0xe8, 0x7d, 0x58, 0x68, 0xb4, 0x2c,
-		0x22, 0x69, 0xcb, 0xda, 0x75, 0x0d, 0xb7, 0xa7, 0x1f, 0x62, 0x97, 0x22, 0xe0, 0x18, 0x35, 0xe9,
-		0x39, 0xd6, 0xfb, 0x90, 0x75, 0x3e, 0x67, 0x7d, 0x31, 0xaa, 0x26, 0x36, 0xfc, 0xae, 0x8b, 0x43,
-		0xaa, 0xf1, 0x38, 0xd5, 0x3d, 0xd6, 0x19, 0x50, 0x9d, 0x83, 0x09, 0x4e, 0x65, 0xb5, 0x3b, 0xad,
-		0x0a, 0xd0, 0xa1, 0xc0, 0x9a, 0xea, 0xed, 0x4e, 0x0b, 0x79, 0x70, 0xb9, 0x7f, 0x55, 0xba, 0xd7,
-		0xd8, 0xc7, 0x66, 0xb7, 0x85, 0x75, 0xdf, 0x61, 0x9b, 0x45, 0x5f, 0x81, 0x4e, 0xd7, 0xaf, 0x4c,
-		0xe4, 0x3d, 0x58, 0xce, 0x27, 0xd7, 0xba, 0xcd, 0x39, 0xed, 0x38, 0x74, 0xdf, 0x76, 0x18, 0x1b,
-		0xe2, 0x92, 0xb0, 0xad, 0x22, 0x2f, 0xfe, 0x68, 0x21, 0x93, 0xf4, 0x21, 0x3a, 0x4b, 0xbb, 0xb6,
-		0x49, 0x4f, 0xb0, 0x8a, 0xac, 0xe3, 0x34, 0x95, 0x75, 0x9c, 0xd0, 0x43, 0x28, 0x87, 0xb6, 0xed,
-		0x91, 0xc3, 0x54, 0x29, 0xd3, 0x47, 0xe7, 0x85, 0xe4, 0x56, 0xb1, 0x48, 0x40, 0xdc, 0xbe, 0xd9,
-		0xc9, 0x0b, 0x0f, 0x06, 0xfd, 0x89, 0x1a, 0x30, 0x17, 0x72, 0x6b, 0xb4, 0x1c, 0x0f, 0x73, 0x9e,
-		0xd3, 0x94, 0xe7, 0xd5, 0x82, 0x0e, 0x03, 0x21, 0x24, 0xfc, 0xba, 0x9e, 0x16, 0x9e, 0xe7, 0xb0,
-		0x91, 0x9c, 0xf2, 0x59, 0xae, 0x08, 0x9d, 0x85, 0x42, 0xc8, 0x2d, 0x3e, 0x23, 0xba, 0x13, 0x23,
-		0xa9, 0xb9, 0x82, 0x3e, 0x0f, 0xc6, 0x6b, 0x33, 0x87, 0x7d, 0x2d, 0xe8, 0x63, 0x58, 0xb6, 0xc8,
-		0x99, 0xeb, 0xdb, 0x63, 0x6c, 0x13, 0x9c, 0x31, 0x2b, 0xb3, 0xd4, 0x0d, 0x5c, 0xb4, 0xbc, 0x24,
-		0x1a, 0xdf, 0x65, 0xdd, 0xea, 0x7f, 0x2b, 0xb0, 0xf8, 0xd4, 0x69, 0xb5, 0x7e, 0xc1, 0xd0, 0xf8,
-		0x67, 0x63, 0x50, 0x49, 0x2f, 0xfb, 0x5b, 0x38, 0xfe, 0x16, 0x8e, 0xbf, 0x89, 0x70, 0x9c, 0x75,
-		0x3e, 0x26, 0x33, 0xe1, 0x55, 0x88, 0x55, 0x53, 0x27, 0xc6, 0xaa, 0xaf, 0x1f, 0x6a, 0xab, 0xff,
-		0x38, 0x04, 0xab, 0x1a, 0x6e, 0x38, 0xae, 0x19, 0x8f, 0xd2, 0xf1, 0x63, 0xf1, 0x26, 0x91, 0xf2,
-		0x1c, 0x4c, 0x84, 0x86, 0x13, 0x82, 0x00, 0x04, 0x4d, 0x75, 0x13, 0x2d, 0xc2, 0x28, 0xb5, 0x31,
-		0x7e, 0xe2, 0x4b, 0xda, 0x08, 0xf9, 0x59, 0x37, 0xd1, 0x59, 0x00, 0xee, 0xc7, 0x07, 0x67, 0x77,
-		0x5c, 0x1b, 0xe7, 0x2d, 0x75, 0x13, 0x69, 0x30, 0xd9, 0x71, 0x5a, 0x2d, 0x3d, 0x78, 0x2b, 0x8c,
-		0x48, 0xde, 0x0a, 0x04, 0x43, 0xef, 0x39, 0x6e, 0x5c, 0x35, 0xc1, 0x5b, 0x61, 0x82, 0x30, 0xe1,
-		0x3f, 0xd4, 0x7f, 0x1d, 0x85, 0x35, 0x89, 0x16, 0x39, 0xf0, 0xa6, 0x10, 0x52, 0x39, 0x1e, 0x42,
-		0x4a, 0xd1, 0x6f, 0xe8, 0xf8, 0xe8, 0xf7, 0x0e, 0xa0, 0x40, 0xbf, 0x66, 0x3f, 0xfc, 0xce, 0x84,
-		0x3d, 0xc1, 0xe8, 0x75, 0x02, 0x60, 0x02, 0xe8, 0x2d, 0x11, 0x84, 0x4a, 0xf0, 0x4d, 0x21, 0xfa,
-		0x70, 0x1a, 0xd1, 0x63, 0xf1, 0xfc, 0x91, 0x64, 0x3c, 0xff, 0x26, 0x54, 0x38, 0xa4, 0x44, 0x01,
-		0x88, 0xe0, 0xf6, 0x1f, 0xa5, 0xb7, 0xff, 0x02, 0xeb, 0x0f, 0x6d, 0x87, 0x5f, 0xfe, 0x48, 0x83,
-		0xa9, 0x30, 0x6e, 0x4d, 0x43, 0x16, 0x2c, 0x10, 0xfe, 0x6e, 0xd6, 0x69, 0xdc, 0x71, 0x0d, 0xdb,
-		0x23, 0x50, 0x96, 0x78, 0xa6, 0x4f, 0x9a, 0xb1, 0x5f, 0xe8, 0x25, 0x9c, 0x11, 0x04, 0x44, 0x22,
-		0x08, 0x1f, 0x2f, 0x02, 0xe1, 0x4b, 0x29, 0x73, 0x0f, 0xd1, 0x3c, 0xc3, 0xb5, 0x84, 0x2c, 0xd7,
-		0x72, 0x0d, 0x26, 0x13, 0x98, 0x37, 0x41, 0x31, 0x6f, 0x62, 0x37, 0x06, 0x76, 0xb7, 0xa1, 0x1c,
-		0x6d, 0x2b, 0xcd, 0x87, 0x4c, 0xe6, 0xe6, 0x43, 0xa6, 0x42, 0x0a, 0x9a, 0x0e, 0xf9, 0x04, 0x26,
-		0x83, 0xbd, 0xa6, 0x0c, 0xa6, 0x72, 0x19, 0x4c, 0xf0, 0xf1, 0x94, 0xdc, 0x80, 0x51, 0xf2, 0x92,
-		0x27, 0x20, 0x5b, 0xa6, 0xf1, 0x97, 0xfb, 0xb5, 0x8c, 0x44, 0x67, 0x2d, 0xf7, 0x14, 0xd1, 0x10,
-		0x81, 0x85, 0xbd, 0xbb, 0xb6, 0xef, 0xf6, 0xb4, 0x80, 0x6f, 0xf5, 0x25, 0x4c, 0xc6, 0x3b, 0xd0,
-		0x0c, 0x94, 0x0e, 0x70, 0x8f, 0x83, 0x15, 0xf9, 0x13, 0xdd, 0x84, 0xe1, 0x43, 0x62, 0xfe, 0xd2,
-		0xf8, 0x43, 0x70, 0xea, 0x58, 0x1c, 0x82, 0x11, 0xdc, 0x1a, 0xba, 0xa9, 0xc4, 0x70, 0x32, 0x88,
-		0x3a, 0x7d, 0x8b, 0x93, 0x29, 0x9c, 0x8c, 0xab, 0x46, 0x88, 0x93, 0x3f, 0x2f, 0x05, 0x38, 0x29,
-		0xd4, 0x22, 0xc7, 0xc9, 0x07, 0x30, 0xdd, 0x87, 0x43, 0x52, 0xa4, 0x64, 0xf7, 0x6f, 0x8f, 0x22,
-		0x89, 0x56, 0x4e, 0xe2, 0x54, 0xca, 0x72, 0x87, 0x06, 0xb3, 0xdc, 0x18, 0x2c, 0x95, 0x92, 0xb0,
-		0xf4, 0x12, 0x56, 0x92, 0xa7, 0x4a, 0x77, 0x9a, 0xba, 0xbf, 0x6f, 0x79, 0x7a, 0x3c, 0x2f, 0x29,
-		0x9f, 0xaa, 0x9a, 0x38, 0x65, 0x4f, 0x9a, 0x3b, 0xfb, 0x96, 0x77, 0x9b, 0xf3, 0xaf, 0xc3, 0xec,
-		0x3e, 0x36, 0x5c, 0x7f, 0x17, 0x1b, 0xbe, 0x6e, 0x62, 0xdf, 0xb0, 0x5a, 0x1e, 0x0f, 0x31, 0xca,
-		0xa3, 0x6f, 0x33, 0x21, 0xd9, 0x16, 0xa3, 0x4a, 0xdf, 0x3b, 0x23, 0xc7, 0xbb, 0x77, 0x2e, 0xc1,
-		0x74, 0xc8, 0x87, 0x99, 0x35, 0x05, 0xe0, 0x71, 0x2d, 0xf4, 0x7a, 0xb6, 0x68, 0xab, 0xfa, 0x27,
-		0x0a, 0x7c, 0x87, 0xed, 0x66, 0xe2, 0x24, 0xf3, 0xf4, 0x62, 0x74, 0x5e, 0xb4, 0xfe, 0x88, 0xdd,
-		0xcd, 0xac, 0x88, 0x5d, 0x1e, 0xab, 0x82, 0xa1, 0xbb, 0xbf, 0x2d, 0xc1, 0x79, 0x39, 0x37, 0x6e,
-		0x82, 0x38, 0xba, 0xdc, 0x5c, 0xde, 0xc6, 0x45, 0xbc, 0x75, 0x7c, 0xe8, 0xd2, 0xa6, 0xbd, 0x3e,
-		0x4b, 0xff, 0xa9, 0x02, 0x2b, 0x51, 0xcc, 0x9b, 0x38, 0xc8, 0xa6, 0xe5, 0x75, 0x0c, 0xbf, 0xb1,
-		0xaf, 0xb7, 0x9c, 0x86, 0xd1, 0x6a, 0xf5, 0x2a, 0x43, 0x14, 0x30, 0x5f, 0x4a, 0x66, 0xcd, 0x5f,
-		0x4e, 0x2d, 0x0a, 0x8a, 0xef, 0x38, 0x5b, 0x7c, 0x86, 0x87, 0x6c, 0x02, 0x86, 0xa3, 0xcb, 0x46,
-		0xf6, 0x88, 0xea, 0x6f, 0xc0, 0x6a, 0x1e, 0x03, 0x01, 0xde, 0x6e, 0x25, 0xf1, 0x56, 0x1c, 0x72,
-		0x0f, 0x60, 0x80, 0xf2, 0x0a, 0x18, 0xd3, 0x6b, 0x37, 0x86, 0xbd, 0x3f, 0x52, 0x08, 0xf6, 0xa6,
-		0x96, 0x79, 0xcf, 0xb0, 0x5a, 0x91, 0x2d, 0x15, 0xcc, 0xd5, 0xe4, 0xf1, 0x29, 0x68, 0x48, 0xdf,
-		0x21, 0x38, 0x96, 0xc9, 0x89, 0x47, 0x82, 0x7f, 0xac, 0x80, 0x9a, 0x46, 0xbb, 0xcf, 0x83, 0xe3,
-		0x19, 0x48, 0xfe, 0xac, 0x5f, 0xf2, 0x1b, 0x19, 0x92, 0xe7, 0x71, 0x2a, 0x28, 0xfb, 0x53, 0x72,
-		0x38, 0x25, 0xbc, 0xb8, 0x6d, 0xbe, 0x05, 0x33, 0x0d, 0xc3, 0x6e, 0xe0, 0xf0, 0x06, 0xc0, 0xec,
-		0x4e, 0x1b, 0xd3, 0xa6, 0x59, 0xbb, 0x16, 0x34, 0xc7, 0xcf, 0x7b, 0x9c, 0xe7, 0x09, 0xcf, 0xbb,
-		0x8c, 0x55, 0xc1, 0xa5, 0x5e, 0x0c, 0x8f, 0x7b, 0x06, 0xb3, 0x58, 0x36, 0x50, 0x30, 0xf0, 0x24,
-		0x16, 0x96, 0xc9, 0x67, 0x60, 0x0b, 0x13, 0x71, 0x4a, 0x58, 0x58, 0x7a, 0x81, 0x74, 0x7f, 0x22,
-		0xc9, 0x0b, 0x5b, 0x58, 0x1e, 0xa7, 0x82, 0xb2, 0x5f, 0x10, 0x9b, 0x43, 0xc8, 0x8b, 0x4b, 0xff,
-		0x77, 0x0a, 0x9c, 0xd3, 0x70, 0xdb, 0x39, 0xc4, 0x2c, 0xcd, 0xff, 0x55, 0x09, 0xd2, 0x25, 0x1d,
-		0xa3, 0x52, 0x9f, 0x63, 0xa4, 0xaa, 0xc4, 0x56, 0xb2, 0xa4, 0xe6, 0x4b, 0xfb, 0xfb, 0x21, 0xb8,
-		0xc0, 0x97, 0xc0, 0x96, 0x9d, 0x99, 0x63, 0x96, 0x2e, 0xd0, 0x80, 0x72, 0xf2, 0x0c, 0xf2, 0xc5,
-		0xdd, 0xca, 0xd8, 0xbf, 0x02, 0x13, 0x6a, 0x53, 0x89, 0xd3, 0x8b, 0x76, 0x61, 0x31, 0x4c, 0xe3,
-		0x0b, 0x0b, 0xb5, 0xc4, 0x19, 0xde, 0xbb, 0x9c, 0xa6, 0x2f, 0xc3, 0x8b, 0x45, 0xcd, 0x03, 0xa7,
-		0xf0, 0xd7, 0xe1, 0x62, 0xde, 0x5a, 0xb8, 0x9e, 0xff, 0x41, 0x81, 0xe5, 0x20, 0x2a, 0x24, 0x78,
-		0xa5, 0xbf, 0x11, 0xf3, 0xb9, 0x0c, 0xb3, 0x96, 0xa7, 0x27, 0xeb, 0xa6, 0xa8, 0x2e, 0xc7, 0xb4,
-		0x69, 0xcb, 0xbb, 0x17, 0xaf, 0x88, 0x52, 0x57, 0xe0, 0x8c, 0x58, 0x7c, 0xbe, 0xbe, 0x9f, 0x0f,
-		0x11, 0x04, 0x23, 0x60, 0x9d, 0xcc, 0x4a, 0xa7, 0xa0, 0xf5, 0x4d, 0x2c, 0x74, 0x0d, 0x26, 0x79,
-		0x51, 0x1c, 0x36, 0x63, 0x81, 0xda, 0xb0, 0xad, 0x6e, 0xa2, 0x17, 0x70, 0xba, 0x11, 0x88, 0x1a,
-		0x9b, 0xfa, 0xd4, 0x40, 0x53, 0xa3, 0x90, 0x45, 0x34, 0xf7, 0x43, 0x98, 0x89, 0x15, 0xba, 0xb1,
-		0x47, 0xc2, 0x70, 0xd1, 0x47, 0xc2, 0x74, 0x44, 0x4a, 0x1b, 0xd4, 0x4b, 0xe4, 0xb4, 0x4a, 0xb5,
-		0xcc, 0xf7, 0xe3, 0xdf, 0x87, 0xa0, 0xa2, 0xf1, 0xf2, 0x4c, 0x4c, 0x69, 0xbd, 0xe7, 0x1b, 0x6f,
-		0x72, 0x0f, 0x5e, 0xc2, 0x7c, 0x32, 0x92, 0xd9, 0xd3, 0x2d, 0x1f, 0xb7, 0x83, 0xfa, 0x89, 0xcb,
-		0x85, 0xa2, 0x99, 0xbd, 0xba, 0x8f, 0xdb, 0xda, 0xe9, 0xc3, 0x54, 0x9b, 0x87, 0x3e, 0x80, 0x11,
-		0xaa, 0x5c, 0x8f, 0xef, 0x99, 0x38, 0xb2, 0xb1, 0x65, 0xf8, 0xc6, 0x9d, 0x96, 0xb3, 0xab, 0xf1,
-		0xc1, 0x68, 0x13, 0xca, 0x36, 0x3e, 0xd2, 0xdd, 0x2e, 0xdf, 0x9b, 0xe0, 0xe9, 0x92, 0x43, 0x3e,
-		0x69, 0xe3, 0x23, 0xad, 0xcb, 0x36, 0xc5, 0x53, 0x97, 0x61, 0x49, 0xa0, 0x6b, 0xbe, 0x13, 0x3f,
-		0x54, 0x60, 0x61, 0xbb, 0x67, 0x37, 0xb6, 0xf7, 0x0d, 0xd7, 0xe4, 0x01, 0x4e, 0xbe, 0x0f, 0x17,
-		0xa0, 0xec, 0x39, 0x5d, 0xb7, 0x81, 0x75, 0x5e, 0xb9, 0xcb, 0x37, 0x63, 0x8a, 0xb5, 0x6e, 0xb2,
-		0x46, 0xb4, 0x04, 0x63, 0x44, 0x1f, 0x66, 0x70, 0x83, 0x0d, 0x6b, 0xa3, 0xf4, 0x77, 0xdd, 0x44,
-		0x35, 0x38, 0x45, 0x5f, 0x8b, 0xa5, 0xdc, 0x27, 0x1c, 0x1d, 0xa7, 0x2e, 0xc1, 0x62, 0x4a, 0x16,
-		0x2e, 0xe7, 0x3f, 0x0d, 0xc3, 0x69, 0xd2, 0x17, 0xdc, 0x84, 0x6f, 0xd2, 0x58, 0x2a, 0x30, 0x1a,
-		0x04, 0x94, 0xd8, 0x59, 0x0d, 0x7e, 0x92, 0xa3, 0x1c, 0xbd, 0x66, 0xc3, 0x48, 0x41, 0x18, 0x59,
-		0x20, 0x3a, 0x49, 0x87, 0x91, 0x86, 0x07, 0x0d, 0x23, 0x9d, 0x05, 0x08, 0x5e, 0x55, 0x96, 0x49,
-		0x5f, 0xa1, 0x25, 0x6d, 0x9c, 0xb7, 0xd4, 0xcd, 0xd4, 0x5b, 0x7d, 0x74, 0xb0, 0xb7, 0xfa, 0x03,
-		0x9e, 0xbc, 0x89, 0x9e, 0xcd, 0x94, 0xcb, 0x58, 0x2e, 0x97, 0x59, 0x42, 0x16, 0x3a, 0xc0, 0x94,
-		0xd7, 0x75, 0x18, 0x0d, 0xde, 0xdc, 0xe3, 0x05, 0xde, 0xdc, 0xc1, 0xe0, 0x78, 0xbc, 0x00, 0x92,
-		0xf1, 0x82, 0x4f, 0x61, 0x92, 0xa5, 0x96, 0x78, 0x91, 0xef, 0x44, 0x81, 0x22, 0xdf, 0x09, 0x9a,
-		0x71, 0xe2, 0xf5, 0xbd, 0xef, 0x01, 0xad, 0xd1, 0xe5, 0x25, 0xeb, 0xba, 0x65, 0x62, 0xdb, 0xb7,
-		0xfc, 0x1e, 0x0d, 0xe6, 0x8d, 0x6b, 0x88, 0xf4, 0xbd, 0xa0, 0x5d, 0x75, 0xde, 0x83, 0x9e, 0xc0,
-		0x74, 0x1f, 0x36, 0xf0, 0xc0, 0xdd, 0xc5, 0x62, 0xa8, 0xa0, 0x95, 0x93, 0x88, 0xa0, 0x2e, 0xc0,
-		0x5c, 0xd2, 0x94, 0xb9, 0x8d, 0xff, 0x81, 0x02, 0xcb, 0x41, 0xe5, 0xda, 0x57, 0xc4, 0x89, 0x53,
-		0x7f, 0x4f, 0x81, 0x33, 0x62, 0x99, 0xf8, 0xfb, 0xe6, 0x1a, 0x2c, 0xb4, 0x59, 0x3b, 0xcb, 0xab,
-		0xe8, 0x96, 0xad, 0x37, 0x8c, 0xc6, 0x3e, 0xe6, 0x12, 0x9e, 0x6e, 0xc7, 0xa8, 0xea, 0xf6, 0x26,
-		0xe9, 0x42, 0x1f, 0xc2, 0x52, 0x8a, 0xc8, 0x34, 0x7c, 0x63, 0xd7, 0xf0, 0x30, 0x77, 0x83, 0x17,
-		0x92, 0x74, 0x5b, 0xbc, 0x57, 0x3d, 0x03, 0xd5, 0x40, 0x1e, 0xae, 0xcf, 0xcf, 0x9d, 0xb0, 0xf4,
-		0x48, 0xfd, 0xad, 0xa1, 0x48, 0x85, 0x89, 0x6e, 0x2e, 0xed, 0x3a, 0xcc, 0xd8, 0xdd, 0xf6, 0x2e,
-		0x76, 0x75, 0xa7, 0xa9, 0x53, 0x98, 0xf2, 0xa8, 0x9c, 0xc3, 0x5a, 0x99, 0xb5, 0x3f, 0x69, 0x52,
-		0xf4, 0xf1, 0x88, 0xb2, 0x03, 0x58, 0xf3, 0x68, 0xf4, 0x60, 0x58, 0x1b, 0xe3, 0xb8, 0xe6, 0xa1,
-		0x07, 0x30, 0xc9, 0x77, 0x82, 0x2d, 0x95, 0x01, 0xdc, 0xa5, 0x2c, 0x7b, 0x60, 0xf1, 0x1c, 0xba,
-		0x74, 0xea, 0xdf, 0x4d, 0x98, 0x51, 0x03, 0xba, 0x0e, 0x8b, 0x6c, 0xa2, 0x86, 0x63, 0xfb, 0xae,
-		0xd3, 0x6a, 0x61, 0x97, 0x2a, 0xa5, 0xcb, 0xee, 0x8a, 0x71, 0x6d, 0x9e, 0x76, 0x6f, 0x86, 0xbd,
-		0x0c, 0x19, 0xe9, 0x19, 0x31, 0x4d, 0x17, 0x7b, 0x1e, 0x0f, 0x3a, 0x06, 0x3f, 0xd5, 0x1a, 0xcc,
-		0xb2, 0xd4, 0x14, 0xa1, 0x0b, 0x8c, 0x27, 0x0e, 0xd3, 0x4a, 0x02, 0xa6, 0xd5, 0x39, 0x40, 0xf1,
-		0xf1, 0xdc, 0x1a, 0xff, 0x53, 0x81, 0x59, 0xe6, 0xa0, 0xc7, 0x3d, 0xc1, 0x6c, 0x36, 0xe8, 0x13,
-		0x9e, 0xc6, 0x0d, 0xb3, 0xd6, 0xe5, 0x8d, 0xd5, 0xcc, 0x1c, 0x81, 0xe1, 0x1d, 0xd0, 0xd0, 0x18,
-		0xcd, 0xe4, 0xd2, 0xb0, 0x58, 0x2c, 0xc0, 0x5a, 0x4a, 0x04, 0x58, 0x37, 0x61, 0xfa, 0xd0, 0xf2,
-		0xac, 0x5d, 0xab, 0x65, 0xf9, 0x3d, 0x06, 0x46, 0xf9, 0x31, 0xc1, 0x72, 0x44, 0x42, 0x91, 0x68,
-		0x0d, 0x26, 0xf9, 0x2d, 0xa6, 0xdb, 0x06, 0x07, 0xdd, 0x71, 0x6d, 0x82, 0xb7, 0x3d, 0x36, 0xda,
-		0x98, 0xa8, 0x21, 0xbe, 0xde, 0xe8, 0x4d, 0x3b, 0x4b, 0xeb, 0xd4, 0x9e, 0x75, 0x71, 0x17, 0x17,
-		0x50, 0x43, 0xff, 0x4c, 0x43, 0xa9, 0x99, 0x92, 0x9a, 0x2a, 0x0d, 0xaa, 0x29, 0x26, 0x68, 0x24,
-		0x11, 0x17, 0xf4, 0x8f, 0x14, 0x98, 0x0b, 0x4c, 0xff, 0xab, 0x23, 0xeb, 0x13, 0x98, 0xef, 0x13,
-		0x8a, 0x9f, 0xc4, 0xeb, 0xb0, 0xd8, 0x71, 0x9d, 0x06, 0xf6, 0x3c, 0xcb, 0xde, 0xd3, 0xe9, 0xf7,
-		0x3c, 0x0c, 0x0b, 0xc8, 0x81, 0x2c, 0x11, 0xb3, 0x8f, 0xba, 0x29, 0x25, 0x05, 0x02, 0x4f, 0xfd,
-		0xbe, 0x02, 0x67, 0xef, 0x63, 0x5f, 0x8b, 0x3e, 0xee, 0x79, 0x84, 0x3d, 0xcf, 0xd8, 0xc3, 0xa1,
-		0xdf, 0xf2, 0x19, 0x8c, 0xd0, 0x24, 0x0e, 0x63, 0x24, 0x49, 0x45, 0xc7, 0x78, 0xd0, 0x14, 0x8f,
-		0xc6, 0xe9, 0x0a, 0xa8, 0x85, 0x00, 0xcd, 0x4a, 0x96, 0x18, 0x7c, 0x85, 0xaf, 0xa0, 0xcc, 0xf4,
-		0xde, 0xe6, 0x3d, 0x5c, 0x9e, 0x07, 0x99, 0x41, 0x48, 0x39, 0xc3, 0x1a, 0x3d, 0x9f, 0x41, 0x2b,
-		0x0b, 0x38, 0x4e, 0x79, 0xf1, 0xb6, 0x6a, 0x1b, 0x50, 0x7a, 0x50, 0x3c, 0xa8, 0x38, 0xcc, 0x82,
-		0x8a, 0xb7, 0x93, 0x41, 0xc5, 0xb7, 0x0b, 0x68, 0x28, 0x94, 0x26, 0x16, 0x51, 0xb4, 0x61, 0xf5,
-		0x3e, 0xf6, 0xb7, 0x1e, 0x3e, 0x93, 0xec, 0xc6, 0x03, 0x00, 0x76, 0xac, 0xed, 0xa6, 0x13, 0x68,
-		0xa0, 0xc8, 0x7c, 0xc4, 0x96, 0x28, 0x58, 0x52, 0xf3, 0x23, 0x7f, 0x79, 0x6a, 0x0f, 0xd6, 0x24,
-		0xf3, 0x71, 0xb5, 0xef, 0xc0, 0x6c, 0xec, 0xcb, 0x2f, 0x9a, 0x53, 0x0c, 0xe6, 0xbd, 0x54, 0x70,
-		0x5e, 0x6d, 0xc6, 0x4d, 0x36, 0x78, 0xea, 0xbf, 0x28, 0x30, 0xa7, 0x61, 0xa3, 0xd3, 0x69, 0xb1,
-		0xc7, 0x4f, 0xb8, 0xbe, 0x05, 0x18, 0xe1, 0x41, 0x7c, 0x76, 0xdf, 0xf1, 0x5f, 0xf2, 0xa2, 0x7f,
-		0xf1, 0x65, 0x5d, 0x3a, 0xa9, 0x63, 0x7a, 0xbc, 0x57, 0x86, 0xba, 0x08, 0xf3, 0x7d, 0x4b, 0xe3,
-		0x90, 0xf2, 0x57, 0x0a, 0x2c, 0x6b, 0xb8, 0xe9, 0x62, 0x6f, 0x3f, 0xcc, 0x67, 0x10, 0x6d, 0x7c,
-		0x05, 0xd7, 0xae, 0xae, 0xc0, 0x19, 0xb1, 0xa8, 0x7c, 0x2d, 0x5f, 0x0e, 0xc1, 0x82, 0x86, 0x0d,
-		0x73, 0xeb, 0xe1, 0xb3, 0x7e, 0x13, 0xbd, 0x06, 0xa7, 0xc2, 0x3a, 0x82, 0xf2, 0xc6, 0xb9, 0xcc,
-		0x5b, 0xfc, 0xe1, 0x33, 0x0a, 0x6e, 0x74, 0xb0, 0xec, 0xd9, 0x93, 0x7e, 0x38, 0x95, 0x44, 0x0f,
-		0xa7, 0x1d, 0xa8, 0x58, 0x36, 0x19, 0x61, 0x1d, 0x62, 0x1d, 0xdb, 0x21, 0x4e, 0x14, 0x2c, 0xbe,
-		0x9a, 0x0f, 0x89, 0xef, 0xda, 0xc1, 0x81, 0xaf, 0x9b, 0x44, 0xf7, 0x1d, 0xc2, 0xc4, 0xb3, 0xbe,
-		0x60, 0xb7, 0xdc, 0xb0, 0x36, 0x46, 0x1a, 0xb6, 0xad, 0x2f, 0x30, 0xba, 0x08, 0xd3, 0xb4, 0x84,
-		0x80, 0x8e, 0x60, 0x99, 0xee, 0x11, 0x9a, 0xe9, 0xa6, 0x95, 0x05, 0x4f, 0x8d, 0x3d, 0xcc, 0x0a,
-		0xdf, 0xfe, 0x66, 0x08, 0x16, 0x53, 0xca, 0x0a, 0x1d, 0xbe, 0x63, 0x68, 0x4b, 0x78, 0x28, 0x87,
-		0x4e, 0x78, 0x28, 0x91, 0x01, 0x0b, 0x29, 0xae, 0x41, 0xcc, 0x6d, 0x60, 0x9c, 0x99, 0xeb, 0x67,
-		0x4f, 0x63, 0x6e, 0x02, 0x8d, 0x9d, 0x12, 0x69, 0xec, 0xdf, 0x14, 0x58, 0x7c, 0xda, 0x75, 0xf7,
-		0xf0, 0x37, 0xdc, 0xbe, 0xd4, 0x2a, 0x54, 0xd2, 0xeb, 0xe4, 0x67, 0xec, 0xaf, 0x87, 0x60, 0xf1,
-		0x11, 0xfe, 0xe6, 0x2b, 0xe1, 0xff, 0xe7, 0x90, 0xdd, 0x81, 0x4a, 0x5a, 0x59, 0xfc, 0x90, 0x09,
-		0x78, 0x28, 0x22, 0x1e, 0xbf, 0xa9, 0xc0, 0x99, 0xc7, 0x8e, 0x6f, 0x35, 0x7b, 0xe4, 0x7d, 0xeb,
-		0x1c, 0x62, 0xf7, 0x91, 0x41, 0x1e, 0xaf, 0xa1, 0xda, 0x0d, 0x58, 0x68, 0xf2, 0x1e, 0xbd, 0x4d,
-		0xbb, 0xf4, 0x84, 0x73, 0x94, 0x79, 0x44, 0x92, 0xfc, 0x98, 0x7f, 0x34, 0xd7, 0x4c, 0x37, 0x7a,
-		0xea, 0x39, 0x38, 0x9b, 0x21, 0x02, 0x37, 0x0b, 0x03, 0x96, 0xef, 0x63, 0x7f, 0xd3, 0x75, 0x3c,
-		0x8f, 0x6f, 0x4b, 0xe2, 0x16, 0x49, 0xbc, 0xb4, 0x94, 0xbe, 0x97, 0xd6, 0x05, 0x28, 0xfb, 0x86,
-		0xbb, 0x87, 0xfd, 0x70, 0x9b, 0xd9, 0x7d, 0x32, 0xc5, 0x5a, 0x39, 0x3f, 0xf5, 0x7f, 0x4a, 0x70,
-		0x46, 0x3c, 0x07, 0x57, 0x68, 0x9b, 0xf0, 0x21, 0xf0, 0xb0, 0xdb, 0x63, 0xef, 0x3e, 0xbe, 0xfe,
-		0xfb, 0x32, 0x67, 0x2c, 0x93, 0x1d, 0xf5, 0x74, 0xbd, 0x3b, 0x3d, 0xea, 0x6c, 0x31, 0x4f, 0x6c,
-		0xd2, 0x8f, 0x35, 0xa1, 0xef, 0x29, 0x30, 0xdf, 0xa4, 0x49, 0x26, 0xbd, 0x61, 0x74, 0x3d, 0x1c,
-		0x4d, 0xcb, 0x40, 0xef, 0xd1, 0xf1, 0xa6, 0x65, 0x79, 0xab, 0x4d, 0xc2, 0x31, 0x31, 0x39, 0x6a,
-		0xa6, 0x3a, 0xaa, 0xaf, 0x60, 0x36, 0x25, 0xa5, 0xc0, 0x15, 0xbc, 0x97, 0x74, 0x05, 0xdf, 0xcb,
-		0xb2, 0x87, 0x7e, 0xa1, 0xf8, 0xee, 0xc5, 0xfd, 0xc1, 0xea, 0x2b, 0x58, 0xcc, 0x90, 0x50, 0x30,
-		0xf1, 0x67, 0xf1, 0x89, 0xcb, 0xd9, 0x21, 0xd6, 0xfb, 0xd8, 0x8f, 0x52, 0x76, 0x94, 0x71, 0xdc,
-		0x05, 0xfd, 0x0f, 0x05, 0xd6, 0x79, 0x92, 0x2c, 0xa5, 0xb6, 0x54, 0x74, 0x5f, 0xf2, 0x12, 0x2a,
-		0x66, 0x67, 0xe8, 0x05, 0x33, 0xa3, 0xb0, 0x9a, 0x21, 0x08, 0x10, 0x0f, 0xa0, 0x36, 0x5e, 0xc4,
-		0x30, 0xe5, 0xc7, 0x7e, 0x79, 0xe8, 0x3c, 0x4c, 0x35, 0xb1, 0xdf, 0xd8, 0x7f, 0x8c, 0x99, 0xdf,
-		0xc2, 0xb3, 0x3a, 0xc9, 0x46, 0xd5, 0x83, 0xb7, 0x0a, 0x2c, 0x36, 0xac, 0x93, 0x1c, 0x0e, 0x9c,
-		0xdf, 0x63, 0xee, 0x2c, 0x25, 0x57, 0x3f, 0xa0, 0x1f, 0x62, 0x05, 0x87, 0x9b, 0xde, 0x95, 0x05,
-		0x02, 0x52, 0xaa, 0x4f, 0xbf, 0x64, 0x4a, 0x92, 0x71, 0xc9, 0x36, 0x60, 0x3e, 0x4a, 0x67, 0x04,
-		0xc1, 0x8f, 0x2e, 0xaf, 0x4f, 0x1a, 0xd6, 0xa2, 0x5c, 0xc7, 0x36, 0x8b, 0x7c, 0x74, 0x6d, 0x1a,
-		0x8d, 0x0e, 0x3e, 0x15, 0xe4, 0x71, 0x1b, 0x16, 0x94, 0x99, 0xe2, 0xad, 0x2c, 0x6c, 0xb3, 0xf1,
-		0xcf, 0xef, 0x00, 0xf0, 0xc0, 0xcf, 0xed, 0xa7, 0x75, 0xf4, 0x03, 0x05, 0x16, 0xc4, 0x9f, 0x3c,
-		0xa3, 0xeb, 0x99, 0x47, 0x50, 0xfa, 0xd1, 0x75, 0xf5, 0xc6, 0xc0, 0x74, 0x7c, 0xd5, 0xbf, 0xab,
-		0xc0, 0x62, 0xc6, 0xc7, 0xe4, 0x48, 0xc2, 0x54, 0xfa, 0x79, 0x7d, 0xf5, 0xe6, 0xe0, 0x84, 0x5c,
-		0x9c, 0x9f, 0x29, 0xb0, 0x9a, 0xf7, 0x5d, 0x38, 0xfa, 0x2c, 0x8f, 0x7d, 0xde, 0x37, 0xea, 0xd5,
-		0xdb, 0x27, 0xe0, 0xc0, 0x25, 0x25, 0x9b, 0x28, 0xfe, 0xe2, 0x5b, 0xb2, 0x89, 0xd2, 0x2f, 0xcd,
-		0x25, 0x9b, 0x98, 0xf3, 0x69, 0xf9, 0x1f, 0x2b, 0x50, 0xcd, 0xfe, 0x2e, 0x1a, 0x65, 0x97, 0x35,
-		0xe5, 0x7e, 0x2f, 0x5e, 0xfd, 0xe8, 0x58, 0xb4, 0x5c, 0xae, 0x3f, 0x54, 0x60, 0x29, 0xf3, 0xab,
-		0x67, 0xf4, 0x61, 0x26, 0xeb, 0xbc, 0x8f, 0xae, 0xab, 0xb7, 0x8e, 0x43, 0xca, 0x85, 0xb2, 0x61,
-		0x2a, 0xf1, 0x39, 0x2c, 0x7a, 0x37, 0x93, 0x99, 0xe8, 0xab, 0xdb, 0x6a, 0xad, 0xe8, 0x70, 0x3e,
-		0xdf, 0xf7, 0x14, 0x38, 0x2d, 0xf8, 0xa6, 0x14, 0x5d, 0x93, 0xef, 0xb6, 0xf0, 0x2b, 0xd6, 0xea,
-		0xfb, 0x83, 0x11, 0x71, 0x11, 0x7c, 0x98, 0xee, 0xfb, 0x7e, 0x13, 0x5d, 0x91, 0xdd, 0xf5, 0x82,
-		0x38, 0x7f, 0xf5, 0xbd, 0xe2, 0x04, 0x7c, 0xd6, 0x23, 0x98, 0xe9, 0xff, 0x4e, 0x09, 0x65, 0x73,
-		0xc9, 0xf8, 0x92, 0xab, 0x7a, 0x75, 0x00, 0x8a, 0x98, 0xd9, 0x65, 0x16, 0xec, 0x49, 0xcc, 0x2e,
-		0xef, 0x5b, 0x89, 0xea, 0x09, 0xea, 0x03, 0xd1, 0x9f, 0x29, 0x70, 0x46, 0x56, 0xcf, 0x87, 0x3e,
-		0x3e, 0x66, 0x19, 0x20, 0x13, 0xed, 0x93, 0x13, 0x15, 0x11, 0x72, 0x95, 0x65, 0x14, 0xbd, 0x49,
-		0x55, 0x26, 0x2f, 0xb9, 0x93, 0xaa, 0x2c, 0xa7, 0xc6, 0x2e, 0xb6, 0x8f, 0x82, 0x8a, 0xe2, 0xdc,
-		0x7d, 0xcc, 0xae, 0xe5, 0xce, 0xdd, 0x47, 0x59, 0x01, 0x73, 0x6c, 0x1f, 0x85, 0x75, 0x67, 0xf9,
-		0xfb, 0x28, 0xab, 0x7d, 0xcb, 0xdf, 0x47, 0x69, 0xb1, 0x5b, 0x7c, 0x1f, 0xd3, 0xa5, 0x65, 0xf9,
-		0xfb, 0x98, 0x59, 0xd8, 0x96, 0xbf, 0x8f, 0xd9, 0x95, 0x6c, 0xe8, 0x4f, 0x69, 0xc4, 0x2e, 0xb3,
-		0x66, 0x0c, 0x7d, 0x34, 0xd0, 0x9a, 0x93, 0x55, 0x6b, 0xd5, 0x8f, 0x8f, 0x47, 0x9c, 0x10, 0x2d,
-		0xb3, 0x60, 0x52, 0x2a, 0x5a, 0x5e, 0xc9, 0xa6, 0x54, 0xb4, 0xfc, 0x1a, 0xcd, 0x3f, 0x57, 0x60,
-		0x45, 0x5e, 0x29, 0x85, 0xbe, 0x2b, 0x99, 0xa0, 0x40, 0xb9, 0x58, 0xf5, 0xd3, 0x63, 0xd3, 0x73,
-		0x19, 0x7f, 0x5f, 0x81, 0x4a, 0x56, 0xbd, 0x1c, 0xba, 0x29, 0xe1, 0x2e, 0x2d, 0x0c, 0xac, 0x7e,
-		0x78, 0x0c, 0x4a, 0x2e, 0xd1, 0x6f, 0x2b, 0x30, 0x27, 0xaa, 0xba, 0x42, 0xd9, 0x37, 0xa7, 0xa4,
-		0xc6, 0xac, 0xfa, 0xc1, 0x80, 0x54, 0x5c, 0x8a, 0x9f, 0xd0, 0x7f, 0x4d, 0x24, 0x29, 0x3a, 0x42,
-		0x9f, 0xe4, 0xd8, 0x86, 0xbc, 0x24, 0xac, 0xfa, 0xdd, 0xe3, 0x92, 0x73, 0x01, 0xbf, 0x80, 0xd9,
-		0x54, 0xf9, 0x0d, 0xba, 0x2a, 0x61, 0x2a, 0x2e, 0x8b, 0xaa, 0x6e, 0x0c, 0x42, 0x12, 0x79, 0x23,
-		0x7d, 0x05, 0x35, 0x12, 0x6f, 0x44, 0x5c, 0x06, 0x24, 0xf1, 0x46, 0x32, 0x6a, 0x75, 0xd0, 0x01,
-		0x4c, 0xc6, 0xeb, 0x1b, 0xd0, 0x3b, 0x52, 0x0e, 0x7d, 0x15, 0x3d, 0xd5, 0x77, 0x0b, 0x8e, 0x8e,
-		0x59, 0xa1, 0xa8, 0x40, 0x41, 0x62, 0x85, 0x92, 0x1a, 0x0b, 0x89, 0x15, 0x4a, 0xab, 0x20, 0x88,
-		0xe7, 0x29, 0xa8, 0x3b, 0x90, 0x78, 0x9e, 0xd9, 0x45, 0x0c, 0xd5, 0xf7, 0x07, 0x23, 0x0a, 0xbf,
-		0xb5, 0x80, 0x28, 0x8b, 0x8f, 0x2e, 0x67, 0xf2, 0x48, 0x95, 0x06, 0x54, 0xdf, 0x2e, 0x34, 0x36,
-		0x9a, 0x26, 0xca, 0x92, 0x4b, 0xa6, 0x49, 0x95, 0x0e, 0x48, 0xa6, 0x49, 0xa7, 0xdd, 0xd9, 0x34,
-		0x41, 0x8e, 0x5b, 0x3a, 0x4d, 0x5f, 0x6a, 0x5e, 0x3a, 0x4d, 0x7f, 0xd2, 0x9c, 0xbc, 0x50, 0x12,
-		0xe9, 0x69, 0xc9, 0x0b, 0x45, 0x94, 0x5b, 0x97, 0xbc, 0x50, 0xc4, 0x59, 0xef, 0x1f, 0xb0, 0xff,
-		0x6a, 0x23, 0xc8, 0x5f, 0x4a, 0x9e, 0xb2, 0xd2, 0x74, 0xb7, 0xe4, 0x29, 0x9b, 0x93, 0x9f, 0x26,
-		0x0e, 0x4c, 0x66, 0x3a, 0x55, 0xe2, 0xc0, 0xe4, 0xa5, 0x7c, 0x25, 0x0e, 0x4c, 0x7e, 0xf6, 0xd6,
-		0x86, 0xa9, 0x44, 0x2e, 0x52, 0xb2, 0x21, 0xa2, 0x74, 0xac, 0x64, 0x43, 0x84, 0x29, 0x4e, 0x0a,
-		0x1f, 0xa2, 0xbc, 0x21, 0x92, 0x3d, 0xff, 0x32, 0x33, 0xa2, 0x12, 0xf8, 0x90, 0x25, 0x27, 0x09,
-		0x4e, 0xf7, 0xa5, 0xdb, 0x24, 0x38, 0x2d, 0xce, 0x62, 0x4a, 0x70, 0x3a, 0x2b, 0x93, 0x47, 0x5e,
-		0x8d, 0x7d, 0xa9, 0x1c, 0xd9, 0xab, 0x51, 0x9c, 0xdd, 0x92, 0xbd, 0x1a, 0x33, 0xf2, 0x44, 0x64,
-		0xe2, 0xfe, 0xcc, 0x87, 0x64, 0xe2, 0x8c, 0x8c, 0x92, 0x64, 0xe2, 0xcc, 0xb4, 0xca, 0xef, 0x28,
-		0x30, 0x2f, 0xcc, 0x55, 0xa0, 0xec, 0x8d, 0x93, 0xa5, 0x57, 0xaa, 0xd7, 0x07, 0x25, 0x8b, 0x99,
-		0x9d, 0x28, 0xd2, 0x2f, 0x31, 0x3b, 0x49, 0x0a, 0x45, 0x62, 0x76, 0xd2, 0xa4, 0xc8, 0x97, 0x4a,
-		0xf8, 0x75, 0x4c, 0x76, 0x3c, 0x19, 0xdd, 0xce, 0x73, 0xfb, 0x73, 0x03, 0xef, 0xd5, 0x3b, 0x27,
-		0x61, 0x91, 0x88, 0xac, 0xc4, 0xe3, 0xc9, 0xf2, 0xc8, 0x8a, 0x20, 0x60, 0x2d, 0x8f, 0xac, 0x88,
-		0x42, 0xd5, 0x77, 0x3e, 0xfc, 0xd5, 0x1b, 0x7b, 0x96, 0xbf, 0xdf, 0xdd, 0xad, 0x35, 0x9c, 0xf6,
-		0x95, 0xc4, 0xff, 0x0f, 0xae, 0xed, 0x61, 0x9b, 0xfd, 0x23, 0xe8, 0xd8, 0x7f, 0xa2, 0xfe, 0x88,
-		0xff, 0x79, 0x78, 0x75, 0x77, 0x84, 0xf6, 0x5d, 0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a,
-		0xa2, 0x18, 0x8f, 0xb5, 0x5a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x3c, 0xcb, 0x6e, 0x1c, 0x49,
+		0x72, 0xa8, 0x6e, 0xf1, 0x15, 0x24, 0x9b, 0x64, 0x89, 0x8f, 0x66, 0x53, 0xcf, 0x5a, 0x3d, 0x38,
+		0x9a, 0x55, 0x73, 0x44, 0xcd, 0x68, 0x34, 0x9a, 0x99, 0x9d, 0xa1, 0x48, 0x49, 0xc3, 0x81, 0x9e,
+		0x45, 0x5a, 0xb2, 0x0d, 0x43, 0x8d, 0x62, 0x77, 0x36, 0x59, 0x56, 0xb3, 0xbb, 0xa7, 0xab, 0x9a,
+		0x12, 0xf7, 0x60, 0xf8, 0x05, 0x03, 0xb3, 0x58, 0xf8, 0xb9, 0xbb, 0x30, 0x60, 0x60, 0x01, 0x63,
+		0x17, 0x58, 0x7b, 0x7c, 0xf0, 0xc1, 0xbe, 0x19, 0x3e, 0xed, 0xc9, 0x9f, 0xe0, 0x93, 0x2f, 0x0b,
+		0x1f, 0x6c, 0xc0, 0x17, 0x1b, 0x3e, 0x1a, 0xce, 0x67, 0x3d, 0x33, 0xb3, 0xaa, 0x49, 0x03, 0xd2,
+		0x8e, 0xe7, 0xd6, 0x95, 0x99, 0x11, 0x19, 0x19, 0x19, 0x11, 0x19, 0x19, 0x11, 0xd9, 0x70, 0xb1,
+		0xbf, 0x83, 0x7a, 0x2b, 0x75, 0xa7, 0x81, 0xda, 0x75, 0xb4, 0xb2, 0xe7, 0x7a, 0x7e, 0xa7, 0x77,
+		0xb8, 0x72, 0x70, 0x6d, 0xc5, 0x43, 0xbd, 0x03, 0xb7, 0x8e, 0xaa, 0xdd, 0x5e, 0xc7, 0xef, 0x98,
+		0x0b, 0x64, 0x58, 0x95, 0x0f, 0xab, 0xf2, 0x61, 0xd5, 0x83, 0x6b, 0x95, 0x33, 0xbb, 0x9d, 0xce,
+		0x6e, 0x0b, 0xad, 0xd0, 0x61, 0x3b, 0xfd, 0xe6, 0x4a, 0xa3, 0xdf, 0x73, 0x7c, 0xb7, 0xd3, 0x66,
+		0x80, 0x95, 0xb3, 0xc9, 0x7e, 0xdf, 0xdd, 0x47, 0x9e, 0xef, 0xec, 0x77, 0xf9, 0x80, 0x14, 0x82,
+		0x97, 0x3d, 0xa7, 0xdb, 0x45, 0x3d, 0x8f, 0xf7, 0x9f, 0x8b, 0x11, 0xe8, 0x74, 0x5d, 0x42, 0x5c,
+		0xbd, 0xb3, 0xbf, 0x1f, 0x4c, 0x71, 0x5e, 0x36, 0x42, 0x90, 0xc8, 0xa9, 0x90, 0x0d, 0xf9, 0xa2,
+		0x8f, 0x82, 0x01, 0x96, 0x6c, 0x80, 0xef, 0x78, 0x2f, 0x5a, 0x18, 0x8f, 0x6e, 0xcc, 0xcb, 0x4e,
+		0xef, 0x45, 0xb3, 0xd5, 0x79, 0xc9, 0xc7, 0x5c, 0x91, 0x8d, 0xe1, 0xac, 0xac, 0x25, 0xc6, 0x2e,
+		0x67, 0x8d, 0xc5, 0x1c, 0x67, 0x23, 0x2f, 0xc4, 0x46, 0x7a, 0x7b, 0x4e, 0x0f, 0x35, 0x28, 0x1b,
+		0x5a, 0x7d, 0xcf, 0xcf, 0x1c, 0x15, 0x67, 0x85, 0xa5, 0x18, 0x85, 0xb9, 0xd1, 0x47, 0x52, 0xca,
+		0xc2, 0x31, 0x3d, 0xd4, 0x6d, 0xb9, 0xf5, 0xe8, 0xf6, 0x5e, 0x54, 0x8c, 0x8c, 0x2f, 0xd5, 0xfa,
+		0xab, 0x21, 0x38, 0xbd, 0xe5, 0x3b, 0x3d, 0xff, 0x19, 0x6f, 0xbf, 0xf3, 0x0a, 0xd5, 0xfb, 0x04,
+		0x8f, 0x8d, 0xf0, 0xc4, 0x9e, 0x6f, 0xde, 0x87, 0x91, 0x1e, 0xfb, 0x59, 0x36, 0xce, 0x19, 0xcb,
+		0xe3, 0xab, 0xab, 0xd5, 0x98, 0xc8, 0x61, 0xf6, 0x60, 0x71, 0xab, 0x6a, 0x91, 0xd8, 0x02, 0x85,
+		0xb9, 0x04, 0x63, 0x8d, 0xce, 0xbe, 0xe3, 0xb6, 0x6b, 0x6e, 0xa3, 0x5c, 0xc0, 0xf8, 0xc6, 0xec,
+		0x51, 0xd6, 0xb0, 0xd9, 0x30, 0x7f, 0x03, 0xe6, 0xba, 0x98, 0xce, 0xb6, 0x5f, 0x43, 0x02, 0x41,
+		0xcd, 0x6d, 0x37, 0x3b, 0xe5, 0x22, 0x9d, 0x78, 0x59, 0x3a, 0xf1, 0x63, 0x0a, 0x11, 0xcc, 0xb8,
+		0x89, 0xc7, 0xdb, 0x27, 0xbb, 0xe9, 0x46, 0xb3, 0x0c, 0x23, 0x8e, 0xef, 0xa3, 0xfd, 0xae, 0x5f,
+		0x3e, 0x81, 0xf1, 0x0d, 0xd9, 0xe2, 0xd3, 0x5c, 0x87, 0x29, 0xf4, 0xaa, 0xeb, 0x32, 0xf5, 0xa8,
+		0x11, 0x3d, 0x28, 0x0f, 0xd1, 0x19, 0x2b, 0x55, 0xa6, 0x03, 0x55, 0xa1, 0x03, 0xd5, 0x6d, 0xa1,
+		0x24, 0x76, 0x29, 0x04, 0x21, 0x8d, 0x66, 0x13, 0x16, 0xeb, 0x9d, 0xb6, 0xef, 0xb6, 0xfb, 0xa8,
+		0xe6, 0x78, 0xb5, 0x36, 0x7a, 0x89, 0x69, 0x77, 0x7d, 0xd7, 0xc1, 0x5b, 0x5c, 0x1e, 0xc6, 0xe8,
+		0x4a, 0xab, 0x6f, 0x4b, 0x17, 0xb0, 0xce, 0xa1, 0xd6, 0xbc, 0x87, 0xe8, 0xe5, 0xa6, 0x00, 0xb1,
+		0xe7, 0xeb, 0xd2, 0x76, 0x73, 0x13, 0x66, 0x44, 0x4f, 0xa3, 0xd6, 0x74, 0xdc, 0x56, 0xbf, 0x87,
+		0xca, 0x23, 0x94, 0xdc, 0x53, 0x52, 0xfc, 0x77, 0xd9, 0x18, 0x7b, 0x3a, 0x00, 0xe3, 0x2d, 0xa6,
+		0x0d, 0xf3, 0x2d, 0xc7, 0xf3, 0x6b, 0x58, 0x69, 0xbb, 0x2d, 0x44, 0x17, 0xdf, 0x43, 0x5e, 0xbf,
+		0xe5, 0x97, 0x47, 0x35, 0xf8, 0x1e, 0x3b, 0x87, 0xad, 0x8e, 0xd3, 0xb0, 0x67, 0x09, 0xec, 0x7a,
+		0x00, 0x6a, 0x53, 0x48, 0xf3, 0x57, 0x61, 0xa9, 0xe9, 0xf6, 0x30, 0xd2, 0x06, 0xaa, 0xbb, 0x1e,
+		0xe5, 0x27, 0x56, 0xd6, 0xda, 0x8e, 0x53, 0x7f, 0xd1, 0x69, 0x36, 0xcb, 0x63, 0x14, 0xf1, 0x62,
+		0x8a, 0xaf, 0x1b, 0xdc, 0x38, 0xd9, 0x65, 0x0a, 0xbd, 0xc1, 0x81, 0xb7, 0x31, 0xec, 0x6d, 0x06,
+		0x6a, 0xbd, 0x0f, 0x67, 0x54, 0x42, 0xe6, 0x75, 0x3b, 0x6d, 0x0f, 0x99, 0x73, 0x30, 0xdc, 0xeb,
+		0x53, 0xc9, 0x32, 0xa8, 0x64, 0x0d, 0xe1, 0xaf, 0xcd, 0x86, 0xf5, 0xd3, 0x02, 0x86, 0x74, 0x77,
+		0xdb, 0x4e, 0x4b, 0x29, 0xe4, 0x0f, 0x92, 0x42, 0x7e, 0x5d, 0x2e, 0xe4, 0x5a, 0x2c, 0x39, 0xa5,
+		0xbc, 0x09, 0x4b, 0xe8, 0x15, 0x36, 0x0e, 0x18, 0x53, 0x60, 0x78, 0x42, 0x81, 0xe7, 0xb2, 0x7e,
+		0x49, 0x3a, 0x7f, 0x7a, 0xe6, 0x45, 0x81, 0x2a, 0xd5, 0x65, 0x56, 0xe1, 0x64, 0x7d, 0xcf, 0x6d,
+		0x35, 0xc2, 0x49, 0x3a, 0xed, 0xd6, 0x21, 0x95, 0xfd, 0x51, 0x7b, 0x86, 0x76, 0x09, 0xa0, 0x47,
+		0xb8, 0xc3, 0x3a, 0x0f, 0x67, 0x95, 0xeb, 0x63, 0x0c, 0xb6, 0x7e, 0x6c, 0xc0, 0x65, 0x3e, 0xc6,
+		0xf5, 0xf7, 0xf4, 0x76, 0xe3, 0x69, 0x92, 0xa5, 0x1f, 0xe9, 0x58, 0x9a, 0x85, 0x2e, 0x1f, 0x6f,
+		0xad, 0x35, 0x58, 0xce, 0x46, 0xa8, 0x97, 0x96, 0xef, 0x19, 0x70, 0x1a, 0x8f, 0x41, 0xc7, 0xb6,
+		0x88, 0x5a, 0x24, 0x39, 0xd7, 0x83, 0x65, 0x5e, 0x85, 0x46, 0xbf, 0x8a, 0xaf, 0x0a, 0x70, 0x7e,
+		0x1b, 0xf5, 0xf6, 0xdd, 0xb6, 0xe3, 0x23, 0xe5, 0x4a, 0x1e, 0x27, 0x57, 0x72, 0x43, 0xba, 0x92,
+		0x4c, 0x44, 0xbf, 0xe4, 0x92, 0x7f, 0x01, 0x2c, 0xdd, 0x12, 0xb9, 0xf0, 0xff, 0xb1, 0x01, 0xe7,
+		0x36, 0x90, 0x57, 0xef, 0xb9, 0x3b, 0x6a, 0x8e, 0x3e, 0x4a, 0x72, 0xf4, 0x3d, 0xe9, 0x72, 0xb2,
+		0xf0, 0xe4, 0x14, 0x8f, 0xff, 0x29, 0xc2, 0x79, 0x0d, 0x2a, 0x2e, 0x22, 0x2d, 0x58, 0x08, 0xcf,
+		0x53, 0x7c, 0x08, 0x34, 0xdd, 0x5d, 0x6e, 0x6d, 0xb5, 0xc6, 0x2e, 0x85, 0x70, 0x3d, 0x0a, 0x6a,
+		0xcf, 0x23, 0x69, 0xbb, 0xb9, 0x03, 0x0b, 0xe9, 0xbd, 0x65, 0xc7, 0x78, 0x81, 0xce, 0x76, 0x25,
+		0xdf, 0x6c, 0xf4, 0x20, 0x9f, 0x7b, 0x29, 0x6b, 0x36, 0x9f, 0x81, 0xd9, 0x45, 0xed, 0x86, 0xdb,
+		0xde, 0xad, 0x39, 0x75, 0xdf, 0x3d, 0xc0, 0x67, 0x23, 0xf2, 0xb0, 0xfc, 0x14, 0xd5, 0x5e, 0x02,
+		0x1b, 0xbe, 0xc6, 0x46, 0x1f, 0x52, 0xe4, 0x33, 0xdd, 0x58, 0x23, 0x46, 0x61, 0xfe, 0x1a, 0x4c,
+		0x0b, 0xc4, 0x54, 0x4c, 0xb0, 0x17, 0x81, 0xc5, 0x86, 0xa0, 0xad, 0xea, 0xd0, 0xae, 0x93, 0xb1,
+		0x71, 0xca, 0xa7, 0xba, 0x91, 0x2e, 0x8c, 0xc6, 0xdc, 0x0a, 0x51, 0x8b, 0xa3, 0x91, 0x7b, 0x19,
+		0x5a, 0x8a, 0xc5, 0x49, 0x18, 0x43, 0x2a, 0x1a, 0xad, 0x57, 0x30, 0xfb, 0x84, 0x38, 0xcb, 0x82,
+		0x7b, 0x42, 0x0c, 0xd7, 0x93, 0x62, 0xf8, 0x96, 0x74, 0x0e, 0x19, 0x6c, 0x4e, 0xd1, 0xfb, 0x89,
+		0x01, 0x73, 0x09, 0x70, 0x2e, 0x6e, 0x9f, 0xc0, 0x04, 0x75, 0xe0, 0x85, 0x2f, 0x61, 0xe4, 0xf0,
+		0x25, 0xc6, 0x29, 0x04, 0x77, 0x21, 0x36, 0xa1, 0x24, 0x10, 0xfc, 0x26, 0xaa, 0xfb, 0xa8, 0xc1,
+		0x05, 0xc7, 0x52, 0xaf, 0xc1, 0xe6, 0x23, 0xed, 0xc9, 0x2f, 0xa2, 0x9f, 0xd6, 0xef, 0x1b, 0x50,
+		0xa1, 0x06, 0x74, 0xcb, 0x77, 0xeb, 0x2f, 0x0e, 0x89, 0x3b, 0x71, 0x1f, 0x3b, 0xdd, 0x82, 0x4d,
+		0x9b, 0x49, 0x36, 0xad, 0xa8, 0x2d, 0xb9, 0x14, 0x43, 0x4e, 0x66, 0x9d, 0x86, 0x25, 0x29, 0x0e,
+		0x6e, 0x59, 0xfe, 0xd3, 0x80, 0xf9, 0x7b, 0xc8, 0x7f, 0xd0, 0xf7, 0x9d, 0x9d, 0x16, 0xc2, 0xc7,
+		0x96, 0x8f, 0x6c, 0x19, 0x5a, 0x23, 0x61, 0x4f, 0x7f, 0x05, 0x4c, 0x89, 0x19, 0x2d, 0x0c, 0x64,
+		0x46, 0x67, 0x52, 0x1a, 0x66, 0x5e, 0x07, 0xac, 0xdb, 0x5d, 0xca, 0x40, 0xec, 0xc6, 0xbe, 0xc2,
+		0xde, 0xf8, 0x01, 0xf1, 0xc9, 0x31, 0x01, 0xc4, 0x42, 0x17, 0xed, 0x93, 0xa2, 0xf7, 0x21, 0xee,
+		0xbc, 0x43, 0xfa, 0x30, 0x2d, 0xef, 0xc0, 0x6c, 0xbd, 0xdf, 0xa3, 0xce, 0xfb, 0x4e, 0xcf, 0x69,
+		0xd7, 0xf7, 0x6a, 0x7e, 0xe7, 0x05, 0xd5, 0x1e, 0x63, 0x79, 0xc2, 0x36, 0x79, 0xdf, 0x6d, 0xda,
+		0xb5, 0x4d, 0x7a, 0xac, 0x1f, 0x8c, 0xc1, 0x42, 0x6a, 0xd5, 0x5c, 0x86, 0xe4, 0x2b, 0x33, 0x8e,
+		0xbb, 0xb2, 0xbb, 0x30, 0x19, 0xa0, 0xf5, 0x0f, 0xbb, 0x88, 0xf3, 0xea, 0xbc, 0x16, 0xe3, 0x36,
+		0x1e, 0x68, 0x4f, 0xbc, 0x8c, 0x7c, 0x99, 0x16, 0x4c, 0xca, 0x18, 0x33, 0xde, 0x8e, 0x30, 0xe4,
+		0x29, 0x2c, 0x76, 0x7b, 0xe8, 0xc0, 0xed, 0xf4, 0xbd, 0x9a, 0x47, 0x3c, 0x11, 0xcc, 0xcd, 0x60,
+		0xfc, 0x09, 0x3a, 0xef, 0x52, 0xca, 0x0d, 0xde, 0x6c, 0xfb, 0x37, 0xde, 0x7d, 0xea, 0xb4, 0xfa,
+		0xc8, 0x9e, 0x17, 0xd0, 0x5b, 0x0c, 0x58, 0xe0, 0xbd, 0x0a, 0x27, 0xa9, 0xd3, 0xce, 0xbc, 0xec,
+		0x00, 0xe3, 0x10, 0xa5, 0x60, 0x9a, 0x74, 0xdd, 0x25, 0x3d, 0x62, 0xf8, 0x2d, 0x18, 0xa3, 0x0e,
+		0x38, 0xb9, 0x2e, 0xd3, 0x6b, 0xc8, 0xf8, 0xea, 0x69, 0xf9, 0x21, 0x2f, 0xa4, 0x72, 0xd4, 0xe7,
+		0xbf, 0xcc, 0x7b, 0x30, 0xed, 0x51, 0x89, 0xad, 0x85, 0x28, 0x46, 0xf2, 0xa0, 0x28, 0x79, 0x31,
+		0x41, 0x37, 0xdf, 0x85, 0xf9, 0x7a, 0xcb, 0x25, 0x94, 0xb6, 0x5c, 0x2c, 0x1d, 0x58, 0xb5, 0x0f,
+		0x50, 0x8f, 0x5a, 0xc0, 0x51, 0x2a, 0xd2, 0xb3, 0xac, 0xf7, 0x3e, 0xeb, 0x7c, 0xca, 0xfa, 0x22,
+		0x50, 0x4d, 0xe4, 0xf8, 0xf8, 0xc2, 0x12, 0x40, 0x8d, 0x45, 0xa1, 0xee, 0xb2, 0x4e, 0x01, 0x75,
+		0x16, 0xc6, 0x39, 0x94, 0x8b, 0xaf, 0x26, 0x65, 0xa0, 0x43, 0x81, 0x35, 0x6d, 0xe2, 0x16, 0xd3,
+		0x83, 0x2b, 0xc9, 0x55, 0xd5, 0xbc, 0xfa, 0x1e, 0x6a, 0xf4, 0x5b, 0x08, 0x0b, 0x2d, 0xdb, 0x2c,
+		0x7a, 0x0b, 0xec, 0xf4, 0xfd, 0xf2, 0x78, 0xd6, 0x85, 0xe5, 0x42, 0x7c, 0xad, 0x5b, 0x1c, 0xd3,
+		0x76, 0x87, 0xee, 0xdb, 0x36, 0x43, 0x43, 0x5c, 0x12, 0xb6, 0x55, 0xe4, 0xc6, 0x1f, 0x2e, 0x64,
+		0x82, 0x5e, 0x44, 0x67, 0x68, 0xd7, 0x16, 0xe9, 0x11, 0xab, 0x50, 0xa9, 0xd3, 0xa4, 0x4a, 0x9d,
+		0xb0, 0x57, 0x5a, 0x0a, 0x64, 0xdb, 0x23, 0xca, 0x54, 0x2e, 0xd1, 0x4b, 0xe7, 0xc5, 0xf8, 0x56,
+		0xb1, 0x48, 0x40, 0x54, 0xbe, 0x99, 0xe6, 0x05, 0x8a, 0x41, 0x3f, 0xcd, 0x3a, 0xcc, 0x06, 0xd8,
+		0xea, 0xad, 0x8e, 0x87, 0x38, 0xce, 0x29, 0x8a, 0xf3, 0x5a, 0x4e, 0x87, 0x81, 0x00, 0x12, 0x7c,
+		0x7d, 0xcf, 0x0e, 0xf4, 0x39, 0x68, 0x24, 0x5a, 0x3e, 0xc3, 0x19, 0x51, 0x63, 0xa1, 0x10, 0x72,
+		0x8a, 0x4f, 0xcb, 0xce, 0xc4, 0x90, 0x6a, 0xce, 0xa0, 0xcf, 0xc4, 0x78, 0x7b, 0xfa, 0x20, 0xd1,
+		0x62, 0x7e, 0x04, 0x4b, 0x2e, 0xd1, 0xb9, 0xc4, 0x1e, 0xa3, 0x36, 0xb1, 0x33, 0x8d, 0xf2, 0x0c,
+		0x75, 0x03, 0x17, 0x5c, 0x2f, 0x6e, 0x8d, 0xef, 0xb0, 0x6e, 0xeb, 0xbf, 0x0c, 0x58, 0x78, 0xdc,
+		0x69, 0xb5, 0xfe, 0x9f, 0x59, 0xe3, 0x9f, 0x8d, 0x42, 0x39, 0xbd, 0xec, 0x6f, 0xcc, 0xf1, 0x37,
+		0xe6, 0xf8, 0xeb, 0x68, 0x8e, 0x55, 0xfa, 0x31, 0xa1, 0x34, 0xaf, 0x52, 0x5b, 0x35, 0x79, 0x6c,
+		0x5b, 0xf5, 0xcb, 0x67, 0xb5, 0xad, 0x9f, 0x17, 0xe0, 0x9c, 0x8d, 0xea, 0x9d, 0x5e, 0x23, 0x1a,
+		0xa5, 0xe3, 0x6a, 0xf1, 0x3a, 0x2d, 0x25, 0x16, 0xb5, 0x40, 0x70, 0x02, 0x23, 0x00, 0xa2, 0x09,
+		0xcf, 0xbb, 0x00, 0x23, 0x54, 0xc6, 0xb8, 0xc6, 0x17, 0xed, 0x61, 0xf2, 0x89, 0x3b, 0x4e, 0x03,
+		0x70, 0x3f, 0x5e, 0xe8, 0xee, 0x98, 0x3d, 0xc6, 0x5b, 0x70, 0xb7, 0x0d, 0x13, 0x5d, 0x6c, 0x1a,
+		0x6b, 0xe2, 0xae, 0x30, 0xac, 0xb9, 0x2b, 0x10, 0x1b, 0x7a, 0xb7, 0xd3, 0x8b, 0xb2, 0x46, 0xdc,
+		0x15, 0xc6, 0x09, 0x12, 0xfe, 0x61, 0xfd, 0xcb, 0x08, 0x9c, 0xd7, 0x70, 0x91, 0x1b, 0xde, 0x94,
+		0x85, 0x34, 0x8e, 0x66, 0x21, 0xb5, 0xd6, 0xaf, 0x70, 0x74, 0xeb, 0xf7, 0x6d, 0x30, 0x05, 0x7f,
+		0x1b, 0x49, 0xf3, 0x3b, 0x1d, 0xf4, 0x88, 0xd1, 0xcb, 0xc4, 0x80, 0x49, 0x4c, 0x6f, 0x91, 0x58,
+		0xa8, 0x18, 0xde, 0x94, 0x45, 0x1f, 0x4a, 0x5b, 0xf4, 0x48, 0x3c, 0x7f, 0x38, 0x1e, 0xcf, 0xbf,
+		0x09, 0x65, 0x6e, 0x52, 0xc2, 0x00, 0x84, 0x38, 0xfd, 0x47, 0xe8, 0xe9, 0x3f, 0xcf, 0xfa, 0x03,
+		0xd9, 0xe1, 0x87, 0x3f, 0xde, 0xe9, 0xc9, 0x20, 0x6e, 0x4d, 0x43, 0x16, 0x2c, 0x10, 0x7e, 0x55,
+		0xa5, 0x8d, 0xdb, 0xd8, 0x42, 0x78, 0xc4, 0x94, 0xc5, 0xae, 0xe9, 0x13, 0x8d, 0xc8, 0x97, 0xf9,
+		0x1c, 0x4e, 0x49, 0x02, 0x22, 0xa1, 0x09, 0x1f, 0xcb, 0x63, 0xc2, 0x17, 0x53, 0xe2, 0x1e, 0x58,
+		0x73, 0x85, 0x6b, 0x09, 0x2a, 0xd7, 0xf2, 0x3c, 0x4c, 0xc4, 0x6c, 0xde, 0x38, 0xb5, 0x79, 0xe3,
+		0x3b, 0x11, 0x63, 0xb7, 0x06, 0xa5, 0x70, 0x5b, 0x69, 0x3e, 0x64, 0x22, 0x33, 0x1f, 0x32, 0x19,
+		0x40, 0xd0, 0x74, 0xc8, 0xc7, 0x30, 0x21, 0xf6, 0x9a, 0x22, 0x98, 0xcc, 0x44, 0x30, 0xce, 0xc7,
+		0x53, 0x70, 0x07, 0x46, 0xc8, 0x4d, 0x9e, 0x18, 0xd9, 0x12, 0x8d, 0xbf, 0xdc, 0xab, 0x2a, 0x12,
+		0x9d, 0xd5, 0x4c, 0x2d, 0xa2, 0x21, 0x02, 0x8c, 0xe9, 0x4e, 0xdb, 0xef, 0x1d, 0xda, 0x02, 0x6f,
+		0xe5, 0x39, 0x4c, 0x44, 0x3b, 0xcc, 0x69, 0x28, 0xbe, 0x40, 0x87, 0xdc, 0x58, 0x91, 0x9f, 0x58,
+		0x8e, 0x86, 0x0e, 0x88, 0xf8, 0x6b, 0xe3, 0x0f, 0x42, 0xeb, 0x58, 0x1c, 0x82, 0x01, 0xdc, 0x2a,
+		0xdc, 0x34, 0x22, 0x76, 0x52, 0x44, 0x9d, 0xbe, 0xb1, 0x93, 0x29, 0x3b, 0x19, 0x65, 0x8d, 0xd4,
+		0x4e, 0xfe, 0xa2, 0x28, 0xec, 0xa4, 0x94, 0x8b, 0xdc, 0x4e, 0x7e, 0x0e, 0x53, 0x09, 0x3b, 0xa4,
+		0xb5, 0x94, 0xec, 0xfc, 0x3d, 0xa4, 0x96, 0xc4, 0x2e, 0xc5, 0xed, 0x54, 0x4a, 0x72, 0x0b, 0x83,
+		0x49, 0x6e, 0xc4, 0x2c, 0x15, 0xe3, 0x66, 0xe9, 0x39, 0x9c, 0x89, 0x6b, 0x55, 0xad, 0xd3, 0xac,
+		0xf9, 0x58, 0x92, 0x6b, 0xd1, 0xbc, 0xa4, 0x7e, 0xaa, 0x4a, 0x4c, 0xcb, 0x1e, 0x35, 0xb7, 0x31,
+		0xf8, 0x1a, 0xc7, 0xbf, 0x09, 0x33, 0x7b, 0x08, 0x13, 0xb2, 0x83, 0x3d, 0xb0, 0x5a, 0x03, 0xf9,
+		0x8e, 0xdb, 0xf2, 0x78, 0x88, 0x51, 0x1f, 0x7d, 0x9b, 0x0e, 0xc0, 0x36, 0x18, 0x54, 0xfa, 0xdc,
+		0x19, 0x3e, 0xda, 0xb9, 0x73, 0x19, 0xa6, 0x02, 0x3c, 0x4c, 0xac, 0xa9, 0x01, 0x1e, 0xb3, 0x03,
+		0xaf, 0x67, 0x83, 0xb6, 0x5a, 0x3f, 0x32, 0xe0, 0x5b, 0x6c, 0x37, 0x63, 0x9a, 0xcc, 0xd3, 0x8b,
+		0xa1, 0xbe, 0xd8, 0xc9, 0x88, 0xdd, 0x4d, 0x55, 0xc4, 0x2e, 0x0b, 0x55, 0xce, 0xd0, 0xdd, 0xdf,
+		0x15, 0xe1, 0x82, 0x1e, 0x1b, 0x17, 0x41, 0x14, 0x1e, 0x6e, 0x3d, 0xde, 0xc6, 0x49, 0xbc, 0x75,
+		0x74, 0xd3, 0x65, 0x4f, 0x79, 0x09, 0x49, 0xff, 0x89, 0x01, 0x67, 0xc2, 0x98, 0x37, 0x71, 0x90,
+		0x1b, 0xae, 0xd7, 0x75, 0x7c, 0x6c, 0xce, 0x5b, 0x9d, 0xba, 0xd3, 0x6a, 0x1d, 0xe2, 0x25, 0x10,
+		0x83, 0xf9, 0x5c, 0x33, 0x6b, 0xf6, 0x72, 0xaa, 0x61, 0x50, 0x7c, 0xbb, 0xb3, 0xc1, 0x67, 0xb8,
+		0xcf, 0x26, 0x60, 0x76, 0x74, 0xc9, 0x51, 0x8f, 0xa8, 0xfc, 0x16, 0x9c, 0xcb, 0x42, 0x20, 0xb1,
+		0xb7, 0x1b, 0x71, 0x7b, 0x2b, 0x0f, 0xb9, 0x0b, 0x33, 0x40, 0x71, 0x09, 0xc4, 0xf4, 0xd8, 0x8d,
+		0xd8, 0x5e, 0x92, 0xab, 0x91, 0x2c, 0x93, 0x24, 0xbe, 0x43, 0x59, 0xca, 0x99, 0xab, 0xc9, 0xc2,
+		0x93, 0x53, 0x90, 0xbe, 0x45, 0xec, 0x98, 0x12, 0x13, 0x8f, 0x04, 0xff, 0xc0, 0x00, 0x2b, 0x6d,
+		0xed, 0x3e, 0x13, 0xea, 0x29, 0x28, 0x7f, 0x92, 0xa4, 0xfc, 0x7d, 0x05, 0xe5, 0x59, 0x98, 0x72,
+		0xd2, 0xfe, 0x98, 0x28, 0xa7, 0x06, 0x17, 0x97, 0xcd, 0xb7, 0x60, 0xba, 0x8e, 0x9d, 0x08, 0x14,
+		0x9c, 0x00, 0x88, 0x9d, 0x69, 0xa3, 0xf6, 0x14, 0x6b, 0xb7, 0x45, 0x73, 0x54, 0xdf, 0xa3, 0x38,
+		0x8f, 0xa9, 0xef, 0x3a, 0x54, 0x39, 0x97, 0x7a, 0x29, 0x50, 0x77, 0x05, 0xb2, 0x48, 0x36, 0x50,
+		0x32, 0xf0, 0x38, 0x12, 0xa6, 0xc4, 0x33, 0xb0, 0x84, 0xc9, 0x30, 0xc5, 0x24, 0x2c, 0xbd, 0x40,
+		0xba, 0x3f, 0x21, 0xe5, 0xb9, 0x25, 0x2c, 0x0b, 0x53, 0x4e, 0xda, 0x2f, 0xca, 0xc5, 0x21, 0xc0,
+		0xc5, 0xa9, 0xff, 0x7b, 0x03, 0xce, 0xda, 0x68, 0xbf, 0x73, 0x80, 0x58, 0x9a, 0xff, 0x4d, 0x09,
+		0xd2, 0xc5, 0x1d, 0xa3, 0x62, 0xc2, 0x31, 0xb2, 0x2c, 0x22, 0x2b, 0x2a, 0xaa, 0xf9, 0xd2, 0xfe,
+		0xa1, 0x00, 0x17, 0xf9, 0x12, 0xd8, 0xb2, 0x95, 0x39, 0x66, 0xed, 0x02, 0x1d, 0x28, 0xc5, 0x75,
+		0x90, 0x2f, 0xee, 0x96, 0x62, 0xff, 0x72, 0x4c, 0x68, 0x4f, 0xc6, 0xb4, 0x97, 0x64, 0x78, 0x83,
+		0x34, 0xbe, 0xb4, 0x50, 0x4b, 0x9e, 0xe1, 0xbd, 0xc3, 0x61, 0x12, 0x19, 0x5e, 0x24, 0x6b, 0x1e,
+		0x38, 0x85, 0xbf, 0x0c, 0x97, 0xb2, 0xd6, 0xc2, 0xf9, 0xfc, 0x8f, 0x06, 0x2c, 0x89, 0xa8, 0x90,
+		0xe4, 0x96, 0xfe, 0x5a, 0xc4, 0xe7, 0x0a, 0xcc, 0x60, 0x2f, 0x30, 0x5e, 0x37, 0x45, 0x79, 0x89,
+		0x2d, 0xa7, 0xeb, 0xdd, 0x8d, 0x56, 0x44, 0x59, 0x67, 0xe0, 0x94, 0x9c, 0x7c, 0xbe, 0xbe, 0x5f,
+		0x14, 0x88, 0x05, 0x23, 0xc6, 0x3a, 0x9e, 0x95, 0x4e, 0x99, 0xd6, 0xd7, 0xb1, 0x50, 0x7c, 0xf7,
+		0xe4, 0x45, 0x71, 0xd8, 0x4d, 0x0a, 0x03, 0xb5, 0x41, 0x1b, 0x9e, 0xf9, 0x19, 0xde, 0x79, 0x41,
+		0x6a, 0x64, 0xea, 0x13, 0x03, 0x4d, 0x6d, 0x06, 0x28, 0xc2, 0xb9, 0xef, 0xe3, 0xd3, 0x29, 0x2c,
+		0x74, 0x63, 0x97, 0x84, 0xa1, 0xbc, 0x97, 0x84, 0xa9, 0x10, 0x94, 0x36, 0x58, 0x97, 0x89, 0xb6,
+		0x6a, 0xb9, 0xcc, 0xf7, 0xe3, 0xdf, 0x0a, 0x50, 0xb6, 0x79, 0x79, 0x26, 0xa2, 0xb0, 0xde, 0xd3,
+		0xd5, 0xd7, 0xb9, 0x07, 0xcf, 0x61, 0x2e, 0x1e, 0xc9, 0x3c, 0xac, 0xb9, 0xf8, 0x02, 0x21, 0xea,
+		0x27, 0xae, 0xe4, 0x8a, 0x66, 0x1e, 0x6e, 0x62, 0x10, 0xfb, 0xe4, 0x41, 0xaa, 0xcd, 0x33, 0xdf,
+		0x83, 0x61, 0xca, 0x5c, 0x8f, 0xef, 0x99, 0x3c, 0xb2, 0xb1, 0xe1, 0xf8, 0xce, 0xed, 0x56, 0x67,
+		0xc7, 0xe6, 0x83, 0xcd, 0x75, 0x28, 0x91, 0x9a, 0x49, 0x52, 0xcc, 0xc4, 0xc1, 0x87, 0xf2, 0x80,
+		0x4f, 0x60, 0x20, 0xbb, 0xcf, 0x36, 0xc5, 0xb3, 0x96, 0x60, 0x51, 0xc2, 0x6b, 0xbe, 0x13, 0xdf,
+		0x33, 0x60, 0x7e, 0xeb, 0xb0, 0x5d, 0xdf, 0xc2, 0x4b, 0x6a, 0xf0, 0x00, 0x27, 0xdf, 0x87, 0x8b,
+		0x50, 0xf2, 0x3a, 0xfd, 0x5e, 0x1d, 0xd5, 0x78, 0xe5, 0x2e, 0xdf, 0x8c, 0x49, 0xd6, 0xba, 0xce,
+		0x1a, 0xcd, 0x45, 0x18, 0x25, 0xfc, 0x68, 0x88, 0x13, 0x0c, 0x5f, 0xee, 0xe8, 0x37, 0xde, 0xac,
+		0x2a, 0x9c, 0xa0, 0xb7, 0xc5, 0x62, 0xe6, 0x15, 0x8e, 0x8e, 0xb3, 0x16, 0x61, 0x21, 0x45, 0x0b,
+		0xa7, 0xf3, 0x9f, 0x86, 0xe0, 0x24, 0xe9, 0x13, 0x27, 0xe1, 0xeb, 0x14, 0x16, 0x7c, 0x9b, 0x15,
+		0x01, 0x25, 0xa6, 0xab, 0xe2, 0x93, 0xa8, 0x72, 0x78, 0x9b, 0x0d, 0x22, 0x05, 0x41, 0x64, 0x81,
+		0xf0, 0x24, 0x1d, 0x46, 0x1a, 0x1a, 0x34, 0x8c, 0x84, 0x0f, 0x56, 0x71, 0xab, 0xc2, 0x73, 0x0c,
+		0xd3, 0x39, 0xc6, 0x78, 0x0b, 0x9e, 0x21, 0x79, 0x57, 0x1f, 0x19, 0xec, 0xae, 0xfe, 0x39, 0x4f,
+		0xde, 0x84, 0xd7, 0x66, 0x8a, 0x65, 0x34, 0x13, 0xcb, 0x0c, 0x01, 0x0b, 0x1c, 0x60, 0x8a, 0xeb,
+		0x06, 0x8c, 0x88, 0x3b, 0xf7, 0x58, 0x8e, 0x3b, 0xb7, 0x18, 0x1c, 0x8d, 0x17, 0x40, 0x3c, 0x5e,
+		0xf0, 0x09, 0x4c, 0xb0, 0xd4, 0x12, 0x2f, 0xf2, 0x1d, 0xcf, 0x51, 0xe4, 0x3b, 0x4e, 0x33, 0x4e,
+		0xbc, 0xbe, 0xf7, 0x1d, 0xa0, 0x35, 0xba, 0xbc, 0x64, 0x1d, 0x33, 0x10, 0x2b, 0x04, 0x96, 0x27,
+		0x1a, 0xcc, 0x1b, 0xb3, 0x4d, 0xd2, 0xf7, 0x8c, 0x76, 0x6d, 0xf2, 0x1e, 0xec, 0xb0, 0x4e, 0x25,
+		0x6c, 0x03, 0x0f, 0xdc, 0x5d, 0xca, 0x67, 0x15, 0xec, 0x52, 0xdc, 0x22, 0x58, 0xf3, 0x30, 0x1b,
+		0x17, 0x65, 0x2e, 0xe3, 0x7f, 0x82, 0x4f, 0x61, 0x51, 0xb9, 0xf6, 0x86, 0x38, 0x71, 0xd6, 0x1f,
+		0x1a, 0x70, 0x4a, 0x4e, 0x13, 0xbf, 0xdf, 0x5c, 0x87, 0xf9, 0x7d, 0xd6, 0xce, 0xf2, 0x2a, 0xd8,
+		0xe7, 0xa9, 0xd5, 0x1d, 0x2c, 0xaf, 0x9c, 0xc2, 0x93, 0xfb, 0x11, 0xa8, 0xcd, 0xf6, 0x3a, 0xe9,
+		0x32, 0x3f, 0x80, 0xc5, 0x14, 0x50, 0x03, 0x5b, 0xaf, 0x1d, 0xc7, 0x43, 0xdc, 0x0d, 0x9e, 0x8f,
+		0xc3, 0x6d, 0xf0, 0x5e, 0xeb, 0x14, 0x54, 0x04, 0x3d, 0x9c, 0x9f, 0x9f, 0x75, 0x82, 0xd2, 0x23,
+		0xeb, 0x77, 0x0b, 0x21, 0x0b, 0x63, 0xdd, 0x9c, 0xda, 0x65, 0x98, 0x6e, 0xf7, 0xf7, 0x31, 0x33,
+		0x48, 0x98, 0x89, 0x9a, 0x29, 0x8f, 0xd2, 0x39, 0x64, 0x97, 0x58, 0xfb, 0xa3, 0x26, 0xb5, 0x3e,
+		0x1e, 0x61, 0xb6, 0x30, 0x6b, 0x1e, 0x8d, 0x1e, 0x0c, 0xd9, 0xa3, 0xdc, 0xae, 0x79, 0x58, 0x47,
+		0x26, 0xf8, 0x4e, 0xb0, 0xa5, 0x32, 0x03, 0x77, 0x59, 0x25, 0x0f, 0x2c, 0x9e, 0x43, 0x97, 0x4e,
+		0xfd, 0xbb, 0xf1, 0x46, 0xd8, 0x80, 0x75, 0x64, 0x81, 0x4d, 0x44, 0x4a, 0xd1, 0x7b, 0x9d, 0x56,
+		0x0b, 0x13, 0xe7, 0x51, 0xe3, 0x47, 0xcd, 0xc7, 0x98, 0x3d, 0x47, 0xbb, 0xd7, 0x83, 0x5e, 0x66,
+		0x19, 0xa9, 0x8e, 0x34, 0x1a, 0x3d, 0xe4, 0x79, 0x3c, 0xe8, 0x28, 0x3e, 0xad, 0x2a, 0xcc, 0xb0,
+		0xd4, 0x14, 0x81, 0x13, 0xc2, 0x13, 0x35, 0xd3, 0x46, 0xcc, 0x4c, 0x5b, 0xb3, 0x60, 0x46, 0xc7,
+		0x73, 0x69, 0xfc, 0x0f, 0x03, 0x66, 0x98, 0x83, 0x1e, 0xf5, 0x04, 0xd5, 0x68, 0xb0, 0xdd, 0x61,
+		0x69, 0xdc, 0x20, 0x6b, 0x5d, 0x5a, 0x3d, 0xa7, 0xcc, 0x11, 0xe0, 0x81, 0x34, 0x34, 0x46, 0x33,
+		0xb9, 0x34, 0x2c, 0x16, 0x09, 0xb0, 0x16, 0x63, 0x01, 0xd6, 0x75, 0xac, 0x7f, 0xd8, 0xa5, 0xdb,
+		0x71, 0x5b, 0x58, 0x59, 0x98, 0x31, 0xca, 0x8e, 0x09, 0x96, 0x42, 0x10, 0x6a, 0x89, 0xb0, 0x65,
+		0xe6, 0xa7, 0x58, 0xad, 0xed, 0x70, 0xa3, 0x3b, 0x66, 0x8f, 0xf3, 0xb6, 0x87, 0xb8, 0x89, 0xb0,
+		0x21, 0xba, 0xde, 0xf0, 0x4e, 0x3b, 0x43, 0xeb, 0xd4, 0x9e, 0x90, 0x27, 0x27, 0x39, 0xd8, 0x90,
+		0x9c, 0xa9, 0x90, 0x9a, 0x29, 0xce, 0xa9, 0xe2, 0xa0, 0x9c, 0x62, 0x84, 0x86, 0x14, 0x71, 0x42,
+		0xff, 0xcc, 0x80, 0x59, 0x21, 0xfa, 0x6f, 0x0e, 0xad, 0x8f, 0x60, 0x2e, 0x41, 0x14, 0xd7, 0x44,
+		0x2c, 0xf6, 0x78, 0xdb, 0xea, 0x58, 0x5e, 0x49, 0xf5, 0x27, 0x7d, 0xcf, 0xc3, 0x6c, 0x01, 0x51,
+		0xc8, 0x22, 0x11, 0xfb, 0xb0, 0x9b, 0x42, 0x52, 0x43, 0xe0, 0x91, 0xea, 0xc5, 0xd3, 0xf7, 0x90,
+		0x6f, 0x87, 0x8f, 0x7b, 0x1e, 0xe0, 0x41, 0xce, 0x2e, 0x0a, 0xfc, 0x96, 0x4f, 0x61, 0x98, 0x26,
+		0x71, 0x18, 0x22, 0x4d, 0x2a, 0x3a, 0x82, 0x83, 0xa6, 0x78, 0x6c, 0x0e, 0x97, 0x83, 0x2d, 0xc4,
+		0xd0, 0x9c, 0x51, 0x91, 0xc1, 0x57, 0xf8, 0x05, 0x3e, 0xe9, 0x29, 0xdf, 0xf7, 0x79, 0x0f, 0xa7,
+		0xe7, 0x73, 0x65, 0x10, 0x52, 0x8f, 0xb0, 0x4a, 0xf5, 0x53, 0xb4, 0xb2, 0x80, 0xe3, 0xa4, 0x17,
+		0x6d, 0xab, 0xec, 0x83, 0x99, 0x1e, 0x14, 0x0d, 0x2a, 0x0e, 0xb1, 0xa0, 0xe2, 0x5a, 0x3c, 0xa8,
+		0xf8, 0x76, 0x0e, 0x0e, 0x05, 0xd4, 0x44, 0x22, 0x8a, 0x6d, 0x38, 0x87, 0x49, 0xde, 0xb8, 0xff,
+		0x44, 0xb3, 0x1b, 0x9f, 0x03, 0x30, 0xb5, 0xc6, 0x86, 0x4f, 0x70, 0x20, 0xcf, 0x7c, 0x44, 0x96,
+		0xa8, 0xb1, 0xa4, 0xe2, 0x47, 0x7e, 0x79, 0xd6, 0x21, 0x9c, 0xd7, 0xcc, 0xc7, 0xd9, 0xbe, 0x0d,
+		0x33, 0x91, 0x97, 0x5f, 0x34, 0xa7, 0x28, 0xe6, 0xbd, 0x9c, 0x73, 0x5e, 0x7b, 0xba, 0x17, 0x6f,
+		0xf0, 0xac, 0x7f, 0xc6, 0xda, 0x65, 0x23, 0xa7, 0xdb, 0x6d, 0xb1, 0xcb, 0x4f, 0xb0, 0xbe, 0x79,
+		0x18, 0xe6, 0x41, 0x7c, 0x76, 0xde, 0xf1, 0x2f, 0x7d, 0xd1, 0xbf, 0xfc, 0xb0, 0x2e, 0x1e, 0xd7,
+		0x31, 0x3d, 0xda, 0x2d, 0xc3, 0x5a, 0x80, 0xb9, 0xc4, 0xd2, 0xb8, 0x49, 0xf9, 0x6b, 0x83, 0xd4,
+		0xe8, 0x36, 0xf1, 0xa1, 0xb2, 0x17, 0xe4, 0x33, 0x08, 0x37, 0xde, 0xc0, 0xb5, 0x93, 0x10, 0x80,
+		0x9c, 0x54, 0xbe, 0x96, 0xaf, 0x0a, 0x30, 0x8f, 0x57, 0xd9, 0xc0, 0xd2, 0x93, 0x14, 0xd1, 0xeb,
+		0xf8, 0x9a, 0x22, 0xea, 0x08, 0x4a, 0xab, 0x67, 0x95, 0xa7, 0xf8, 0xfd, 0x27, 0xd4, 0xb8, 0xd1,
+		0xc1, 0xba, 0x6b, 0x4f, 0xfa, 0xe2, 0x54, 0x94, 0x5d, 0x9c, 0xb6, 0xa1, 0xec, 0xb6, 0xc9, 0x08,
+		0xf7, 0x00, 0xd5, 0x50, 0x3b, 0xb0, 0x13, 0x39, 0x8b, 0xaf, 0xe6, 0x02, 0xe0, 0x3b, 0x6d, 0xa1,
+		0xf0, 0x78, 0x72, 0xcc, 0xfb, 0x2e, 0x41, 0xe2, 0xb9, 0xdf, 0x65, 0xa7, 0x1c, 0xf6, 0x5b, 0x48,
+		0xc3, 0x16, 0xfe, 0x36, 0x2f, 0xc1, 0x14, 0x2d, 0x21, 0xa0, 0x23, 0x58, 0xa6, 0x7b, 0x98, 0x66,
+		0xba, 0x69, 0x65, 0xc1, 0x63, 0xdc, 0xca, 0x0a, 0xdf, 0xfe, 0xb6, 0x00, 0x0b, 0x29, 0x66, 0x05,
+		0x0e, 0xdf, 0x11, 0xb8, 0x25, 0x55, 0xca, 0xc2, 0x31, 0x95, 0xd2, 0x74, 0x60, 0x3e, 0x85, 0x55,
+		0xc4, 0xdc, 0x06, 0xb6, 0x33, 0xb3, 0x49, 0xf4, 0x34, 0xe6, 0x26, 0xe1, 0xd8, 0x09, 0x19, 0xc7,
+		0xfe, 0x95, 0x54, 0x48, 0xf6, 0x7b, 0xbb, 0xe8, 0x6b, 0x2e, 0x5f, 0x56, 0x05, 0xca, 0xe9, 0x75,
+		0x72, 0x1d, 0xfb, 0x1b, 0x2c, 0x36, 0x0f, 0xd0, 0xd7, 0x9f, 0x09, 0xff, 0x37, 0x4a, 0x76, 0x1b,
+		0xca, 0x69, 0x66, 0x71, 0x25, 0x93, 0xe0, 0x30, 0x64, 0x38, 0x7e, 0x07, 0x5f, 0xcf, 0x1e, 0x76,
+		0x7c, 0xb7, 0x79, 0x48, 0xee, 0xb7, 0xd8, 0x77, 0xed, 0x3d, 0x70, 0xc8, 0xe5, 0x35, 0x60, 0x3b,
+		0x56, 0x91, 0x26, 0xef, 0xa9, 0xed, 0xd3, 0xae, 0x5a, 0xcc, 0x39, 0x52, 0xaa, 0x48, 0x1c, 0x1f,
+		0xf3, 0x8f, 0x66, 0x9b, 0xe9, 0x46, 0xcf, 0x3a, 0x0b, 0xa7, 0x15, 0x24, 0x70, 0xb1, 0x70, 0x60,
+		0x09, 0x1f, 0xdb, 0xeb, 0xbd, 0x8e, 0xe7, 0xf1, 0x6d, 0x89, 0x9d, 0x22, 0xb1, 0x9b, 0x96, 0x91,
+		0xb8, 0x69, 0xe1, 0x6d, 0xf6, 0x1d, 0xcc, 0x24, 0x3f, 0xd8, 0x66, 0x76, 0x9e, 0x4c, 0xb2, 0x56,
+		0x8e, 0xcf, 0xfa, 0xef, 0x22, 0x9c, 0x92, 0xcf, 0xc1, 0x19, 0xba, 0x4f, 0xf0, 0x10, 0xf3, 0xb0,
+		0x73, 0xc8, 0xee, 0x7d, 0x7c, 0xfd, 0xf7, 0x74, 0xce, 0x98, 0x12, 0x1d, 0xf5, 0x74, 0xbd, 0xdb,
+		0x87, 0xd4, 0xd9, 0x62, 0x9e, 0xd8, 0x84, 0x1f, 0x69, 0x32, 0x7f, 0xdb, 0x80, 0xb9, 0x26, 0x4d,
+		0x32, 0xe1, 0x1b, 0x62, 0xdf, 0x43, 0xe1, 0xb4, 0xcc, 0xe8, 0x3d, 0x38, 0xda, 0xb4, 0x2c, 0x6f,
+		0xb5, 0x4e, 0x30, 0xc6, 0x26, 0x37, 0x9b, 0xa9, 0x8e, 0xca, 0x17, 0x30, 0x93, 0xa2, 0x52, 0xe2,
+		0x0a, 0xde, 0x8d, 0xbb, 0x82, 0xef, 0xa8, 0xe4, 0x21, 0x49, 0x14, 0xdf, 0xbd, 0xa8, 0x3f, 0x88,
+		0xa7, 0x5c, 0x50, 0x50, 0x28, 0x99, 0xf8, 0xd3, 0xe8, 0xc4, 0x25, 0x75, 0x88, 0x15, 0x33, 0x24,
+		0x4c, 0xd9, 0x51, 0xc4, 0x51, 0x17, 0xf4, 0xdf, 0x0d, 0x58, 0xe6, 0x49, 0xb2, 0x14, 0xdb, 0x52,
+		0xd1, 0x7d, 0xcd, 0x4d, 0x28, 0x9f, 0x9c, 0x99, 0xcf, 0x98, 0x18, 0x05, 0xd5, 0x0c, 0x22, 0x40,
+		0x3c, 0x00, 0xdb, 0x78, 0x11, 0xc3, 0xa4, 0x1f, 0xf9, 0xf2, 0xcc, 0x0b, 0x30, 0xd9, 0x44, 0x7e,
+		0x7d, 0xef, 0x21, 0x62, 0x7e, 0x0b, 0xcf, 0xea, 0xc4, 0x1b, 0x2d, 0x0f, 0xde, 0xca, 0xb1, 0xd8,
+		0xa0, 0x4e, 0x72, 0x48, 0x38, 0xbf, 0x47, 0xdc, 0x59, 0x0a, 0x6e, 0xbd, 0x47, 0x1f, 0x62, 0x09,
+		0xe5, 0xa6, 0x67, 0x65, 0x8e, 0x80, 0x94, 0xe5, 0xd3, 0x97, 0x4c, 0x71, 0x30, 0x4e, 0xd9, 0x2a,
+		0xcc, 0x85, 0xe9, 0x0c, 0x11, 0xfc, 0xe8, 0xf3, 0xfa, 0xa4, 0x21, 0x3b, 0xcc, 0x75, 0x6c, 0xb1,
+		0xc8, 0x07, 0xee, 0x22, 0x1b, 0x24, 0x9e, 0x0a, 0xf2, 0xb8, 0x0d, 0x0b, 0xca, 0x4c, 0xf2, 0x56,
+		0x16, 0xb6, 0xb1, 0x7e, 0x6a, 0x10, 0x37, 0x8f, 0x56, 0xfd, 0x25, 0xdf, 0xff, 0xbd, 0x49, 0xde,
+		0xea, 0x22, 0xf1, 0xaf, 0x12, 0x54, 0x32, 0xe6, 0xac, 0xfe, 0xfc, 0x2a, 0x00, 0x0f, 0x5d, 0xad,
+		0x3d, 0xde, 0x34, 0xbf, 0x24, 0x01, 0x7a, 0xe9, 0xa3, 0x6d, 0xf3, 0x86, 0xd2, 0x88, 0x68, 0x9f,
+		0x8d, 0x57, 0xde, 0x1f, 0x18, 0x8e, 0xef, 0xdb, 0xf7, 0xb1, 0x93, 0xa3, 0x78, 0x0e, 0x6f, 0x6a,
+		0x90, 0x6a, 0xff, 0x20, 0xa0, 0x72, 0x73, 0x70, 0x40, 0x4e, 0xce, 0xcf, 0x0c, 0x38, 0x97, 0xf5,
+		0xb2, 0xdd, 0xfc, 0x34, 0x0b, 0x7d, 0xd6, 0x2b, 0xfb, 0xca, 0xda, 0x31, 0x30, 0x70, 0x4a, 0xbf,
+		0x64, 0x52, 0x89, 0x06, 0xda, 0x44, 0xed, 0x5b, 0x79, 0xcd, 0x26, 0x66, 0x3c, 0x8e, 0xff, 0xa1,
+		0x01, 0x15, 0xf5, 0xcb, 0x6e, 0x53, 0x5d, 0x98, 0x95, 0xf9, 0xe2, 0xbd, 0xf2, 0xe1, 0x91, 0x60,
+		0x39, 0x5d, 0x7f, 0x6a, 0xc0, 0xa2, 0xf2, 0xdd, 0xb6, 0xf9, 0x81, 0x12, 0x75, 0xd6, 0xb3, 0xf1,
+		0xca, 0xad, 0xa3, 0x80, 0x72, 0xa2, 0xda, 0x30, 0x19, 0x7b, 0xd0, 0x6b, 0x5e, 0x55, 0x22, 0x93,
+		0xbd, 0x1b, 0xae, 0x54, 0xf3, 0x0e, 0xe7, 0xf3, 0x61, 0xbf, 0xe1, 0xa4, 0xe4, 0x55, 0xac, 0x79,
+		0x5d, 0xbf, 0xdb, 0xd2, 0x77, 0xb8, 0x95, 0x77, 0x07, 0x03, 0xe2, 0x24, 0xf8, 0x30, 0x95, 0x78,
+		0x81, 0x6a, 0xae, 0xe8, 0xbc, 0x15, 0x49, 0xa6, 0xa2, 0xf2, 0x4e, 0x7e, 0x00, 0x3e, 0xeb, 0x4b,
+		0x98, 0x4e, 0xbe, 0xb4, 0x32, 0xd5, 0x58, 0x14, 0x6f, 0xd1, 0x2a, 0xd7, 0x06, 0x80, 0x88, 0x88,
+		0x9d, 0xb2, 0xe4, 0x50, 0x23, 0x76, 0x59, 0xaf, 0x3d, 0x2a, 0xc7, 0xa8, 0x70, 0x34, 0xff, 0xc2,
+		0x20, 0xd1, 0x0c, 0x75, 0x45, 0xa2, 0xf9, 0xd1, 0x11, 0x0b, 0x19, 0x19, 0x69, 0x1f, 0x1f, 0xab,
+		0x0c, 0x92, 0xb3, 0x4c, 0x51, 0xb6, 0xa7, 0x65, 0x99, 0xbe, 0x68, 0x50, 0xcb, 0xb2, 0x8c, 0x2a,
+		0xc1, 0xc8, 0x3e, 0x4a, 0x6a, 0xa2, 0x33, 0xf7, 0x51, 0x5d, 0x8d, 0x9e, 0xb9, 0x8f, 0xba, 0x12,
+		0xec, 0xc8, 0x3e, 0x4a, 0x2b, 0xe7, 0xb2, 0xf7, 0x51, 0x57, 0xbd, 0x97, 0xbd, 0x8f, 0xda, 0x72,
+		0xbd, 0xe8, 0x3e, 0xa6, 0x8b, 0xe3, 0xb2, 0xf7, 0x51, 0x59, 0x9a, 0x97, 0xbd, 0x8f, 0xea, 0x5a,
+		0x3c, 0xf3, 0xcf, 0x69, 0xcc, 0x51, 0x59, 0xf5, 0x66, 0x7e, 0x38, 0xd0, 0x9a, 0xe3, 0x75, 0x77,
+		0x95, 0x8f, 0x8e, 0x06, 0x1c, 0x23, 0x4d, 0x59, 0xf2, 0xa9, 0x25, 0x2d, 0xab, 0xe8, 0x54, 0x4b,
+		0x5a, 0x76, 0x95, 0xe9, 0x5f, 0x1a, 0xe4, 0x4f, 0x71, 0x74, 0xb5, 0x5e, 0xe6, 0x77, 0x34, 0x13,
+		0xe4, 0x28, 0x78, 0xab, 0x7c, 0x72, 0x64, 0x78, 0x4e, 0xe3, 0x1f, 0x19, 0xa4, 0xe8, 0x47, 0x5e,
+		0xf1, 0x67, 0xde, 0xd4, 0x60, 0xd7, 0x96, 0x36, 0x56, 0x3e, 0x38, 0x02, 0x24, 0xa7, 0xe8, 0xf7,
+		0x0c, 0x98, 0x95, 0xd5, 0x8d, 0x99, 0xea, 0x93, 0x53, 0x53, 0x25, 0x57, 0x79, 0x6f, 0x40, 0x28,
+		0x4e, 0xc5, 0x8f, 0xe9, 0x9f, 0x2b, 0x69, 0xca, 0xa6, 0xcc, 0x8f, 0x33, 0x64, 0x43, 0x5f, 0xd4,
+		0x56, 0xf9, 0xce, 0x51, 0xc1, 0x39, 0x81, 0xdf, 0x25, 0x19, 0xd0, 0x44, 0x01, 0x91, 0x79, 0x4d,
+		0x83, 0x54, 0x5e, 0xd8, 0x55, 0x59, 0x1d, 0x04, 0x24, 0xf4, 0x46, 0x12, 0x25, 0x41, 0x1a, 0x6f,
+		0x44, 0x5e, 0xc8, 0xa4, 0xf1, 0x46, 0x14, 0xd5, 0x46, 0xe6, 0x0b, 0x98, 0x88, 0x56, 0x68, 0x98,
+		0xdf, 0xd6, 0x62, 0x48, 0xd4, 0x24, 0x55, 0xae, 0xe6, 0x1c, 0x1d, 0x91, 0x42, 0x59, 0x89, 0x85,
+		0x46, 0x0a, 0x35, 0x55, 0x22, 0x1a, 0x29, 0xd4, 0xd6, 0x71, 0x10, 0xcf, 0x53, 0x52, 0x39, 0xa1,
+		0xf1, 0x3c, 0xd5, 0x65, 0x18, 0x95, 0x77, 0x07, 0x03, 0x0a, 0x5e, 0x8b, 0x40, 0x58, 0x87, 0x60,
+		0x5e, 0x51, 0xe2, 0x48, 0x15, 0x37, 0x54, 0xde, 0xce, 0x35, 0x36, 0x9c, 0x26, 0xcc, 0xf3, 0x6b,
+		0xa6, 0x49, 0x15, 0x3f, 0x68, 0xa6, 0x49, 0x17, 0x0e, 0xb0, 0x69, 0x44, 0x96, 0x5e, 0x3b, 0x4d,
+		0xa2, 0xb8, 0x40, 0x3b, 0x4d, 0x32, 0xed, 0x4f, 0x6e, 0x28, 0xb1, 0x04, 0xbb, 0xe6, 0x86, 0x22,
+		0xab, 0x0e, 0xd0, 0xdc, 0x50, 0xe4, 0x79, 0xfb, 0x2f, 0xd9, 0xff, 0xf2, 0x48, 0x32, 0xb0, 0x9a,
+		0xab, 0xac, 0x36, 0x61, 0xaf, 0xb9, 0xca, 0x66, 0x64, 0xd8, 0x89, 0x03, 0xa3, 0x4c, 0x08, 0x6b,
+		0x1c, 0x98, 0xac, 0xa4, 0xb5, 0xc6, 0x81, 0xc9, 0xce, 0x3f, 0xe3, 0x0d, 0x89, 0x65, 0x53, 0x35,
+		0x1b, 0x22, 0x4b, 0x28, 0x6b, 0x36, 0x44, 0x9a, 0xa4, 0xa5, 0xe6, 0x43, 0x96, 0xf9, 0x34, 0x75,
+		0xd7, 0x3f, 0x65, 0x4e, 0x57, 0x63, 0x3e, 0x74, 0xe9, 0x55, 0x62, 0xa7, 0x13, 0x09, 0x43, 0x8d,
+		0x9d, 0x96, 0xe7, 0x61, 0x35, 0x76, 0x5a, 0x95, 0x8b, 0x24, 0xb7, 0xc6, 0x44, 0x32, 0x4a, 0x77,
+		0x6b, 0x94, 0xe7, 0xe7, 0x74, 0xb7, 0x46, 0x45, 0xa6, 0x8b, 0x4c, 0x9c, 0xcc, 0xdd, 0x68, 0x26,
+		0x56, 0xe4, 0xc4, 0x34, 0x13, 0x2b, 0x13, 0x43, 0x7f, 0x60, 0xc0, 0x9c, 0x34, 0xdb, 0x62, 0xaa,
+		0x37, 0x4e, 0x97, 0x20, 0xaa, 0xdc, 0x18, 0x14, 0x2c, 0x22, 0x76, 0xb2, 0x5c, 0x85, 0x46, 0xec,
+		0x34, 0x49, 0x20, 0x8d, 0xd8, 0x69, 0xd3, 0x3a, 0x5f, 0x19, 0xc1, 0xfb, 0x1e, 0x75, 0x44, 0xdc,
+		0x5c, 0xcb, 0x72, 0xfb, 0x33, 0x53, 0x07, 0x95, 0xdb, 0xc7, 0x41, 0x11, 0x8b, 0xac, 0x44, 0x23,
+		0xe2, 0xfa, 0xc8, 0x8a, 0x24, 0xe4, 0xae, 0x8f, 0xac, 0x48, 0x83, 0xed, 0x54, 0x33, 0x63, 0xa1,
+		0x66, 0xad, 0x66, 0xca, 0x42, 0xe7, 0x5a, 0xcd, 0x94, 0x46, 0xb1, 0x6f, 0x7f, 0xf0, 0xeb, 0xef,
+		0xef, 0xba, 0xfe, 0x5e, 0x7f, 0xa7, 0x5a, 0xef, 0xec, 0xaf, 0xc4, 0xfe, 0x77, 0xb9, 0xba, 0x8b,
+		0xda, 0xec, 0x0f, 0xb4, 0x23, 0xff, 0xe0, 0xfd, 0x21, 0xff, 0x79, 0x70, 0x6d, 0x67, 0x98, 0xf6,
+		0x5d, 0xff, 0x5f, 0x1f, 0xba, 0xa1, 0x24, 0xed, 0x5b, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
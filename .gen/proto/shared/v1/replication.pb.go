@@ -379,6 +379,8 @@ type DomainTaskAttributes struct {
 	ConfigVersion           int64           `protobuf:"varint,4,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion         int64           `protobuf:"varint,5,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	PreviousFailoverVersion int64           `protobuf:"varint,6,opt,name=previous_failover_version,json=previousFailoverVersion,proto3" json:"previous_failover_version,omitempty"`
+	// api.v1.Domain does not carry archival retention, so it is replicated alongside.
+	ArchivalRetentionPeriod *types.Duration `protobuf:"bytes,7,opt,name=archival_retention_period,json=archivalRetentionPeriod,proto3" json:"archival_retention_period,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}        `json:"-"`
 	XXX_unrecognized        []byte          `json:"-"`
 	XXX_sizecache           int32           `json:"-"`
@@ -459,6 +461,13 @@ func (m *DomainTaskAttributes) GetPreviousFailoverVersion() int64 {
 	return 0
 }
 
+func (m *DomainTaskAttributes) GetArchivalRetentionPeriod() *types.Duration {
+	if m != nil {
+		return m.ArchivalRetentionPeriod
+	}
+	return nil
+}
+
 type SyncShardStatusTaskAttributes struct {
 	SourceCluster        string           `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	ShardId              int32            `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -1109,102 +1118,104 @@ func init() {
 }
 
 var fileDescriptor_00df2ec6c2eaefe5 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1a, 0x49,
-	0x12, 0x76, 0x83, 0xf8, 0x51, 0x0a, 0x01, 0x2a, 0x69, 0x2d, 0x2c, 0x59, 0x5e, 0xcc, 0xca, 0x96,
-	0x2c, 0x6f, 0x80, 0x2d, 0x87, 0x37, 0xf6, 0x27, 0x36, 0x1c, 0x6d, 0x81, 0x42, 0xbd, 0xd6, 0x9f,
-	0x8b, 0xb6, 0x1c, 0xda, 0xc3, 0x74, 0xb4, 0xe8, 0x92, 0xe8, 0x10, 0x74, 0x13, 0x5d, 0x05, 0x32,
-	0xc7, 0x99, 0xfb, 0x1c, 0x67, 0x2e, 0x73, 0x9c, 0xe7, 0x98, 0xeb, 0xc4, 0x1c, 0xfd, 0x08, 0x13,
-	0x7e, 0x92, 0x89, 0xae, 0xaa, 0x06, 0x1a, 0x1a, 0x2c, 0x87, 0x0f, 0x73, 0x53, 0x67, 0x7e, 0x5f,
-	0x66, 0x56, 0x66, 0x56, 0x66, 0x09, 0xd8, 0xee, 0x5e, 0x10, 0xaf, 0xd2, 0x30, 0x2d, 0xe2, 0x34,
-	0x48, 0x85, 0x36, 0x4d, 0x8f, 0x58, 0x95, 0xde, 0xf3, 0x8a, 0x47, 0x3a, 0x2d, 0xbb, 0x61, 0x32,
-	0xdb, 0x75, 0xca, 0x1d, 0xcf, 0x65, 0x2e, 0xba, 0xeb, 0x23, 0xcb, 0x12, 0x59, 0x16, 0xc8, 0x72,
-	0xef, 0xf9, 0xda, 0x5f, 0xaf, 0x5c, 0xf7, 0xaa, 0x45, 0x2a, 0x1c, 0x75, 0xd1, 0xbd, 0xac, 0x30,
-	0xbb, 0x4d, 0x28, 0x33, 0xdb, 0x1d, 0x41, 0x5c, 0x2b, 0x86, 0x5c, 0x98, 0x1d, 0xdb, 0xb7, 0xdf,
-	0x70, 0xdb, 0xed, 0xc0, 0x74, 0x34, 0xc2, 0x72, 0xdb, 0xa6, 0x1d, 0x20, 0x36, 0xa7, 0x84, 0xd9,
-	0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x40, 0x95, 0x7e, 0x8c, 0xc1, 0x32, 0x1e, 0x06, 0x7e, 0x44, 0x28,
-	0x35, 0xaf, 0x08, 0x45, 0x3a, 0x2c, 0x8d, 0x9c, 0xc7, 0x60, 0x26, 0xbd, 0xa6, 0x05, 0xa5, 0x18,
-	0xdf, 0x5e, 0xd8, 0xdd, 0x2a, 0x47, 0x1f, 0xab, 0x3c, 0x62, 0x47, 0x37, 0xe9, 0x35, 0xce, 0x7b,
-	0x61, 0x01, 0x45, 0xff, 0x82, 0x7b, 0x2d, 0x93, 0x32, 0xc3, 0x23, 0xcc, 0xb3, 0x49, 0x8f, 0x58,
-	0x46, 0x5b, 0x38, 0x34, 0x6c, 0xab, 0x10, 0x2b, 0x2a, 0xdb, 0x71, 0x7c, 0xd7, 0x07, 0xe0, 0x40,
-	0x2f, 0xe3, 0xd1, 0x2c, 0x74, 0x0f, 0xd2, 0x4d, 0x93, 0x1a, 0x6d, 0xd7, 0x23, 0x85, 0x78, 0x51,
-	0xd9, 0x4e, 0xe3, 0x54, 0xd3, 0xa4, 0x47, 0xae, 0x47, 0x50, 0x1d, 0x96, 0x68, 0xdf, 0x69, 0x18,
-	0x7e, 0x24, 0x96, 0x41, 0x99, 0xc9, 0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xc5, 0x5a, 0xef, 0x3b,
-	0x8d, 0xba, 0x8f, 0xaf, 0x73, 0x38, 0xce, 0xd1, 0xb0, 0xa0, 0xf4, 0x43, 0x12, 0x72, 0x63, 0x07,
-	0x42, 0x07, 0x30, 0xef, 0x27, 0xc2, 0x60, 0xfd, 0x0e, 0x29, 0x28, 0x45, 0x65, 0x3b, 0xbb, 0xfb,
-	0xf4, 0x96, 0xc9, 0xd0, 0xfb, 0x1d, 0x82, 0xd3, 0x4c, 0xfe, 0x85, 0x36, 0x21, 0x4b, 0xdd, 0xae,
-	0xd7, 0x20, 0x3c, 0xb3, 0xc3, 0xd3, 0x67, 0x84, 0xd4, 0x67, 0x68, 0x16, 0x7a, 0x05, 0x8b, 0x0d,
-	0x8f, 0xc8, 0x0a, 0xd8, 0x6d, 0x71, 0xf0, 0x85, 0xdd, 0xb5, 0xb2, 0xe8, 0x9f, 0x72, 0xd0, 0x3f,
-	0x65, 0x3d, 0xe8, 0x1f, 0x9c, 0x09, 0x08, 0xbe, 0x08, 0x59, 0x70, 0x57, 0xf4, 0x84, 0x70, 0x63,
-	0x32, 0xe6, 0xd9, 0x17, 0x5d, 0x46, 0x82, 0xf4, 0xfc, 0x7d, 0x5a, 0xf4, 0x55, 0xce, 0xf2, 0xc3,
-	0x50, 0x07, 0x9c, 0x83, 0x3b, 0x78, 0xc5, 0x8a, 0x90, 0xa3, 0x6f, 0x15, 0x78, 0x38, 0x51, 0x80,
-	0x09, 0x8f, 0x09, 0xee, 0xf1, 0xe5, 0x2d, 0x0b, 0x32, 0xe1, 0x7a, 0x83, 0xce, 0x02, 0xa0, 0x1b,
-	0xe0, 0x00, 0xc3, 0x6c, 0x30, 0xbb, 0x67, 0xb3, 0xfe, 0x84, 0xfb, 0x24, 0x77, 0xbf, 0x3b, 0xcb,
-	0xbd, 0x2a, 0xb9, 0x13, 0xbe, 0xd7, 0xe8, 0x54, 0x2d, 0x72, 0x60, 0x4d, 0xde, 0x28, 0xe1, 0xb2,
-	0xb7, 0x3b, 0xea, 0x35, 0xc5, 0xbd, 0x56, 0xa6, 0x79, 0x3d, 0x10, 0x4c, 0xdf, 0xe4, 0xd9, 0x6e,
-	0xc8, 0xe5, 0x6a, 0x33, 0x5a, 0x85, 0x3a, 0xb0, 0x76, 0x69, 0xda, 0x2d, 0xb7, 0x47, 0x3c, 0xa3,
-	0x6d, 0x7a, 0xd7, 0xc4, 0x1b, 0xf5, 0x97, 0xe6, 0xfe, 0x9e, 0x4d, 0xf3, 0xb7, 0x2f, 0x99, 0x47,
-	0x9c, 0x18, 0x72, 0x58, 0xb8, 0x9c, 0xa2, 0x7b, 0x9d, 0x01, 0x18, 0x7a, 0x28, 0xfd, 0x12, 0x83,
-	0x95, 0xa8, 0xee, 0x40, 0x18, 0xf2, 0xb2, 0xd7, 0xdc, 0x0e, 0xf1, 0x78, 0x0f, 0xca, 0x3b, 0xb2,
-	0x35, 0xbb, 0xcb, 0x4e, 0x02, 0x38, 0xce, 0x59, 0x61, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8d, 0x79,
-	0x1c, 0xb3, 0x2d, 0xf4, 0x02, 0x92, 0x02, 0x22, 0x6f, 0xc2, 0x7a, 0xd8, 0xb2, 0xd9, 0xb1, 0x87,
-	0x66, 0xb1, 0x84, 0xa2, 0x47, 0x90, 0x6d, 0xb8, 0xce, 0xa5, 0x7d, 0x65, 0xf4, 0x88, 0x47, 0xfd,
-	0xb0, 0xe6, 0xf8, 0x5d, 0x5b, 0x14, 0xd2, 0x33, 0x21, 0x44, 0x4f, 0x20, 0x3f, 0x48, 0x6c, 0x00,
-	0x4c, 0x70, 0x60, 0x2e, 0x90, 0x07, 0xd0, 0x7f, 0xc3, 0xbd, 0x8e, 0x47, 0x7a, 0xb6, 0xdb, 0xa5,
-	0xc6, 0x04, 0x27, 0xc9, 0x39, 0xab, 0x01, 0x60, 0x3f, 0xcc, 0x2d, 0xfd, 0xa4, 0xc0, 0xc6, 0xcc,
-	0x5e, 0xf7, 0xe3, 0x95, 0xb3, 0xa1, 0xd1, 0xea, 0x52, 0x46, 0x3c, 0x9e, 0xc6, 0x79, 0xbc, 0x28,
-	0xa4, 0x7b, 0x42, 0xe8, 0x0f, 0x44, 0x71, 0xdf, 0x64, 0x86, 0x12, 0x38, 0xc5, 0xbf, 0x35, 0x0b,
-	0xfd, 0x13, 0xe6, 0x07, 0x1b, 0xe5, 0x16, 0x33, 0x63, 0x08, 0x2e, 0x7d, 0x4c, 0xc0, 0xda, 0xf4,
-	0xab, 0x80, 0xd6, 0x61, 0x5e, 0xd6, 0xd8, 0xb6, 0x64, 0x54, 0x69, 0x21, 0xd0, 0x2c, 0xf4, 0x0e,
-	0xd0, 0x8d, 0xeb, 0x5d, 0x5f, 0xb6, 0xdc, 0x1b, 0x83, 0x7c, 0x20, 0x8d, 0x2e, 0x6f, 0x81, 0x18,
-	0x77, 0xff, 0x38, 0xb2, 0x50, 0xef, 0x25, 0xbc, 0x16, 0xa0, 0xf1, 0xd2, 0xcd, 0xb8, 0x08, 0x15,
-	0x20, 0x15, 0xa4, 0x36, 0xce, 0x53, 0x1b, 0x7c, 0xa2, 0x87, 0x90, 0xa1, 0x8d, 0x26, 0xb1, 0xba,
-	0x2d, 0xc2, 0xb3, 0x20, 0xca, 0xba, 0x30, 0x90, 0x69, 0x16, 0x52, 0x21, 0x3b, 0x84, 0xf0, 0x11,
-	0x9a, 0xf8, 0x6c, 0x3a, 0x16, 0x07, 0x0c, 0x3e, 0x43, 0x37, 0x00, 0x28, 0x33, 0x3d, 0x26, 0x7c,
-	0x88, 0xea, 0xce, 0x4b, 0x89, 0x66, 0xa1, 0xff, 0x42, 0x26, 0x50, 0x73, 0xfb, 0xa9, 0xcf, 0xda,
-	0x5f, 0x90, 0x78, 0x6e, 0xfd, 0x7f, 0xb0, 0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26,
-	0x13, 0x56, 0xd2, 0x9f, 0xb5, 0xb2, 0xe4, 0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x0f, 0x48, 0x59,
-	0x84, 0x99, 0x76, 0x8b, 0x16, 0xe6, 0x39, 0xff, 0x7e, 0x64, 0xd6, 0x4f, 0xcd, 0x7e, 0xcb, 0x35,
-	0x2d, 0x1c, 0x80, 0xfd, 0x0c, 0x9b, 0x8c, 0x91, 0x76, 0x87, 0x15, 0x40, 0x34, 0x92, 0xfc, 0x44,
-	0xaf, 0x20, 0xc3, 0xa3, 0xf3, 0x9b, 0xbc, 0xeb, 0x91, 0xc2, 0xc2, 0x0c, 0xb3, 0xfb, 0x02, 0x83,
-	0x17, 0x7c, 0x86, 0xfc, 0x40, 0xcf, 0x60, 0x85, 0x1b, 0xf0, 0xcb, 0x4a, 0x3c, 0xc3, 0xb6, 0x88,
-	0xc3, 0x6c, 0xd6, 0x2f, 0x64, 0x78, 0xef, 0x20, 0x5f, 0xf7, 0x9e, 0xab, 0x34, 0xa9, 0x41, 0x27,
-	0x90, 0x93, 0xf5, 0x35, 0xe4, 0x08, 0x2c, 0x2c, 0x46, 0xb5, 0xd0, 0x70, 0x8a, 0xc8, 0x9b, 0x25,
-	0x67, 0x29, 0xce, 0xf6, 0x42, 0xdf, 0xa5, 0xef, 0xe2, 0xb0, 0x3a, 0x65, 0xce, 0xa2, 0x55, 0x48,
-	0x05, 0xfb, 0x57, 0xe1, 0x85, 0x4d, 0x32, 0xb1, 0x79, 0x43, 0x8d, 0x1e, 0xbb, 0x55, 0xa3, 0xc7,
-	0xbf, 0xb6, 0xd1, 0xbf, 0x81, 0xbf, 0x8c, 0x9d, 0xdc, 0xb0, 0x19, 0x69, 0xfb, 0xbb, 0xda, 0x7f,
-	0x76, 0xed, 0xdc, 0xee, 0xfc, 0x1a, 0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x12, 0x92,
-	0xa4, 0x47, 0x1c, 0x16, 0xac, 0xe2, 0x8d, 0xe8, 0xe1, 0x69, 0x32, 0xf3, 0x75, 0xcb, 0xbd, 0xc0,
-	0x12, 0x8c, 0xf6, 0x20, 0xeb, 0x90, 0x1b, 0xc3, 0xeb, 0x3a, 0x86, 0xa4, 0x27, 0x6f, 0x43, 0xcf,
-	0x38, 0xe4, 0x06, 0x77, 0x9d, 0x1a, 0xa7, 0x94, 0x7e, 0x56, 0xa0, 0x30, 0x6d, 0xf9, 0xcc, 0x9e,
-	0x2a, 0x51, 0x63, 0x39, 0x16, 0x3d, 0x96, 0xbf, 0xf6, 0xb9, 0x54, 0xfa, 0x5e, 0x81, 0xe5, 0x70,
-	0x94, 0xba, 0x7b, 0x4d, 0x1c, 0x3f, 0xc0, 0x60, 0xd4, 0x8a, 0x47, 0x70, 0x02, 0xa7, 0xe5, 0xac,
-	0xa5, 0xe8, 0x1c, 0x72, 0x63, 0x0b, 0x59, 0xce, 0xbc, 0x2f, 0xde, 0xc2, 0x38, 0x1b, 0xde, 0xc1,
-	0xa5, 0x5f, 0xc3, 0x8f, 0x73, 0xfe, 0x2a, 0x74, 0x2e, 0xdd, 0x3f, 0x65, 0x0c, 0xaf, 0x8f, 0xbe,
-	0x7d, 0xe3, 0x7c, 0x4c, 0x0c, 0x9f, 0xb3, 0x23, 0xf7, 0x68, 0x2e, 0x74, 0x8f, 0x46, 0x86, 0x77,
-	0x22, 0x3c, 0xbc, 0x37, 0x21, 0x7b, 0x69, 0x7b, 0x94, 0x89, 0xa6, 0x1a, 0x8e, 0xd6, 0x0c, 0x97,
-	0xf2, 0xb6, 0xd1, 0x2c, 0x54, 0x82, 0x45, 0x87, 0x7c, 0x18, 0x01, 0xa5, 0xc4, 0x8c, 0xf7, 0x85,
-	0x01, 0x66, 0x7c, 0x0d, 0xa4, 0x27, 0xd6, 0x80, 0xdf, 0x7e, 0xf9, 0xd1, 0x44, 0xf2, 0xaa, 0x8e,
-	0x2e, 0x50, 0x25, 0xbc, 0x40, 0xbf, 0xe2, 0xff, 0x94, 0x80, 0xda, 0xf1, 0xdc, 0x06, 0xa1, 0x34,
-	0x4c, 0x8d, 0x0f, 0xa9, 0xa7, 0x81, 0x7e, 0x40, 0x2d, 0xbd, 0x81, 0xdc, 0xd8, 0xcb, 0x20, 0xbc,
-	0xc9, 0x95, 0x2f, 0xd8, 0xe4, 0x3b, 0x1f, 0x27, 0x7b, 0x87, 0x97, 0xea, 0x21, 0x6c, 0xe0, 0xda,
-	0xe9, 0xa1, 0xb6, 0xa7, 0xea, 0xda, 0xc9, 0xb1, 0xa1, 0xab, 0xf5, 0x37, 0x86, 0x7e, 0x7e, 0x5a,
-	0x33, 0xb4, 0xe3, 0x33, 0xf5, 0x50, 0xab, 0xe6, 0xef, 0xa0, 0x22, 0xdc, 0x8f, 0x86, 0x54, 0x4f,
-	0x8e, 0x54, 0xed, 0x38, 0xaf, 0x4c, 0x37, 0x72, 0xa0, 0xd5, 0xf5, 0x13, 0x7c, 0x9e, 0x8f, 0xa1,
-	0xa7, 0xb0, 0x15, 0x0d, 0xa9, 0x9f, 0x1f, 0xef, 0x19, 0xf5, 0x03, 0x15, 0x57, 0x8d, 0xba, 0xae,
-	0xea, 0xef, 0xea, 0xf9, 0x38, 0xda, 0x82, 0xbf, 0xcd, 0x00, 0xab, 0x7b, 0xba, 0x76, 0xa6, 0xe9,
-	0xe7, 0xf9, 0x39, 0xb4, 0x03, 0x8f, 0x67, 0x3a, 0x36, 0x8e, 0x6a, 0xba, 0x5a, 0x55, 0x75, 0x35,
-	0x9f, 0x40, 0x9b, 0x50, 0x9c, 0x8d, 0x3d, 0xdb, 0xcd, 0x27, 0xd1, 0x13, 0x78, 0x14, 0x8d, 0xda,
-	0x57, 0xb5, 0xc3, 0x93, 0xb3, 0x1a, 0x36, 0x8e, 0x54, 0xfc, 0xa6, 0x86, 0xf3, 0xa9, 0x1d, 0x1b,
-	0x72, 0x63, 0x2f, 0x56, 0x74, 0x1f, 0x0a, 0x22, 0x29, 0xc6, 0xc9, 0x69, 0x0d, 0x0b, 0x13, 0xc3,
-	0x44, 0xae, 0xc3, 0xea, 0x84, 0x76, 0x0f, 0xd7, 0x54, 0xbd, 0x96, 0x57, 0x22, 0x95, 0xef, 0x4e,
-	0xab, 0xbe, 0x32, 0xb6, 0x73, 0x0c, 0xa9, 0xea, 0xe1, 0x5b, 0x5e, 0xb0, 0x15, 0xc8, 0x57, 0x0f,
-	0xdf, 0x8e, 0xd7, 0xa8, 0x00, 0x2b, 0x03, 0xe9, 0x48, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0x6e, 0xa0,
-	0x91, 0x05, 0x8b, 0xbd, 0xde, 0xfb, 0xed, 0xd3, 0x03, 0xe5, 0xe3, 0xa7, 0x07, 0xca, 0xef, 0x9f,
-	0x1e, 0x28, 0xff, 0x7f, 0x79, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0xdc, 0x70, 0xdb, 0x95, 0xd0, 0xaf,
-	0x04, 0xe5, 0x2b, 0xe2, 0x88, 0x5f, 0x25, 0x86, 0x3f, 0x18, 0xfc, 0x47, 0xfc, 0xd5, 0x7b, 0x7e,
-	0x91, 0xe4, 0x9a, 0x17, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0xd1, 0xe8, 0x01, 0x11,
-	0x00, 0x00,
+	// 1543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x53, 0xdb, 0xc6,
+	0x16, 0x8f, 0x6c, 0xfc, 0xc1, 0xc1, 0xd8, 0x66, 0xe1, 0x06, 0x01, 0x81, 0xeb, 0xf8, 0x92, 0x40,
+	0xc8, 0x1d, 0x3b, 0x21, 0x93, 0x3b, 0xf7, 0xde, 0x4e, 0x27, 0xa3, 0x60, 0x33, 0xa8, 0xe1, 0x2b,
+	0x6b, 0x41, 0x86, 0x3e, 0x54, 0x23, 0xa4, 0x05, 0x6b, 0xb0, 0x25, 0x8f, 0xb4, 0x36, 0xf1, 0x63,
+	0xfb, 0xde, 0xb7, 0xb6, 0x2f, 0x7d, 0xec, 0xff, 0xd2, 0xe9, 0x63, 0xfe, 0x84, 0x4e, 0xfe, 0x92,
+	0x8e, 0x76, 0x57, 0xb6, 0xe5, 0xaf, 0xd0, 0xe6, 0xa1, 0x6f, 0xe8, 0x9c, 0xdf, 0xef, 0x9c, 0xdd,
+	0xf3, 0xb9, 0x18, 0xb6, 0xdb, 0x97, 0xc4, 0x2b, 0x9b, 0x86, 0x45, 0x1c, 0x93, 0x94, 0xfd, 0xba,
+	0xe1, 0x11, 0xab, 0xdc, 0x79, 0x5e, 0xf6, 0x48, 0xab, 0x61, 0x9b, 0x06, 0xb5, 0x5d, 0xa7, 0xd4,
+	0xf2, 0x5c, 0xea, 0xa2, 0xfb, 0x01, 0xb2, 0x24, 0x90, 0x25, 0x8e, 0x2c, 0x75, 0x9e, 0xaf, 0x6e,
+	0x5c, 0xbb, 0xee, 0x75, 0x83, 0x94, 0x19, 0xea, 0xb2, 0x7d, 0x55, 0xb6, 0xda, 0xde, 0x00, 0x6f,
+	0xf5, 0x9f, 0xc3, 0x7a, 0x6a, 0x37, 0x89, 0x4f, 0x8d, 0x66, 0x4b, 0x00, 0x0a, 0x91, 0x23, 0x18,
+	0x2d, 0x3b, 0xf0, 0x6f, 0xba, 0xcd, 0xa6, 0xeb, 0x4c, 0x43, 0x58, 0x6e, 0xd3, 0xb0, 0x43, 0xc4,
+	0xe6, 0x84, 0x6b, 0xd4, 0x6d, 0x9f, 0xba, 0x5e, 0x97, 0xa3, 0x8a, 0x3f, 0xc5, 0x60, 0x11, 0xf7,
+	0x2f, 0x76, 0x44, 0x7c, 0xdf, 0xb8, 0x26, 0x3e, 0xd2, 0x60, 0x61, 0xe0, 0xbe, 0x3a, 0x35, 0xfc,
+	0x1b, 0x5f, 0x96, 0x0a, 0xf1, 0xed, 0xb9, 0xdd, 0xad, 0xd2, 0xf8, 0x6b, 0x97, 0x06, 0xec, 0x68,
+	0x86, 0x7f, 0x83, 0xf3, 0x5e, 0x54, 0xe0, 0xa3, 0xff, 0xc1, 0x4a, 0xc3, 0xf0, 0xa9, 0xee, 0x11,
+	0xea, 0xd9, 0xa4, 0x43, 0x2c, 0xbd, 0xc9, 0x1d, 0xea, 0xb6, 0x25, 0xc7, 0x0a, 0xd2, 0x76, 0x1c,
+	0xdf, 0x0f, 0x00, 0x38, 0xd4, 0x8b, 0xf3, 0xa8, 0x16, 0x5a, 0x81, 0x74, 0xdd, 0xf0, 0xf5, 0xa6,
+	0xeb, 0x11, 0x39, 0x5e, 0x90, 0xb6, 0xd3, 0x38, 0x55, 0x37, 0xfc, 0x23, 0xd7, 0x23, 0xa8, 0x06,
+	0x0b, 0x7e, 0xd7, 0x31, 0xf5, 0xe0, 0x24, 0x96, 0xee, 0x53, 0x83, 0xb6, 0x7d, 0x79, 0xa6, 0x20,
+	0x4d, 0x3b, 0x6b, 0xad, 0xeb, 0x98, 0xb5, 0x00, 0x5f, 0x63, 0x70, 0x9c, 0xf3, 0xa3, 0x82, 0xe2,
+	0x8f, 0x49, 0xc8, 0x0d, 0x5d, 0x08, 0x1d, 0xc0, 0x6c, 0x10, 0x08, 0x9d, 0x76, 0x5b, 0x44, 0x96,
+	0x0a, 0xd2, 0x76, 0x76, 0xf7, 0xe9, 0x1d, 0x83, 0xa1, 0x75, 0x5b, 0x04, 0xa7, 0xa9, 0xf8, 0x0b,
+	0x6d, 0x42, 0xd6, 0x77, 0xdb, 0x9e, 0x49, 0x58, 0x64, 0xfb, 0xb7, 0xcf, 0x70, 0x69, 0xc0, 0x50,
+	0x2d, 0xf4, 0x0a, 0xe6, 0x4d, 0x8f, 0x88, 0x0c, 0xd8, 0x4d, 0x7e, 0xf1, 0xb9, 0xdd, 0xd5, 0x12,
+	0xaf, 0x9f, 0x52, 0x58, 0x3f, 0x25, 0x2d, 0xac, 0x1f, 0x9c, 0x09, 0x09, 0x81, 0x08, 0x59, 0x70,
+	0x9f, 0xd7, 0x04, 0x77, 0x63, 0x50, 0xea, 0xd9, 0x97, 0x6d, 0x4a, 0xc2, 0xf0, 0xfc, 0x7b, 0xd2,
+	0xe9, 0x2b, 0x8c, 0x15, 0x1c, 0x43, 0xe9, 0x71, 0x0e, 0xee, 0xe1, 0x25, 0x6b, 0x8c, 0x1c, 0x7d,
+	0x2b, 0xc1, 0xc3, 0x91, 0x04, 0x8c, 0x78, 0x4c, 0x30, 0x8f, 0x2f, 0xef, 0x98, 0x90, 0x11, 0xd7,
+	0xeb, 0xfe, 0x34, 0x00, 0xba, 0x05, 0x06, 0xd0, 0x0d, 0x93, 0xda, 0x1d, 0x9b, 0x76, 0x47, 0xdc,
+	0x27, 0x99, 0xfb, 0xdd, 0x69, 0xee, 0x15, 0xc1, 0x1d, 0xf1, 0xbd, 0xea, 0x4f, 0xd4, 0x22, 0x07,
+	0x56, 0x45, 0x47, 0x71, 0x97, 0x9d, 0xdd, 0x41, 0xaf, 0x29, 0xe6, 0xb5, 0x3c, 0xc9, 0xeb, 0x01,
+	0x67, 0x06, 0x26, 0xcf, 0x77, 0x23, 0x2e, 0x97, 0xeb, 0xe3, 0x55, 0xa8, 0x05, 0xab, 0x57, 0x86,
+	0xdd, 0x70, 0x3b, 0xc4, 0xd3, 0x9b, 0x86, 0x77, 0x43, 0xbc, 0x41, 0x7f, 0x69, 0xe6, 0xef, 0xd9,
+	0x24, 0x7f, 0xfb, 0x82, 0x79, 0xc4, 0x88, 0x11, 0x87, 0xf2, 0xd5, 0x04, 0xdd, 0xeb, 0x0c, 0x40,
+	0xdf, 0x43, 0xf1, 0x87, 0x38, 0x2c, 0x8d, 0xab, 0x0e, 0x84, 0x21, 0x2f, 0x6a, 0xcd, 0x6d, 0x11,
+	0x3e, 0xee, 0x44, 0x8f, 0x6c, 0x4d, 0xaf, 0xb2, 0x93, 0x10, 0x8e, 0x73, 0x56, 0x54, 0x80, 0xb2,
+	0x10, 0x13, 0xad, 0x31, 0x8b, 0x63, 0xb6, 0x85, 0x5e, 0x40, 0x92, 0x43, 0x44, 0x27, 0xac, 0x45,
+	0x2d, 0x1b, 0x2d, 0xbb, 0x6f, 0x16, 0x0b, 0x28, 0x7a, 0x04, 0x59, 0xd3, 0x75, 0xae, 0xec, 0x6b,
+	0xbd, 0x43, 0x3c, 0x3f, 0x38, 0xd6, 0x0c, 0xeb, 0xb5, 0x79, 0x2e, 0x3d, 0xe7, 0x42, 0xf4, 0x04,
+	0xf2, 0xbd, 0xc0, 0x86, 0xc0, 0x04, 0x03, 0xe6, 0x42, 0x79, 0x08, 0xfd, 0x3f, 0xac, 0xb4, 0x3c,
+	0xd2, 0xb1, 0xdd, 0xb6, 0xaf, 0x8f, 0x70, 0x92, 0x8c, 0xb3, 0x1c, 0x02, 0xf6, 0x87, 0xb8, 0x67,
+	0xb0, 0x62, 0x78, 0x66, 0xdd, 0xee, 0x18, 0x8d, 0x60, 0x0c, 0x12, 0x87, 0x75, 0x77, 0x8b, 0x78,
+	0xb6, 0x6b, 0x89, 0x72, 0x59, 0x19, 0xe9, 0xef, 0x8a, 0xd8, 0x1f, 0x78, 0x39, 0xe4, 0xe2, 0x90,
+	0x7a, 0xca, 0x98, 0xc5, 0x9f, 0x25, 0x58, 0x9f, 0xda, 0x42, 0x41, 0x18, 0xc4, 0xc8, 0x31, 0x1b,
+	0x6d, 0x9f, 0x12, 0x8f, 0x65, 0x67, 0x16, 0xcf, 0x73, 0xe9, 0x1e, 0x17, 0x06, 0x73, 0x96, 0xb7,
+	0xb1, 0x08, 0x7c, 0x02, 0xa7, 0xd8, 0xb7, 0x6a, 0xa1, 0xff, 0xc2, 0x6c, 0x6f, 0x51, 0xdd, 0x61,
+	0x14, 0xf5, 0xc1, 0xc5, 0x0f, 0x09, 0x58, 0x9d, 0xdc, 0x61, 0x68, 0x0d, 0x66, 0x45, 0xe9, 0xd8,
+	0x96, 0x38, 0x55, 0x9a, 0x0b, 0x54, 0x0b, 0x9d, 0x01, 0xba, 0x75, 0xbd, 0x9b, 0xab, 0x86, 0x7b,
+	0xab, 0x93, 0xf7, 0xc4, 0x6c, 0xb3, 0xca, 0x8a, 0x31, 0xf7, 0x8f, 0xc7, 0xe6, 0xff, 0x9d, 0x80,
+	0x57, 0x43, 0x34, 0x5e, 0xb8, 0x1d, 0x16, 0x21, 0x19, 0x52, 0x61, 0xc6, 0xe2, 0x2c, 0x63, 0xe1,
+	0x27, 0x7a, 0x08, 0x19, 0xdf, 0xac, 0x13, 0xab, 0xdd, 0x20, 0x2c, 0x0a, 0xbc, 0x5a, 0xe6, 0x7a,
+	0x32, 0xd5, 0x42, 0x0a, 0x64, 0xfb, 0x10, 0x36, 0x99, 0x13, 0x9f, 0x0c, 0xc7, 0x7c, 0x8f, 0x11,
+	0xc8, 0xd0, 0x3a, 0x80, 0x4f, 0x0d, 0x8f, 0x72, 0x1f, 0xbc, 0x68, 0x66, 0x85, 0x44, 0xb5, 0xd0,
+	0x97, 0x90, 0x09, 0xd5, 0xcc, 0x7e, 0xea, 0x93, 0xf6, 0xe7, 0x04, 0x9e, 0x59, 0xff, 0x0a, 0x16,
+	0xd9, 0xa2, 0xad, 0x13, 0xc3, 0xa3, 0x97, 0xc4, 0xa0, 0xdc, 0x4a, 0xfa, 0x93, 0x56, 0x16, 0x02,
+	0xda, 0x41, 0xc8, 0x62, 0xb6, 0xfe, 0x03, 0x29, 0x8b, 0x50, 0xc3, 0x6e, 0xf8, 0xf2, 0x2c, 0xe3,
+	0x3f, 0x18, 0x1b, 0xf5, 0x53, 0xa3, 0xdb, 0x70, 0x0d, 0x0b, 0x87, 0xe0, 0x20, 0xc2, 0x06, 0xa5,
+	0xa4, 0xd9, 0xa2, 0x32, 0xf0, 0x42, 0x12, 0x9f, 0xe8, 0x15, 0x64, 0xd8, 0xe9, 0x82, 0xde, 0x69,
+	0x7b, 0x44, 0x9e, 0x9b, 0x62, 0x76, 0x9f, 0x63, 0xf0, 0x5c, 0xc0, 0x10, 0x1f, 0xe8, 0x19, 0x2c,
+	0x31, 0x03, 0x41, 0x5a, 0x89, 0xa7, 0xdb, 0x56, 0xd0, 0x0b, 0xb4, 0x2b, 0x67, 0x58, 0xed, 0xa0,
+	0x40, 0xf7, 0x8e, 0xa9, 0x54, 0xa1, 0x41, 0x27, 0x90, 0x13, 0xf9, 0xd5, 0xc5, 0x64, 0x95, 0xe7,
+	0xc7, 0x95, 0x50, 0x7f, 0x38, 0x89, 0x86, 0x15, 0x23, 0x1a, 0x67, 0x3b, 0x91, 0xef, 0xe2, 0x77,
+	0x71, 0x58, 0x9e, 0x30, 0xbe, 0xd1, 0x32, 0xa4, 0xc2, 0xb5, 0x2e, 0xb1, 0xc4, 0x26, 0x29, 0x5f,
+	0xe8, 0x91, 0x42, 0x8f, 0xdd, 0xa9, 0xd0, 0xe3, 0x9f, 0x5b, 0xe8, 0xdf, 0xc0, 0x3f, 0x86, 0x6e,
+	0xae, 0xdb, 0x94, 0x34, 0x83, 0x27, 0x40, 0xf0, 0x9a, 0xdb, 0xb9, 0xdb, 0xfd, 0x55, 0x4a, 0x9a,
+	0x78, 0xb1, 0x33, 0x22, 0xf3, 0xd1, 0x4b, 0x48, 0x92, 0x0e, 0x71, 0x68, 0xb8, 0xe1, 0xd7, 0xc7,
+	0xcf, 0x64, 0x83, 0x1a, 0xaf, 0x1b, 0xee, 0x25, 0x16, 0x60, 0xb4, 0x07, 0x59, 0x87, 0xdc, 0xea,
+	0x5e, 0xdb, 0xd1, 0x05, 0x3d, 0x79, 0x17, 0x7a, 0xc6, 0x21, 0xb7, 0xb8, 0xed, 0x54, 0x19, 0xa5,
+	0xf8, 0x8b, 0x04, 0xf2, 0xa4, 0x9d, 0x36, 0x7d, 0xaa, 0x8c, 0x9b, 0xf6, 0xb1, 0xf1, 0xd3, 0xfe,
+	0x73, 0x5f, 0x61, 0xc5, 0xef, 0x25, 0x58, 0x8c, 0x9e, 0x52, 0x73, 0x6f, 0x88, 0x13, 0x1c, 0x30,
+	0x1c, 0xb5, 0xfc, 0x6d, 0x9d, 0xc0, 0x69, 0x31, 0x6b, 0x7d, 0x74, 0x01, 0xb9, 0xa1, 0x3d, 0x2f,
+	0xc7, 0xfe, 0xda, 0x72, 0xc7, 0xd9, 0xe8, 0x6a, 0x2f, 0xfe, 0x1a, 0x7d, 0xf3, 0xb3, 0xc7, 0xa6,
+	0x73, 0xe5, 0xfe, 0x2d, 0x63, 0x78, 0x6d, 0xf0, 0x49, 0x1d, 0x67, 0x63, 0xa2, 0xff, 0x4a, 0x1e,
+	0xe8, 0xa3, 0x99, 0x48, 0x1f, 0x0d, 0x0c, 0xef, 0x44, 0x74, 0x78, 0x6f, 0x42, 0xf6, 0xca, 0xf6,
+	0x7c, 0xca, 0x8b, 0xaa, 0x3f, 0x5a, 0x33, 0x4c, 0xca, 0xca, 0x46, 0xb5, 0x50, 0x11, 0xe6, 0x1d,
+	0xf2, 0x7e, 0x00, 0x94, 0xe2, 0x33, 0x3e, 0x10, 0x86, 0x98, 0xe1, 0x35, 0x90, 0x1e, 0x59, 0x03,
+	0x41, 0xf9, 0xe5, 0x07, 0x03, 0xc9, 0xb2, 0x3a, 0xb8, 0x40, 0xa5, 0xe8, 0x02, 0xfd, 0x8c, 0x7f,
+	0x7f, 0x42, 0x6a, 0xcb, 0x73, 0x4d, 0xe2, 0xfb, 0x51, 0x6a, 0xbc, 0x4f, 0x3d, 0x0d, 0xf5, 0x3d,
+	0x6a, 0xf1, 0x0d, 0xe4, 0x86, 0x5e, 0x06, 0xd1, 0x4d, 0x2e, 0xfd, 0x89, 0x4d, 0xbe, 0xf3, 0x61,
+	0xb4, 0x76, 0x58, 0xaa, 0x1e, 0xc2, 0x3a, 0xae, 0x9e, 0x1e, 0xaa, 0x7b, 0x8a, 0xa6, 0x9e, 0x1c,
+	0xeb, 0x9a, 0x52, 0x7b, 0xa3, 0x6b, 0x17, 0xa7, 0x55, 0x5d, 0x3d, 0x3e, 0x57, 0x0e, 0xd5, 0x4a,
+	0xfe, 0x1e, 0x2a, 0xc0, 0x83, 0xf1, 0x90, 0xca, 0xc9, 0x91, 0xa2, 0x1e, 0xe7, 0xa5, 0xc9, 0x46,
+	0x0e, 0xd4, 0x9a, 0x76, 0x82, 0x2f, 0xf2, 0x31, 0xf4, 0x14, 0xb6, 0xc6, 0x43, 0x6a, 0x17, 0xc7,
+	0x7b, 0x7a, 0xed, 0x40, 0xc1, 0x15, 0xbd, 0xa6, 0x29, 0xda, 0x59, 0x2d, 0x1f, 0x47, 0x5b, 0xf0,
+	0xaf, 0x29, 0x60, 0x65, 0x4f, 0x53, 0xcf, 0x55, 0xed, 0x22, 0x3f, 0x83, 0x76, 0xe0, 0xf1, 0x54,
+	0xc7, 0xfa, 0x51, 0x55, 0x53, 0x2a, 0x8a, 0xa6, 0xe4, 0x13, 0x68, 0x13, 0x0a, 0xd3, 0xb1, 0xe7,
+	0xbb, 0xf9, 0x24, 0x7a, 0x02, 0x8f, 0xc6, 0xa3, 0xf6, 0x15, 0xf5, 0xf0, 0xe4, 0xbc, 0x8a, 0xf5,
+	0x23, 0x05, 0xbf, 0xa9, 0xe2, 0x7c, 0x6a, 0xc7, 0x86, 0xdc, 0xd0, 0x43, 0x18, 0x3d, 0x00, 0x99,
+	0x07, 0x45, 0x3f, 0x39, 0xad, 0x62, 0x6e, 0xa2, 0x1f, 0xc8, 0x35, 0x58, 0x1e, 0xd1, 0xee, 0xe1,
+	0xaa, 0xa2, 0x55, 0xf3, 0xd2, 0x58, 0xe5, 0xd9, 0x69, 0x25, 0x50, 0xc6, 0x76, 0x8e, 0x21, 0x55,
+	0x39, 0x7c, 0xcb, 0x12, 0xb6, 0x04, 0xf9, 0xca, 0xe1, 0xdb, 0xe1, 0x1c, 0xc9, 0xb0, 0xd4, 0x93,
+	0x0e, 0x9c, 0x3f, 0x2f, 0xa1, 0x45, 0xc8, 0xf5, 0x34, 0x22, 0x61, 0xb1, 0xd7, 0x7b, 0xbf, 0x7d,
+	0xdc, 0x90, 0x3e, 0x7c, 0xdc, 0x90, 0x7e, 0xff, 0xb8, 0x21, 0x7d, 0xfd, 0xf2, 0xda, 0xa6, 0xf5,
+	0xf6, 0x65, 0xc9, 0x74, 0x9b, 0xe5, 0xc8, 0x8f, 0x0f, 0xa5, 0x6b, 0xe2, 0xf0, 0x1f, 0x3b, 0xfa,
+	0xbf, 0x43, 0x7c, 0xc1, 0xff, 0xea, 0x3c, 0xbf, 0x4c, 0x32, 0xcd, 0x8b, 0x3f, 0x06, 0x00, 0xe7,
+	0xa1, 0x91, 0xf6, 0x78, 0x11, 0x00, 0x00,
 }
 
 func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
@@ -1462,6 +1473,18 @@ func (m *DomainTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ArchivalRetentionPeriod != nil {
+		{
+			size, err := m.ArchivalRetentionPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PreviousFailoverVersion != 0 {
 		i = encodeVarintReplication(dAtA, i, uint64(m.PreviousFailoverVersion))
 		i--
@@ -1877,21 +1900,21 @@ func (m *FailoverMarkerToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA23 := make([]byte, len(m.ShardIds)*10)
-		var j22 int
+		dAtA24 := make([]byte, len(m.ShardIds)*10)
+		var j23 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j22++
+				j23++
 			}
-			dAtA23[j22] = uint8(num)
-			j22++
+			dAtA24[j23] = uint8(num)
+			j23++
 		}
-		i -= j22
-		copy(dAtA[i:], dAtA23[:j22])
-		i = encodeVarintReplication(dAtA, i, uint64(j22))
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintReplication(dAtA, i, uint64(j23))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2205,6 +2228,10 @@ func (m *DomainTaskAttributes) Size() (n int) {
 	if m.PreviousFailoverVersion != 0 {
 		n += 1 + sovReplication(uint64(m.PreviousFailoverVersion))
 	}
+	if m.ArchivalRetentionPeriod != nil {
+		l = m.ArchivalRetentionPeriod.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3091,6 +3118,42 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivalRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArchivalRetentionPeriod == nil {
+				m.ArchivalRetentionPeriod = &types.Duration{}
+			}
+			if err := m.ArchivalRetentionPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
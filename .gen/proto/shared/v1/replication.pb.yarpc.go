@@ -28,99 +28,117 @@ package sharedv1
 var yarpcFileDescriptorClosure00df2ec6c2eaefe5 = [][]byte{
 	// uber/cadence/shared/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x73, 0x1a, 0x47,
-		0x13, 0xf6, 0x82, 0xf8, 0x50, 0x0b, 0x01, 0x1a, 0xe9, 0xb5, 0xb0, 0x64, 0xd5, 0x8b, 0x89, 0x6c,
-		0xc9, 0x72, 0x0a, 0x6c, 0xb9, 0x9c, 0xcf, 0x4a, 0xb9, 0xd6, 0x02, 0x95, 0x36, 0xd6, 0x97, 0x87,
-		0xb5, 0x5c, 0xca, 0x21, 0x5b, 0x2b, 0x76, 0x24, 0xb6, 0x04, 0xbb, 0xd4, 0xce, 0x80, 0xcc, 0x31,
-		0xb9, 0xe7, 0x98, 0x5c, 0x72, 0xcc, 0xef, 0xc8, 0x35, 0x67, 0xff, 0xa4, 0xd4, 0xce, 0xcc, 0x02,
-		0x0b, 0x0b, 0x96, 0xcb, 0x87, 0xdc, 0xb4, 0xdd, 0xcf, 0xd3, 0xdd, 0xd3, 0xdd, 0xd3, 0x3d, 0x02,
-		0xb6, 0xbb, 0x17, 0xc4, 0xab, 0x34, 0x4c, 0x8b, 0x38, 0x0d, 0x52, 0xa1, 0x4d, 0xd3, 0x23, 0x56,
-		0xa5, 0xf7, 0xac, 0xe2, 0x91, 0x4e, 0xcb, 0x6e, 0x98, 0xcc, 0x76, 0x9d, 0x72, 0xc7, 0x73, 0x99,
-		0x8b, 0xee, 0xfa, 0xc8, 0xb2, 0x44, 0x96, 0x05, 0xb2, 0xdc, 0x7b, 0xb6, 0xf6, 0xff, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x47, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0xcc, 0x76, 0x47,
-		0x10, 0xd7, 0x8a, 0x21, 0x17, 0x66, 0xc7, 0xf6, 0xed, 0x37, 0xdc, 0x76, 0x3b, 0x30, 0x1d, 0x8d,
-		0xb0, 0xdc, 0xb6, 0x69, 0x07, 0x88, 0xcd, 0x29, 0x61, 0x36, 0x6d, 0xca, 0x5c, 0xaf, 0x2f, 0x50,
-		0xa5, 0x3f, 0x62, 0xb0, 0x8c, 0x87, 0x81, 0x1f, 0x11, 0x4a, 0xcd, 0x2b, 0x42, 0x91, 0x0e, 0x4b,
-		0x23, 0xe7, 0x31, 0x98, 0x49, 0xaf, 0x69, 0x41, 0x29, 0xc6, 0xb7, 0x17, 0x76, 0xb7, 0xca, 0xd1,
-		0xc7, 0x2a, 0x8f, 0xd8, 0xd1, 0x4d, 0x7a, 0x8d, 0xf3, 0x5e, 0x58, 0x40, 0xd1, 0xb7, 0x70, 0xaf,
-		0x65, 0x52, 0x66, 0x78, 0x84, 0x79, 0x36, 0xe9, 0x11, 0xcb, 0x68, 0x0b, 0x87, 0x86, 0x6d, 0x15,
-		0x62, 0x45, 0x65, 0x3b, 0x8e, 0xef, 0xfa, 0x00, 0x1c, 0xe8, 0x65, 0x3c, 0x9a, 0x85, 0xee, 0x41,
-		0xba, 0x69, 0x52, 0xa3, 0xed, 0x7a, 0xa4, 0x10, 0x2f, 0x2a, 0xdb, 0x69, 0x9c, 0x6a, 0x9a, 0xf4,
-		0xc8, 0xf5, 0x08, 0xaa, 0xc3, 0x12, 0xed, 0x3b, 0x0d, 0xc3, 0x8f, 0xc4, 0x32, 0x28, 0x33, 0x59,
-		0x97, 0x16, 0xe6, 0x8a, 0xca, 0xac, 0x58, 0xeb, 0x7d, 0xa7, 0x51, 0xf7, 0xf1, 0x75, 0x0e, 0xc7,
-		0x39, 0x1a, 0x16, 0x94, 0x7e, 0x4f, 0x42, 0x6e, 0xec, 0x40, 0xe8, 0x00, 0xe6, 0xfd, 0x44, 0x18,
-		0xac, 0xdf, 0x21, 0x05, 0xa5, 0xa8, 0x6c, 0x67, 0x77, 0x9f, 0xdc, 0x32, 0x19, 0x7a, 0xbf, 0x43,
-		0x70, 0x9a, 0xc9, 0xbf, 0xd0, 0x26, 0x64, 0xa9, 0xdb, 0xf5, 0x1a, 0x84, 0x67, 0x76, 0x78, 0xfa,
-		0x8c, 0x90, 0xfa, 0x0c, 0xcd, 0x42, 0x2f, 0x61, 0xb1, 0xe1, 0x11, 0x59, 0x01, 0xbb, 0x2d, 0x0e,
-		0xbe, 0xb0, 0xbb, 0x56, 0x16, 0xfd, 0x53, 0x0e, 0xfa, 0xa7, 0xac, 0x07, 0xfd, 0x83, 0x33, 0x01,
-		0xc1, 0x17, 0x21, 0x0b, 0xee, 0x8a, 0x9e, 0x10, 0x6e, 0x4c, 0xc6, 0x3c, 0xfb, 0xa2, 0xcb, 0x48,
-		0x90, 0x9e, 0x2f, 0xa7, 0x45, 0x5f, 0xe5, 0x2c, 0x3f, 0x0c, 0x75, 0xc0, 0x39, 0xb8, 0x83, 0x57,
-		0xac, 0x08, 0x39, 0xfa, 0x45, 0x81, 0x07, 0x13, 0x05, 0x98, 0xf0, 0x98, 0xe0, 0x1e, 0x5f, 0xdc,
-		0xb2, 0x20, 0x13, 0xae, 0x37, 0xe8, 0x2c, 0x00, 0xba, 0x01, 0x0e, 0x30, 0xcc, 0x06, 0xb3, 0x7b,
-		0x36, 0xeb, 0x4f, 0xb8, 0x4f, 0x72, 0xf7, 0xbb, 0xb3, 0xdc, 0xab, 0x92, 0x3b, 0xe1, 0x7b, 0x8d,
-		0x4e, 0xd5, 0x22, 0x07, 0xd6, 0xe4, 0x8d, 0x12, 0x2e, 0x7b, 0xbb, 0xa3, 0x5e, 0x53, 0xdc, 0x6b,
-		0x65, 0x9a, 0xd7, 0x03, 0xc1, 0xf4, 0x4d, 0x9e, 0xed, 0x86, 0x5c, 0xae, 0x36, 0xa3, 0x55, 0xa8,
-		0x03, 0x6b, 0x97, 0xa6, 0xdd, 0x72, 0x7b, 0xc4, 0x33, 0xda, 0xa6, 0x77, 0x4d, 0xbc, 0x51, 0x7f,
-		0x69, 0xee, 0xef, 0xe9, 0x34, 0x7f, 0xfb, 0x92, 0x79, 0xc4, 0x89, 0x21, 0x87, 0x85, 0xcb, 0x29,
-		0xba, 0x57, 0x19, 0x80, 0xa1, 0x87, 0xd2, 0xdf, 0x31, 0x58, 0x89, 0xea, 0x0e, 0x84, 0x21, 0x2f,
-		0x7b, 0xcd, 0xed, 0x10, 0x8f, 0xf7, 0xa0, 0xbc, 0x23, 0x5b, 0xb3, 0xbb, 0xec, 0x24, 0x80, 0xe3,
-		0x9c, 0x15, 0x16, 0xa0, 0x2c, 0xc4, 0xe4, 0xd5, 0x98, 0xc7, 0x31, 0xdb, 0x42, 0xcf, 0x21, 0x29,
-		0x20, 0xf2, 0x26, 0xac, 0x87, 0x2d, 0x9b, 0x1d, 0x7b, 0x68, 0x16, 0x4b, 0x28, 0x7a, 0x08, 0xd9,
-		0x86, 0xeb, 0x5c, 0xda, 0x57, 0x46, 0x8f, 0x78, 0xd4, 0x0f, 0x6b, 0x8e, 0xdf, 0xb5, 0x45, 0x21,
-		0x3d, 0x13, 0x42, 0xf4, 0x18, 0xf2, 0x83, 0xc4, 0x06, 0xc0, 0x04, 0x07, 0xe6, 0x02, 0x79, 0x00,
-		0xfd, 0x0e, 0xee, 0x75, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0x35, 0x26, 0x38, 0x49, 0xce, 0x59, 0x0d,
-		0x00, 0xfb, 0x61, 0x6e, 0xe9, 0x4f, 0x05, 0x36, 0x66, 0xf6, 0xba, 0x1f, 0xaf, 0x9c, 0x0d, 0x8d,
-		0x56, 0x97, 0x32, 0xe2, 0xf1, 0x34, 0xce, 0xe3, 0x45, 0x21, 0xdd, 0x13, 0x42, 0x7f, 0x20, 0x8a,
-		0xfb, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xfe, 0xad, 0x59, 0xe8, 0x1b, 0x98, 0x1f, 0x6c, 0x94, 0x5b,
-		0xcc, 0x8c, 0x21, 0xb8, 0xf4, 0x21, 0x01, 0x6b, 0xd3, 0xaf, 0x02, 0x5a, 0x87, 0x79, 0x59, 0x63,
-		0xdb, 0x92, 0x51, 0xa5, 0x85, 0x40, 0xb3, 0xd0, 0x5b, 0x40, 0x37, 0xae, 0x77, 0x7d, 0xd9, 0x72,
-		0x6f, 0x0c, 0xf2, 0x9e, 0x34, 0xba, 0xbc, 0x05, 0x62, 0xdc, 0xfd, 0xa3, 0xc8, 0x42, 0xbd, 0x93,
-		0xf0, 0x5a, 0x80, 0xc6, 0x4b, 0x37, 0xe3, 0x22, 0x54, 0x80, 0x54, 0x90, 0xda, 0x38, 0x4f, 0x6d,
-		0xf0, 0x89, 0x1e, 0x40, 0x86, 0x36, 0x9a, 0xc4, 0xea, 0xb6, 0x08, 0xcf, 0x82, 0x28, 0xeb, 0xc2,
-		0x40, 0xa6, 0x59, 0x48, 0x85, 0xec, 0x10, 0xc2, 0x47, 0x68, 0xe2, 0xa3, 0xe9, 0x58, 0x1c, 0x30,
-		0xf8, 0x0c, 0xdd, 0x00, 0xa0, 0xcc, 0xf4, 0x98, 0xf0, 0x21, 0xaa, 0x3b, 0x2f, 0x25, 0x9a, 0x85,
-		0x7e, 0x80, 0x4c, 0xa0, 0xe6, 0xf6, 0x53, 0x1f, 0xb5, 0xbf, 0x20, 0xf1, 0xdc, 0xfa, 0x8f, 0xb0,
-		0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26, 0x13, 0x56, 0xd2, 0x1f, 0xb5, 0xb2, 0xe4,
-		0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x15, 0xa4, 0x2c, 0xc2, 0x4c, 0xbb, 0x45, 0x0b, 0xf3, 0x9c,
-		0x7f, 0x3f, 0x32, 0xeb, 0xa7, 0x66, 0xbf, 0xe5, 0x9a, 0x16, 0x0e, 0xc0, 0x7e, 0x86, 0x4d, 0xc6,
-		0x48, 0xbb, 0xc3, 0x0a, 0x20, 0x1a, 0x49, 0x7e, 0xa2, 0x97, 0x90, 0xe1, 0xd1, 0xf9, 0x4d, 0xde,
-		0xf5, 0x48, 0x61, 0x61, 0x86, 0xd9, 0x7d, 0x81, 0xc1, 0x0b, 0x3e, 0x43, 0x7e, 0xa0, 0xa7, 0xb0,
-		0xc2, 0x0d, 0xf8, 0x65, 0x25, 0x9e, 0x61, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc, 0x77,
-		0x90, 0xaf, 0x7b, 0xc7, 0x55, 0x9a, 0xd4, 0xa0, 0x13, 0xc8, 0xc9, 0xfa, 0x1a, 0x72, 0x04, 0x16,
-		0x16, 0xa3, 0x5a, 0x68, 0x38, 0x45, 0xe4, 0xcd, 0x92, 0xb3, 0x14, 0x67, 0x7b, 0xa1, 0xef, 0xd2,
-		0xaf, 0x71, 0x58, 0x9d, 0x32, 0x67, 0xd1, 0x2a, 0xa4, 0x82, 0xfd, 0xab, 0xf0, 0xc2, 0x26, 0x99,
-		0xd8, 0xbc, 0xa1, 0x46, 0x8f, 0xdd, 0xaa, 0xd1, 0xe3, 0x9f, 0xdb, 0xe8, 0x3f, 0xc3, 0xff, 0xc6,
-		0x4e, 0x6e, 0xd8, 0x8c, 0xb4, 0xfd, 0x5d, 0xed, 0x3f, 0xbb, 0x76, 0x6e, 0x77, 0x7e, 0x8d, 0x91,
-		0x36, 0x5e, 0xee, 0x4d, 0xc8, 0x28, 0x7a, 0x01, 0x49, 0xd2, 0x23, 0x0e, 0x0b, 0x56, 0xf1, 0x46,
-		0xf4, 0xf0, 0x34, 0x99, 0xf9, 0xaa, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0x7b, 0x90, 0x75, 0xc8, 0x8d,
-		0xe1, 0x75, 0x1d, 0x43, 0xd2, 0x93, 0xb7, 0xa1, 0x67, 0x1c, 0x72, 0x83, 0xbb, 0x4e, 0x8d, 0x53,
-		0x4a, 0x7f, 0x29, 0x50, 0x98, 0xb6, 0x7c, 0x66, 0x4f, 0x95, 0xa8, 0xb1, 0x1c, 0x8b, 0x1e, 0xcb,
-		0x9f, 0xfb, 0x5c, 0x2a, 0xfd, 0xa6, 0xc0, 0x72, 0x38, 0x4a, 0xdd, 0xbd, 0x26, 0x8e, 0x1f, 0x60,
-		0x30, 0x6a, 0xc5, 0x23, 0x38, 0x81, 0xd3, 0x72, 0xd6, 0x52, 0x74, 0x0e, 0xb9, 0xb1, 0x85, 0x2c,
-		0x67, 0xde, 0x27, 0x6f, 0x61, 0x9c, 0x0d, 0xef, 0xe0, 0xd2, 0x3f, 0xe1, 0xc7, 0x39, 0x7f, 0x15,
-		0x3a, 0x97, 0xee, 0x7f, 0x32, 0x86, 0xd7, 0x47, 0xdf, 0xbe, 0x71, 0x3e, 0x26, 0x86, 0xcf, 0xd9,
-		0x91, 0x7b, 0x34, 0x17, 0xba, 0x47, 0x23, 0xc3, 0x3b, 0x11, 0x1e, 0xde, 0x9b, 0x90, 0xbd, 0xb4,
-		0x3d, 0xca, 0x44, 0x53, 0x0d, 0x47, 0x6b, 0x86, 0x4b, 0x79, 0xdb, 0x68, 0x16, 0x2a, 0xc1, 0xa2,
-		0x43, 0xde, 0x8f, 0x80, 0x52, 0x62, 0xc6, 0xfb, 0xc2, 0x00, 0x33, 0xbe, 0x06, 0xd2, 0x13, 0x6b,
-		0xc0, 0x6f, 0xbf, 0xfc, 0x68, 0x22, 0x79, 0x55, 0x47, 0x17, 0xa8, 0x12, 0x5e, 0xa0, 0x9f, 0xf1,
-		0x7f, 0x4a, 0x40, 0xed, 0x78, 0x6e, 0x83, 0x50, 0x1a, 0xa6, 0xc6, 0x87, 0xd4, 0xd3, 0x40, 0x3f,
-		0xa0, 0x96, 0x5e, 0x43, 0x6e, 0xec, 0x65, 0x10, 0xde, 0xe4, 0xca, 0x27, 0x6c, 0xf2, 0x9d, 0x0f,
-		0x93, 0xbd, 0xc3, 0x4b, 0xf5, 0x00, 0x36, 0x70, 0xed, 0xf4, 0x50, 0xdb, 0x53, 0x75, 0xed, 0xe4,
-		0xd8, 0xd0, 0xd5, 0xfa, 0x6b, 0x43, 0x3f, 0x3f, 0xad, 0x19, 0xda, 0xf1, 0x99, 0x7a, 0xa8, 0x55,
-		0xf3, 0x77, 0x50, 0x11, 0xee, 0x47, 0x43, 0xaa, 0x27, 0x47, 0xaa, 0x76, 0x9c, 0x57, 0xa6, 0x1b,
-		0x39, 0xd0, 0xea, 0xfa, 0x09, 0x3e, 0xcf, 0xc7, 0xd0, 0x13, 0xd8, 0x8a, 0x86, 0xd4, 0xcf, 0x8f,
-		0xf7, 0x8c, 0xfa, 0x81, 0x8a, 0xab, 0x46, 0x5d, 0x57, 0xf5, 0xb7, 0xf5, 0x7c, 0x1c, 0x6d, 0xc1,
-		0x17, 0x33, 0xc0, 0xea, 0x9e, 0xae, 0x9d, 0x69, 0xfa, 0x79, 0x7e, 0x0e, 0xed, 0xc0, 0xa3, 0x99,
-		0x8e, 0x8d, 0xa3, 0x9a, 0xae, 0x56, 0x55, 0x5d, 0xcd, 0x27, 0xd0, 0x26, 0x14, 0x67, 0x63, 0xcf,
-		0x76, 0xf3, 0x49, 0xf4, 0x18, 0x1e, 0x46, 0xa3, 0xf6, 0x55, 0xed, 0xf0, 0xe4, 0xac, 0x86, 0x8d,
-		0x23, 0x15, 0xbf, 0xae, 0xe1, 0x7c, 0x6a, 0xc7, 0x86, 0xdc, 0xd8, 0x8b, 0x15, 0xdd, 0x87, 0x82,
-		0x48, 0x8a, 0x71, 0x72, 0x5a, 0xc3, 0xc2, 0xc4, 0x30, 0x91, 0xeb, 0xb0, 0x3a, 0xa1, 0xdd, 0xc3,
-		0x35, 0x55, 0xaf, 0xe5, 0x95, 0x48, 0xe5, 0xdb, 0xd3, 0xaa, 0xaf, 0x8c, 0xed, 0x1c, 0x43, 0xaa,
-		0x7a, 0xf8, 0x86, 0x17, 0x6c, 0x05, 0xf2, 0xd5, 0xc3, 0x37, 0xe3, 0x35, 0x2a, 0xc0, 0xca, 0x40,
-		0x3a, 0x12, 0x7f, 0x5e, 0x41, 0xcb, 0x90, 0x1b, 0x68, 0x64, 0xc1, 0x62, 0xaf, 0xbe, 0xfe, 0xe9,
-		0xc5, 0x95, 0xcd, 0x9a, 0xdd, 0x8b, 0x72, 0xc3, 0x6d, 0x57, 0x42, 0xbf, 0x0c, 0x94, 0xaf, 0x88,
-		0x23, 0x7e, 0x89, 0x18, 0xfe, 0x48, 0xf0, 0xbd, 0xf8, 0xab, 0xf7, 0xec, 0x22, 0xc9, 0x35, 0xcf,
-		0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x50, 0xe1, 0x45, 0xf5, 0x10, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x53, 0xdb, 0xc0,
+		0x15, 0x8f, 0x6c, 0xfc, 0xc1, 0xc3, 0xd8, 0x66, 0xa1, 0x41, 0x40, 0x68, 0x1d, 0x97, 0x04, 0x42,
+		0x3a, 0x76, 0x42, 0x26, 0xfd, 0x9c, 0x4e, 0x46, 0xc1, 0x66, 0x50, 0xc3, 0x57, 0xd6, 0x82, 0x0c,
+		0x3d, 0x54, 0x23, 0xa4, 0x05, 0x6b, 0xb0, 0x25, 0x8f, 0x76, 0x6d, 0xe2, 0x63, 0x7b, 0xef, 0xad,
+		0xed, 0xa5, 0xc7, 0xfe, 0x2f, 0x3d, 0xe7, 0x4f, 0xea, 0x68, 0x77, 0x65, 0x5b, 0xfe, 0x0a, 0x6d,
+		0x0e, 0xbd, 0xa1, 0xf7, 0x7e, 0xbf, 0xf7, 0x76, 0xdf, 0xe7, 0x62, 0xd8, 0xeb, 0xde, 0x90, 0xa0,
+		0x6a, 0x5b, 0x0e, 0xf1, 0x6c, 0x52, 0xa5, 0x4d, 0x2b, 0x20, 0x4e, 0xb5, 0xf7, 0xb6, 0x1a, 0x90,
+		0x4e, 0xcb, 0xb5, 0x2d, 0xe6, 0xfa, 0x5e, 0xa5, 0x13, 0xf8, 0xcc, 0x47, 0x4f, 0x43, 0x64, 0x45,
+		0x22, 0x2b, 0x02, 0x59, 0xe9, 0xbd, 0xdd, 0xfc, 0xe9, 0x9d, 0xef, 0xdf, 0xb5, 0x48, 0x95, 0xa3,
+		0x6e, 0xba, 0xb7, 0x55, 0xa7, 0x1b, 0x8c, 0xf0, 0x36, 0x7f, 0x36, 0xae, 0x67, 0x6e, 0x9b, 0x50,
+		0x66, 0xb5, 0x3b, 0x12, 0x50, 0x8a, 0x1d, 0xc1, 0xea, 0xb8, 0xa1, 0x7f, 0xdb, 0x6f, 0xb7, 0x7d,
+		0x6f, 0x1e, 0xc2, 0xf1, 0xdb, 0x96, 0x1b, 0x21, 0x76, 0x66, 0x5c, 0xa3, 0xe9, 0x52, 0xe6, 0x07,
+		0x7d, 0x81, 0x2a, 0xff, 0x23, 0x01, 0xab, 0x78, 0x78, 0xb1, 0x53, 0x42, 0xa9, 0x75, 0x47, 0x28,
+		0x32, 0x60, 0x65, 0xe4, 0xbe, 0x26, 0xb3, 0xe8, 0x3d, 0x55, 0x95, 0x52, 0x72, 0x6f, 0xe9, 0x60,
+		0xb7, 0x32, 0xfd, 0xda, 0x95, 0x11, 0x3b, 0x86, 0x45, 0xef, 0x71, 0x31, 0x88, 0x0b, 0x28, 0xfa,
+		0x0d, 0x6c, 0xb4, 0x2c, 0xca, 0xcc, 0x80, 0xb0, 0xc0, 0x25, 0x3d, 0xe2, 0x98, 0x6d, 0xe1, 0xd0,
+		0x74, 0x1d, 0x35, 0x51, 0x52, 0xf6, 0x92, 0xf8, 0x69, 0x08, 0xc0, 0x91, 0x5e, 0x9e, 0x47, 0x77,
+		0xd0, 0x06, 0x64, 0x9b, 0x16, 0x35, 0xdb, 0x7e, 0x40, 0xd4, 0x64, 0x49, 0xd9, 0xcb, 0xe2, 0x4c,
+		0xd3, 0xa2, 0xa7, 0x7e, 0x40, 0x50, 0x03, 0x56, 0x68, 0xdf, 0xb3, 0xcd, 0xf0, 0x24, 0x8e, 0x49,
+		0x99, 0xc5, 0xba, 0x54, 0x5d, 0x28, 0x29, 0xf3, 0xce, 0xda, 0xe8, 0x7b, 0x76, 0x23, 0xc4, 0x37,
+		0x38, 0x1c, 0x17, 0x68, 0x5c, 0x50, 0xfe, 0x7b, 0x1a, 0x0a, 0x63, 0x17, 0x42, 0xc7, 0xb0, 0x18,
+		0x06, 0xc2, 0x64, 0xfd, 0x0e, 0x51, 0x95, 0x92, 0xb2, 0x97, 0x3f, 0x78, 0xfd, 0xc8, 0x60, 0x18,
+		0xfd, 0x0e, 0xc1, 0x59, 0x26, 0xff, 0x42, 0x3b, 0x90, 0xa7, 0x7e, 0x37, 0xb0, 0x09, 0x8f, 0xec,
+		0xf0, 0xf6, 0x39, 0x21, 0x0d, 0x19, 0xba, 0x83, 0x3e, 0xc0, 0xb2, 0x1d, 0x10, 0x99, 0x01, 0xb7,
+		0x2d, 0x2e, 0xbe, 0x74, 0xb0, 0x59, 0x11, 0xf5, 0x53, 0x89, 0xea, 0xa7, 0x62, 0x44, 0xf5, 0x83,
+		0x73, 0x11, 0x21, 0x14, 0x21, 0x07, 0x9e, 0x8a, 0x9a, 0x10, 0x6e, 0x2c, 0xc6, 0x02, 0xf7, 0xa6,
+		0xcb, 0x48, 0x14, 0x9e, 0x5f, 0xcc, 0x3a, 0x7d, 0x8d, 0xb3, 0xc2, 0x63, 0x68, 0x03, 0xce, 0xf1,
+		0x13, 0xbc, 0xe6, 0x4c, 0x91, 0xa3, 0x3f, 0x2b, 0xf0, 0x7c, 0x22, 0x01, 0x13, 0x1e, 0x53, 0xdc,
+		0xe3, 0xfb, 0x47, 0x26, 0x64, 0xc2, 0xf5, 0x36, 0x9d, 0x07, 0x40, 0x0f, 0xc0, 0x01, 0xa6, 0x65,
+		0x33, 0xb7, 0xe7, 0xb2, 0xfe, 0x84, 0xfb, 0x34, 0x77, 0x7f, 0x30, 0xcf, 0xbd, 0x26, 0xb9, 0x13,
+		0xbe, 0x37, 0xe9, 0x4c, 0x2d, 0xf2, 0x60, 0x53, 0x76, 0x94, 0x70, 0xd9, 0x3b, 0x18, 0xf5, 0x9a,
+		0xe1, 0x5e, 0xab, 0xb3, 0xbc, 0x1e, 0x0b, 0x66, 0x68, 0xf2, 0xea, 0x20, 0xe6, 0x72, 0xbd, 0x39,
+		0x5d, 0x85, 0x3a, 0xb0, 0x79, 0x6b, 0xb9, 0x2d, 0xbf, 0x47, 0x02, 0xb3, 0x6d, 0x05, 0xf7, 0x24,
+		0x18, 0xf5, 0x97, 0xe5, 0xfe, 0xde, 0xcc, 0xf2, 0x77, 0x24, 0x99, 0xa7, 0x9c, 0x18, 0x73, 0xa8,
+		0xde, 0xce, 0xd0, 0x7d, 0xcc, 0x01, 0x0c, 0x3d, 0x94, 0xff, 0x96, 0x84, 0xb5, 0x69, 0xd5, 0x81,
+		0x30, 0x14, 0x65, 0xad, 0xf9, 0x1d, 0x22, 0xc6, 0x9d, 0xec, 0x91, 0xdd, 0xf9, 0x55, 0x76, 0x1e,
+		0xc1, 0x71, 0xc1, 0x89, 0x0b, 0x50, 0x1e, 0x12, 0xb2, 0x35, 0x16, 0x71, 0xc2, 0x75, 0xd0, 0x3b,
+		0x48, 0x0b, 0x88, 0xec, 0x84, 0xad, 0xb8, 0x65, 0xab, 0xe3, 0x0e, 0xcd, 0x62, 0x09, 0x45, 0x2f,
+		0x20, 0x6f, 0xfb, 0xde, 0xad, 0x7b, 0x67, 0xf6, 0x48, 0x40, 0xc3, 0x63, 0x2d, 0xf0, 0x5e, 0x5b,
+		0x16, 0xd2, 0x2b, 0x21, 0x44, 0xaf, 0xa0, 0x38, 0x08, 0x6c, 0x04, 0x4c, 0x71, 0x60, 0x21, 0x92,
+		0x47, 0xd0, 0xdf, 0xc2, 0x46, 0x27, 0x20, 0x3d, 0xd7, 0xef, 0x52, 0x73, 0x82, 0x93, 0xe6, 0x9c,
+		0xf5, 0x08, 0x70, 0x34, 0xc6, 0xbd, 0x84, 0x0d, 0x2b, 0xb0, 0x9b, 0x6e, 0xcf, 0x6a, 0x85, 0x63,
+		0x90, 0x78, 0xbc, 0xbb, 0x3b, 0x24, 0x70, 0x7d, 0x47, 0x96, 0xcb, 0xc6, 0x44, 0x7f, 0xd7, 0xe4,
+		0xfe, 0xc0, 0xeb, 0x11, 0x17, 0x47, 0xd4, 0x0b, 0xce, 0x2c, 0xff, 0x53, 0x81, 0xed, 0xb9, 0x2d,
+		0x14, 0x86, 0x41, 0x8e, 0x1c, 0xbb, 0xd5, 0xa5, 0x8c, 0x04, 0x3c, 0x3b, 0x8b, 0x78, 0x59, 0x48,
+		0x0f, 0x85, 0x30, 0x9c, 0xb3, 0xa2, 0x8d, 0x65, 0xe0, 0x53, 0x38, 0xc3, 0xbf, 0x75, 0x07, 0xfd,
+		0x1a, 0x16, 0x07, 0x8b, 0xea, 0x11, 0xa3, 0x68, 0x08, 0x2e, 0x7f, 0x4b, 0xc1, 0xe6, 0xec, 0x0e,
+		0x43, 0x5b, 0xb0, 0x28, 0x4b, 0xc7, 0x75, 0xe4, 0xa9, 0xb2, 0x42, 0xa0, 0x3b, 0xe8, 0x12, 0xd0,
+		0x83, 0x1f, 0xdc, 0xdf, 0xb6, 0xfc, 0x07, 0x93, 0x7c, 0x25, 0x76, 0x97, 0x57, 0x56, 0x82, 0xbb,
+		0x7f, 0x39, 0x35, 0xff, 0x5f, 0x24, 0xbc, 0x1e, 0xa1, 0xf1, 0xca, 0xc3, 0xb8, 0x08, 0xa9, 0x90,
+		0x89, 0x32, 0x96, 0xe4, 0x19, 0x8b, 0x3e, 0xd1, 0x73, 0xc8, 0x51, 0xbb, 0x49, 0x9c, 0x6e, 0x8b,
+		0xf0, 0x28, 0x88, 0x6a, 0x59, 0x1a, 0xc8, 0x74, 0x07, 0x69, 0x90, 0x1f, 0x42, 0xf8, 0x64, 0x4e,
+		0x7d, 0x37, 0x1c, 0xcb, 0x03, 0x46, 0x28, 0x43, 0xdb, 0x00, 0x94, 0x59, 0x01, 0x13, 0x3e, 0x44,
+		0xd1, 0x2c, 0x4a, 0x89, 0xee, 0xa0, 0xdf, 0x43, 0x2e, 0x52, 0x73, 0xfb, 0x99, 0xef, 0xda, 0x5f,
+		0x92, 0x78, 0x6e, 0xfd, 0x0f, 0xb0, 0xca, 0x17, 0x6d, 0x93, 0x58, 0x01, 0xbb, 0x21, 0x16, 0x13,
+		0x56, 0xb2, 0xdf, 0xb5, 0xb2, 0x12, 0xd2, 0x8e, 0x23, 0x16, 0xb7, 0xf5, 0x4b, 0xc8, 0x38, 0x84,
+		0x59, 0x6e, 0x8b, 0xaa, 0x8b, 0x9c, 0xff, 0x6c, 0x6a, 0xd4, 0x2f, 0xac, 0x7e, 0xcb, 0xb7, 0x1c,
+		0x1c, 0x81, 0xc3, 0x08, 0x5b, 0x8c, 0x91, 0x76, 0x87, 0xa9, 0x20, 0x0a, 0x49, 0x7e, 0xa2, 0x0f,
+		0x90, 0xe3, 0xa7, 0x0b, 0x7b, 0xa7, 0x1b, 0x10, 0x75, 0x69, 0x8e, 0xd9, 0x23, 0x81, 0xc1, 0x4b,
+		0x21, 0x43, 0x7e, 0xa0, 0x37, 0xb0, 0xc6, 0x0d, 0x84, 0x69, 0x25, 0x81, 0xe9, 0x3a, 0x61, 0x2f,
+		0xb0, 0xbe, 0x9a, 0xe3, 0xb5, 0x83, 0x42, 0xdd, 0x17, 0xae, 0xd2, 0xa5, 0x06, 0x9d, 0x43, 0x41,
+		0xe6, 0xd7, 0x94, 0x93, 0x55, 0x5d, 0x9e, 0x56, 0x42, 0xc3, 0xe1, 0x24, 0x1b, 0x56, 0x8e, 0x68,
+		0x9c, 0xef, 0xc5, 0xbe, 0xcb, 0x7f, 0x49, 0xc2, 0xfa, 0x8c, 0xf1, 0x8d, 0xd6, 0x21, 0x13, 0xad,
+		0x75, 0x85, 0x27, 0x36, 0xcd, 0xc4, 0x42, 0x8f, 0x15, 0x7a, 0xe2, 0x51, 0x85, 0x9e, 0xfc, 0xd1,
+		0x42, 0xff, 0x13, 0xfc, 0x64, 0xec, 0xe6, 0xa6, 0xcb, 0x48, 0x3b, 0x7c, 0x02, 0x84, 0xaf, 0xb9,
+		0xfd, 0xc7, 0xdd, 0x5f, 0x67, 0xa4, 0x8d, 0x57, 0x7b, 0x13, 0x32, 0x8a, 0xde, 0x43, 0x9a, 0xf4,
+		0x88, 0xc7, 0xa2, 0x0d, 0xbf, 0x3d, 0x7d, 0x26, 0x5b, 0xcc, 0xfa, 0xd8, 0xf2, 0x6f, 0xb0, 0x04,
+		0xa3, 0x43, 0xc8, 0x7b, 0xe4, 0xc1, 0x0c, 0xba, 0x9e, 0x29, 0xe9, 0xe9, 0xc7, 0xd0, 0x73, 0x1e,
+		0x79, 0xc0, 0x5d, 0xaf, 0xce, 0x29, 0xe5, 0x7f, 0x29, 0xa0, 0xce, 0xda, 0x69, 0xf3, 0xa7, 0xca,
+		0xb4, 0x69, 0x9f, 0x98, 0x3e, 0xed, 0x7f, 0xf4, 0x15, 0x56, 0xfe, 0xab, 0x02, 0xab, 0xf1, 0x53,
+		0x1a, 0xfe, 0x3d, 0xf1, 0xc2, 0x03, 0x46, 0xa3, 0x56, 0xbc, 0xad, 0x53, 0x38, 0x2b, 0x67, 0x2d,
+		0x45, 0xd7, 0x50, 0x18, 0xdb, 0xf3, 0x6a, 0xe2, 0x7f, 0x5b, 0xee, 0x38, 0x1f, 0x5f, 0xed, 0xe5,
+		0x7f, 0xc7, 0xdf, 0xfc, 0xfc, 0xb1, 0xe9, 0xdd, 0xfa, 0xff, 0x97, 0x31, 0xbc, 0x35, 0xfa, 0xa4,
+		0x4e, 0xf2, 0x31, 0x31, 0x7c, 0x25, 0x8f, 0xf4, 0xd1, 0x42, 0xac, 0x8f, 0x46, 0x86, 0x77, 0x2a,
+		0x3e, 0xbc, 0x77, 0x20, 0x7f, 0xeb, 0x06, 0x94, 0x89, 0xa2, 0x1a, 0x8e, 0xd6, 0x1c, 0x97, 0xf2,
+		0xb2, 0xd1, 0x1d, 0x54, 0x86, 0x65, 0x8f, 0x7c, 0x1d, 0x01, 0x65, 0xc4, 0x8c, 0x0f, 0x85, 0x11,
+		0x66, 0x7c, 0x0d, 0x64, 0x27, 0xd6, 0x40, 0x58, 0x7e, 0xc5, 0xd1, 0x40, 0xf2, 0xac, 0x8e, 0x2e,
+		0x50, 0x25, 0xbe, 0x40, 0x7f, 0xe0, 0xdf, 0x9f, 0x88, 0xda, 0x09, 0x7c, 0x9b, 0x50, 0x1a, 0xa7,
+		0x26, 0x87, 0xd4, 0x8b, 0x48, 0x3f, 0xa0, 0x96, 0x3f, 0x41, 0x61, 0xec, 0x65, 0x10, 0xdf, 0xe4,
+		0xca, 0x7f, 0xb1, 0xc9, 0xf7, 0xbf, 0x4d, 0xd6, 0x0e, 0x4f, 0xd5, 0x73, 0xd8, 0xc6, 0xf5, 0x8b,
+		0x13, 0xfd, 0x50, 0x33, 0xf4, 0xf3, 0x33, 0xd3, 0xd0, 0x1a, 0x9f, 0x4c, 0xe3, 0xfa, 0xa2, 0x6e,
+		0xea, 0x67, 0x57, 0xda, 0x89, 0x5e, 0x2b, 0x3e, 0x41, 0x25, 0x78, 0x36, 0x1d, 0x52, 0x3b, 0x3f,
+		0xd5, 0xf4, 0xb3, 0xa2, 0x32, 0xdb, 0xc8, 0xb1, 0xde, 0x30, 0xce, 0xf1, 0x75, 0x31, 0x81, 0x5e,
+		0xc3, 0xee, 0x74, 0x48, 0xe3, 0xfa, 0xec, 0xd0, 0x6c, 0x1c, 0x6b, 0xb8, 0x66, 0x36, 0x0c, 0xcd,
+		0xb8, 0x6c, 0x14, 0x93, 0x68, 0x17, 0x7e, 0x3e, 0x07, 0xac, 0x1d, 0x1a, 0xfa, 0x95, 0x6e, 0x5c,
+		0x17, 0x17, 0xd0, 0x3e, 0xbc, 0x9c, 0xeb, 0xd8, 0x3c, 0xad, 0x1b, 0x5a, 0x4d, 0x33, 0xb4, 0x62,
+		0x0a, 0xed, 0x40, 0x69, 0x3e, 0xf6, 0xea, 0xa0, 0x98, 0x46, 0xaf, 0xe0, 0xc5, 0x74, 0xd4, 0x91,
+		0xa6, 0x9f, 0x9c, 0x5f, 0xd5, 0xb1, 0x79, 0xaa, 0xe1, 0x4f, 0x75, 0x5c, 0xcc, 0xec, 0xbb, 0x50,
+		0x18, 0x7b, 0x08, 0xa3, 0x67, 0xa0, 0x8a, 0xa0, 0x98, 0xe7, 0x17, 0x75, 0x2c, 0x4c, 0x0c, 0x03,
+		0xb9, 0x05, 0xeb, 0x13, 0xda, 0x43, 0x5c, 0xd7, 0x8c, 0x7a, 0x51, 0x99, 0xaa, 0xbc, 0xbc, 0xa8,
+		0x85, 0xca, 0xc4, 0xfe, 0x19, 0x64, 0x6a, 0x27, 0x9f, 0x79, 0xc2, 0xd6, 0xa0, 0x58, 0x3b, 0xf9,
+		0x3c, 0x9e, 0x23, 0x15, 0xd6, 0x06, 0xd2, 0x91, 0xf3, 0x17, 0x15, 0xb4, 0x0a, 0x85, 0x81, 0x46,
+		0x26, 0x2c, 0xf1, 0xf1, 0x57, 0x7f, 0x7c, 0x7f, 0xe7, 0xb2, 0x66, 0xf7, 0xa6, 0x62, 0xfb, 0xed,
+		0x6a, 0xec, 0x07, 0x87, 0xca, 0x1d, 0xf1, 0xc4, 0x0f, 0x1c, 0xc3, 0xdf, 0x1e, 0x7e, 0x27, 0xfe,
+		0xea, 0xbd, 0xbd, 0x49, 0x73, 0xcd, 0xbb, 0xff, 0x0c, 0x00, 0x84, 0x8d, 0xed, 0x57, 0x6c, 0x11,
+		0x00, 0x00,
+	},
+	// google/protobuf/duration.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
+		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
+		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
+		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
+		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
+		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
+		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
+		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
+		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
+		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
+		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -191,21 +209,6 @@ var yarpcFileDescriptorClosure00df2ec6c2eaefe5 = [][]byte{
 		0x4c, 0x65, 0xf9, 0xbb, 0x31, 0x7f, 0xa9, 0x17, 0x34, 0xe1, 0xf3, 0x93, 0x49, 0xad, 0xa8, 0x3d,
 		0xfb, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x8c, 0x5b, 0xe4, 0xc9, 0x06, 0x00, 0x00,
 	},
-	// google/protobuf/duration.proto
-	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
-		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x4f, 0x29, 0x2d, 0x4a,
-		0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0x56,
-		0x5c, 0x1c, 0x2e, 0x50, 0x25, 0x42, 0x12, 0x5c, 0xec, 0xc5, 0xa9, 0xc9, 0xf9, 0x79, 0x29, 0xc5,
-		0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xcc, 0x41, 0x30, 0xae, 0x90, 0x08, 0x17, 0x6b, 0x5e, 0x62, 0x5e,
-		0x7e, 0xb1, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x6b, 0x10, 0x84, 0xe3, 0xd4, 0xcc, 0xc8, 0x25, 0x9c,
-		0x9c, 0x9f, 0xab, 0x87, 0x66, 0xa6, 0x13, 0x2f, 0xcc, 0xc4, 0x00, 0x90, 0x48, 0x00, 0x63, 0x94,
-		0x21, 0x54, 0x45, 0x7a, 0x7e, 0x4e, 0x62, 0x5e, 0xba, 0x5e, 0x7e, 0x51, 0x3a, 0xc2, 0x81, 0x25,
-		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
-		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
-		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
-	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
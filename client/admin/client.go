@@ -332,6 +332,28 @@ func (c *clientImpl) MaintainCorruptWorkflow(
 	return c.client.MaintainCorruptWorkflow(ctx, request, opts...)
 }
 
+func (c *clientImpl) RestoreWorkflow(
+	ctx context.Context,
+	request *types.AdminRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RestoreWorkflow(ctx, request, opts...)
+}
+
+func (c *clientImpl) SearchArchivedHistory(
+	ctx context.Context,
+	request *types.AdminSearchArchivedHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.AdminSearchArchivedHistoryResponse, error) {
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SearchArchivedHistory(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -721,6 +721,57 @@ func (c *errorInjectionClient) MaintainCorruptWorkflow(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) RestoreWorkflow(
+	ctx context.Context,
+	request *types.AdminRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.RestoreWorkflow(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationRestoreWorkflow,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
+func (c *errorInjectionClient) SearchArchivedHistory(
+	ctx context.Context,
+	request *types.AdminSearchArchivedHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.AdminSearchArchivedHistoryResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.AdminSearchArchivedHistoryResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.SearchArchivedHistory(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationSearchArchivedHistory,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
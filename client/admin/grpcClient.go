@@ -168,6 +168,16 @@ func (g grpcClient) MaintainCorruptWorkflow(ctx context.Context, request *types.
 	return proto.ToAdminMaintainWorkflowResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) RestoreWorkflow(ctx context.Context, request *types.AdminRestoreWorkflowRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RestoreWorkflow(ctx, proto.FromAdminRestoreWorkflowRequest(request), opts...)
+	return proto.ToError(err)
+}
+
+func (g grpcClient) SearchArchivedHistory(ctx context.Context, request *types.AdminSearchArchivedHistoryRequest, opts ...yarpc.CallOption) (*types.AdminSearchArchivedHistoryResponse, error) {
+	response, err := g.c.SearchArchivedHistory(ctx, proto.FromAdminSearchArchivedHistoryRequest(request), opts...)
+	return proto.ToAdminSearchArchivedHistoryResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromListDynamicConfigRequest(request), opts...)
 	return proto.ToListDynamicConfigResponse(response), proto.ToError(err)
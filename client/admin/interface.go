@@ -59,4 +59,6 @@ type Client interface {
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*types.AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*types.AdminMaintainWorkflowResponse, error)
+	RestoreWorkflow(context.Context, *types.AdminRestoreWorkflowRequest, ...yarpc.CallOption) error
+	SearchArchivedHistory(context.Context, *types.AdminSearchArchivedHistoryRequest, ...yarpc.CallOption) (*types.AdminSearchArchivedHistoryResponse, error)
 }
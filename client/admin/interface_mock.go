@@ -569,6 +569,45 @@ func (mr *MockClientMockRecorder) MaintainCorruptWorkflow(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaintainCorruptWorkflow", reflect.TypeOf((*MockClient)(nil).MaintainCorruptWorkflow), varargs...)
 }
 
+// RestoreWorkflow mocks base method
+func (m *MockClient) RestoreWorkflow(arg0 context.Context, arg1 *types.AdminRestoreWorkflowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreWorkflow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkflow indicates an expected call of RestoreWorkflow
+func (mr *MockClientMockRecorder) RestoreWorkflow(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflow", reflect.TypeOf((*MockClient)(nil).RestoreWorkflow), varargs...)
+}
+
+// SearchArchivedHistory mocks base method
+func (m *MockClient) SearchArchivedHistory(arg0 context.Context, arg1 *types.AdminSearchArchivedHistoryRequest, arg2 ...yarpc.CallOption) (*types.AdminSearchArchivedHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SearchArchivedHistory", varargs...)
+	ret0, _ := ret[0].(*types.AdminSearchArchivedHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchArchivedHistory indicates an expected call of SearchArchivedHistory
+func (mr *MockClientMockRecorder) SearchArchivedHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArchivedHistory", reflect.TypeOf((*MockClient)(nil).SearchArchivedHistory), varargs...)
+}
+
 // ListDynamicConfig mocks base method
 func (m *MockClient) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest, arg2 ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
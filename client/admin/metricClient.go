@@ -496,6 +496,41 @@ func (c *metricClient) MaintainCorruptWorkflow(
 	return resp, err
 }
 
+func (c *metricClient) RestoreWorkflow(
+	ctx context.Context,
+	request *types.AdminRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRestoreWorkflowScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRestoreWorkflowScope, metrics.CadenceClientLatency)
+	err := c.client.RestoreWorkflow(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRestoreWorkflowScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) SearchArchivedHistory(
+	ctx context.Context,
+	request *types.AdminSearchArchivedHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.AdminSearchArchivedHistoryResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSearchArchivedHistoryScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSearchArchivedHistoryScope, metrics.CadenceClientLatency)
+	resp, err := c.client.SearchArchivedHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSearchArchivedHistoryScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -413,6 +413,34 @@ func (c *retryableClient) MaintainCorruptWorkflow(
 	return resp, err
 }
 
+func (c *retryableClient) RestoreWorkflow(
+	ctx context.Context,
+	request *types.AdminRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RestoreWorkflow(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
+func (c *retryableClient) SearchArchivedHistory(
+	ctx context.Context,
+	request *types.AdminSearchArchivedHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*types.AdminSearchArchivedHistoryResponse, error) {
+
+	var resp *types.AdminSearchArchivedHistoryResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SearchArchivedHistory(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) ListDynamicConfig(
 	ctx context.Context,
 	request *types.ListDynamicConfigRequest,
@@ -169,6 +169,16 @@ func (t thriftClient) MaintainCorruptWorkflow(ctx context.Context, request *type
 	return thrift.ToAdminMaintainWorkflowResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) RestoreWorkflow(ctx context.Context, request *types.AdminRestoreWorkflowRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RestoreWorkflow(ctx, thrift.FromAdminRestoreWorkflowRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
+func (t thriftClient) SearchArchivedHistory(ctx context.Context, request *types.AdminSearchArchivedHistoryRequest, opts ...yarpc.CallOption) (*types.AdminSearchArchivedHistoryResponse, error) {
+	response, err := t.c.SearchArchivedHistory(ctx, thrift.FromListArchivedWorkflowExecutionsRequest(request), opts...)
+	return thrift.ToListArchivedWorkflowExecutionsResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest, opts ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error) {
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
@@ -143,6 +143,11 @@ func (g grpcClient) RefreshWorkflowTasks(ctx context.Context, request *types.His
 	return proto.ToError(err)
 }
 
+func (g grpcClient) RestoreWorkflow(ctx context.Context, request *types.HistoryRestoreWorkflowRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.RestoreWorkflow(ctx, proto.FromHistoryRestoreWorkflowRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.RemoveSignalMutableState(ctx, proto.FromHistoryRemoveSignalMutableStateRequest(request), opts...)
 	return proto.ToError(err)
@@ -473,6 +473,25 @@ func (mr *MockClientMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockClient)(nil).RefreshWorkflowTasks), varargs...)
 }
 
+// RestoreWorkflow mocks base method
+func (m *MockClient) RestoreWorkflow(arg0 context.Context, arg1 *types.HistoryRestoreWorkflowRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreWorkflow", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkflow indicates an expected call of RestoreWorkflow
+func (mr *MockClientMockRecorder) RestoreWorkflow(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflow", reflect.TypeOf((*MockClient)(nil).RestoreWorkflow), varargs...)
+}
+
 // RemoveSignalMutableState mocks base method
 func (m *MockClient) RemoveSignalMutableState(arg0 context.Context, arg1 *types.RemoveSignalMutableStateRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
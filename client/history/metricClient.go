@@ -723,6 +723,23 @@ func (c *metricClient) RefreshWorkflowTasks(
 	return err
 }
 
+func (c *metricClient) RestoreWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientRestoreWorkflowScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRestoreWorkflowScope, metrics.CadenceClientLatency)
+	err := c.client.RestoreWorkflow(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRestoreWorkflowScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
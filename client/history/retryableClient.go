@@ -624,6 +624,19 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) RestoreWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreWorkflowRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RestoreWorkflow(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) NotifyFailoverMarkers(
 	ctx context.Context,
 	request *types.NotifyFailoverMarkersRequest,
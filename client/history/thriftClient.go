@@ -144,6 +144,11 @@ func (t thriftClient) RefreshWorkflowTasks(ctx context.Context, request *types.H
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) RestoreWorkflow(ctx context.Context, request *types.HistoryRestoreWorkflowRequest, opts ...yarpc.CallOption) error {
+	err := t.c.RestoreWorkflow(ctx, thrift.FromHistoryRestoreWorkflowRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest, opts ...yarpc.CallOption) error {
 	err := t.c.RemoveSignalMutableState(ctx, thrift.FromRemoveSignalMutableStateRequest(request), opts...)
 	return thrift.ToError(err)
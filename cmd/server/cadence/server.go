@@ -32,7 +32,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
-	"github.com/uber/cadence/common/blobstore/filestore"
+	blobstoreprovider "github.com/uber/cadence/common/blobstore/provider"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -203,8 +203,13 @@ func (s *server) startService() common.Daemon {
 		common.GetDefaultAdvancedVisibilityWritingMode(params.PersistenceConfig.IsAdvancedVisibilityConfigExist()),
 	)()
 	isAdvancedVisEnabled := advancedVisMode != common.AdvancedVisibilityWritingModeOff
-	if isAdvancedVisEnabled {
-		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isAdvancedVisEnabled)
+	// without the Kafka topics of visibility, history writes advanced visibility to ElasticSearch directly
+	_, isKafkaVisConfigured := s.cfg.Kafka.Applications[common.VisibilityAppName]
+	isKafkaVisEnabled := isAdvancedVisEnabled && isKafkaVisConfigured
+	params.KafkaVisibilityEnabled = isKafkaVisEnabled
+	isKafkaAuditEnabled := s.cfg.Audit.Enable && s.cfg.Audit.Kafka != nil
+	if isKafkaVisEnabled || isKafkaAuditEnabled {
+		params.MessagingClient = kafka.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, params.Logger, params.MetricScope, isKafkaVisEnabled)
 	} else {
 		params.MessagingClient = nil
 	}
@@ -256,10 +261,16 @@ func (s *server) startService() common.Daemon {
 	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicconfig.PersistenceErrorInjectionRate, 0)
+	for _, ds := range params.PersistenceConfig.DataStores {
+		if ds.SQL != nil && ds.SQL.Resharding != nil {
+			ds.SQL.Resharding.Cutover = dc.GetBoolPropertyFilteredByShardID(dynamicconfig.SQLReshardingCutover, false)
+		}
+	}
 	params.AuthorizationConfig = s.cfg.Authorization
-	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
+	params.AuditConfig = s.cfg.Audit
+	params.BlobstoreClient, err = blobstoreprovider.NewClient(&s.cfg.Blobstore)
 	if err != nil {
-		log.Printf("failed to create file blobstore client, will continue startup without it: %v", err)
+		log.Printf("failed to create blobstore client, will continue startup without it: %v", err)
 		params.BlobstoreClient = nil
 	}
 
@@ -0,0 +1,268 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package connector wraps the Azure Blob Storage SDK with the operations needed by the archivers, it authorizes
+// the requests with either the shared key of the account or a SAS token.
+// It works with both Azure Storage and the Azurite emulator.
+package connector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-pipeline-go/pipeline"
+	"github.com/Azure/azure-storage-blob-go/azblob"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	accountKeyEnv = "AZURE_STORAGE_KEY"
+)
+
+var (
+	// ErrContainerNotFound is non retriable error that is thrown when the container doesn't exist
+	ErrContainerNotFound = errors.New("container not found")
+	// ErrBlobNotFound is the error that is thrown when the blob doesn't exist
+	ErrBlobNotFound = errors.New("blob not found")
+
+	errEmptyAccountName = errors.New("empty azure storage account name")
+	errNoCredentials    = errors.New("either the account key or the SAS token must be set for azure storage")
+)
+
+type (
+	// Client is a client of Azure Blob Storage, the blobs of all methods are in the given container.
+	Client interface {
+		Upload(ctx context.Context, container, blob string, data []byte) error
+		Get(ctx context.Context, container, blob string) ([]byte, error)
+		// Exist checks if a blob exists, if blob is empty it checks if the container exists.
+		// ErrContainerNotFound is returned if the container doesn't exist.
+		Exist(ctx context.Context, container, blob string) (bool, error)
+		// List returns the names of at most maxResults blobs with the given prefix in lexicographical order,
+		// starting from the marker returned by the previous call. The returned marker is empty after the last page.
+		List(ctx context.Context, container, prefix, marker string, maxResults int) ([]string, string, error)
+		// DeleteModifiedBefore deletes the blobs with the given prefix that were last modified before the given time.
+		DeleteModifiedBefore(ctx context.Context, container, prefix string, before time.Time) error
+	}
+
+	// StorageError is the error returned by Azure Blob Storage for a failed request
+	StorageError struct {
+		StatusCode int
+		Code       string
+	}
+
+	client struct {
+		service azblob.ServiceURL
+	}
+)
+
+// NewClient creates a client of the blob service of the configured account
+func NewClient(config *config.AzblobArchiver) (Client, error) {
+	if config.AccountName == "" {
+		return nil, errEmptyAccountName
+	}
+
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.blob.core.windows.net", config.AccountName)
+	}
+	serviceURL, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	var credential azblob.Credential
+	switch accountKey := config.AccountKey; {
+	case config.SASToken != "":
+		if _, err := url.ParseQuery(strings.TrimPrefix(config.SASToken, "?")); err != nil {
+			return nil, err
+		}
+		serviceURL.RawQuery = strings.TrimPrefix(config.SASToken, "?")
+		credential = azblob.NewAnonymousCredential()
+	case accountKey != "" || os.Getenv(accountKeyEnv) != "":
+		if accountKey == "" {
+			accountKey = os.Getenv(accountKeyEnv)
+		}
+		if credential, err = azblob.NewSharedKeyCredential(config.AccountName, accountKey); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errNoCredentials
+	}
+
+	httpClient := &http.Client{}
+	if config.TLS.Enabled {
+		tlsConfig, err := config.TLS.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		httpClient.Transport = transport
+	}
+	p := azblob.NewPipeline(credential, azblob.PipelineOptions{
+		// the archivers retry the failed requests with their own retry policies,
+		// which also keep inline archival within its time limit
+		Retry:      azblob.RetryOptions{MaxTries: 1},
+		HTTPSender: newHTTPSender(httpClient),
+	})
+	return &client{service: azblob.NewServiceURL(*serviceURL, p)}, nil
+}
+
+func (e *StorageError) Error() string {
+	return fmt.Sprintf("azure storage request failed with status %v and error code %v", e.StatusCode, e.Code)
+}
+
+func (c *client) Upload(ctx context.Context, container, blob string, data []byte) error {
+	blobURL := c.service.NewContainerURL(container).NewBlockBlobURL(blob)
+	_, err := azblob.UploadBufferToBlockBlob(ctx, data, blobURL, azblob.UploadToBlockBlobOptions{
+		BlobHTTPHeaders: azblob.BlobHTTPHeaders{ContentType: "application/octet-stream"},
+	})
+	return convertError(err, ErrBlobNotFound)
+}
+
+func (c *client) Get(ctx context.Context, container, blob string) ([]byte, error) {
+	blobURL := c.service.NewContainerURL(container).NewBlobURL(blob)
+	resp, err := blobURL.Download(ctx, 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		return nil, convertError(err, ErrBlobNotFound)
+	}
+	body := resp.Body(azblob.RetryReaderOptions{})
+	defer body.Close()
+	return ioutil.ReadAll(body)
+}
+
+func (c *client) Exist(ctx context.Context, container, blob string) (bool, error) {
+	containerURL := c.service.NewContainerURL(container)
+	var err error
+	if blob == "" {
+		_, err = containerURL.GetProperties(ctx, azblob.LeaseAccessConditions{})
+		err = convertError(err, ErrContainerNotFound)
+	} else {
+		_, err = containerURL.NewBlobURL(blob).GetProperties(ctx, azblob.BlobAccessConditions{}, azblob.ClientProvidedKeyOptions{})
+		err = convertError(err, ErrBlobNotFound)
+	}
+	switch err {
+	case nil:
+		return true, nil
+	case ErrBlobNotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (c *client) List(ctx context.Context, container, prefix, marker string, maxResults int) ([]string, string, error) {
+	listMarker := azblob.Marker{}
+	if marker != "" {
+		listMarker.Val = &marker
+	}
+	segment, err := c.listBlobs(ctx, container, prefix, listMarker, maxResults)
+	if err != nil {
+		return nil, "", err
+	}
+	names := make([]string, 0, len(segment.Segment.BlobItems))
+	for _, blob := range segment.Segment.BlobItems {
+		names = append(names, blob.Name)
+	}
+	return names, *segment.NextMarker.Val, nil
+}
+
+func (c *client) DeleteModifiedBefore(ctx context.Context, container, prefix string, before time.Time) error {
+	containerURL := c.service.NewContainerURL(container)
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		segment, err := c.listBlobs(ctx, container, prefix, marker, 0)
+		if err != nil {
+			return err
+		}
+		for _, blob := range segment.Segment.BlobItems {
+			if !blob.Properties.LastModified.Before(before) {
+				continue
+			}
+			_, err := containerURL.NewBlobURL(blob.Name).Delete(ctx, azblob.DeleteSnapshotsOptionNone, azblob.BlobAccessConditions{})
+			if err := convertError(err, ErrBlobNotFound); err != nil && err != ErrBlobNotFound {
+				return err
+			}
+		}
+		marker = segment.NextMarker
+	}
+	return nil
+}
+
+func (c *client) listBlobs(
+	ctx context.Context,
+	container string,
+	prefix string,
+	marker azblob.Marker,
+	maxResults int,
+) (*azblob.ListBlobsFlatSegmentResponse, error) {
+	segment, err := c.service.NewContainerURL(container).ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{
+		Prefix:     prefix,
+		MaxResults: int32(maxResults),
+	})
+	if err != nil {
+		return nil, convertError(err, ErrContainerNotFound)
+	}
+	if segment.NextMarker.Val == nil {
+		// the marker is nil if the response has no NextMarker element, which is the same as an empty one
+		segment.NextMarker.Val = new(string)
+	}
+	return segment, nil
+}
+
+// newHTTPSender sends the requests of the pipeline with the given http client
+func newHTTPSender(httpClient *http.Client) pipeline.Factory {
+	return pipeline.FactoryFunc(func(next pipeline.Policy, po *pipeline.PolicyOptions) pipeline.PolicyFunc {
+		return func(ctx context.Context, request pipeline.Request) (pipeline.Response, error) {
+			resp, err := httpClient.Do(request.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			return pipeline.NewHTTPResponse(resp), nil
+		}
+	})
+}
+
+// convertError converts the errors returned by the service for missing containers and blobs to ErrContainerNotFound
+// and ErrBlobNotFound, and the other errors returned by the service to StorageError. notFound is returned for
+// responses without an error code, e.g. the responses of HEAD requests, with status 404.
+func convertError(err error, notFound error) error {
+	storageErr, ok := err.(azblob.StorageError)
+	if !ok {
+		return err
+	}
+	switch code := storageErr.ServiceCode(); {
+	case code == azblob.ServiceCodeContainerNotFound:
+		return ErrContainerNotFound
+	case code == azblob.ServiceCodeBlobNotFound:
+		return ErrBlobNotFound
+	case code == azblob.ServiceCodeNone && storageErr.Response().StatusCode == http.StatusNotFound:
+		return notFound
+	default:
+		return &StorageError{StatusCode: storageErr.Response().StatusCode, Code: string(code)}
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build archivalintegration
+// +build archivalintegration
+
+// to run locally, make sure azurite is running, e.g. with docker/dev/archival-emulators.yml,
+// then run cmd `go test -v ./common/archiver/azblob/connector -run TestAzuriteSuite -tags archivalintegration`
+package connector
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	azuriteEndpointEnv = "AZURITE_ENDPOINT"
+	// the well known account of the emulators
+	azuriteAccountName = "devstoreaccount1"
+	azuriteAccountKey  = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+)
+
+type azuriteSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	client    Client
+	container string
+}
+
+func TestAzuriteSuite(t *testing.T) {
+	suite.Run(t, new(azuriteSuite))
+}
+
+func (s *azuriteSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+	endpoint := os.Getenv(azuriteEndpointEnv)
+	if endpoint == "" {
+		endpoint = "http://127.0.0.1:10000/" + azuriteAccountName
+	}
+
+	var err error
+	s.client, err = NewClient(&config.AzblobArchiver{
+		AccountName: azuriteAccountName,
+		AccountKey:  azuriteAccountKey,
+		Endpoint:    endpoint,
+	})
+	s.Require().NoError(err)
+
+	s.container = fmt.Sprintf("cadence-test-%v", time.Now().UnixNano())
+	containerURL := s.client.(*client).service.NewContainerURL(s.container)
+	_, err = containerURL.Create(context.Background(), azblob.Metadata{}, azblob.PublicAccessNone)
+	s.Require().NoError(err)
+}
+
+func (s *azuriteSuite) TearDownSuite() {
+	containerURL := s.client.(*client).service.NewContainerURL(s.container)
+	containerURL.Delete(context.Background(), azblob.ContainerAccessConditions{})
+}
+
+func (s *azuriteSuite) TestContainer() {
+	exists, err := s.client.Exist(context.Background(), s.container, "")
+	s.NoError(err)
+	s.True(exists)
+
+	_, err = s.client.Exist(context.Background(), "missing-container", "")
+	s.Equal(ErrContainerNotFound, err)
+}
+
+func (s *azuriteSuite) TestBlobs() {
+	ctx := context.Background()
+	names := []string{"blobs/a b/3", "blobs/a b/1", "blobs/c/1", "blobs/a b/2"}
+	for _, name := range names {
+		s.NoError(s.client.Upload(ctx, s.container, name, []byte(name)))
+	}
+
+	for _, name := range names {
+		exists, err := s.client.Exist(ctx, s.container, name)
+		s.NoError(err)
+		s.True(exists)
+
+		data, err := s.client.Get(ctx, s.container, name)
+		s.NoError(err)
+		s.Equal([]byte(name), data)
+	}
+
+	exists, err := s.client.Exist(ctx, s.container, "blobs/missing")
+	s.NoError(err)
+	s.False(exists)
+	_, err = s.client.Get(ctx, s.container, "blobs/missing")
+	s.Equal(ErrBlobNotFound, err)
+
+	listed, marker, err := s.client.List(ctx, s.container, "blobs/a b/", "", 2)
+	s.NoError(err)
+	s.Equal([]string{"blobs/a b/1", "blobs/a b/2"}, listed)
+	s.NotEmpty(marker)
+
+	listed, marker, err = s.client.List(ctx, s.container, "blobs/a b/", marker, 2)
+	s.NoError(err)
+	s.Equal([]string{"blobs/a b/3"}, listed)
+	s.Empty(marker)
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package connector
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	testAccountName = "devstoreaccount1"
+	testContainer   = "test-container"
+	testSignature   = "test-signature"
+)
+
+var (
+	testAccountKey = base64.StdEncoding.EncodeToString([]byte("test-account-key"))
+)
+
+type (
+	clientSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		server   *httptest.Server
+		blobs    map[string][]byte
+		modified map[string]time.Time
+	}
+
+	listBlobsResponse struct {
+		XMLName    xml.Name     `xml:"EnumerationResults"`
+		Blobs      []listedBlob `xml:"Blobs>Blob"`
+		NextMarker string       `xml:"NextMarker"`
+	}
+
+	listedBlob struct {
+		Name         string `xml:"Name"`
+		LastModified string `xml:"Properties>Last-Modified"`
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(clientSuite))
+}
+
+func (s *clientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.blobs = make(map[string][]byte)
+	s.modified = make(map[string]time.Time)
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *clientSuite) TestNewClient_Fail() {
+	_, err := NewClient(&config.AzblobArchiver{AccountKey: testAccountKey})
+	s.Equal(errEmptyAccountName, err)
+
+	_, err = NewClient(&config.AzblobArchiver{AccountName: testAccountName})
+	s.Equal(errNoCredentials, err)
+
+	_, err = NewClient(&config.AzblobArchiver{AccountName: testAccountName, AccountKey: "not base64"})
+	s.Error(err)
+}
+
+func (s *clientSuite) TestNewClient_DefaultEndpoint() {
+	c, err := NewClient(&config.AzblobArchiver{AccountName: testAccountName, SASToken: "?sv=2020-04-08&sig=abc"})
+	s.NoError(err)
+	serviceURL := c.(*client).service.URL()
+	s.Equal("https://devstoreaccount1.blob.core.windows.net?sv=2020-04-08&sig=abc", serviceURL.String())
+}
+
+func (s *clientSuite) TestSASToken() {
+	c, err := NewClient(&config.AzblobArchiver{
+		AccountName: testAccountName,
+		SASToken:    "?sv=2020-04-08&sig=" + testSignature,
+		Endpoint:    s.server.URL + "/" + testAccountName,
+	})
+	s.NoError(err)
+	ctx := context.Background()
+
+	s.NoError(c.Upload(ctx, testContainer, "blob", []byte("data")))
+	data, err := c.Get(ctx, testContainer, "blob")
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+}
+
+func (s *clientSuite) TestUploadAndGet() {
+	c := s.newClient()
+	ctx := context.Background()
+
+	s.NoError(c.Upload(ctx, testContainer, "dir/some blob", []byte("data")))
+	s.Equal([]byte("data"), s.blobs["/"+testAccountName+"/"+testContainer+"/dir/some blob"])
+
+	data, err := c.Get(ctx, testContainer, "dir/some blob")
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+
+	_, err = c.Get(ctx, testContainer, "dir/missing")
+	s.Equal(ErrBlobNotFound, err)
+
+	err = c.Upload(ctx, "missing-container", "blob", []byte("data"))
+	s.Equal(ErrContainerNotFound, err)
+}
+
+func (s *clientSuite) TestExist() {
+	c := s.newClient()
+	ctx := context.Background()
+	s.NoError(c.Upload(ctx, testContainer, "blob", []byte("data")))
+
+	exists, err := c.Exist(ctx, testContainer, "")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = c.Exist(ctx, testContainer, "blob")
+	s.NoError(err)
+	s.True(exists)
+
+	exists, err = c.Exist(ctx, testContainer, "missing")
+	s.NoError(err)
+	s.False(exists)
+
+	exists, err = c.Exist(ctx, "missing-container", "")
+	s.Equal(ErrContainerNotFound, err)
+	s.False(exists)
+}
+
+func (s *clientSuite) TestList() {
+	c := s.newClient()
+	ctx := context.Background()
+	for _, blob := range []string{"a/3", "a/1", "b/1", "a/2"} {
+		s.NoError(c.Upload(ctx, testContainer, blob, []byte(blob)))
+	}
+
+	names, marker, err := c.List(ctx, testContainer, "a/", "", 2)
+	s.NoError(err)
+	s.Equal([]string{"a/1", "a/2"}, names)
+	s.NotEmpty(marker)
+
+	names, marker, err = c.List(ctx, testContainer, "a/", marker, 2)
+	s.NoError(err)
+	s.Equal([]string{"a/3"}, names)
+	s.Empty(marker)
+
+	_, _, err = c.List(ctx, "missing-container", "", "", 0)
+	s.Equal(ErrContainerNotFound, err)
+}
+
+func (s *clientSuite) TestDeleteModifiedBefore() {
+	c := s.newClient()
+	ctx := context.Background()
+	for _, blob := range []string{"a/1", "a/2", "b/1"} {
+		s.NoError(c.Upload(ctx, testContainer, blob, []byte(blob)))
+	}
+	now := time.Now()
+	s.modified["/"+testAccountName+"/"+testContainer+"/a/1"] = now.Add(-time.Hour)
+	s.modified["/"+testAccountName+"/"+testContainer+"/b/1"] = now.Add(-time.Hour)
+
+	s.NoError(c.DeleteModifiedBefore(ctx, testContainer, "a/", now.Add(-time.Minute)))
+	names, _, err := c.List(ctx, testContainer, "", "", 0)
+	s.NoError(err)
+	s.Equal([]string{"a/2", "b/1"}, names)
+
+	err = c.DeleteModifiedBefore(ctx, "missing-container", "", now)
+	s.Equal(ErrContainerNotFound, err)
+}
+
+func (s *clientSuite) TestStorageError() {
+	c, err := NewClient(&config.AzblobArchiver{
+		AccountName: testAccountName,
+		SASToken:    "?sv=2020-04-08&sig=wrong",
+		Endpoint:    s.server.URL + "/" + testAccountName,
+	})
+	s.NoError(err)
+
+	_, err = c.Get(context.Background(), testContainer, "blob")
+	s.Equal(&StorageError{StatusCode: http.StatusForbidden, Code: "AuthenticationFailed"}, err)
+}
+
+func (s *clientSuite) newClient() Client {
+	c, err := NewClient(&config.AzblobArchiver{
+		AccountName: testAccountName,
+		AccountKey:  testAccountKey,
+		Endpoint:    s.server.URL + "/" + testAccountName,
+	})
+	s.NoError(err)
+	return c
+}
+
+// handle emulates the blob service with a single container
+func (s *clientSuite) handle(w http.ResponseWriter, r *http.Request) {
+	// the signature of the shared key is computed by the SDK, only its presence is checked
+	sharedKey := strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+testAccountName+":")
+	if !sharedKey && r.URL.Query().Get("sig") != testSignature {
+		writeError(w, http.StatusForbidden, "AuthenticationFailed")
+		return
+	}
+
+	containerPath := "/" + testAccountName + "/" + testContainer
+	if r.URL.Path != containerPath && !strings.HasPrefix(r.URL.Path, containerPath+"/") {
+		writeError(w, http.StatusNotFound, "ContainerNotFound")
+		return
+	}
+
+	query := r.URL.Query()
+	switch {
+	case r.URL.Path == containerPath && query.Get("comp") == "list":
+		s.handleList(w, query)
+	case r.URL.Path == containerPath:
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodPut:
+		data, _ := ioutil.ReadAll(r.Body)
+		s.blobs[r.URL.Path] = data
+		s.modified[r.URL.Path] = time.Now()
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodDelete:
+		if _, ok := s.blobs[r.URL.Path]; !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		delete(s.blobs, r.URL.Path)
+		delete(s.modified, r.URL.Path)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		data, ok := s.blobs[r.URL.Path]
+		if !ok {
+			writeError(w, http.StatusNotFound, "BlobNotFound")
+			return
+		}
+		w.Write(data)
+	}
+}
+
+func (s *clientSuite) handleList(w http.ResponseWriter, query url.Values) {
+	containerPath := "/" + testAccountName + "/" + testContainer + "/"
+	var names []string
+	for path := range s.blobs {
+		name := strings.TrimPrefix(path, containerPath)
+		if strings.HasPrefix(name, query.Get("prefix")) && name >= query.Get("marker") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	response := listBlobsResponse{}
+	if maxResults, _ := strconv.Atoi(query.Get("maxresults")); maxResults > 0 && len(names) > maxResults {
+		response.NextMarker = names[maxResults]
+		names = names[:maxResults]
+	}
+	for _, name := range names {
+		response.Blobs = append(response.Blobs, listedBlob{
+			Name:         name,
+			LastModified: s.modified[containerPath+name].UTC().Format(http.TimeFormat),
+		})
+	}
+	data, _ := xml.Marshal(response)
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code string) {
+	w.Header().Set("x-ms-error-code", code)
+	w.WriteHeader(statusCode)
+}
@@ -0,0 +1,508 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/azblob/connector"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID             = "test-domain-id"
+	testDomainName           = "test-domain-name"
+	testWorkflowID           = "test-workflow-id"
+	testRunID                = "test-run-id"
+	testNextEventID          = 1800
+	testCloseFailoverVersion = 100
+	testPageSize             = 100
+	testContainer            = "test-container"
+	testContainerURI         = "azblob://test-container"
+)
+
+var (
+	testBranchToken = []byte{1, 2, 3}
+)
+
+type (
+	historyArchiverSuite struct {
+		*require.Assertions
+		suite.Suite
+		client             *memoryClient
+		container          *archiver.HistoryBootstrapContainer
+		testArchivalURI    archiver.URI
+		historyBatchesV1   []*archiver.HistoryBlob
+		historyBatchesV100 []*archiver.HistoryBlob
+	}
+
+	// memoryClient is an in memory connector.Client with a single container
+	memoryClient struct {
+		sync.Mutex
+		blobs     map[string][]byte
+		modified  map[string]time.Time
+		uploadErr error
+	}
+)
+
+func TestHistoryArchiverSuite(t *testing.T) {
+	suite.Run(t, new(historyArchiverSuite))
+}
+
+func (s *historyArchiverSuite) SetupTest() {
+	var err error
+	s.Assertions = require.New(s.T())
+	s.client = newMemoryClient()
+	s.container = &archiver.HistoryBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zap.NewNop()),
+		MetricsClient: metrics.NewClient(tally.NewTestScope("test", nil), metrics.HistoryArchiverScope),
+	}
+	s.testArchivalURI, err = archiver.NewURI(testContainerURI)
+	s.Require().NoError(err)
+	s.setupHistoryDirectory()
+}
+
+func (s *historyArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme:///a/b/c",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "azblob://",
+			expectedErr: errNoContainerSpecified,
+		},
+		{
+			URI:         "azblob://container/a/b/c",
+			expectedErr: connector.ErrContainerNotFound,
+		},
+		{
+			URI:         testContainerURI + "/a/b/c",
+			expectedErr: nil,
+		},
+	}
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, historyArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.Equal(archiver.ErrURISchemeMismatch, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := s.newArchiveRequest()
+	request.WorkflowID = "" // an invalid request
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, request)
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_ErrorOnReadHistory() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, errors.New("some random error")),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.Error(err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Fail_RetryableUploadError() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+	)
+	uploadErr := &connector.StorageError{StatusCode: 503, Code: "ServerBusy"}
+	s.client.uploadErr = uploadErr
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	nonRetriableErr := errors.New("some non-retryable error")
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest(), archiver.GetNonRetriableErrorOption(nonRetriableErr))
+	s.Equal(uploadErr, err)
+}
+
+func (s *historyArchiverSuite) TestArchive_Skip() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(nil, &types.EntityNotExistsError{Message: "workflow not found"}),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	err := historyArchiver.Archive(context.Background(), s.testArchivalURI, s.newArchiveRequest())
+	s.NoError(err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.Nil(response)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Fail_KeyNotExist() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+	}
+	URI, err := archiver.NewURI(testContainerURI + "/non-existent")
+	s.NoError(err)
+	response, err := historyArchiver.Get(context.Background(), URI, request)
+	s.Nil(response)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_PickHighestVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_UseProvidedVersion() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: common.Int64Ptr(1),
+	}
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV1[0].Body, response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	request := &archiver.GetHistoryRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             1,
+		CloseFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+	}
+	combinedHistory := []*types.History{}
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.NotNil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = historyArchiver.Get(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.HistoryBatches, 1)
+	combinedHistory = append(combinedHistory, response.HistoryBatches...)
+
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), combinedHistory)
+}
+
+func (s *historyArchiverSuite) TestDelete() {
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	otherDomainKey := constructHistoryKey("", "other-domain-id", testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+	s.NoError(s.client.Upload(context.Background(), testContainer, otherDomainKey, []byte("data")))
+
+	err := historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID: testDomainID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	err = historyArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteHistoryRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now().UnixNano(),
+	})
+	s.NoError(err)
+
+	response, err := historyArchiver.Get(context.Background(), s.testArchivalURI, &archiver.GetHistoryRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		PageSize:             testPageSize,
+		CloseFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+	})
+	s.Nil(response)
+	s.IsType(&types.EntityNotExistsError{}, err)
+	exists, err := s.client.Exist(context.Background(), testContainer, otherDomainKey)
+	s.NoError(err)
+	s.True(exists)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndGet() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[0], nil),
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(s.historyBatchesV100[1], nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	URI, err := archiver.NewURI(testContainerURI + "/TestArchiveAndGet")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, s.newArchiveRequest())
+	s.NoError(err)
+	s.Contains(s.client.blobs, constructHistoryKey(URI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 1))
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal(append(s.historyBatchesV100[0].Body, s.historyBatchesV100[1].Body...), response.HistoryBatches)
+}
+
+func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
+	return newHistoryArchiver(s.container, s.client, historyIterator)
+}
+
+func (s *historyArchiverSuite) newArchiveRequest() *archiver.ArchiveHistoryRequest {
+	return &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+}
+
+func (s *historyArchiverSuite) setupHistoryDirectory() {
+	now := time.Now().UnixNano()
+	s.historyBatchesV1 = []*archiver.HistoryBlob{
+		{
+			Header: &archiver.HistoryBlobHeader{
+				IsLast: common.BoolPtr(true),
+			},
+			Body: []*types.History{
+				{
+					Events: []*types.HistoryEvent{
+						{
+							ID:        testNextEventID - 1,
+							Timestamp: common.Int64Ptr(now),
+							Version:   1,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s.historyBatchesV100 = []*archiver.HistoryBlob{
+		{
+			Header: &archiver.HistoryBlobHeader{
+				IsLast: common.BoolPtr(false),
+			},
+			Body: []*types.History{
+				{
+					Events: []*types.HistoryEvent{
+						{
+							ID:        common.FirstEventID + 1,
+							Timestamp: common.Int64Ptr(now),
+							Version:   testCloseFailoverVersion,
+						},
+						{
+							ID:        common.FirstEventID + 1,
+							Timestamp: common.Int64Ptr(now),
+							Version:   testCloseFailoverVersion,
+						},
+					},
+				},
+			},
+		},
+		{
+			Header: &archiver.HistoryBlobHeader{
+				IsLast: common.BoolPtr(true),
+			},
+			Body: []*types.History{
+				{
+					Events: []*types.HistoryEvent{
+						{
+							ID:        testNextEventID - 1,
+							Timestamp: common.Int64Ptr(now),
+							Version:   testCloseFailoverVersion,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s.writeHistoryBatchesForGetTest(s.historyBatchesV1, int64(1))
+	s.writeHistoryBatchesForGetTest(s.historyBatchesV100, testCloseFailoverVersion)
+}
+
+func (s *historyArchiverSuite) writeHistoryBatchesForGetTest(historyBatches []*archiver.HistoryBlob, version int64) {
+	for i, batch := range historyBatches {
+		data, err := encode(batch)
+		s.Require().NoError(err)
+		key := constructHistoryKey("", testDomainID, testWorkflowID, testRunID, version, i)
+		s.Require().NoError(s.client.Upload(context.Background(), testContainer, key, data))
+	}
+}
+
+func newMemoryClient() *memoryClient {
+	return &memoryClient{
+		blobs:    make(map[string][]byte),
+		modified: make(map[string]time.Time),
+	}
+}
+
+func (c *memoryClient) Upload(_ context.Context, container, blob string, data []byte) error {
+	c.Lock()
+	defer c.Unlock()
+	if container != testContainer {
+		return connector.ErrContainerNotFound
+	}
+	if c.uploadErr != nil {
+		return c.uploadErr
+	}
+	c.blobs[blob] = data
+	c.modified[blob] = time.Now()
+	return nil
+}
+
+func (c *memoryClient) Get(_ context.Context, container, blob string) ([]byte, error) {
+	c.Lock()
+	defer c.Unlock()
+	if container != testContainer {
+		return nil, connector.ErrContainerNotFound
+	}
+	data, ok := c.blobs[blob]
+	if !ok {
+		return nil, connector.ErrBlobNotFound
+	}
+	return data, nil
+}
+
+func (c *memoryClient) Exist(_ context.Context, container, blob string) (bool, error) {
+	c.Lock()
+	defer c.Unlock()
+	if container != testContainer {
+		return false, connector.ErrContainerNotFound
+	}
+	if blob == "" {
+		return true, nil
+	}
+	_, ok := c.blobs[blob]
+	return ok, nil
+}
+
+func (c *memoryClient) List(_ context.Context, container, prefix, marker string, maxResults int) ([]string, string, error) {
+	c.Lock()
+	defer c.Unlock()
+	if container != testContainer {
+		return nil, "", connector.ErrContainerNotFound
+	}
+	var names []string
+	for name := range c.blobs {
+		if strings.HasPrefix(name, prefix) && name >= marker {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if maxResults > 0 && len(names) > maxResults {
+		return names[:maxResults], names[maxResults], nil
+	}
+	return names, "", nil
+}
+
+func (c *memoryClient) DeleteModifiedBefore(_ context.Context, container, prefix string, before time.Time) error {
+	c.Lock()
+	defer c.Unlock()
+	if container != testContainer {
+		return connector.ErrContainerNotFound
+	}
+	for name := range c.blobs {
+		if strings.HasPrefix(name, prefix) && c.modified[name].Before(before) {
+			delete(c.blobs, name)
+			delete(c.modified, name)
+		}
+	}
+	return nil
+}
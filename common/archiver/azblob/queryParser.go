@@ -0,0 +1,65 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/definition"
+)
+
+type (
+	queryParser struct{}
+
+	parsedQuery struct {
+		// earliestCloseTime and latestCloseTime are the close time range required by the query,
+		// which is used to narrow down the prefix of the listed keys
+		earliestCloseTime int64
+		latestCloseTime   int64
+		filter            *archiver.VisibilityQuery
+		emptyResult       bool
+	}
+)
+
+func (p *queryParser) Parse(query string) (*parsedQuery, error) {
+	filter, err := archiver.ParseVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	parsedQuery := &parsedQuery{
+		filter: filter,
+	}
+	parsedQuery.earliestCloseTime, parsedQuery.latestCloseTime = filter.TimeRange(definition.CloseTime)
+	parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, time.Now().UnixNano())
+	if parsedQuery.earliestCloseTime > parsedQuery.latestCloseTime {
+		parsedQuery.emptyResult = true
+	}
+	return parsedQuery, nil
+}
+
+func (q *parsedQuery) match(record *archiver.ArchiveVisibilityRequest) bool {
+	if record.CloseTimestamp < q.earliestCloseTime || record.CloseTimestamp > q.latestCloseTime {
+		return false
+	}
+	return q.filter.Match(record)
+}
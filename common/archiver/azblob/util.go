@@ -0,0 +1,241 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/azblob/connector"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// reverseCloseTimeFormat is a fixed width format of the reverse close time in the visibility keys,
+	// so that the lexicographical order of the keys is the descending order of the close time
+	reverseCloseTimeFormat = "%019d"
+)
+
+// encoding & decoding util
+
+func encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func decodeHistoryBlob(data []byte) (*archiver.HistoryBlob, error) {
+	historyBlob := &archiver.HistoryBlob{}
+	err := json.Unmarshal(data, historyBlob)
+	if err != nil {
+		return nil, err
+	}
+	return historyBlob, nil
+}
+
+func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
+	record := &visibilityRecord{}
+	err := json.Unmarshal(data, record)
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func serializeToken(token interface{}) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return json.Marshal(token)
+}
+
+func deserializeGetHistoryToken(bytes []byte) (*getHistoryToken, error) {
+	token := &getHistoryToken{}
+	err := json.Unmarshal(bytes, token)
+	return token, err
+}
+
+// Only validates the scheme and containers are passed
+func softValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+	if len(URI.Hostname()) == 0 {
+		return errNoContainerSpecified
+	}
+	return nil
+}
+
+func containerExists(ctx context.Context, client connector.Client, URI archiver.URI) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := client.Exist(ctx, URI.Hostname(), "")
+	return err
+}
+
+func blobExists(ctx context.Context, client connector.Client, URI archiver.URI, blob string) (bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	exists, err := client.Exist(ctx, URI.Hostname(), blob)
+	if err == connector.ErrContainerNotFound {
+		return false, &types.BadRequestError{Message: err.Error()}
+	}
+	return exists, err
+}
+
+func upload(ctx context.Context, client connector.Client, URI archiver.URI, blob string, data []byte) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	err := client.Upload(ctx, URI.Hostname(), blob, data)
+	if err == connector.ErrContainerNotFound {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return err
+}
+
+func download(ctx context.Context, client connector.Client, URI archiver.URI, blob string) ([]byte, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	data, err := client.Get(ctx, URI.Hostname(), blob)
+	switch err {
+	case nil:
+		return data, nil
+	case connector.ErrContainerNotFound:
+		return nil, &types.BadRequestError{Message: err.Error()}
+	case connector.ErrBlobNotFound:
+		return nil, &types.EntityNotExistsError{Message: archiver.ErrHistoryNotExist.Error()}
+	default:
+		return nil, err
+	}
+}
+
+func deleteModifiedBefore(ctx context.Context, client connector.Client, URI archiver.URI, prefix string, before time.Time) error {
+	err := client.DeleteModifiedBefore(ctx, URI.Hostname(), prefix, before)
+	if err == connector.ErrContainerNotFound {
+		return &types.BadRequestError{Message: err.Error()}
+	}
+	return err
+}
+
+// Key construction
+func constructHistoryKey(path, domainID, workflowID, runID string, version int64, batchIdx int) string {
+	prefix := constructHistoryKeyPrefixWithVersion(path, domainID, workflowID, runID, version)
+	return fmt.Sprintf("%s%d", prefix, batchIdx)
+}
+
+func constructHistoryKeyPrefixWithVersion(path, domainID, workflowID, runID string, version int64) string {
+	prefix := constructHistoryKeyPrefix(path, domainID, workflowID, runID)
+	return fmt.Sprintf("%s/%v/", prefix, version)
+}
+
+func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
+}
+
+func constructHistoryDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "history"}, "/"), "/") + "/"
+}
+
+func constructVisibilityKey(path, domainID string, closeTimestamp int64, runID string) string {
+	return fmt.Sprintf("%s/%s/%s", constructVisibilityKeyPrefix(path, domainID), formatReverseCloseTime(closeTimestamp), runID)
+}
+
+func constructVisibilityKeyPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility", "reverseCloseTime"}, "/"), "/")
+}
+
+func constructVisibilityDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility"}, "/"), "/") + "/"
+}
+
+// constructCloseTimeSearchPrefix returns the longest prefix shared by the visibility keys
+// of the records closed in the given time range
+func constructCloseTimeSearchPrefix(path, domainID string, earliestCloseTime, latestCloseTime int64) string {
+	earliest := formatReverseCloseTime(earliestCloseTime)
+	latest := formatReverseCloseTime(latestCloseTime)
+	i := 0
+	for i < len(earliest) && earliest[i] == latest[i] {
+		i++
+	}
+	return constructVisibilityKeyPrefix(path, domainID) + "/" + earliest[:i]
+}
+
+// formatReverseCloseTime formats the close time subtracted from math.MaxInt64, so that the newer records
+// are listed first
+func formatReverseCloseTime(timestamp int64) string {
+	return fmt.Sprintf(reverseCloseTimeFormat, math.MaxInt64-timestamp)
+}
+
+func ensureContextTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, defaultBlobstoreTimeout)
+}
+
+func contextExpired(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableError(err error) bool {
+	switch err := err.(type) {
+	case *connector.StorageError:
+		return err.StatusCode == http.StatusTooManyRequests ||
+			(err.StatusCode >= http.StatusInternalServerError && err.StatusCode != http.StatusNotImplemented)
+	case *url.Error:
+		// the request failed before getting a response, e.g. a network error or a timeout
+		return true
+	default:
+		return false
+	}
+}
+
+func convertToExecutionInfo(record *visibilityRecord) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: record.WorkflowID,
+			RunID:      record.RunID,
+		},
+		Type: &types.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:     common.Int64Ptr(record.StartTimestamp),
+		ExecutionTime: common.Int64Ptr(record.ExecutionTimestamp),
+		CloseTime:     common.Int64Ptr(record.CloseTimestamp),
+		CloseStatus:   record.CloseStatus.Ptr(),
+		HistoryLength: record.HistoryLength,
+		Memo:          record.Memo,
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: archiver.ConvertSearchAttrToBytes(record.SearchAttributes),
+		},
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"context"
+	"time"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/azblob/connector"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	errEncodeVisibilityRecord = "failed to encode visibility record"
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		client      connector.Client
+		queryParser *queryParser
+	}
+
+	visibilityRecord archiver.ArchiveVisibilityRequest
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on azure blob storage.
+// The records are keyed by their reverse close time, so they are returned by Query in the descending order of close time.
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.AzblobArchiver,
+) (archiver.VisibilityArchiver, error) {
+	client, err := connector.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, client), nil
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	client connector.Client,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		client:      client,
+		queryParser: &queryParser{},
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.DomainTag(request.DomainName))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if isRetryableError(err) {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount)
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetriableError != nil {
+					err = featureCatalog.NonRetriableError()
+				}
+			}
+		}
+	}()
+
+	if err := softValidateURI(URI); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	encodedVisibilityRecord, err := encode(request)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityRecord
+		return err
+	}
+
+	// the same record may be archived more than once, which overwrites the same key
+	key := constructVisibilityKey(URI.Path(), request.DomainID, request.CloseTimestamp, request.RunID)
+	if err := upload(ctx, v.client, URI, key, encodedVisibilityRecord); err != nil {
+		archiveFailReason = errWriteBlob
+		return err
+	}
+	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	prefix := constructCloseTimeSearchPrefix(URI.Path(), request.DomainID, parsedQuery.earliestCloseTime, parsedQuery.latestCloseTime)
+	marker := string(request.NextPageToken)
+	response := &archiver.QueryVisibilityResponse{}
+	// the records filtered out are not counted in the page size, so a page may have fewer records,
+	// keep listing until the page has a record, there are no more keys or the max number of pages is read
+	for page := 0; page < archiver.QueryVisibilityMaxPages; page++ {
+		keys, nextMarker, err := v.client.List(ctx, URI.Hostname(), prefix, marker, request.PageSize)
+		if err != nil {
+			if isRetryableError(err) {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+
+		for _, key := range keys {
+			encodedRecord, err := download(ctx, v.client, URI, key)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			if !parsedQuery.match((*archiver.ArchiveVisibilityRequest)(record)) {
+				continue
+			}
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		}
+
+		marker = nextMarker
+		if len(response.Executions) != 0 || marker == "" {
+			break
+		}
+	}
+	if marker != "" {
+		response.NextPageToken = []byte(marker)
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	prefix := constructVisibilityDomainPrefix(URI.Path(), request.DomainID)
+	if err := deleteModifiedBefore(ctx, v.client, URI, prefix, time.Unix(0, request.ArchivedBefore)); err != nil {
+		if _, ok := err.(*types.BadRequestError); ok {
+			return err
+		}
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	err := softValidateURI(URI)
+	if err != nil {
+		return err
+	}
+	return containerExists(context.TODO(), v.client, URI)
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package azblob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+	client          *memoryClient
+	container       *archiver.VisibilityBootstrapContainer
+	testArchivalURI archiver.URI
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	var err error
+	s.Assertions = require.New(s.T())
+	s.client = newMemoryClient()
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zap.NewNop()),
+		MetricsClient: metrics.NewClient(tally.NewTestScope("test", nil), metrics.VisibilityArchiverScope),
+	}
+	s.testArchivalURI, err = archiver.NewURI(testContainerURI + "/visibility")
+	s.Require().NoError(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidURI() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	URI, err := archiver.NewURI("wrongscheme://")
+	s.NoError(err)
+	err = visibilityArchiver.Archive(context.Background(), URI, s.newTestRecord("run-1", time.Now()))
+	s.Equal(archiver.ErrURISchemeMismatch, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetriableErrorOption() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	nonRetriableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.testArchivalURI,
+		&archiver.ArchiveVisibilityRequest{DomainID: testDomainID},
+		archiver.GetNonRetriableErrorOption(nonRetriableErr),
+	)
+	s.Equal(nonRetriableErr, err)
+	s.Empty(s.client.blobs)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Success() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	closeTime := time.Date(2021, 3, 4, 5, 6, 7, 8, time.UTC)
+	request := s.newTestRecord("run-1", closeTime)
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, request))
+	// archiving the same record again overwrites it
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, request))
+
+	s.Len(s.client.blobs, 1)
+	data, ok := s.client.blobs["visibility/test-domain-id/visibility/reverseCloseTime/7608537669854775799/run-1"]
+	s.True(ok)
+	record, err := decodeVisibilityRecord(data)
+	s.NoError(err)
+	s.Equal(request, (*archiver.ArchiveVisibilityRequest)(record))
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+		Query:    "invalid query",
+	})
+	s.Nil(response)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_ContainerNotFound() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	URI, err := archiver.NewURI("azblob://missing-container")
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+		Query:    "WorkflowID = 'test-workflow-id'",
+	})
+	s.Nil(response)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_CloseTimeRange() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	base := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		s.archive(visibilityArchiver, s.newTestRecord(fmt.Sprintf("run-%v", i), base.Add(time.Duration(i)*time.Hour)))
+	}
+
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+		Query:    fmt.Sprintf("CloseTime >= %v and CloseTime <= %v", base.Add(time.Hour).UnixNano(), base.Add(3*time.Hour).UnixNano()),
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal([]string{"run-3", "run-2", "run-1"}, runIDs(response))
+
+	response, err = visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: testPageSize,
+		Query:    fmt.Sprintf("CloseTime > %v and CloseTime < %v", base.UnixNano(), base.UnixNano()),
+	})
+	s.NoError(err)
+	s.Empty(response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Pagination() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	base := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 6; i++ {
+		record := s.newTestRecord(fmt.Sprintf("run-%v", i), base.Add(time.Duration(i)*time.Minute))
+		if i%3 != 0 {
+			record.WorkflowTypeName = "other-workflow-type"
+		}
+		s.archive(visibilityArchiver, record)
+	}
+	s.archive(visibilityArchiver, &archiver.ArchiveVisibilityRequest{
+		DomainID:         "other-domain-id",
+		DomainName:       "other-domain-name",
+		WorkflowID:       "test-workflow-id",
+		RunID:            "run-other-domain",
+		WorkflowTypeName: "test-workflow-type",
+		CloseTimestamp:   base.UnixNano(),
+	})
+
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 2,
+		Query:    "WorkflowType = 'test-workflow-type'",
+	}
+	var executions []string
+	for {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		s.True(len(response.Executions) <= request.PageSize)
+		executions = append(executions, runIDs(response)...)
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-3", "run-0"}, executions)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_MaxPages() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.client)
+	base := time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= archiver.QueryVisibilityMaxPages; i++ {
+		s.archive(visibilityArchiver, s.newTestRecord(fmt.Sprintf("run-%v", i), base.Add(time.Duration(i)*time.Minute)))
+	}
+
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+		Query:    "WorkflowType = 'other-workflow-type'",
+	}
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.NotNil(response.NextPageToken)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+	s.NoError(err)
+	s.Empty(response.Executions)
+	s.Nil(response.NextPageToken)
+}
+
+func (s *visibilityArchiverSuite) TestConstructCloseTimeSearchPrefix() {
+	earliest := time.Date(2021, 3, 4, 5, 0, 0, 0, time.UTC).UnixNano()
+	latest := time.Date(2021, 3, 4, 5, 59, 0, 0, time.UTC).UnixNano()
+	s.Equal("path/test-domain-id/visibility/reverseCloseTime/760853", constructCloseTimeSearchPrefix("/path", testDomainID, earliest, latest))
+	s.Equal("test-domain-id/visibility/reverseCloseTime/", constructCloseTimeSearchPrefix("", testDomainID, 0, time.Now().UnixNano()))
+}
+
+func (s *visibilityArchiverSuite) archive(visibilityArchiver archiver.VisibilityArchiver, request *archiver.ArchiveVisibilityRequest) {
+	s.Require().NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, request))
+}
+
+func (s *visibilityArchiverSuite) newTestRecord(runID string, closeTime time.Time) *archiver.ArchiveVisibilityRequest {
+	return &archiver.ArchiveVisibilityRequest{
+		DomainID:         testDomainID,
+		DomainName:       testDomainName,
+		WorkflowID:       testWorkflowID,
+		RunID:            runID,
+		WorkflowTypeName: "test-workflow-type",
+		StartTimestamp:   closeTime.Add(-time.Hour).UnixNano(),
+		CloseTimestamp:   closeTime.UnixNano(),
+		CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    10,
+	}
+}
+
+func runIDs(response *archiver.QueryVisibilityResponse) []string {
+	var runIDs []string
+	for _, execution := range response.Executions {
+		runIDs = append(runIDs, execution.Execution.RunID)
+	}
+	return runIDs
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	// CompressionNone means the blobs are not compressed
+	CompressionNone = "none"
+	// CompressionGzip means the blobs are compressed with gzip
+	CompressionGzip = "gzip"
+	// CompressionZstd means the blobs are compressed with zstd
+	CompressionZstd = "zstd"
+
+	// EncryptionAESGCM means the blobs are encrypted with AES-GCM by a random data key,
+	// which is encrypted with AES-GCM by a key of the KeyProvider and stored in the blob header
+	EncryptionAESGCM = "aes-gcm"
+
+	codecVersion  = 1
+	dataKeyLength = 32
+)
+
+var (
+	// codecMagic prefixes the blobs encoded by a codec, it can't be the start of a JSON document
+	// so blobs without it are the plain JSON blobs archived before codecs were supported
+	codecMagic = []byte{0, 'C', 'A', 'B'}
+
+	errUnknownCompression   = errors.New("unknown compression")
+	errUnknownEncryption    = errors.New("unknown encryption")
+	errNoKeyProvider        = errors.New("blob is encrypted but no key provider is configured")
+	errCorruptedCodecHeader = errors.New("corrupted codec header")
+	errInvalidCiphertext    = errors.New("ciphertext is too short")
+
+	// zstd encoders and decoders are safe for concurrent EncodeAll and DecodeAll calls,
+	// NewWriter and NewReader only fail on invalid options
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+type (
+	// Codec encodes the archived history blobs before they are written and decodes them after they are read.
+	// Decode must be able to read the blobs written before the codec was changed.
+	Codec interface {
+		Encode(data []byte) ([]byte, error)
+		Decode(data []byte) ([]byte, error)
+	}
+
+	// KeyProvider provides the keys for the envelope encryption of the archived history blobs
+	KeyProvider interface {
+		// CurrentKey returns the ID and the key used to encrypt new blobs
+		CurrentKey() (keyID string, key []byte, err error)
+		// GetKey returns the key of the ID to decrypt existing blobs
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	codec struct {
+		compression string
+		encryption  string
+		keyProvider KeyProvider
+	}
+
+	// codecHeader is written in front of the encoded payload and records how to decode it
+	codecHeader struct {
+		Compression string `json:"compression,omitempty"`
+		Encryption  string `json:"encryption,omitempty"`
+		KeyID       string `json:"keyID,omitempty"`
+		// EncryptedDataKey is the data key of the payload encrypted by the key of KeyID
+		EncryptedDataKey []byte `json:"encryptedDataKey,omitempty"`
+	}
+
+	fileKeyProvider struct {
+		currentKeyID string
+		keys         map[string][]byte
+	}
+)
+
+// NewCodec creates a Codec based on the config, keyProvider is only used if encryption is enabled
+// and the file key provider of the config is used if it's nil. A nil config means blobs are written as is.
+func NewCodec(cfg *config.ArchiverCodec, keyProvider KeyProvider) (Codec, error) {
+	c := &codec{
+		compression: CompressionNone,
+		keyProvider: keyProvider,
+	}
+	if cfg == nil {
+		return c, nil
+	}
+
+	switch cfg.Compression {
+	case "", CompressionNone:
+	case CompressionGzip, CompressionZstd:
+		c.compression = cfg.Compression
+	default:
+		return nil, fmt.Errorf("%v: %v", errUnknownCompression, cfg.Compression)
+	}
+
+	if cfg.Encryption != nil {
+		c.encryption = EncryptionAESGCM
+		if c.keyProvider == nil {
+			var err error
+			if c.keyProvider, err = NewFileKeyProvider(cfg.Encryption); err != nil {
+				return nil, err
+			}
+		}
+		if _, _, err := c.keyProvider.CurrentKey(); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// NewDefaultCodec creates a Codec which writes blobs as is and reads both plain and compressed blobs
+func NewDefaultCodec() Codec {
+	return &codec{compression: CompressionNone}
+}
+
+func (c *codec) Encode(data []byte) ([]byte, error) {
+	if c.compression == CompressionNone && c.encryption == "" {
+		return data, nil
+	}
+
+	header := codecHeader{}
+	payload := data
+	var err error
+	if c.compression != CompressionNone {
+		header.Compression = c.compression
+		if payload, err = compress(c.compression, payload); err != nil {
+			return nil, err
+		}
+	}
+	if c.encryption != "" {
+		keyID, key, err := c.keyProvider.CurrentKey()
+		if err != nil {
+			return nil, err
+		}
+		dataKey := make([]byte, dataKeyLength)
+		if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+			return nil, err
+		}
+		if header.EncryptedDataKey, err = encryptAESGCM(key, dataKey); err != nil {
+			return nil, err
+		}
+		if payload, err = encryptAESGCM(dataKey, payload); err != nil {
+			return nil, err
+		}
+		header.Encryption = c.encryption
+		header.KeyID = keyID
+	}
+
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, len(codecMagic)+5+len(encodedHeader)+len(payload)))
+	buf.Write(codecMagic)
+	buf.WriteByte(codecVersion)
+	headerLength := make([]byte, 4)
+	binary.BigEndian.PutUint32(headerLength, uint32(len(encodedHeader)))
+	buf.Write(headerLength)
+	buf.Write(encodedHeader)
+	buf.Write(payload)
+	return buf.Bytes(), nil
+}
+
+func (c *codec) Decode(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, codecMagic) {
+		// archived before codecs were supported
+		return data, nil
+	}
+
+	header, payload, err := parseCodecHeader(data)
+	if err != nil {
+		return nil, err
+	}
+	switch header.Encryption {
+	case "":
+	case EncryptionAESGCM:
+		if c.keyProvider == nil {
+			return nil, errNoKeyProvider
+		}
+		key, err := c.keyProvider.GetKey(header.KeyID)
+		if err != nil {
+			return nil, err
+		}
+		dataKey, err := decryptAESGCM(key, header.EncryptedDataKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt data key with key %v: %v", header.KeyID, err)
+		}
+		if payload, err = decryptAESGCM(dataKey, payload); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("%v: %v", errUnknownEncryption, header.Encryption)
+	}
+
+	if header.Compression == "" || header.Compression == CompressionNone {
+		return payload, nil
+	}
+	return decompress(header.Compression, payload)
+}
+
+func parseCodecHeader(data []byte) (*codecHeader, []byte, error) {
+	data = data[len(codecMagic):]
+	if len(data) < 5 || data[0] != codecVersion {
+		return nil, nil, errCorruptedCodecHeader
+	}
+	headerLength := binary.BigEndian.Uint32(data[1:5])
+	data = data[5:]
+	if uint64(headerLength) > uint64(len(data)) {
+		return nil, nil, errCorruptedCodecHeader
+	}
+	header := &codecHeader{}
+	if err := json.Unmarshal(data[:headerLength], header); err != nil {
+		return nil, nil, errCorruptedCodecHeader
+	}
+	return header, data[headerLength:], nil
+}
+
+func compress(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		return zstdEncoder.EncodeAll(data, nil), nil
+	default:
+		return nil, fmt.Errorf("%v: %v", errUnknownCompression, compression)
+	}
+}
+
+func decompress(compression string, data []byte) ([]byte, error) {
+	switch compression {
+	case CompressionGzip:
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return ioutil.ReadAll(reader)
+	case CompressionZstd:
+		return zstdDecoder.DecodeAll(data, nil)
+	default:
+		return nil, fmt.Errorf("%v: %v", errUnknownCompression, compression)
+	}
+}
+
+// encryptAESGCM encrypts the plaintext and prefixes the random nonce to the ciphertext
+func encryptAESGCM(key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func decryptAESGCM(key []byte, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errInvalidCiphertext
+	}
+	nonce := ciphertext[:gcm.NonceSize()]
+	return gcm.Open(nil, nonce, ciphertext[gcm.NonceSize():], nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// NewFileKeyProvider creates a KeyProvider which loads the base64 encoded AES keys from the files of the config
+func NewFileKeyProvider(cfg *config.ArchiverEncryption) (KeyProvider, error) {
+	if _, ok := cfg.KeyFiles[cfg.KeyID]; !ok {
+		return nil, fmt.Errorf("no key file for the encryption key %v", cfg.KeyID)
+	}
+	provider := &fileKeyProvider{
+		currentKeyID: cfg.KeyID,
+		keys:         make(map[string][]byte, len(cfg.KeyFiles)),
+	}
+	for keyID, path := range cfg.KeyFiles {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the key file of encryption key %v: %v", keyID, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 encoding of encryption key %v: %v", keyID, err)
+		}
+		if _, err := aes.NewCipher(key); err != nil {
+			return nil, fmt.Errorf("invalid encryption key %v: %v", keyID, err)
+		}
+		provider.keys[keyID] = key
+	}
+	return provider, nil
+}
+
+func (p *fileKeyProvider) CurrentKey() (string, []byte, error) {
+	key, err := p.GetKey(p.currentKeyID)
+	return p.currentKeyID, key, err
+}
+
+func (p *fileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %v", keyID)
+	}
+	return key, nil
+}
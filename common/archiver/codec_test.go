@@ -0,0 +1,255 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	codecSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testKeyProvider struct {
+		currentKeyID string
+		keys         map[string][]byte
+	}
+)
+
+var testBlob = []byte(`[{"events":[{"eventId":1,"timestamp":1,"eventType":"WorkflowExecutionStarted","version":1}]}]`)
+
+func TestCodecSuite(t *testing.T) {
+	suite.Run(t, new(codecSuite))
+}
+
+func (s *codecSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *codecSuite) TestNewCodec_InvalidConfig() {
+	_, err := NewCodec(&config.ArchiverCodec{Compression: "lzma"}, nil)
+	s.Error(err)
+
+	_, err = NewCodec(&config.ArchiverCodec{
+		Encryption: &config.ArchiverEncryption{
+			KeyID:    "k1",
+			KeyFiles: map[string]string{"k2": "/path/to/k2"},
+		},
+	}, nil)
+	s.Error(err)
+
+	_, err = NewCodec(&config.ArchiverCodec{Encryption: &config.ArchiverEncryption{}}, newTestKeyProvider("k1"))
+	s.NoError(err)
+}
+
+func (s *codecSuite) TestEncodeDecode() {
+	for _, compression := range []string{"", CompressionNone, CompressionGzip, CompressionZstd} {
+		for _, encrypted := range []bool{false, true} {
+			cfg := &config.ArchiverCodec{Compression: compression}
+			if encrypted {
+				cfg.Encryption = &config.ArchiverEncryption{}
+			}
+			codec, err := NewCodec(cfg, newTestKeyProvider("k1"))
+			s.NoError(err)
+
+			encoded, err := codec.Encode(testBlob)
+			s.NoError(err, "compression: %v, encrypted: %v", compression, encrypted)
+			if (compression == "" || compression == CompressionNone) && !encrypted {
+				s.Equal(testBlob, encoded)
+			} else {
+				s.NotContains(string(encoded), "WorkflowExecutionStarted")
+			}
+
+			decoded, err := codec.Decode(encoded)
+			s.NoError(err, "compression: %v, encrypted: %v", compression, encrypted)
+			s.Equal(testBlob, decoded)
+		}
+	}
+}
+
+func (s *codecSuite) TestEncodeDecode_NilConfig() {
+	codec, err := NewCodec(nil, nil)
+	s.NoError(err)
+	encoded, err := codec.Encode(testBlob)
+	s.NoError(err)
+	s.Equal(testBlob, encoded)
+}
+
+func (s *codecSuite) TestDecode_PlainBlob() {
+	codec, err := NewCodec(&config.ArchiverCodec{
+		Compression: CompressionZstd,
+		Encryption:  &config.ArchiverEncryption{},
+	}, newTestKeyProvider("k1"))
+	s.NoError(err)
+
+	decoded, err := codec.Decode(testBlob)
+	s.NoError(err)
+	s.Equal(testBlob, decoded)
+}
+
+func (s *codecSuite) TestDecode_DefaultCodec() {
+	codec, err := NewCodec(&config.ArchiverCodec{Compression: CompressionGzip}, nil)
+	s.NoError(err)
+	encoded, err := codec.Encode(testBlob)
+	s.NoError(err)
+	decoded, err := NewDefaultCodec().Decode(encoded)
+	s.NoError(err)
+	s.Equal(testBlob, decoded)
+
+	codec, err = NewCodec(&config.ArchiverCodec{Encryption: &config.ArchiverEncryption{}}, newTestKeyProvider("k1"))
+	s.NoError(err)
+	encoded, err = codec.Encode(testBlob)
+	s.NoError(err)
+	_, err = NewDefaultCodec().Decode(encoded)
+	s.Equal(errNoKeyProvider, err)
+}
+
+func (s *codecSuite) TestDecode_KeyRotation() {
+	keyProvider := newTestKeyProvider("k1")
+	cfg := &config.ArchiverCodec{Encryption: &config.ArchiverEncryption{}}
+	codec, err := NewCodec(cfg, keyProvider)
+	s.NoError(err)
+	encodedByK1, err := codec.Encode(testBlob)
+	s.NoError(err)
+
+	keyProvider.currentKeyID = "k2"
+	encodedByK2, err := codec.Encode(testBlob)
+	s.NoError(err)
+	for _, encoded := range [][]byte{encodedByK1, encodedByK2} {
+		decoded, err := codec.Decode(encoded)
+		s.NoError(err)
+		s.Equal(testBlob, decoded)
+	}
+
+	delete(keyProvider.keys, "k1")
+	_, err = codec.Decode(encodedByK1)
+	s.Error(err)
+
+	// a different key with the same ID can't decrypt the data key
+	keyProvider.keys["k1"] = keyProvider.keys["k2"]
+	_, err = codec.Decode(encodedByK1)
+	s.Error(err)
+}
+
+func (s *codecSuite) TestDecode_Corrupted() {
+	codec, err := NewCodec(&config.ArchiverCodec{
+		Compression: CompressionGzip,
+		Encryption:  &config.ArchiverEncryption{},
+	}, newTestKeyProvider("k1"))
+	s.NoError(err)
+	encoded, err := codec.Encode(testBlob)
+	s.NoError(err)
+
+	tampered := append([]byte{}, encoded...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = codec.Decode(tampered)
+	s.Error(err)
+
+	_, err = codec.Decode(encoded[:len(codecMagic)+3])
+	s.Equal(errCorruptedCodecHeader, err)
+
+	truncatedHeader := append([]byte{}, encoded[:len(codecMagic)+5]...)
+	_, err = codec.Decode(truncatedHeader)
+	s.Equal(errCorruptedCodecHeader, err)
+}
+
+func (s *codecSuite) TestFileKeyProvider() {
+	dir, err := ioutil.TempDir("", "TestFileKeyProvider")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	keyFiles := map[string]string{}
+	for keyID, length := range map[string]int{"k1": 16, "k2": 32, "invalid": 20} {
+		key := make([]byte, length)
+		for i := range key {
+			key[i] = byte(i)
+		}
+		keyFiles[keyID] = filepath.Join(dir, keyID)
+		s.NoError(ioutil.WriteFile(keyFiles[keyID], []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+	}
+
+	_, err = NewFileKeyProvider(&config.ArchiverEncryption{KeyID: "k2", KeyFiles: keyFiles})
+	s.Error(err)
+
+	delete(keyFiles, "invalid")
+	keyProvider, err := NewFileKeyProvider(&config.ArchiverEncryption{KeyID: "k2", KeyFiles: keyFiles})
+	s.NoError(err)
+	keyID, key, err := keyProvider.CurrentKey()
+	s.NoError(err)
+	s.Equal("k2", keyID)
+	s.Len(key, 32)
+	key, err = keyProvider.GetKey("k1")
+	s.NoError(err)
+	s.Len(key, 16)
+	_, err = keyProvider.GetKey("k3")
+	s.Error(err)
+
+	codec, err := NewCodec(&config.ArchiverCodec{
+		Compression: CompressionZstd,
+		Encryption:  &config.ArchiverEncryption{KeyID: "k1", KeyFiles: keyFiles},
+	}, nil)
+	s.NoError(err)
+	encoded, err := codec.Encode(testBlob)
+	s.NoError(err)
+	decoded, err := codec.Decode(encoded)
+	s.NoError(err)
+	s.Equal(testBlob, decoded)
+}
+
+func newTestKeyProvider(currentKeyID string) *testKeyProvider {
+	keys := make(map[string][]byte)
+	for i, keyID := range []string{"k1", "k2"} {
+		key := make([]byte, 32)
+		for j := range key {
+			key[j] = byte(i + j)
+		}
+		keys[keyID] = key
+	}
+	return &testKeyProvider{
+		currentKeyID: currentKeyID,
+		keys:         keys,
+	}
+}
+
+func (p *testKeyProvider) CurrentKey() (string, []byte, error) {
+	key, err := p.GetKey(p.currentKeyID)
+	return p.currentKeyID, key, err
+}
+
+func (p *testKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %v", keyID)
+	}
+	return key, nil
+}
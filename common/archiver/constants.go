@@ -42,6 +42,10 @@ const (
 	ErrReasonReadHistory = "failed to read history batches"
 	// ErrReasonHistoryMutated is the error reason for mutated history
 	ErrReasonHistoryMutated = "history was mutated"
+
+	// QueryVisibilityMaxPages is the max number of pages a visibility query reads from the storage
+	// before returning a page, which may be empty, with a token to continue the query
+	QueryVisibilityMaxPages = 10
 )
 
 var (
@@ -57,6 +61,8 @@ var (
 	ErrInvalidGetHistoryRequest = errors.New("get archived history request is invalid")
 	// ErrInvalidQueryVisibilityRequest is the error for invalid Query Visibility request
 	ErrInvalidQueryVisibilityRequest = errors.New("query visiblity request is invalid")
+	// ErrInvalidDeleteRequest is the error for invalid Delete History or Delete Visibility request
+	ErrInvalidDeleteRequest = errors.New("delete archived data request is invalid")
 	// ErrNextPageTokenCorrupted is the error for corrupted GetHistory token
 	ErrNextPageTokenCorrupted = errors.New("next page token is corrupted")
 	// ErrHistoryNotExist is the error for non-exist history
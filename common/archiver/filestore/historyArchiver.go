@@ -22,7 +22,8 @@
 
 // Each Archive() request results in a file named in the format of
 // hash(domainID, workflowID, runID)_version.history being created in the specified
-// directory. Workflow histories stored in that file are encoded in JSON format.
+// directory. Workflow histories stored in that file are encoded in JSON format, which
+// is then compressed and encrypted by the archiver.Codec if one is configured.
 
 // The Get() method retrieves the archived histories from the directory specified in the
 // URI. It optionally takes in a NextPageToken which specifies the workflow close failover
@@ -32,6 +33,11 @@
 // of NextPageToken or close failover version is specified, the highest close failover version
 // will be picked.
 
+// If indexing is enabled, Archive() also writes an entry file for the archived workflow run under
+// index/hash(domainID)/field/hash(value) in the directory for each of the signal names, activity types
+// and failure reasons found in the history. The Search() method walks the entries of the first term
+// in the query and probes the other terms.
+
 package filestore
 
 import (
@@ -39,7 +45,9 @@ import (
 	"errors"
 	"os"
 	"path"
+	"sort"
 	"strconv"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -58,6 +66,7 @@ const (
 	errEncodeHistory = "failed to encode history batches"
 	errMakeDirectory = "failed to make directory"
 	errWriteFile     = "failed to write history to file"
+	errWriteIndex    = "failed to write history index"
 
 	targetHistoryBlobSize = 2 * 1024 * 1024 // 2MB
 )
@@ -72,6 +81,7 @@ type (
 		container *archiver.HistoryBootstrapContainer
 		fileMode  os.FileMode
 		dirMode   os.FileMode
+		codec     archiver.Codec
 
 		// only set in test code
 		historyIterator archiver.HistoryIterator
@@ -104,10 +114,15 @@ func newHistoryArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	codec := container.Codec
+	if codec == nil {
+		codec = archiver.NewDefaultCodec()
+	}
 	return &historyArchiver{
 		container:       container,
 		fileMode:        os.FileMode(fileMode),
 		dirMode:         os.FileMode(dirMode),
+		codec:           codec,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -173,6 +188,9 @@ func (h *historyArchiver) Archive(
 	}
 
 	encodedHistoryBatches, err := encode(historyBatches)
+	if err == nil {
+		encodedHistoryBatches, err = h.codec.Encode(encodedHistoryBatches)
+	}
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 		return err
@@ -190,6 +208,38 @@ func (h *historyArchiver) Archive(
 		return err
 	}
 
+	if h.container.EnableIndex {
+		if err := h.writeHistoryIndex(dirPath, request, historyBatches); err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errWriteIndex), tag.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *historyArchiver) writeHistoryIndex(
+	dirPath string,
+	request *archiver.ArchiveHistoryRequest,
+	historyBatches []*types.History,
+) error {
+	entry := &archiver.HistoryIndexEntry{
+		WorkflowID: request.WorkflowID,
+		RunID:      request.RunID,
+	}
+	data, err := encode(entry)
+	if err != nil {
+		return err
+	}
+	for _, term := range archiver.ExtractHistoryIndexTerms(historyBatches) {
+		termDir := constructHistoryIndexTermDir(dirPath, request.DomainID, term)
+		if err := util.MkdirAll(termDir, h.dirMode); err != nil {
+			return err
+		}
+		if err := util.WriteFile(path.Join(termDir, entry.Name()), data, h.fileMode); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -251,6 +301,10 @@ func (h *historyArchiver) Get(
 	if err != nil {
 		return nil, &types.InternalServiceError{Message: err.Error()}
 	}
+	encodedHistoryBatches, err = h.codec.Decode(encodedHistoryBatches)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
 
 	historyBatches, err := decodeHistoryBatches(encodedHistoryBatches)
 	if err != nil {
@@ -282,6 +336,115 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	dirPath := URI.Path()
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	// histories of all domains are in the same directory, the filenames start with the hash of the domainID
+	if err := util.DeleteFilesModifiedBefore(dirPath, hash(request.DomainID), time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if err := deleteHistoryIndexModifiedBefore(dirPath, request.DomainID, time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
+func (h *historyArchiver) Search(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.SearchHistoryRequest,
+) (*archiver.SearchHistoryResponse, error) {
+	if err := h.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateSearchHistoryRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	terms, err := archiver.ParseHistorySearchQuery(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	dirPath := URI.Path()
+	reader := &historyIndexReader{dirPath: dirPath, domainID: request.DomainID}
+	names, nextPageToken, err := archiver.SearchHistoryIndex(reader, terms, request.PageSize, request.NextPageToken)
+	if err != nil {
+		if err == archiver.ErrNextPageTokenCorrupted {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	// all the entries of a run are the same, they are read from the directory of the first term
+	termDir := constructHistoryIndexTermDir(dirPath, request.DomainID, terms[0])
+	response := &archiver.SearchHistoryResponse{NextPageToken: nextPageToken}
+	for _, name := range names {
+		data, err := util.ReadFile(path.Join(termDir, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				// the entry was deleted after being listed
+				continue
+			}
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		entry, err := decodeHistoryIndexEntry(data)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.Executions = append(response.Executions, entry)
+	}
+	return response, nil
+}
+
+// historyIndexReader reads the history index of a domain under the directory of the URI
+type historyIndexReader struct {
+	dirPath  string
+	domainID string
+}
+
+func (r *historyIndexReader) ListEntries(term archiver.HistoryIndexTerm, after string, limit int) ([]string, bool, error) {
+	termDir := constructHistoryIndexTermDir(r.dirPath, r.domainID, term)
+	exists, err := util.DirectoryExists(termDir)
+	if err != nil || !exists {
+		return nil, false, err
+	}
+	names, err := util.ListFiles(termDir)
+	if err != nil {
+		return nil, false, err
+	}
+	sort.Strings(names)
+	names = names[sort.Search(len(names), func(i int) bool { return names[i] > after }):]
+	if len(names) > limit {
+		return names[:limit], true, nil
+	}
+	return names, false, nil
+}
+
+func (r *historyIndexReader) EntryExists(term archiver.HistoryIndexTerm, name string) (bool, error) {
+	return util.FileExists(path.Join(constructHistoryIndexTermDir(r.dirPath, r.domainID, term), name))
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -22,6 +22,7 @@ package filestore
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"io/ioutil"
 	"os"
@@ -512,6 +513,35 @@ func (s *historyArchiverSuite) TestGet_Success_SmallPageSize() {
 	s.Equal(s.historyBatchesV100, combinedHistory)
 }
 
+func (s *historyArchiverSuite) TestDelete() {
+	dir, err := ioutil.TempDir("", "TestDelete")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	oldFilename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, 1)
+	newFilename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	otherDomainFilename := constructHistoryFilename("other-domain-id", testWorkflowID, testRunID, 1)
+	oldTime := time.Now().Add(-time.Hour)
+	for _, filename := range []string{oldFilename, newFilename, otherDomainFilename} {
+		s.NoError(util.WriteFile(path.Join(dir, filename), []byte("data"), testFileMode))
+	}
+	s.NoError(os.Chtimes(path.Join(dir, oldFilename), oldTime, oldTime))
+	s.NoError(os.Chtimes(path.Join(dir, otherDomainFilename), oldTime, oldTime))
+
+	historyArchiver := s.newTestHistoryArchiver(nil)
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now().Add(-time.Minute).UnixNano(),
+	})
+	s.NoError(err)
+
+	filenames, err := util.ListFiles(dir)
+	s.NoError(err)
+	s.ElementsMatch([]string{newFilename, otherDomainFilename}, filenames)
+}
+
 func (s *historyArchiverSuite) TestArchiveAndGet() {
 	mockCtrl := gomock.NewController(s.T())
 	defer mockCtrl.Finish()
@@ -563,6 +593,172 @@ func (s *historyArchiverSuite) TestArchiveAndGet() {
 	s.Equal(s.historyBatchesV100, response.HistoryBatches)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndGet_WithCodec() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: s.historyBatchesV100,
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndGet_WithCodec")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	keyFile := path.Join(dir, "key")
+	s.NoError(ioutil.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))), testFileMode))
+	s.container.Codec, err = archiver.NewCodec(&config.ArchiverCodec{
+		Compression: archiver.CompressionGzip,
+		Encryption: &config.ArchiverEncryption{
+			KeyID:    "k1",
+			KeyFiles: map[string]string{"k1": keyFile},
+		},
+	}, nil)
+	s.NoError(err)
+
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	expectedFilename := constructHistoryFilename(testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion)
+	data, err := util.ReadFile(path.Join(dir, expectedFilename))
+	s.NoError(err)
+	_, err = decodeHistoryBatches(data)
+	s.Error(err)
+
+	getRequest := &archiver.GetHistoryRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		PageSize:   testPageSize,
+	}
+	response, err := historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Equal(s.historyBatchesV100, response.HistoryBatches)
+
+	// history archived as plain JSON is still readable
+	URI, err = archiver.NewURI("file://" + s.testGetDirectory)
+	s.NoError(err)
+	response, err = historyArchiver.Get(context.Background(), URI, getRequest)
+	s.NoError(err)
+	s.NotNil(response)
+}
+
+func (s *historyArchiverSuite) TestArchiveAndSearch() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: []*types.History{
+			{
+				Events: []*types.HistoryEvent{
+					{
+						ID:        common.FirstEventID,
+						EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+						Version:   testCloseFailoverVersion,
+						WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+							SignalName: "cancel",
+						},
+					},
+					{
+						ID:        common.FirstEventID + 1,
+						EventType: types.EventTypeWorkflowExecutionFailed.Ptr(),
+						Version:   testCloseFailoverVersion,
+						WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+							Reason: common.StringPtr("card declined"),
+						},
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	dir, err := ioutil.TempDir("", "TestArchiveAndSearch")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	s.container.EnableIndex = true
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	archiveRequest := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          common.FirstEventID + 2,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, archiveRequest)
+	s.NoError(err)
+
+	searchRequest := &archiver.SearchHistoryRequest{
+		DomainID: testDomainID,
+		Query:    "SignalName = 'cancel' and FailureReason = 'card declined'",
+		PageSize: testPageSize,
+	}
+	response, err := historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal([]*archiver.HistoryIndexEntry{{WorkflowID: testWorkflowID, RunID: testRunID}}, response.Executions)
+
+	searchRequest.Query = "SignalName = 'cancel' and ActivityType = 'chargeCard'"
+	response, err = historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.NoError(err)
+	s.Empty(response.Executions)
+
+	searchRequest.Query = "SignalName = 'cancel'"
+	searchRequest.DomainID = "other-domain-id"
+	response, err = historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.NoError(err)
+	s.Empty(response.Executions)
+
+	searchRequest.Query = "WorkflowID = 'some random workflowID'"
+	_, err = historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.IsType(&types.BadRequestError{}, err)
+
+	err = historyArchiver.Delete(context.Background(), URI, &archiver.DeleteHistoryRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: time.Now().Add(time.Minute).UnixNano(),
+	})
+	s.NoError(err)
+	searchRequest.Query = "SignalName = 'cancel'"
+	searchRequest.DomainID = testDomainID
+	response, err = historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.NoError(err)
+	s.Empty(response.Executions)
+}
+
 func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.HistoryIterator) *historyArchiver {
 	config := &config.FilestoreArchiver{
 		FileMode: testFileModeStr,
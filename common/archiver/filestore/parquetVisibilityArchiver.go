@@ -0,0 +1,115 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package filestore
+
+import (
+	"context"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/parquet"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/util"
+)
+
+type (
+	parquetStore struct {
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+)
+
+// NewParquetVisibilityArchiver creates a new archiver.VisibilityArchiver which writes
+// the visibility records as parquet files to the filestore
+func NewParquetVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.FilestoreArchiver,
+	parquetConfig *config.ParquetVisibilityArchiver,
+) (archiver.VisibilityArchiver, error) {
+	fileMode, err := strconv.ParseUint(config.FileMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidFileMode
+	}
+	dirMode, err := strconv.ParseUint(config.DirMode, 0, 32)
+	if err != nil {
+		return nil, errInvalidDirMode
+	}
+	store := &parquetStore{
+		fileMode: os.FileMode(fileMode),
+		dirMode:  os.FileMode(dirMode),
+	}
+	return parquet.NewVisibilityArchiver(container, store, parquetConfig)
+}
+
+func (s *parquetStore) Put(_ context.Context, URI archiver.URI, key string, data []byte) error {
+	filePath := path.Join(URI.Path(), key)
+	if err := util.MkdirAll(path.Dir(filePath), s.dirMode); err != nil {
+		return err
+	}
+	return util.WriteFile(filePath, data, s.fileMode)
+}
+
+func (s *parquetStore) Get(_ context.Context, URI archiver.URI, key string) ([]byte, error) {
+	return util.ReadFile(path.Join(URI.Path(), key))
+}
+
+func (s *parquetStore) List(_ context.Context, URI archiver.URI, prefix string) ([]string, error) {
+	dirPath := path.Join(URI.Path(), prefix)
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	var keys []string
+	err = filepath.Walk(dirPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		key, err := filepath.Rel(URI.Path(), filePath)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, filepath.ToSlash(key))
+		return nil
+	})
+	return keys, err
+}
+
+func (s *parquetStore) Delete(_ context.Context, URI archiver.URI, key string) error {
+	if err := os.Remove(path.Join(URI.Path(), key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (s *parquetStore) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != URIScheme {
+		return archiver.ErrURISchemeMismatch
+	}
+
+	return validateDirPath(URI.Path())
+}
@@ -108,8 +108,23 @@ func (s *queryParserSuite) TestParseWorkflowID_RunID_WorkflowType() {
 			expectErr: true,
 		},
 		{
-			query:     "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
-			expectErr: true,
+			query:       "WorkflowID = \"random workflowID\" or WorkflowID = \"another workflowID\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:     "WorkflowType = 'random typeName' and (WorkflowID = \"random workflowID\" or RunID = 'random runID')",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowTypeName: common.StringPtr("random typeName"),
+			},
+		},
+		{
+			query:     "WorkflowTypeName = 'random typeName' and WorkflowID in ('random workflowID', 'another workflowID')",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowTypeName: common.StringPtr("random typeName"),
+			},
 		},
 		{
 			query:     "WorkflowID = \"random workflowID\" or runID = \"random runID\"",
@@ -187,7 +202,12 @@ func (s *queryParserSuite) TestParseCloseStatus() {
 			expectErr: true,
 		},
 		{
-			query:     "CloseStatus = \"Failed\" or CloseStatus = \"Failed\"",
+			query:       "CloseStatus = \"Failed\" or CloseStatus = \"Failed\"",
+			expectErr:   false,
+			parsedQuery: &parsedQuery{},
+		},
+		{
+			query:     "CloseStatus like \"Failed\"",
 			expectErr: true,
 		},
 		{
@@ -335,6 +355,8 @@ func (s *queryParserSuite) TestParse() {
 		s.NoError(err)
 		s.Equal(tc.parsedQuery.emptyResult, parsedQuery.emptyResult)
 		if !tc.parsedQuery.emptyResult {
+			s.NotNil(parsedQuery.filter)
+			parsedQuery.filter = nil
 			s.Equal(tc.parsedQuery, parsedQuery)
 		}
 	}
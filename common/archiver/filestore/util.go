@@ -26,15 +26,22 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dgryski/go-farm"
 
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/util"
 )
 
+const (
+	historyIndexDirName = "index"
+)
+
 var (
 	errEmptyDirectoryPath = errors.New("directory path is empty")
 )
@@ -63,6 +70,15 @@ func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
 	return record, nil
 }
 
+func decodeHistoryIndexEntry(data []byte) (*archiver.HistoryIndexEntry, error) {
+	entry := &archiver.HistoryIndexEntry{}
+	err := json.Unmarshal(data, entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
 func serializeToken(token interface{}) ([]byte, error) {
 	if token == nil {
 		return nil, nil
@@ -97,6 +113,14 @@ func constructVisibilityFilename(closeTimestamp int64, runID string) string {
 	return fmt.Sprintf("%v_%s.visibility", closeTimestamp, hash(runID))
 }
 
+func constructHistoryIndexDomainDir(dirPath, domainID string) string {
+	return path.Join(dirPath, historyIndexDirName, hash(domainID))
+}
+
+func constructHistoryIndexTermDir(dirPath, domainID string, term archiver.HistoryIndexTerm) string {
+	return path.Join(constructHistoryIndexDomainDir(dirPath, domainID), term.Path())
+}
+
 func hash(s string) string {
 	return fmt.Sprintf("%v", farm.Fingerprint64([]byte(s)))
 }
@@ -122,6 +146,30 @@ func validateDirPath(dirPath string) error {
 
 // Misc.
 
+func deleteHistoryIndexModifiedBefore(dirPath, domainID string, before time.Time) error {
+	domainDir := constructHistoryIndexDomainDir(dirPath, domainID)
+	for _, field := range archiver.HistoryIndexFields {
+		fieldDir := path.Join(domainDir, field)
+		exists, err := util.DirectoryExists(fieldDir)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		termDirs, err := util.ListFiles(fieldDir)
+		if err != nil {
+			return err
+		}
+		for _, termDir := range termDirs {
+			if err := util.DeleteFilesModifiedBefore(path.Join(fieldDir, termDir), "", before); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func extractCloseFailoverVersion(filename string) (int64, error) {
 	filenameParts := strings.FieldsFunc(filename, func(r rune) bool {
 		return r == '_' || r == '.'
@@ -28,6 +28,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -237,6 +238,34 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	dirPath := path.Join(URI.Path(), request.DomainID)
+	exists, err := util.DirectoryExists(dirPath)
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	if !exists {
+		return nil
+	}
+
+	if err := util.DeleteFilesModifiedBefore(dirPath, "", time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -319,6 +348,9 @@ func matchQuery(record *visibilityRecord, query *parsedQuery) bool {
 	if query.closeStatus != nil && record.CloseStatus != *query.closeStatus {
 		return false
 	}
+	if query.filter != nil && !query.filter.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+		return false
+	}
 	return true
 }
 
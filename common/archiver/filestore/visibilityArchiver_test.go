@@ -276,6 +276,25 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 			},
 			shouldMatch: true,
 		},
+		{
+			query: s.parseQuery("WorkflowType like 'some random%' and (CloseStatus = 'Failed' or CustomKeywordField in ('keyword', 'another keyword'))"),
+			record: &visibilityRecord{
+				CloseTimestamp:   int64(12345),
+				CloseStatus:      types.WorkflowExecutionCloseStatusContinuedAsNew,
+				WorkflowTypeName: "some random type name",
+				SearchAttributes: map[string]string{"CustomKeywordField": "\"keyword\""},
+			},
+			shouldMatch: true,
+		},
+		{
+			query: s.parseQuery("WorkflowType like 'some random%' and (CloseStatus = 'Failed' or CustomKeywordField in ('keyword', 'another keyword'))"),
+			record: &visibilityRecord{
+				CloseTimestamp:   int64(12345),
+				CloseStatus:      types.WorkflowExecutionCloseStatusContinuedAsNew,
+				WorkflowTypeName: "some random type name",
+			},
+			shouldMatch: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -283,6 +302,12 @@ func (s *visibilityArchiverSuite) TestMatchQuery() {
 	}
 }
 
+func (s *visibilityArchiverSuite) parseQuery(query string) *parsedQuery {
+	parsedQuery, err := NewQueryParser().Parse(query)
+	s.NoError(err)
+	return parsedQuery
+}
+
 func (s *visibilityArchiverSuite) TestSortAndFilterFiles() {
 	testCases := []struct {
 		filenames      []string
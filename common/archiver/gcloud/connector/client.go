@@ -27,6 +27,7 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"google.golang.org/api/iterator"
@@ -53,6 +54,7 @@ type (
 		Query(ctx context.Context, URI archiver.URI, fileNamePrefix string) ([]string, error)
 		QueryWithFilters(ctx context.Context, URI archiver.URI, fileNamePrefix string, pageSize, offset int, filters []Precondition) ([]string, bool, int, error)
 		Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error)
+		DeleteModifiedBefore(ctx context.Context, URI archiver.URI, fileNamePrefix string, before time.Time) error
 	}
 
 	storageWrapper struct {
@@ -192,6 +194,30 @@ func (s *storageWrapper) QueryWithFilters(ctx context.Context, URI archiver.URI,
 
 }
 
+// DeleteModifiedBefore deletes the files with the prefix that were last updated before the given time
+func (s *storageWrapper) DeleteModifiedBefore(ctx context.Context, URI archiver.URI, fileNamePrefix string, before time.Time) error {
+	bucket := s.client.Bucket(URI.Hostname())
+	it := bucket.Objects(ctx, &storage.Query{
+		Prefix: formatSinkPath(URI.Path()) + "/" + fileNamePrefix,
+	})
+
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !attrs.Updated.Before(before) {
+			continue
+		}
+		if err := bucket.Object(attrs.Name).Delete(ctx); err != nil && err != storage.ErrObjectNotExist {
+			return err
+		}
+	}
+}
+
 func isPageCompleted(pageSize, currentPosition int) bool {
 	return pageSize != 0 && currentPosition > 0 && pageSize <= currentPosition
 }
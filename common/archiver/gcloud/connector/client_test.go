@@ -29,6 +29,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"cloud.google.com/go/storage"
 	"github.com/stretchr/testify/mock"
@@ -333,3 +334,45 @@ func newWorkflowIDPrecondition(workflowID string) connector.Precondition {
 		return false
 	}
 }
+
+func (s *clientSuite) TestDeleteModifiedBefore() {
+
+	ctx := context.Background()
+	mockBucketHandleClient := &mocks.BucketHandleWrapper{}
+	mockStorageClient := &mocks.GcloudStorageClient{}
+	mockObjectIterator := &mocks.ObjectIteratorWrapper{}
+	mockObjectHandler := &mocks.ObjectHandleWrapper{}
+	storageWrapper, _ := connector.NewClientWithParams(mockStorageClient)
+
+	now := time.Now()
+	attrOld := &storage.ObjectAttrs{Name: "cadence_archival/development/fileName_01", Updated: now.Add(-time.Hour)}
+	attrNew := &storage.ObjectAttrs{Name: "cadence_archival/development/fileName_02", Updated: now}
+
+	mockStorageClient.On("Bucket", "my-bucket-cad").Return(mockBucketHandleClient).Times(1)
+	mockBucketHandleClient.On("Objects", ctx, mock.Anything).Return(mockObjectIterator).Times(1)
+	mockBucketHandleClient.On("Object", attrOld.Name).Return(mockObjectHandler).Times(1)
+	mockObjectHandler.On("Delete", ctx).Return(nil).Times(1)
+	mockIterator := 0
+	mockObjectIterator.On("Next").Return(func() *storage.ObjectAttrs {
+		mockIterator++
+		switch mockIterator {
+		case 1:
+			return attrOld
+		case 2:
+			return attrNew
+		default:
+			return nil
+		}
+	}, func() error {
+		if mockIterator <= 2 {
+			return nil
+		}
+		return iterator.Done
+	}).Times(3)
+
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	s.Require().NoError(err)
+	err = storageWrapper.DeleteModifiedBefore(ctx, URI, "fileName_", now.Add(-time.Minute))
+	s.Require().NoError(err)
+	mockObjectHandler.AssertExpectations(s.T())
+}
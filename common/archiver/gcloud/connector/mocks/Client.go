@@ -24,6 +24,7 @@ package mocks
 
 import (
 	context "context"
+	time "time"
 
 	archiver "github.com/uber/cadence/common/archiver"
 	connector "github.com/uber/cadence/common/archiver/gcloud/connector"
@@ -36,6 +37,20 @@ type Client struct {
 	mock.Mock
 }
 
+// DeleteModifiedBefore provides a mock function with given fields: ctx, URI, fileNamePrefix, before
+func (_m *Client) DeleteModifiedBefore(ctx context.Context, URI archiver.URI, fileNamePrefix string, before time.Time) error {
+	ret := _m.Called(ctx, URI, fileNamePrefix, before)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, archiver.URI, string, time.Time) error); ok {
+		r0 = rf(ctx, URI, fileNamePrefix, before)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Exist provides a mock function with given fields: ctx, URI, fileName
 func (_m *Client) Exist(ctx context.Context, URI archiver.URI, fileName string) (bool, error) {
 	ret := _m.Called(ctx, URI, fileName)
@@ -25,6 +25,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"path/filepath"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -54,6 +55,7 @@ const (
 type historyArchiver struct {
 	container     *archiver.HistoryBootstrapContainer
 	gcloudStorage connector.Client
+	codec         archiver.Codec
 
 	// only set in test code
 	historyIterator archiver.HistoryIterator
@@ -88,9 +90,14 @@ func newHistoryArchiver(
 	historyIterator archiver.HistoryIterator,
 	storage connector.Client,
 ) archiver.HistoryArchiver {
+	codec := container.Codec
+	if codec == nil {
+		codec = archiver.NewDefaultCodec()
+	}
 	return &historyArchiver{
 		container:       container,
 		gcloudStorage:   storage,
+		codec:           codec,
 		historyIterator: historyIterator,
 	}
 }
@@ -173,6 +180,9 @@ func (h *historyArchiver) Archive(ctx context.Context, URI archiver.URI, request
 		}
 
 		encodedHistoryPart, err := encode(historyBlob.Body)
+		if err == nil {
+			encodedHistoryPart, err = h.codec.Encode(encodedHistoryPart)
+		}
 		if err != nil {
 			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return errUploadNonRetriable
@@ -249,6 +259,10 @@ outer:
 			return nil, &types.InternalServiceError{Message: "Fail retrieving history file: " + URI.String() + "/" + filename}
 		}
 
+		encodedHistoryBatches, err = h.codec.Decode(encodedHistoryBatches)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
 		batches, err := decodeHistoryBatches(encodedHistoryBatches)
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
@@ -288,6 +302,24 @@ outer:
 	return response, nil
 }
 
+// Delete is used to delete the histories of a domain which were archived before the given time
+func (h *historyArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteHistoryRequest) error {
+
+	if err := h.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	// histories of all domains are in the same path, the filenames start with the hash of the domainID
+	if err := h.gcloudStorage.DeleteModifiedBefore(ctx, URI, hash(request.DomainID), time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (h *historyArchiver) ValidateURI(URI archiver.URI) (err error) {
 
@@ -602,3 +602,23 @@ func (h *historyArchiverSuite) TestGet_Success_FromToken() {
 
 	h.EqualValues(4, numOfEvents)
 }
+
+func (h *historyArchiverSuite) TestDelete_Success() {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(h.T())
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/development")
+	h.NoError(err)
+	archivedBefore := time.Now()
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", ctx, URI, "").Return(true, nil).Times(1)
+	storageWrapper.On("DeleteModifiedBefore", ctx, URI, hash(testDomainID), time.Unix(0, archivedBefore.UnixNano())).Return(nil).Times(1)
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	historyArchiver := newHistoryArchiver(h.container, historyIterator, storageWrapper)
+
+	err = historyArchiver.Delete(ctx, URI, &archiver.DeleteHistoryRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: archivedBefore.UnixNano(),
+	})
+	h.NoError(err)
+	storageWrapper.AssertExpectations(h.T())
+}
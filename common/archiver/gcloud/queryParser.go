@@ -25,12 +25,10 @@ package gcloud
 import (
 	"errors"
 	"fmt"
-	"strconv"
-	"time"
-
-	"github.com/xwb1989/sqlparser"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/definition"
 )
 
 type (
@@ -49,17 +47,20 @@ type (
 		searchPrecision *string
 		runID           *string
 		emptyResult     bool
+		// filter contains the conditions of the query which are not part of the search prefix
+		filter *archiver.VisibilityQuery
 	}
 )
 
-// All allowed fields for filtering
+// All allowed fields for filtering, any other field is a custom search attribute.
+// The query must have StartTime or CloseTime with SearchPrecision in its top level and expression.
 const (
-	WorkflowID      = "WorkflowID"
-	RunID           = "RunID"
-	WorkflowType    = "WorkflowType"
-	CloseTime       = "CloseTime"
-	StartTime       = "StartTime"
-	CloseStatus     = "CloseStatus"
+	WorkflowID      = definition.WorkflowID
+	RunID           = definition.RunID
+	WorkflowType    = definition.WorkflowType
+	CloseTime       = definition.CloseTime
+	StartTime       = definition.StartTime
+	CloseStatus     = definition.CloseStatus
 	SearchPrecision = "SearchPrecision"
 )
 
@@ -71,27 +72,32 @@ const (
 	PrecisionSecond = "Second"
 )
 
-const (
-	queryTemplate = "select * from dummy where %s"
-
-	defaultDateTimeFormat = time.RFC3339
-)
-
 // NewQueryParser creates a new query parser for filestore
 func NewQueryParser() QueryParser {
 	return &queryParser{}
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	filter, err := archiver.ParseVisibilityQuery(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	parsedQuery := &parsedQuery{
+		filter: filter,
+	}
+	if parsedQuery.searchPrecision, err = removeSearchPrecision(filter); err != nil {
 		return nil, err
 	}
+	if parsedQuery.closeTime, err = removeTimeCondition(filter, CloseTime); err != nil {
+		return nil, err
+	}
+	if parsedQuery.startTime, err = removeTimeCondition(filter, StartTime); err != nil {
+		return nil, err
+	}
+	// the filename of the records contains the hashes of these fields
+	parsedQuery.workflowID = requiredStringValue(filter, WorkflowID, parsedQuery)
+	parsedQuery.runID = requiredStringValue(filter, RunID, parsedQuery)
+	parsedQuery.workflowType = requiredStringValue(filter, WorkflowType, parsedQuery)
 
 	if (parsedQuery.closeTime == 0 && parsedQuery.startTime == 0) || (parsedQuery.closeTime != 0 && parsedQuery.startTime != 0) {
 		return nil, errors.New("requires a StartTime or CloseTime")
@@ -104,116 +110,19 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
-	}
-
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
-	}
-}
-
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
-	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
-	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil && *parsedQuery.workflowID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case RunID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", RunID)
-		}
-		if parsedQuery.runID != nil && *parsedQuery.runID != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.runID = common.StringPtr(val)
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", CloseTime)
-		}
-		parsedQuery.closeTime = timestamp
-
-	case StartTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", StartTime)
-		}
-		parsedQuery.startTime = timestamp
-	case WorkflowType:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", WorkflowType)
-		}
-		if parsedQuery.workflowType != nil && *parsedQuery.workflowType != val {
-			parsedQuery.emptyResult = true
-			return nil
-		}
-		parsedQuery.workflowType = common.StringPtr(val)
-	case SearchPrecision:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
+// removeSearchPrecision removes the top level search precision condition, which is not a field of the records
+func removeSearchPrecision(filter *archiver.VisibilityQuery) (*string, error) {
+	var searchPrecision *string
+	for _, condition := range filter.RemoveConditions(SearchPrecision) {
+		val, ok := condition.Values[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value for %s: %v", SearchPrecision, condition.Values[0])
 		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", SearchPrecision)
+		if condition.Operator != "=" {
+			return nil, fmt.Errorf("only operator = is supported for %s with Google Cloud Storage", SearchPrecision)
 		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+		if searchPrecision != nil && *searchPrecision != val {
+			return nil, fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
 		}
 		switch val {
 		case PrecisionDay:
@@ -221,35 +130,42 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		case PrecisionMinute:
 		case PrecisionSecond:
 		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+			return nil, fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
 		}
-		parsedQuery.searchPrecision = common.StringPtr(val)
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		searchPrecision = common.StringPtr(val)
 	}
-
-	return nil
+	if filter.HasField(SearchPrecision) {
+		return nil, fmt.Errorf("%s is only supported in the top level and expression", SearchPrecision)
+	}
+	return searchPrecision, nil
 }
 
-func convertToTimestamp(timeStr string) (int64, error) {
-	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp, nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return 0, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return 0, err
+// removeTimeCondition removes the top level equality condition on the time field,
+// which matches the records within the search precision of the time
+func removeTimeCondition(filter *archiver.VisibilityQuery, field string) (int64, error) {
+	conditions := filter.RemoveConditions(field, "=")
+	switch len(conditions) {
+	case 0:
+		return 0, nil
+	case 1:
+		return conditions[0].Values[0].(int64), nil
+	default:
+		return 0, fmt.Errorf("can not query %s multiple times", field)
 	}
-	return parsedTime.UnixNano(), nil
 }
 
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
+// requiredStringValue returns the value of the field required by the top level equality conditions
+func requiredStringValue(filter *archiver.VisibilityQuery, field string, parsedQuery *parsedQuery) *string {
+	var value *string
+	for _, condition := range filter.Conditions(field) {
+		if condition.Operator != "=" {
+			continue
+		}
+		val := condition.Values[0].(string)
+		if value != nil && *value != val {
+			parsedQuery.emptyResult = true
+		}
+		value = common.StringPtr(val)
 	}
-	return "", fmt.Errorf("value %s is not a string value", s)
+	return value
 }
@@ -206,24 +206,36 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 		filters = append(filters, newWorkflowIDPrecondition(hash(*request.parsedQuery.workflowType)))
 	}
 
-	filenames, completed, currentCursorPos, err := v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.pageSize, token.Offset, filters)
-	if err != nil {
-		return nil, &types.InternalServiceError{Message: err.Error()}
-	}
-
 	response := &archiver.QueryVisibilityResponse{}
-	for _, file := range filenames {
-		encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.domainID, filepath.Base(file)))
+	// the records filtered out are not counted in the page size, so a page may have fewer records,
+	// keep querying until the page has a record, all the files are read or the max number of pages is read
+	var completed bool
+	var currentCursorPos int
+	for page := 0; len(response.Executions) == 0 && !completed && page < archiver.QueryVisibilityMaxPages; page++ {
+		var filenames []string
+		var err error
+		filenames, completed, currentCursorPos, err = v.gcloudStorage.QueryWithFilters(ctx, URI, prefix, request.pageSize, token.Offset, filters)
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
-		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
-		}
+		for _, file := range filenames {
+			encodedRecord, err := v.gcloudStorage.Get(ctx, URI, fmt.Sprintf("%s/%s", request.domainID, filepath.Base(file)))
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
 
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+
+			if request.parsedQuery.filter != nil && !request.parsedQuery.filter.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+				continue
+			}
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		}
+		token.Offset = currentCursorPos
 	}
 
 	if !completed {
@@ -240,6 +252,23 @@ func (v *visibilityArchiver) query(ctx context.Context, URI archiver.URI, reques
 	return response, nil
 }
 
+// Delete is used to delete the visibility records of a domain which were archived before the given time
+func (v *visibilityArchiver) Delete(ctx context.Context, URI archiver.URI, request *archiver.DeleteVisibilityRequest) error {
+
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	if err := v.gcloudStorage.DeleteModifiedBefore(ctx, URI, request.DomainID+"/", time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
 // ValidateURI is used to define what a valid URI for an implementation is.
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) (err error) {
 	ctx, cancel := context.WithTimeout(context.Background(), timeoutInSeconds*time.Second)
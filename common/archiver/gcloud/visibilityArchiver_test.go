@@ -333,3 +333,42 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Len(response.Executions, 1)
 	s.Equal(convertToExecutionInfo(s.expectedVisibilityRecords[0]), response.Executions[0])
 }
+
+func (s *visibilityArchiverSuite) TestQuery_Success_MaxPages() {
+
+	pageSize := 1
+	ctx := context.Background()
+	URI, err := archiver.NewURI("gs://my-bucket-cad/cadence_archival/visibility")
+	s.NoError(err)
+	storageWrapper := &mocks.Client{}
+	storageWrapper.On("Exist", mock.Anything, URI, mock.Anything).Return(false, nil)
+	for offset := 0; offset < archiver.QueryVisibilityMaxPages; offset++ {
+		storageWrapper.On("QueryWithFilters", mock.Anything, URI, mock.Anything, pageSize, offset, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]string{"closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility"}, false, offset+1, nil).Once()
+	}
+	storageWrapper.On("Get", mock.Anything, URI, "test-domain-id/closeTimeout_2020-02-05T09:56:14Z_test-workflow-id_MobileOnlyWorkflow::processMobileOnly_test-run-id.visibility").Return([]byte(exampleVisibilityRecord), nil)
+
+	visibilityArchiver := newVisibilityArchiver(s.container, storageWrapper)
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+
+	filter, err := archiver.ParseVisibilityQuery("HistoryLength = 1")
+	s.NoError(err)
+	mockParser := NewMockQueryParser(mockCtrl)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		workflowID: common.StringPtr(testWorkflowID),
+		filter:     filter,
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: pageSize,
+		Query:    "parsed by mockParser",
+	}
+
+	response, err := visibilityArchiver.Query(ctx, URI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.NotNil(response.NextPageToken)
+	s.Empty(response.Executions)
+	storageWrapper.AssertExpectations(s.T())
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// The fields of the archived histories which are indexed
+const (
+	// HistoryIndexFieldSignalName is the name of the signals received by the workflow
+	HistoryIndexFieldSignalName = "SignalName"
+	// HistoryIndexFieldActivityType is the type of the activities scheduled by the workflow
+	HistoryIndexFieldActivityType = "ActivityType"
+	// HistoryIndexFieldFailureReason is the reason of the failed workflow, decision tasks, activities and child workflows
+	HistoryIndexFieldFailureReason = "FailureReason"
+)
+
+const (
+	historySearchQueryTemplate = "select * from dummy where %s"
+
+	// maxHistoryIndexListings bounds the listings of the first term done by a search call, so a query whose
+	// terms rarely occur together doesn't walk the whole index in one request
+	maxHistoryIndexListings = 10
+)
+
+var (
+	// HistoryIndexFields are the fields of the archived histories which are indexed
+	HistoryIndexFields = []string{
+		HistoryIndexFieldSignalName,
+		HistoryIndexFieldActivityType,
+		HistoryIndexFieldFailureReason,
+	}
+
+	errInvalidHistorySearchQuery = errors.New("invalid history search query, it must be an and of equality conditions on " +
+		strings.Join(HistoryIndexFields, ", "))
+)
+
+type (
+	// HistoryIndexTerm is a value of an indexed field found in the events of an archived history
+	HistoryIndexTerm struct {
+		Field string
+		Value string
+	}
+
+	// HistoryIndexEntry identifies an archived history in the index
+	HistoryIndexEntry struct {
+		WorkflowID string
+		RunID      string
+	}
+
+	// HistoryIndexReader reads the entries of the terms in the index of a history archiver
+	HistoryIndexReader interface {
+		// ListEntries returns at most limit names of the entries of the term which sort after the given name,
+		// in name order, and whether there are more
+		ListEntries(term HistoryIndexTerm, after string, limit int) ([]string, bool, error)
+		// EntryExists tells whether the entry is found under the term
+		EntryExists(term HistoryIndexTerm, name string) (bool, error)
+	}
+
+	historyIndexToken struct {
+		After string
+	}
+)
+
+// Path returns the relative path of the entries of the term in the index,
+// the value is hashed so it can be used as a file name or a key of any length
+func (t HistoryIndexTerm) Path() string {
+	return fmt.Sprintf("%s/%v", t.Field, farm.Fingerprint64([]byte(t.Value)))
+}
+
+// Name returns the name of the entry in the index, which is unique per workflow run
+func (e *HistoryIndexEntry) Name() string {
+	return fmt.Sprintf("%v_%s", farm.Fingerprint64([]byte(e.WorkflowID)), e.RunID)
+}
+
+// ExtractHistoryIndexTerms returns the distinct terms of the indexed fields found in the history batches
+func ExtractHistoryIndexTerms(historyBatches []*types.History) []HistoryIndexTerm {
+	seen := make(map[HistoryIndexTerm]struct{})
+	var terms []HistoryIndexTerm
+	add := func(field, value string) {
+		term := HistoryIndexTerm{Field: field, Value: value}
+		if _, ok := seen[term]; ok || value == "" {
+			return
+		}
+		seen[term] = struct{}{}
+		terms = append(terms, term)
+	}
+	for _, batch := range historyBatches {
+		for _, event := range batch.GetEvents() {
+			switch event.GetEventType() {
+			case types.EventTypeWorkflowExecutionSignaled:
+				add(HistoryIndexFieldSignalName, event.GetWorkflowExecutionSignaledEventAttributes().GetSignalName())
+			case types.EventTypeActivityTaskScheduled:
+				add(HistoryIndexFieldActivityType, event.GetActivityTaskScheduledEventAttributes().GetActivityType().GetName())
+			case types.EventTypeWorkflowExecutionFailed:
+				add(HistoryIndexFieldFailureReason, event.GetWorkflowExecutionFailedEventAttributes().GetReason())
+			case types.EventTypeDecisionTaskFailed:
+				add(HistoryIndexFieldFailureReason, event.GetDecisionTaskFailedEventAttributes().GetReason())
+			case types.EventTypeActivityTaskFailed:
+				add(HistoryIndexFieldFailureReason, event.GetActivityTaskFailedEventAttributes().GetReason())
+			case types.EventTypeChildWorkflowExecutionFailed:
+				add(HistoryIndexFieldFailureReason, event.GetChildWorkflowExecutionFailedEventAttributes().GetReason())
+			}
+		}
+	}
+	return terms
+}
+
+// ParseHistorySearchQuery parses a query of the archived histories, which is an and of equality conditions
+// on the indexed fields, e.g. SignalName = 'cancel' and ActivityType = 'chargeCard'
+func ParseHistorySearchQuery(query string) ([]HistoryIndexTerm, error) {
+	stmt, err := sqlparser.Parse(fmt.Sprintf(historySearchQueryTemplate, query))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil || len(sel.OrderBy) != 0 || sel.Limit != nil {
+		return nil, errInvalidHistorySearchQuery
+	}
+	var terms []HistoryIndexTerm
+	if err := collectHistoryIndexTerms(sel.Where.Expr, &terms); err != nil {
+		return nil, err
+	}
+	return terms, nil
+}
+
+func collectHistoryIndexTerms(expr sqlparser.Expr, terms *[]HistoryIndexTerm) error {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		if err := collectHistoryIndexTerms(expr.Left, terms); err != nil {
+			return err
+		}
+		return collectHistoryIndexTerms(expr.Right, terms)
+	case *sqlparser.ParenExpr:
+		return collectHistoryIndexTerms(expr.Expr, terms)
+	case *sqlparser.ComparisonExpr:
+		if expr.Operator != sqlparser.EqualStr {
+			return errInvalidHistorySearchQuery
+		}
+		col, ok := expr.Left.(*sqlparser.ColName)
+		if !ok || !isHistoryIndexField(col.Name.String()) {
+			return errInvalidHistorySearchQuery
+		}
+		val, ok := expr.Right.(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.StrVal {
+			return errInvalidHistorySearchQuery
+		}
+		*terms = append(*terms, HistoryIndexTerm{Field: col.Name.String(), Value: string(val.Val)})
+		return nil
+	default:
+		return errInvalidHistorySearchQuery
+	}
+}
+
+func isHistoryIndexField(field string) bool {
+	for _, f := range HistoryIndexFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// SearchHistoryIndex returns a page of the names of the entries found under all the terms and the token of the
+// next page. The entries of the first term are walked in name order, starting after the token, and each one is
+// probed under the other terms. At most maxHistoryIndexListings listings are done per call, so a page can hold
+// less than pageSize names while there are more to search.
+func SearchHistoryIndex(
+	reader HistoryIndexReader,
+	terms []HistoryIndexTerm,
+	pageSize int,
+	nextPageToken []byte,
+) ([]string, []byte, error) {
+	token := &historyIndexToken{}
+	if len(nextPageToken) != 0 {
+		if err := json.Unmarshal(nextPageToken, token); err != nil {
+			return nil, nil, ErrNextPageTokenCorrupted
+		}
+	}
+
+	var names []string
+	after := token.After
+	for listing := 0; listing < maxHistoryIndexListings; listing++ {
+		listed, more, err := reader.ListEntries(terms[0], after, pageSize)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, name := range listed {
+			after = name
+			matched, err := historyIndexEntryMatches(reader, terms[1:], name)
+			if err != nil {
+				return nil, nil, err
+			}
+			if matched {
+				names = append(names, name)
+			}
+			if len(names) == pageSize {
+				more = more || i < len(listed)-1
+				break
+			}
+		}
+		if !more {
+			return names, nil, nil
+		}
+		if len(names) == pageSize {
+			break
+		}
+	}
+
+	next, err := json.Marshal(&historyIndexToken{After: after})
+	if err != nil {
+		return nil, nil, err
+	}
+	return names, next, nil
+}
+
+func historyIndexEntryMatches(reader HistoryIndexReader, terms []HistoryIndexTerm, name string) (bool, error) {
+	for _, term := range terms {
+		exists, err := reader.EntryExists(term, name)
+		if err != nil || !exists {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ValidateSearchHistoryRequest validates the search history request
+func ValidateSearchHistoryRequest(request *SearchHistoryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.PageSize <= 0 {
+		return errInvalidPageSize
+	}
+	if request.Query == "" {
+		return errEmptyQuery
+	}
+	return nil
+}
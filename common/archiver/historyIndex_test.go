@@ -0,0 +1,228 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type historyIndexSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestHistoryIndexSuite(t *testing.T) {
+	suite.Run(t, new(historyIndexSuite))
+}
+
+func (s *historyIndexSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *historyIndexSuite) TestExtractHistoryIndexTerms() {
+	historyBatches := []*types.History{
+		{
+			Events: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						ActivityType: &types.ActivityType{Name: "chargeCard"},
+					},
+				},
+				{
+					EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+					WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+						SignalName: "cancel",
+					},
+				},
+			},
+		},
+		{
+			Events: []*types.HistoryEvent{
+				{
+					EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+					ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+						ActivityType: &types.ActivityType{Name: "chargeCard"},
+					},
+				},
+				{
+					EventType: types.EventTypeWorkflowExecutionFailed.Ptr(),
+					WorkflowExecutionFailedEventAttributes: &types.WorkflowExecutionFailedEventAttributes{
+						Reason: common.StringPtr("card declined"),
+					},
+				},
+			},
+		},
+	}
+	s.Equal([]HistoryIndexTerm{
+		{Field: HistoryIndexFieldActivityType, Value: "chargeCard"},
+		{Field: HistoryIndexFieldSignalName, Value: "cancel"},
+		{Field: HistoryIndexFieldFailureReason, Value: "card declined"},
+	}, ExtractHistoryIndexTerms(historyBatches))
+}
+
+func (s *historyIndexSuite) TestParseHistorySearchQuery() {
+	testCases := []struct {
+		query     string
+		expected  []HistoryIndexTerm
+		expectErr bool
+	}{
+		{
+			query:    "SignalName = 'cancel'",
+			expected: []HistoryIndexTerm{{Field: HistoryIndexFieldSignalName, Value: "cancel"}},
+		},
+		{
+			query: "ActivityType = 'chargeCard' and (FailureReason = 'card declined')",
+			expected: []HistoryIndexTerm{
+				{Field: HistoryIndexFieldActivityType, Value: "chargeCard"},
+				{Field: HistoryIndexFieldFailureReason, Value: "card declined"},
+			},
+		},
+		{
+			query:     "SignalName = 'cancel' or ActivityType = 'chargeCard'",
+			expectErr: true,
+		},
+		{
+			query:     "SignalName != 'cancel'",
+			expectErr: true,
+		},
+		{
+			query:     "WorkflowID = 'id'",
+			expectErr: true,
+		},
+		{
+			query:     "SignalName = 5",
+			expectErr: true,
+		},
+		{
+			query:     "SignalName = 'cancel' order by SignalName",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		terms, err := ParseHistorySearchQuery(tc.query)
+		if tc.expectErr {
+			s.Error(err, tc.query)
+			continue
+		}
+		s.NoError(err, tc.query)
+		s.Equal(tc.expected, terms, tc.query)
+	}
+}
+
+type fakeHistoryIndexReader struct {
+	index    map[HistoryIndexTerm][]string
+	listings int
+	err      error
+}
+
+func (r *fakeHistoryIndexReader) ListEntries(term HistoryIndexTerm, after string, limit int) ([]string, bool, error) {
+	r.listings++
+	if r.err != nil {
+		return nil, false, r.err
+	}
+	names := append([]string(nil), r.index[term]...)
+	sort.Strings(names)
+	names = names[sort.Search(len(names), func(i int) bool { return names[i] > after }):]
+	if len(names) > limit {
+		return names[:limit], true, nil
+	}
+	return names, false, nil
+}
+
+func (r *fakeHistoryIndexReader) EntryExists(term HistoryIndexTerm, name string) (bool, error) {
+	for _, entry := range r.index[term] {
+		if entry == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *historyIndexSuite) TestSearchHistoryIndex() {
+	signal := HistoryIndexTerm{Field: HistoryIndexFieldSignalName, Value: "cancel"}
+	activity := HistoryIndexTerm{Field: HistoryIndexFieldActivityType, Value: "chargeCard"}
+	failure := HistoryIndexTerm{Field: HistoryIndexFieldFailureReason, Value: "timeout"}
+	reader := &fakeHistoryIndexReader{index: map[HistoryIndexTerm][]string{
+		signal:   {"c", "a", "b", "e"},
+		activity: {"b", "d", "c", "e"},
+	}}
+
+	names, token, err := SearchHistoryIndex(reader, []HistoryIndexTerm{signal}, 10, nil)
+	s.NoError(err)
+	s.Equal([]string{"a", "b", "c", "e"}, names)
+	s.Nil(token)
+
+	names, token, err = SearchHistoryIndex(reader, []HistoryIndexTerm{signal, activity}, 2, nil)
+	s.NoError(err)
+	s.Equal([]string{"b", "c"}, names)
+	s.NotNil(token)
+
+	names, token, err = SearchHistoryIndex(reader, []HistoryIndexTerm{signal, activity}, 2, token)
+	s.NoError(err)
+	s.Equal([]string{"e"}, names)
+	s.Nil(token)
+
+	names, token, err = SearchHistoryIndex(reader, []HistoryIndexTerm{signal, failure}, 10, nil)
+	s.NoError(err)
+	s.Empty(names)
+	s.Nil(token)
+
+	_, _, err = SearchHistoryIndex(reader, []HistoryIndexTerm{signal}, 2, []byte("invalid token"))
+	s.Equal(ErrNextPageTokenCorrupted, err)
+
+	_, _, err = SearchHistoryIndex(&fakeHistoryIndexReader{err: errors.New("some random error")}, []HistoryIndexTerm{signal}, 2, nil)
+	s.Error(err)
+}
+
+func (s *historyIndexSuite) TestSearchHistoryIndex_ListingsPerCall() {
+	signal := HistoryIndexTerm{Field: HistoryIndexFieldSignalName, Value: "cancel"}
+	activity := HistoryIndexTerm{Field: HistoryIndexFieldActivityType, Value: "chargeCard"}
+	var signaled []string
+	for i := 0; i < maxHistoryIndexListings*2; i++ {
+		signaled = append(signaled, fmt.Sprintf("%03d", i))
+	}
+	reader := &fakeHistoryIndexReader{index: map[HistoryIndexTerm][]string{
+		signal:   signaled,
+		activity: {signaled[len(signaled)-1]},
+	}}
+
+	names, token, err := SearchHistoryIndex(reader, []HistoryIndexTerm{signal, activity}, 1, nil)
+	s.NoError(err)
+	s.Empty(names)
+	s.NotNil(token)
+	s.Equal(maxHistoryIndexListings, reader.listings)
+
+	names, token, err = SearchHistoryIndex(reader, []HistoryIndexTerm{signal, activity}, 1, token)
+	s.NoError(err)
+	s.Equal([]string{signaled[len(signaled)-1]}, names)
+	s.Nil(token)
+}
@@ -60,6 +60,28 @@ type (
 		NextPageToken  []byte
 	}
 
+	// DeleteHistoryRequest is the request to Delete the archived histories of a domain
+	DeleteHistoryRequest struct {
+		DomainID string
+		// ArchivedBefore is the unix nano timestamp before which the deleted histories were archived
+		ArchivedBefore int64
+	}
+
+	// SearchHistoryRequest is the request to Search the index of the archived histories of a domain
+	SearchHistoryRequest struct {
+		DomainID string
+		// Query is an and of equality conditions on the indexed fields, see ParseHistorySearchQuery
+		Query         string
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// SearchHistoryResponse is the response of Search archived histories
+	SearchHistoryResponse struct {
+		Executions    []*HistoryIndexEntry
+		NextPageToken []byte
+	}
+
 	// HistoryBootstrapContainer contains components needed by all history Archiver implementations
 	HistoryBootstrapContainer struct {
 		HistoryV2Manager persistence.HistoryManager
@@ -67,15 +89,27 @@ type (
 		MetricsClient    metrics.Client
 		ClusterMetadata  cluster.Metadata
 		DomainCache      cache.DomainCache
+		// Codec is optional, the codec of the history archiver provider config is used if it's not set
+		Codec Codec
+		// EnableIndex makes the history archivers implementing HistorySearcher index the archived histories,
+		// it's also enabled by the history archiver provider config
+		EnableIndex bool
 	}
 
-	// HistoryArchiver is used to archive history and read archived history
+	// HistoryArchiver is used to archive history, read and delete archived history
 	HistoryArchiver interface {
 		Archive(context.Context, URI, *ArchiveHistoryRequest, ...ArchiveOption) error
 		Get(context.Context, URI, *GetHistoryRequest) (*GetHistoryResponse, error)
+		Delete(context.Context, URI, *DeleteHistoryRequest) error
 		ValidateURI(URI) error
 	}
 
+	// HistorySearcher is implemented by the history archivers which can index the archived histories
+	// by the event attributes in HistoryIndexFields and search them
+	HistorySearcher interface {
+		Search(context.Context, URI, *SearchHistoryRequest) (*SearchHistoryResponse, error)
+	}
+
 	// VisibilityBootstrapContainer contains components needed by all visibility Archiver implementations
 	VisibilityBootstrapContainer struct {
 		Logger          log.Logger
@@ -115,10 +149,18 @@ type (
 		NextPageToken []byte
 	}
 
-	// VisibilityArchiver is used to archive visibility and read archived visibility
+	// DeleteVisibilityRequest is the request to Delete the archived visibility records of a domain
+	DeleteVisibilityRequest struct {
+		DomainID string
+		// ArchivedBefore is the unix nano timestamp before which the deleted records were archived
+		ArchivedBefore int64
+	}
+
+	// VisibilityArchiver is used to archive visibility, read and delete archived visibility
 	VisibilityArchiver interface {
 		Archive(context.Context, URI, *ArchiveVisibilityRequest, ...ArchiveOption) error
 		Query(context.Context, URI, *QueryVisibilityRequest) (*QueryVisibilityResponse, error)
+		Delete(context.Context, URI, *DeleteVisibilityRequest) error
 		ValidateURI(URI) error
 	}
 )
@@ -54,6 +54,20 @@ func (_m *HistoryArchiverMock) Archive(ctx context.Context, uri URI, request *Ar
 	return r0
 }
 
+// Delete provides a mock function with given fields: ctx, uri, request
+func (_m *HistoryArchiverMock) Delete(ctx context.Context, uri URI, request *DeleteHistoryRequest) error {
+	ret := _m.Called(ctx, uri, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *DeleteHistoryRequest) error); ok {
+		r0 = rf(ctx, uri, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Get provides a mock function with given fields: ctx, uri, request
 func (_m *HistoryArchiverMock) Get(ctx context.Context, uri URI, request *GetHistoryRequest) (*GetHistoryResponse, error) {
 	ret := _m.Called(ctx, uri, request)
@@ -117,6 +131,20 @@ func (_m *VisibilityArchiverMock) Archive(_a0 context.Context, _a1 URI, _a2 *Arc
 	return r0
 }
 
+// Delete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *VisibilityArchiverMock) Delete(_a0 context.Context, _a1 URI, _a2 *DeleteVisibilityRequest) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, URI, *DeleteVisibilityRequest) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Query provides a mock function with given fields: _a0, _a1, _a2
 func (_m *VisibilityArchiverMock) Query(_a0 context.Context, _a1 URI, _a2 *QueryVisibilityRequest) (*QueryVisibilityResponse, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -0,0 +1,439 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// The parquet libraries for Go, e.g. github.com/xitongsys/parquet-go, are generated by thrift 0.13 or later,
+// which doesn't build with the older thrift that go.mod pins for ringpop-go and tchannel-go. So the archiver
+// encodes and decodes the subset of parquet.thrift it uses, see https://github.com/apache/parquet-format
+const (
+	parquetMagic = "PAR1"
+	createdBy    = "cadence visibility archiver"
+	fileVersion  = 1
+
+	typeInt64     int32 = 2
+	typeByteArray int32 = 6
+
+	repetitionRequired int32 = 0
+	convertedTypeUTF8  int32 = 0
+
+	encodingPlain int32 = 0
+	encodingRLE   int32 = 3
+
+	codecUncompressed int32 = 0
+	codecGzip         int32 = 2
+
+	pageTypeData int32 = 0
+)
+
+const (
+	columnKindString columnKind = iota
+	columnKindInt64
+	// columnKindTimestamp is an INT64 column of nanoseconds since the epoch in UTC
+	columnKindTimestamp
+)
+
+var errInvalidParquetFile = errors.New("invalid parquet file")
+
+type (
+	columnKind int
+
+	// column is a required column of a flat schema,
+	// strings is set for string columns and int64s for the other kinds
+	column struct {
+		name    string
+		kind    columnKind
+		strings []string
+		int64s  []int64
+	}
+
+	columnChunk struct {
+		offset           int64
+		numValues        int64
+		uncompressedSize int64
+		compressedSize   int64
+		min, max         []byte
+	}
+)
+
+func (c *column) len() int {
+	if c.kind == columnKindString {
+		return len(c.strings)
+	}
+	return len(c.int64s)
+}
+
+func (c *column) physicalType() int32 {
+	if c.kind == columnKindString {
+		return typeByteArray
+	}
+	return typeInt64
+}
+
+// encodeFile writes the columns as a parquet file with a single row group,
+// each column chunk is a single gzip compressed data page with plain encoding
+func encodeFile(columns []*column) ([]byte, error) {
+	numRows := 0
+	if len(columns) > 0 {
+		numRows = columns[0].len()
+	}
+	buf := bytes.NewBufferString(parquetMagic)
+	chunks := make([]*columnChunk, 0, len(columns))
+	for _, col := range columns {
+		if col.len() != numRows {
+			return nil, fmt.Errorf("column %v has %v values, expected %v", col.name, col.len(), numRows)
+		}
+		data := encodePlain(col)
+		compressed, err := compressGzip(data)
+		if err != nil {
+			return nil, err
+		}
+		header, err := encodePageHeader(int32(numRows), len(data), len(compressed))
+		if err != nil {
+			return nil, err
+		}
+		chunk := &columnChunk{
+			offset:           int64(buf.Len()),
+			numValues:        int64(numRows),
+			uncompressedSize: int64(len(header) + len(data)),
+			compressedSize:   int64(len(header) + len(compressed)),
+		}
+		if col.kind != columnKindString && numRows > 0 {
+			chunk.min, chunk.max = int64Stats(col.int64s)
+		}
+		chunks = append(chunks, chunk)
+		buf.Write(header)
+		buf.Write(compressed)
+	}
+
+	footer, err := encodeFileMetaData(columns, chunks, int64(numRows))
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(footer)
+	footerLength := make([]byte, 4)
+	binary.LittleEndian.PutUint32(footerLength, uint32(len(footer)))
+	buf.Write(footerLength)
+	buf.WriteString(parquetMagic)
+	return buf.Bytes(), nil
+}
+
+func encodePlain(col *column) []byte {
+	var buf bytes.Buffer
+	value := make([]byte, 8)
+	switch col.kind {
+	case columnKindString:
+		for _, s := range col.strings {
+			binary.LittleEndian.PutUint32(value, uint32(len(s)))
+			buf.Write(value[:4])
+			buf.WriteString(s)
+		}
+	default:
+		for _, i := range col.int64s {
+			binary.LittleEndian.PutUint64(value, uint64(i))
+			buf.Write(value)
+		}
+	}
+	return buf.Bytes()
+}
+
+func int64Stats(values []int64) ([]byte, []byte) {
+	min, max := values[0], values[0]
+	for _, value := range values[1:] {
+		if value < min {
+			min = value
+		}
+		if value > max {
+			max = value
+		}
+	}
+	minBytes, maxBytes := make([]byte, 8), make([]byte, 8)
+	binary.LittleEndian.PutUint64(minBytes, uint64(min))
+	binary.LittleEndian.PutUint64(maxBytes, uint64(max))
+	return minBytes, maxBytes
+}
+
+func compressGzip(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func encodePageHeader(numValues int32, uncompressedSize, compressedSize int) ([]byte, error) {
+	w := newThriftWriter()
+	return w.writeStruct(func() {
+		w.i32Field(1, pageTypeData)
+		w.i32Field(2, int32(uncompressedSize))
+		w.i32Field(3, int32(compressedSize))
+		w.structField(5, func() { // DataPageHeader
+			w.i32Field(1, numValues)
+			w.i32Field(2, encodingPlain)
+			w.i32Field(3, encodingRLE)
+			w.i32Field(4, encodingRLE)
+		})
+	})
+}
+
+func encodeFileMetaData(columns []*column, chunks []*columnChunk, numRows int64) ([]byte, error) {
+	w := newThriftWriter()
+	return w.writeStruct(func() {
+		w.i32Field(1, fileVersion)
+		w.structListField(2, len(columns)+1, func(i int) { // SchemaElement
+			if i == 0 {
+				w.stringField(4, "schema")
+				w.i32Field(5, int32(len(columns)))
+				return
+			}
+			col := columns[i-1]
+			w.i32Field(1, col.physicalType())
+			w.i32Field(3, repetitionRequired)
+			w.stringField(4, col.name)
+			switch col.kind {
+			case columnKindString:
+				w.i32Field(6, convertedTypeUTF8)
+				w.structField(10, func() { // LogicalType
+					w.structField(1, func() {}) // STRING
+				})
+			case columnKindTimestamp:
+				w.structField(10, func() { // LogicalType
+					w.structField(8, func() { // TIMESTAMP
+						// isAdjustedToUTC
+						w.boolField(1, true)
+						w.structField(2, func() { // TimeUnit
+							w.structField(3, func() {}) // NANOS
+						})
+					})
+				})
+			}
+		})
+		w.i64Field(3, numRows)
+		w.structListField(4, 1, func(int) { // RowGroup
+			var totalByteSize int64
+			w.structListField(1, len(columns), func(i int) { // ColumnChunk
+				col, chunk := columns[i], chunks[i]
+				totalByteSize += chunk.uncompressedSize
+				w.i64Field(2, chunk.offset)
+				w.structField(3, func() { // ColumnMetaData
+					w.i32Field(1, col.physicalType())
+					w.i32ListField(2, encodingPlain, encodingRLE)
+					w.stringListField(3, col.name)
+					w.i32Field(4, codecGzip)
+					w.i64Field(5, chunk.numValues)
+					w.i64Field(6, chunk.uncompressedSize)
+					w.i64Field(7, chunk.compressedSize)
+					w.i64Field(9, chunk.offset)
+					if chunk.min != nil {
+						w.structField(12, func() { // Statistics
+							w.i64Field(3, 0) // null_count
+							w.binaryField(5, chunk.max)
+							w.binaryField(6, chunk.min)
+						})
+					}
+				})
+			})
+			w.i64Field(2, totalByteSize)
+			w.i64Field(3, numRows)
+		})
+		w.stringField(6, createdBy)
+	})
+}
+
+// decodeFile reads the columns of a parquet file with a flat schema of required columns,
+// it supports the plain encoding of uncompressed or gzip compressed data pages, which
+// is how the files are written by encodeFile
+func decodeFile(data []byte) ([]*column, error) {
+	if len(data) < 2*len(parquetMagic)+4 ||
+		!bytes.HasPrefix(data, []byte(parquetMagic)) ||
+		!bytes.HasSuffix(data, []byte(parquetMagic)) {
+		return nil, errInvalidParquetFile
+	}
+	footerEnd := len(data) - len(parquetMagic) - 4
+	footerLength := int(binary.LittleEndian.Uint32(data[footerEnd:]))
+	if footerLength <= 0 || footerLength > footerEnd-len(parquetMagic) {
+		return nil, errInvalidParquetFile
+	}
+	metadata, _, err := readThriftStruct(data[footerEnd-footerLength : footerEnd])
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidParquetFile, err)
+	}
+
+	schema, err := metadata.structList(2)
+	if err != nil || len(schema) == 0 {
+		return nil, fmt.Errorf("%v: invalid schema", errInvalidParquetFile)
+	}
+	columns := make([]*column, 0, len(schema)-1)
+	columnsByName := make(map[string]*column, len(schema)-1)
+	for _, element := range schema[1:] {
+		name, _ := element.binary(4)
+		physicalType, ok := element.i32(1)
+		if !ok {
+			return nil, fmt.Errorf("unsupported nested column %s", name)
+		}
+		if repetition, ok := element.i32(3); ok && repetition != repetitionRequired {
+			return nil, fmt.Errorf("unsupported optional or repeated column %s", name)
+		}
+		col := &column{name: string(name)}
+		switch physicalType {
+		case typeByteArray:
+			col.kind = columnKindString
+		case typeInt64:
+			col.kind = columnKindInt64
+		default:
+			return nil, fmt.Errorf("unsupported type %v of column %s", physicalType, name)
+		}
+		columns = append(columns, col)
+		columnsByName[col.name] = col
+	}
+
+	rowGroups, err := metadata.structList(4)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidParquetFile, err)
+	}
+	for _, rowGroup := range rowGroups {
+		chunks, err := rowGroup.structList(1)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", errInvalidParquetFile, err)
+		}
+		for _, chunk := range chunks {
+			if err := decodeColumnChunk(data[:footerEnd-footerLength], chunk, columnsByName); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	numRows, _ := metadata.i64(3)
+	for _, col := range columns {
+		if int64(col.len()) != numRows {
+			return nil, fmt.Errorf("%v: column %v has %v values, expected %v", errInvalidParquetFile, col.name, col.len(), numRows)
+		}
+	}
+	return columns, nil
+}
+
+func decodeColumnChunk(data []byte, chunk thriftStruct, columnsByName map[string]*column) error {
+	metadata, ok := chunk.structValue(3)
+	if !ok {
+		return fmt.Errorf("%v: column chunk without metadata", errInvalidParquetFile)
+	}
+	path, _ := metadata[3].([]interface{})
+	if len(path) != 1 {
+		return fmt.Errorf("%v: unsupported column path %v", errInvalidParquetFile, path)
+	}
+	name, _ := path[0].([]byte)
+	col, ok := columnsByName[string(name)]
+	if !ok {
+		return fmt.Errorf("%v: column %s is not in the schema", errInvalidParquetFile, name)
+	}
+	codec, _ := metadata.i32(4)
+	if codec != codecUncompressed && codec != codecGzip {
+		return fmt.Errorf("unsupported compression codec %v of column %v", codec, col.name)
+	}
+	numValues, _ := metadata.i64(5)
+	offset, _ := metadata.i64(9)
+
+	for read := int64(0); read < numValues; {
+		if offset < 0 || offset >= int64(len(data)) {
+			return fmt.Errorf("%v: invalid page offset %v of column %v", errInvalidParquetFile, offset, col.name)
+		}
+		header, headerLength, err := readThriftStruct(data[offset:])
+		if err != nil {
+			return fmt.Errorf("%v: %v", errInvalidParquetFile, err)
+		}
+		pageType, _ := header.i32(1)
+		compressedSize, _ := header.i32(3)
+		dataPageHeader, ok := header.structValue(5)
+		if pageType != pageTypeData || !ok {
+			return fmt.Errorf("unsupported page type %v of column %v", pageType, col.name)
+		}
+		if encoding, _ := dataPageHeader.i32(2); encoding != encodingPlain {
+			return fmt.Errorf("unsupported encoding %v of column %v", encoding, col.name)
+		}
+		pageValues, _ := dataPageHeader.i32(1)
+		if pageValues <= 0 {
+			return fmt.Errorf("%v: empty page of column %v", errInvalidParquetFile, col.name)
+		}
+
+		start := offset + int64(headerLength)
+		end := start + int64(compressedSize)
+		if compressedSize < 0 || end > int64(len(data)) {
+			return fmt.Errorf("%v: invalid page size of column %v", errInvalidParquetFile, col.name)
+		}
+		page := data[start:end]
+		if codec == codecGzip {
+			if page, err = decompressGzip(page); err != nil {
+				return err
+			}
+		}
+		if err := decodePlain(col, page, int(pageValues)); err != nil {
+			return err
+		}
+		read += int64(pageValues)
+		offset = end
+	}
+	return nil
+}
+
+func decompressGzip(data []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return ioutil.ReadAll(reader)
+}
+
+func decodePlain(col *column, data []byte, numValues int) error {
+	for i := 0; i < numValues; i++ {
+		switch col.kind {
+		case columnKindString:
+			if len(data) < 4 {
+				return fmt.Errorf("%v: truncated values of column %v", errInvalidParquetFile, col.name)
+			}
+			length := binary.LittleEndian.Uint32(data)
+			if uint64(length) > uint64(len(data)-4) {
+				return fmt.Errorf("%v: truncated values of column %v", errInvalidParquetFile, col.name)
+			}
+			col.strings = append(col.strings, string(data[4:4+length]))
+			data = data[4+length:]
+		default:
+			if len(data) < 8 {
+				return fmt.Errorf("%v: truncated values of column %v", errInvalidParquetFile, col.name)
+			}
+			col.int64s = append(col.int64s, int64(binary.LittleEndian.Uint64(data)))
+			data = data[8:]
+		}
+	}
+	return nil
+}
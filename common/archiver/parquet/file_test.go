@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestEncodeDecodeFile(t *testing.T) {
+	columns := []*column{
+		{name: "string_column", kind: columnKindString, strings: []string{"a", "", "some longer value"}},
+		{name: "int64_column", kind: columnKindInt64, int64s: []int64{-1, 0, 1 << 40}},
+		{name: "timestamp_column", kind: columnKindTimestamp, int64s: []int64{1580819141000000000, 0, 1}},
+	}
+	data, err := encodeFile(columns)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(data, []byte("PAR1")))
+	require.True(t, bytes.HasSuffix(data, []byte("PAR1")))
+
+	decoded, err := decodeFile(data)
+	require.NoError(t, err)
+	// timestamps are decoded as their physical type
+	columns[2].kind = columnKindInt64
+	require.Equal(t, columns, decoded)
+}
+
+func TestEncodeFile_MismatchedColumns(t *testing.T) {
+	columns := []*column{
+		{name: "string_column", kind: columnKindString, strings: []string{"a", "b"}},
+		{name: "int64_column", kind: columnKindInt64, int64s: []int64{1}},
+	}
+	_, err := encodeFile(columns)
+	require.Error(t, err)
+}
+
+func TestDecodeFile_Invalid(t *testing.T) {
+	data, err := encodeFile([]*column{
+		{name: "string_column", kind: columnKindString, strings: []string{"a", "b"}},
+	})
+	require.NoError(t, err)
+
+	corrupted := append([]byte{}, data...)
+	for i := 4; i < len(corrupted)-8; i++ {
+		corrupted[i] = 0xff
+	}
+
+	testCases := map[string][]byte{
+		"empty":            nil,
+		"magic only":       []byte("PAR1PAR1"),
+		"missing footer":   data[:len(data)-4],
+		"truncated":        append(append([]byte{}, data[:4]...), data[len(data)-8:]...),
+		"corrupted":        corrupted,
+		"not parquet":      []byte("this is not a parquet file"),
+		"no leading magic": append([]byte("PAR2"), data[4:]...),
+	}
+	for name, data := range testCases {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeFile(data)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEncodeDecodeVisibilityRecords(t *testing.T) {
+	records := []*archiver.ArchiveVisibilityRequest{
+		{
+			DomainID:           "test-domain-id",
+			DomainName:         "test-domain-name",
+			WorkflowID:         "test-workflow-id",
+			RunID:              "test-run-id",
+			WorkflowTypeName:   "test-workflow-type",
+			StartTimestamp:     1580819141000000000,
+			ExecutionTimestamp: 1580819142000000000,
+			CloseTimestamp:     1580819143000000000,
+			CloseStatus:        types.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:      101,
+			Memo: &types.Memo{
+				Fields: map[string][]byte{"memo-key": []byte("memo-value")},
+			},
+			SearchAttributes:   map[string]string{"CustomKeywordField": "\"keyword\""},
+			HistoryArchivalURI: "file:///a/b/c",
+		},
+		{
+			DomainID:         "test-domain-id",
+			DomainName:       "test-domain-name",
+			WorkflowID:       "other-workflow-id",
+			RunID:            "other-run-id",
+			WorkflowTypeName: "other-workflow-type",
+			StartTimestamp:   1580819144000000000,
+			CloseTimestamp:   1580819145000000000,
+			CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+			HistoryLength:    3,
+		},
+	}
+	data, err := encodeVisibilityRecords(records)
+	require.NoError(t, err)
+
+	decoded, err := decodeVisibilityRecords(data)
+	require.NoError(t, err)
+	require.Equal(t, records, decoded)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestQueryParser_Parse(t *testing.T) {
+	closeTime, err := time.Parse(time.RFC3339, "2020-02-05T11:00:00Z")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		query     string
+		expectErr bool
+		check     func(*parsedQuery)
+	}{
+		{
+			query: "CloseTime >= '2020-02-05T11:00:00Z' and CloseTime < 1580905000000000000",
+			check: func(q *parsedQuery) {
+				require.Equal(t, closeTime.UnixNano(), q.earliestCloseTime)
+				require.Equal(t, int64(1580905000000000000-1), q.latestCloseTime)
+				require.False(t, q.emptyResult)
+			},
+		},
+		{
+			query: "CloseTime between 100 and 200 and (WorkflowID = 'wid' or CloseTime > 1000)",
+			check: func(q *parsedQuery) {
+				require.Equal(t, int64(100), q.earliestCloseTime)
+				require.Equal(t, int64(200), q.latestCloseTime)
+			},
+		},
+		{
+			query: "WorkflowID = 'wid'",
+			check: func(q *parsedQuery) {
+				require.Equal(t, int64(0), q.earliestCloseTime)
+				require.True(t, q.latestCloseTime <= time.Now().UnixNano())
+			},
+		},
+		{
+			query: "CloseTime > 200 and CloseTime < 100",
+			check: func(q *parsedQuery) {
+				require.True(t, q.emptyResult)
+			},
+		},
+		{
+			query:     "CloseStatus > 'failed'",
+			expectErr: true,
+		},
+		{
+			query:     "closeTime = 100",
+			expectErr: true,
+		},
+	}
+
+	parser := &queryParser{}
+	for _, tc := range testCases {
+		parsedQuery, err := parser.Parse(tc.query)
+		if tc.expectErr {
+			require.Error(t, err, tc.query)
+			continue
+		}
+		require.NoError(t, err, tc.query)
+		tc.check(parsedQuery)
+	}
+}
+
+func TestParsedQuery_Match(t *testing.T) {
+	record := &archiver.ArchiveVisibilityRequest{
+		WorkflowID:       "wid",
+		RunID:            "rid",
+		WorkflowTypeName: "type",
+		StartTimestamp:   100,
+		CloseTimestamp:   200,
+		CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+	}
+
+	testCases := map[string]bool{
+		"WorkflowID = 'wid'":                            true,
+		"WorkflowID = 'other wid'":                      false,
+		"RunID = 'rid' and WorkflowTypeName = 'type'":   true,
+		"WorkflowType = 'other type'":                   false,
+		"CloseStatus = 'completed'":                     true,
+		"CloseStatus = 'failed'":                        false,
+		"CloseTime >= 200 and CloseTime <= 200":         true,
+		"CloseTime > 200":                               false,
+		"StartTime < 100":                               false,
+		"StartTime = 100 and CloseStatus = 'completed'": true,
+		"WorkflowID = 'other wid' or RunID in ('rid')":  true,
+		"CloseTime > 200 or WorkflowType like 'ty%'":    true,
+	}
+	parser := &queryParser{}
+	for query, expected := range testCases {
+		parsedQuery, err := parser.Parse(query)
+		require.NoError(t, err, query)
+		require.Equal(t, expected, parsedQuery.match(record), query)
+	}
+}
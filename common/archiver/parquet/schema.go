@@ -0,0 +1,195 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquet
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/types"
+)
+
+// The columns of the visibility files, memo and search attributes are JSON encoded
+const (
+	columnDomainID           = "domain_id"
+	columnDomainName         = "domain_name"
+	columnWorkflowID         = "workflow_id"
+	columnRunID              = "run_id"
+	columnWorkflowTypeName   = "workflow_type_name"
+	columnStartTime          = "start_time"
+	columnExecutionTime      = "execution_time"
+	columnCloseTime          = "close_time"
+	columnCloseStatus        = "close_status"
+	columnHistoryLength      = "history_length"
+	columnMemo               = "memo"
+	columnSearchAttributes   = "search_attributes"
+	columnHistoryArchivalURI = "history_archival_uri"
+)
+
+// encodeVisibilityRecords encodes the records into a parquet file
+func encodeVisibilityRecords(records []*archiver.ArchiveVisibilityRequest) ([]byte, error) {
+	columns := []*column{
+		{name: columnDomainID, kind: columnKindString},
+		{name: columnDomainName, kind: columnKindString},
+		{name: columnWorkflowID, kind: columnKindString},
+		{name: columnRunID, kind: columnKindString},
+		{name: columnWorkflowTypeName, kind: columnKindString},
+		{name: columnStartTime, kind: columnKindTimestamp},
+		{name: columnExecutionTime, kind: columnKindTimestamp},
+		{name: columnCloseTime, kind: columnKindTimestamp},
+		{name: columnCloseStatus, kind: columnKindString},
+		{name: columnHistoryLength, kind: columnKindInt64},
+		{name: columnMemo, kind: columnKindString},
+		{name: columnSearchAttributes, kind: columnKindString},
+		{name: columnHistoryArchivalURI, kind: columnKindString},
+	}
+	for _, record := range records {
+		memo, err := encodeJSON(record.Memo)
+		if err != nil {
+			return nil, err
+		}
+		searchAttributes, err := encodeJSON(record.SearchAttributes)
+		if err != nil {
+			return nil, err
+		}
+		values := []interface{}{
+			record.DomainID,
+			record.DomainName,
+			record.WorkflowID,
+			record.RunID,
+			record.WorkflowTypeName,
+			record.StartTimestamp,
+			record.ExecutionTimestamp,
+			record.CloseTimestamp,
+			record.CloseStatus.String(),
+			record.HistoryLength,
+			memo,
+			searchAttributes,
+			record.HistoryArchivalURI,
+		}
+		for i, value := range values {
+			switch value := value.(type) {
+			case string:
+				columns[i].strings = append(columns[i].strings, value)
+			case int64:
+				columns[i].int64s = append(columns[i].int64s, value)
+			}
+		}
+	}
+	return encodeFile(columns)
+}
+
+// decodeVisibilityRecords decodes the records from a parquet file, unknown columns are ignored
+func decodeVisibilityRecords(data []byte) ([]*archiver.ArchiveVisibilityRequest, error) {
+	columns, err := decodeFile(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, nil
+	}
+	records := make([]*archiver.ArchiveVisibilityRequest, columns[0].len())
+	for i := range records {
+		records[i] = &archiver.ArchiveVisibilityRequest{}
+	}
+	for _, col := range columns {
+		for i, record := range records {
+			if err := setVisibilityRecordField(record, col, i); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return records, nil
+}
+
+func setVisibilityRecordField(record *archiver.ArchiveVisibilityRequest, col *column, i int) error {
+	var err error
+	switch col.name {
+	case columnDomainID:
+		record.DomainID, err = col.stringAt(i)
+	case columnDomainName:
+		record.DomainName, err = col.stringAt(i)
+	case columnWorkflowID:
+		record.WorkflowID, err = col.stringAt(i)
+	case columnRunID:
+		record.RunID, err = col.stringAt(i)
+	case columnWorkflowTypeName:
+		record.WorkflowTypeName, err = col.stringAt(i)
+	case columnStartTime:
+		record.StartTimestamp, err = col.int64At(i)
+	case columnExecutionTime:
+		record.ExecutionTimestamp, err = col.int64At(i)
+	case columnCloseTime:
+		record.CloseTimestamp, err = col.int64At(i)
+	case columnCloseStatus:
+		var status string
+		if status, err = col.stringAt(i); err == nil {
+			err = record.CloseStatus.UnmarshalText([]byte(status))
+		}
+	case columnHistoryLength:
+		record.HistoryLength, err = col.int64At(i)
+	case columnMemo:
+		var memo string
+		if memo, err = col.stringAt(i); err == nil && memo != "" {
+			record.Memo = &types.Memo{}
+			err = json.Unmarshal([]byte(memo), record.Memo)
+		}
+	case columnSearchAttributes:
+		var searchAttributes string
+		if searchAttributes, err = col.stringAt(i); err == nil && searchAttributes != "" {
+			err = json.Unmarshal([]byte(searchAttributes), &record.SearchAttributes)
+		}
+	case columnHistoryArchivalURI:
+		record.HistoryArchivalURI, err = col.stringAt(i)
+	}
+	return err
+}
+
+func (c *column) stringAt(i int) (string, error) {
+	if c.kind != columnKindString {
+		return "", fmt.Errorf("column %v is not a string column", c.name)
+	}
+	return c.strings[i], nil
+}
+
+func (c *column) int64At(i int) (int64, error) {
+	if c.kind == columnKindString {
+		return 0, fmt.Errorf("column %v is not an int64 column", c.name)
+	}
+	return c.int64s[i], nil
+}
+
+// encodeJSON returns an empty string for nil values so they are decoded as nil
+func encodeJSON(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case *types.Memo:
+		if v == nil {
+			return "", nil
+		}
+	case map[string]string:
+		if v == nil {
+			return "", nil
+		}
+	}
+	data, err := json.Marshal(value)
+	return string(data), err
+}
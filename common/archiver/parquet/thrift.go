@@ -0,0 +1,249 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/thrift/lib/go/thrift"
+)
+
+type (
+	// thriftWriter writes the parquet metadata structs with the thrift compact protocol,
+	// the first error is kept and the following writes are no-ops
+	thriftWriter struct {
+		buffer   *thrift.TMemoryBuffer
+		protocol *thrift.TCompactProtocol
+		err      error
+	}
+
+	// thriftStruct is a decoded thrift struct keyed by field ID, the values are
+	// bool, int8, int16, int32, int64, float64, []byte, thriftStruct or []interface{}
+	thriftStruct map[int16]interface{}
+)
+
+func newThriftWriter() *thriftWriter {
+	buffer := thrift.NewTMemoryBuffer()
+	return &thriftWriter{
+		buffer:   buffer,
+		protocol: thrift.NewTCompactProtocol(buffer),
+	}
+}
+
+// writeStruct writes a top level struct and returns the encoded bytes
+func (w *thriftWriter) writeStruct(fields func()) ([]byte, error) {
+	w.structBody(fields)
+	if w.err == nil {
+		w.err = w.protocol.Flush()
+	}
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buffer.Bytes(), nil
+}
+
+func (w *thriftWriter) structBody(fields func()) {
+	w.do(w.protocol.WriteStructBegin(""))
+	fields()
+	w.do(w.protocol.WriteFieldStop())
+	w.do(w.protocol.WriteStructEnd())
+}
+
+func (w *thriftWriter) boolField(id int16, value bool) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.BOOL, id))
+	w.do(w.protocol.WriteBool(value))
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) i32Field(id int16, value int32) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.I32, id))
+	w.do(w.protocol.WriteI32(value))
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) i64Field(id int16, value int64) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.I64, id))
+	w.do(w.protocol.WriteI64(value))
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) binaryField(id int16, value []byte) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.STRING, id))
+	w.do(w.protocol.WriteBinary(value))
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) stringField(id int16, value string) {
+	w.binaryField(id, []byte(value))
+}
+
+func (w *thriftWriter) structField(id int16, fields func()) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.STRUCT, id))
+	w.structBody(fields)
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) structListField(id int16, size int, element func(i int)) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.LIST, id))
+	w.do(w.protocol.WriteListBegin(thrift.STRUCT, size))
+	for i := 0; i < size; i++ {
+		w.structBody(func() { element(i) })
+	}
+	w.do(w.protocol.WriteListEnd())
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) i32ListField(id int16, values ...int32) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.LIST, id))
+	w.do(w.protocol.WriteListBegin(thrift.I32, len(values)))
+	for _, value := range values {
+		w.do(w.protocol.WriteI32(value))
+	}
+	w.do(w.protocol.WriteListEnd())
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) stringListField(id int16, values ...string) {
+	w.do(w.protocol.WriteFieldBegin("", thrift.LIST, id))
+	w.do(w.protocol.WriteListBegin(thrift.STRING, len(values)))
+	for _, value := range values {
+		w.do(w.protocol.WriteString(value))
+	}
+	w.do(w.protocol.WriteListEnd())
+	w.do(w.protocol.WriteFieldEnd())
+}
+
+func (w *thriftWriter) do(err error) {
+	if w.err == nil && err != nil {
+		w.err = err
+	}
+}
+
+// readThriftStruct decodes a compact protocol struct from the beginning of data
+// and returns the number of bytes it takes
+func readThriftStruct(data []byte) (thriftStruct, int, error) {
+	buffer := &thrift.TMemoryBuffer{Buffer: bytes.NewBuffer(data)}
+	protocol := thrift.NewTCompactProtocol(buffer)
+	value, err := readStruct(protocol)
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, len(data) - buffer.Len(), nil
+}
+
+func readStruct(protocol *thrift.TCompactProtocol) (thriftStruct, error) {
+	if _, err := protocol.ReadStructBegin(); err != nil {
+		return nil, err
+	}
+	value := make(thriftStruct)
+	for {
+		_, fieldType, id, err := protocol.ReadFieldBegin()
+		if err != nil {
+			return nil, err
+		}
+		if fieldType == thrift.STOP {
+			break
+		}
+		if value[id], err = readValue(protocol, fieldType); err != nil {
+			return nil, err
+		}
+		if err := protocol.ReadFieldEnd(); err != nil {
+			return nil, err
+		}
+	}
+	return value, protocol.ReadStructEnd()
+}
+
+func readValue(protocol *thrift.TCompactProtocol, valueType thrift.TType) (interface{}, error) {
+	switch valueType {
+	case thrift.BOOL:
+		return protocol.ReadBool()
+	case thrift.BYTE:
+		return protocol.ReadByte()
+	case thrift.I16:
+		return protocol.ReadI16()
+	case thrift.I32:
+		return protocol.ReadI32()
+	case thrift.I64:
+		return protocol.ReadI64()
+	case thrift.DOUBLE:
+		return protocol.ReadDouble()
+	case thrift.STRING:
+		return protocol.ReadBinary()
+	case thrift.STRUCT:
+		return readStruct(protocol)
+	case thrift.LIST, thrift.SET:
+		elemType, size, err := protocol.ReadListBegin()
+		if err != nil {
+			return nil, err
+		}
+		// the size is not trusted for preallocation as the file may be corrupted
+		var values []interface{}
+		for i := 0; i < size; i++ {
+			value, err := readValue(protocol, elemType)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, protocol.ReadListEnd()
+	default:
+		// maps are not used by the parquet metadata
+		return nil, protocol.Skip(valueType)
+	}
+}
+
+func (s thriftStruct) i32(id int16) (int32, bool) {
+	value, ok := s[id].(int32)
+	return value, ok
+}
+
+func (s thriftStruct) i64(id int16) (int64, bool) {
+	value, ok := s[id].(int64)
+	return value, ok
+}
+
+func (s thriftStruct) binary(id int16) ([]byte, bool) {
+	value, ok := s[id].([]byte)
+	return value, ok
+}
+
+func (s thriftStruct) structValue(id int16) (thriftStruct, bool) {
+	value, ok := s[id].(thriftStruct)
+	return value, ok
+}
+
+func (s thriftStruct) structList(id int16) ([]thriftStruct, error) {
+	values, ok := s[id].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field %v is not a list", id)
+	}
+	structs := make([]thriftStruct, 0, len(values))
+	for _, value := range values {
+		element, ok := value.(thriftStruct)
+		if !ok {
+			return nil, fmt.Errorf("element of field %v is not a struct", id)
+		}
+		structs = append(structs, element)
+	}
+	return structs, nil
+}
@@ -0,0 +1,488 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package parquet implements a visibility archiver which batches the archived visibility
+// records into time partitioned parquet files, so they can be queried by external engines.
+//
+// The files are written under the archival URI with hive style partitions of the close time,
+// e.g. domain_id=<domainID>/close_date=<yyyy-mm-dd>/close_hour=<hh>/<timestamp>_<uuid>.parquet,
+// where the close_hour partition only exists if the files are partitioned by hour.
+//
+// Archive adds the record to the batch of its partition and returns after the batch is written,
+// which happens when the batch is full or the flush interval has passed. The batch is written
+// earlier if the deadline of a request in the batch comes first, leaving half of the remaining
+// time of the request for the write. A record may be written more than once if Archive is retried,
+// Query returns one record per run ID.
+//
+// Query reads all the files of the partitions within the close time range of the query,
+// so the query should specify the close time range for large domains.
+//
+// Delete removes the files of the domain written before the given time, which is the timestamp
+// prefix of the filename.
+package parquet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// PartitionDay partitions the files by the close date of the records
+	PartitionDay = "day"
+	// PartitionHour partitions the files by the close date and hour of the records
+	PartitionHour = "hour"
+
+	// defaultFlushInterval is well below the 200ms default time limit of inline archival
+	defaultFlushInterval = 50 * time.Millisecond
+	defaultMaxBatchSize  = 1000
+	flushTimeout         = time.Minute
+
+	fileExtension            = ".parquet"
+	partitionKeyDomainID     = "domain_id="
+	partitionKeyCloseDate    = "close_date="
+	partitionKeyCloseHour    = "close_hour="
+	partitionCloseDateFormat = "2006-01-02"
+
+	errWriteFile = "failed to write parquet file"
+)
+
+type (
+	// Store reads and writes the parquet files under an archival URI,
+	// the keys are slash separated paths relative to the URI
+	Store interface {
+		Put(ctx context.Context, URI archiver.URI, key string, data []byte) error
+		Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error)
+		// List returns the keys of all the files under the directory of the prefix
+		List(ctx context.Context, URI archiver.URI, prefix string) ([]string, error)
+		// Delete removes the file of the key, it's not an error if the file doesn't exist
+		Delete(ctx context.Context, URI archiver.URI, key string) error
+		ValidateURI(URI archiver.URI) error
+	}
+
+	visibilityArchiver struct {
+		sync.Mutex
+
+		container     *archiver.VisibilityBootstrapContainer
+		store         Store
+		partition     string
+		flushInterval time.Duration
+		maxBatchSize  int
+		queryParser   *queryParser
+		batches       map[batchKey]*batch
+	}
+
+	batchKey struct {
+		URI       string
+		domainID  string
+		partition string
+	}
+
+	batch struct {
+		key     batchKey
+		URI     archiver.URI
+		records []*archiver.ArchiveVisibilityRequest
+		flushAt time.Time
+		timer   *time.Timer
+		// flushed is closed after the batch is written and err is set
+		flushed chan struct{}
+		err     error
+	}
+
+	queryVisibilityToken struct {
+		LastCloseTime int64
+		LastRunID     string
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver which writes parquet files to the store
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	store Store,
+	config *config.ParquetVisibilityArchiver,
+) (archiver.VisibilityArchiver, error) {
+	v := &visibilityArchiver{
+		container:     container,
+		store:         store,
+		partition:     config.Partition,
+		flushInterval: config.FlushInterval,
+		maxBatchSize:  config.MaxBatchSize,
+		queryParser:   &queryParser{},
+		batches:       make(map[batchKey]*batch),
+	}
+	switch v.partition {
+	case "":
+		v.partition = PartitionDay
+	case PartitionDay, PartitionHour:
+	default:
+		return nil, fmt.Errorf("unknown parquet partition: %v", v.partition)
+	}
+	if v.flushInterval <= 0 {
+		v.flushInterval = defaultFlushInterval
+	}
+	if v.maxBatchSize <= 0 {
+		v.maxBatchSize = defaultMaxBatchSize
+	}
+	return v, nil
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) error {
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+
+	if err := v.ValidateURI(URI); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidURI), tag.Error(err))
+		if featureCatalog.NonRetriableError != nil {
+			return featureCatalog.NonRetriableError()
+		}
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiver.ErrReasonInvalidArchiveRequest), tag.Error(err))
+		if featureCatalog.NonRetriableError != nil {
+			return featureCatalog.NonRetriableError()
+		}
+		return err
+	}
+
+	b := v.addToBatch(ctx, URI, request)
+	select {
+	case <-b.flushed:
+		if b.err != nil {
+			logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(b.err))
+		}
+		return b.err
+	case <-ctx.Done():
+		// the record may still be written with the batch, a retry would write it again
+		logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(ctx.Err()))
+		return ctx.Err()
+	}
+}
+
+func (v *visibilityArchiver) addToBatch(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+) *batch {
+	key := batchKey{
+		URI:       URI.String(),
+		domainID:  request.DomainID,
+		partition: v.partitionKey(request.CloseTimestamp),
+	}
+	now := time.Now()
+	flushAt := now.Add(v.flushInterval)
+	if deadline, ok := ctx.Deadline(); ok {
+		if halfway := now.Add(deadline.Sub(now) / 2); halfway.Before(flushAt) {
+			flushAt = halfway
+		}
+	}
+
+	v.Lock()
+	defer v.Unlock()
+	b, ok := v.batches[key]
+	if !ok {
+		b = &batch{
+			key:     key,
+			URI:     URI,
+			flushAt: flushAt,
+			flushed: make(chan struct{}),
+		}
+		v.batches[key] = b
+		b.timer = time.AfterFunc(flushAt.Sub(now), func() { v.flush(b) })
+	} else if flushAt.Before(b.flushAt) {
+		// flush ignores the batch if it's already written, so the timer can be reset even if it has fired
+		b.flushAt = flushAt
+		b.timer.Reset(flushAt.Sub(now))
+	}
+	b.records = append(b.records, request)
+	if len(b.records) >= v.maxBatchSize {
+		delete(v.batches, key)
+		b.timer.Stop()
+		go v.write(b)
+	}
+	return b
+}
+
+func (v *visibilityArchiver) flush(b *batch) {
+	v.Lock()
+	if v.batches[b.key] != b {
+		// already written because it was full
+		v.Unlock()
+		return
+	}
+	delete(v.batches, b.key)
+	v.Unlock()
+	v.write(b)
+}
+
+func (v *visibilityArchiver) write(b *batch) {
+	defer close(b.flushed)
+	data, err := encodeVisibilityRecords(b.records)
+	if err != nil {
+		b.err = err
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+	defer cancel()
+	filename := fmt.Sprintf("%v_%v%v", time.Now().UnixNano(), uuid.New(), fileExtension)
+	b.err = v.store.Put(ctx, b.URI, strings.Join([]string{domainPrefix(b.key.domainID), b.key.partition, filename}, "/"), data)
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if err := v.ValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	var token *queryVisibilityToken
+	if request.NextPageToken != nil {
+		token = &queryVisibilityToken{}
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+		}
+		parsedQuery.latestCloseTime = common.MinInt64(parsedQuery.latestCloseTime, token.LastCloseTime)
+	}
+
+	records, err := v.readRecords(ctx, URI, request.DomainID, parsedQuery)
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].CloseTimestamp == records[j].CloseTimestamp {
+			return records[i].RunID > records[j].RunID
+		}
+		return records[i].CloseTimestamp > records[j].CloseTimestamp
+	})
+	if token != nil {
+		records = records[sort.Search(len(records), func(i int) bool {
+			if records[i].CloseTimestamp == token.LastCloseTime {
+				return records[i].RunID < token.LastRunID
+			}
+			return records[i].CloseTimestamp < token.LastCloseTime
+		}):]
+	}
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, record := range records {
+		if len(response.Executions) == request.PageSize {
+			lastRecord := records[len(response.Executions)-1]
+			nextPageToken, err := json.Marshal(&queryVisibilityToken{
+				LastCloseTime: lastRecord.CloseTimestamp,
+				LastRunID:     lastRecord.RunID,
+			})
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			response.NextPageToken = nextPageToken
+			break
+		}
+		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+	}
+	return response, nil
+}
+
+// readRecords reads the records matching the query from the partitions within its close time range
+func (v *visibilityArchiver) readRecords(
+	ctx context.Context,
+	URI archiver.URI,
+	domainID string,
+	query *parsedQuery,
+) ([]*archiver.ArchiveVisibilityRequest, error) {
+	keys, err := v.store.List(ctx, URI, domainPrefix(domainID))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*archiver.ArchiveVisibilityRequest
+	runIDs := make(map[string]struct{})
+	for _, key := range keys {
+		if !strings.HasSuffix(key, fileExtension) {
+			continue
+		}
+		start, end, err := parsePartitionRange(key)
+		if err != nil {
+			return nil, err
+		}
+		if end < query.earliestCloseTime || start > query.latestCloseTime {
+			continue
+		}
+
+		data, err := v.store.Get(ctx, URI, key)
+		if err != nil {
+			return nil, err
+		}
+		fileRecords, err := decodeVisibilityRecords(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %v: %v", key, err)
+		}
+		for _, record := range fileRecords {
+			if _, ok := runIDs[record.RunID]; ok || !query.match(record) {
+				continue
+			}
+			runIDs[record.RunID] = struct{}{}
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := v.ValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	keys, err := v.store.List(ctx, URI, domainPrefix(request.DomainID))
+	if err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	for _, key := range keys {
+		if !strings.HasSuffix(key, fileExtension) {
+			continue
+		}
+		writeTime, err := parseWriteTime(key)
+		if err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+		if writeTime >= request.ArchivedBefore {
+			continue
+		}
+		if err := v.store.Delete(ctx, URI, key); err != nil {
+			return &types.InternalServiceError{Message: err.Error()}
+		}
+	}
+	return nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	return v.store.ValidateURI(URI)
+}
+
+func (v *visibilityArchiver) partitionKey(closeTimestamp int64) string {
+	closeTime := time.Unix(0, closeTimestamp).UTC()
+	key := partitionKeyCloseDate + closeTime.Format(partitionCloseDateFormat)
+	if v.partition == PartitionHour {
+		key += fmt.Sprintf("/%v%02d", partitionKeyCloseHour, closeTime.Hour())
+	}
+	return key
+}
+
+// parsePartitionRange returns the close time range of the records of the file
+func parsePartitionRange(key string) (int64, int64, error) {
+	var start time.Time
+	duration := 24 * time.Hour
+	for _, part := range strings.Split(key, "/") {
+		switch {
+		case strings.HasPrefix(part, partitionKeyCloseDate):
+			date, err := time.Parse(partitionCloseDateFormat, strings.TrimPrefix(part, partitionKeyCloseDate))
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid partition of %v: %v", key, err)
+			}
+			start = date
+		case strings.HasPrefix(part, partitionKeyCloseHour):
+			hour, err := strconv.Atoi(strings.TrimPrefix(part, partitionKeyCloseHour))
+			if err != nil || hour < 0 || hour > 23 {
+				return 0, 0, fmt.Errorf("invalid partition of %v", key)
+			}
+			start = start.Add(time.Duration(hour) * time.Hour)
+			duration = time.Hour
+		}
+	}
+	if start.IsZero() {
+		return 0, 0, fmt.Errorf("invalid partition of %v", key)
+	}
+	return start.UnixNano(), start.Add(duration).UnixNano() - 1, nil
+}
+
+// parseWriteTime returns the time the file was written, which is the prefix of the filename
+func parseWriteTime(key string) (int64, error) {
+	filename := key[strings.LastIndex(key, "/")+1:]
+	writeTime, err := strconv.ParseInt(strings.SplitN(filename, "_", 2)[0], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid filename of %v: %v", key, err)
+	}
+	return writeTime, nil
+}
+
+func domainPrefix(domainID string) string {
+	return partitionKeyDomainID + domainID
+}
+
+func convertToExecutionInfo(record *archiver.ArchiveVisibilityRequest) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: record.WorkflowID,
+			RunID:      record.RunID,
+		},
+		Type: &types.WorkflowType{
+			Name: record.WorkflowTypeName,
+		},
+		StartTime:     common.Int64Ptr(record.StartTimestamp),
+		ExecutionTime: common.Int64Ptr(record.ExecutionTimestamp),
+		CloseTime:     common.Int64Ptr(record.CloseTimestamp),
+		CloseStatus:   record.CloseStatus.Ptr(),
+		HistoryLength: record.HistoryLength,
+		Memo:          record.Memo,
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: archiver.ConvertSearchAttrToBytes(record.SearchAttributes),
+		},
+	}
+}
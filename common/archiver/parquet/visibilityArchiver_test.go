@@ -0,0 +1,456 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package parquet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID = "test-domain-id"
+)
+
+type (
+	visibilityArchiverSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		container       *archiver.VisibilityBootstrapContainer
+		store           *memoryStore
+		testArchivalURI archiver.URI
+	}
+
+	memoryStore struct {
+		sync.Mutex
+
+		files    map[string][]byte
+		putErr   error
+		putDelay time.Duration
+	}
+)
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger: loggerimpl.NewLogger(zap.NewNop()),
+	}
+	s.store = &memoryStore{files: make(map[string][]byte)}
+	var err error
+	s.testArchivalURI, err = archiver.NewURI("file:///a/b/c")
+	s.NoError(err)
+}
+
+func (s *visibilityArchiverSuite) TestNewVisibilityArchiver_InvalidPartition() {
+	_, err := NewVisibilityArchiver(s.container, s.store, &config.ParquetVisibilityArchiver{Partition: "minute"})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 10)
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, &archiver.ArchiveVisibilityRequest{})
+	s.Error(err)
+	s.Empty(s.store.keys())
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_NonRetriableErrorOption() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 10)
+	nonRetriableErr := errors.New("some non-retryable error")
+	err := visibilityArchiver.Archive(
+		context.Background(),
+		s.testArchivalURI,
+		&archiver.ArchiveVisibilityRequest{DomainID: testDomainID},
+		archiver.GetNonRetriableErrorOption(nonRetriableErr),
+	)
+	s.Equal(nonRetriableErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_WriteError() {
+	s.store.putErr = errors.New("some transient error")
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 10)
+	err := visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newTestRecord("run-1", time.Now()))
+	s.Equal(s.store.putErr, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_ContextTimeout() {
+	s.store.putDelay = time.Second
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Hour, 10)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err := visibilityArchiver.Archive(ctx, s.testArchivalURI, s.newTestRecord("run-1", time.Now()))
+	s.Equal(context.DeadlineExceeded, err)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_FlushBeforeDeadline() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Hour, 10)
+	closeTime := time.Now()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newTestRecord("run-1", closeTime))
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// the batch is written before the deadline of the second request, instead of after the flush interval
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	s.NoError(visibilityArchiver.Archive(ctx, s.testArchivalURI, s.newTestRecord("run-2", closeTime)))
+	s.NoError(<-errs)
+	keys := s.store.keys()
+	s.Len(keys, 1)
+	records, err := decodeVisibilityRecords(s.store.files[keys[0]])
+	s.NoError(err)
+	s.Len(records, 2)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_FlushByInterval() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, 10*time.Millisecond, 1000)
+	closeTime := time.Date(2020, 2, 5, 11, 30, 0, 0, time.UTC)
+
+	s.archiveConcurrently(visibilityArchiver, 5, func(i int) *archiver.ArchiveVisibilityRequest {
+		return s.newTestRecord(fmt.Sprintf("run-%v", i), closeTime)
+	})
+
+	keys := s.store.keys()
+	s.Len(keys, 1)
+	s.True(strings.HasPrefix(keys[0], "domain_id="+testDomainID+"/close_date=2020-02-05/"))
+	s.True(strings.HasSuffix(keys[0], ".parquet"))
+	records, err := decodeVisibilityRecords(s.store.files[keys[0]])
+	s.NoError(err)
+	s.Len(records, 5)
+}
+
+func (s *visibilityArchiverSuite) TestArchive_FlushByBatchSize() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Hour, 2)
+	closeTime := time.Date(2020, 2, 5, 11, 30, 0, 0, time.UTC)
+
+	s.archiveConcurrently(visibilityArchiver, 4, func(i int) *archiver.ArchiveVisibilityRequest {
+		return s.newTestRecord(fmt.Sprintf("run-%v", i), closeTime)
+	})
+
+	keys := s.store.keys()
+	s.Len(keys, 2)
+	for _, key := range keys {
+		records, err := decodeVisibilityRecords(s.store.files[key])
+		s.NoError(err)
+		s.Len(records, 2)
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive_HourPartition() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionHour, time.Millisecond, 1000)
+
+	s.archiveConcurrently(visibilityArchiver, 2, func(i int) *archiver.ArchiveVisibilityRequest {
+		return s.newTestRecord(fmt.Sprintf("run-%v", i), time.Date(2020, 2, 5, 10+i, 30, 0, 0, time.UTC))
+	})
+
+	keys := s.store.keys()
+	s.Len(keys, 2)
+	s.True(strings.HasPrefix(keys[0], "domain_id="+testDomainID+"/close_date=2020-02-05/close_hour=10/"))
+	s.True(strings.HasPrefix(keys[1], "domain_id="+testDomainID+"/close_date=2020-02-05/close_hour=11/"))
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 1000)
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "some invalid query",
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidToken() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 1000)
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID:      testDomainID,
+		PageSize:      10,
+		NextPageToken: []byte{1, 2, 3},
+		Query:         "WorkflowID = 'wid'",
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_CorruptedFile() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 1000)
+	s.store.files["domain_id="+testDomainID+"/close_date=2020-02-05/corrupted.parquet"] = []byte("corrupted")
+	_, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "CloseTime >= '2020-02-05T00:00:00Z'",
+	})
+	s.Error(err)
+	s.IsType(&types.InternalServiceError{}, err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Filter() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 1000)
+	closeTime := time.Date(2020, 2, 5, 11, 30, 0, 0, time.UTC)
+	s.archiveConcurrently(visibilityArchiver, 4, func(i int) *archiver.ArchiveVisibilityRequest {
+		record := s.newTestRecord(fmt.Sprintf("run-%v", i), closeTime.Add(time.Duration(i)*time.Minute))
+		if i%2 == 0 {
+			record.CloseStatus = types.WorkflowExecutionCloseStatusFailed
+		}
+		return record
+	})
+
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "CloseStatus = 'failed' and CloseTime >= '2020-02-05T00:00:00Z'",
+	})
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Len(response.Executions, 2)
+	s.Equal("run-2", response.Executions[0].Execution.GetRunID())
+	s.Equal("run-0", response.Executions[1].Execution.GetRunID())
+	s.Equal(types.WorkflowExecutionCloseStatusFailed, response.Executions[0].GetCloseStatus())
+	s.Equal("test-workflow-type", response.Executions[0].Type.GetName())
+	s.Equal(closeTime.Add(2*time.Minute).UnixNano(), response.Executions[0].GetCloseTime())
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Success_Pagination() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 3)
+	closeTime := time.Date(2020, 2, 5, 11, 30, 0, 0, time.UTC)
+	s.archiveConcurrently(visibilityArchiver, 7, func(i int) *archiver.ArchiveVisibilityRequest {
+		// records on two days with duplicated close times
+		return s.newTestRecord(fmt.Sprintf("run-%v", i), closeTime.Add(time.Duration(i/2)*12*time.Hour))
+	})
+	// a retried archival writes a duplicate record
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.testArchivalURI, s.newTestRecord("run-0", closeTime)))
+
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 3,
+		Query:    "CloseTime >= '2020-02-01T00:00:00Z'",
+	}
+	var runIDs []string
+	for {
+		response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, request)
+		s.NoError(err)
+		s.True(len(response.Executions) <= 3)
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.Execution.GetRunID())
+		}
+		if response.NextPageToken == nil {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	s.Equal([]string{"run-6", "run-5", "run-4", "run-3", "run-2", "run-1", "run-0"}, runIDs)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_PrunePartitions() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 1000)
+	s.archiveConcurrently(visibilityArchiver, 2, func(i int) *archiver.ArchiveVisibilityRequest {
+		return s.newTestRecord(fmt.Sprintf("run-%v", i), time.Date(2020, 2, 5+i, 11, 30, 0, 0, time.UTC))
+	})
+	// files of partitions outside the close time range of the query are not read
+	for key := range s.store.files {
+		if strings.Contains(key, "close_date=2020-02-05") {
+			s.store.files[key] = []byte("corrupted")
+		}
+	}
+
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "CloseTime >= '2020-02-06T00:00:00Z'",
+	})
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal("run-1", response.Executions[0].Execution.GetRunID())
+}
+
+func (s *visibilityArchiverSuite) TestDelete() {
+	visibilityArchiver := s.newTestVisibilityArchiver(PartitionDay, time.Millisecond, 1000)
+	s.archiveConcurrently(visibilityArchiver, 1, func(i int) *archiver.ArchiveVisibilityRequest {
+		return s.newTestRecord("run-old", time.Date(2020, 2, 5, 11, 30, 0, 0, time.UTC))
+	})
+	archivedBefore := time.Now().UnixNano()
+	s.archiveConcurrently(visibilityArchiver, 1, func(i int) *archiver.ArchiveVisibilityRequest {
+		return s.newTestRecord("run-new", time.Date(2020, 2, 5, 11, 30, 0, 0, time.UTC))
+	})
+	s.Len(s.store.keys(), 2)
+
+	err := visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID: testDomainID,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	err = visibilityArchiver.Delete(context.Background(), s.testArchivalURI, &archiver.DeleteVisibilityRequest{
+		DomainID:       testDomainID,
+		ArchivedBefore: archivedBefore,
+	})
+	s.NoError(err)
+	s.Len(s.store.keys(), 1)
+
+	response, err := visibilityArchiver.Query(context.Background(), s.testArchivalURI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "CloseTime >= '2020-02-05T00:00:00Z'",
+	})
+	s.NoError(err)
+	s.Len(response.Executions, 1)
+	s.Equal("run-new", response.Executions[0].Execution.GetRunID())
+}
+
+func (s *visibilityArchiverSuite) TestParsePartitionRange() {
+	start, end, err := parsePartitionRange("domain_id=some-id/close_date=2020-02-05/close_hour=11/1_uuid.parquet")
+	s.NoError(err)
+	s.Equal(time.Date(2020, 2, 5, 11, 0, 0, 0, time.UTC).UnixNano(), start)
+	s.Equal(time.Date(2020, 2, 5, 12, 0, 0, 0, time.UTC).UnixNano()-1, end)
+
+	start, end, err = parsePartitionRange("domain_id=some-id/close_date=2020-02-05/1_uuid.parquet")
+	s.NoError(err)
+	s.Equal(time.Date(2020, 2, 5, 0, 0, 0, 0, time.UTC).UnixNano(), start)
+	s.Equal(time.Date(2020, 2, 6, 0, 0, 0, 0, time.UTC).UnixNano()-1, end)
+
+	_, _, err = parsePartitionRange("domain_id=some-id/1_uuid.parquet")
+	s.Error(err)
+	_, _, err = parsePartitionRange("domain_id=some-id/close_date=2020-02-05/close_hour=24/1_uuid.parquet")
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) archiveConcurrently(
+	visibilityArchiver archiver.VisibilityArchiver,
+	count int,
+	record func(i int) *archiver.ArchiveVisibilityRequest,
+) {
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(request *archiver.ArchiveVisibilityRequest) {
+			defer wg.Done()
+			errs <- visibilityArchiver.Archive(context.Background(), s.testArchivalURI, request)
+		}(record(i))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.NoError(err)
+	}
+}
+
+func (s *visibilityArchiverSuite) newTestVisibilityArchiver(
+	partition string,
+	flushInterval time.Duration,
+	maxBatchSize int,
+) archiver.VisibilityArchiver {
+	visibilityArchiver, err := NewVisibilityArchiver(s.container, s.store, &config.ParquetVisibilityArchiver{
+		Partition:     partition,
+		FlushInterval: flushInterval,
+		MaxBatchSize:  maxBatchSize,
+	})
+	s.NoError(err)
+	return visibilityArchiver
+}
+
+func (s *visibilityArchiverSuite) newTestRecord(runID string, closeTime time.Time) *archiver.ArchiveVisibilityRequest {
+	return &archiver.ArchiveVisibilityRequest{
+		DomainID:         testDomainID,
+		DomainName:       "test-domain-name",
+		WorkflowID:       "test-workflow-id",
+		RunID:            runID,
+		WorkflowTypeName: "test-workflow-type",
+		StartTimestamp:   closeTime.Add(-time.Hour).UnixNano(),
+		CloseTimestamp:   closeTime.UnixNano(),
+		CloseStatus:      types.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:    10,
+	}
+}
+
+func (m *memoryStore) Put(_ context.Context, _ archiver.URI, key string, data []byte) error {
+	time.Sleep(m.putDelay)
+	m.Lock()
+	defer m.Unlock()
+	if m.putErr != nil {
+		return m.putErr
+	}
+	m.files[key] = data
+	return nil
+}
+
+func (m *memoryStore) Get(_ context.Context, _ archiver.URI, key string) ([]byte, error) {
+	m.Lock()
+	defer m.Unlock()
+	data, ok := m.files[key]
+	if !ok {
+		return nil, &types.EntityNotExistsError{Message: key}
+	}
+	return data, nil
+}
+
+func (m *memoryStore) List(_ context.Context, _ archiver.URI, prefix string) ([]string, error) {
+	var keys []string
+	for _, key := range m.keys() {
+		if strings.HasPrefix(key, prefix+"/") {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+func (m *memoryStore) Delete(_ context.Context, _ archiver.URI, key string) error {
+	m.Lock()
+	defer m.Unlock()
+	delete(m.files, key)
+	return nil
+}
+
+func (m *memoryStore) ValidateURI(URI archiver.URI) error {
+	if URI.Scheme() != "file" {
+		return archiver.ErrURISchemeMismatch
+	}
+	return nil
+}
+
+func (m *memoryStore) keys() []string {
+	m.Lock()
+	defer m.Unlock()
+	keys := make([]string, 0, len(m.files))
+	for key := range m.files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
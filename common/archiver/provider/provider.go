@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/archiver/gcloud"
 
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/azblob"
 	"github.com/uber/cadence/common/archiver/filestore"
 	"github.com/uber/cadence/common/archiver/s3store"
 	"github.com/uber/cadence/common/config"
@@ -131,6 +132,20 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 	if !ok {
 		return nil, ErrBootstrapContainerNotFound
 	}
+	if container.Codec == nil && p.historyArchiverConfigs != nil {
+		codec, err := archiver.NewCodec(p.historyArchiverConfigs.Codec, nil)
+		if err != nil {
+			return nil, err
+		}
+		containerWithCodec := *container
+		containerWithCodec.Codec = codec
+		container = &containerWithCodec
+	}
+	if !container.EnableIndex && p.historyArchiverConfigs != nil && p.historyArchiverConfigs.EnableIndex {
+		containerWithIndex := *container
+		containerWithIndex.EnableIndex = true
+		container = &containerWithIndex
+	}
 
 	switch scheme {
 	case filestore.URIScheme:
@@ -151,6 +166,12 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 			return nil, ErrArchiverConfigNotFound
 		}
 		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+
+	case azblob.URIScheme:
+		if p.historyArchiverConfigs.Azblob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		historyArchiver, err = azblob.NewHistoryArchiver(container, p.historyArchiverConfigs.Azblob)
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -190,17 +211,30 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 		if p.visibilityArchiverConfigs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		visibilityArchiver, err = filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore)
+		if p.visibilityArchiverConfigs.Parquet != nil {
+			visibilityArchiver, err = filestore.NewParquetVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore, p.visibilityArchiverConfigs.Parquet)
+		} else {
+			visibilityArchiver, err = filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore)
+		}
 	case s3store.URIScheme:
 		if p.visibilityArchiverConfigs.S3store == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		visibilityArchiver, err = s3store.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.S3store)
+		if p.visibilityArchiverConfigs.Parquet != nil {
+			visibilityArchiver, err = s3store.NewParquetVisibilityArchiver(container, p.visibilityArchiverConfigs.S3store, p.visibilityArchiverConfigs.Parquet)
+		} else {
+			visibilityArchiver, err = s3store.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.S3store)
+		}
 	case gcloud.URIScheme:
 		if p.visibilityArchiverConfigs.Gstorage == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
 		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+	case azblob.URIScheme:
+		if p.visibilityArchiverConfigs.Azblob == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = azblob.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Azblob)
 
 	default:
 		return nil, ErrUnknownScheme
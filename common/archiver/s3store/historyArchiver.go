@@ -20,6 +20,11 @@
 
 // S3 History Archiver will archive workflow histories to amazon s3
 
+// If indexing is enabled, Archive() also writes an entry object for the archived workflow run under
+// path/domainID/history-index/field/hash(value)/ for each of the signal names, activity types and
+// failure reasons found in the history, the entries are uploaded concurrently. The Search() method
+// walks the entries of the first term in the query and probes the other terms.
+
 package s3store
 
 import (
@@ -32,10 +37,9 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
-	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -44,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/s3util"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -52,8 +57,12 @@ const (
 	URIScheme               = "s3"
 	errEncodeHistory        = "failed to encode history batches"
 	errWriteKey             = "failed to write history to s3"
+	errWriteIndex           = "failed to write history index to s3"
 	defaultBlobstoreTimeout = 60 * time.Second
 	targetHistoryBlobSize   = 2 * 1024 * 1024 // 2MB
+
+	// historyIndexUploadConcurrency is the number of index entries of a history written at the same time
+	historyIndexUploadConcurrency = 16
 )
 
 var (
@@ -66,6 +75,7 @@ type (
 	historyArchiver struct {
 		container *archiver.HistoryBootstrapContainer
 		s3cli     s3iface.S3API
+		codec     archiver.Codec
 		// only set in test code
 		historyIterator archiver.HistoryIterator
 	}
@@ -99,19 +109,19 @@ func newHistoryArchiver(
 	if len(config.Region) == 0 {
 		return nil, errEmptyAwsRegion
 	}
-	s3Config := &aws.Config{
-		Endpoint:         config.Endpoint,
-		Region:           aws.String(config.Region),
-		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
-	}
-	sess, err := session.NewSession(s3Config)
+	sess, err := s3util.NewSession(config)
 	if err != nil {
 		return nil, err
 	}
 
+	codec := container.Codec
+	if codec == nil {
+		codec = archiver.NewDefaultCodec()
+	}
 	return &historyArchiver{
 		container:       container,
 		s3cli:           s3.New(sess),
+		codec:           codec,
 		historyIterator: historyIterator,
 	}, nil
 }
@@ -127,7 +137,7 @@ func (h *historyArchiver) Archive(
 	defer func() {
 		sw.Stop()
 		if err != nil {
-			if persistence.IsTransientError(err) || isRetryableError(err) {
+			if persistence.IsTransientError(err) || s3util.IsRetryableError(err) {
 				scope.IncCounter(metrics.HistoryArchiverArchiveTransientErrorCount)
 			} else {
 				scope.IncCounter(metrics.HistoryArchiverArchiveNonRetryableErrorCount)
@@ -187,13 +197,19 @@ func (h *historyArchiver) Archive(
 			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
 			return err
 		}
+		historySize := int64(binary.Size(encodedHistoryBlob))
+		encodedHistoryBlob, err = h.codec.Encode(encodedHistoryBlob)
+		if err != nil {
+			logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeHistory), tag.Error(err))
+			return err
+		}
 
 		key := constructHistoryKey(URI.Path(), request.DomainID, request.WorkflowID, request.RunID, request.CloseFailoverVersion, progress.BatchIdx)
 
 		exists, err := keyExists(ctx, h.s3cli, URI, key)
 		if err != nil {
 			logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-			if isRetryableError(err) {
+			if s3util.IsRetryableError(err) {
 				logger.Error(archiver.ArchiveTransientErrorMsg)
 			} else {
 				logger.Error(archiver.ArchiveNonRetriableErrorMsg)
@@ -206,7 +222,7 @@ func (h *historyArchiver) Archive(
 		} else {
 			if err := upload(ctx, h.s3cli, URI, key, encodedHistoryBlob); err != nil {
 				logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteKey), tag.Error(err))
-				if isRetryableError(err) {
+				if s3util.IsRetryableError(err) {
 					logger.Error(archiver.ArchiveTransientErrorMsg)
 				} else {
 					logger.Error(archiver.ArchiveNonRetriableErrorMsg)
@@ -217,7 +233,19 @@ func (h *historyArchiver) Archive(
 			scope.RecordTimer(metrics.HistoryArchiverBlobSize, time.Duration(blobSize))
 		}
 
-		progress.historySize += blobSize
+		if h.container.EnableIndex {
+			if err := h.writeHistoryIndex(ctx, URI, request, historyBlob.Body); err != nil {
+				logger := logger.WithTags(tag.ArchivalArchiveFailReason(errWriteIndex), tag.Error(err))
+				if s3util.IsRetryableError(err) {
+					logger.Error(archiver.ArchiveTransientErrorMsg)
+				} else {
+					logger.Error(archiver.ArchiveNonRetriableErrorMsg)
+				}
+				return err
+			}
+		}
+
+		progress.historySize += historySize
 		progress.BatchIdx = progress.BatchIdx + 1
 		saveHistoryIteratorState(ctx, featureCatalog, historyIterator, &progress)
 	}
@@ -228,6 +256,50 @@ func (h *historyArchiver) Archive(
 	return nil
 }
 
+func (h *historyArchiver) writeHistoryIndex(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveHistoryRequest,
+	historyBatches []*types.History,
+) error {
+	entry := &archiver.HistoryIndexEntry{
+		WorkflowID: request.WorkflowID,
+		RunID:      request.RunID,
+	}
+	data, err := encode(entry)
+	if err != nil {
+		return err
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	uploads := make(chan struct{}, historyIndexUploadConcurrency)
+	for _, term := range archiver.ExtractHistoryIndexTerms(historyBatches) {
+		key := constructHistoryIndexTermPrefix(URI.Path(), request.DomainID, term) + entry.Name()
+		uploads <- struct{}{}
+		g.Go(func() error {
+			defer func() { <-uploads }()
+			return upload(ctx, h.s3cli, URI, key, data)
+		})
+	}
+	return g.Wait()
+}
+
+// historyIndexReader reads the history index of a domain in the bucket of the URI
+type historyIndexReader struct {
+	ctx      context.Context
+	s3cli    s3iface.S3API
+	URI      archiver.URI
+	domainID string
+}
+
+func (r *historyIndexReader) ListEntries(term archiver.HistoryIndexTerm, after string, limit int) ([]string, bool, error) {
+	prefix := constructHistoryIndexTermPrefix(r.URI.Path(), r.domainID, term)
+	return listObjectNames(r.ctx, r.s3cli, r.URI, prefix, after, limit)
+}
+
+func (r *historyIndexReader) EntryExists(term archiver.HistoryIndexTerm, name string) (bool, error) {
+	return keyExists(r.ctx, r.s3cli, r.URI, constructHistoryIndexTermPrefix(r.URI.Path(), r.domainID, term)+name)
+}
+
 func loadHistoryIterator(ctx context.Context, request *archiver.ArchiveHistoryRequest, historyManager persistence.HistoryManager, featureCatalog *archiver.ArchiveFeatureCatalog, progress *uploadProgress) (historyIterator archiver.HistoryIterator) {
 	if featureCatalog.ProgressManager != nil {
 		if featureCatalog.ProgressManager.HasProgress(ctx) {
@@ -308,7 +380,7 @@ func (h *historyArchiver) Get(
 
 		encodedRecord, err := download(ctx, h.s3cli, URI, key)
 		if err != nil {
-			if isRetryableError(err) {
+			if s3util.IsRetryableError(err) {
 				return nil, &types.InternalServiceError{Message: err.Error()}
 			}
 			switch err.(type) {
@@ -319,6 +391,10 @@ func (h *historyArchiver) Get(
 			}
 		}
 
+		encodedRecord, err = h.codec.Decode(encodedRecord)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
 		historyBlob, err := decodeHistoryBlob(encodedRecord)
 		if err != nil {
 			return nil, &types.InternalServiceError{Message: err.Error()}
@@ -346,6 +422,78 @@ func (h *historyArchiver) Get(
 	return response, nil
 }
 
+func (h *historyArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteHistoryRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteHistoryRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	prefix := constructHistoryDomainPrefix(URI.Path(), request.DomainID)
+	if err := deleteObjectsModifiedBefore(ctx, h.s3cli, URI, prefix, time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	prefix = constructHistoryIndexDomainPrefix(URI.Path(), request.DomainID)
+	if err := deleteObjectsModifiedBefore(ctx, h.s3cli, URI, prefix, time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
+func (h *historyArchiver) Search(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.SearchHistoryRequest,
+) (*archiver.SearchHistoryResponse, error) {
+	if err := softValidateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateSearchHistoryRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	terms, err := archiver.ParseHistorySearchQuery(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+
+	reader := &historyIndexReader{ctx: ctx, s3cli: h.s3cli, URI: URI, domainID: request.DomainID}
+	names, nextPageToken, err := archiver.SearchHistoryIndex(reader, terms, request.PageSize, request.NextPageToken)
+	if err != nil {
+		if err == archiver.ErrNextPageTokenCorrupted {
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	// all the entries of a run are the same, they are read from the prefix of the first term
+	prefix := constructHistoryIndexTermPrefix(URI.Path(), request.DomainID, terms[0])
+	response := &archiver.SearchHistoryResponse{NextPageToken: nextPageToken}
+	for _, name := range names {
+		data, err := download(ctx, h.s3cli, URI, prefix+name)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				// the entry was deleted after being listed
+				continue
+			}
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		entry, err := decodeHistoryIndexEntry(data)
+		if err != nil {
+			return nil, &types.InternalServiceError{Message: err.Error()}
+		}
+		response.Executions = append(response.Executions, entry)
+	}
+	return response, nil
+}
+
 func (h *historyArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
@@ -411,28 +559,3 @@ func (h *historyArchiver) getHighestVersion(ctx context.Context, URI archiver.UR
 	}
 	return highestVersion, nil
 }
-
-func isRetryableError(err error) bool {
-	if err == nil {
-		return false
-	}
-	if aerr, ok := err.(awserr.Error); ok {
-		return isStatusCodeRetryable(aerr) || request.IsErrorRetryable(aerr) || request.IsErrorThrottle(aerr)
-	}
-	return false
-}
-
-func isStatusCodeRetryable(err error) bool {
-	if aerr, ok := err.(awserr.Error); ok {
-		if rerr, ok := err.(awserr.RequestFailure); ok {
-			if rerr.StatusCode() == 429 {
-				return true
-			}
-			if rerr.StatusCode() >= 500 && rerr.StatusCode() != 501 {
-				return true
-			}
-		}
-		return isStatusCodeRetryable(aerr.OrigErr())
-	}
-	return false
-}
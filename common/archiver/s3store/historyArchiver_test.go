@@ -29,6 +29,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -106,20 +107,34 @@ func (s *historyArchiverSuite) SetupTest() {
 
 func setupFsEmulation(s3cli *mocks.S3API) {
 	fs := make(map[string][]byte)
+	// the history index entries are uploaded concurrently
+	var mu sync.Mutex
 
 	putObjectFn := func(_ aws.Context, input *s3.PutObjectInput, _ ...request.Option) *s3.PutObjectOutput {
 		buf := new(bytes.Buffer)
 		buf.ReadFrom(input.Body)
+		mu.Lock()
+		defer mu.Unlock()
 		fs[*input.Bucket+*input.Key] = buf.Bytes()
 		return &s3.PutObjectOutput{}
 	}
 	getObjectFn := func(_ aws.Context, input *s3.GetObjectInput, _ ...request.Option) *s3.GetObjectOutput {
+		mu.Lock()
+		defer mu.Unlock()
 		return &s3.GetObjectOutput{
 			Body: ioutil.NopCloser(bytes.NewReader(fs[*input.Bucket+*input.Key])),
 		}
 	}
+	exists := func(key string) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		_, ok := fs[key]
+		return ok
+	}
 	s3cli.On("ListObjectsV2WithContext", mock.Anything, mock.Anything).
 		Return(func(_ context.Context, input *s3.ListObjectsV2Input, opts ...request.Option) *s3.ListObjectsV2Output {
+			mu.Lock()
+			defer mu.Unlock()
 			objects := make([]*s3.Object, 0)
 			commonPrefixMap := map[string]bool{}
 			for k := range fs {
@@ -199,14 +214,12 @@ func setupFsEmulation(s3cli *mocks.S3API) {
 	s3cli.On("PutObjectWithContext", mock.Anything, mock.Anything).Return(putObjectFn, nil)
 
 	s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
-		_, ok := fs[*input.Bucket+*input.Key]
-		return !ok
+		return !exists(*input.Bucket + *input.Key)
 	})).Return(nil, awserr.New("NotFound", "", nil))
 	s3cli.On("HeadObjectWithContext", mock.Anything, mock.Anything).Return(&s3.HeadObjectOutput{}, nil)
 
 	s3cli.On("GetObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.GetObjectInput) bool {
-		_, ok := fs[*input.Bucket+*input.Key]
-		return !ok
+		return !exists(*input.Bucket + *input.Key)
 	})).Return(nil, awserr.New(s3.ErrCodeNoSuchKey, "", nil))
 	s3cli.On("GetObjectWithContext", mock.Anything, mock.Anything).Return(getObjectFn, nil)
 }
@@ -495,6 +508,78 @@ func (s *historyArchiverSuite) TestArchive_Success() {
 	s.assertKeyExists(expectedkey)
 }
 
+func (s *historyArchiverSuite) TestArchiveAndSearch() {
+	mockCtrl := gomock.NewController(s.T())
+	defer mockCtrl.Finish()
+	historyIterator := archiver.NewMockHistoryIterator(mockCtrl)
+	var events []*types.HistoryEvent
+	for i := 0; i < 2*historyIndexUploadConcurrency; i++ {
+		events = append(events, &types.HistoryEvent{
+			ID:        common.FirstEventID + int64(i),
+			EventType: types.EventTypeActivityTaskScheduled.Ptr(),
+			Version:   testCloseFailoverVersion,
+			ActivityTaskScheduledEventAttributes: &types.ActivityTaskScheduledEventAttributes{
+				ActivityType: &types.ActivityType{Name: fmt.Sprintf("activity-%v", i)},
+			},
+		})
+	}
+	events = append(events, &types.HistoryEvent{
+		ID:        common.FirstEventID + int64(len(events)),
+		EventType: types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		Version:   testCloseFailoverVersion,
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{
+			SignalName: "cancel",
+		},
+	})
+	historyBlob := &archiver.HistoryBlob{
+		Header: &archiver.HistoryBlobHeader{
+			IsLast: common.BoolPtr(true),
+		},
+		Body: []*types.History{{Events: events}},
+	}
+	gomock.InOrder(
+		historyIterator.EXPECT().HasNext().Return(true),
+		historyIterator.EXPECT().Next().Return(historyBlob, nil),
+		historyIterator.EXPECT().HasNext().Return(false),
+	)
+
+	s.container.EnableIndex = true
+	historyArchiver := s.newTestHistoryArchiver(historyIterator)
+	request := &archiver.ArchiveHistoryRequest{
+		DomainID:             testDomainID,
+		DomainName:           testDomainName,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          common.FirstEventID + int64(len(events)),
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}
+	URI, err := archiver.NewURI(testBucketURI + "/TestArchiveAndSearch")
+	s.NoError(err)
+	err = historyArchiver.Archive(context.Background(), URI, request)
+	s.NoError(err)
+
+	searchRequest := &archiver.SearchHistoryRequest{
+		DomainID: testDomainID,
+		Query:    "SignalName = 'cancel' and ActivityType = 'activity-7'",
+		PageSize: testPageSize,
+	}
+	response, err := historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.NoError(err)
+	s.Nil(response.NextPageToken)
+	s.Equal([]*archiver.HistoryIndexEntry{{WorkflowID: testWorkflowID, RunID: testRunID}}, response.Executions)
+
+	searchRequest.Query = "SignalName = 'cancel' and FailureReason = 'card declined'"
+	response, err = historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.NoError(err)
+	s.Empty(response.Executions)
+
+	searchRequest.Query = "SignalName = 'cancel'"
+	searchRequest.NextPageToken = []byte("invalid token")
+	_, err = historyArchiver.Search(context.Background(), URI, searchRequest)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *historyArchiverSuite) TestGet_Fail_InvalidURI() {
 	historyArchiver := s.newTestHistoryArchiver(nil)
 	request := &archiver.GetHistoryRequest{
@@ -670,6 +755,7 @@ func (s *historyArchiverSuite) newTestHistoryArchiver(historyIterator archiver.H
 	archiver := &historyArchiver{
 		container:       s.container,
 		s3cli:           s.s3cli,
+		codec:           archiver.NewDefaultCodec(),
 		historyIterator: historyIterator,
 	}
 	return archiver
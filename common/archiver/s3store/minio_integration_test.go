@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build archivalintegration
+// +build archivalintegration
+
+// to run locally, make sure minio is running, e.g. with docker/dev/archival-emulators.yml,
+// then run cmd `go test -v ./common/archiver/s3store -run TestMinioSuite -tags archivalintegration`
+// set MINIO_CA_FILE to the CA certificate of minio to test with TLS
+package s3store
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/s3util"
+	"github.com/uber/cadence/common/types"
+)
+
+type minioSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	s3cli *s3.S3
+	URI   archiver.URI
+}
+
+func TestMinioSuite(t *testing.T) {
+	suite.Run(t, new(minioSuite))
+}
+
+func (s *minioSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+	endpoint := os.Getenv("MINIO_ENDPOINT")
+	if endpoint == "" {
+		endpoint = "http://127.0.0.1:9000"
+	}
+	if os.Getenv("AWS_ACCESS_KEY_ID") == "" {
+		os.Setenv("AWS_ACCESS_KEY_ID", "minioadmin")
+		os.Setenv("AWS_SECRET_ACCESS_KEY", "minioadmin")
+	}
+
+	cfg := &config.S3Archiver{
+		Region:           "us-east-1",
+		Endpoint:         aws.String(endpoint),
+		S3ForcePathStyle: true,
+	}
+	if caFile := os.Getenv("MINIO_CA_FILE"); caFile != "" {
+		cfg.TLS = config.TLS{
+			Enabled:                true,
+			CaFile:                 caFile,
+			EnableHostVerification: true,
+		}
+	}
+	sess, err := s3util.NewSession(cfg)
+	s.Require().NoError(err)
+	s.s3cli = s3.New(sess)
+
+	bucket := fmt.Sprintf("cadence-test-%v", time.Now().UnixNano())
+	_, err = s.s3cli.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)})
+	s.Require().NoError(err)
+	s.URI, err = archiver.NewURI("s3://" + bucket + "/archival")
+	s.Require().NoError(err)
+}
+
+func (s *minioSuite) TestBucketExists() {
+	s.NoError(bucketExists(context.Background(), s.s3cli, s.URI))
+
+	URI, err := archiver.NewURI("s3://missing-bucket")
+	s.NoError(err)
+	s.Equal(errBucketNotExists, bucketExists(context.Background(), s.s3cli, URI))
+}
+
+func (s *minioSuite) TestUploadAndDownload() {
+	ctx := context.Background()
+	key := constructHistoryKey(s.URI.Path(), testDomainID, testWorkflowID, testRunID, testCloseFailoverVersion, 0)
+
+	exists, err := keyExists(ctx, s.s3cli, s.URI, key)
+	s.NoError(err)
+	s.False(exists)
+	_, err = download(ctx, s.s3cli, s.URI, key)
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	s.NoError(upload(ctx, s.s3cli, s.URI, key, []byte("data")))
+
+	exists, err = keyExists(ctx, s.s3cli, s.URI, key)
+	s.NoError(err)
+	s.True(exists)
+	data, err := download(ctx, s.s3cli, s.URI, key)
+	s.NoError(err)
+	s.Equal([]byte("data"), data)
+}
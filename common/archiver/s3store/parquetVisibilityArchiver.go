@@ -0,0 +1,102 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/parquet"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/s3util"
+)
+
+type (
+	parquetStore struct {
+		s3cli s3iface.S3API
+	}
+)
+
+// NewParquetVisibilityArchiver creates a new archiver.VisibilityArchiver which writes
+// the visibility records as parquet files to s3
+func NewParquetVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.S3Archiver,
+	parquetConfig *config.ParquetVisibilityArchiver,
+) (archiver.VisibilityArchiver, error) {
+	sess, err := s3util.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+	return parquet.NewVisibilityArchiver(container, &parquetStore{s3cli: s3.New(sess)}, parquetConfig)
+}
+
+func (s *parquetStore) Put(ctx context.Context, URI archiver.URI, key string, data []byte) error {
+	return upload(ctx, s.s3cli, URI, constructParquetKey(URI.Path(), key), data)
+}
+
+func (s *parquetStore) Get(ctx context.Context, URI archiver.URI, key string) ([]byte, error) {
+	return download(ctx, s.s3cli, URI, constructParquetKey(URI.Path(), key))
+}
+
+func (s *parquetStore) List(ctx context.Context, URI archiver.URI, prefix string) ([]string, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+
+	basePrefix := constructParquetKey(URI.Path(), "")
+	var keys []string
+	err := s.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(URI.Hostname()),
+		Prefix: aws.String(constructParquetKey(URI.Path(), prefix) + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			keys = append(keys, strings.TrimPrefix(*object.Key, basePrefix))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *parquetStore) Delete(ctx context.Context, URI archiver.URI, key string) error {
+	return deleteObject(ctx, s.s3cli, URI, constructParquetKey(URI.Path(), key))
+}
+
+func (s *parquetStore) ValidateURI(URI archiver.URI) error {
+	err := softValidateURI(URI)
+	if err != nil {
+		return err
+	}
+	return bucketExists(context.TODO(), s.s3cli, URI)
+}
+
+// constructParquetKey returns the s3 key of the parquet file key relative to the path,
+// or the prefix of all the parquet file keys if the key is empty
+func constructParquetKey(path, key string) string {
+	return strings.TrimLeft(strings.Join([]string{path, key}, "/"), "/")
+}
@@ -25,12 +25,10 @@ package s3store
 import (
 	"errors"
 	"fmt"
-	"strconv"
-	"time"
-
-	"github.com/xwb1989/sqlparser"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/definition"
 )
 
 type (
@@ -47,15 +45,18 @@ type (
 		startTime        *int64
 		closeTime        *int64
 		searchPrecision  *string
+		// filter contains the conditions of the query which are not part of the search prefix
+		filter *archiver.VisibilityQuery
 	}
 )
 
-// All allowed fields for filtering
+// All allowed fields for filtering, any other field is a custom search attribute.
+// The query must have WorkflowID or WorkflowTypeName in its top level and expression.
 const (
-	WorkflowTypeName = "WorkflowTypeName"
-	WorkflowID       = "WorkflowID"
-	StartTime        = "StartTime"
-	CloseTime        = "CloseTime"
+	WorkflowTypeName = archiver.VisibilityQueryWorkflowTypeName
+	WorkflowID       = definition.WorkflowID
+	StartTime        = definition.StartTime
+	CloseTime        = definition.CloseTime
 	SearchPrecision  = "SearchPrecision"
 )
 
@@ -66,10 +67,6 @@ const (
 	PrecisionMinute = "Minute"
 	PrecisionSecond = "Second"
 )
-const (
-	queryTemplate         = "select * from dummy where %s"
-	defaultDateTimeFormat = time.RFC3339
-)
 
 // NewQueryParser creates a new query parser for filestore
 func NewQueryParser() QueryParser {
@@ -77,21 +74,35 @@ func NewQueryParser() QueryParser {
 }
 
 func (p *queryParser) Parse(query string) (*parsedQuery, error) {
-	stmt, err := sqlparser.Parse(fmt.Sprintf(queryTemplate, query))
+	filter, err := archiver.ParseVisibilityQuery(query)
 	if err != nil {
 		return nil, err
 	}
-	whereExpr := stmt.(*sqlparser.Select).Where.Expr
-	parsedQuery := &parsedQuery{}
-	if err := p.convertWhereExpr(whereExpr, parsedQuery); err != nil {
+	parsedQuery := &parsedQuery{
+		filter: filter,
+	}
+	if parsedQuery.searchPrecision, err = removeSearchPrecision(filter); err != nil {
+		return nil, err
+	}
+	if parsedQuery.workflowID, err = removeStringCondition(filter, WorkflowID); err != nil {
+		return nil, err
+	}
+	if parsedQuery.workflowID == nil {
+		// the workflow type is checked by the filter if the records are searched by workflow ID
+		if parsedQuery.workflowTypeName, err = removeStringCondition(filter, definition.WorkflowType); err != nil {
+			return nil, err
+		}
+	}
+	if parsedQuery.closeTime, err = removeTimeCondition(filter, CloseTime); err != nil {
+		return nil, err
+	}
+	if parsedQuery.startTime, err = removeTimeCondition(filter, StartTime); err != nil {
 		return nil, err
 	}
+
 	if parsedQuery.workflowID == nil && parsedQuery.workflowTypeName == nil {
 		return nil, errors.New("WorkflowID or WorkflowTypeName is required in query")
 	}
-	if parsedQuery.workflowID != nil && parsedQuery.workflowTypeName != nil {
-		return nil, errors.New("only one of WorkflowID or WorkflowTypeName can be specified in a query")
-	}
 	if parsedQuery.closeTime != nil && parsedQuery.startTime != nil {
 		return nil, errors.New("only one of StartTime or CloseTime can be specified in a query")
 	}
@@ -105,100 +116,19 @@ func (p *queryParser) Parse(query string) (*parsedQuery, error) {
 	return parsedQuery, nil
 }
 
-func (p *queryParser) convertWhereExpr(expr sqlparser.Expr, parsedQuery *parsedQuery) error {
-	if expr == nil {
-		return errors.New("where expression is nil")
-	}
-
-	switch expr := expr.(type) {
-	case *sqlparser.ComparisonExpr:
-		return p.convertComparisonExpr(expr, parsedQuery)
-	case *sqlparser.AndExpr:
-		return p.convertAndExpr(expr, parsedQuery)
-	case *sqlparser.ParenExpr:
-		return p.convertParenExpr(expr, parsedQuery)
-	default:
-		return errors.New("only comparison and \"and\" expression is supported")
-	}
-}
-
-func (p *queryParser) convertParenExpr(parenExpr *sqlparser.ParenExpr, parsedQuery *parsedQuery) error {
-	return p.convertWhereExpr(parenExpr.Expr, parsedQuery)
-}
-
-func (p *queryParser) convertAndExpr(andExpr *sqlparser.AndExpr, parsedQuery *parsedQuery) error {
-	if err := p.convertWhereExpr(andExpr.Left, parsedQuery); err != nil {
-		return err
-	}
-	return p.convertWhereExpr(andExpr.Right, parsedQuery)
-}
-
-func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr, parsedQuery *parsedQuery) error {
-	colName, ok := compExpr.Left.(*sqlparser.ColName)
-	if !ok {
-		return fmt.Errorf("invalid filter name: %s", sqlparser.String(compExpr.Left))
-	}
-	colNameStr := sqlparser.String(colName)
-	op := compExpr.Operator
-	valExpr, ok := compExpr.Right.(*sqlparser.SQLVal)
-	if !ok {
-		return fmt.Errorf("invalid value: %s", sqlparser.String(compExpr.Right))
-	}
-	valStr := sqlparser.String(valExpr)
-
-	switch colNameStr {
-	case WorkflowTypeName:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", WorkflowTypeName)
-		}
-		if parsedQuery.workflowTypeName != nil {
-			return fmt.Errorf("can not query %s multiple times", WorkflowTypeName)
-		}
-		parsedQuery.workflowTypeName = common.StringPtr(val)
-	case WorkflowID:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", WorkflowID)
-		}
-		if parsedQuery.workflowID != nil {
-			return fmt.Errorf("can not query %s multiple times", WorkflowID)
-		}
-		parsedQuery.workflowID = common.StringPtr(val)
-	case CloseTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", CloseTime)
-		}
-		parsedQuery.closeTime = &timestamp
-	case StartTime:
-		timestamp, err := convertToTimestamp(valStr)
-		if err != nil {
-			return err
+// removeSearchPrecision removes the top level search precision condition, which is not a field of the records
+func removeSearchPrecision(filter *archiver.VisibilityQuery) (*string, error) {
+	var searchPrecision *string
+	for _, condition := range filter.RemoveConditions(SearchPrecision) {
+		val, ok := condition.Values[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid value for %s: %v", SearchPrecision, condition.Values[0])
 		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", StartTime)
+		if condition.Operator != "=" {
+			return nil, fmt.Errorf("only operator = is supported for %s with Amazon S3", SearchPrecision)
 		}
-		parsedQuery.startTime = &timestamp
-	case SearchPrecision:
-		val, err := extractStringValue(valStr)
-		if err != nil {
-			return err
-		}
-		if op != "=" {
-			return fmt.Errorf("only operator = is supported for %s with Amazon S3", SearchPrecision)
-		}
-		if parsedQuery.searchPrecision != nil && *parsedQuery.searchPrecision != val {
-			return fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
+		if searchPrecision != nil && *searchPrecision != val {
+			return nil, fmt.Errorf("only one expression is allowed for %s", SearchPrecision)
 		}
 		switch val {
 		case PrecisionDay:
@@ -206,36 +136,39 @@ func (p *queryParser) convertComparisonExpr(compExpr *sqlparser.ComparisonExpr,
 		case PrecisionMinute:
 		case PrecisionSecond:
 		default:
-			return fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
+			return nil, fmt.Errorf("invalid value for %s: %s", SearchPrecision, val)
 		}
-		parsedQuery.searchPrecision = common.StringPtr(val)
-
-	default:
-		return fmt.Errorf("unknown filter name: %s", colNameStr)
+		searchPrecision = common.StringPtr(val)
 	}
-
-	return nil
+	if filter.HasField(SearchPrecision) {
+		return nil, fmt.Errorf("%s is only supported in the top level and expression", SearchPrecision)
+	}
+	return searchPrecision, nil
 }
 
-func convertToTimestamp(timeStr string) (int64, error) {
-	timestamp, err := strconv.ParseInt(timeStr, 10, 64)
-	if err == nil {
-		return timestamp, nil
-	}
-	timestampStr, err := extractStringValue(timeStr)
-	if err != nil {
-		return 0, err
-	}
-	parsedTime, err := time.Parse(defaultDateTimeFormat, timestampStr)
-	if err != nil {
-		return 0, err
+// removeStringCondition removes the top level equality condition on the field used as the search prefix
+func removeStringCondition(filter *archiver.VisibilityQuery, field string) (*string, error) {
+	conditions := filter.RemoveConditions(field, "=")
+	switch len(conditions) {
+	case 0:
+		return nil, nil
+	case 1:
+		return common.StringPtr(conditions[0].Values[0].(string)), nil
+	default:
+		return nil, fmt.Errorf("can not query %s multiple times", field)
 	}
-	return parsedTime.UnixNano(), nil
 }
 
-func extractStringValue(s string) (string, error) {
-	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
-		return s[1 : len(s)-1], nil
+// removeTimeCondition removes the top level equality condition on the time field,
+// which matches the records within the search precision of the time
+func removeTimeCondition(filter *archiver.VisibilityQuery, field string) (*int64, error) {
+	conditions := filter.RemoveConditions(field, "=")
+	switch len(conditions) {
+	case 0:
+		return nil, nil
+	case 1:
+		return common.Int64Ptr(conditions[0].Values[0].(int64)), nil
+	default:
+		return nil, fmt.Errorf("can not query %s multiple times", field)
 	}
-	return "", fmt.Errorf("value %s is not a string value", s)
 }
@@ -67,6 +67,20 @@ func (s *queryParserSuite) TestParseWorkflowIDAndWorkflowTypeName() {
 		},
 		{
 			query:     "WorkflowID = \"random workflowID\" and WorkflowTypeName = \"random workflowTypeName\"",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowID: common.StringPtr("random workflowID"),
+			},
+		},
+		{
+			query:     "WorkflowTypeName = \"random workflowTypeName\" and (RunID = \"random runID\" or CustomKeywordField in ('keyword', 'another keyword'))",
+			expectErr: false,
+			parsedQuery: &parsedQuery{
+				workflowTypeName: common.StringPtr("random workflowTypeName"),
+			},
+		},
+		{
+			query:     "WorkflowID = \"random workflowID\" and (SearchPrecision = 'Day' or RunID = \"random runID\")",
 			expectErr: true,
 		},
 		{
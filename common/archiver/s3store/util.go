@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common"
 
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/s3util"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -55,6 +56,16 @@ func decodeHistoryBlob(data []byte) (*archiver.HistoryBlob, error) {
 	}
 	return historyBlob, nil
 }
+
+func decodeHistoryIndexEntry(data []byte) (*archiver.HistoryIndexEntry, error) {
+	entry := &archiver.HistoryIndexEntry{}
+	err := json.Unmarshal(data, entry)
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
 func decodeVisibilityRecord(data []byte) (*visibilityRecord, error) {
 	record := &visibilityRecord{}
 	err := json.Unmarshal(data, record)
@@ -105,7 +116,7 @@ func bucketExists(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI) er
 	if err == nil {
 		return nil
 	}
-	if isNotFoundError(err) {
+	if s3util.IsNotFoundError(err) {
 		return errBucketNotExists
 	}
 	return err
@@ -119,7 +130,7 @@ func keyExists(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key s
 		Key:    aws.String(key),
 	})
 	if err != nil {
-		if isNotFoundError(err) {
+		if s3util.IsNotFoundError(err) {
 			return false, nil
 		}
 		return false, err
@@ -127,9 +138,73 @@ func keyExists(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key s
 	return true, nil
 }
 
-func isNotFoundError(err error) bool {
-	aerr, ok := err.(awserr.Error)
-	return ok && (aerr.Code() == "NotFound")
+// deleteObjectsModifiedBefore deletes the objects with the prefix that were last modified before the given time
+func deleteObjectsModifiedBefore(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, prefix string, before time.Time) error {
+	var deleteErr error
+	err := s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(URI.Hostname()),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		var objects []*s3.ObjectIdentifier
+		for _, object := range page.Contents {
+			if object.LastModified != nil && object.LastModified.Before(before) {
+				objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+			}
+		}
+		if len(objects) == 0 {
+			return true
+		}
+		output, err := s3cli.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(URI.Hostname()),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err == nil && len(output.Errors) > 0 {
+			err = fmt.Errorf("failed to delete %v: %v", aws.StringValue(output.Errors[0].Key), aws.StringValue(output.Errors[0].Message))
+		}
+		deleteErr = err
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	return deleteErr
+}
+
+// listObjectNames returns at most limit names of the objects with the prefix which sort after the given name,
+// relative to the prefix, and whether there are more
+func listObjectNames(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, prefix, after string, limit int) ([]string, bool, error) {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(URI.Hostname()),
+		Prefix:  aws.String(prefix),
+		MaxKeys: aws.Int64(int64(limit)),
+	}
+	if after != "" {
+		input.StartAfter = aws.String(prefix + after)
+	}
+	output, err := s3cli.ListObjectsV2WithContext(ctx, input)
+	if err != nil {
+		return nil, false, err
+	}
+	names := make([]string, 0, len(output.Contents))
+	for _, object := range output.Contents {
+		names = append(names, strings.TrimPrefix(aws.StringValue(object.Key), prefix))
+	}
+	return names, aws.BoolValue(output.IsTruncated), nil
+}
+
+func deleteObject(ctx context.Context, s3cli s3iface.S3API, URI archiver.URI, key string) error {
+	ctx, cancel := ensureContextTimeout(ctx)
+	defer cancel()
+	_, err := s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(URI.Hostname()),
+		Key:    aws.String(key),
+	})
+	return err
 }
 
 // Key construction
@@ -147,6 +222,22 @@ func constructHistoryKeyPrefix(path, domainID, workflowID, runID string) string
 	return strings.TrimLeft(strings.Join([]string{path, domainID, "history", workflowID, runID}, "/"), "/")
 }
 
+func constructHistoryDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "history"}, "/"), "/") + "/"
+}
+
+func constructHistoryIndexDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "history-index"}, "/"), "/") + "/"
+}
+
+func constructHistoryIndexTermPrefix(path, domainID string, term archiver.HistoryIndexTerm) string {
+	return constructHistoryIndexDomainPrefix(path, domainID) + term.Path() + "/"
+}
+
+func constructVisibilityDomainPrefix(path, domainID string) string {
+	return strings.TrimLeft(strings.Join([]string{path, domainID, "visibility"}, "/"), "/") + "/"
+}
+
 func constructTimeBasedSearchKey(path, domainID, primaryIndexKey, primaryIndexValue, secondaryIndexKey string, timestamp int64, precision string) string {
 	t := time.Unix(0, timestamp).In(time.UTC)
 	var timeFormat = ""
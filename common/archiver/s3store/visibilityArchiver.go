@@ -22,17 +22,18 @@ package s3store
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common/metrics"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/s3util"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -79,12 +80,7 @@ func NewVisibilityArchiver(
 func newVisibilityArchiver(
 	container *archiver.VisibilityBootstrapContainer,
 	config *config.S3Archiver) (*visibilityArchiver, error) {
-	s3Config := &aws.Config{
-		Endpoint:         config.Endpoint,
-		Region:           aws.String(config.Region),
-		S3ForcePathStyle: aws.Bool(config.S3ForcePathStyle),
-	}
-	sess, err := session.NewSession(s3Config)
+	sess, err := s3util.NewSession(config)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +105,7 @@ func (v *visibilityArchiver) Archive(
 	defer func() {
 		sw.Stop()
 		if err != nil {
-			if isRetryableError(err) {
+			if s3util.IsRetryableError(err) {
 				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
 				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
 			} else {
@@ -209,41 +205,73 @@ func (v *visibilityArchiver) query(
 		prefix = constructTimeBasedSearchKey(URI.Path(), request.domainID, primaryIndex, *primaryIndexValue, secondaryIndexKeyStartTimeout, *request.parsedQuery.startTime, *request.parsedQuery.searchPrecision)
 	}
 
-	results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
-		Bucket:            aws.String(URI.Hostname()),
-		Prefix:            aws.String(prefix),
-		MaxKeys:           aws.Int64(int64(request.pageSize)),
-		ContinuationToken: token,
-	})
-	if err != nil {
-		if isRetryableError(err) {
-			return nil, &types.InternalServiceError{Message: err.Error()}
-		}
-		return nil, &types.BadRequestError{Message: err.Error()}
-	}
-	if len(results.Contents) == 0 {
-		return &archiver.QueryVisibilityResponse{}, nil
-	}
-
 	response := &archiver.QueryVisibilityResponse{}
-	if *results.IsTruncated {
-		response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
-	}
-	for _, item := range results.Contents {
-		encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+	// the records filtered out are not counted in the page size, so a page may have fewer records,
+	// keep listing until the page has a record, there are no more keys or the max number of pages is read
+	for page := 0; len(response.Executions) == 0 && page < archiver.QueryVisibilityMaxPages; page++ {
+		results, err := v.s3cli.ListObjectsV2WithContext(ctx, &s3.ListObjectsV2Input{
+			Bucket:            aws.String(URI.Hostname()),
+			Prefix:            aws.String(prefix),
+			MaxKeys:           aws.Int64(int64(request.pageSize)),
+			ContinuationToken: token,
+		})
 		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+			if s3util.IsRetryableError(err) {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			return nil, &types.BadRequestError{Message: err.Error()}
+		}
+		if len(results.Contents) == 0 {
+			return &archiver.QueryVisibilityResponse{}, nil
 		}
 
-		record, err := decodeVisibilityRecord(encodedRecord)
-		if err != nil {
-			return nil, &types.InternalServiceError{Message: err.Error()}
+		response.NextPageToken = nil
+		if *results.IsTruncated {
+			response.NextPageToken = serializeQueryVisibilityToken(*results.NextContinuationToken)
 		}
-		response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		for _, item := range results.Contents {
+			encodedRecord, err := download(ctx, v.s3cli, URI, *item.Key)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+
+			record, err := decodeVisibilityRecord(encodedRecord)
+			if err != nil {
+				return nil, &types.InternalServiceError{Message: err.Error()}
+			}
+			if request.parsedQuery.filter != nil && !request.parsedQuery.filter.Match((*archiver.ArchiveVisibilityRequest)(record)) {
+				continue
+			}
+			response.Executions = append(response.Executions, convertToExecutionInfo(record))
+		}
+		if !*results.IsTruncated {
+			break
+		}
+		token = results.NextContinuationToken
 	}
 	return response, nil
 }
 
+func (v *visibilityArchiver) Delete(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.DeleteVisibilityRequest,
+) error {
+	if err := softValidateURI(URI); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateDeleteVisibilityRequest(request); err != nil {
+		return &types.BadRequestError{Message: archiver.ErrInvalidDeleteRequest.Error()}
+	}
+
+	prefix := constructVisibilityDomainPrefix(URI.Path(), request.DomainID)
+	if err := deleteObjectsModifiedBefore(ctx, v.s3cli, URI, prefix, time.Unix(0, request.ArchivedBefore)); err != nil {
+		return &types.InternalServiceError{Message: err.Error()}
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	err := softValidateURI(URI)
 	if err != nil {
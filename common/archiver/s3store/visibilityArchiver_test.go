@@ -332,6 +332,51 @@ func (s *visibilityArchiverSuite) TestQuery_Success_SmallPageSize() {
 	s.Equal(convertToExecutionInfo(s.visibilityRecords[2]), response.Executions[0])
 }
 
+func (s *visibilityArchiverSuite) TestQuery_Success_MaxPages() {
+	visibilityArchiver := s.newTestVisibilityArchiver()
+	workflowID := "max-pages-workflow-id"
+	for i := 0; i <= archiver.QueryVisibilityMaxPages; i++ {
+		s.writeVisibilityRecordForQueryTest(visibilityArchiver, &visibilityRecord{
+			DomainID:         testDomainID,
+			DomainName:       testDomainName,
+			WorkflowID:       workflowID,
+			RunID:            fmt.Sprintf("%s-%d", testRunID, i),
+			WorkflowTypeName: "max-pages-workflow-type",
+			StartTimestamp:   1,
+			CloseTimestamp:   int64(1 * time.Hour),
+			CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+			HistoryLength:    101,
+		})
+	}
+	filter, err := archiver.ParseVisibilityQuery("HistoryLength = 1")
+	s.NoError(err)
+	mockParser := NewMockQueryParser(s.controller)
+	mockParser.EXPECT().Parse(gomock.Any()).Return(&parsedQuery{
+		workflowID: common.StringPtr(workflowID),
+		filter:     filter,
+	}, nil).AnyTimes()
+	visibilityArchiver.queryParser = mockParser
+	request := &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 1,
+		Query:    "parsed by mockParser",
+	}
+	URI, err := archiver.NewURI(testBucketURI)
+	s.NoError(err)
+	response, err := visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.NotNil(response.NextPageToken)
+	s.Empty(response.Executions)
+
+	request.NextPageToken = response.NextPageToken
+	response, err = visibilityArchiver.Query(context.Background(), URI, request)
+	s.NoError(err)
+	s.NotNil(response)
+	s.Nil(response.NextPageToken)
+	s.Empty(response.Executions)
+}
+
 type precisionTest struct {
 	day       int64
 	hour      int64
@@ -38,6 +38,7 @@ var (
 	errEmptyStartTime        = errors.New("StartTimestamp is empty")
 	errEmptyCloseTime        = errors.New("CloseTimestamp is empty")
 	errEmptyQuery            = errors.New("Query string is empty")
+	errEmptyArchivedBefore   = errors.New("ArchivedBefore is empty")
 )
 
 // TagLoggerWithArchiveHistoryRequestAndURI tags logger with fields in the archive history request and the URI
@@ -143,6 +144,28 @@ func ValidateQueryRequest(request *QueryVisibilityRequest) error {
 	return nil
 }
 
+// ValidateDeleteHistoryRequest validates the delete archived history request
+func ValidateDeleteHistoryRequest(request *DeleteHistoryRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.ArchivedBefore == 0 {
+		return errEmptyArchivedBefore
+	}
+	return nil
+}
+
+// ValidateDeleteVisibilityRequest validates the delete archived visibility request
+func ValidateDeleteVisibilityRequest(request *DeleteVisibilityRequest) error {
+	if request.DomainID == "" {
+		return errEmptyDomainID
+	}
+	if request.ArchivedBefore == 0 {
+		return errEmptyArchivedBefore
+	}
+	return nil
+}
+
 // ConvertSearchAttrToBytes converts search attribute value from string back to byte array
 func ConvertSearchAttrToBytes(searchAttrStr map[string]string) map[string][]byte {
 	searchAttr := make(map[string][]byte)
@@ -0,0 +1,712 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+// VisibilityQueryWorkflowTypeName is an alias of the WorkflowType field in visibility queries
+const VisibilityQueryWorkflowTypeName = "WorkflowTypeName"
+
+const (
+	visibilityQueryTemplate       = "select * from dummy where %s"
+	visibilityQueryDateTimeFormat = time.RFC3339
+)
+
+const (
+	queryFieldTypeString queryFieldType = iota
+	queryFieldTypeInt
+	queryFieldTypeTime
+	queryFieldTypeCloseStatus
+	queryFieldTypeSearchAttribute
+)
+
+type (
+	// VisibilityQuery is a query of archived visibility records parsed by ParseVisibilityQuery.
+	// It supports the same subset of SQL as advanced visibility: and, or, parentheses,
+	// comparison, in, like and between on the system fields and custom search attributes.
+	VisibilityQuery struct {
+		// Filter is nil if the query has no condition left
+		Filter QueryExpr
+	}
+
+	// QueryExpr is an expression of a visibility query
+	QueryExpr interface {
+		// Match returns true if the record satisfies the expression
+		Match(record *ArchiveVisibilityRequest) bool
+	}
+
+	// AndQueryExpr matches the records which satisfy all of its expressions
+	AndQueryExpr struct {
+		Exprs []QueryExpr
+	}
+
+	// OrQueryExpr matches the records which satisfy any of its expressions
+	OrQueryExpr struct {
+		Exprs []QueryExpr
+	}
+
+	// QueryCondition compares a field of the records with the values.
+	// Values of WorkflowID, RunID and WorkflowType are strings, values of the other system fields
+	// are int64, including the times in unix nanoseconds and the close status.
+	// Values of the custom search attributes are string, int64, float64 or bool.
+	QueryCondition struct {
+		Field    string
+		Operator string
+		Values   []interface{}
+
+		fieldType queryFieldType
+		pattern   *regexp.Regexp
+	}
+
+	queryFieldType int
+)
+
+var queryFieldTypes = map[string]queryFieldType{
+	definition.WorkflowID:    queryFieldTypeString,
+	definition.RunID:         queryFieldTypeString,
+	definition.WorkflowType:  queryFieldTypeString,
+	definition.StartTime:     queryFieldTypeTime,
+	definition.ExecutionTime: queryFieldTypeTime,
+	definition.CloseTime:     queryFieldTypeTime,
+	definition.CloseStatus:   queryFieldTypeCloseStatus,
+	definition.HistoryLength: queryFieldTypeInt,
+}
+
+// ParseVisibilityQuery parses the where clause of a visibility query.
+// Fields other than the system fields are custom search attributes, which are matched with the
+// JSON encoded values in ArchiveVisibilityRequest.SearchAttributes, and records without the
+// search attribute only match the negative conditions, e.g. != and not in.
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	stmt, err := sqlparser.Parse(fmt.Sprintf(visibilityQueryTemplate, query))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return nil, errors.New("invalid query")
+	}
+	if len(sel.OrderBy) != 0 || sel.Limit != nil {
+		return nil, errors.New("order by and limit are not supported")
+	}
+	filter, err := convertQueryExpr(sel.Where.Expr)
+	if err != nil {
+		return nil, err
+	}
+	return &VisibilityQuery{Filter: filter}, nil
+}
+
+// Match returns true if the record matches the query
+func (q *VisibilityQuery) Match(record *ArchiveVisibilityRequest) bool {
+	return q.Filter == nil || q.Filter.Match(record)
+}
+
+// Conditions returns the conditions on the field in the top level and expression of the query,
+// which are satisfied by all the records matching the query
+func (q *VisibilityQuery) Conditions(field string) []*QueryCondition {
+	var conditions []*QueryCondition
+	for _, expr := range q.conjuncts() {
+		if condition, ok := expr.(*QueryCondition); ok && condition.Field == field {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+// RemoveConditions removes the conditions on the field with any of the operators from the top level
+// and expression of the query, so the archiver can apply them in its own way, e.g. as a key prefix.
+// Conditions with any operator are removed if no operator is given.
+func (q *VisibilityQuery) RemoveConditions(field string, operators ...string) []*QueryCondition {
+	var removed []*QueryCondition
+	var remaining []QueryExpr
+	for _, expr := range q.conjuncts() {
+		if condition, ok := expr.(*QueryCondition); ok && condition.Field == field &&
+			(len(operators) == 0 || containsString(operators, condition.Operator)) {
+			removed = append(removed, condition)
+			continue
+		}
+		remaining = append(remaining, expr)
+	}
+	switch len(remaining) {
+	case 0:
+		q.Filter = nil
+	case 1:
+		q.Filter = remaining[0]
+	default:
+		q.Filter = &AndQueryExpr{Exprs: remaining}
+	}
+	return removed
+}
+
+// HasField returns true if any condition of the query is on the field
+func (q *VisibilityQuery) HasField(field string) bool {
+	return hasQueryField(q.Filter, field)
+}
+
+// TimeRange returns the inclusive range of the time field required by the top level and expression
+// of the query, which is [0, math.MaxInt64] if the query has no such condition
+func (q *VisibilityQuery) TimeRange(field string) (int64, int64) {
+	earliest, latest := int64(0), int64(math.MaxInt64)
+	for _, condition := range q.Conditions(field) {
+		values := make([]int64, 0, len(condition.Values))
+		for _, value := range condition.Values {
+			if timestamp, ok := value.(int64); ok {
+				values = append(values, timestamp)
+			}
+		}
+		if len(values) != len(condition.Values) || len(values) == 0 {
+			continue
+		}
+		switch condition.Operator {
+		case sqlparser.EqualStr:
+			earliest, latest = common.MaxInt64(earliest, values[0]), common.MinInt64(latest, values[0])
+		case sqlparser.LessThanStr:
+			latest = common.MinInt64(latest, values[0]-1)
+		case sqlparser.LessEqualStr:
+			latest = common.MinInt64(latest, values[0])
+		case sqlparser.GreaterThanStr:
+			earliest = common.MaxInt64(earliest, values[0]+1)
+		case sqlparser.GreaterEqualStr:
+			earliest = common.MaxInt64(earliest, values[0])
+		case sqlparser.BetweenStr:
+			earliest, latest = common.MaxInt64(earliest, values[0]), common.MinInt64(latest, values[1])
+		}
+	}
+	return earliest, latest
+}
+
+func (q *VisibilityQuery) conjuncts() []QueryExpr {
+	switch filter := q.Filter.(type) {
+	case nil:
+		return nil
+	case *AndQueryExpr:
+		return filter.Exprs
+	default:
+		return []QueryExpr{filter}
+	}
+}
+
+// Match implements QueryExpr
+func (e *AndQueryExpr) Match(record *ArchiveVisibilityRequest) bool {
+	for _, expr := range e.Exprs {
+		if !expr.Match(record) {
+			return false
+		}
+	}
+	return true
+}
+
+// Match implements QueryExpr
+func (e *OrQueryExpr) Match(record *ArchiveVisibilityRequest) bool {
+	for _, expr := range e.Exprs {
+		if expr.Match(record) {
+			return true
+		}
+	}
+	return false
+}
+
+// Match implements QueryExpr
+func (c *QueryCondition) Match(record *ArchiveVisibilityRequest) bool {
+	var values []interface{}
+	switch c.Field {
+	case definition.WorkflowID:
+		values = []interface{}{record.WorkflowID}
+	case definition.RunID:
+		values = []interface{}{record.RunID}
+	case definition.WorkflowType:
+		values = []interface{}{record.WorkflowTypeName}
+	case definition.StartTime:
+		values = []interface{}{record.StartTimestamp}
+	case definition.ExecutionTime:
+		values = []interface{}{record.ExecutionTimestamp}
+	case definition.CloseTime:
+		values = []interface{}{record.CloseTimestamp}
+	case definition.CloseStatus:
+		values = []interface{}{int64(record.CloseStatus)}
+	case definition.HistoryLength:
+		values = []interface{}{record.HistoryLength}
+	default:
+		if encoded, ok := record.SearchAttributes[c.Field]; ok {
+			values = decodeSearchAttributeValues(encoded)
+		}
+	}
+
+	// search attributes with multiple values match if any of the values matches
+	matched := false
+	for _, value := range values {
+		if c.matchValue(value) {
+			matched = true
+			break
+		}
+	}
+	if isNegativeOperator(c.Operator) {
+		return !matched
+	}
+	return matched
+}
+
+// matchValue returns true if the value satisfies the condition without its negation
+func (c *QueryCondition) matchValue(value interface{}) bool {
+	switch c.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+		for _, conditionValue := range c.Values {
+			if result, ok := compareQueryValues(value, conditionValue); ok && result == 0 {
+				return true
+			}
+		}
+		return false
+	case sqlparser.LikeStr, sqlparser.NotLikeStr:
+		s, ok := value.(string)
+		return ok && c.pattern.MatchString(s)
+	case sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		from, ok := compareQueryValues(value, c.Values[0])
+		if !ok || from < 0 {
+			return false
+		}
+		to, ok := compareQueryValues(value, c.Values[1])
+		return ok && to <= 0
+	}
+
+	result, ok := compareQueryValues(value, c.Values[0])
+	if !ok {
+		return false
+	}
+	switch c.Operator {
+	case sqlparser.LessThanStr:
+		return result < 0
+	case sqlparser.LessEqualStr:
+		return result <= 0
+	case sqlparser.GreaterThanStr:
+		return result > 0
+	case sqlparser.GreaterEqualStr:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+func convertQueryExpr(expr sqlparser.Expr) (QueryExpr, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := convertQueryExprs(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		and := &AndQueryExpr{}
+		for _, e := range []QueryExpr{left, right} {
+			if nested, ok := e.(*AndQueryExpr); ok {
+				and.Exprs = append(and.Exprs, nested.Exprs...)
+			} else {
+				and.Exprs = append(and.Exprs, e)
+			}
+		}
+		return and, nil
+	case *sqlparser.OrExpr:
+		left, right, err := convertQueryExprs(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		or := &OrQueryExpr{}
+		for _, e := range []QueryExpr{left, right} {
+			if nested, ok := e.(*OrQueryExpr); ok {
+				or.Exprs = append(or.Exprs, nested.Exprs...)
+			} else {
+				or.Exprs = append(or.Exprs, e)
+			}
+		}
+		return or, nil
+	case *sqlparser.ParenExpr:
+		return convertQueryExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return convertRangeCond(expr)
+	case nil:
+		return nil, errors.New("where expression is nil")
+	default:
+		return nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func convertQueryExprs(left, right sqlparser.Expr) (QueryExpr, QueryExpr, error) {
+	leftExpr, err := convertQueryExpr(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightExpr, err := convertQueryExpr(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftExpr, rightExpr, nil
+}
+
+func convertComparisonExpr(expr *sqlparser.ComparisonExpr) (QueryExpr, error) {
+	condition, err := newQueryCondition(expr.Left, expr.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	var valueExprs sqlparser.Exprs
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr,
+		sqlparser.LikeStr, sqlparser.NotLikeStr:
+		valueExprs = sqlparser.Exprs{expr.Right}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid values: %s", sqlparser.String(expr.Right))
+		}
+		valueExprs = sqlparser.Exprs(tuple)
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+	if err := condition.convertValues(valueExprs); err != nil {
+		return nil, err
+	}
+
+	if expr.Operator == sqlparser.LikeStr || expr.Operator == sqlparser.NotLikeStr {
+		pattern, ok := condition.Values[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("value of %s must be a string for operator %s", condition.Field, expr.Operator)
+		}
+		if condition.pattern, err = compileLikePattern(pattern); err != nil {
+			return nil, err
+		}
+	}
+	return condition, nil
+}
+
+func convertRangeCond(expr *sqlparser.RangeCond) (QueryExpr, error) {
+	condition, err := newQueryCondition(expr.Left, expr.Operator)
+	if err != nil {
+		return nil, err
+	}
+	if err := condition.convertValues(sqlparser.Exprs{expr.From, expr.To}); err != nil {
+		return nil, err
+	}
+	return condition, nil
+}
+
+func newQueryCondition(left sqlparser.Expr, operator string) (*QueryCondition, error) {
+	colName, ok := left.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter name: %s", sqlparser.String(left))
+	}
+	field := sqlparser.String(colName)
+	if field == VisibilityQueryWorkflowTypeName {
+		field = definition.WorkflowType
+	}
+
+	fieldType, ok := queryFieldTypes[field]
+	if !ok {
+		if definition.IsSystemIndexedKey(field) {
+			return nil, fmt.Errorf("filter %s is not supported for archived workflows", field)
+		}
+		// field names are case sensitive, reject the misspelled system fields instead of
+		// treating them as search attributes which never match
+		for systemField := range queryFieldTypes {
+			if strings.EqualFold(field, systemField) {
+				return nil, fmt.Errorf("unknown filter name: %s", field)
+			}
+		}
+		if strings.EqualFold(field, VisibilityQueryWorkflowTypeName) {
+			return nil, fmt.Errorf("unknown filter name: %s", field)
+		}
+		fieldType = queryFieldTypeSearchAttribute
+	}
+
+	switch {
+	case fieldType == queryFieldTypeCloseStatus &&
+		operator != sqlparser.EqualStr && operator != sqlparser.NotEqualStr &&
+		operator != sqlparser.InStr && operator != sqlparser.NotInStr:
+		return nil, fmt.Errorf("operator %s is not supported for %s", operator, field)
+	case (fieldType == queryFieldTypeInt || fieldType == queryFieldTypeTime) &&
+		(operator == sqlparser.LikeStr || operator == sqlparser.NotLikeStr):
+		return nil, fmt.Errorf("operator %s is not supported for %s", operator, field)
+	}
+	return &QueryCondition{
+		Field:     field,
+		Operator:  operator,
+		fieldType: fieldType,
+	}, nil
+}
+
+func (c *QueryCondition) convertValues(exprs sqlparser.Exprs) error {
+	for _, expr := range exprs {
+		value, err := convertQueryValue(expr)
+		if err != nil {
+			return err
+		}
+		switch c.fieldType {
+		case queryFieldTypeString:
+			if _, ok := value.(string); !ok {
+				return fmt.Errorf("value of %s must be a string: %s", c.Field, sqlparser.String(expr))
+			}
+		case queryFieldTypeInt:
+			if _, ok := value.(int64); !ok {
+				return fmt.Errorf("value of %s must be an integer: %s", c.Field, sqlparser.String(expr))
+			}
+		case queryFieldTypeTime:
+			if value, err = convertQueryTimeValue(value); err != nil {
+				return err
+			}
+		case queryFieldTypeCloseStatus:
+			if value, err = convertQueryCloseStatusValue(value); err != nil {
+				return err
+			}
+		}
+		c.Values = append(c.Values, value)
+	}
+	return nil
+}
+
+func convertQueryValue(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(expr.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	}
+	return nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr))
+}
+
+// convertQueryTimeValue converts unix nanoseconds or RFC3339 strings to unix nanoseconds
+func convertQueryTimeValue(value interface{}) (int64, error) {
+	switch value := value.(type) {
+	case int64:
+		return value, nil
+	case string:
+		parsedTime, err := time.Parse(visibilityQueryDateTimeFormat, value)
+		if err != nil {
+			return 0, err
+		}
+		return parsedTime.UnixNano(), nil
+	default:
+		return 0, fmt.Errorf("invalid time value: %v", value)
+	}
+}
+
+func convertQueryCloseStatusValue(value interface{}) (int64, error) {
+	var status types.WorkflowExecutionCloseStatus
+	switch value := value.(type) {
+	case int64:
+		status = types.WorkflowExecutionCloseStatus(value)
+	case string:
+		// accept the status names without underscores used by the previous query parsers
+		switch normalized := strings.ToUpper(strings.TrimSpace(value)); normalized {
+		case "CONTINUEDASNEW":
+			status = types.WorkflowExecutionCloseStatusContinuedAsNew
+		case "TIMEDOUT":
+			status = types.WorkflowExecutionCloseStatusTimedOut
+		default:
+			if err := status.UnmarshalText([]byte(normalized)); err != nil {
+				return 0, fmt.Errorf("unknown workflow close status: %s", value)
+			}
+		}
+	default:
+		return 0, fmt.Errorf("unknown workflow close status: %v", value)
+	}
+	if status < types.WorkflowExecutionCloseStatusCompleted || status > types.WorkflowExecutionCloseStatusTimedOut {
+		return 0, fmt.Errorf("unknown workflow close status: %v", value)
+	}
+	return int64(status), nil
+}
+
+// compileLikePattern converts a like pattern, where % matches any string, _ matches any character
+// and \ escapes the next character, to a regular expression
+func compileLikePattern(pattern string) (*regexp.Regexp, error) {
+	var expr strings.Builder
+	expr.WriteString("(?s)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			expr.WriteString(".*")
+		case r == '_':
+			expr.WriteString(".")
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// decodeSearchAttributeValues decodes the JSON encoded search attribute value,
+// arrays are decoded to their elements
+func decodeSearchAttributeValues(encoded string) []interface{} {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(encoded)))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return []interface{}{encoded}
+	}
+	if array, ok := decoded.([]interface{}); ok {
+		values := make([]interface{}, 0, len(array))
+		for _, element := range array {
+			values = append(values, convertJSONValue(element))
+		}
+		return values
+	}
+	return []interface{}{convertJSONValue(decoded)}
+}
+
+func convertJSONValue(value interface{}) interface{} {
+	number, ok := value.(json.Number)
+	if !ok {
+		return value
+	}
+	if i, err := number.Int64(); err == nil {
+		return i
+	}
+	if f, err := number.Float64(); err == nil {
+		return f
+	}
+	return number.String()
+}
+
+// compareQueryValues compares values of the same kind, ok is false if they are not comparable
+func compareQueryValues(a, b interface{}) (result int, ok bool) {
+	switch a := a.(type) {
+	case string:
+		b, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(a, b), true
+	case bool:
+		b, ok := b.(bool)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case a == b:
+			return 0, true
+		case b:
+			return -1, true
+		default:
+			return 1, true
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			switch {
+			case a < b:
+				return -1, true
+			case a > b:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+		return compareFloats(float64(a), b)
+	case float64:
+		return compareFloats(a, b)
+	default:
+		return 0, false
+	}
+}
+
+func compareFloats(a float64, b interface{}) (int, bool) {
+	var f float64
+	switch b := b.(type) {
+	case int64:
+		f = float64(b)
+	case float64:
+		f = b
+	default:
+		return 0, false
+	}
+	switch {
+	case a < f:
+		return -1, true
+	case a > f:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+func hasQueryField(expr QueryExpr, field string) bool {
+	switch expr := expr.(type) {
+	case *AndQueryExpr:
+		for _, e := range expr.Exprs {
+			if hasQueryField(e, field) {
+				return true
+			}
+		}
+	case *OrQueryExpr:
+		for _, e := range expr.Exprs {
+			if hasQueryField(e, field) {
+				return true
+			}
+		}
+	case *QueryCondition:
+		return expr.Field == field
+	}
+	return false
+}
+
+func isNegativeOperator(operator string) bool {
+	switch operator {
+	case sqlparser.NotEqualStr, sqlparser.NotInStr, sqlparser.NotLikeStr, sqlparser.NotBetweenStr:
+		return true
+	default:
+		return false
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
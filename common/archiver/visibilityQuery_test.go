@@ -0,0 +1,213 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type VisibilityQuerySuite struct {
+	*require.Assertions
+	suite.Suite
+
+	record *ArchiveVisibilityRequest
+}
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(VisibilityQuerySuite))
+}
+
+func (s *VisibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.record = &ArchiveVisibilityRequest{
+		DomainID:           "test-domain-id",
+		DomainName:         "test-domain-name",
+		WorkflowID:         "test-workflow-id",
+		RunID:              "test-run-id",
+		WorkflowTypeName:   "test-workflow-type",
+		StartTimestamp:     1000,
+		ExecutionTimestamp: 1500,
+		CloseTimestamp:     2000,
+		CloseStatus:        types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:      101,
+		SearchAttributes: map[string]string{
+			"CustomKeywordField":  `"keyword"`,
+			"CustomIntField":      `10`,
+			"CustomDoubleField":   `1.5`,
+			"CustomBoolField":     `true`,
+			"BinaryChecksums":     `["checksum-1","checksum-2"]`,
+			"CustomDatetimeField": `"2020-02-05T11:00:00Z"`,
+		},
+	}
+}
+
+func (s *VisibilityQuerySuite) TestParse_Invalid() {
+	queries := []string{
+		"",
+		"some invalid query",
+		"workflowid = 'test-workflow-id'",
+		"closeTime > 100",
+		"TaskList = 'test-task-list'",
+		"WorkflowID = 1",
+		"HistoryLength = 'long'",
+		"CloseTime > '2019-01-01 00:00:00'",
+		"CloseTime like '2019%'",
+		"CloseStatus > 'failed'",
+		"CloseStatus = 'unknown'",
+		"CloseStatus = 10",
+		"WorkflowID regexp 'test.*'",
+		"not WorkflowID = 'test-workflow-id'",
+		"WorkflowID = RunID",
+		"CustomKeywordField like 1",
+		"WorkflowID = 'test-workflow-id' order by CloseTime",
+	}
+	for _, query := range queries {
+		_, err := ParseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
+
+func (s *VisibilityQuerySuite) TestMatch() {
+	testCases := map[string]bool{
+		"WorkflowID = 'test-workflow-id'":                                         true,
+		"WorkflowID != 'test-workflow-id'":                                        false,
+		"WorkflowID = 'test-workflow-id' and RunID = 'another-run-id'":            false,
+		"WorkflowID = 'another-workflow-id' or RunID = 'test-run-id'":             true,
+		"(WorkflowID = 'a' or WorkflowID = 'b') and RunID = 'test-run-id'":        false,
+		"WorkflowID in ('a', 'test-workflow-id')":                                 true,
+		"WorkflowID not in ('a', 'test-workflow-id')":                             false,
+		"WorkflowType = 'test-workflow-type'":                                     true,
+		"WorkflowTypeName like 'test-%-type'":                                     true,
+		"WorkflowTypeName like 'test-_-type'":                                     false,
+		"WorkflowTypeName not like '%workflow%'":                                  false,
+		"StartTime = 1000 and ExecutionTime > 1000 and CloseTime <= 2000":         true,
+		"CloseTime between 1000 and 1999":                                         false,
+		"CloseTime not between 1000 and 1999":                                     true,
+		"CloseTime > '1970-01-01T00:00:00Z'":                                      true,
+		"CloseStatus = 'failed'":                                                  true,
+		"CloseStatus = 1":                                                         true,
+		"CloseStatus in ('completed', 'TIMED_OUT', 'continuedasnew')":             false,
+		"HistoryLength >= 101 and HistoryLength < 102":                            true,
+		"CustomKeywordField = 'keyword'":                                          true,
+		"CustomKeywordField = \"keyword\"":                                        true,
+		"CustomKeywordField like 'key%'":                                          true,
+		"CustomKeywordField = 1":                                                  false,
+		"CustomIntField > 5 and CustomIntField <= 10":                             true,
+		"CustomIntField = 10.0":                                                   true,
+		"CustomDoubleField between 1 and 2":                                       true,
+		"CustomBoolField = true":                                                  true,
+		"CustomBoolField = false":                                                 false,
+		"BinaryChecksums = 'checksum-2'":                                          true,
+		"BinaryChecksums != 'checksum-2'":                                         false,
+		"BinaryChecksums in ('checksum-3')":                                       false,
+		"CustomDatetimeField >= '2020-02-05T00:00:00Z'":                           true,
+		"CustomStringField = 'missing'":                                           false,
+		"CustomStringField != 'missing'":                                          true,
+		"CustomStringField = 'missing' or CustomKeywordField in ('a', 'keyword')": true,
+	}
+	for query, expected := range testCases {
+		parsedQuery, err := ParseVisibilityQuery(query)
+		s.NoError(err, query)
+		s.Equal(expected, parsedQuery.Match(s.record), query)
+	}
+}
+
+func (s *VisibilityQuerySuite) TestConditions() {
+	query, err := ParseVisibilityQuery("WorkflowID = 'a' and (RunID = 'b' or WorkflowID = 'c') and WorkflowID != 'd'")
+	s.NoError(err)
+
+	conditions := query.Conditions("WorkflowID")
+	s.Len(conditions, 2)
+	s.Equal("=", conditions[0].Operator)
+	s.Equal([]interface{}{"a"}, conditions[0].Values)
+	s.Equal("!=", conditions[1].Operator)
+	s.Empty(query.Conditions("RunID"))
+	s.True(query.HasField("RunID"))
+	s.False(query.HasField("CloseTime"))
+}
+
+func (s *VisibilityQuerySuite) TestRemoveConditions() {
+	query, err := ParseVisibilityQuery("SearchPrecision = 'Day' and CloseTime = 2000 and CloseTime > 1000 and WorkflowID = 'test-workflow-id'")
+	s.NoError(err)
+
+	removed := query.RemoveConditions("SearchPrecision")
+	s.Len(removed, 1)
+	s.Equal([]interface{}{"Day"}, removed[0].Values)
+	s.False(query.HasField("SearchPrecision"))
+
+	removed = query.RemoveConditions("CloseTime", "=")
+	s.Len(removed, 1)
+	s.Equal([]interface{}{int64(2000)}, removed[0].Values)
+	s.True(query.HasField("CloseTime"))
+
+	s.Len(query.RemoveConditions("CloseTime"), 1)
+	s.IsType(&QueryCondition{}, query.Filter)
+	s.Len(query.RemoveConditions("WorkflowID"), 1)
+	s.Nil(query.Filter)
+	s.True(query.Match(s.record))
+}
+
+func (s *VisibilityQuerySuite) TestTimeRange() {
+	testCases := []struct {
+		query    string
+		earliest int64
+		latest   int64
+	}{
+		{
+			query:    "WorkflowID = 'test-workflow-id'",
+			earliest: 0,
+			latest:   math.MaxInt64,
+		},
+		{
+			query:    "CloseTime > 1000 and CloseTime <= 2000 and StartTime < 100",
+			earliest: 1001,
+			latest:   2000,
+		},
+		{
+			query:    "CloseTime = 1500 and CloseTime between 1000 and 2000",
+			earliest: 1500,
+			latest:   1500,
+		},
+		{
+			query:    "CloseTime >= '2019-01-01T11:11:11Z' and (CloseTime < 100 or WorkflowID = 'test-workflow-id')",
+			earliest: 1546341071000000000,
+			latest:   math.MaxInt64,
+		},
+		{
+			query:    "CloseTime != 1000 and CloseTime in (100, 200)",
+			earliest: 0,
+			latest:   math.MaxInt64,
+		},
+	}
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err)
+		earliest, latest := query.TimeRange("CloseTime")
+		s.Equal(tc.earliest, earliest, tc.query)
+		s.Equal(tc.latest, latest, tc.query)
+	}
+}
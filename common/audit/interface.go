@@ -0,0 +1,116 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+const (
+	// ResultSuccess means the API call succeeded
+	ResultSuccess = "success"
+	// ResultFailure means the API call was authorized but failed
+	ResultFailure = "failure"
+	// ResultUnauthorized means the API call was rejected by the authorizer
+	ResultUnauthorized = "unauthorized"
+)
+
+type (
+	// Record is an audit record of a mutating API call
+	Record struct {
+		Timestamp time.Time `json:"timestamp"`
+		// Actor is the subject of the JWT of the caller, if any
+		Actor string `json:"actor,omitempty"`
+		// ActorName is the name of the JWT of the caller, if any
+		ActorName string `json:"actorName,omitempty"`
+		// Caller is the name of the calling service from the RPC headers, e.g. cadence-cli
+		Caller     string `json:"caller,omitempty"`
+		APIName    string `json:"apiName"`
+		DomainName string `json:"domainName,omitempty"`
+		WorkflowID string `json:"workflowId,omitempty"`
+		RunID      string `json:"runId,omitempty"`
+		Reason     string `json:"reason,omitempty"`
+		// Identity is the identity set by the client in the request, if any
+		Identity string `json:"identity,omitempty"`
+		// Details are the API specific parameters, e.g. the shard ID of CloseShard
+		Details string `json:"details,omitempty"`
+		// Result is one of success, failure and unauthorized
+		Result string `json:"result"`
+		// Error is the error message if the call failed
+		Error string `json:"error,omitempty"`
+	}
+
+	// Logger records the mutating API calls
+	Logger interface {
+		// Log fills the timestamp and the caller of the record from the context and writes it to the sink.
+		// Failures are logged and don't fail the API call.
+		Log(ctx context.Context, record *Record)
+		// Close flushes the pending records and closes the sink
+		Close() error
+	}
+
+	// Sink is where the audit records are written to
+	Sink interface {
+		Write(ctx context.Context, record *Record) error
+		Close() error
+	}
+
+	// Filter selects records by the fields set, the string fields match exactly except APIName,
+	// which matches case-insensitively. Actor matches either the subject or the name of the caller's JWT
+	Filter struct {
+		Actor      string
+		APIName    string
+		DomainName string
+		WorkflowID string
+		Result     string
+		// StartTime and EndTime are ignored if zero
+		StartTime time.Time
+		EndTime   time.Time
+	}
+)
+
+// Match returns true if the record matches all the fields set in the filter
+func (f *Filter) Match(record *Record) bool {
+	if f.Actor != "" && f.Actor != record.Actor && f.Actor != record.ActorName {
+		return false
+	}
+	if f.APIName != "" && !strings.EqualFold(f.APIName, record.APIName) {
+		return false
+	}
+	if f.DomainName != "" && f.DomainName != record.DomainName {
+		return false
+	}
+	if f.WorkflowID != "" && f.WorkflowID != record.WorkflowID {
+		return false
+	}
+	if f.Result != "" && f.Result != record.Result {
+		return false
+	}
+	if !f.StartTime.IsZero() && record.Timestamp.Before(f.StartTime) {
+		return false
+	}
+	if !f.EndTime.IsZero() && record.Timestamp.After(f.EndTime) {
+		return false
+	}
+	return true
+}
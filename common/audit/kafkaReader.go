@@ -0,0 +1,113 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+const (
+	// kafkaReadTimeout is how long reading a partition waits for the next message
+	kafkaReadTimeout = 10 * time.Second
+)
+
+// ReadKafkaRecords reads the records published to the topic by the kafka sink and returns the ones matching the filter,
+// ordered by their timestamps. Each partition is read from its oldest retained message up to its newest message
+// when the call starts. At most limit records are returned if limit is positive.
+func ReadKafkaRecords(brokers []string, topic string, filter *Filter, limit int) ([]*Record, error) {
+	config := sarama.NewConfig()
+	config.Version = sarama.V0_10_2_0
+	config.Consumer.Return.Errors = true
+	client, err := sarama.NewClient(brokers, config)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	partitions, err := client.Partitions(topic)
+	if err != nil {
+		return nil, err
+	}
+	consumer, err := sarama.NewConsumerFromClient(client)
+	if err != nil {
+		return nil, err
+	}
+	defer consumer.Close()
+
+	var records [][]*Record
+	for _, partition := range partitions {
+		partitionRecords, err := readPartition(client, consumer, topic, partition, filter)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, partitionRecords)
+	}
+	return MergeRecords(limit, records...), nil
+}
+
+func readPartition(
+	client sarama.Client,
+	consumer sarama.Consumer,
+	topic string,
+	partition int32,
+	filter *Filter,
+) ([]*Record, error) {
+	oldest, err := client.GetOffset(topic, partition, sarama.OffsetOldest)
+	if err != nil {
+		return nil, err
+	}
+	newest, err := client.GetOffset(topic, partition, sarama.OffsetNewest)
+	if err != nil {
+		return nil, err
+	}
+	if oldest >= newest {
+		return nil, nil
+	}
+	partitionConsumer, err := consumer.ConsumePartition(topic, partition, oldest)
+	if err != nil {
+		return nil, err
+	}
+	defer partitionConsumer.Close()
+
+	var records []*Record
+	for offset := oldest; offset < newest; {
+		select {
+		case message := <-partitionConsumer.Messages():
+			offset = message.Offset + 1
+			var record Record
+			if err := json.Unmarshal(message.Value, &record); err != nil {
+				return nil, fmt.Errorf("invalid audit record at offset %v of partition %v: %v", message.Offset, partition, err)
+			}
+			if filter.Match(&record) {
+				records = append(records, &record)
+			}
+		case err := <-partitionConsumer.Errors():
+			return nil, err
+		case <-time.After(kafkaReadTimeout):
+			return nil, fmt.Errorf("timed out reading offset %v of partition %v", offset, partition)
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuditTopic = "test-audit-topic"
+
+func TestReadKafkaRecords(t *testing.T) {
+	start := time.Unix(1600000000, 0).UTC()
+	records := []*Record{
+		{Timestamp: start, Actor: "alice", APIName: "TerminateWorkflowExecution", DomainName: "d1", WorkflowID: "w1", Result: ResultSuccess},
+		{Timestamp: start.Add(time.Minute), Actor: "bob", APIName: "SignalWorkflowExecution", DomainName: "d1", WorkflowID: "w2", Result: ResultUnauthorized},
+		{Timestamp: start.Add(2 * time.Minute), Actor: "alice", APIName: "UpdateDomain", DomainName: "d2", Result: ResultFailure},
+	}
+	encode := func(record *Record) sarama.Encoder {
+		data, err := json.Marshal(record)
+		require.NoError(t, err)
+		return sarama.ByteEncoder(data)
+	}
+
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	// partition 0 has the records of w1 and d2, and the oldest retained offset is 5,
+	// partition 1 has the record of w2
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetLeader(testAuditTopic, 0, broker.BrokerID()).
+			SetLeader(testAuditTopic, 1, broker.BrokerID()),
+		"OffsetRequest": sarama.NewMockOffsetResponse(t).
+			SetOffset(testAuditTopic, 0, sarama.OffsetOldest, 5).
+			SetOffset(testAuditTopic, 0, sarama.OffsetNewest, 7).
+			SetOffset(testAuditTopic, 1, sarama.OffsetOldest, 0).
+			SetOffset(testAuditTopic, 1, sarama.OffsetNewest, 1),
+		"FetchRequest": sarama.NewMockFetchResponse(t, 2).
+			SetMessage(testAuditTopic, 0, 5, encode(records[0])).
+			SetMessage(testAuditTopic, 0, 6, encode(records[2])).
+			SetHighWaterMark(testAuditTopic, 0, 7).
+			SetMessage(testAuditTopic, 1, 0, encode(records[1])).
+			SetHighWaterMark(testAuditTopic, 1, 1),
+	})
+
+	tests := map[string]struct {
+		filter   Filter
+		limit    int
+		expected []*Record
+	}{
+		"all":   {expected: records},
+		"limit": {limit: 2, expected: records[:2]},
+		"actor": {filter: Filter{Actor: "alice"}, expected: []*Record{records[0], records[2]}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			result, err := ReadKafkaRecords([]string{broker.Addr()}, testAuditTopic, &test.filter, test.limit)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
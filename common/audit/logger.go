@@ -0,0 +1,144 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cristalhq/jwt/v3"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	loggerImpl struct {
+		sink       Sink
+		timeSource clock.TimeSource
+		logger     log.Logger
+	}
+
+	noopLogger struct{}
+)
+
+// NewLogger creates the audit logger of the config, or a no-op logger if audit is disabled.
+// The records are written to the sink asynchronously.
+func NewLogger(
+	cfg config.Audit,
+	messagingClient messaging.Client,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) (Logger, error) {
+	if !cfg.Enable {
+		return NewNoopLogger(), nil
+	}
+	var sink Sink
+	var err error
+	switch {
+	case cfg.File != nil:
+		sink, err = NewFileSink(cfg.File.Path)
+	case cfg.Kafka != nil:
+		if messagingClient == nil {
+			return nil, fmt.Errorf("kafka audit sink requires the kafka config")
+		}
+		var producer messaging.Producer
+		producer, err = messagingClient.NewProducer(cfg.Kafka.Application)
+		if err == nil {
+			sink = NewKafkaSink(producer)
+		}
+	default:
+		return nil, fmt.Errorf("audit sink is not configured")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sink = NewAsyncSink(sink, cfg.BufferSize, metricsClient, logger)
+	return NewLoggerWithSink(sink, clock.NewRealTimeSource(), logger), nil
+}
+
+// NewLoggerWithSink creates an audit logger writing to the sink
+func NewLoggerWithSink(
+	sink Sink,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) Logger {
+	return &loggerImpl{
+		sink:       sink,
+		timeSource: timeSource,
+		logger:     logger,
+	}
+}
+
+// NewNoopLogger creates an audit logger which drops all records
+func NewNoopLogger() Logger {
+	return &noopLogger{}
+}
+
+func (l *loggerImpl) Log(ctx context.Context, record *Record) {
+	record.Timestamp = l.timeSource.Now()
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		record.Caller = call.Caller()
+		record.Actor, record.ActorName = actorFromToken(call.Header(common.AuthorizationTokenHeaderName))
+	}
+	if err := l.sink.Write(ctx, record); err != nil {
+		l.logger.Error("failed to write audit record",
+			tag.WorkflowDomainName(record.DomainName),
+			tag.WorkflowID(record.WorkflowID),
+			tag.Value(record.APIName),
+			tag.Error(err))
+	}
+}
+
+func (l *loggerImpl) Close() error {
+	return l.sink.Close()
+}
+
+func (l *noopLogger) Log(ctx context.Context, record *Record) {}
+
+func (l *noopLogger) Close() error {
+	return nil
+}
+
+// actorFromToken returns the subject and name claims of the JWT. The token is not verified here,
+// it's done by the authorizer, claims of a rejected call are recorded as they are.
+func actorFromToken(token string) (string, string) {
+	if token == "" {
+		return "", ""
+	}
+	parsed, err := jwt.ParseString(token)
+	if err != nil {
+		return "", ""
+	}
+	var claims authorization.JWTClaims
+	if err := json.Unmarshal(parsed.RawClaims(), &claims); err != nil {
+		return "", ""
+	}
+	return claims.Sub, claims.Name
+}
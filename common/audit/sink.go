@@ -0,0 +1,252 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+const (
+	// DefaultBufferSize is the default number of records buffered by the async sink
+	DefaultBufferSize = 10000
+
+	asyncSinkWriteTimeout = 10 * time.Second
+)
+
+type (
+	// asyncSink buffers the records and writes them to the underlying sink in the background,
+	// so that a slow sink doesn't add latency to the API calls. Records are dropped when the buffer is full.
+	asyncSink struct {
+		sink         Sink
+		records      chan *Record
+		metricsScope metrics.Scope
+		logger       log.Logger
+
+		closeOnce  sync.Once
+		shutdownCh chan struct{}
+		doneCh     chan struct{}
+	}
+
+	fileSink struct {
+		sync.Mutex
+		file *os.File
+	}
+
+	kafkaSink struct {
+		producer messaging.Producer
+	}
+)
+
+// NewFileSink creates a sink appending the records to the file as JSON lines
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit file %v: %v", path, err)
+	}
+	return &fileSink{file: file}, nil
+}
+
+// NewKafkaSink creates a sink publishing the records as JSON, keyed by the workflow ID
+func NewKafkaSink(producer messaging.Producer) Sink {
+	return &kafkaSink{producer: producer}
+}
+
+// NewAsyncSink creates a sink buffering up to bufferSize records and writing them to the sink in the background.
+// Write never blocks, the records which don't fit into the buffer are dropped and counted.
+func NewAsyncSink(
+	sink Sink,
+	bufferSize int,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) Sink {
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+	s := &asyncSink{
+		sink:         sink,
+		records:      make(chan *Record, bufferSize),
+		metricsScope: metricsClient.Scope(metrics.AuditLogScope),
+		logger:       logger,
+		shutdownCh:   make(chan struct{}),
+		doneCh:       make(chan struct{}),
+	}
+	go s.writeLoop()
+	return s
+}
+
+func (s *asyncSink) Write(_ context.Context, record *Record) error {
+	select {
+	case <-s.shutdownCh:
+		s.metricsScope.IncCounter(metrics.AuditRecordsDropped)
+		return nil
+	default:
+	}
+
+	select {
+	case s.records <- record:
+	default:
+		s.metricsScope.IncCounter(metrics.AuditRecordsDropped)
+	}
+	return nil
+}
+
+// Close writes the buffered records and closes the underlying sink
+func (s *asyncSink) Close() error {
+	s.closeOnce.Do(func() {
+		close(s.shutdownCh)
+	})
+	<-s.doneCh
+	return s.sink.Close()
+}
+
+func (s *asyncSink) writeLoop() {
+	defer close(s.doneCh)
+
+	for {
+		select {
+		case record := <-s.records:
+			s.write(record)
+		case <-s.shutdownCh:
+			for {
+				select {
+				case record := <-s.records:
+					s.write(record)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (s *asyncSink) write(record *Record) {
+	ctx, cancel := context.WithTimeout(context.Background(), asyncSinkWriteTimeout)
+	defer cancel()
+
+	if err := s.sink.Write(ctx, record); err != nil {
+		s.metricsScope.IncCounter(metrics.AuditRecordWriteFailures)
+		s.logger.Error("failed to write audit record",
+			tag.WorkflowDomainName(record.DomainName),
+			tag.WorkflowID(record.WorkflowID),
+			tag.Value(record.APIName),
+			tag.Error(err))
+	}
+}
+
+func (s *fileSink) Write(_ context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.Lock()
+	defer s.Unlock()
+	_, err = s.file.Write(data)
+	return err
+}
+
+func (s *fileSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	return s.file.Close()
+}
+
+func (s *kafkaSink) Write(ctx context.Context, record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	key := record.WorkflowID
+	if key == "" {
+		key = record.DomainName
+	}
+	return s.producer.Publish(ctx, &messaging.RawMessage{
+		Key:   key,
+		Value: data,
+	})
+}
+
+func (s *kafkaSink) Close() error {
+	if closeable, ok := s.producer.(messaging.CloseableProducer); ok {
+		return closeable.Close()
+	}
+	return nil
+}
+
+// ReadRecords reads the JSON lines records written by the file sink and returns the ones matching the filter,
+// at most limit records are returned if limit is positive
+func ReadRecords(reader io.Reader, filter *Filter, limit int) ([]*Record, error) {
+	var records []*Record
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return nil, fmt.Errorf("invalid audit record at line %v: %v", line, err)
+		}
+		if !filter.Match(&record) {
+			continue
+		}
+		records = append(records, &record)
+		if limit > 0 && len(records) >= limit {
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// MergeRecords merges the records read from several sources, e.g. the audit log files of several frontend hosts,
+// ordered by their timestamps. At most limit records are returned if limit is positive.
+func MergeRecords(limit int, sources ...[]*Record) []*Record {
+	var records []*Record
+	for _, source := range sources {
+		records = append(records, source...)
+	}
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+	if limit > 0 && len(records) > limit {
+		records = records[:limit]
+	}
+	return records
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+)
+
+// blockingSink blocks the writes until unblocked, the records with the API name "fail" fail
+type blockingSink struct {
+	sync.Mutex
+	started chan struct{}
+	unblock chan struct{}
+	records []*Record
+	closed  bool
+}
+
+func (s *blockingSink) Write(_ context.Context, record *Record) error {
+	select {
+	case s.started <- struct{}{}:
+	default:
+	}
+	<-s.unblock
+
+	s.Lock()
+	defer s.Unlock()
+	if record.APIName == "fail" {
+		return errors.New("sink is unavailable")
+	}
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *blockingSink) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	s.closed = true
+	return nil
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	start := time.Unix(1600000000, 0).UTC()
+	records := []*Record{
+		{Timestamp: start, Actor: "alice", APIName: "TerminateWorkflowExecution", DomainName: "d1", WorkflowID: "w1", Reason: "stuck", Result: ResultSuccess},
+		{Timestamp: start.Add(time.Minute), Actor: "bob", APIName: "SignalWorkflowExecution", DomainName: "d1", WorkflowID: "w2", Result: ResultUnauthorized},
+		{Timestamp: start.Add(2 * time.Minute), Actor: "alice", APIName: "UpdateDomain", DomainName: "d2", Result: ResultFailure, Error: "bad request"},
+	}
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+	for _, record := range records[:2] {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+	require.NoError(t, sink.Close())
+	// the file is appended to
+	sink, err = NewFileSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(context.Background(), records[2]))
+	require.NoError(t, sink.Close())
+
+	tests := map[string]struct {
+		filter   Filter
+		limit    int
+		expected []*Record
+	}{
+		"all":             {expected: records},
+		"limit":           {limit: 2, expected: records[:2]},
+		"actor":           {filter: Filter{Actor: "alice"}, expected: []*Record{records[0], records[2]}},
+		"API":             {filter: Filter{APIName: "signalworkflowexecution"}, expected: records[1:2]},
+		"domain":          {filter: Filter{DomainName: "d1"}, expected: records[:2]},
+		"workflow":        {filter: Filter{WorkflowID: "w1"}, expected: records[:1]},
+		"result":          {filter: Filter{Result: ResultUnauthorized}, expected: records[1:2]},
+		"time range":      {filter: Filter{StartTime: start.Add(time.Second), EndTime: start.Add(time.Minute)}, expected: records[1:2]},
+		"nothing matches": {filter: Filter{DomainName: "d3"}},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			file, err := os.Open(path)
+			require.NoError(t, err)
+			defer file.Close()
+			result, err := ReadRecords(file, &test.filter, test.limit)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, result)
+		})
+	}
+}
+
+func TestReadRecords_Invalid(t *testing.T) {
+	_, err := ReadRecords(strings.NewReader("{}\n\nnot a record\n"), &Filter{}, 0)
+	assert.EqualError(t, err, "invalid audit record at line 3: invalid character 'o' in literal null (expecting 'u')")
+}
+
+func TestMergeRecords(t *testing.T) {
+	start := time.Unix(1600000000, 0).UTC()
+	records := []*Record{
+		{Timestamp: start, APIName: "r0"},
+		{Timestamp: start.Add(time.Minute), APIName: "r1"},
+		{Timestamp: start.Add(time.Minute), APIName: "r2"},
+		{Timestamp: start.Add(2 * time.Minute), APIName: "r3"},
+	}
+	host1 := []*Record{records[0], records[1], records[3]}
+	host2 := []*Record{records[2]}
+
+	assert.Equal(t, records, MergeRecords(0, host1, host2))
+	assert.Equal(t, records[:2], MergeRecords(2, host1, host2))
+	assert.Empty(t, MergeRecords(0))
+}
+
+func TestKafkaSink(t *testing.T) {
+	producer := &mocks.KafkaProducer{}
+	sink := NewKafkaSink(producer)
+	record := &Record{APIName: "UpdateDomain", DomainName: "test-domain", Result: ResultSuccess}
+	producer.On("Publish", mock.Anything, mock.MatchedBy(func(message *messaging.RawMessage) bool {
+		var published Record
+		return message.Key == "test-domain" &&
+			json.Unmarshal(message.Value, &published) == nil &&
+			published.APIName == record.APIName
+	})).Return(nil).Once()
+	producer.On("Close").Return(nil).Once()
+
+	assert.NoError(t, sink.Write(context.Background(), record))
+	assert.NoError(t, sink.Close())
+	producer.AssertExpectations(t)
+}
+
+func TestAsyncSink(t *testing.T) {
+	scope := tally.NewTestScope("test", nil)
+	inner := &blockingSink{
+		started: make(chan struct{}, 1),
+		unblock: make(chan struct{}),
+	}
+	sink := NewAsyncSink(inner, 2, metrics.NewClient(scope, metrics.Frontend), loggerimpl.NewNopLogger())
+
+	records := []*Record{
+		{APIName: "TerminateWorkflowExecution"},
+		{APIName: "fail"},
+		{APIName: "SignalWorkflowExecution"},
+		{APIName: "UpdateDomain"},
+	}
+	require.NoError(t, sink.Write(context.Background(), records[0]))
+	<-inner.started
+	// the first record is being written, the next two are buffered and the last one is dropped
+	for _, record := range records[1:] {
+		require.NoError(t, sink.Write(context.Background(), record))
+	}
+	close(inner.unblock)
+	require.NoError(t, sink.Close())
+	require.NoError(t, sink.Write(context.Background(), &Record{APIName: "UpdateDomain"}))
+
+	assert.True(t, inner.closed)
+	assert.Equal(t, []*Record{records[0], records[2]}, inner.records)
+	counters := scope.Snapshot().Counters()
+	assert.Equal(t, int64(2), counters["test.audit_records_dropped+operation=AuditLog"].Value())
+	assert.Equal(t, int64(1), counters["test.audit_record_write_failures+operation=AuditLog"].Value())
+}
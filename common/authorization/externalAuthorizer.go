@@ -0,0 +1,289 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/cristalhq/jwt/v3"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	defaultExternalAuthorizerTimeout   = time.Second
+	defaultExternalAuthorizerCacheSize = 10000
+)
+
+type (
+	externalAuthority struct {
+		cfg        config.ExternalAuthorizer
+		httpClient *http.Client
+		// decisions is nil if caching is disabled
+		decisions  cache.Cache
+		timeSource clock.TimeSource
+		log        log.Logger
+	}
+
+	// cachedDecision is a decision cached until the token of the request expires or CacheTTL passes
+	cachedDecision struct {
+		decision  Decision
+		expiresAt time.Time
+	}
+
+	// tokenExpiration are the claims of a JWT which limit its lifetime, the cadence tokens set Iat and TTL
+	// instead of the standard exp claim
+	tokenExpiration struct {
+		Exp int64 `json:"exp"`
+		Iat int64 `json:"iat"`
+		TTL int64 `json:"ttl"`
+	}
+
+	// ExternalAuthorizationRequest is the body of the request sent to the external authorizer.
+	// The attributes are wrapped in "input" so that it can be sent to the Open Policy Agent data API directly.
+	ExternalAuthorizationRequest struct {
+		Input ExternalAuthorizationInput `json:"input"`
+	}
+
+	// ExternalAuthorizationInput are the attributes of the request to authorize
+	ExternalAuthorizationInput struct {
+		// Actor is set by the handler if known
+		Actor string `json:"actor,omitempty"`
+		// Caller is the name of the calling service from the RPC headers
+		Caller string `json:"caller,omitempty"`
+		// Token is the value of the authorization header, if any
+		Token        string `json:"token,omitempty"`
+		APIName      string `json:"apiName"`
+		DomainName   string `json:"domainName,omitempty"`
+		WorkflowType string `json:"workflowType,omitempty"`
+		TaskList     string `json:"taskList,omitempty"`
+		// Permission is one of read, write and admin
+		Permission string `json:"permission"`
+	}
+
+	// ExternalAuthorizationResponse is the response of the external authorizer. Result is either
+	// a boolean or an object with an "allow" boolean field.
+	ExternalAuthorizationResponse struct {
+		Result json.RawMessage `json:"result"`
+	}
+
+	externalAuthorizationResult struct {
+		Allow bool `json:"allow"`
+	}
+)
+
+// NewExternalAuthorizer creates an authority which delegates the decisions to an external policy service
+func NewExternalAuthorizer(
+	authorizationCfg config.ExternalAuthorizer,
+	logger log.Logger,
+) (Authorizer, error) {
+	timeout := authorizationCfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultExternalAuthorizerTimeout
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if authorizationCfg.TLS.Enabled {
+		tlsConfig, err := authorizationCfg.TLS.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+	authority := &externalAuthority{
+		cfg: authorizationCfg,
+		httpClient: &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		},
+		timeSource: clock.NewRealTimeSource(),
+		log:        logger,
+	}
+	if authorizationCfg.CacheTTL > 0 {
+		cacheSize := authorizationCfg.CacheSize
+		if cacheSize <= 0 {
+			cacheSize = defaultExternalAuthorizerCacheSize
+		}
+		authority.decisions = cache.New(&cache.Options{
+			TTL:      authorizationCfg.CacheTTL,
+			MaxCount: cacheSize,
+		})
+	}
+	return authority, nil
+}
+
+// Authorize asks the external policy service for the decision
+func (a *externalAuthority) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	input := newExternalAuthorizationInput(ctx, attributes)
+	if a.decisions != nil {
+		if cached, ok := a.decisions.Get(input).(cachedDecision); ok && a.timeSource.Now().Before(cached.expiresAt) {
+			return Result{Decision: cached.decision}, nil
+		}
+	}
+
+	decision, err := a.call(ctx, input)
+	if err != nil {
+		if a.cfg.FailOpen {
+			a.log.Warn("external authorizer is unavailable, request is allowed", tag.Error(err))
+			return Result{Decision: DecisionAllow}, nil
+		}
+		a.log.Warn("external authorizer is unavailable, request is denied", tag.Error(err))
+		return Result{Decision: DecisionDeny}, &types.InternalServiceError{Message: "Authorization service is unavailable."}
+	}
+	if a.decisions != nil {
+		a.cacheDecision(input, decision)
+	}
+	return Result{Decision: decision}, nil
+}
+
+// cacheDecision caches the decision for CacheTTL, or until the token expires if that is earlier,
+// so that an allow decision doesn't outlive the token it was made for
+func (a *externalAuthority) cacheDecision(input ExternalAuthorizationInput, decision Decision) {
+	now := a.timeSource.Now()
+	expiresAt := now.Add(a.cfg.CacheTTL)
+	if tokenExpiresAt, ok := getTokenExpiration(input.Token); ok && tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+	if !now.Before(expiresAt) {
+		return
+	}
+	a.decisions.Put(input, cachedDecision{decision: decision, expiresAt: expiresAt})
+}
+
+// getTokenExpiration returns when the token expires if it is a JWT with an expiration.
+// The signature is not verified, that is left to the external authorizer.
+func getTokenExpiration(token string) (time.Time, bool) {
+	if token == "" {
+		return time.Time{}, false
+	}
+	parsed, err := jwt.ParseString(token)
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims tokenExpiration
+	if err := json.Unmarshal(parsed.RawClaims(), &claims); err != nil {
+		return time.Time{}, false
+	}
+	switch {
+	case claims.Exp > 0:
+		return time.Unix(claims.Exp, 0), true
+	case claims.TTL > 0:
+		return time.Unix(claims.Iat+claims.TTL, 0), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+func (a *externalAuthority) call(ctx context.Context, input ExternalAuthorizationInput) (Decision, error) {
+	body, err := json.Marshal(ExternalAuthorizationRequest{Input: input})
+	if err != nil {
+		return DecisionDeny, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, a.cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return DecisionDeny, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range a.cfg.Headers {
+		request.Header.Set(key, value)
+	}
+
+	response, err := a.httpClient.Do(request)
+	if err != nil {
+		return DecisionDeny, err
+	}
+	defer response.Body.Close()
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return DecisionDeny, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return DecisionDeny, fmt.Errorf("external authorizer returned %v: %s", response.Status, data)
+	}
+	return parseExternalAuthorizationResponse(data)
+}
+
+func parseExternalAuthorizationResponse(data []byte) (Decision, error) {
+	var response ExternalAuthorizationResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return DecisionDeny, fmt.Errorf("invalid external authorizer response: %v", err)
+	}
+	if len(response.Result) == 0 {
+		// OPA omits the result if the policy is not defined
+		return DecisionDeny, fmt.Errorf("external authorizer response has no result")
+	}
+	var allow bool
+	if err := json.Unmarshal(response.Result, &allow); err != nil {
+		var result externalAuthorizationResult
+		if err := json.Unmarshal(response.Result, &result); err != nil {
+			return DecisionDeny, fmt.Errorf("invalid external authorizer result: %s", response.Result)
+		}
+		allow = result.Allow
+	}
+	if allow {
+		return DecisionAllow, nil
+	}
+	return DecisionDeny, nil
+}
+
+func newExternalAuthorizationInput(ctx context.Context, attributes *Attributes) ExternalAuthorizationInput {
+	input := ExternalAuthorizationInput{
+		Actor:        attributes.Actor,
+		APIName:      attributes.APIName,
+		DomainName:   attributes.DomainName,
+		WorkflowType: attributes.WorkflowType.GetName(),
+		TaskList:     attributes.TaskList.GetName(),
+		Permission:   permissionName(attributes.Permission),
+	}
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		input.Caller = call.Caller()
+		input.Token = call.Header(common.AuthorizationTokenHeaderName)
+	}
+	return input
+}
+
+func permissionName(permission Permission) string {
+	switch permission {
+	case PermissionRead:
+		return "read"
+	case PermissionWrite:
+		return "write"
+	case PermissionAdmin:
+		return "admin"
+	default:
+		return fmt.Sprintf("%d", permission)
+	}
+}
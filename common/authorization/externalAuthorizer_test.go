@@ -0,0 +1,271 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	externalAuthorizerSuite struct {
+		suite.Suite
+		server   *httptest.Server
+		requests int32
+		// handler is called by the test server
+		handler func(w http.ResponseWriter, request ExternalAuthorizationRequest)
+	}
+)
+
+func TestExternalAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(externalAuthorizerSuite))
+}
+
+func (s *externalAuthorizerSuite) SetupTest() {
+	s.requests = 0
+	s.handler = func(w http.ResponseWriter, request ExternalAuthorizationRequest) {
+		allow := request.Input.Token == "token" && request.Input.WorkflowType == "allowed-type"
+		s.NoError(json.NewEncoder(w).Encode(map[string]interface{}{
+			"result": map[string]bool{"allow": allow},
+		}))
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&s.requests, 1)
+		s.Equal(http.MethodPost, r.Method)
+		s.Equal("secret", r.Header.Get("X-Api-Key"))
+		var request ExternalAuthorizationRequest
+		s.NoError(json.NewDecoder(r.Body).Decode(&request))
+		s.handler(w, request)
+	}))
+}
+
+func (s *externalAuthorizerSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize() {
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{})
+	ctx := s.contextWithToken("token")
+
+	result, err := authorizer.Authorize(ctx, s.attributes("allowed-type"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	result, err = authorizer.Authorize(ctx, s.attributes("other-type"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	result, err = authorizer.Authorize(context.Background(), s.attributes("allowed-type"))
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+	s.Equal(int32(3), atomic.LoadInt32(&s.requests))
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize_Input() {
+	s.handler = func(w http.ResponseWriter, request ExternalAuthorizationRequest) {
+		s.Equal(ExternalAuthorizationInput{
+			Token:        "token",
+			APIName:      "PollForDecisionTask",
+			DomainName:   "test-domain",
+			WorkflowType: "allowed-type",
+			TaskList:     "test-task-list",
+			Permission:   "write",
+		}, request.Input)
+		_, err := w.Write([]byte(`{"result": true}`))
+		s.NoError(err)
+	}
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{})
+
+	result, err := authorizer.Authorize(s.contextWithToken("token"), s.attributes("allowed-type"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize_Cache() {
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{CacheTTL: time.Minute})
+	ctx := s.contextWithToken("token")
+
+	for i := 0; i < 3; i++ {
+		result, err := authorizer.Authorize(ctx, s.attributes("allowed-type"))
+		s.NoError(err)
+		s.Equal(DecisionAllow, result.Decision)
+		result, err = authorizer.Authorize(ctx, s.attributes("other-type"))
+		s.NoError(err)
+		s.Equal(DecisionDeny, result.Decision)
+	}
+	s.Equal(int32(2), atomic.LoadInt32(&s.requests))
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize_CacheTokenExpiration() {
+	s.handler = func(w http.ResponseWriter, request ExternalAuthorizationRequest) {
+		_, err := w.Write([]byte(`{"result": true}`))
+		s.NoError(err)
+	}
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{CacheTTL: time.Minute})
+	authorizer.(*externalAuthority).timeSource = timeSource
+	ctx := s.contextWithToken(s.token(map[string]int64{"exp": now.Add(10 * time.Second).Unix()}))
+
+	authorize := func() {
+		result, err := authorizer.Authorize(ctx, s.attributes("allowed-type"))
+		s.NoError(err)
+		s.Equal(DecisionAllow, result.Decision)
+	}
+	authorize()
+	timeSource.Update(now.Add(5 * time.Second))
+	authorize()
+	s.Equal(int32(1), atomic.LoadInt32(&s.requests))
+	// the decision is not cached beyond the expiration of the token even though CacheTTL hasn't passed
+	timeSource.Update(now.Add(11 * time.Second))
+	authorize()
+	s.Equal(int32(2), atomic.LoadInt32(&s.requests))
+	// the decisions for an expired token are not cached
+	authorize()
+	s.Equal(int32(3), atomic.LoadInt32(&s.requests))
+}
+
+func (s *externalAuthorizerSuite) TestGetTokenExpiration() {
+	tests := map[string]struct {
+		token     string
+		expiresAt time.Time
+		ok        bool
+	}{
+		"exp":           {token: s.token(map[string]int64{"exp": 1600000600, "iat": 1600000000, "ttl": 3600}), expiresAt: time.Unix(1600000600, 0), ok: true},
+		"iat and ttl":   {token: s.token(map[string]int64{"iat": 1600000000, "ttl": 3600}), expiresAt: time.Unix(1600003600, 0), ok: true},
+		"no expiration": {token: s.token(map[string]int64{"iat": 1600000000})},
+		"not a JWT":     {token: "token"},
+		"no token":      {},
+	}
+	for name, test := range tests {
+		expiresAt, ok := getTokenExpiration(test.token)
+		s.Equal(test.ok, ok, name)
+		s.Equal(test.expiresAt, expiresAt, name)
+	}
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize_FailClosed() {
+	s.handler = func(w http.ResponseWriter, request ExternalAuthorizationRequest) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{CacheTTL: time.Minute})
+
+	for i := 0; i < 2; i++ {
+		result, err := authorizer.Authorize(s.contextWithToken("token"), s.attributes("allowed-type"))
+		s.Error(err)
+		s.IsType(&types.InternalServiceError{}, err)
+		s.Equal(DecisionDeny, result.Decision)
+	}
+	// failures are not cached
+	s.Equal(int32(2), atomic.LoadInt32(&s.requests))
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize_FailOpen() {
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{FailOpen: true})
+	s.server.Close()
+
+	result, err := authorizer.Authorize(s.contextWithToken("token"), s.attributes("other-type"))
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+}
+
+func (s *externalAuthorizerSuite) TestAuthorize_Timeout() {
+	s.handler = func(w http.ResponseWriter, request ExternalAuthorizationRequest) {
+		time.Sleep(200 * time.Millisecond)
+	}
+	authorizer := s.newAuthorizer(config.ExternalAuthorizer{Timeout: 50 * time.Millisecond})
+
+	result, err := authorizer.Authorize(s.contextWithToken("token"), s.attributes("allowed-type"))
+	s.Error(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *externalAuthorizerSuite) TestParseResponse() {
+	tests := map[string]struct {
+		response string
+		decision Decision
+		hasError bool
+	}{
+		"boolean allow":   {response: `{"result": true}`, decision: DecisionAllow},
+		"boolean deny":    {response: `{"result": false}`, decision: DecisionDeny},
+		"object allow":    {response: `{"result": {"allow": true, "reason": "owner"}}`, decision: DecisionAllow},
+		"object deny":     {response: `{"result": {}}`, decision: DecisionDeny},
+		"no result":       {response: `{}`, decision: DecisionDeny, hasError: true},
+		"invalid result":  {response: `{"result": "yes"}`, decision: DecisionDeny, hasError: true},
+		"invalid message": {response: `allow`, decision: DecisionDeny, hasError: true},
+	}
+	for name, test := range tests {
+		decision, err := parseExternalAuthorizationResponse([]byte(test.response))
+		s.Equal(test.decision, decision, name)
+		s.Equal(test.hasError, err != nil, name)
+	}
+}
+
+func (s *externalAuthorizerSuite) newAuthorizer(cfg config.ExternalAuthorizer) Authorizer {
+	cfg.Enable = true
+	cfg.URL = s.server.URL
+	cfg.Headers = map[string]string{"X-Api-Key": "secret"}
+	authorizer, err := NewExternalAuthorizer(cfg, loggerimpl.NewNopLogger())
+	s.NoError(err)
+	return authorizer
+}
+
+func (s *externalAuthorizerSuite) contextWithToken(token string) context.Context {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token),
+	}))
+	return ctx
+}
+
+func (s *externalAuthorizerSuite) token(claims map[string]int64) string {
+	signer, err := jwt.NewSignerHS(jwt.HS256, []byte("secret"))
+	s.NoError(err)
+	token, err := jwt.NewBuilder(signer).Build(claims)
+	s.NoError(err)
+	return token.String()
+}
+
+func (s *externalAuthorizerSuite) attributes(workflowType string) *Attributes {
+	return &Attributes{
+		APIName:      "PollForDecisionTask",
+		DomainName:   "test-domain",
+		WorkflowType: &types.WorkflowType{Name: workflowType},
+		TaskList:     &types.TaskList{Name: "test-task-list"},
+		Permission:   PermissionWrite,
+	}
+}
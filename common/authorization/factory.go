@@ -30,6 +30,8 @@ func NewAuthorizer(authorization config.Authorization, logger log.Logger, domain
 	switch true {
 	case authorization.OAuthAuthorizer.Enable:
 		return NewOAuthAuthorizer(authorization.OAuthAuthorizer, logger, domainCache)
+	case authorization.ExternalAuthorizer.Enable:
+		return NewExternalAuthorizer(authorization.ExternalAuthorizer, logger)
 	default:
 		return NewNopAuthorizer()
 	}
@@ -89,3 +89,15 @@ func (s *factorySuite) TestFactoryNoopAuthorizer() {
 		s.Equal(err, test.err)
 	}
 }
+
+func (s *factorySuite) TestFactoryExternalAuthorizer() {
+	cfg := config.Authorization{
+		ExternalAuthorizer: config.ExternalAuthorizer{
+			Enable: true,
+			URL:    "http://localhost:8181/v1/data/cadence/authz",
+		},
+	}
+	authorizer, err := NewAuthorizer(cfg, s.logger, nil)
+	s.NoError(err)
+	s.IsType(&externalAuthority{}, authorizer)
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+const (
+	defaultJWKSRefreshInterval = time.Minute
+	// minJWKSRefreshInterval limits the refetches triggered by tokens with an unknown key ID
+	minJWKSRefreshInterval = 10 * time.Second
+	jwksRequestTimeout     = 10 * time.Second
+)
+
+type (
+	// jsonWebKey is a public key of a JSON Web Key Set
+	jsonWebKey struct {
+		id        string
+		algorithm string
+		key       interface{}
+	}
+
+	// jwksKeySet caches a JSON Web Key Set fetched from a URL or loaded from a file.
+	// The set is refreshed in the background when it is older than the refresh interval,
+	// and immediately when a token refers to an unknown key, e.g. right after a key rotation.
+	jwksKeySet struct {
+		cfg             config.JWKS
+		refreshInterval time.Duration
+		httpClient      *http.Client
+		timeSource      clock.TimeSource
+		logger          log.Logger
+
+		refreshLock sync.Mutex
+		refreshing  int32
+
+		sync.RWMutex
+		keys        map[string]*jsonWebKey
+		refreshedAt time.Time
+		fileModTime time.Time
+	}
+
+	// jwksDocument is the JSON representation of a JSON Web Key Set, see RFC 7517
+	jwksDocument struct {
+		Keys []jwksKey `json:"keys"`
+	}
+
+	jwksKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Alg string `json:"alg"`
+		Use string `json:"use"`
+		// RSA
+		N string `json:"n"`
+		E string `json:"e"`
+		// EC
+		Crv string `json:"crv"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}
+)
+
+func newJWKSKeySet(
+	cfg config.JWKS,
+	timeSource clock.TimeSource,
+	logger log.Logger,
+) (*jwksKeySet, error) {
+	refreshInterval := cfg.RefreshInterval
+	if refreshInterval <= 0 {
+		refreshInterval = defaultJWKSRefreshInterval
+	}
+	keySet := &jwksKeySet{
+		cfg:             cfg,
+		refreshInterval: refreshInterval,
+		httpClient:      &http.Client{Timeout: jwksRequestTimeout},
+		timeSource:      timeSource,
+		logger:          logger,
+		keys:            make(map[string]*jsonWebKey),
+	}
+	if err := keySet.refresh(); err != nil {
+		return nil, err
+	}
+	return keySet, nil
+}
+
+// getKey returns the key with the given ID. A token without key ID can be
+// verified if the set has a single key.
+func (k *jwksKeySet) getKey(keyID string) (*jsonWebKey, error) {
+	k.refreshIfStale()
+
+	key, refreshedAt := k.lookup(keyID)
+	if key != nil {
+		return key, nil
+	}
+	if k.timeSource.Now().Sub(refreshedAt) >= minJWKSRefreshInterval {
+		if err := k.refresh(); err != nil {
+			k.logger.Warn("failed to refresh JWKS", tag.Error(err))
+		}
+		if key, _ = k.lookup(keyID); key != nil {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("key %q is not found in JWKS", keyID)
+}
+
+func (k *jwksKeySet) lookup(keyID string) (*jsonWebKey, time.Time) {
+	k.RLock()
+	defer k.RUnlock()
+	if key, ok := k.keys[keyID]; ok {
+		return key, k.refreshedAt
+	}
+	if keyID == "" && len(k.keys) == 1 {
+		for _, key := range k.keys {
+			return key, k.refreshedAt
+		}
+	}
+	return nil, k.refreshedAt
+}
+
+func (k *jwksKeySet) refreshIfStale() {
+	k.RLock()
+	stale := k.timeSource.Now().Sub(k.refreshedAt) >= k.refreshInterval
+	k.RUnlock()
+	if !stale || !atomic.CompareAndSwapInt32(&k.refreshing, 0, 1) {
+		return
+	}
+	go func() {
+		defer atomic.StoreInt32(&k.refreshing, 0)
+		if err := k.refresh(); err != nil {
+			k.logger.Warn("failed to refresh JWKS", tag.Error(err))
+		}
+	}()
+}
+
+func (k *jwksKeySet) refresh() error {
+	k.refreshLock.Lock()
+	defer k.refreshLock.Unlock()
+
+	var keys map[string]*jsonWebKey
+	var modTime time.Time
+	var err error
+	if k.cfg.URL != "" {
+		keys, err = k.fetch()
+	} else {
+		keys, modTime, err = k.load()
+	}
+
+	k.Lock()
+	defer k.Unlock()
+	// failed attempts also count as refresh to limit the retries
+	k.refreshedAt = k.timeSource.Now()
+	if err != nil || keys == nil {
+		return err
+	}
+	k.keys = keys
+	k.fileModTime = modTime
+	return nil
+}
+
+func (k *jwksKeySet) fetch() (map[string]*jsonWebKey, error) {
+	resp, err := k.httpClient.Get(k.cfg.URL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS from %v: %v", k.cfg.URL, resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return parseJWKS(data)
+}
+
+// load reads the key set file, it returns nil keys if the file is not modified since the last load
+func (k *jwksKeySet) load() (map[string]*jsonWebKey, time.Time, error) {
+	info, err := os.Stat(k.cfg.File)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	k.RLock()
+	modified := !info.ModTime().Equal(k.fileModTime)
+	k.RUnlock()
+	if !modified {
+		return nil, time.Time{}, nil
+	}
+	data, err := ioutil.ReadFile(k.cfg.File)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	keys, err := parseJWKS(data)
+	return keys, info.ModTime(), err
+}
+
+func parseJWKS(data []byte) (map[string]*jsonWebKey, error) {
+	var document jwksDocument
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse JWKS: %v", err)
+	}
+	keys := make(map[string]*jsonWebKey)
+	for _, key := range document.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		publicKey, err := key.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWKS key %q: %v", key.Kid, err)
+		}
+		if publicKey == nil {
+			continue
+		}
+		keys[key.Kid] = &jsonWebKey{
+			id:        key.Kid,
+			algorithm: key.Alg,
+			key:       publicKey,
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS has no supported signing key")
+	}
+	return keys, nil
+}
+
+// publicKey returns nil for the key types which can't be used to verify RS256 or ES256 tokens
+func (k *jwksKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > int64(^uint32(0)>>1) {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, nil
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		curve := elliptic.P256()
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve P-256")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt/v3"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	jwksSuite struct {
+		suite.Suite
+		rsaKey     *rsa.PrivateKey
+		ecKey      *ecdsa.PrivateKey
+		server     *httptest.Server
+		documentMu sync.Mutex
+		document   jwksDocument
+	}
+)
+
+func TestJWKSSuite(t *testing.T) {
+	suite.Run(t, new(jwksSuite))
+}
+
+func (s *jwksSuite) SetupTest() {
+	var err error
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	s.setDocument(rsaJWK("rsa-1", &s.rsaKey.PublicKey), ecJWK("ec-1", &s.ecKey.PublicKey))
+
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.documentMu.Lock()
+		defer s.documentMu.Unlock()
+		s.NoError(json.NewEncoder(w).Encode(s.document))
+	}))
+}
+
+func (s *jwksSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *jwksSuite) TestAuthorize() {
+	authorizer, err := NewOAuthAuthorizer(s.oauthConfig(""), loggerimpl.NewNopLogger(), nil)
+	s.NoError(err)
+
+	rsaSigner, err := jwt.NewSignerRS(jwt.RS256, s.rsaKey)
+	s.NoError(err)
+	ecSigner, err := jwt.NewSignerES(jwt.ES256, s.ecKey)
+	s.NoError(err)
+
+	s.Equal(DecisionAllow, s.authorize(authorizer, s.adminToken(rsaSigner, "rsa-1")))
+	s.Equal(DecisionAllow, s.authorize(authorizer, s.adminToken(ecSigner, "ec-1")))
+	// key of another type
+	s.Equal(DecisionDeny, s.authorize(authorizer, s.adminToken(ecSigner, "rsa-1")))
+	// unknown key
+	s.Equal(DecisionDeny, s.authorize(authorizer, s.adminToken(rsaSigner, "rsa-2")))
+	// tokens without key ID are rejected if the set has more than one key
+	s.Equal(DecisionDeny, s.authorize(authorizer, s.adminToken(rsaSigner, "")))
+}
+
+func (s *jwksSuite) TestAuthorize_AllowedAlgorithm() {
+	authorizer, err := NewOAuthAuthorizer(s.oauthConfig(jwt.RS256.String()), loggerimpl.NewNopLogger(), nil)
+	s.NoError(err)
+
+	rsaSigner, err := jwt.NewSignerRS(jwt.RS256, s.rsaKey)
+	s.NoError(err)
+	ecSigner, err := jwt.NewSignerES(jwt.ES256, s.ecKey)
+	s.NoError(err)
+
+	s.Equal(DecisionAllow, s.authorize(authorizer, s.adminToken(rsaSigner, "rsa-1")))
+	s.Equal(DecisionDeny, s.authorize(authorizer, s.adminToken(ecSigner, "ec-1")))
+}
+
+func (s *jwksSuite) TestKeyRotation() {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	keySet, err := newJWKSKeySet(config.JWKS{URL: s.server.URL, RefreshInterval: time.Hour}, timeSource, loggerimpl.NewNopLogger())
+	s.NoError(err)
+
+	key, err := keySet.getKey("rsa-1")
+	s.NoError(err)
+	s.Equal(&s.rsaKey.PublicKey, key.key)
+
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.NoError(err)
+	s.setDocument(rsaJWK("rsa-2", &newKey.PublicKey))
+
+	// the set was just refreshed
+	_, err = keySet.getKey("rsa-2")
+	s.Error(err)
+
+	timeSource.Update(timeSource.Now().Add(minJWKSRefreshInterval))
+	key, err = keySet.getKey("rsa-2")
+	s.NoError(err)
+	s.Equal(&newKey.PublicKey, key.key)
+	_, err = keySet.getKey("rsa-1")
+	s.Error(err)
+	// a single key is used for tokens without key ID
+	key, err = keySet.getKey("")
+	s.NoError(err)
+	s.Equal("rsa-2", key.id)
+}
+
+func (s *jwksSuite) TestFile() {
+	dir, err := ioutil.TempDir("", "jwks")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "jwks.json")
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	s.writeDocument(path, modTime, rsaJWK("rsa-1", &s.rsaKey.PublicKey))
+
+	keySet, err := newJWKSKeySet(config.JWKS{File: path}, clock.NewRealTimeSource(), loggerimpl.NewNopLogger())
+	s.NoError(err)
+	_, err = keySet.getKey("rsa-1")
+	s.NoError(err)
+
+	// file with unchanged modification time is not reloaded
+	s.writeDocument(path, modTime, ecJWK("ec-1", &s.ecKey.PublicKey))
+	s.NoError(keySet.refresh())
+	_, err = keySet.getKey("rsa-1")
+	s.NoError(err)
+
+	s.writeDocument(path, time.Now(), ecJWK("ec-1", &s.ecKey.PublicKey))
+	s.NoError(keySet.refresh())
+	key, err := keySet.getKey("ec-1")
+	s.NoError(err)
+	s.Equal(&s.ecKey.PublicKey, key.key)
+}
+
+func (s *jwksSuite) TestParseJWKS() {
+	_, err := parseJWKS([]byte(`{"keys": []}`))
+	s.EqualError(err, "JWKS has no supported signing key")
+
+	_, err = parseJWKS([]byte(`{"keys": [{"kty": "oct", "kid": "hmac", "k": "c2VjcmV0"}]}`))
+	s.EqualError(err, "JWKS has no supported signing key")
+
+	_, err = parseJWKS([]byte(`{"keys": [{"kty": "RSA", "kid": "rsa", "n": "", "e": "AQAB"}]}`))
+	s.EqualError(err, `failed to parse JWKS key "rsa": empty value`)
+
+	encryptionKey := rsaJWK("enc", &s.rsaKey.PublicKey)
+	encryptionKey.Use = "enc"
+	data, err := json.Marshal(jwksDocument{Keys: []jwksKey{encryptionKey, ecJWK("ec-1", &s.ecKey.PublicKey)}})
+	s.NoError(err)
+	keys, err := parseJWKS(data)
+	s.NoError(err)
+	s.Len(keys, 1)
+	s.Equal("ES256", keys["ec-1"].algorithm)
+}
+
+func (s *jwksSuite) oauthConfig(algorithm string) config.OAuthAuthorizer {
+	return config.OAuthAuthorizer{
+		Enable: true,
+		JwtCredentials: config.JwtCredentials{
+			Algorithm: algorithm,
+			JWKS:      &config.JWKS{URL: s.server.URL},
+		},
+		MaxJwtTTL: 3600,
+	}
+}
+
+func (s *jwksSuite) adminToken(signer jwt.Signer, keyID string) string {
+	token, err := jwt.NewBuilder(signer, jwt.WithKeyID(keyID)).Build(JWTClaims{
+		Admin: true,
+		Iat:   time.Now().Unix(),
+		TTL:   600,
+	})
+	s.NoError(err)
+	return token.String()
+}
+
+func (s *jwksSuite) authorize(authorizer Authorizer, token string) Decision {
+	ctx, call := encoding.NewInboundCall(context.Background())
+	s.NoError(call.ReadFromRequest(&transport.Request{
+		Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token),
+	}))
+	result, err := authorizer.Authorize(ctx, &Attributes{DomainName: "test-domain", Permission: PermissionRead})
+	s.NoError(err)
+	return result.Decision
+}
+
+func (s *jwksSuite) setDocument(keys ...jwksKey) {
+	s.documentMu.Lock()
+	defer s.documentMu.Unlock()
+	s.document = jwksDocument{Keys: keys}
+}
+
+func (s *jwksSuite) writeDocument(path string, modTime time.Time, keys ...jwksKey) {
+	data, err := json.Marshal(jwksDocument{Keys: keys})
+	s.NoError(err)
+	s.NoError(ioutil.WriteFile(path, data, 0644))
+	s.NoError(os.Chtimes(path, modTime, modTime))
+}
+
+func rsaJWK(keyID string, key *rsa.PublicKey) jwksKey {
+	return jwksKey{
+		Kty: "RSA",
+		Kid: keyID,
+		Alg: "RS256",
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func ecJWK(keyID string, key *ecdsa.PublicKey) jwksKey {
+	return jwksKey{
+		Kty: "EC",
+		Kid: keyID,
+		Alg: "ES256",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(key.X.Bytes()),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.Bytes()),
+	}
+}
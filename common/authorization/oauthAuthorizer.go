@@ -22,10 +22,12 @@ package authorization
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cristalhq/jwt/v3"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -42,7 +45,24 @@ type oauthAuthority struct {
 	authorizationCfg config.OAuthAuthorizer
 	domainCache      cache.DomainCache
 	log              log.Logger
-	publicKey        *rsa.PublicKey
+	// publicKey is set when the token is verified with a static key
+	publicKey interface{}
+	// keySet is set when the token is verified with a key of a JWKS
+	keySet *jwksKeySet
+	// policy is the cluster level policy loaded from the policy file
+	policy *Policy
+	// domainPolicies caches the parsed domain policies by domain ID
+	domainPolicies     map[string]*domainPolicyEntry
+	domainPoliciesLock sync.RWMutex
+}
+
+// domainPolicyEntry is a parsed domain policy, valid as long as the notification version
+// of the domain and the policy JSON in its data don't change
+type domainPolicyEntry struct {
+	notificationVersion int64
+	rawPolicy           string
+	policy              *Policy
+	err                 error
 }
 
 type JWTClaims struct {
@@ -62,16 +82,32 @@ func NewOAuthAuthorizer(
 	log log.Logger,
 	domainCache cache.DomainCache,
 ) (Authorizer, error) {
-	publicKey, err := common.LoadRSAPublicKey(authorizationCfg.JwtCredentials.PublicKey)
-	if err != nil {
-		return nil, err
-	}
-	return &oauthAuthority{
+	authority := &oauthAuthority{
 		authorizationCfg: authorizationCfg,
 		domainCache:      domainCache,
 		log:              log,
-		publicKey:        publicKey,
-	}, nil
+		domainPolicies:   make(map[string]*domainPolicyEntry),
+	}
+	credentials := authorizationCfg.JwtCredentials
+	var err error
+	switch {
+	case credentials.JWKS != nil:
+		authority.keySet, err = newJWKSKeySet(*credentials.JWKS, clock.NewRealTimeSource(), log)
+	case credentials.Algorithm == jwt.ES256.String():
+		authority.publicKey, err = common.LoadECDSAPublicKey(credentials.PublicKey)
+	default:
+		authority.publicKey, err = common.LoadRSAPublicKey(credentials.PublicKey)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if authorizationCfg.PolicyFile != "" {
+		authority.policy, err = LoadPolicyFile(authorizationCfg.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return authority, nil
 }
 
 // Authorize defines the logic to verify get claims from token
@@ -80,15 +116,26 @@ func (a *oauthAuthority) Authorize(
 	attributes *Attributes,
 ) (Result, error) {
 	call := yarpc.CallFromContext(ctx)
-	verifier, err := a.getVerifier()
-	if err != nil {
-		return Result{Decision: DecisionDeny}, err
+	var verifier jwt.Verifier
+	var err error
+	if a.keySet == nil {
+		verifier, err = a.getVerifier()
+		if err != nil {
+			return Result{Decision: DecisionDeny}, err
+		}
 	}
 	token := call.Header(common.AuthorizationTokenHeaderName)
 	if token == "" {
 		a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("token is not set in header")))
 		return Result{Decision: DecisionDeny}, nil
 	}
+	if a.keySet != nil {
+		verifier, err = a.getKeySetVerifier(token)
+		if err != nil {
+			a.log.Debug("request is not authorized", tag.Error(err))
+			return Result{Decision: DecisionDeny}, nil
+		}
+	}
 	claims, err := a.parseToken(token, verifier)
 	if err != nil {
 		a.log.Debug("request is not authorized", tag.Error(err))
@@ -107,6 +154,17 @@ func (a *oauthAuthority) Authorize(
 		return Result{Decision: DecisionDeny}, err
 	}
 
+	decision, matched, err := a.evaluatePolicies(claims, attributes, domain)
+	if err != nil {
+		a.log.Warn("request is denied by invalid authorization policy", tag.WorkflowDomainName(attributes.DomainName), tag.Error(err))
+		return Result{Decision: DecisionDeny}, nil
+	}
+	if matched {
+		if decision == DecisionDeny {
+			a.log.Debug("request is not authorized", tag.Error(fmt.Errorf("request is denied by authorization policy, jwt groups: %v, API: %v", claims.Groups, attributes.APIName)))
+		}
+		return Result{Decision: decision}, nil
+	}
 	err = a.validatePermission(claims, attributes, domain.GetInfo().Data)
 	if err != nil {
 		a.log.Debug("request is not authorized", tag.Error(err))
@@ -118,13 +176,47 @@ func (a *oauthAuthority) Authorize(
 func (a *oauthAuthority) getVerifier() (jwt.Verifier, error) {
 
 	algorithm := jwt.Algorithm(a.authorizationCfg.JwtCredentials.Algorithm)
-	verifier, err := jwt.NewVerifierRS(algorithm, a.publicKey)
+	verifier, err := newVerifier(algorithm, a.publicKey)
 	if err != nil {
 		return nil, err
 	}
 	return verifier, nil
 }
 
+// getKeySetVerifier returns the verifier for the JWKS key picked by the kid header of the token
+func (a *oauthAuthority) getKeySetVerifier(tokenStr string) (jwt.Verifier, error) {
+	token, err := jwt.ParseString(tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	header := token.Header()
+	if header.Algorithm != jwt.RS256 && header.Algorithm != jwt.ES256 {
+		return nil, fmt.Errorf("token algorithm %v is not supported", header.Algorithm)
+	}
+	if allowed := a.authorizationCfg.JwtCredentials.Algorithm; allowed != "" && header.Algorithm.String() != allowed {
+		return nil, fmt.Errorf("token algorithm %v is not allowed", header.Algorithm)
+	}
+	key, err := a.keySet.getKey(header.KeyID)
+	if err != nil {
+		return nil, err
+	}
+	if key.algorithm != "" && key.algorithm != header.Algorithm.String() {
+		return nil, fmt.Errorf("token algorithm %v doesn't match the algorithm of key %q", header.Algorithm, key.id)
+	}
+	return newVerifier(header.Algorithm, key.key)
+}
+
+func newVerifier(algorithm jwt.Algorithm, publicKey interface{}) (jwt.Verifier, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return jwt.NewVerifierRS(algorithm, key)
+	case *ecdsa.PublicKey:
+		return jwt.NewVerifierES(algorithm, key)
+	default:
+		return nil, fmt.Errorf("public key type %T is not supported", publicKey)
+	}
+}
+
 func (a *oauthAuthority) parseToken(tokenStr string, verifier jwt.Verifier) (*JWTClaims, error) {
 	token, verifyErr := jwt.ParseAndVerifyString(tokenStr, verifier)
 	if verifyErr != nil {
@@ -145,6 +237,47 @@ func (a *oauthAuthority) validateTTL(claims *JWTClaims) error {
 	return nil
 }
 
+// evaluatePolicies evaluates the rules of the policy file and the domain policy
+func (a *oauthAuthority) evaluatePolicies(claims *JWTClaims, attributes *Attributes, domain *cache.DomainCacheEntry) (Decision, bool, error) {
+	domainPolicy, err := a.getDomainPolicy(
+		domain.GetInfo().ID,
+		domain.GetNotificationVersion(),
+		domain.GetInfo().Data[common.DomainDataKeyForAuthorizationPolicy],
+	)
+	if err != nil {
+		return DecisionDeny, true, err
+	}
+	decision, matched := evaluatePolicies(strings.Split(claims.Groups, groupSeparator), attributes, a.policy, domainPolicy)
+	return decision, matched, nil
+}
+
+// getDomainPolicy returns the parsed policy of the domain data, parsing it only when the domain
+// has been updated since the last call
+func (a *oauthAuthority) getDomainPolicy(domainID string, notificationVersion int64, rawPolicy string) (*Policy, error) {
+	if rawPolicy == "" {
+		return nil, nil
+	}
+
+	a.domainPoliciesLock.RLock()
+	entry, ok := a.domainPolicies[domainID]
+	a.domainPoliciesLock.RUnlock()
+	if ok && entry.notificationVersion == notificationVersion && entry.rawPolicy == rawPolicy {
+		return entry.policy, entry.err
+	}
+
+	policy, err := ParsePolicy(rawPolicy)
+	entry = &domainPolicyEntry{
+		notificationVersion: notificationVersion,
+		rawPolicy:           rawPolicy,
+		policy:              policy,
+		err:                 err,
+	}
+	a.domainPoliciesLock.Lock()
+	a.domainPolicies[domainID] = entry
+	a.domainPoliciesLock.Unlock()
+	return policy, err
+}
+
 func (a *oauthAuthority) validatePermission(claims *JWTClaims, attributes *Attributes, data map[string]string) error {
 	groups := ""
 	switch attributes.Permission {
@@ -251,3 +251,82 @@ func (s *oauthSuite) TestIncorrectPermission() {
 	s.NoError(err)
 	s.Equal(result.Decision, DecisionDeny)
 }
+
+func (s *oauthSuite) TestDomainPolicyDeny() {
+	s.domainEntry.GetInfo().Data[common.DomainDataKeyForAuthorizationPolicy] = `{"rules": [{"effect": "deny", "groups": ["c"], "apis": ["DescribeWorkflowExecution"]}]}`
+	s.domainCache.EXPECT().GetDomain(s.att.DomainName).Return(s.domainEntry, nil).Times(1)
+	s.att.APIName = "DescribeWorkflowExecution"
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+	s.logger.On("Debug", "request is not authorized", mock.MatchedBy(func(t []tag.Tag) bool {
+		return fmt.Sprintf("%v", t[0].Field().Interface) == "request is denied by authorization policy, jwt groups: a b c, API: DescribeWorkflowExecution"
+	}))
+	result, err := authorizer.Authorize(s.ctx, &s.att)
+	s.NoError(err)
+	s.Equal(result.Decision, DecisionDeny)
+}
+
+func (s *oauthSuite) TestDomainPolicyAllow() {
+	s.domainEntry.GetInfo().Data[common.DomainDataKeyForAuthorizationPolicy] = `{"rules": [{"effect": "allow", "groups": ["a"], "apis": ["SignalWorkflowExecution"]}]}`
+	s.domainCache.EXPECT().GetDomain(s.att.DomainName).Return(s.domainEntry, nil).Times(1)
+	s.att.APIName = "SignalWorkflowExecution"
+	s.att.Permission = PermissionWrite
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+	result, err := authorizer.Authorize(s.ctx, &s.att)
+	s.NoError(err)
+	s.Equal(result.Decision, DecisionAllow)
+}
+
+func (s *oauthSuite) TestInvalidDomainPolicy() {
+	s.domainEntry.GetInfo().Data[common.DomainDataKeyForAuthorizationPolicy] = `{"rules": [{"effect": "block"}]}`
+	s.domainCache.EXPECT().GetDomain(s.att.DomainName).Return(s.domainEntry, nil).Times(1)
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+	s.logger.On("Warn", "request is denied by invalid authorization policy", mock.Anything)
+	result, err := authorizer.Authorize(s.ctx, &s.att)
+	s.NoError(err)
+	s.Equal(result.Decision, DecisionDeny)
+}
+
+func (s *oauthSuite) TestIncorrectPolicyFile() {
+	s.cfg.PolicyFile = "incorrectPolicyFile"
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.Nil(authorizer)
+	s.Error(err)
+}
+
+func (s *oauthSuite) TestDomainPolicyCache() {
+	authorizer, err := NewOAuthAuthorizer(s.cfg, s.logger, s.domainCache)
+	s.NoError(err)
+	authority := authorizer.(*oauthAuthority)
+	denyPolicy := `{"rules": [{"effect": "deny", "groups": ["c"], "apis": ["DescribeWorkflowExecution"]}]}`
+	allowPolicy := `{"rules": [{"effect": "allow", "groups": ["a"], "apis": ["SignalWorkflowExecution"]}]}`
+
+	policy, err := authority.getDomainPolicy("test-domain-id", 1, "")
+	s.NoError(err)
+	s.Nil(policy)
+
+	policy, err = authority.getDomainPolicy("test-domain-id", 1, denyPolicy)
+	s.NoError(err)
+	s.NotNil(policy)
+	cached, err := authority.getDomainPolicy("test-domain-id", 1, denyPolicy)
+	s.NoError(err)
+	s.True(policy == cached)
+
+	// a new notification version invalidates the cached policy
+	updated, err := authority.getDomainPolicy("test-domain-id", 2, denyPolicy)
+	s.NoError(err)
+	s.False(policy == updated)
+	s.Equal(policy, updated)
+
+	// so does a change of the policy without a notification version bump
+	updated, err = authority.getDomainPolicy("test-domain-id", 2, allowPolicy)
+	s.NoError(err)
+	s.Equal(PolicyEffectAllow, updated.Rules[0].Effect)
+
+	_, err = authority.getDomainPolicy("test-domain-id", 3, `{"rules": [{"effect": "block"}]}`)
+	s.Error(err)
+	_, err = authority.getDomainPolicy("test-domain-id", 3, `{"rules": [{"effect": "block"}]}`)
+	s.Error(err)
+}
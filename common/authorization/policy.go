@@ -0,0 +1,238 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// PolicyEffectAllow grants the read or write permission to the requests matching the rule
+	PolicyEffectAllow = "allow"
+	// PolicyEffectDeny rejects the requests matching the rule
+	PolicyEffectDeny = "deny"
+
+	policyWildcard = "*"
+)
+
+var (
+	// workflowTypeAPIs are the APIs whose authorization attributes carry the workflow type
+	workflowTypeAPIs = map[string]struct{}{
+		"StartWorkflowExecution":           {},
+		"SignalWithStartWorkflowExecution": {},
+		"RecordActivityTaskHeartbeat":      {},
+		"RespondActivityTaskCompleted":     {},
+		"RespondActivityTaskFailed":        {},
+		"RespondActivityTaskCanceled":      {},
+		"RespondDecisionTaskCompleted":     {},
+		"RespondDecisionTaskFailed":        {},
+	}
+	// taskListAPIs are the APIs whose authorization attributes carry the task list
+	taskListAPIs = map[string]struct{}{
+		"StartWorkflowExecution":           {},
+		"SignalWithStartWorkflowExecution": {},
+		"PollForDecisionTask":              {},
+		"PollForActivityTask":              {},
+		"RespondQueryTaskCompleted":        {},
+		"DescribeTaskList":                 {},
+		"ListTaskListPartitions":           {},
+	}
+)
+
+type (
+	// Policy is a list of rules which allow or deny requests by API name, workflow type and task list.
+	// Policies are stored in the domain data under common.DomainDataKeyForAuthorizationPolicy
+	// as JSON, or in the policy file of the authorizer as YAML.
+	//
+	// A request matching any deny rule is rejected, otherwise a request matching any allow rule
+	// is accepted. Requests not matching any rule fall back to the READ_GROUPS / WRITE_GROUPS check.
+	Policy struct {
+		Rules []PolicyRule `json:"rules" yaml:"rules"`
+	}
+
+	// PolicyRule matches a request if each of its non-empty fields matches.
+	// Values are exact names, "*" for any, or a prefix ending with "*".
+	PolicyRule struct {
+		// Effect is either "allow" or "deny"
+		Effect string `json:"effect" yaml:"effect"`
+		// Groups of the caller, the rule matches if the caller is in any of them
+		Groups []string `json:"groups" yaml:"groups"`
+		// Domains is only used in the policy file, rules in the domain data apply to the domain itself
+		Domains []string `json:"domains" yaml:"domains"`
+		// APIs are the names of the frontend APIs, e.g. StartWorkflowExecution
+		APIs []string `json:"apis" yaml:"apis"`
+		// WorkflowTypes only match the APIs which carry the workflow type,
+		// e.g. StartWorkflowExecution, SignalWithStartWorkflowExecution and activity task completions.
+		// Deny rules with WorkflowTypes must list such APIs by their exact names, as e.g. signals
+		// and terminations of the workflows of a denied type can't be matched by their type.
+		WorkflowTypes []string `json:"workflowTypes" yaml:"workflowTypes"`
+		// TaskLists only match the APIs which carry the task list, e.g. PollForDecisionTask.
+		// Deny rules with TaskLists must list such APIs by their exact names.
+		TaskLists []string `json:"taskLists" yaml:"taskLists"`
+	}
+)
+
+// ParsePolicy parses a JSON policy stored in the domain data
+func ParsePolicy(data string) (*Policy, error) {
+	var policy Policy
+	if err := json.Unmarshal([]byte(data), &policy); err != nil {
+		return nil, fmt.Errorf("invalid authorization policy: %v", err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// LoadPolicyFile loads a YAML policy file
+func LoadPolicyFile(path string) (*Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read authorization policy file %v: %v", path, err)
+	}
+	var policy Policy
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return nil, fmt.Errorf("invalid authorization policy file %v: %v", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// Validate returns an error if any rule of the policy is invalid
+func (p *Policy) Validate() error {
+	for i, rule := range p.Rules {
+		if rule.Effect != PolicyEffectAllow && rule.Effect != PolicyEffectDeny {
+			return fmt.Errorf("invalid authorization policy: rule %v has unknown effect %q", i, rule.Effect)
+		}
+		if rule.Effect != PolicyEffectDeny {
+			continue
+		}
+		if len(rule.WorkflowTypes) > 0 {
+			if err := validateDenyRuleAPIs(rule.APIs, workflowTypeAPIs); err != nil {
+				return fmt.Errorf("invalid authorization policy: rule %v denies workflow types %v", i, err)
+			}
+		}
+		if len(rule.TaskLists) > 0 {
+			if err := validateDenyRuleAPIs(rule.APIs, taskListAPIs); err != nil {
+				return fmt.Errorf("invalid authorization policy: rule %v denies task lists %v", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateDenyRuleAPIs returns an error unless the APIs are exact names of the APIs carrying the denied attribute,
+// otherwise the requests of the other APIs would not be denied
+func validateDenyRuleAPIs(apis []string, supportedAPIs map[string]struct{}) error {
+	if len(apis) == 0 {
+		return errors.New("without apis")
+	}
+	for _, api := range apis {
+		if _, ok := supportedAPIs[api]; !ok {
+			return fmt.Errorf("on api %q which doesn't carry them", api)
+		}
+	}
+	return nil
+}
+
+// evaluatePolicies returns the decision of the rules matching the request, and false if none matches
+func evaluatePolicies(groups []string, attributes *Attributes, policies ...*Policy) (Decision, bool) {
+	allowed := false
+	for _, policy := range policies {
+		if policy == nil {
+			continue
+		}
+		for _, rule := range policy.Rules {
+			if !rule.matches(groups, attributes) {
+				continue
+			}
+			if rule.Effect == PolicyEffectDeny {
+				return DecisionDeny, true
+			}
+			allowed = true
+		}
+	}
+	if !allowed {
+		return DecisionDeny, false
+	}
+	// rules grant domain level permissions only
+	if attributes.Permission != PermissionRead && attributes.Permission != PermissionWrite {
+		return DecisionDeny, true
+	}
+	return DecisionAllow, true
+}
+
+func (r *PolicyRule) matches(groups []string, attributes *Attributes) bool {
+	if len(r.Groups) > 0 && !matchesAny(r.Groups, groups...) {
+		return false
+	}
+	if len(r.Domains) > 0 && !matchesAny(r.Domains, attributes.DomainName) {
+		return false
+	}
+	if len(r.APIs) > 0 && !matchesAny(r.APIs, attributes.APIName) {
+		return false
+	}
+	if len(r.WorkflowTypes) > 0 && !r.matchesAttribute(r.WorkflowTypes, attributes.WorkflowType != nil, attributes.WorkflowType.GetName()) {
+		return false
+	}
+	if len(r.TaskLists) > 0 && !r.matchesAttribute(r.TaskLists, attributes.TaskList != nil, attributes.TaskList.GetName()) {
+		return false
+	}
+	return true
+}
+
+// matchesAttribute returns true if the value of an attribute matches any pattern.
+// Deny rules match the requests missing the attribute, e.g. the ones with task tokens from older versions.
+func (r *PolicyRule) matchesAttribute(patterns []string, isSet bool, value string) bool {
+	if !isSet {
+		return r.Effect == PolicyEffectDeny
+	}
+	return matchesAny(patterns, value)
+}
+
+func matchesAny(patterns []string, values ...string) bool {
+	for _, pattern := range patterns {
+		for _, value := range values {
+			if matchesPattern(pattern, value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchesPattern(pattern string, value string) bool {
+	if pattern == policyWildcard {
+		return true
+	}
+	if strings.HasSuffix(pattern, policyWildcard) {
+		return strings.HasPrefix(value, strings.TrimSuffix(pattern, policyWildcard))
+	}
+	return pattern == value
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestEvaluatePolicies(t *testing.T) {
+	domainPolicy := &Policy{
+		Rules: []PolicyRule{
+			{
+				Effect: PolicyEffectDeny,
+				Groups: []string{"contractors"},
+				APIs:   []string{"TerminateWorkflowExecution", "ResetWorkflowExecution"},
+			},
+			{
+				Effect:        PolicyEffectAllow,
+				Groups:        []string{"payments"},
+				APIs:          []string{"StartWorkflowExecution", "SignalWithStartWorkflowExecution"},
+				WorkflowTypes: []string{"payments.*"},
+			},
+			{
+				Effect:    PolicyEffectAllow,
+				Groups:    []string{"payments-workers"},
+				APIs:      []string{"PollFor*"},
+				TaskLists: []string{"payments-tl"},
+			},
+		},
+	}
+	clusterPolicy := &Policy{
+		Rules: []PolicyRule{
+			{
+				Effect:        PolicyEffectDeny,
+				Domains:       []string{"prod-*"},
+				APIs:          []string{"StartWorkflowExecution", "RespondActivityTaskCompleted"},
+				WorkflowTypes: []string{"payments.debug*"},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		groups           []string
+		attributes       Attributes
+		expectedDecision Decision
+		expectedMatched  bool
+	}{
+		"deny by API": {
+			groups:           []string{"a", "contractors"},
+			attributes:       Attributes{APIName: "TerminateWorkflowExecution", Permission: PermissionWrite},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  true,
+		},
+		"allow by workflow type prefix": {
+			groups: []string{"payments"},
+			attributes: Attributes{
+				APIName:      "StartWorkflowExecution",
+				WorkflowType: &types.WorkflowType{Name: "payments.refund"},
+				Permission:   PermissionWrite,
+			},
+			expectedDecision: DecisionAllow,
+			expectedMatched:  true,
+		},
+		"workflow type not matched": {
+			groups: []string{"payments"},
+			attributes: Attributes{
+				APIName:      "StartWorkflowExecution",
+				WorkflowType: &types.WorkflowType{Name: "orders.create"},
+				Permission:   PermissionWrite,
+			},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  false,
+		},
+		"workflow type not set on request": {
+			groups:           []string{"payments"},
+			attributes:       Attributes{APIName: "StartWorkflowExecution", Permission: PermissionWrite},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  false,
+		},
+		"allow by task list": {
+			groups: []string{"payments-workers"},
+			attributes: Attributes{
+				APIName:    "PollForActivityTask",
+				TaskList:   &types.TaskList{Name: "payments-tl"},
+				Permission: PermissionWrite,
+			},
+			expectedDecision: DecisionAllow,
+			expectedMatched:  true,
+		},
+		"group not matched": {
+			groups: []string{"orders-workers"},
+			attributes: Attributes{
+				APIName:    "PollForActivityTask",
+				TaskList:   &types.TaskList{Name: "payments-tl"},
+				Permission: PermissionWrite,
+			},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  false,
+		},
+		"deny has precedence": {
+			groups: []string{"payments"},
+			attributes: Attributes{
+				APIName:      "StartWorkflowExecution",
+				DomainName:   "prod-payments",
+				WorkflowType: &types.WorkflowType{Name: "payments.debug"},
+				Permission:   PermissionWrite,
+			},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  true,
+		},
+		"domain not matched": {
+			groups: []string{"payments"},
+			attributes: Attributes{
+				APIName:      "StartWorkflowExecution",
+				DomainName:   "staging-payments",
+				WorkflowType: &types.WorkflowType{Name: "payments.debug"},
+				Permission:   PermissionWrite,
+			},
+			expectedDecision: DecisionAllow,
+			expectedMatched:  true,
+		},
+		"deny matches request missing workflow type": {
+			groups: []string{"payments"},
+			attributes: Attributes{
+				APIName:    "RespondActivityTaskCompleted",
+				DomainName: "prod-payments",
+				Permission: PermissionWrite,
+			},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  true,
+		},
+		"admin permission is not granted": {
+			groups: []string{"payments-workers"},
+			attributes: Attributes{
+				APIName:    "PollForDecisionTask",
+				TaskList:   &types.TaskList{Name: "payments-tl"},
+				Permission: PermissionAdmin,
+			},
+			expectedDecision: DecisionDeny,
+			expectedMatched:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			decision, matched := evaluatePolicies(test.groups, &test.attributes, clusterPolicy, domainPolicy, nil)
+			assert.Equal(t, test.expectedDecision, decision)
+			assert.Equal(t, test.expectedMatched, matched)
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	policy, err := ParsePolicy(`{"rules": [{"effect": "deny", "groups": ["a"], "apis": ["TerminateWorkflowExecution"]}]}`)
+	require.NoError(t, err)
+	assert.Equal(t, &Policy{
+		Rules: []PolicyRule{
+			{Effect: PolicyEffectDeny, Groups: []string{"a"}, APIs: []string{"TerminateWorkflowExecution"}},
+		},
+	}, policy)
+
+	_, err = ParsePolicy(`{"rules": [{"effect": "block"}]}`)
+	assert.EqualError(t, err, `invalid authorization policy: rule 0 has unknown effect "block"`)
+
+	_, err = ParsePolicy(`rules`)
+	assert.Error(t, err)
+
+	_, err = ParsePolicy(`{"rules": [{"effect": "deny", "workflowTypes": ["payments.*"]}]}`)
+	assert.EqualError(t, err, `invalid authorization policy: rule 0 denies workflow types without apis`)
+
+	_, err = ParsePolicy(`{"rules": [{"effect": "deny", "apis": ["StartWorkflowExecution", "SignalWorkflowExecution"], "workflowTypes": ["payments.*"]}]}`)
+	assert.EqualError(t, err, `invalid authorization policy: rule 0 denies workflow types on api "SignalWorkflowExecution" which doesn't carry them`)
+
+	_, err = ParsePolicy(`{"rules": [{"effect": "deny", "apis": ["PollFor*"], "taskLists": ["critical"]}]}`)
+	assert.EqualError(t, err, `invalid authorization policy: rule 0 denies task lists on api "PollFor*" which doesn't carry them`)
+
+	_, err = ParsePolicy(`{"rules": [{"effect": "allow", "workflowTypes": ["payments.*"]}]}`)
+	assert.NoError(t, err)
+}
+
+func TestLoadPolicyFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "policy*.yaml")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`
+rules:
+  - effect: deny
+    domains: ["prod-*"]
+    apis: ["PollForDecisionTask"]
+    taskLists: ["critical"]
+`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	policy, err := LoadPolicyFile(file.Name())
+	require.NoError(t, err)
+	assert.Equal(t, &Policy{
+		Rules: []PolicyRule{
+			{
+				Effect:    PolicyEffectDeny,
+				Domains:   []string{"prod-*"},
+				APIs:      []string{"PollForDecisionTask"},
+				TaskLists: []string{"critical"},
+			},
+		},
+	}, policy)
+
+	_, err = LoadPolicyFile("not-exists.yaml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	client struct {
+		bucket *storage.BucketHandle
+		prefix string
+	}
+)
+
+// NewGstorageClient constructs a blobstore backed by a google storage bucket.
+// The bucket must already exist, it's not created by the client.
+func NewGstorageClient(cfg *config.GstorageBlobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("gstorage blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for gstorage blobstore")
+	}
+	var opts []option.ClientOption
+	if len(cfg.CredentialsPath) != 0 {
+		opts = append(opts, option.WithCredentialsFile(cfg.CredentialsPath))
+	}
+	nativeClient, err := storage.NewClient(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		bucket: nativeClient.Bucket(cfg.Bucket),
+		prefix: strings.Trim(cfg.Prefix, "/"),
+	}, nil
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	writer := c.bucket.Object(c.objectName(request.Key)).NewWriter(ctx)
+	writer.Metadata = request.Blob.Tags
+	if _, err := io.Copy(writer, bytes.NewReader(request.Blob.Body)); err != nil {
+		writer.CloseWithError(err)
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	object := c.bucket.Object(c.objectName(request.Key))
+	attrs, err := object.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// read the generation the attributes were fetched from so body and tags match
+	reader, err := object.Generation(attrs.Generation).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string, len(attrs.Metadata))
+	for key, value := range attrs.Metadata {
+		tags[key] = value
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: data,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.bucket.Object(c.objectName(request.Key)).Attrs(ctx)
+	if err == storage.ErrObjectNotExist {
+		return &blobstore.ExistsResponse{Exists: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{
+		Exists: true,
+	}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if err := c.bucket.Object(c.objectName(request.Key)).Delete(ctx); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	return isRetryableError(err)
+}
+
+func (c *client) objectName(key string) string {
+	if len(c.prefix) == 0 {
+		return key
+	}
+	return c.prefix + "/" + key
+}
+
+func isRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if gerr, ok := err.(*googleapi.Error); ok {
+		return gerr.Code == http.StatusTooManyRequests ||
+			(gerr.Code >= http.StatusInternalServerError && gerr.Code != http.StatusNotImplemented)
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gcloud
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestNewGstorageClient_InvalidConfig(t *testing.T) {
+	_, err := NewGstorageClient(nil)
+	assert.Error(t, err)
+	_, err = NewGstorageClient(&config.GstorageBlobstore{})
+	assert.Error(t, err)
+}
+
+func TestIsRetryableError(t *testing.T) {
+	assert.False(t, isRetryableError(nil))
+	assert.False(t, isRetryableError(errors.New("some error")))
+	assert.False(t, isRetryableError(&googleapi.Error{Code: http.StatusForbidden}))
+	assert.False(t, isRetryableError(&googleapi.Error{Code: http.StatusNotImplemented}))
+	assert.True(t, isRetryableError(&googleapi.Error{Code: http.StatusTooManyRequests}))
+	assert.True(t, isRetryableError(&googleapi.Error{Code: http.StatusBadGateway}))
+	assert.True(t, isRetryableError(io.ErrUnexpectedEOF))
+}
+
+func TestObjectName(t *testing.T) {
+	assert.Equal(t, "key", (&client{}).objectName("key"))
+	assert.Equal(t, "prefix/key", (&client{prefix: "prefix"}).objectName("key"))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"errors"
+	"time"
+
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/gcloud"
+	"github.com/uber/cadence/common/blobstore/s3store"
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	retryInitialInterval    = 100 * time.Millisecond
+	retryMaxInterval        = 5 * time.Second
+	retryExpirationInterval = time.Minute
+)
+
+var (
+	errNoBlobstoreConfigured       = errors.New("no blobstore is configured")
+	errMultipleBlobstoreConfigured = errors.New("only one of filestore, s3store and gstorage blobstore can be configured")
+)
+
+// NewClient constructs the blobstore client selected by the config,
+// transient errors of the underlying store are retried by the returned client
+func NewClient(cfg *config.Blobstore) (blobstore.Client, error) {
+	configured := 0
+	for _, set := range []bool{cfg.Filestore != nil, cfg.S3store != nil, cfg.Gstorage != nil} {
+		if set {
+			configured++
+		}
+	}
+	switch configured {
+	case 0:
+		return nil, errNoBlobstoreConfigured
+	case 1:
+	default:
+		return nil, errMultipleBlobstoreConfigured
+	}
+
+	var client blobstore.Client
+	var err error
+	switch {
+	case cfg.Filestore != nil:
+		client, err = filestore.NewFilestoreClient(cfg.Filestore)
+	case cfg.S3store != nil:
+		client, err = s3store.NewS3Client(cfg.S3store)
+	case cfg.Gstorage != nil:
+		client, err = gcloud.NewGstorageClient(cfg.Gstorage)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return blobstore.NewRetryableClient(client, createRetryPolicy()), nil
+}
+
+func createRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(retryInitialInterval)
+	policy.SetMaximumInterval(retryMaxInterval)
+	policy.SetExpirationInterval(retryExpirationInterval)
+	return policy
+}
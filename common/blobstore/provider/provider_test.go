@@ -0,0 +1,64 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provider
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestNewClient_NoneConfigured(t *testing.T) {
+	_, err := NewClient(&config.Blobstore{})
+	assert.Equal(t, errNoBlobstoreConfigured, err)
+}
+
+func TestNewClient_MultipleConfigured(t *testing.T) {
+	_, err := NewClient(&config.Blobstore{
+		Filestore: &config.FileBlobstore{OutputDirectory: "/tmp/blobstore"},
+		S3store:   &config.S3Blobstore{Bucket: "bucket"},
+	})
+	assert.Equal(t, errMultipleBlobstoreConfigured, err)
+}
+
+func TestNewClient_Filestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestNewClient_Filestore")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	client, err := NewClient(&config.Blobstore{
+		Filestore: &config.FileBlobstore{OutputDirectory: dir},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+}
+
+func TestNewClient_InvalidStoreConfig(t *testing.T) {
+	_, err := NewClient(&config.Blobstore{
+		S3store: &config.S3Blobstore{Bucket: "bucket"},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/s3util"
+)
+
+const (
+	// tagsMetadataKey is the user metadata key the json encoded blob tags are stored under
+	tagsMetadataKey = "Cadence-Tags"
+)
+
+type (
+	client struct {
+		s3cli  s3iface.S3API
+		bucket string
+		prefix string
+	}
+)
+
+// NewS3Client constructs a blobstore backed by a S3 bucket
+func NewS3Client(cfg *config.S3Blobstore) (blobstore.Client, error) {
+	if cfg == nil {
+		return nil, errors.New("s3 blobstore config is nil")
+	}
+	if len(cfg.Bucket) == 0 {
+		return nil, errors.New("bucket not given for s3 blobstore")
+	}
+	if len(cfg.Region) == 0 {
+		return nil, errors.New("region not given for s3 blobstore")
+	}
+	sess, err := s3util.NewSession(&cfg.S3Archiver)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(s3.New(sess), cfg), nil
+}
+
+func newClient(s3cli s3iface.S3API, cfg *config.S3Blobstore) *client {
+	return &client{
+		s3cli:  s3cli,
+		bucket: cfg.Bucket,
+		prefix: strings.Trim(cfg.Prefix, "/"),
+	}
+}
+
+// Put stores a blob
+func (c *client) Put(ctx context.Context, request *blobstore.PutRequest) (*blobstore.PutResponse, error) {
+	input := &s3.PutObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+		Body:   bytes.NewReader(request.Blob.Body),
+	}
+	if len(request.Blob.Tags) != 0 {
+		tagsData, err := json.Marshal(request.Blob.Tags)
+		if err != nil {
+			return nil, err
+		}
+		input.Metadata = map[string]*string{tagsMetadataKey: aws.String(string(tagsData))}
+	}
+	if _, err := c.s3cli.PutObjectWithContext(ctx, input); err != nil {
+		return nil, err
+	}
+	return &blobstore.PutResponse{}, nil
+}
+
+// Get fetches a blob
+func (c *client) Get(ctx context.Context, request *blobstore.GetRequest) (*blobstore.GetResponse, error) {
+	output, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer output.Body.Close()
+	data, err := ioutil.ReadAll(output.Body)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	for key, value := range output.Metadata {
+		// S3 canonicalizes the case of metadata keys
+		if strings.EqualFold(key, tagsMetadataKey) && value != nil {
+			if err := json.Unmarshal([]byte(*value), &tags); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &blobstore.GetResponse{
+		Blob: blobstore.Blob{
+			Body: data,
+			Tags: tags,
+		},
+	}, nil
+}
+
+// Exists determines if a blob exists
+func (c *client) Exists(ctx context.Context, request *blobstore.ExistsRequest) (*blobstore.ExistsResponse, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	})
+	if err != nil {
+		if s3util.IsNotFoundError(err) {
+			return &blobstore.ExistsResponse{Exists: false}, nil
+		}
+		return nil, err
+	}
+	return &blobstore.ExistsResponse{
+		Exists: true,
+	}, nil
+}
+
+// Delete deletes a blob
+func (c *client) Delete(ctx context.Context, request *blobstore.DeleteRequest) (*blobstore.DeleteResponse, error) {
+	if _, err := c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(request.Key)),
+	}); err != nil {
+		return nil, err
+	}
+	return &blobstore.DeleteResponse{}, nil
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func (c *client) IsRetryableError(err error) bool {
+	return s3util.IsRetryableError(err)
+}
+
+func (c *client) objectKey(key string) string {
+	if len(c.prefix) == 0 {
+		return key
+	}
+	return c.prefix + "/" + key
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/archiver/s3store/mocks"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/config"
+)
+
+const (
+	testBucket = "test-bucket"
+	testPrefix = "scanner/output"
+)
+
+type ClientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	s3cli  *mocks.S3API
+	client *client
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.s3cli = &mocks.S3API{}
+	s.client = newClient(s.s3cli, &config.S3Blobstore{Bucket: testBucket, Prefix: "/" + testPrefix + "/"})
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.s3cli.AssertExpectations(s.T())
+}
+
+func (s *ClientSuite) TestNewS3Client_InvalidConfig() {
+	_, err := NewS3Client(nil)
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{})
+	s.Error(err)
+	_, err = NewS3Client(&config.S3Blobstore{Bucket: testBucket})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestPutGet() {
+	var stored *s3.PutObjectInput
+	s.s3cli.On("PutObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.PutObjectInput) bool {
+		return *input.Bucket == testBucket && *input.Key == testPrefix+"/key"
+	})).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(*s3.PutObjectInput)
+	}).Return(&s3.PutObjectOutput{}, nil).Once()
+
+	_, err := s.client.Put(context.Background(), &blobstore.PutRequest{
+		Key: "key",
+		Blob: blobstore.Blob{
+			Tags: map[string]string{"key1": "value1"},
+			Body: []byte("body"),
+		},
+	})
+	s.NoError(err)
+	s.NotNil(stored)
+	body, err := ioutil.ReadAll(stored.Body)
+	s.NoError(err)
+
+	// S3 returns the metadata keys in canonical form
+	s.s3cli.On("GetObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.GetObjectInput) bool {
+		return *input.Bucket == testBucket && *input.Key == testPrefix+"/key"
+	})).Return(&s3.GetObjectOutput{
+		Body:     ioutil.NopCloser(bytes.NewReader(body)),
+		Metadata: map[string]*string{"Cadence-Tags": stored.Metadata[tagsMetadataKey]},
+	}, nil).Once()
+
+	resp, err := s.client.Get(context.Background(), &blobstore.GetRequest{Key: "key"})
+	s.NoError(err)
+	s.Equal([]byte("body"), resp.Blob.Body)
+	s.Equal(map[string]string{"key1": "value1"}, resp.Blob.Tags)
+}
+
+func (s *ClientSuite) TestExists() {
+	s.s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
+		return *input.Key == testPrefix+"/found"
+	})).Return(&s3.HeadObjectOutput{}, nil).Once()
+	s.s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
+		return *input.Key == testPrefix+"/missing"
+	})).Return(nil, awserr.NewRequestFailure(awserr.New("NotFound", "not found", nil), http.StatusNotFound, "")).Once()
+	s.s3cli.On("HeadObjectWithContext", mock.Anything, mock.MatchedBy(func(input *s3.HeadObjectInput) bool {
+		return *input.Key == testPrefix+"/error"
+	})).Return(nil, awserr.NewRequestFailure(awserr.New("Forbidden", "forbidden", nil), http.StatusForbidden, "")).Once()
+
+	resp, err := s.client.Exists(context.Background(), &blobstore.ExistsRequest{Key: "found"})
+	s.NoError(err)
+	s.True(resp.Exists)
+	resp, err = s.client.Exists(context.Background(), &blobstore.ExistsRequest{Key: "missing"})
+	s.NoError(err)
+	s.False(resp.Exists)
+	_, err = s.client.Exists(context.Background(), &blobstore.ExistsRequest{Key: "error"})
+	s.Error(err)
+}
+
+func (s *ClientSuite) TestDelete() {
+	s.s3cli.On("DeleteObjectWithContext", mock.Anything, &s3.DeleteObjectInput{
+		Bucket: aws.String(testBucket),
+		Key:    aws.String(testPrefix + "/key"),
+	}).Return(&s3.DeleteObjectOutput{}, nil).Once()
+
+	_, err := s.client.Delete(context.Background(), &blobstore.DeleteRequest{Key: "key"})
+	s.NoError(err)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	s.False(s.client.IsRetryableError(nil))
+	s.False(s.client.IsRetryableError(errors.New("some error")))
+	s.False(s.client.IsRetryableError(awserr.NewRequestFailure(awserr.New(s3.ErrCodeNoSuchKey, "", nil), http.StatusNotFound, "")))
+	s.False(s.client.IsRetryableError(awserr.NewRequestFailure(awserr.New("NotImplemented", "", nil), http.StatusNotImplemented, "")))
+	s.True(s.client.IsRetryableError(awserr.NewRequestFailure(awserr.New("InternalError", "", nil), http.StatusInternalServerError, "")))
+	s.True(s.client.IsRetryableError(awserr.NewRequestFailure(awserr.New("SlowDown", "", nil), http.StatusServiceUnavailable, "")))
+	s.True(s.client.IsRetryableError(awserr.NewRequestFailure(awserr.New("TooManyRequests", "", nil), http.StatusTooManyRequests, "")))
+}
@@ -746,6 +746,7 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 		HistoryArchivalURI:       entry.config.HistoryArchivalURI,
 		VisibilityArchivalStatus: entry.config.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    entry.config.VisibilityArchivalURI,
+		ArchivalRetention:        entry.config.ArchivalRetention,
 		BadBinaries:              copyResetBinary(entry.config.BadBinaries),
 	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
@@ -0,0 +1,43 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import "fmt"
+
+// Validate validates the audit config
+func (a *Audit) Validate() error {
+	if !a.Enable {
+		return nil
+	}
+	if (a.File == nil) == (a.Kafka == nil) {
+		return fmt.Errorf("[AuditConfig] Exactly one of File and Kafka sinks must be set")
+	}
+	if a.File != nil && a.File.Path == "" {
+		return fmt.Errorf("[AuditConfig] File path can't be empty")
+	}
+	if a.Kafka != nil && a.Kafka.Application == "" {
+		return fmt.Errorf("[AuditConfig] Kafka application can't be empty")
+	}
+	if a.BufferSize < 0 {
+		return fmt.Errorf("[AuditConfig] BufferSize can't be negative")
+	}
+	return nil
+}
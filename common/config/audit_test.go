@@ -0,0 +1,74 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditValidation(t *testing.T) {
+	tests := map[string]struct {
+		cfg Audit
+		err string
+	}{
+		"disabled": {
+			cfg: Audit{File: &FileAuditSink{}},
+		},
+		"no sink": {
+			cfg: Audit{Enable: true},
+			err: "[AuditConfig] Exactly one of File and Kafka sinks must be set",
+		},
+		"both sinks": {
+			cfg: Audit{Enable: true, File: &FileAuditSink{Path: "audit.log"}, Kafka: &KafkaAuditSink{Application: "audit"}},
+			err: "[AuditConfig] Exactly one of File and Kafka sinks must be set",
+		},
+		"empty file path": {
+			cfg: Audit{Enable: true, File: &FileAuditSink{}},
+			err: "[AuditConfig] File path can't be empty",
+		},
+		"empty kafka application": {
+			cfg: Audit{Enable: true, Kafka: &KafkaAuditSink{}},
+			err: "[AuditConfig] Kafka application can't be empty",
+		},
+		"negative buffer size": {
+			cfg: Audit{Enable: true, File: &FileAuditSink{Path: "audit.log"}, BufferSize: -1},
+			err: "[AuditConfig] BufferSize can't be negative",
+		},
+		"file": {
+			cfg: Audit{Enable: true, File: &FileAuditSink{Path: "audit.log"}},
+		},
+		"kafka": {
+			cfg: Audit{Enable: true, Kafka: &KafkaAuditSink{Application: "audit"}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
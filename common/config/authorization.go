@@ -22,13 +22,20 @@ package config
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/cristalhq/jwt/v3"
 )
 
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
-	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
+	enabled := 0
+	for _, enable := range []bool{a.OAuthAuthorizer.Enable, a.NoopAuthorizer.Enable, a.ExternalAuthorizer.Enable} {
+		if enable {
+			enabled++
+		}
+	}
+	if enabled > 1 {
 		return fmt.Errorf("[AuthorizationConfig] More than one authorizer is enabled")
 	}
 
@@ -38,6 +45,25 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if a.ExternalAuthorizer.Enable {
+		if externalError := a.validateExternal(); externalError != nil {
+			return externalError
+		}
+	}
+
+	return nil
+}
+
+func (a *Authorization) validateExternal() error {
+	externalConfig := a.ExternalAuthorizer
+
+	endpoint, err := url.Parse(externalConfig.URL)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return fmt.Errorf("[ExternalAuthorizerConfig] URL must be a valid http or https URL")
+	}
+	if externalConfig.Timeout < 0 || externalConfig.CacheTTL < 0 || externalConfig.CacheSize < 0 {
+		return fmt.Errorf("[ExternalAuthorizerConfig] Timeout, CacheTTL and CacheSize can't be negative")
+	}
 	return nil
 }
 
@@ -47,11 +73,22 @@ func (a *Authorization) validateOAuth() error {
 	if oauthConfig.MaxJwtTTL <= 0 {
 		return fmt.Errorf("[OAuthConfig] MaxTTL must be greater than 0")
 	}
-	if oauthConfig.JwtCredentials.PublicKey == "" {
+	credentials := oauthConfig.JwtCredentials
+	if credentials.JWKS != nil {
+		if credentials.PublicKey != "" {
+			return fmt.Errorf("[OAuthConfig] PublicKey and JWKS can't be both set")
+		}
+		if (credentials.JWKS.URL == "") == (credentials.JWKS.File == "") {
+			return fmt.Errorf("[OAuthConfig] Exactly one of JWKS URL and File must be set")
+		}
+		if credentials.Algorithm == "" {
+			return nil
+		}
+	} else if credentials.PublicKey == "" {
 		return fmt.Errorf("[OAuthConfig] PublicKey can't be empty")
 	}
-	if oauthConfig.JwtCredentials.Algorithm != jwt.RS256.String() {
-		return fmt.Errorf("[OAuthConfig] The only supported Algorithm is RS256")
+	if credentials.Algorithm != jwt.RS256.String() && credentials.Algorithm != jwt.ES256.String() {
+		return fmt.Errorf("[OAuthConfig] The supported Algorithms are RS256 and ES256")
 	}
 	return nil
 }
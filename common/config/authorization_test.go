@@ -22,6 +22,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -91,7 +92,36 @@ func TestAlgorithmIsInvalid(t *testing.T) {
 	}
 
 	err := cfg.Validate()
-	assert.EqualError(t, err, "[OAuthConfig] The only supported Algorithm is RS256")
+	assert.EqualError(t, err, "[OAuthConfig] The supported Algorithms are RS256 and ES256")
+}
+
+func TestJWKSValidation(t *testing.T) {
+	cfg := Authorization{
+		OAuthAuthorizer: OAuthAuthorizer{
+			Enable: true,
+			JwtCredentials: JwtCredentials{
+				JWKS: &JWKS{URL: "https://example.com/.well-known/jwks.json"},
+			},
+			MaxJwtTTL: 1000000,
+		},
+	}
+	assert.NoError(t, cfg.Validate())
+
+	cfg.OAuthAuthorizer.JwtCredentials.Algorithm = "ES256"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.OAuthAuthorizer.JwtCredentials.Algorithm = "HS256"
+	assert.EqualError(t, cfg.Validate(), "[OAuthConfig] The supported Algorithms are RS256 and ES256")
+
+	cfg.OAuthAuthorizer.JwtCredentials.JWKS.File = "jwks.json"
+	assert.EqualError(t, cfg.Validate(), "[OAuthConfig] Exactly one of JWKS URL and File must be set")
+
+	cfg.OAuthAuthorizer.JwtCredentials.JWKS = &JWKS{}
+	assert.EqualError(t, cfg.Validate(), "[OAuthConfig] Exactly one of JWKS URL and File must be set")
+
+	cfg.OAuthAuthorizer.JwtCredentials.JWKS = &JWKS{File: "jwks.json"}
+	cfg.OAuthAuthorizer.JwtCredentials.PublicKey = "public"
+	assert.EqualError(t, cfg.Validate(), "[OAuthConfig] PublicKey and JWKS can't be both set")
 }
 
 func TestCorrectValidation(t *testing.T) {
@@ -112,3 +142,30 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestExternalAuthorizerValidation(t *testing.T) {
+	cfg := Authorization{
+		OAuthAuthorizer: OAuthAuthorizer{
+			Enable: true,
+		},
+		ExternalAuthorizer: ExternalAuthorizer{
+			Enable: true,
+		},
+	}
+	assert.EqualError(t, cfg.Validate(), "[AuthorizationConfig] More than one authorizer is enabled")
+
+	cfg = Authorization{
+		ExternalAuthorizer: ExternalAuthorizer{
+			Enable: true,
+			URL:    "localhost:8181",
+		},
+	}
+	assert.EqualError(t, cfg.Validate(), "[ExternalAuthorizerConfig] URL must be a valid http or https URL")
+
+	cfg.ExternalAuthorizer.URL = "http://localhost:8181/v1/data/cadence/authz"
+	cfg.ExternalAuthorizer.CacheTTL = -time.Second
+	assert.EqualError(t, cfg.Validate(), "[ExternalAuthorizerConfig] Timeout, CacheTTL and CacheSize can't be negative")
+
+	cfg.ExternalAuthorizer.CacheTTL = time.Minute
+	assert.NoError(t, cfg.Validate())
+}
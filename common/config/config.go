@@ -72,11 +72,38 @@ type (
 		Blobstore Blobstore `yaml:"blobstore"`
 		// Authorization is the config for setting up authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Audit is the config for the audit log of mutating frontend and admin API calls
+		Audit Audit `yaml:"audit"`
 	}
 
 	Authorization struct {
-		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
-		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		OAuthAuthorizer    OAuthAuthorizer    `yaml:"oauthAuthorizer"`
+		NoopAuthorizer     NoopAuthorizer     `yaml:"noopAuthorizer"`
+		ExternalAuthorizer ExternalAuthorizer `yaml:"externalAuthorizer"`
+	}
+
+	// Audit is the config of the audit log, exactly one sink must be set if enabled
+	Audit struct {
+		Enable bool `yaml:"enable"`
+		// File sink appends the records to a local file as JSON lines
+		File *FileAuditSink `yaml:"file"`
+		// Kafka sink publishes the records as JSON to the topic of a kafka application
+		Kafka *KafkaAuditSink `yaml:"kafka"`
+		// BufferSize is the number of records buffered in memory before they are written to the sink,
+		// records are dropped when the buffer is full. Default is 10000
+		BufferSize int `yaml:"bufferSize"`
+	}
+
+	// FileAuditSink is the config of the file audit sink
+	FileAuditSink struct {
+		// Path of the audit file, it's created if not exists
+		Path string `yaml:"path"`
+	}
+
+	// KafkaAuditSink is the config of the kafka audit sink
+	KafkaAuditSink struct {
+		// Application is the name of the application in the kafka config, its topic receives the records
+		Application string `yaml:"application"`
 	}
 
 	DynamicConfig struct {
@@ -95,13 +122,53 @@ type (
 		JwtCredentials JwtCredentials `yaml:"jwtCredentials"`
 		// Max of TTL in the claim
 		MaxJwtTTL int64 `yaml:"maxJwtTTL"`
+		// PolicyFile is an optional YAML file of authorization rules applied to all domains,
+		// in addition to the rules in the domain data
+		PolicyFile string `yaml:"policyFile"`
+	}
+
+	// ExternalAuthorizer forwards the authorization attributes of each request to an
+	// external policy service over HTTP, e.g. an Open Policy Agent server
+	ExternalAuthorizer struct {
+		Enable bool `yaml:"enable"`
+		// URL of the endpoint receiving the attributes as a JSON POST request,
+		// e.g. http://localhost:8181/v1/data/cadence/authz
+		URL string `yaml:"url"`
+		// Headers are added to every request, e.g. an API key of the policy service
+		Headers map[string]string `yaml:"headers"`
+		// TLS is the client TLS config for https URLs
+		TLS TLS `yaml:"tls"`
+		// Timeout of a single authorization call. Default is 1s
+		Timeout time.Duration `yaml:"timeout"`
+		// CacheTTL is how long a decision is cached, at most until the JWT of the request expires.
+		// Caching is disabled if it is 0
+		CacheTTL time.Duration `yaml:"cacheTTL"`
+		// CacheSize is the max number of cached decisions. Default is 10000
+		CacheSize int `yaml:"cacheSize"`
+		// FailOpen allows the requests when the policy service can't be reached or returns an error,
+		// otherwise they are rejected
+		FailOpen bool `yaml:"failOpen"`
 	}
 
 	JwtCredentials struct {
-		// support: RS256 (RSA using SHA256)
+		// support: RS256 (RSA using SHA256), ES256 (ECDSA using P-256 and SHA256)
+		// Optional with JWKS, tokens signed with any supported algorithm are accepted then
 		Algorithm string `yaml:"algorithm"`
 		// Public Key Path for verifying JWT token passed in from external clients
 		PublicKey string `yaml:"publicKey"`
+		// JWKS is the JSON Web Key Set for verifying JWT tokens, used instead of PublicKey.
+		// The key is picked by the kid header of the token.
+		JWKS *JWKS `yaml:"jwks"`
+	}
+
+	// JWKS is the source of a JSON Web Key Set, exactly one of URL and File must be set
+	JWKS struct {
+		// URL to fetch the key set from
+		URL string `yaml:"url"`
+		// File to load the key set from, it is reloaded when modified
+		File string `yaml:"file"`
+		// RefreshInterval is how often the key set is refetched, or the file checked for modification. Default is 1m
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 
 	// Service contains the service specific config items
@@ -142,9 +209,11 @@ type (
 		TLS TLS `yaml:"tls"`
 	}
 
-	// Blobstore contains the config for blobstore
+	// Blobstore contains the config for blobstore, only one of the stores should be configured
 	Blobstore struct {
-		Filestore *FileBlobstore `yaml:"filestore"`
+		Filestore *FileBlobstore     `yaml:"filestore"`
+		S3store   *S3Blobstore       `yaml:"s3store"`
+		Gstorage  *GstorageBlobstore `yaml:"gstorage"`
 	}
 
 	// FileBlobstore contains the config for a file backed blobstore
@@ -152,6 +221,27 @@ type (
 		OutputDirectory string `yaml:"outputDirectory"`
 	}
 
+	// S3Blobstore contains the config for a S3 backed blobstore
+	S3Blobstore struct {
+		// Bucket is the name of the bucket the blobs are stored in
+		Bucket string `yaml:"bucket"`
+		// Prefix is prepended to the key of every blob
+		Prefix string `yaml:"prefix"`
+		// S3Archiver contains the region, endpoint and TLS settings for connecting to S3
+		S3Archiver `yaml:",inline"`
+	}
+
+	// GstorageBlobstore contains the config for a google storage backed blobstore
+	GstorageBlobstore struct {
+		// Bucket is the name of the bucket the blobs are stored in
+		Bucket string `yaml:"bucket"`
+		// Prefix is prepended to the key of every blob
+		Prefix string `yaml:"prefix"`
+		// CredentialsPath is the path to the service account key file,
+		// default application credentials are used if not set
+		CredentialsPath string `yaml:"credentialsPath"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -214,7 +304,7 @@ type (
 		AllowedAuthenticators []string `yaml:"allowedAuthenticators"`
 		// Keyspace is the cassandra keyspace
 		Keyspace string `yaml:"keyspace"`
-		// Region is the region filter arg for cassandra
+		// Region is the region filter arg for cassandra, or the AWS region for dynamodb
 		Region string `yaml:"region"`
 		// Datacenter is the data center filter arg for cassandra
 		Datacenter string `yaml:"datacenter"`
@@ -228,6 +318,7 @@ type (
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
 		// If being used in any database, update this comment here to make it clear
+		// For MongoDB, they are passed as the options of the connection string, e.g. replicaSet
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 	}
 
@@ -266,7 +357,7 @@ type (
 		NumShards int `yaml:"nShards"`
 		// TLS is the configuration for TLS connections
 		TLS *TLS `yaml:"tls"`
-		// EncodingType is the configuration for the type of encoding used for sql blobs
+		// EncodingType is the configuration for the type of encoding used for sql blobs, either thriftrw or proto3
 		EncodingType string `yaml:"encodingType"`
 		// DecodingTypes is the configuration for all the sql blob decoding types which need to be supported
 		// DecodingTypes should not be removed unless there are no blobs in database with the encoding type
@@ -276,8 +367,24 @@ type (
 		// of  User, Password, DatabaseName, ConnectAddr.
 		UseMultipleDatabases bool `yaml:"useMultipleDatabases"`
 		// Required when UseMultipleDatabases is true
-		// the length of the list should be exactly the same as NumShards
+		// the length of the list should be exactly the same as NumShards,
+		// or the larger of NumShards and Resharding.TargetNumShards when Resharding is configured
 		MultipleDatabasesConfig []MultipleDatabasesConfigEntry `yaml:"multipleDatabasesConfig"`
+		// Resharding is the configuration for changing NumShards of a sharded sql database online, optional
+		Resharding *SQLResharding `yaml:"resharding"`
+	}
+
+	// SQLResharding is the configuration for moving a sharded SQL database from NumShards to TargetNumShards DB shards
+	// while the cluster keeps serving traffic. Only the history shard keyed tables (shards, executions and their tasks
+	// and maps) change placement, the remaining tables stay on the first NumShards databases.
+	// The first NumShards entries of MultipleDatabasesConfig are the current databases and the first TargetNumShards
+	// entries are the databases after resharding.
+	SQLResharding struct {
+		// TargetNumShards is the number of DB shards after resharding
+		TargetNumShards int `yaml:"targetNumShards"`
+		// Cutover returns true for a history shard once it is switched to the TargetNumShards placement,
+		// it is wired from dynamic config (system.sqlReshardingCutover)
+		Cutover dynamicconfig.BoolPropertyFnWithShardIDFilter `yaml:"-" json:"-"`
 	}
 
 	// MultipleDatabasesConfigEntry is an entry for MultipleDatabasesConfig to connect to a single SQL database
@@ -424,6 +531,30 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
+		// Codec is the compression and encryption of the history blobs written by all history archivers,
+		// blobs are written as plain JSON if it's not set
+		Codec *ArchiverCodec `yaml:"codec"`
+		// EnableIndex makes the history archivers which support searching index the signal names,
+		// activity types and failure reasons of the archived histories, default is false
+		EnableIndex bool `yaml:"enableIndex"`
+	}
+
+	// ArchiverCodec contains the config for compressing and encrypting archived history blobs
+	ArchiverCodec struct {
+		// Compression is one of none, gzip and zstd, default is none
+		Compression string `yaml:"compression"`
+		// Encryption enables the AES-GCM envelope encryption of the blobs if set
+		Encryption *ArchiverEncryption `yaml:"encryption"`
+	}
+
+	// ArchiverEncryption contains the config for the keys encrypting archived history blobs
+	ArchiverEncryption struct {
+		// KeyID is the ID of the key used to encrypt new blobs, it must be one of KeyFiles
+		KeyID string `yaml:"keyID"`
+		// KeyFiles maps the key IDs to the files containing the base64 encoded 128, 192 or 256 bit AES keys.
+		// Keys which are no longer used to encrypt new blobs must be kept to read the blobs encrypted by them.
+		KeyFiles map[string]string `yaml:"keyFiles"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -441,6 +572,24 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		Azblob    *AzblobArchiver    `yaml:"azblob"`
+		// Parquet makes the filestore and s3store visibility archivers batch the records into
+		// time partitioned parquet files instead of writing a JSON file per record
+		Parquet *ParquetVisibilityArchiver `yaml:"parquet"`
+	}
+
+	// ParquetVisibilityArchiver contains the config for archiving visibility records as parquet files
+	ParquetVisibilityArchiver struct {
+		// Partition is the time partition of the files by the close time of the records, either day or hour, default is day
+		Partition string `yaml:"partition"`
+		// FlushInterval is the max time a record is buffered before its batch is written, default is 50ms.
+		// Archive returns after the batch is written, so it must be well below the time limit of inline archival,
+		// history.transferProcessorVisibilityArchivalTimeLimit(200ms by default). Otherwise inline archival times out,
+		// and the record is written again by the archival workflow. A batch is also written before half of the
+		// remaining time of any of its requests has passed.
+		FlushInterval time.Duration `yaml:"flushInterval"`
+		// MaxBatchSize is the max number of records in a file, default is 1000
+		MaxBatchSize int `yaml:"maxBatchSize"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -459,6 +608,24 @@ type (
 		Region           string  `yaml:"region"`
 		Endpoint         *string `yaml:"endpoint"`
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
+		// TLS is the TLS config for connecting to the endpoint, e.g. to trust the custom CA of a S3 compatible store
+		TLS TLS `yaml:"tls"`
+	}
+
+	// AzblobArchiver contains the config for azure blob storage archiver
+	AzblobArchiver struct {
+		// AccountName is the name of the storage account
+		AccountName string `yaml:"accountName"`
+		// AccountKey is the base64 encoded shared key of the account,
+		// it's read from the AZURE_STORAGE_KEY environment variable if not set
+		AccountKey string `yaml:"accountKey"`
+		// SASToken is the shared access signature used instead of the AccountKey if set
+		SASToken string `yaml:"sasToken"`
+		// Endpoint is the blob service endpoint, default is https://<accountName>.blob.core.windows.net,
+		// the account name must be included in the path for emulators, e.g. http://127.0.0.1:10000/devstoreaccount1
+		Endpoint string `yaml:"endpoint"`
+		// TLS is the TLS config for connecting to the endpoint
+		TLS TLS `yaml:"tls"`
 	}
 
 	// PublicClient is config for connecting to cadence frontend
@@ -523,6 +690,10 @@ func (c *Config) validate() error {
 		return err
 	}
 
+	if err := c.Audit.Validate(); err != nil {
+		return err
+	}
+
 	return c.Authorization.Validate()
 }
 
@@ -159,6 +159,37 @@ func TestInvalidMultipleDatabaseConfig_nonEmptySQLConnAddr(t *testing.T) {
 	require.EqualError(t, err, "sql persistence config: connectAddr can only be configured in multipleDatabasesConfig when UseMultipleDatabases is true")
 }
 
+func TestValidMultipleDatabaseConfig_resharding(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	sqlds := cfg.Persistence.DataStores["default"]
+	sqlds.SQL.Resharding = &SQLResharding{TargetNumShards: 3}
+	sqlds.SQL.MultipleDatabasesConfig = append(sqlds.SQL.MultipleDatabasesConfig, MultipleDatabasesConfigEntry{
+		DatabaseName: "db3",
+		ConnectAddr:  "192.168.0.3:3306",
+	})
+	cfg.Persistence.DataStores["default"] = sqlds
+	err := cfg.ValidateAndFillDefaults()
+	require.NoError(t, err)
+}
+
+func TestInvalidMultipleDatabaseConfig_reshardingWrongNumDatabases(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	sqlds := cfg.Persistence.DataStores["default"]
+	sqlds.SQL.Resharding = &SQLResharding{TargetNumShards: 3}
+	cfg.Persistence.DataStores["default"] = sqlds
+	err := cfg.ValidateAndFillDefaults()
+	require.EqualError(t, err, "sql persistence config: nShards must be greater than one and equal to the length of multipleDatabasesConfig")
+}
+
+func TestInvalidMultipleDatabaseConfig_reshardingSameNumShards(t *testing.T) {
+	cfg := getValidMultipleDatabasseConfig()
+	sqlds := cfg.Persistence.DataStores["default"]
+	sqlds.SQL.Resharding = &SQLResharding{TargetNumShards: 2}
+	cfg.Persistence.DataStores["default"] = sqlds
+	err := cfg.ValidateAndFillDefaults()
+	require.EqualError(t, err, "sql persistence config: resharding targetNumShards must be positive and different from nShards")
+}
+
 func TestConfigFallbacks(t *testing.T) {
 	metadata := validClusterGroupMetadata()
 	cfg := &Config{
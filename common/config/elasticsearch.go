@@ -34,7 +34,7 @@ type (
 	ElasticSearchConfig struct {
 		URL     url.URL           `yaml:"url"`     //nolint:govet
 		Indices map[string]string `yaml:"indices"` //nolint:govet
-		// supporting v6 and v7. Default to v6 if empty.
+		// supporting v6, v7 and opensearch (OpenSearch 1.x and 2.x). Default to v6 if empty.
 		Version string `yaml:"version"` //nolint:govet
 		// optional username to communicate with ElasticSearch
 		Username string `yaml:"username"` //nolint:govet
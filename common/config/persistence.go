@@ -100,6 +100,18 @@ func (c *Persistence) Validate() error {
 			return fmt.Errorf("persistence config: datastore %v: only one of SQL or NoSQL can be specified", st)
 		}
 		if ds.SQL != nil {
+			numDatabases := ds.SQL.NumShards
+			if ds.SQL.Resharding != nil {
+				if !ds.SQL.UseMultipleDatabases {
+					return fmt.Errorf("sql persistence config: resharding can only be used when UseMultipleDatabases is true")
+				}
+				if ds.SQL.Resharding.TargetNumShards < 1 || ds.SQL.Resharding.TargetNumShards == ds.SQL.NumShards {
+					return fmt.Errorf("sql persistence config: resharding targetNumShards must be positive and different from nShards")
+				}
+				if ds.SQL.Resharding.TargetNumShards > numDatabases {
+					numDatabases = ds.SQL.Resharding.TargetNumShards
+				}
+			}
 			if ds.SQL.UseMultipleDatabases {
 				if !useAdvancedVisibilityOnly {
 					return fmt.Errorf("sql persistence config: multipleSQLDatabases can only be used with advanced visibility only")
@@ -116,7 +128,7 @@ func (c *Persistence) Validate() error {
 				if ds.SQL.Password != "" {
 					return fmt.Errorf("sql persistence config: password can only be configured in multipleDatabasesConfig when UseMultipleDatabases is true")
 				}
-				if ds.SQL.NumShards <= 1 || len(ds.SQL.MultipleDatabasesConfig) != ds.SQL.NumShards {
+				if numDatabases <= 1 || len(ds.SQL.MultipleDatabasesConfig) != numDatabases {
 					return fmt.Errorf("sql persistence config: nShards must be greater than one and equal to the length of multipleDatabasesConfig")
 				}
 				for _, entry := range ds.SQL.MultipleDatabasesConfig {
@@ -162,6 +162,8 @@ const (
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
+	// DomainDataKeyForAuthorizationPolicy stores the JSON authorization policy of the domain API, see authorization.Policy
+	DomainDataKeyForAuthorizationPolicy = "AUTHORIZATION_POLICY"
 )
 
 type (
@@ -209,6 +211,25 @@ const (
 	DefaultESAnalyzerWorkflowDurationWarnThresholds = ""
 )
 
+const (
+	// DefaultArchivalVerifierPause controls if we want to dynamically pause the archival verifier
+	DefaultArchivalVerifierPause = false
+	// DefaultArchivalVerifierSamplesPerDomain controls how many closed workflows are verified per domain
+	DefaultArchivalVerifierSamplesPerDomain = 10
+	// DefaultArchivalVerifierTimeWindow controls how far back closed workflows are sampled from
+	DefaultArchivalVerifierTimeWindow = time.Hour * 24
+	// DefaultArchivalVerifierMinCloseAge controls how long a workflow must have been closed before it is verified
+	DefaultArchivalVerifierMinCloseAge = time.Hour
+	// DefaultArchivalVerifierEnableReArchive controls if broken archives are re-archived from persistence
+	DefaultArchivalVerifierEnableReArchive = true
+	// DefaultArchivalScavengerPause controls if we want to dynamically pause the archival scavenger
+	DefaultArchivalScavengerPause = false
+	// DefaultArchivalBackfillRPS is the default rate limit of the archival backfill workflows on a worker host
+	DefaultArchivalBackfillRPS = 10
+	// DefaultVisibilityReindexRPS is the default rate limit of the visibility reindex workflows on a worker host
+	DefaultVisibilityReindexRPS = 100
+)
+
 // StickyTaskConditionFailedErrorMsg error msg for sticky task ConditionFailedError
 const StickyTaskConditionFailedErrorMsg = "StickyTaskConditionFailedError"
 
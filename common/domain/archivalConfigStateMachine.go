@@ -233,3 +233,16 @@ func (s *ArchivalState) getNextState(
 	}
 	return nil, false, errCannotHandleStateChange
 }
+
+// getNextArchivalRetention returns the archival retention (in days) to persist given the current one
+// and the one requested by user (nil indicates no update by user is being attempted).
+// The retention is shared by history and visibility archival and 0 means archived data is kept forever.
+func getNextArchivalRetention(current int32, requested *int32) (int32, bool, error) {
+	if requested == nil || *requested == current {
+		return current, false, nil
+	}
+	if *requested < 0 {
+		return current, false, errInvalidArchivalRetention
+	}
+	return *requested, true, nil
+}
@@ -52,6 +52,9 @@ func (d *AttrValidatorImpl) validateDomainConfig(config *persistence.DomainConfi
 	if config.Retention < int32(d.minRetentionDays) {
 		return errInvalidRetentionPeriod
 	}
+	if config.ArchivalRetention < 0 {
+		return errInvalidArchivalRetention
+	}
 	if config.HistoryArchivalStatus == types.ArchivalStatusEnabled && len(config.HistoryArchivalURI) == 0 {
 		return errInvalidArchivalConfig
 	}
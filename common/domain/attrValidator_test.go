@@ -87,6 +87,32 @@ func (s *attrValidatorSuite) TestValidateConfigRetentionPeriod() {
 	}
 }
 
+func (s *attrValidatorSuite) TestValidateConfigArchivalRetention() {
+	testCases := []struct {
+		archivalRetention int32
+		expectedErr       error
+	}{
+		{
+			archivalRetention: 30,
+			expectedErr:       nil,
+		},
+		{
+			archivalRetention: 0,
+			expectedErr:       nil,
+		},
+		{
+			archivalRetention: -1,
+			expectedErr:       errInvalidArchivalRetention,
+		},
+	}
+	for _, tc := range testCases {
+		actualErr := s.validator.validateDomainConfig(
+			&persistence.DomainConfig{Retention: 10, ArchivalRetention: tc.archivalRetention},
+		)
+		s.Equal(tc.expectedErr, actualErr)
+	}
+}
+
 func (s *attrValidatorSuite) TestClusterName() {
 	s.mockClusterMetadata.On("GetAllClusterInfo").Return(
 		cluster.TestAllClusterInfo,
@@ -37,4 +37,6 @@ var (
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+
+	errInvalidArchivalRetention = &types.BadRequestError{Message: "Archival retention period cannot be negative."}
 )
@@ -218,6 +218,7 @@ func (d *handlerImpl) RegisterDomain(
 		HistoryArchivalURI:       nextHistoryArchivalState.URI,
 		VisibilityArchivalStatus: nextVisibilityArchivalState.Status,
 		VisibilityArchivalURI:    nextVisibilityArchivalState.URI,
+		ArchivalRetention:        registerRequest.GetArchivalRetentionPeriodInDays(),
 		BadBinaries:              types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{}},
 	}
 	replicationConfig := &persistence.DomainReplicationConfig{
@@ -394,6 +395,8 @@ func (d *handlerImpl) UpdateDomain(
 	historyArchivalConfigChanged := false
 	// whether visibility archival config changed
 	visibilityArchivalConfigChanged := false
+	// whether archival retention changed
+	archivalRetentionChanged := false
 	// whether active cluster is changed
 	activeClusterChanged := false
 	// whether anything other than active cluster is changed
@@ -425,6 +428,15 @@ func (d *handlerImpl) UpdateDomain(
 		config.VisibilityArchivalURI = visibilityArchivalState.URI
 	}
 
+	// Update archival retention
+	config.ArchivalRetention, archivalRetentionChanged, err = getNextArchivalRetention(
+		config.ArchivalRetention,
+		updateRequest.ArchivalRetentionPeriodInDays,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update domain info
 	info, domainInfoChanged := d.updateDomainInfo(
 		updateRequest,
@@ -480,7 +492,7 @@ func (d *handlerImpl) UpdateDomain(
 		previousFailoverVersion = failoverVersion
 	}
 
-	configurationChanged = historyArchivalConfigChanged || visibilityArchivalConfigChanged || domainInfoChanged || domainConfigChanged || deleteBinaryChanged || replicationConfigChanged
+	configurationChanged = historyArchivalConfigChanged || visibilityArchivalConfigChanged || archivalRetentionChanged || domainInfoChanged || domainConfigChanged || deleteBinaryChanged || replicationConfigChanged
 
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
@@ -668,6 +680,7 @@ func (d *handlerImpl) createResponse(
 		HistoryArchivalURI:                     config.HistoryArchivalURI,
 		VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 		VisibilityArchivalURI:                  config.VisibilityArchivalURI,
+		ArchivalRetentionPeriodInDays:          config.ArchivalRetention,
 		BadBinaries:                            &config.BadBinaries,
 	}
 
@@ -103,6 +103,7 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(
 			HistoryArchivalURI:                     config.HistoryArchivalURI,
 			VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 			VisibilityArchivalURI:                  config.VisibilityArchivalURI,
+			ArchivalRetentionPeriodInDays:          config.ArchivalRetention,
 			BadBinaries:                            &config.BadBinaries,
 		},
 		ReplicationConfig: &types.DomainReplicationConfiguration{
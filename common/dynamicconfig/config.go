@@ -161,6 +161,9 @@ type BoolPropertyFnWithDomainIDFilter func(domainID string) bool
 // BoolPropertyFnWithDomainIDAndWorkflowIDFilter is a wrapper to get bool property from dynamic config with domainID and workflowID as filter
 type BoolPropertyFnWithDomainIDAndWorkflowIDFilter func(domainID string, workflowID string) bool
 
+// BoolPropertyFnWithShardIDFilter is a wrapper to get bool property from dynamic config with shardID as filter
+type BoolPropertyFnWithShardIDFilter func(shardID int) bool
+
 // BoolPropertyFnWithTaskListInfoFilters is a wrapper to get bool property from dynamic config with three filters: domain, taskList, taskType
 type BoolPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) bool
 
@@ -536,6 +539,23 @@ func (c *Collection) GetBoolPropertyFilteredByDomainIDAndWorkflowID(key Key, def
 	}
 }
 
+// GetBoolPropertyFilteredByShardID gets property with shardID as filter and asserts that it's a bool
+func (c *Collection) GetBoolPropertyFilteredByShardID(key Key, defaultValue bool) BoolPropertyFnWithShardIDFilter {
+	return func(shardID int) bool {
+		filters := c.toFilterMap(ShardIDFilter(shardID))
+		val, err := c.client.GetBoolValue(
+			key,
+			filters,
+			defaultValue,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		c.logValue(key, filters, val, defaultValue, boolCompareEquals)
+		return val
+	}
+}
+
 // GetBoolPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's an bool
 func (c *Collection) GetBoolPropertyFilteredByTaskListInfo(key Key, defaultValue bool) BoolPropertyFnWithTaskListInfoFilters {
 	return func(domain string, taskList string, taskType int) bool {
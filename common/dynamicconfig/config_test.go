@@ -128,6 +128,15 @@ func (s *configSuite) TestGetBoolPropertyFilteredByTaskListInfo() {
 	s.Equal(true, value(domain, taskList, taskType))
 }
 
+func (s *configSuite) TestGetBoolPropertyFilteredByShardID() {
+	key := TestGetBoolPropertyFilteredByShardIDKey
+	shardID := 1
+	value := s.cln.GetBoolPropertyFilteredByShardID(key, false)
+	s.Equal(false, value(shardID))
+	s.client.SetValue(key, true)
+	s.Equal(true, value(shardID))
+}
+
 func (s *configSuite) TestGetDurationProperty() {
 	key := TestGetDurationPropertyKey
 	value := s.cln.GetDurationProperty(key, time.Second)
@@ -64,6 +64,7 @@ const (
 	TestGetDurationPropertyFilteredByTaskListInfoKey
 	TestGetBoolPropertyFilteredByDomainIDKey
 	TestGetBoolPropertyFilteredByTaskListInfoKey
+	TestGetBoolPropertyFilteredByShardIDKey
 
 	// key for common & admin
 
@@ -97,6 +98,12 @@ const (
 	// Default value: true if advanced visibility persistence is configured, otherwise false
 	// Allowed filters: DomainName
 	EnableReadVisibilityFromES
+	// EnableESVisibilityDirectWrite is key for writing advanced visibility records to ElasticSearch directly instead of going through Kafka and the indexer
+	// KeyName: system.enableESVisibilityDirectWrite
+	// Value type: Bool
+	// Default value: false (always true if no Kafka cluster is configured)
+	// Allowed filters: N/A
+	EnableESVisibilityDirectWrite
 	// EmitShardDiffLog is whether emit the shard diff log
 	// KeyName: history.emitShardDiffLog
 	// Value type: Bool
@@ -159,6 +166,12 @@ const (
 	// Default value: 0
 	// Allowed filters: N/A
 	PersistenceErrorInjectionRate
+	// SQLReshardingCutover is whether a history shard reads and writes the target placement of an ongoing SQL resharding
+	// KeyName: system.sqlReshardingCutover
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: ShardID
+	SQLReshardingCutover
 	// MaxRetentionDays is the maximum allowed retention days for domain
 	// KeyName: system.maxRetentionDays
 	// Value type: Int
@@ -696,6 +709,24 @@ const (
 	// Default value: 300
 	// Allowed filters: DomainName
 	HistoryVisibilityClosedMaxQPS
+	// HistoryESVisibilityDirectWriteNumOfWorkers is the number of bulk workers used when writing visibility to ElasticSearch directly
+	// KeyName: history.ESVisibilityDirectWriteNumOfWorkers
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: N/A
+	HistoryESVisibilityDirectWriteNumOfWorkers
+	// HistoryESVisibilityDirectWriteBulkActions is the max number of requests in one bulk when writing visibility to ElasticSearch directly
+	// KeyName: history.ESVisibilityDirectWriteBulkActions
+	// Value type: Int
+	// Default value: 500
+	// Allowed filters: N/A
+	HistoryESVisibilityDirectWriteBulkActions
+	// HistoryESVisibilityDirectWriteFlushInterval is the flush interval of the bulk processor when writing visibility to ElasticSearch directly
+	// KeyName: history.ESVisibilityDirectWriteFlushInterval
+	// Value type: Duration
+	// Default value: 100ms (100*time.Millisecond)
+	// Allowed filters: N/A
+	HistoryESVisibilityDirectWriteFlushInterval
 	// HistoryLongPollExpirationInterval is the long poll expiration interval in the history service
 	// KeyName: history.longPollExpirationInterval
 	// Value type: Duration
@@ -1993,6 +2024,19 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableWatchDog
+	// EnableArchivalVerifier decides whether to enable the archival verifier system worker
+	// KeyName: system.enableArchivalVerifier
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableArchivalVerifier
+	// EnableArchivalScavenger decides whether to enable the archival scavenger system worker,
+	// which deletes the archived data of the domains past their archival retention
+	// KeyName: system.enableArchivalScavenger
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	EnableArchivalScavenger
 	// EnableStickyQuery is indicates if sticky query should be enabled per domain
 	// KeyName: system.enableStickyQuery
 	// Value type: Bool
@@ -2077,6 +2121,54 @@ const (
 	// Default value: false
 	// Allowed filters: DomainName
 	TimersFixerDomainAllow
+	// VisibilityScannerEnabled is if visibility scanner should be started as part of worker.Scanner
+	// KeyName: worker.visibilityScannerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	VisibilityScannerEnabled
+	// VisibilityFixerEnabled is if visibility fixer should be started as part of worker.Scanner
+	// KeyName: worker.visibilityFixerEnabled
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	VisibilityFixerEnabled
+	// VisibilityScannerConcurrency is the concurrency of visibility scanner
+	// KeyName: worker.visibilityScannerConcurrency
+	// Value type: Int
+	// Default value: 5
+	// Allowed filters: N/A
+	VisibilityScannerConcurrency
+	// VisibilityScannerPersistencePageSize is the page size of executions persistence fetches in visibility scanner
+	// KeyName: worker.visibilityScannerPersistencePageSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	VisibilityScannerPersistencePageSize
+	// VisibilityScannerBlobstoreFlushThreshold is threshold to flush blob store
+	// KeyName: worker.visibilityScannerBlobstoreFlushThreshold
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: N/A
+	VisibilityScannerBlobstoreFlushThreshold
+	// VisibilityScannerActivityBatchSize is the number of shards scanned by an activity of visibility scanner
+	// KeyName: worker.visibilityScannerActivityBatchSize
+	// Value type: Int
+	// Default value: 25
+	// Allowed filters: N/A
+	VisibilityScannerActivityBatchSize
+	// VisibilityScannerShardSampleRate is the fraction of shards checked by each run of visibility scanner
+	// KeyName: worker.visibilityScannerShardSampleRate
+	// Value type: Float64
+	// Default value: 1.0
+	// Allowed filters: N/A
+	VisibilityScannerShardSampleRate
+	// VisibilityFixerDomainAllow is which domains are allowed to be fixed by visibility fixer workflow
+	// KeyName: worker.visibilityFixerDomainAllow
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName
+	VisibilityFixerDomainAllow
 	// ConcreteExecutionFixerEnabled is if concrete execution fixer workflow is enabled
 	// KeyName: worker.concreteExecutionFixerEnabled
 	// Value type: Bool
@@ -2188,6 +2280,52 @@ const (
 	// Default value: false
 	CorruptWorkflowWatchdogPause
 
+	// ArchivalVerifierPause defines if we want to dynamically pause the archival verifier workflow
+	// KeyName: worker.ArchivalVerifierPause
+	// Value type: bool
+	// Default value: false
+	ArchivalVerifierPause
+	// ArchivalVerifierSamplesPerDomain defines how many closed workflows are verified per domain in each run
+	// KeyName: worker.ArchivalVerifierSamplesPerDomain
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName
+	ArchivalVerifierSamplesPerDomain
+	// ArchivalVerifierTimeWindow defines how far back closed workflows are sampled from
+	// KeyName: worker.ArchivalVerifierTimeWindow
+	// Value type: Duration
+	// Default value: 24 hours
+	ArchivalVerifierTimeWindow
+	// ArchivalVerifierMinCloseAge defines how long a workflow must have been closed before it is verified,
+	// so that archival has a chance to complete
+	// KeyName: worker.ArchivalVerifierMinCloseAge
+	// Value type: Duration
+	// Default value: 1 hour
+	ArchivalVerifierMinCloseAge
+	// ArchivalVerifierEnableReArchive defines if missing or broken archives should be re-archived from persistence
+	// KeyName: worker.ArchivalVerifierEnableReArchive
+	// Value type: bool
+	// Default value: true
+	ArchivalVerifierEnableReArchive
+
+	// ArchivalScavengerPause defines if we want to dynamically pause the archival scavenger workflow
+	// KeyName: worker.ArchivalScavengerPause
+	// Value type: bool
+	// Default value: false
+	ArchivalScavengerPause
+	// ArchivalBackfillRPS is the rate limit on the number of closed workflows submitted for archival per second
+	// by the archival backfill workflows running on a worker host
+	// KeyName: worker.ArchivalBackfillRPS
+	// Value type: Int
+	// Default value: 10
+	ArchivalBackfillRPS
+	// VisibilityReindexRPS is the rate limit on the number of workflows written to the target visibility store per second
+	// by the visibility reindex workflows running on a worker host
+	// KeyName: worker.VisibilityReindexRPS
+	// Value type: Int
+	// Default value: 100
+	VisibilityReindexRPS
+
 	// LastKeyForTest must be the last one in this const group for testing purpose
 	LastKeyForTest
 )
@@ -2210,6 +2348,7 @@ var Keys = map[Key]string{
 	TestGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
 	TestGetBoolPropertyFilteredByDomainIDKey:         "testGetBoolPropertyFilteredByDomainIDKey",
 	TestGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
+	TestGetBoolPropertyFilteredByShardIDKey:          "testGetBoolPropertyFilteredByShardIDKey",
 
 	// system settings
 	EnableGlobalDomain:                  "system.enableGlobalDomain",
@@ -2217,6 +2356,7 @@ var Keys = map[Key]string{
 	EnableReadFromClosedExecutionV2:     "system.enableReadFromClosedExecutionV2",
 	AdvancedVisibilityWritingMode:       "system.advancedVisibilityWritingMode",
 	EnableReadVisibilityFromES:          "system.enableReadVisibilityFromES",
+	EnableESVisibilityDirectWrite:       "system.enableESVisibilityDirectWrite",
 	HistoryArchivalStatus:               "system.historyArchivalStatus",
 	EnableReadFromHistoryArchival:       "system.enableReadFromHistoryArchival",
 	VisibilityArchivalStatus:            "system.visibilityArchivalStatus",
@@ -2225,6 +2365,7 @@ var Keys = map[Key]string{
 	EnableGracefulFailover:              "system.enableGracefulFailover",
 	TransactionSizeLimit:                "system.transactionSizeLimit",
 	PersistenceErrorInjectionRate:       "system.persistenceErrorInjectionRate",
+	SQLReshardingCutover:                "system.sqlReshardingCutover",
 	MaxRetentionDays:                    "system.maxRetentionDays",
 	MinRetentionDays:                    "system.minRetentionDays",
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
@@ -2240,6 +2381,8 @@ var Keys = map[Key]string{
 	EnableGRPCOutbound:                  "system.enableGRPCOutbound",
 	GRPCMaxSizeInByte:                   "system.grpcMaxSizeInByte",
 	EnableWatchDog:                      "system.EnableWatchDog",
+	EnableArchivalVerifier:              "system.enableArchivalVerifier",
+	EnableArchivalScavenger:             "system.enableArchivalScavenger",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -2332,6 +2475,9 @@ var Keys = map[Key]string{
 	HistoryPersistenceGlobalMaxQPS:                     "history.persistenceGlobalMaxQPS",
 	HistoryVisibilityOpenMaxQPS:                        "history.historyVisibilityOpenMaxQPS",
 	HistoryVisibilityClosedMaxQPS:                      "history.historyVisibilityClosedMaxQPS",
+	HistoryESVisibilityDirectWriteNumOfWorkers:         "history.ESVisibilityDirectWriteNumOfWorkers",
+	HistoryESVisibilityDirectWriteBulkActions:          "history.ESVisibilityDirectWriteBulkActions",
+	HistoryESVisibilityDirectWriteFlushInterval:        "history.ESVisibilityDirectWriteFlushInterval",
 	HistoryLongPollExpirationInterval:                  "history.longPollExpirationInterval",
 	HistoryCacheInitialSize:                            "history.cacheInitialSize",
 	HistoryMaxAutoResetPoints:                          "history.historyMaxAutoResetPoints",
@@ -2561,6 +2707,14 @@ var Keys = map[Key]string{
 	TimersScannerPeriodStart:                                 "worker.timersScannerPeriodStart",
 	TimersScannerPeriodEnd:                                   "worker.timersScannerPeriodEnd",
 	TimersFixerDomainAllow:                                   "worker.timersFixerDomainAllow",
+	VisibilityScannerEnabled:                                 "worker.visibilityScannerEnabled",
+	VisibilityFixerEnabled:                                   "worker.visibilityFixerEnabled",
+	VisibilityScannerConcurrency:                             "worker.visibilityScannerConcurrency",
+	VisibilityScannerPersistencePageSize:                     "worker.visibilityScannerPersistencePageSize",
+	VisibilityScannerBlobstoreFlushThreshold:                 "worker.visibilityScannerBlobstoreFlushThreshold",
+	VisibilityScannerActivityBatchSize:                       "worker.visibilityScannerActivityBatchSize",
+	VisibilityScannerShardSampleRate:                         "worker.visibilityScannerShardSampleRate",
+	VisibilityFixerDomainAllow:                               "worker.visibilityFixerDomainAllow",
 
 	// used by internal repos, need to moved out of this repo
 	// TODO https://github.com/uber/cadence/issues/3861
@@ -2585,6 +2739,16 @@ var Keys = map[Key]string{
 	ESAnalyzerWorkflowDurationWarnThresholds: "worker.ESAnalyzerWorkflowDurationWarnThresholds",
 
 	CorruptWorkflowWatchdogPause: "worker.CorruptWorkflowWatchdogPause",
+
+	ArchivalVerifierPause:            "worker.ArchivalVerifierPause",
+	ArchivalVerifierSamplesPerDomain: "worker.ArchivalVerifierSamplesPerDomain",
+	ArchivalVerifierTimeWindow:       "worker.ArchivalVerifierTimeWindow",
+	ArchivalVerifierMinCloseAge:      "worker.ArchivalVerifierMinCloseAge",
+	ArchivalVerifierEnableReArchive:  "worker.ArchivalVerifierEnableReArchive",
+
+	ArchivalScavengerPause: "worker.ArchivalScavengerPause",
+	ArchivalBackfillRPS:    "worker.ArchivalBackfillRPS",
+	VisibilityReindexRPS:   "worker.VisibilityReindexRPS",
 }
 
 var KeyNames map[string]Key
@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+)
+
+// retryableStatusCode is compliant with GenericBulkProcessorService.RetryItemStatusCodes,
+// responses with these status will be kept in queue and retried until success
+// 408 - Request Timeout
+// 429 - Too Many Requests
+// 500 - Node not connected
+// 503 - Service Unavailable
+// 507 - Insufficient Storage
+var retryableStatusCode = map[int]struct{}{408: {}, 429: {}, 500: {}, 503: {}, 507: {}}
+
+// IsResponseSuccess returns true if the bulk item response means the request is done
+// 409 - Version Conflict
+// 404 - Not Found
+func IsResponseSuccess(status int) bool {
+	if status >= 200 && status < 300 || status == 409 || status == 404 {
+		return true
+	}
+	return false
+}
+
+// IsResponseRetriable returns true if the bulk processor retries the requests failed with the status
+func IsResponseRetriable(status int) bool {
+	_, ok := retryableStatusCode[status]
+	return ok
+}
+
+// GetErrorMsgFromESResp returns the error message of a bulk item response
+func GetErrorMsgFromESResp(resp *GenericBulkResponseItem) string {
+	var errMsg string
+	if resp.Error != nil {
+		errMsg = fmt.Sprintf("%v", resp.Error)
+	}
+	return errMsg
+}
+
+// GenerateESDoc converts the fields of a visibility message into the document of the visibility index.
+// Fields which are not registered as search attributes and search attributes which can't be decoded
+// are logged and counted with the corruptedDataMetric of the scope.
+func GenerateESDoc(
+	msg *indexer.Message,
+	kafkaKey string,
+	validSearchAttributes map[string]interface{},
+	logger log.Logger,
+	metricsScope metrics.Scope,
+	corruptedDataMetric int,
+) map[string]interface{} {
+	doc := make(map[string]interface{})
+	attr := make(map[string]interface{})
+	for k, v := range msg.Fields {
+		if !isValidFieldToES(k, validSearchAttributes) {
+			logger.Error("Unregistered field.", tag.ESField(k))
+			metricsScope.IncCounter(corruptedDataMetric)
+			continue
+		}
+
+		switch v.GetType() {
+		case indexer.FieldTypeString:
+			doc[k] = v.GetStringData()
+		case indexer.FieldTypeInt:
+			doc[k] = v.GetIntData()
+		case indexer.FieldTypeBool:
+			doc[k] = v.GetBoolData()
+		case indexer.FieldTypeBinary:
+			if k == definition.Memo {
+				doc[k] = v.GetBinaryData()
+			} else { // custom search attributes
+				var val interface{}
+				if err := json.Unmarshal(v.GetBinaryData(), &val); err != nil {
+					logger.Error("Error when decode search attributes values.", tag.Error(err), tag.ESField(k))
+					metricsScope.IncCounter(corruptedDataMetric)
+				}
+				attr[k] = val
+			}
+		default:
+			// must be bug in code and bad deployment, check data sent from producer
+			logger.Error("Unknown field type.", tag.ESField(k))
+			metricsScope.IncCounter(corruptedDataMetric)
+		}
+	}
+	doc[definition.Attr] = attr
+	doc[definition.DomainID] = msg.GetDomainID()
+	doc[definition.WorkflowID] = msg.GetWorkflowID()
+	doc[definition.RunID] = msg.GetRunID()
+	doc[definition.KafkaKey] = kafkaKey
+	return doc
+}
+
+func isValidFieldToES(field string, validSearchAttributes map[string]interface{}) bool {
+	if _, ok := validSearchAttributes[field]; ok {
+		return true
+	}
+	return field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding
+}
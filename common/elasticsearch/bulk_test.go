@@ -0,0 +1,103 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+)
+
+func TestIsResponseSuccess(t *testing.T) {
+	for i := 200; i < 300; i++ {
+		assert.True(t, IsResponseSuccess(i))
+	}
+	status := []int{409, 404}
+	for _, code := range status {
+		assert.True(t, IsResponseSuccess(code))
+	}
+	status = []int{100, 199, 300, 400, 500, 408, 429, 503, 507}
+	for _, code := range status {
+		assert.False(t, IsResponseSuccess(code))
+	}
+}
+
+func TestIsResponseRetriable(t *testing.T) {
+	status := []int{408, 429, 500, 503, 507}
+	for _, code := range status {
+		assert.True(t, IsResponseRetriable(code))
+	}
+	status = []int{0, 200, 400, 404, 409}
+	for _, code := range status {
+		assert.False(t, IsResponseRetriable(code))
+	}
+}
+
+func TestGetErrorMsgFromESResp(t *testing.T) {
+	assert.Equal(t, "", GetErrorMsgFromESResp(&GenericBulkResponseItem{}))
+	assert.Equal(t, "map[reason:mapper_parsing_exception]", GetErrorMsgFromESResp(&GenericBulkResponseItem{
+		Error: map[string]interface{}{"reason": "mapper_parsing_exception"},
+	}))
+}
+
+func TestGenerateESDoc(t *testing.T) {
+	msg := &indexer.Message{
+		DomainID:   common.StringPtr("domain-id"),
+		WorkflowID: common.StringPtr("workflow-id"),
+		RunID:      common.StringPtr("run-id"),
+		Fields: map[string]*indexer.Field{
+			definition.WorkflowType: {Type: indexer.FieldTypeString.Ptr(), StringData: common.StringPtr("type")},
+			definition.StartTime:    {Type: indexer.FieldTypeInt.Ptr(), IntData: common.Int64Ptr(123)},
+			definition.Memo:         {Type: indexer.FieldTypeBinary.Ptr(), BinaryData: []byte("memo")},
+			"CustomStringField":     {Type: indexer.FieldTypeBinary.Ptr(), BinaryData: []byte(`"value"`)},
+			"CustomBoolField":       {Type: indexer.FieldTypeBinary.Ptr(), BinaryData: []byte(`not json`)},
+			"UnknownField":          {Type: indexer.FieldTypeString.Ptr(), StringData: common.StringPtr("value")},
+		},
+	}
+	scope := tally.NewTestScope("test", nil)
+	metricsScope := metrics.NewClient(scope, metrics.Worker).Scope(metrics.IndexProcessorScope)
+
+	doc := GenerateESDoc(msg, "key", definition.GetDefaultIndexedKeys(), loggerimpl.NewNopLogger(), metricsScope, metrics.IndexProcessorCorruptedData)
+	assert.Equal(t, map[string]interface{}{
+		definition.WorkflowType: "type",
+		definition.StartTime:    int64(123),
+		definition.Memo:         []byte("memo"),
+		definition.Attr: map[string]interface{}{
+			"CustomStringField": "value",
+			"CustomBoolField":   nil,
+		},
+		definition.DomainID:   "domain-id",
+		definition.WorkflowID: "workflow-id",
+		definition.RunID:      "run-id",
+		definition.KafkaKey:   "key",
+	}, doc)
+
+	counter, ok := scope.Snapshot().Counters()["test.es_processor_corrupted_data+operation=IndexProcessor"]
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), counter.Value())
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/olivere/elastic/v7"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+)
+
+const (
+	// openSearchDistribution is reported by OpenSearch in version.distribution of the root endpoint,
+	// which ElasticSearch doesn't have
+	openSearchDistribution = "opensearch"
+
+	openSearchHandshakeTimeout = 10 * time.Second
+)
+
+var _ GenericClient = (*openSearch)(nil)
+var _ GenericBulkProcessor = (*osBulkProcessor)(nil)
+
+var supportedOpenSearchMajorVersions = []string{"1", "2"}
+
+type (
+	// openSearch implements Client for OpenSearch 1.x and 2.x.
+	// OpenSearch serves the ElasticSearch v7 APIs used by Cadence, except that 2.x rejects mapping types in bulk requests.
+	openSearch struct {
+		*elasticV7
+	}
+
+	// osBulkProcessor adds the bulk requests without mapping types
+	osBulkProcessor struct {
+		*v7BulkProcessor
+	}
+
+	// openSearchInfo is the response of the root endpoint
+	openSearchInfo struct {
+		Version struct {
+			Distribution string `json:"distribution"`
+			Number       string `json:"number"`
+		} `json:"version"`
+	}
+)
+
+// NewOSClient returns a new implementation of GenericClient for OpenSearch
+func NewOSClient(
+	connectConfig *config.ElasticSearchConfig,
+	logger log.Logger,
+	clientOptFuncs ...elastic.ClientOptionFunc,
+) (GenericClient, error) {
+	if connectConfig.Username != "" {
+		clientOptFuncs = append(clientOptFuncs, elastic.SetBasicAuth(connectConfig.Username, connectConfig.Password))
+	}
+	client, err := newElasticV7(connectConfig, logger, clientOptFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	if !connectConfig.DisableHealthCheck {
+		ctx, cancel := context.WithTimeout(context.Background(), openSearchHandshakeTimeout)
+		defer cancel()
+		if err := checkOpenSearchVersion(ctx, client.client); err != nil {
+			return nil, err
+		}
+	}
+	return &openSearch{elasticV7: client}, nil
+}
+
+// checkOpenSearchVersion fails fast when the cluster is not OpenSearch 1.x or 2.x,
+// e.g. when an ElasticSearch cluster is configured with the opensearch version
+func checkOpenSearchVersion(ctx context.Context, client *elastic.Client) error {
+	resp, err := client.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: http.MethodGet,
+		Path:   "/",
+	})
+	if err != nil {
+		return err
+	}
+	var info openSearchInfo
+	if err := json.Unmarshal(resp.Body, &info); err != nil {
+		return err
+	}
+	if info.Version.Distribution != openSearchDistribution {
+		return fmt.Errorf("not an OpenSearch cluster, version: %v, use v6 or v7 for ElasticSearch", info.Version.Number)
+	}
+	for _, major := range supportedOpenSearchMajorVersions {
+		if strings.HasPrefix(info.Version.Number, major+".") {
+			return nil
+		}
+	}
+	return fmt.Errorf("not supported OpenSearch version: %v", info.Version.Number)
+}
+
+func (c *openSearch) RunBulkProcessor(ctx context.Context, parameters *BulkProcessorParameters) (GenericBulkProcessor, error) {
+	processor, err := c.runGenericBulkProcessor(ctx, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return &osBulkProcessor{v7BulkProcessor: processor}, nil
+}
+
+func (v *osBulkProcessor) Add(request *GenericBulkableAddRequest) {
+	v.processor.Add(newOSBulkableRequest(request))
+}
+
+func newOSBulkableRequest(request *GenericBulkableAddRequest) elastic.BulkableRequest {
+	if request.IsDelete {
+		return elastic.NewBulkDeleteRequest().
+			Index(request.Index).
+			Id(request.ID).
+			VersionType(request.VersionType).
+			Version(request.Version)
+	}
+	return elastic.NewBulkIndexRequest().
+		Index(request.Index).
+		Id(request.ID).
+		VersionType(request.VersionType).
+		Version(request.Version).
+		Doc(request.Doc)
+}
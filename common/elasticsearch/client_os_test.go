@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckOpenSearchVersion(t *testing.T) {
+	tests := map[string]struct {
+		response    string
+		expectError bool
+	}{
+		"OpenSearch 1.x": {
+			response: `{"version":{"distribution":"opensearch","number":"1.3.6"}}`,
+		},
+		"OpenSearch 2.x": {
+			response: `{"version":{"distribution":"opensearch","number":"2.3.0"}}`,
+		},
+		"ElasticSearch": {
+			response:    `{"version":{"number":"7.10.2","build_flavor":"oss"}}`,
+			expectError: true,
+		},
+		"unsupported OpenSearch version": {
+			response:    `{"version":{"distribution":"opensearch","number":"3.0.0"}}`,
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			client, err := elastic.NewClient(
+				elastic.SetURL(server.URL),
+				elastic.SetSniff(false),
+				elastic.SetHealthcheck(false),
+			)
+			require.NoError(t, err)
+
+			err = checkOpenSearchVersion(context.Background(), client)
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewOSBulkableRequest(t *testing.T) {
+	indexRequest := newOSBulkableRequest(&GenericBulkableAddRequest{
+		Index:       "test-index",
+		Type:        esDocType,
+		ID:          "wid~rid",
+		VersionType: "external",
+		Version:     123,
+		Doc:         map[string]interface{}{"WorkflowID": "wid"},
+	})
+	source, err := indexRequest.Source()
+	require.NoError(t, err)
+	require.Len(t, source, 2)
+	assert.Contains(t, source[0], `"_id":"wid~rid"`)
+	assert.NotContains(t, source[0], "_type")
+	assert.Equal(t, `{"WorkflowID":"wid"}`, source[1])
+
+	deleteRequest := newOSBulkableRequest(&GenericBulkableAddRequest{
+		Index:       "test-index",
+		Type:        esDocType,
+		ID:          "wid~rid",
+		VersionType: "external",
+		Version:     123,
+		IsDelete:    true,
+	})
+	source, err = deleteRequest.Source()
+	require.NoError(t, err)
+	require.Len(t, source, 1)
+	assert.NotContains(t, source[0], "_type")
+}
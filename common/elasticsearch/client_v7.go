@@ -86,6 +86,18 @@ func NewV7Client(
 	logger log.Logger,
 	clientOptFuncs ...elastic.ClientOptionFunc,
 ) (GenericClient, error) {
+	client, err := newElasticV7(connectConfig, logger, clientOptFuncs...)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func newElasticV7(
+	connectConfig *config.ElasticSearchConfig,
+	logger log.Logger,
+	clientOptFuncs ...elastic.ClientOptionFunc,
+) (*elasticV7, error) {
 	clientOptFuncs = append(clientOptFuncs,
 		elastic.SetURL(connectConfig.URL.String()),
 		elastic.SetRetrier(elastic.NewBackoffRetrier(elastic.NewExponentialBackoff(128*time.Millisecond, 513*time.Millisecond))),
@@ -251,6 +263,14 @@ func (c *elasticV7) ScanByQuery(ctx context.Context, request *ScanByQueryRequest
 }
 
 func (c *elasticV7) RunBulkProcessor(ctx context.Context, parameters *BulkProcessorParameters) (GenericBulkProcessor, error) {
+	processor, err := c.runGenericBulkProcessor(ctx, parameters)
+	if err != nil {
+		return nil, err
+	}
+	return processor, nil
+}
+
+func (c *elasticV7) runGenericBulkProcessor(ctx context.Context, parameters *BulkProcessorParameters) (*v7BulkProcessor, error) {
 	beforeFunc := func(executionId int64, requests []elastic.BulkableRequest) {
 		parameters.BeforeFunc(executionId, fromV7ToGenericBulkableRequests(requests))
 	}
@@ -46,6 +46,8 @@ func NewGenericClient(
 		return NewV6Client(connectConfig, logger)
 	case "v7":
 		return NewV7Client(connectConfig, logger)
+	case "opensearch":
+		return NewOSClient(connectConfig, logger)
 	default:
 		return nil, fmt.Errorf("not supported ElasticSearch version: %v", connectConfig.Version)
 	}
@@ -131,6 +131,7 @@ var (
 	ComponentCrossClusterTaskFetcher    = component("cross-cluster-task-fetcher")
 	ComponentShardScanner               = component("shardscanner-scanner")
 	ComponentShardFixer                 = component("shardscanner-fixer")
+	ComponentSQLResharder               = component("sql-resharder")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -292,6 +293,8 @@ var (
 	AdminClientOperationPurgeDLQMessages                  = clientOperation("admin-purge-dlq-messsages")
 	AdminClientOperationMergeDLQMessages                  = clientOperation("admin-merge-dlq-messsages")
 	AdminClientOperationRefreshWorkflowTasks              = clientOperation("admin-refresh-wf-tasks")
+	AdminClientOperationRestoreWorkflow                   = clientOperation("admin-restore-wf")
+	AdminClientOperationSearchArchivedHistory             = clientOperation("admin-search-archived-history")
 	AdminClientOperationResendReplicationTasks            = clientOperation("admin-resend-replication-tasks")
 	AdminClientOperationGetCrossClusterTasks              = clientOperation("admin-get-cross-cluster-tasks")
 	AdminClientOperationRespondCrossClusterTasksCompleted = clientOperation("admin-respond-cross-cluster-tasks-completed")
@@ -381,6 +384,7 @@ var (
 	HistoryClientOperationPurgeDLQMessages                  = clientOperation("history-purge-dlq-messages")
 	HistoryClientOperationMergeDLQMessages                  = clientOperation("history-merge-dlq-messages")
 	HistoryClientOperationRefreshWorkflowTasks              = clientOperation("history-refresh-wf-tasks")
+	HistoryClientOperationRestoreWorkflow                   = clientOperation("history-restore-wf")
 	HistoryClientOperationNotifyFailoverMarkers             = clientOperation("history-notify-failover-markers")
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
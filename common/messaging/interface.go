@@ -60,6 +60,12 @@ type (
 		Publish(ctx context.Context, message interface{}) error
 	}
 
+	// RawMessage is a message with an already serialized value, it's published as is
+	RawMessage struct {
+		Key   string
+		Value []byte
+	}
+
 	// CloseableProducer is a Producer that can be closed
 	CloseableProducer interface {
 		Producer
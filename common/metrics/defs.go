@@ -373,6 +373,8 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientRestoreWorkflowScope tracks RPC calls to history service
+	HistoryClientRestoreWorkflowScope
 	// HistoryClientNotifyFailoverMarkersScope tracks RPC calls to history service
 	HistoryClientNotifyFailoverMarkersScope
 	// HistoryClientGetCrossClusterTasksScope tracks RPC calls to history service
@@ -521,6 +523,10 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientRestoreWorkflowScope tracks RPC calls to admin service
+	AdminClientRestoreWorkflowScope
+	// AdminClientSearchArchivedHistoryScope tracks RPC calls to admin service
+	AdminClientSearchArchivedHistoryScope
 	// AdminClientResendReplicationTasksScope tracks RPC calls to admin service
 	AdminClientResendReplicationTasksScope
 	// AdminClientGetCrossClusterTasksScope tracks RPC calls to Admin service
@@ -686,6 +692,8 @@ const (
 	ElasticsearchCountWorkflowExecutionsScope
 	// ElasticsearchDeleteWorkflowExecutionsScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	ElasticsearchDeleteWorkflowExecutionsScope
+	// ElasticsearchDirectWriteScope tracks visibility records written to ElasticSearch directly by history service
+	ElasticsearchDirectWriteScope
 
 	// SequentialTaskProcessingScope is used by sequential task processing logic
 	SequentialTaskProcessingScope
@@ -719,6 +727,8 @@ const (
 	DomainFailoverScope
 	// DomainReplicationQueueScope is used in domainreplication queue
 	DomainReplicationQueueScope
+	// AuditLogScope is used by the audit log of the mutating API calls
+	AuditLogScope
 
 	NumCommonScopes
 )
@@ -745,6 +755,10 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminRestoreWorkflowScope is the metric scope for admin.RestoreWorkflow
+	AdminRestoreWorkflowScope
+	// AdminSearchArchivedHistoryScope is the metric scope for admin.SearchArchivedHistory
+	AdminSearchArchivedHistoryScope
 	// AdminResendReplicationTasksScope is the metric scope for admin.ResendReplicationTasks
 	AdminResendReplicationTasksScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -947,6 +961,8 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
 	HistoryRefreshWorkflowTasksScope
+	// HistoryRestoreWorkflowScope tracks RestoreWorkflow API calls received by service
+	HistoryRestoreWorkflowScope
 	// HistoryNotifyFailoverMarkersScope is the scope used by notify failover marker API
 	HistoryNotifyFailoverMarkersScope
 	// HistoryGetCrossClusterTasksScope tracks GetCrossClusterTasks API calls received by service
@@ -1203,6 +1219,14 @@ const (
 	ESAnalyzerScope
 	// WatchDogScope is scope used by WatchDog workflow
 	WatchDogScope
+	// ArchivalVerifierScope is scope used by archival verifier workflow
+	ArchivalVerifierScope
+	// ArchivalScavengerScope is scope used by archival scavenger workflow
+	ArchivalScavengerScope
+	// ArchivalBackfillScope is scope used by archival backfill workflow
+	ArchivalBackfillScope
+	// VisibilityReindexScope is scope used by visibility reindex workflow
+	VisibilityReindexScope
 
 	NumWorkerScopes
 )
@@ -1339,6 +1363,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientRestoreWorkflowScope:                     {operation: "HistoryClientRestoreWorkflowScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientNotifyFailoverMarkersScope:               {operation: "HistoryClientNotifyFailoverMarkersScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetCrossClusterTasksScope:                {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope:   {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1406,6 +1431,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientRestoreWorkflowScope:                       {operation: "AdminClientRestoreWorkflow", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientSearchArchivedHistoryScope:                 {operation: "AdminClientSearchArchivedHistory", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResendReplicationTasksScope:                {operation: "AdminClientResendReplicationTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1488,6 +1515,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ElasticsearchScanWorkflowExecutionsScope:                   {operation: "ScanWorkflowExecutions"},
 		ElasticsearchCountWorkflowExecutionsScope:                  {operation: "CountWorkflowExecutions"},
 		ElasticsearchDeleteWorkflowExecutionsScope:                 {operation: "DeleteWorkflowExecution"},
+		ElasticsearchDirectWriteScope:                              {operation: "ElasticsearchDirectWrite"},
 		SequentialTaskProcessingScope:                              {operation: "SequentialTaskProcessing"},
 		ParallelTaskProcessingScope:                                {operation: "ParallelTaskProcessing"},
 		TaskSchedulerScope:                                         {operation: "TaskScheduler"},
@@ -1504,6 +1532,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 
 		DomainFailoverScope:         {operation: "DomainFailover"},
 		DomainReplicationQueueScope: {operation: "DomainReplicationQueue"},
+		AuditLogScope:               {operation: "AuditLog"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1526,6 +1555,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:         {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                     {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:              {operation: "RefreshWorkflowTasks"},
+		AdminRestoreWorkflowScope:                   {operation: "RestoreWorkflow"},
+		AdminSearchArchivedHistoryScope:             {operation: "SearchArchivedHistory"},
 		AdminResendReplicationTasksScope:            {operation: "ResendReplicationTasks"},
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
@@ -1620,6 +1651,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                                     {operation: "ShardController"},
 		HistoryReapplyEventsScope:                                       {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                                {operation: "RefreshWorkflowTasks"},
+		HistoryRestoreWorkflowScope:                                     {operation: "RestoreWorkflow"},
 		HistoryNotifyFailoverMarkersScope:                               {operation: "NotifyFailoverMarkers"},
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
@@ -1745,6 +1777,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ParentClosePolicyProcessorScope:        {operation: "ParentClosePolicyProcessor"},
 		ESAnalyzerScope:                        {operation: "ESAnalyzer"},
 		WatchDogScope:                          {operation: "WatchDog"},
+		ArchivalVerifierScope:                  {operation: "ArchivalVerifier"},
+		ArchivalScavengerScope:                 {operation: "ArchivalScavenger"},
+		ArchivalBackfillScope:                  {operation: "ArchivalBackfill"},
+		VisibilityReindexScope:                 {operation: "VisibilityReindex"},
 	},
 }
 
@@ -1828,6 +1864,8 @@ const (
 	ElasticsearchLatency
 	ElasticsearchErrBadRequestCounter
 	ElasticsearchErrBusyCounter
+	ElasticsearchCorruptedData
+	ElasticsearchDirectWriteDropped
 
 	SequentialTaskSubmitRequest
 	SequentialTaskSubmitRequestTaskQueueExist
@@ -1909,6 +1947,9 @@ const (
 	ParentClosePolicyProcessorSuccess
 	ParentClosePolicyProcessorFailures
 
+	AuditRecordsDropped
+	AuditRecordWriteFailures
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -2271,6 +2312,24 @@ const (
 	WatchDogNumDeletedCorruptWorkflows
 	WatchDogNumFailedToDeleteCorruptWorkflows
 	WatchDogNumCorruptWorkflowProcessed
+	ArchivalVerifierNumWorkflowsSampled
+	ArchivalVerifierNumWorkflowsVerified
+	ArchivalVerifierNumArchiveMissing
+	ArchivalVerifierNumArchiveInvalid
+	ArchivalVerifierNumArchiveMismatch
+	ArchivalVerifierNumReArchived
+	ArchivalVerifierNumReArchiveFailed
+	ArchivalVerifierNumUnrecoverable
+	ArchivalVerifierNumVerifyFailed
+	ArchivalScavengerNumDomainsScavenged
+	ArchivalScavengerNumDeleteHistoryFailed
+	ArchivalScavengerNumDeleteVisibilityFailed
+	ArchivalBackfillNumWorkflowsSubmitted
+	ArchivalBackfillNumWorkflowsSkipped
+	ArchivalBackfillNumSubmitFailed
+	VisibilityReindexNumWorkflowsReindexed
+	VisibilityReindexNumWorkflowsSkipped
+	VisibilityReindexNumReindexFailed
 
 	NumWorkerMetrics
 )
@@ -2348,6 +2407,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ElasticsearchLatency:                                {metricName: "elasticsearch_latency", metricType: Timer},
 		ElasticsearchErrBadRequestCounter:                   {metricName: "elasticsearch_errors_bad_request", metricType: Counter},
 		ElasticsearchErrBusyCounter:                         {metricName: "elasticsearch_errors_busy", metricType: Counter},
+		ElasticsearchCorruptedData:                          {metricName: "elasticsearch_corrupted_data", metricType: Counter},
+		ElasticsearchDirectWriteDropped:                     {metricName: "elasticsearch_direct_write_dropped", metricType: Counter},
 		SequentialTaskSubmitRequest:                         {metricName: "sequentialtask_submit_request", metricType: Counter},
 		SequentialTaskSubmitRequestTaskQueueExist:           {metricName: "sequentialtask_submit_request_taskqueue_exist", metricType: Counter},
 		SequentialTaskSubmitRequestTaskQueueMissing:         {metricName: "sequentialtask_submit_request_taskqueue_missing", metricType: Counter},
@@ -2458,6 +2519,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DomainReplicationQueueSizeErrorCount: {metricName: "domain_replication_queue_failed", metricType: Counter},
 		ParentClosePolicyProcessorSuccess:    {metricName: "parent_close_policy_processor_requests", metricType: Counter},
 		ParentClosePolicyProcessorFailures:   {metricName: "parent_close_policy_processor_errors", metricType: Counter},
+		AuditRecordsDropped:                  {metricName: "audit_records_dropped", metricType: Counter},
+		AuditRecordWriteFailures:             {metricName: "audit_record_write_failures", metricType: Counter},
 	},
 	History: {
 		TaskRequests:             {metricName: "task_requests", metricType: Counter},
@@ -2805,6 +2868,24 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		WatchDogNumDeletedCorruptWorkflows:            {metricName: "watchdog_num_deleted_corrupt_workflows", metricType: Counter},
 		WatchDogNumFailedToDeleteCorruptWorkflows:     {metricName: "watchdog_num_failed_to_delete_corrupt_workflows", metricType: Counter},
 		WatchDogNumCorruptWorkflowProcessed:           {metricName: "watchdog_num_corrupt_workflows_processed", metricType: Counter},
+		ArchivalVerifierNumWorkflowsSampled:           {metricName: "archival_verifier_num_workflows_sampled", metricType: Counter},
+		ArchivalVerifierNumWorkflowsVerified:          {metricName: "archival_verifier_num_workflows_verified", metricType: Counter},
+		ArchivalVerifierNumArchiveMissing:             {metricName: "archival_verifier_num_archive_missing", metricType: Counter},
+		ArchivalVerifierNumArchiveInvalid:             {metricName: "archival_verifier_num_archive_invalid", metricType: Counter},
+		ArchivalVerifierNumArchiveMismatch:            {metricName: "archival_verifier_num_archive_mismatch", metricType: Counter},
+		ArchivalVerifierNumReArchived:                 {metricName: "archival_verifier_num_rearchived", metricType: Counter},
+		ArchivalVerifierNumReArchiveFailed:            {metricName: "archival_verifier_num_rearchive_failed", metricType: Counter},
+		ArchivalVerifierNumUnrecoverable:              {metricName: "archival_verifier_num_unrecoverable", metricType: Counter},
+		ArchivalVerifierNumVerifyFailed:               {metricName: "archival_verifier_num_verify_failed", metricType: Counter},
+		ArchivalScavengerNumDomainsScavenged:          {metricName: "archival_scavenger_num_domains_scavenged", metricType: Counter},
+		ArchivalScavengerNumDeleteHistoryFailed:       {metricName: "archival_scavenger_num_delete_history_failed", metricType: Counter},
+		ArchivalScavengerNumDeleteVisibilityFailed:    {metricName: "archival_scavenger_num_delete_visibility_failed", metricType: Counter},
+		ArchivalBackfillNumWorkflowsSubmitted:         {metricName: "archival_backfill_num_workflows_submitted", metricType: Counter},
+		ArchivalBackfillNumWorkflowsSkipped:           {metricName: "archival_backfill_num_workflows_skipped", metricType: Counter},
+		ArchivalBackfillNumSubmitFailed:               {metricName: "archival_backfill_num_submit_failed", metricType: Counter},
+		VisibilityReindexNumWorkflowsReindexed:        {metricName: "visibility_reindex_num_workflows_reindexed", metricType: Counter},
+		VisibilityReindexNumWorkflowsSkipped:          {metricName: "visibility_reindex_num_workflows_skipped", metricType: Counter},
+		VisibilityReindexNumReindexFailed:             {metricName: "visibility_reindex_num_reindex_failed", metricType: Counter},
 	},
 }
 
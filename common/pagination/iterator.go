@@ -114,3 +114,51 @@ func (i *iterator) terminate(err error) {
 	i.nextEntity = nil
 	i.nextError = err
 }
+
+type (
+	chainedIterator struct {
+		current      Iterator
+		newIterators []func() Iterator
+	}
+)
+
+// NewChainedIterator constructs a new Iterator which iterates through the iterators one after another.
+// Each iterator is only constructed once the previous one reaches the end, and the chained iterator
+// stops at the first error other than ErrIteratorFinished.
+func NewChainedIterator(newIterators ...func() Iterator) Iterator {
+	itr := &chainedIterator{
+		newIterators: newIterators,
+	}
+	itr.advance()
+	return itr
+}
+
+// Next returns the next Entity or error.
+func (i *chainedIterator) Next() (Entity, error) {
+	if i.current == nil {
+		return nil, ErrIteratorFinished
+	}
+	entity, err := i.current.Next()
+	i.advance()
+	return entity, err
+}
+
+// HasNext returns true if there is a next element.
+func (i *chainedIterator) HasNext() bool {
+	return i.current != nil && i.current.HasNext()
+}
+
+func (i *chainedIterator) advance() {
+	for len(i.newIterators) != 0 {
+		if i.current != nil {
+			if i.current.HasNext() {
+				return
+			}
+			if _, err := i.current.Next(); err != ErrIteratorFinished {
+				return
+			}
+		}
+		i.current = i.newIterators[0]()
+		i.newIterators = i.newIterators[1:]
+	}
+}
@@ -124,3 +124,58 @@ func (s *IteratorSuite) TestNonEmptyWithErrors() {
 	s.Nil(curr)
 	s.Equal("got error", err.Error())
 }
+
+func (s *IteratorSuite) TestChained() {
+	newIterator := func(first int, last int, err error) func() Iterator {
+		return func() Iterator {
+			return NewIterator(context.Background(), first, func(_ context.Context, token PageToken) (Page, error) {
+				if token.(int) == last {
+					if err != nil {
+						return Page{}, err
+					}
+					return Page{CurrentToken: token}, nil
+				}
+				return Page{
+					CurrentToken: token,
+					NextToken:    token.(int) + 1,
+					Entities:     fetchMap[token],
+				}, nil
+			})
+		}
+	}
+	itr := NewChainedIterator(newIterator(0, 3, nil), newIterator(0, 1, nil), newIterator(3, 5, nil))
+	expectedResults := []string{"one", "two", "three", "four", "five", "six", "seven", "eight"}
+	i := 0
+	for itr.HasNext() {
+		curr, err := itr.Next()
+		s.NoError(err)
+		s.Equal(expectedResults[i], curr.(string))
+		i++
+	}
+	s.Equal(len(expectedResults), i)
+	_, err := itr.Next()
+	s.Equal(ErrIteratorFinished, err)
+
+	constructed := false
+	itr = NewChainedIterator(newIterator(2, 3, errors.New("got error")), func() Iterator {
+		constructed = true
+		return newIterator(3, 5, nil)()
+	})
+	i = 0
+	for itr.HasNext() {
+		curr, err := itr.Next()
+		s.NoError(err)
+		s.Equal(expectedResults[i], curr.(string))
+		i++
+	}
+	s.Equal(3, i)
+	curr, err := itr.Next()
+	s.Nil(curr)
+	s.Equal("got error", err.Error())
+	s.False(constructed)
+
+	itr = NewChainedIterator()
+	s.False(itr.HasNext())
+	_, err = itr.Next()
+	s.Equal(ErrIteratorFinished, err)
+}
@@ -83,8 +83,10 @@ type (
 		PersistenceConfig config.Persistence
 		MetricsClient     metrics.Client
 		MessagingClient   messaging.Client
-		ESClient          es.GenericClient
-		ESConfig          *config.ElasticSearchConfig
+		// KafkaVisibilityEnabled is true if MessagingClient is configured with the Kafka topics of visibility
+		KafkaVisibilityEnabled bool
+		ESClient               es.GenericClient
+		ESConfig               *config.ElasticSearchConfig
 	}
 )
 
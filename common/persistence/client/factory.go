@@ -25,6 +25,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -81,7 +82,8 @@ type (
 		// NewVisibilityStore returns a new visibility store,
 		// TODO We temporarily using sortByCloseTime to determine whether or not ListClosedWorkflowExecutions should
 		// be ordering by CloseTime. This will be removed when implementing https://github.com/uber/cadence/issues/3621
-		NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error)
+		// validSearchAttributes are the declared types of the search attributes used by advanced visibility queries
+		NewVisibilityStore(sortByCloseTime bool, validSearchAttributes dynamicconfig.MapPropertyFn) (p.VisibilityStore, error)
 		NewQueue(queueType p.QueueType) (p.Queue, error)
 		// NewConfigStore returns a new config store
 		NewConfigStore() (p.ConfigStore, error)
@@ -272,10 +274,7 @@ func (f *factoryImpl) NewVisibilityManager(
 	}
 	if params.PersistenceConfig.AdvancedVisibilityStore != "" {
 		visibilityIndexName := params.ESConfig.Indices[common.VisibilityAppName]
-		visibilityProducer, err := params.MessagingClient.NewProducer(common.VisibilityAppName)
-		if err != nil {
-			f.logger.Fatal("Creating visibility producer failed", tag.Error(err))
-		}
+		visibilityProducer := f.newESVisibilityProducer(params, resourceConfig, visibilityIndexName)
 		visibilityFromES = newESVisibilityManager(
 			visibilityIndexName, params.ESClient, resourceConfig, visibilityProducer, params.MetricsClient, f.logger,
 		)
@@ -289,8 +288,32 @@ func (f *factoryImpl) NewVisibilityManager(
 	), nil
 }
 
+// newESVisibilityProducer creates the producer used for writing ElasticSearch visibility.
+// Messages go through Kafka when it's configured, and are written to ElasticSearch directly
+// when Kafka is not configured or direct write is enabled by dynamic config
+func (f *factoryImpl) newESVisibilityProducer(
+	params *Params,
+	resourceConfig *service.Config,
+	indexName string,
+) messaging.Producer {
+	var kafkaProducer messaging.Producer
+	if params.MessagingClient != nil && params.KafkaVisibilityEnabled {
+		producer, err := params.MessagingClient.NewProducer(common.VisibilityAppName)
+		if err != nil {
+			f.logger.Fatal("Creating visibility producer failed", tag.Error(err))
+		}
+		kafkaProducer = producer
+	}
+	if resourceConfig.EnableESVisibilityDirectWrite == nil {
+		// service never writes visibility directly
+		return kafkaProducer
+	}
+	directWriter := elasticsearch.NewESDirectWriter(params.ESClient, indexName, resourceConfig, params.MetricsClient, f.logger)
+	return elasticsearch.NewVisibilityProducer(kafkaProducer, directWriter, resourceConfig.EnableESVisibilityDirectWrite)
+}
+
 // NewESVisibilityManager create a visibility manager for ElasticSearch
-// In history, it only needs kafka producer for writing data;
+// In history, it only needs a producer (kafka or ES direct writer) for writing data;
 // In frontend, it only needs ES client and related config for reading data
 func newESVisibilityManager(
 	indexName string,
@@ -328,7 +351,7 @@ func (f *factoryImpl) newDBVisibilityManager(
 	}
 
 	ds := f.datastores[storeTypeVisibility]
-	store, err := ds.factory.NewVisibilityStore(enableReadFromClosedExecutionV2)
+	store, err := ds.factory.NewVisibilityStore(enableReadFromClosedExecutionV2, visibilityConfig.ValidSearchAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -1343,6 +1343,8 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              types.BadBinaries
+		// NOTE: this retention is in days, 0 means the archived data is kept forever
+		ArchivalRetention int32
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
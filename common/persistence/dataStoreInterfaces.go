@@ -764,6 +764,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		ArchivalRetention        time.Duration
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -0,0 +1,366 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common/dynamicconfig"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// esDirectWriter writes visibility messages to ElasticSearch through a bulk processor,
+	// so advanced visibility can be used without Kafka and the indexer.
+	// Publish blocks until ElasticSearch has accepted the request, which ties the write
+	// to the ack of the transfer task that produced it: a failed or timed out Publish
+	// fails the task, and the task is retried.
+	esDirectWriter struct {
+		esClient      es.GenericClient
+		index         string
+		config        *service.Config
+		logger        log.Logger
+		metricsClient metrics.Client
+
+		startOnce sync.Once
+		startErr  error
+		processor es.GenericBulkProcessor
+		sequence  int64
+
+		sync.Mutex
+		pending map[string]*pendingWrite // bulk request key -> waiting publishers
+	}
+
+	pendingWrite struct {
+		domainID   string
+		workflowID string
+		runID      string
+		waiters    []chan error
+	}
+
+	// visibilityProducer chooses between Kafka and direct write for every message,
+	// based on dynamic config
+	visibilityProducer struct {
+		kafkaProducer     messaging.Producer
+		directWriter      messaging.CloseableProducer
+		enableDirectWrite dynamicconfig.BoolPropertyFn
+	}
+)
+
+const (
+	esDirectWriterProcessorName = "visibility-direct-writer"
+	esDirectWriterBulkSize      = 2 << 24 // 16MB
+	versionTypeExternal         = "external"
+
+	// retry configs for es bulk processor
+	esDirectWriterInitialRetryInterval = 200 * time.Millisecond
+	esDirectWriterMaxRetryInterval     = 5 * time.Second
+)
+
+var (
+	errUnknownVisibilityMessage = &types.BadRequestError{Message: "unknown visibility message"}
+
+	_ messaging.CloseableProducer = (*esDirectWriter)(nil)
+	_ messaging.CloseableProducer = (*visibilityProducer)(nil)
+)
+
+// NewESDirectWriter creates a producer which writes visibility messages to ElasticSearch directly
+func NewESDirectWriter(
+	esClient es.GenericClient,
+	index string,
+	config *service.Config,
+	metricsClient metrics.Client,
+	logger log.Logger,
+) messaging.CloseableProducer {
+	if metricsClient == nil {
+		metricsClient = metrics.NewNoopMetricsClient()
+	}
+	return &esDirectWriter{
+		esClient:      esClient,
+		index:         index,
+		config:        config,
+		logger:        logger.WithTags(tag.ComponentESVisibilityManager),
+		metricsClient: metricsClient,
+		pending:       make(map[string]*pendingWrite),
+	}
+}
+
+// NewVisibilityProducer creates a producer which publishes visibility messages to Kafka,
+// or writes them to ElasticSearch directly when enableDirectWrite is true or Kafka is not configured
+func NewVisibilityProducer(
+	kafkaProducer messaging.Producer,
+	directWriter messaging.CloseableProducer,
+	enableDirectWrite dynamicconfig.BoolPropertyFn,
+) messaging.CloseableProducer {
+	return &visibilityProducer{
+		kafkaProducer:     kafkaProducer,
+		directWriter:      directWriter,
+		enableDirectWrite: enableDirectWrite,
+	}
+}
+
+func (p *visibilityProducer) Publish(ctx context.Context, message interface{}) error {
+	if p.kafkaProducer == nil || p.enableDirectWrite() {
+		return p.directWriter.Publish(ctx, message)
+	}
+	return p.kafkaProducer.Publish(ctx, message)
+}
+
+func (p *visibilityProducer) Close() error {
+	if closeable, ok := p.kafkaProducer.(messaging.CloseableProducer); ok {
+		closeable.Close() //nolint:errcheck
+	}
+	return p.directWriter.Close()
+}
+
+func (w *esDirectWriter) Publish(ctx context.Context, message interface{}) error {
+	msg, ok := message.(*indexer.Message)
+	if !ok {
+		return errUnknownVisibilityMessage
+	}
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	docID := es.GenerateDocID(msg.GetWorkflowID(), msg.GetRunID())
+	// check and skip invalid docID, same as indexer
+	if len(docID) >= es.GetESDocIDSizeLimit() {
+		w.logger.Error("Visibility message is too long",
+			tag.WorkflowDomainID(msg.GetDomainID()),
+			tag.WorkflowID(msg.GetWorkflowID()),
+			tag.WorkflowRunID(msg.GetRunID()))
+		return nil
+	}
+
+	req := &es.GenericBulkableAddRequest{
+		Index:       w.index,
+		Type:        es.GetESDocType(),
+		ID:          docID,
+		VersionType: versionTypeExternal,
+		Version:     msg.GetVersion(),
+	}
+	var key string
+	switch msg.GetMessageType() {
+	case indexer.MessageTypeIndex:
+		key = strconv.FormatInt(atomic.AddInt64(&w.sequence, 1), 10)
+		req.Doc = es.GenerateESDoc(msg, key, w.config.ValidSearchAttributes(), w.logger,
+			w.metricsClient.Scope(metrics.ElasticsearchDirectWriteScope), metrics.ElasticsearchCorruptedData)
+	case indexer.MessageTypeDelete:
+		// bulk processor can only retrieve the doc id from a delete request
+		key = docID
+		req.IsDelete = true
+	default:
+		return errUnknownVisibilityMessage
+	}
+
+	sw := w.metricsClient.StartTimer(metrics.ElasticsearchDirectWriteScope, metrics.ElasticsearchLatency)
+	defer sw.Stop()
+
+	doneCh := make(chan error, 1)
+	w.addPending(key, msg, doneCh)
+	w.processor.Add(req)
+
+	select {
+	case err := <-doneCh:
+		return err
+	case <-ctx.Done():
+		w.removePending(key, doneCh)
+		return ctx.Err()
+	}
+}
+
+func (w *esDirectWriter) Close() error {
+	if w.processor == nil {
+		return nil
+	}
+	err := w.processor.Stop()
+	w.completeAll(&types.InternalServiceError{Message: "visibility direct writer is closed"})
+	return err
+}
+
+func (w *esDirectWriter) start() error {
+	w.startOnce.Do(func() {
+		params := &es.BulkProcessorParameters{
+			Name:          esDirectWriterProcessorName,
+			NumOfWorkers:  w.config.ESVisibilityDirectWriteNumOfWorkers(),
+			BulkActions:   w.config.ESVisibilityDirectWriteBulkActions(),
+			BulkSize:      esDirectWriterBulkSize,
+			FlushInterval: w.config.ESVisibilityDirectWriteFlushInterval(),
+			Backoff:       es.NewExponentialBackoff(esDirectWriterInitialRetryInterval, esDirectWriterMaxRetryInterval),
+			BeforeFunc:    w.bulkBeforeAction,
+			AfterFunc:     w.bulkAfterAction,
+		}
+		w.processor, w.startErr = w.esClient.RunBulkProcessor(context.Background(), params)
+		if w.startErr != nil {
+			w.logger.Error("Failed to start visibility direct writer.", tag.Error(w.startErr))
+		}
+	})
+	return w.startErr
+}
+
+// bulkBeforeAction is triggered before bulk processor commit
+func (w *esDirectWriter) bulkBeforeAction(_ int64, requests []es.GenericBulkableRequest) {
+	w.metricsClient.AddCounter(metrics.ElasticsearchDirectWriteScope, metrics.ElasticsearchRequests, int64(len(requests)))
+}
+
+// bulkAfterAction is triggered after bulk processor commit
+func (w *esDirectWriter) bulkAfterAction(_ int64, requests []es.GenericBulkableRequest, response *es.GenericBulkResponse, err *es.GenericError) {
+	if err != nil {
+		// This happens after configured retry. Retryable requests are failed so that the
+		// transfer tasks are retried, the others can never succeed and are dropped like in indexer.
+		w.logger.Error("Error commit bulk request.", tag.Error(err.Details))
+		isRetryable := es.IsResponseRetriable(err.Status)
+		var result error
+		if isRetryable {
+			result = &types.InternalServiceError{Message: fmt.Sprintf("failed to write visibility to ElasticSearch: %v", err.Details)}
+		}
+		for _, request := range requests {
+			key := w.retrieveKey(request)
+			if isRetryable {
+				w.logger.Error("ES request failed.", tag.ESResponseStatus(err.Status), tag.ESRequest(request.String()))
+			} else {
+				w.drop(key, request, tag.ESResponseStatus(err.Status))
+			}
+			w.metricsClient.IncCounter(metrics.ElasticsearchDirectWriteScope, metrics.ElasticsearchFailures)
+			w.complete(key, result)
+		}
+		return
+	}
+
+	for i, request := range requests {
+		key := w.retrieveKey(request)
+		if key == "" {
+			w.drop(key, request)
+			continue
+		}
+		if i >= len(response.Items) {
+			w.drop(key, request, tag.ESResponseError("missing bulk response item"))
+			w.complete(key, nil)
+			continue
+		}
+		for _, resp := range response.Items[i] {
+			switch {
+			case es.IsResponseSuccess(resp.Status):
+				w.complete(key, nil)
+			case !es.IsResponseRetriable(resp.Status):
+				w.drop(key, request, tag.ESResponseStatus(resp.Status), tag.ESResponseError(es.GetErrorMsgFromESResp(resp)))
+				w.metricsClient.IncCounter(metrics.ElasticsearchDirectWriteScope, metrics.ElasticsearchFailures)
+				w.complete(key, nil)
+			default: // bulk processor will retry
+				w.logger.Info("ES request retried.", tag.ESResponseStatus(resp.Status))
+				w.metricsClient.IncCounter(metrics.ElasticsearchDirectWriteScope, metrics.ElasticsearchErrBusyCounter)
+			}
+		}
+	}
+}
+
+// drop logs and counts a visibility record which is never going to be written,
+// its publishers are acked so that the transfer tasks are not retried forever
+func (w *esDirectWriter) drop(key string, request es.GenericBulkableRequest, tags ...tag.Tag) {
+	tags = append(tags, tag.ESRequest(request.String()))
+	w.Lock()
+	if pending, ok := w.pending[key]; ok {
+		tags = append(tags,
+			tag.WorkflowDomainID(pending.domainID),
+			tag.WorkflowID(pending.workflowID),
+			tag.WorkflowRunID(pending.runID))
+	}
+	w.Unlock()
+	w.logger.Error("Visibility record dropped.", tags...)
+	w.metricsClient.IncCounter(metrics.ElasticsearchDirectWriteScope, metrics.ElasticsearchDirectWriteDropped)
+}
+
+func (w *esDirectWriter) retrieveKey(request es.GenericBulkableRequest) string {
+	return w.processor.RetrieveKafkaKey(request, w.logger, metrics.NewNoopMetricsClient())
+}
+
+func (w *esDirectWriter) addPending(key string, msg *indexer.Message, doneCh chan error) {
+	w.Lock()
+	defer w.Unlock()
+	pending, ok := w.pending[key]
+	if !ok {
+		pending = &pendingWrite{
+			domainID:   msg.GetDomainID(),
+			workflowID: msg.GetWorkflowID(),
+			runID:      msg.GetRunID(),
+		}
+		w.pending[key] = pending
+	}
+	pending.waiters = append(pending.waiters, doneCh)
+}
+
+func (w *esDirectWriter) removePending(key string, doneCh chan error) {
+	w.Lock()
+	defer w.Unlock()
+	pending, ok := w.pending[key]
+	if !ok {
+		return
+	}
+	for i, ch := range pending.waiters {
+		if ch == doneCh {
+			pending.waiters = append(pending.waiters[:i], pending.waiters[i+1:]...)
+			break
+		}
+	}
+	if len(pending.waiters) == 0 {
+		delete(w.pending, key)
+	}
+}
+
+func (w *esDirectWriter) complete(key string, err error) {
+	if key == "" {
+		return
+	}
+	w.Lock()
+	pending, ok := w.pending[key]
+	delete(w.pending, key)
+	w.Unlock()
+	if !ok {
+		return
+	}
+	for _, ch := range pending.waiters {
+		ch <- err
+	}
+}
+
+func (w *esDirectWriter) completeAll(err error) {
+	w.Lock()
+	pending := w.pending
+	w.pending = make(map[string]*pendingWrite)
+	w.Unlock()
+	for _, p := range pending {
+		for _, ch := range p.waiters {
+			ch <- err
+		}
+	}
+}
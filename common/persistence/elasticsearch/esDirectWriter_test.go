@@ -0,0 +1,228 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package elasticsearch
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
+	es "github.com/uber/cadence/common/elasticsearch"
+	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/service"
+)
+
+type ESDirectWriterSuite struct {
+	suite.Suite
+	*require.Assertions
+	mockESClient  *esMocks.GenericClient
+	mockProcessor *esMocks.GenericBulkProcessor
+	writer        *esDirectWriter
+	params        *es.BulkProcessorParameters
+	testScope     tally.TestScope
+}
+
+func TestESDirectWriterSuite(t *testing.T) {
+	suite.Run(t, new(ESDirectWriterSuite))
+}
+
+func (s *ESDirectWriterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.mockESClient = &esMocks.GenericClient{}
+	s.mockProcessor = &esMocks.GenericBulkProcessor{}
+	s.mockESClient.On("RunBulkProcessor", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			s.params = args.Get(1).(*es.BulkProcessorParameters)
+		}).
+		Return(s.mockProcessor, nil).Once()
+
+	config := &service.Config{
+		ValidSearchAttributes:                dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
+		ESVisibilityDirectWriteNumOfWorkers:  dynamicconfig.GetIntPropertyFn(1),
+		ESVisibilityDirectWriteBulkActions:   dynamicconfig.GetIntPropertyFn(10),
+		ESVisibilityDirectWriteFlushInterval: dynamicconfig.GetDurationPropertyFn(time.Millisecond),
+	}
+	s.testScope = tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(s.testScope, metrics.History)
+	s.writer = NewESDirectWriter(s.mockESClient, testIndex, config, metricsClient, loggerimpl.NewNopLogger()).(*esDirectWriter)
+}
+
+func (s *ESDirectWriterSuite) TearDownTest() {
+	s.mockESClient.AssertExpectations(s.T())
+	s.mockProcessor.AssertExpectations(s.T())
+}
+
+// commitOnAdd simulates a bulk commit with the given result for every request added to the processor
+func (s *ESDirectWriterSuite) commitOnAdd(status int, bulkErr *es.GenericError, check func(*es.GenericBulkableAddRequest)) {
+	s.mockProcessor.On("Add", mock.Anything).Run(func(args mock.Arguments) {
+		req := args.Get(0).(*es.GenericBulkableAddRequest)
+		check(req)
+
+		key := req.ID
+		if !req.IsDelete {
+			key = req.Doc.(map[string]interface{})[es.KafkaKey].(string)
+		}
+		bulkRequest := &esMocks.GenericBulkableRequest{}
+		bulkRequest.On("String").Return(key).Maybe()
+		s.mockProcessor.On("RetrieveKafkaKey", bulkRequest, mock.Anything, mock.Anything).Return(key).Once()
+
+		response := &es.GenericBulkResponse{
+			Items: []map[string]*es.GenericBulkResponseItem{{"index": {Status: status}}},
+		}
+		go s.params.AfterFunc(0, []es.GenericBulkableRequest{bulkRequest}, response, bulkErr)
+	}).Once()
+}
+
+func (s *ESDirectWriterSuite) droppedCount() int64 {
+	counter, ok := s.testScope.Snapshot().Counters()["test.elasticsearch_direct_write_dropped+operation=ElasticsearchDirectWrite"]
+	if !ok {
+		return 0
+	}
+	return counter.Value()
+}
+
+func (s *ESDirectWriterSuite) TestPublish_Index() {
+	searchAttr := map[string][]byte{"CustomStringField": []byte(`"value"`)}
+	msg := getVisibilityMessage(testDomainID, testWorkflowID, "rid", testWorkflowType, "tl",
+		int64(123), int64(321), int64(111), []byte("memo"), common.EncodingTypeThriftRW, false, 1, searchAttr)
+
+	s.commitOnAdd(201, nil, func(req *es.GenericBulkableAddRequest) {
+		s.Equal(testIndex, req.Index)
+		s.Equal(es.GenerateDocID(testWorkflowID, "rid"), req.ID)
+		s.Equal(versionTypeExternal, req.VersionType)
+		s.Equal(int64(111), req.Version)
+		s.False(req.IsDelete)
+
+		doc := req.Doc.(map[string]interface{})
+		s.Equal(testDomainID, doc[es.DomainID])
+		s.Equal(testWorkflowID, doc[es.WorkflowID])
+		s.Equal("rid", doc[es.RunID])
+		s.Equal(testWorkflowType, doc[es.WorkflowType])
+		s.Equal(int64(123), doc[es.StartTime])
+		s.Equal([]byte("memo"), doc[es.Memo])
+		s.Equal(map[string]interface{}{"CustomStringField": "value"}, doc[definition.Attr])
+		s.NotEmpty(doc[es.KafkaKey])
+	})
+
+	s.NoError(s.writer.Publish(context.Background(), msg))
+	s.Empty(s.writer.pending)
+}
+
+func (s *ESDirectWriterSuite) TestPublish_Delete() {
+	msg := getVisibilityMessageForDeletion(testDomainID, testWorkflowID, "rid", int64(111))
+
+	s.commitOnAdd(404, nil, func(req *es.GenericBulkableAddRequest) {
+		s.True(req.IsDelete)
+		s.Nil(req.Doc)
+		s.Equal(es.GenerateDocID(testWorkflowID, "rid"), req.ID)
+	})
+
+	s.NoError(s.writer.Publish(context.Background(), msg))
+}
+
+func (s *ESDirectWriterSuite) TestPublish_NonRetryableItemError() {
+	msg := getVisibilityMessageForDeletion(testDomainID, testWorkflowID, "rid", int64(111))
+
+	// request that can never succeed is dropped, otherwise the transfer task would be retried forever
+	s.commitOnAdd(400, nil, func(*es.GenericBulkableAddRequest) {})
+
+	s.NoError(s.writer.Publish(context.Background(), msg))
+	s.Empty(s.writer.pending)
+	s.Equal(int64(1), s.droppedCount())
+}
+
+func (s *ESDirectWriterSuite) TestPublish_NonRetryableBulkError() {
+	msg := getVisibilityMessageForDeletion(testDomainID, testWorkflowID, "rid", int64(111))
+
+	s.commitOnAdd(0, &es.GenericError{Status: 400}, func(*es.GenericBulkableAddRequest) {})
+
+	s.NoError(s.writer.Publish(context.Background(), msg))
+	s.Empty(s.writer.pending)
+	s.Equal(int64(1), s.droppedCount())
+}
+
+func (s *ESDirectWriterSuite) TestPublish_RetryableBulkError() {
+	msg := getVisibilityMessageForDeletion(testDomainID, testWorkflowID, "rid", int64(111))
+
+	s.commitOnAdd(0, &es.GenericError{Status: 503}, func(*es.GenericBulkableAddRequest) {})
+
+	s.Error(s.writer.Publish(context.Background(), msg))
+	s.Empty(s.writer.pending)
+	s.Equal(int64(0), s.droppedCount())
+}
+
+func (s *ESDirectWriterSuite) TestPublish_Timeout() {
+	msg := getVisibilityMessageForDeletion(testDomainID, testWorkflowID, "rid", int64(111))
+	s.mockProcessor.On("Add", mock.Anything).Once()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	s.Equal(context.DeadlineExceeded, s.writer.Publish(ctx, msg))
+	s.Empty(s.writer.pending)
+}
+
+func (s *ESDirectWriterSuite) TestPublish_DocIDTooLong() {
+	longID := strings.Repeat("a", es.GetESDocIDSizeLimit())
+	msg := getVisibilityMessageForDeletion(testDomainID, longID, "rid", int64(111))
+
+	s.NoError(s.writer.Publish(context.Background(), msg))
+	s.mockProcessor.AssertNotCalled(s.T(), "Add", mock.Anything)
+}
+
+func TestVisibilityProducer(t *testing.T) {
+	kafkaProducer := &mocks.KafkaProducer{}
+	directWriter := &mocks.KafkaProducer{}
+	enableDirectWrite := false
+	producer := NewVisibilityProducer(kafkaProducer, directWriter, func(...dynamicconfig.FilterOption) bool {
+		return enableDirectWrite
+	})
+
+	msg := &indexer.Message{}
+	kafkaProducer.On("Publish", mock.Anything, msg).Return(nil).Once()
+	require.NoError(t, producer.Publish(context.Background(), msg))
+
+	enableDirectWrite = true
+	directWriter.On("Publish", mock.Anything, msg).Return(nil).Once()
+	require.NoError(t, producer.Publish(context.Background(), msg))
+
+	// always write directly without kafka
+	enableDirectWrite = false
+	producer = NewVisibilityProducer(nil, directWriter, dynamicconfig.GetBoolPropertyFn(false))
+	directWriter.On("Publish", mock.Anything, msg).Return(nil).Once()
+	require.NoError(t, producer.Publish(context.Background(), msg))
+
+	kafkaProducer.AssertExpectations(t)
+	directWriter.AssertExpectations(t)
+}
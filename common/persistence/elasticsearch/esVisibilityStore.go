@@ -81,7 +81,11 @@ func NewElasticSearchVisibilityStore(
 	}
 }
 
-func (v *esVisibilityStore) Close() {}
+func (v *esVisibilityStore) Close() {
+	if closeable, ok := v.producer.(messaging.CloseableProducer); ok {
+		closeable.Close() //nolint:errcheck
+	}
+}
 
 func (v *esVisibilityStore) GetName() string {
 	return esPersistenceName
@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -85,7 +86,10 @@ func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 }
 
 // NewVisibilityStore returns a visibility store
-func (f *Factory) NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error) {
+func (f *Factory) NewVisibilityStore(
+	sortByCloseTime bool,
+	_ dynamicconfig.MapPropertyFn,
+) (p.VisibilityStore, error) {
 	return newNoSQLVisibilityStore(sortByCloseTime, f.cfg, f.logger)
 }
 
@@ -59,7 +59,8 @@ const (
 		`visibility_archival_status: ?, ` +
 		`visibility_archival_uri: ?, ` +
 		`bad_binaries: ?,` +
-		`bad_binaries_encoding: ?` +
+		`bad_binaries_encoding: ?, ` +
+		`archival_retention: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
@@ -87,7 +88,7 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.archival_retention, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -135,7 +136,7 @@ const (
 		`config.archival_bucket, config.archival_status, ` +
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
-		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.bad_binaries, config.bad_binaries_encoding, config.archival_retention, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -194,6 +195,7 @@ func (db *cdb) InsertDomain(
 		row.Config.VisibilityArchivalURI,
 		row.Config.BadBinaries.Data,
 		string(row.Config.BadBinaries.Encoding),
+		common.DurationToDays(row.Config.ArchivalRetention),
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.IsGlobalDomain,
@@ -292,6 +294,7 @@ func (db *cdb) UpdateDomain(
 		row.Config.VisibilityArchivalURI,
 		row.Config.BadBinaries.Data,
 		string(row.Config.BadBinaries.Encoding),
+		common.DurationToDays(row.Config.ArchivalRetention),
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.ConfigVersion,
@@ -363,6 +366,7 @@ func (db *cdb) SelectDomain(
 	var configVersion int64
 	var isGlobalDomain bool
 	var retentionDays int32
+	var archivalRetentionDays int32
 
 	query = db.session.Query(templateGetDomainByNameQueryV2, constDomainPartition, domainName).WithContext(ctx)
 	err = query.Scan(
@@ -382,6 +386,7 @@ func (db *cdb) SelectDomain(
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&archivalRetentionDays,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&isGlobalDomain,
@@ -400,6 +405,7 @@ func (db *cdb) SelectDomain(
 
 	config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
 	config.Retention = common.DaysToDuration(retentionDays)
+	config.ArchivalRetention = common.DaysToDuration(archivalRetentionDays)
 	replicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 
 	dr := &nosqlplugin.DomainRow{
@@ -445,6 +451,7 @@ func (db *cdb) SelectAllDomains(
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
 	var retentionDays int32
+	var archivalRetentionDays int32
 	var failoverEndTime int64
 	var lastUpdateTime int64
 	var rows []*nosqlplugin.DomainRow
@@ -466,6 +473,7 @@ func (db *cdb) SelectAllDomains(
 		&domain.Config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&archivalRetentionDays,
 		&domain.ReplicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&domain.IsGlobalDomain,
@@ -482,6 +490,7 @@ func (db *cdb) SelectAllDomains(
 			domain.Config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
 			domain.ReplicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 			domain.Config.Retention = common.DaysToDuration(retentionDays)
+			domain.Config.ArchivalRetention = common.DaysToDuration(archivalRetentionDays)
 			domain.LastUpdatedTime = time.Unix(0, lastUpdateTime)
 			if failoverEndTime > emptyFailoverEndTime {
 				domain.FailoverEndTime = common.TimePtr(time.Unix(0, failoverEndTime))
@@ -494,6 +503,7 @@ func (db *cdb) SelectAllDomains(
 		failoverEndTime = 0
 		lastUpdateTime = 0
 		retentionDays = 0
+		archivalRetentionDays = 0
 		domain = &nosqlplugin.DomainRow{
 			Info:              &p.DomainInfo{},
 			Config:            &nosqlplugin.NoSQLInternalDomainConfig{},
@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,15 +21,101 @@
 package dynamodb
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 var _ nosqlplugin.AdminDB = (*ddb)(nil)
 
+const (
+	testSchemaDir = "schema/dynamodb/"
+)
+
+// tables that have records with TTL
+var tablesWithTTL = []string{tableTasks, tableVisibility}
+
 func (db *ddb) SetupTestDatabase(schemaBaseDir string) error {
-	panic("TODO")
+	if schemaBaseDir == "" {
+		var err error
+		schemaBaseDir, err = nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+		if err != nil {
+			return err
+		}
+	}
+
+	tables, err := readTableSchemas(schemaBaseDir + "cadence/schema.json")
+	if err != nil {
+		return err
+	}
+
+	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
+	// It's okay because this is being called during server startup or CLI.
+	ctx := context.Background()
+	for _, table := range tables {
+		table.TableName = aws.String(db.tableName(*table.TableName))
+		if _, err := db.client.CreateTableWithContext(ctx, table); err != nil {
+			return err
+		}
+		if err := db.client.WaitUntilTableExistsWithContext(ctx, &dynamodb.DescribeTableInput{
+			TableName: table.TableName,
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, table := range tablesWithTTL {
+		_, err := db.client.UpdateTimeToLiveWithContext(ctx, &dynamodb.UpdateTimeToLiveInput{
+			TableName: aws.String(db.tableName(table)),
+			TimeToLiveSpecification: &dynamodb.TimeToLiveSpecification{
+				AttributeName: aws.String(attrExpiry),
+				Enabled:       aws.Bool(true),
+			},
+		})
+		if err != nil {
+			// TTL is only an optimization for deleting records, DynamoDB Local may not support it
+			db.logger.Warn("unable to enable TTL on table", tag.Value(table), tag.Error(err))
+		}
+	}
+	return nil
 }
 
 func (db *ddb) TeardownTestDatabase() error {
-	panic("TODO")
+	schemaBaseDir, err := nosqlplugin.GetDefaultTestSchemaDir(testSchemaDir)
+	if err != nil {
+		return err
+	}
+	tables, err := readTableSchemas(schemaBaseDir + "cadence/schema.json")
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for _, table := range tables {
+		_, err := db.client.DeleteTableWithContext(ctx, &dynamodb.DeleteTableInput{
+			TableName: aws.String(db.tableName(*table.TableName)),
+		})
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func readTableSchemas(schemaFile string) ([]*dynamodb.CreateTableInput, error) {
+	byteValues, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		return nil, err
+	}
+	var tables []*dynamodb.CreateTableInput
+	if err := json.Unmarshal(byteValues, &tables); err != nil {
+		return nil, err
+	}
+	return tables, nil
 }
@@ -22,15 +22,58 @@ package dynamodb
 
 import (
 	"context"
-	"errors"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 func (db *ddb) InsertConfig(ctx context.Context, row *persistence.InternalConfigStoreEntry) error {
-	return errors.New("TODO")
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableClusterConfig)),
+		Item: attributeMap{
+			attrRowType:      intAttr(row.RowType),
+			attrVersion:      int64Attr(row.Version),
+			attrTimestamp:    int64Attr(row.Timestamp.UnixNano()),
+			attrData:         bytesAttr(row.Values.Data),
+			attrDataEncoding: stringAttr(row.Values.GetEncodingString()),
+		},
+		ConditionExpression:      aws.String("attribute_not_exists(#version)"),
+		ExpressionAttributeNames: map[string]*string{"#version": aws.String(attrVersion)},
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("InsertConfig operation failed because of version collision")
+	}
+	return err
 }
 
 func (db *ddb) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
-	return nil, errors.New("TODO")
+	output, err := db.client.QueryWithContext(ctx, &dynamodb.QueryInput{
+		TableName:                aws.String(db.tableName(tableClusterConfig)),
+		KeyConditionExpression:   aws.String("#rowType = :rowType"),
+		ExpressionAttributeNames: map[string]*string{"#rowType": aws.String(attrRowType)},
+		ExpressionAttributeValues: attributeMap{
+			":rowType": intAttr(rowType),
+		},
+		ScanIndexForward: aws.Bool(false),
+		Limit:            aws.Int64(1),
+		ConsistentRead:   aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Items) == 0 {
+		return nil, errItemNotFound
+	}
+	item := output.Items[0]
+	return &persistence.InternalConfigStoreEntry{
+		RowType:   rowType,
+		Version:   getInt64Attr(item, attrVersion),
+		Timestamp: time.Unix(0, getInt64Attr(item, attrTimestamp)),
+		Values:    persistence.NewDataBlob(getBytesAttr(item, attrData), common.EncodingType(getStringAttr(item, attrDataEncoding))),
+	}, nil
 }
@@ -0,0 +1,134 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+// below are the names of all DynamoDB tables, without the keyspace prefix
+const (
+	tableExecutions    = "executions"
+	tableHistory       = "history"
+	tableDomains       = "domains"
+	tableQueue         = "queue"
+	tableQueueMetadata = "queue_metadata"
+	tableTasks         = "tasks"
+	tableVisibility    = "visibility"
+	tableClusterConfig = "cluster_config"
+)
+
+// below are the significant attributes used in key schemas, indexes and condition expressions.
+// All the other columns are stored in the opaque data attribute as a JSON blob.
+const (
+	attrShardID          = "shard_id"
+	attrRowKey           = "row_key"
+	attrTreeID           = "tree_id"
+	attrNodeID           = "node_id"
+	attrTxnID            = "txn_id"
+	attrDomainPartition  = "domain_partition"
+	attrQueueType        = "queue_type"
+	attrMessageID        = "message_id"
+	attrTaskListKey      = "task_list_key"
+	attrTaskID           = "task_id"
+	attrDomainID         = "domain_id"
+	attrDomainBucket     = "domain_bucket"
+	attrWorkflowID       = "workflow_id"
+	attrRunID            = "run_id"
+	attrRowType          = "row_type"
+	attrVersion          = "version"
+	attrRangeID          = "range_id"
+	attrNextEventID      = "next_event_id"
+	attrCurrentRunID     = "current_run_id"
+	attrLastWriteVersion = "last_write_version"
+	attrState            = "state"
+	attrNotificationVer  = "notification_version"
+	attrStartTime        = "start_time"
+	attrCloseTime        = "close_time"
+	attrWorkflowType     = "workflow_type"
+	attrCloseStatus      = "close_status"
+	attrExpiry           = "expiry"
+	attrData             = "data"
+	attrDataEncoding     = "data_encoding"
+	attrTimestamp        = "timestamp"
+	attrName             = "name"
+	attrEntryKey         = "entry_key"
+	attrStagedWriteID    = "staged_write_id"
+	attrStagedRowKey     = "staged_row_key"
+	attrStagedRowType    = "staged_row_type"
+	attrStagedDelete     = "staged_delete"
+)
+
+// below are the global secondary indexes of visibility table. They are partitioned by domain_bucket,
+// which spreads the records of a domain over visibilityBuckets partitions, see visibilityBucket
+const (
+	indexVisibilityStartTime = "start_time_index"
+	indexVisibilityCloseTime = "close_time_index"
+	visibilityBuckets        = 16
+)
+
+// below are the prefixes of row_key to differentiate the logical tables that are stored in the same DynamoDB table.
+// executions table keeps shard, current_workflow, workflow_execution with its state items and all the internal tasks
+// of a shard in the same partition, so that they can be written in a single transaction with the shard rangeID condition.
+const (
+	rowKeyShard             = "shard"
+	rowKeyCurrentWorkflow   = "current#"
+	rowKeyWorkflowExecution = "execution#"
+	rowKeyWorkflowState     = "state#"
+	rowKeyTransferTask      = "transfer#"
+	rowKeyReplicationTask   = "replication#"
+	rowKeyCrossClusterTask  = "cross_cluster#"
+	rowKeyTimerTask         = "timer#"
+	rowKeyReplicationDLQ    = "replication_dlq#"
+
+	rowKeyHistoryTree = "tree#"
+	rowKeyHistoryNode = "node#"
+
+	rowKeyDomainByName     = "name#"
+	rowKeyDomainByID       = "id#"
+	rowKeyDomainMetadata   = "metadata"
+	constDomainPartition   = 0
+	rowKeySeparator        = "#"
+	taskListRowTaskID      = int64(-12345)
+	emptyFailoverEndTime   = int64(0)
+	defaultPageSize        = 1000
+	batchWriteMaxItems     = 25
+	conditionCheckFailCode = "ConditionalCheckFailed"
+	// same as Cassandra, the runID of current_workflow returned to scanners
+	permanentRunID = "30000000-0000-f000-f000-000000000001"
+)
+
+// below are the kinds of the state items of a workflow execution, which keep the maps and buffered events of the execution
+const (
+	stateKindActivity        = "activity"
+	stateKindTimer           = "timer"
+	stateKindChild           = "child"
+	stateKindRequestCancel   = "request_cancel"
+	stateKindSignal          = "signal"
+	stateKindSignalRequested = "signal_requested"
+	stateKindBufferedEvents  = "buffered_events"
+	// stateKindStaged is a put or delete of another state item, which is staged by a workflow update
+	// whose state items don't fit into its transaction. See workflowStateWriter.stage
+	stateKindStaged = "staged"
+)
+
+// below are the limits of a single TransactWriteItems call. The size limit leaves a margin for the key
+// and condition expressions, which are not counted by transaction.size
+const (
+	transactWriteMaxItems = 100
+	transactWriteMaxBytes = 4*1024*1024 - 256*1024
+)
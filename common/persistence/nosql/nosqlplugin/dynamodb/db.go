@@ -21,36 +21,42 @@
 package dynamodb
 
 import (
+	"context"
 	"errors"
-	"fmt"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
-const (
-	// PluginName is the name of the plugin
-	PluginName = "dynamodb"
-)
-
 var (
 	errConditionFailed = errors.New("internal condition fail error")
+	errItemNotFound    = errors.New("item not found")
 )
 
 // ddb represents a logical connection to DynamoDB database
 type ddb struct {
+	client      dynamodbiface.DynamoDBAPI
+	cfg         *config.NoSQL
+	logger      log.Logger
+	tablePrefix string
 }
 
 var _ nosqlplugin.DB = (*ddb)(nil)
 
 // NewDynamoDB return a new DB
 func NewDynamoDB(cfg config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
-	return nil, fmt.Errorf("TODO")
+	return (&plugin{}).doCreateDB(&cfg, logger)
 }
 
 func (db *ddb) Close() {
-	panic("TODO")
+	// the client of aws-sdk-go is stateless over HTTP, nothing to close
 }
 
 func (db *ddb) PluginName() string {
@@ -58,17 +64,49 @@ func (db *ddb) PluginName() string {
 }
 
 func (db *ddb) IsNotFoundError(err error) bool {
-	panic("TODO")
+	if err == errItemNotFound {
+		return true
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == dynamodb.ErrCodeResourceNotFoundException
+	}
+	return false
 }
 
 func (db *ddb) IsTimeoutError(err error) bool {
-	panic("TODO")
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == request.ErrCodeResponseTimeout {
+			return true
+		}
+		err = awsErr.OrigErr()
+	}
+	if netErr, ok := err.(net.Error); ok {
+		return netErr.Timeout()
+	}
+	return false
 }
 
 func (db *ddb) IsThrottlingError(err error) bool {
-	panic("TODO")
+	if awsErr, ok := err.(awserr.Error); ok {
+		switch awsErr.Code() {
+		case dynamodb.ErrCodeProvisionedThroughputExceededException,
+			dynamodb.ErrCodeRequestLimitExceeded,
+			"ThrottlingException":
+			return true
+		}
+	}
+	return false
 }
 
 func (db *ddb) IsConditionFailedError(err error) bool {
-	return err == errConditionFailed
+	if err == errConditionFailed {
+		return true
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		return awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+	}
+	return false
 }
@@ -22,17 +22,140 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
+var _ nosqlplugin.DomainCRUD = (*ddb)(nil)
+
+func domainKey(rowKey string) attributeMap {
+	return attributeMap{
+		attrDomainPartition: intAttr(constDomainPartition),
+		attrRowKey:          stringAttr(rowKey),
+	}
+}
+
+func domainByNameKey(name string) attributeMap {
+	return domainKey(rowKeyDomainByName + name)
+}
+
+func domainByIDKey(domainID string) attributeMap {
+	return domainKey(rowKeyDomainByID + domainID)
+}
+
+func newDomainItem(row *nosqlplugin.DomainRow) (attributeMap, error) {
+	data, err := marshalData(row)
+	if err != nil {
+		return nil, err
+	}
+	item := domainByNameKey(row.Info.Name)
+	item[attrDomainID] = stringAttr(row.Info.ID)
+	item[attrNotificationVer] = int64Attr(row.NotificationVersion)
+	item[attrData] = data
+	return item, nil
+}
+
+func toDomainRow(item attributeMap) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := unmarshalData(item, row); err != nil {
+		return nil, err
+	}
+	row.NotificationVersion = getInt64Attr(item, attrNotificationVer)
+	return row, nil
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	idItem := domainByIDKey(row.Info.ID)
+	idItem[attrName] = stringAttr(row.Info.Name)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableDomains)),
+		Item:                     idItem,
+		ConditionExpression:      aws.String("attribute_not_exists(#rowKey)"),
+		ExpressionAttributeNames: map[string]*string{"#rowKey": aws.String(attrRowKey)},
+	})
+	if err != nil {
+		if db.IsConditionFailedError(err) {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+		return err
+	}
+
+	metadataNotificationVersion, err := db.SelectDomainMetadata(ctx)
+	if err != nil {
+		return err
+	}
+
+	newRow := *row
+	newRow.NotificationVersion = metadataNotificationVersion
+	item, err := newDomainItem(&newRow)
+	if err != nil {
+		return err
+	}
+
+	t := &transaction{}
+	t.add(transactItemDomain, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:                aws.String(db.tableName(tableDomains)),
+			Item:                     item,
+			ConditionExpression:      aws.String("attribute_not_exists(#rowKey)"),
+			ExpressionAttributeNames: map[string]*string{"#rowKey": aws.String(attrRowKey)},
+		},
+	})
+	db.addUpdateDomainMetadata(t, metadataNotificationVersion)
+
+	failures, err := db.executeTransaction(ctx, t)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		// Domain already exist.  Delete orphan domain record before returning back to user
+		if errDelete := db.deleteItem(ctx, tableDomains, domainByIDKey(row.Info.ID)); errDelete != nil {
+			db.logger.Warn("Unable to delete orphan domain record. Error", tag.Error(errDelete))
+		}
+
+		for _, f := range failures {
+			if f.itemType == transactItemDomain {
+				db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+				return &types.DomainAlreadyExistsError{
+					Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+				}
+			}
+		}
+
+		db.logger.Warn("Create domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
+}
+
+// addUpdateDomainMetadata bumps the notification version of domain metadata, on the condition of current version
+func (db *ddb) addUpdateDomainMetadata(t *transaction, notificationVersion int64) {
+	item := domainKey(rowKeyDomainMetadata)
+	item[attrNotificationVer] = int64Attr(notificationVersion + 1)
+	put := &dynamodb.Put{
+		TableName: aws.String(db.tableName(tableDomains)),
+		Item:      item,
+	}
+	if notificationVersion > 0 {
+		put.ConditionExpression = aws.String("#notificationVersion = :notificationVersion")
+		put.ExpressionAttributeNames = map[string]*string{"#notificationVersion": aws.String(attrNotificationVer)}
+		put.ExpressionAttributeValues = attributeMap{":notificationVersion": int64Attr(notificationVersion)}
+	} else {
+		put.ConditionExpression = aws.String("attribute_not_exists(#notificationVersion)")
+		put.ExpressionAttributeNames = map[string]*string{"#notificationVersion": aws.String(attrNotificationVer)}
+	}
+	t.add(transactItemDomainMetadata, &dynamodb.TransactWriteItem{Put: put})
 }
 
 // Update domain
@@ -40,7 +163,28 @@ func (db *ddb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	item, err := newDomainItem(row)
+	if err != nil {
+		return err
+	}
+
+	t := &transaction{}
+	t.add(transactItemDomain, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableDomains)),
+			Item:      item,
+		},
+	})
+	db.addUpdateDomainMetadata(t, row.NotificationVersion)
+
+	failures, err := db.executeTransaction(ctx, t)
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return nil
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +193,28 @@ func (db *ddb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID == nil && domainName == nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	var name string
+	if domainID != nil {
+		item, err := db.getItem(ctx, tableDomains, domainByIDKey(*domainID))
+		if err != nil {
+			return nil, err
+		}
+		name = getStringAttr(item, attrName)
+	} else {
+		name = *domainName
+	}
+
+	item, err := db.getItem(ctx, tableDomains, domainByNameKey(name))
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRow(item)
 }
 
 // Get all domain data
@@ -58,20 +223,83 @@ func (db *ddb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	// only the domain by name records are returned, which excludes the id and metadata records
+	items, nextPageToken, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(db.tableName(tableDomains)),
+		KeyConditionExpression: aws.String("#partition = :partition AND begins_with(#rowKey, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#partition": aws.String(attrDomainPartition),
+			"#rowKey":    aws.String(attrRowKey),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":partition": intAttr(constDomainPartition),
+			":prefix":    stringAttr(rowKeyDomainByName),
+		},
+	}, pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(items))
+	for _, item := range items {
+		row, err := toDomainRow(item)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nextPageToken, nil
 }
 
-//  Delete a domain, either by domainID or domainName
+// Delete a domain, either by domainID or domainName
 func (db *ddb) DeleteDomain(
 	ctx context.Context,
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	if domainName == nil && domainID == nil {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+
+	var name, ID string
+	if domainName == nil {
+		item, err := db.getItem(ctx, tableDomains, domainByIDKey(*domainID))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		ID = *domainID
+		name = getStringAttr(item, attrName)
+	} else {
+		item, err := db.getItem(ctx, tableDomains, domainByNameKey(*domainName))
+		if err != nil {
+			if db.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		ID = getStringAttr(item, attrDomainID)
+		name = *domainName
+	}
+
+	if err := db.deleteItem(ctx, tableDomains, domainByNameKey(name)); err != nil {
+		return err
+	}
+	return db.deleteItem(ctx, tableDomains, domainByIDKey(ID))
 }
 
 func (db *ddb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableDomains, domainKey(rowKeyDomainMetadata))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata record doesn't exist until the first domain is created
+			return 0, nil
+		}
+		return -1, err
+	}
+	return getInt64Attr(item, attrNotificationVer), nil
 }
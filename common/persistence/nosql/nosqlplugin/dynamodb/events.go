@@ -22,31 +22,234 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+// upperBoundSuffix is greater than any character of the hex encoded keys, to be used as an inclusive upper bound
+const upperBoundSuffix = "~"
+
+var _ nosqlplugin.HistoryEventsCRUD = (*ddb)(nil)
+
+func historyTreeKey(treeID, branchID string) attributeMap {
+	return attributeMap{
+		attrTreeID: stringAttr(treeID),
+		attrRowKey: stringAttr(rowKeyHistoryTree + branchID),
+	}
+}
+
+func historyNodePrefix(branchID string) string {
+	return rowKeyHistoryNode + branchID + rowKeySeparator
+}
+
+// historyNodeKey is ordered by nodeID ASC and txnID DESC, by encoding the complement of txnID
+func historyNodeKey(treeID, branchID string, nodeID, txnID int64) attributeMap {
+	return attributeMap{
+		attrTreeID: stringAttr(treeID),
+		attrRowKey: stringAttr(historyNodePrefix(branchID) + encodeInt64Key(nodeID) + rowKeySeparator + encodeInt64Key(^txnID)),
+	}
+}
+
+func (db *ddb) newHistoryTreeItem(treeRow *nosqlplugin.HistoryTreeRow) (attributeMap, error) {
+	data, err := marshalData(treeRow)
+	if err != nil {
+		return nil, err
+	}
+	item := historyTreeKey(treeRow.TreeID, treeRow.BranchID)
+	item[attrData] = data
+	return item, nil
+}
+
+func (db *ddb) newHistoryNodeItem(nodeRow *nosqlplugin.HistoryNodeRow) attributeMap {
+	var txnID int64
+	if nodeRow.TxnID != nil {
+		txnID = *nodeRow.TxnID
+	}
+	item := historyNodeKey(nodeRow.TreeID, nodeRow.BranchID, nodeRow.NodeID, txnID)
+	item[attrNodeID] = int64Attr(nodeRow.NodeID)
+	item[attrTxnID] = int64Attr(txnID)
+	item[attrData] = bytesAttr(nodeRow.Data)
+	item[attrDataEncoding] = stringAttr(nodeRow.DataEncoding)
+	return item
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *ddb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+
+	t := &transaction{}
+	if treeRow != nil {
+		item, err := db.newHistoryTreeItem(treeRow)
+		if err != nil {
+			return err
+		}
+		t.add(transactItemHistory, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableHistory)),
+				Item:      item,
+			},
+		})
+	}
+	if nodeRow != nil {
+		t.add(transactItemHistory, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(db.tableName(tableHistory)),
+				Item:      db.newHistoryNodeItem(nodeRow),
+			},
+		})
+	}
+
+	if len(t.items) == 1 {
+		// for perf, a single item doesn't need a transaction
+		_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+			TableName: t.items[0].Put.TableName,
+			Item:      t.items[0].Put.Item,
+		})
+		return err
+	}
+	_, err := db.executeTransaction(ctx, t)
+	return err
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *ddb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	if filter.MinNodeID >= filter.MaxNodeID {
+		return nil, nil, nil
+	}
+	prefix := historyNodePrefix(filter.BranchID)
+	items, nextPageToken, err := db.queryPage(ctx, historyNodeQuery(
+		db.tableName(tableHistory),
+		filter.TreeID,
+		prefix+encodeInt64Key(filter.MinNodeID),
+		// it's less than any key of MaxNodeID, as the txnID follows
+		prefix+encodeInt64Key(filter.MaxNodeID),
+	), filter.PageSize, filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(items))
+	for _, item := range items {
+		txnID := getInt64Attr(item, attrTxnID)
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       getInt64Attr(item, attrNodeID),
+			TxnID:        &txnID,
+			Data:         getBytesAttr(item, attrData),
+			DataEncoding: getStringAttr(item, attrDataEncoding),
+		})
+	}
+	return rows, nextPageToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *ddb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	// nodes are deleted before the branch record, so that the nodes can still be found and deleted on retry
+	for _, nodeFilter := range nodeFilters {
+		prefix := historyNodePrefix(nodeFilter.BranchID)
+		_, err := db.rangeDelete(ctx, historyNodeQuery(
+			db.tableName(tableHistory),
+			nodeFilter.TreeID,
+			prefix+encodeInt64Key(nodeFilter.MinNodeID),
+			prefix+upperBoundSuffix,
+		), []string{attrTreeID, attrRowKey})
+		if err != nil {
+			return err
+		}
+	}
+	if treeFilter.BranchID == nil {
+		return fmt.Errorf("require a branchID to delete a branch record")
+	}
+	return db.deleteItem(ctx, tableHistory, historyTreeKey(treeFilter.TreeID, *treeFilter.BranchID))
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *ddb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	items, token, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:                 aws.String(db.tableName(tableHistory)),
+		FilterExpression:          aws.String("begins_with(#rowKey, :prefix)"),
+		ExpressionAttributeNames:  map[string]*string{"#rowKey": aws.String(attrRowKey)},
+		ExpressionAttributeValues: attributeMap{":prefix": stringAttr(rowKeyHistoryTree)},
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.HistoryTreeRow{}
+		if err := unmarshalData(item, row); err != nil {
+			return nil, nil, err
+		}
+		// same as Cassandra, ancestors are not returned when scanning all the branches
+		row.Ancestors = nil
+		rows = append(rows, row)
+	}
+	return rows, token, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *ddb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	items, _, err := db.queryPage(ctx, &dynamodb.QueryInput{
+		TableName:              aws.String(db.tableName(tableHistory)),
+		KeyConditionExpression: aws.String("#treeID = :treeID AND begins_with(#rowKey, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#treeID": aws.String(attrTreeID),
+			"#rowKey": aws.String(attrRowKey),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":treeID": stringAttr(filter.TreeID),
+			":prefix": stringAttr(rowKeyHistoryTree),
+		},
+	}, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.HistoryTreeRow{}
+		if err := unmarshalData(item, row); err != nil {
+			return nil, err
+		}
+		ancestors := row.Ancestors
+		if len(ancestors) > 0 {
+			// sort ancestors based on EndNodeID so that we can set BeginNodeID
+			sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+			ancestors[0].BeginNodeID = int64(1)
+			for i := 1; i < len(ancestors); i++ {
+				ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+			}
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:    filter.TreeID,
+			BranchID:  row.BranchID,
+			Ancestors: ancestors,
+		})
+	}
+	return rows, nil
+}
+
+func historyNodeQuery(tableName string, treeID string, lower string, upper string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(tableName),
+		KeyConditionExpression: aws.String("#treeID = :treeID AND #rowKey BETWEEN :lower AND :upper"),
+		ExpressionAttributeNames: map[string]*string{
+			"#treeID": aws.String(attrTreeID),
+			"#rowKey": aws.String(attrRowKey),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":treeID": stringAttr(treeID),
+			":lower":  stringAttr(lower),
+			":upper":  stringAttr(upper),
+		},
+	}
 }
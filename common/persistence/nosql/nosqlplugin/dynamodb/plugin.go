@@ -0,0 +1,108 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence/nosql"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "dynamodb"
+
+	defaultRegion = "us-east-1"
+)
+
+type plugin struct{}
+
+var _ nosqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	nosql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.NoSQL, logger log.Logger) (nosqlplugin.DB, error) {
+	return p.doCreateDB(cfg, logger)
+}
+
+// CreateAdminDB initialize the AdminDB object
+func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger) (nosqlplugin.AdminDB, error) {
+	return p.doCreateDB(cfg, logger)
+}
+
+// doCreateDB creates the DynamoDB client:
+// Hosts and Port are used as a custom endpoint(e.g. DynamoDB Local). Leave Hosts empty to use the AWS endpoint of the Region.
+// User and Password are used as static access key ID and secret access key. Leave them empty to use the default AWS credential chain.
+// Keyspace is used as the prefix of all table names so that multiple clusters can share the same AWS account and region.
+func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*ddb, error) {
+	if cfg.Keyspace == "" {
+		return nil, fmt.Errorf("keyspace(table name prefix) cannot be empty")
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	awsConfig := aws.NewConfig().WithRegion(region)
+	if cfg.Hosts != "" {
+		awsConfig = awsConfig.WithEndpoint(toEndpoint(cfg))
+	}
+	if cfg.User != "" {
+		awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(cfg.User, cfg.Password, ""))
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &ddb{
+		client:      dynamodb.New(sess),
+		cfg:         cfg,
+		logger:      logger,
+		tablePrefix: cfg.Keyspace + "_",
+	}, nil
+}
+
+func toEndpoint(cfg *config.NoSQL) string {
+	host := strings.Split(cfg.Hosts, ",")[0]
+	if strings.Contains(host, "://") {
+		return host
+	}
+	scheme := "http"
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		scheme = "https"
+	}
+	if cfg.Port > 0 {
+		return fmt.Sprintf("%v://%v:%v", scheme, host, cfg.Port)
+	}
+	return fmt.Sprintf("%v://%v", scheme, host)
+}
@@ -22,18 +22,68 @@ package dynamodb
 
 import (
 	"context"
+	"math"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
-//Insert message into queue, return error if failed or already exists
+var _ nosqlplugin.MessageQueueCRUD = (*ddb)(nil)
+
+func queueMessageKey(queueType persistence.QueueType, messageID int64) attributeMap {
+	return attributeMap{
+		attrQueueType: intAttr(int(queueType)),
+		attrMessageID: int64Attr(messageID),
+	}
+}
+
+// messagesQuery returns the query of messages of the queue that exclusiveBeginMessageID < message_id <= inclusiveEndMessageID.
+// Callers must make sure the range is not empty, as DynamoDB rejects a BETWEEN condition with lower bound greater than upper bound
+func messagesQuery(tableName string, queueType persistence.QueueType, exclusiveBeginMessageID, inclusiveEndMessageID int64) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(tableName),
+		KeyConditionExpression: aws.String("#queueType = :queueType AND #messageID BETWEEN :begin AND :end"),
+		ExpressionAttributeNames: map[string]*string{
+			"#queueType": aws.String(attrQueueType),
+			"#messageID": aws.String(attrMessageID),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":queueType": intAttr(int(queueType)),
+			":begin":     int64Attr(exclusiveBeginMessageID + 1),
+			":end":       int64Attr(inclusiveEndMessageID),
+		},
+	}
+}
+
+func toQueueMessageRow(queueType persistence.QueueType, item attributeMap) nosqlplugin.QueueMessageRow {
+	return nosqlplugin.QueueMessageRow{
+		QueueType: queueType,
+		ID:        getInt64Attr(item, attrMessageID),
+		Payload:   getBytesAttr(item, attrData),
+	}
+}
+
+// Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *ddb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	item := queueMessageKey(row.QueueType, row.ID)
+	item[attrData] = bytesAttr(row.Payload)
+	_, err := db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableQueue)),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#messageID)"),
+		ExpressionAttributeNames: map[string]*string{"#messageID": aws.String(attrMessageID)},
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -41,7 +91,16 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	input := messagesQuery(db.tableName(tableQueue), queueType, math.MinInt64, math.MaxInt64)
+	input.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, input, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, errItemNotFound
+	}
+	return getInt64Attr(items[0], attrMessageID), nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -51,7 +110,17 @@ func (db *ddb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	input := messagesQuery(db.tableName(tableQueue), queueType, exclusiveBeginMessageID, math.MaxInt64)
+	items, _, err := db.queryPage(ctx, input, maxRows, nil)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, item := range items {
+		row := toQueueMessageRow(queueType, item)
+		result = append(result, &row)
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -59,7 +128,22 @@ func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	if request.ExclusiveBeginMessageID >= request.InclusiveEndMessageID {
+		return &nosqlplugin.SelectMessagesBetweenResponse{}, nil
+	}
+	input := messagesQuery(db.tableName(tableQueue), request.QueueType, request.ExclusiveBeginMessageID, request.InclusiveEndMessageID)
+	items, nextPageToken, err := db.queryPage(ctx, input, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]nosqlplugin.QueueMessageRow, 0, len(items))
+	for _, item := range items {
+		rows = append(rows, toQueueMessageRow(request.QueueType, item))
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -68,7 +152,12 @@ func (db *ddb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID == math.MinInt64 {
+		return nil
+	}
+	input := messagesQuery(db.tableName(tableQueue), queueType, math.MinInt64, exclusiveBeginMessageID-1)
+	_, err := db.rangeDelete(ctx, input, []string{attrQueueType, attrMessageID})
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -78,7 +167,12 @@ func (db *ddb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	if exclusiveBeginMessageID >= inclusiveEndMessageID {
+		return nil
+	}
+	input := messagesQuery(db.tableName(tableQueue), queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
+	_, err := db.rangeDelete(ctx, input, []string{attrQueueType, attrMessageID})
+	return err
 }
 
 // Delete one message
@@ -87,7 +181,7 @@ func (db *ddb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableQueue, queueMessageKey(queueType, messageID))
 }
 
 // Insert an empty metadata row, starting from a version
@@ -96,7 +190,25 @@ func (db *ddb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	panic("TODO")
+	data, err := marshalData(map[string]int64{})
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableQueueMetadata)),
+		Item: attributeMap{
+			attrQueueType: intAttr(int(queueType)),
+			attrVersion:   int64Attr(version),
+			attrData:      data,
+		},
+		ConditionExpression:      aws.String("attribute_not_exists(#queueType)"),
+		ExpressionAttributeNames: map[string]*string{"#queueType": aws.String(attrQueueType)},
+	})
+	if db.IsConditionFailedError(err) {
+		// it's ok if the item is not written, which means that the record exists already.
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -106,7 +218,25 @@ func (db *ddb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	data, err := marshalData(row.ClusterAckLevels)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableQueueMetadata)),
+		Item: attributeMap{
+			attrQueueType: intAttr(int(row.QueueType)),
+			attrVersion:   int64Attr(row.Version),
+			attrData:      data,
+		},
+		ConditionExpression:       aws.String("#version = :version"),
+		ExpressionAttributeNames:  map[string]*string{"#version": aws.String(attrVersion)},
+		ExpressionAttributeValues: attributeMap{":version": int64Attr(row.Version - 1)},
+	})
+	if db.IsConditionFailedError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Read a QueueMetadata
@@ -114,12 +244,41 @@ func (db *ddb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableQueueMetadata, attributeMap{attrQueueType: intAttr(int(queueType))})
+	if err != nil {
+		return nil, err
+	}
+	var ackLevels map[string]int64
+	if err := unmarshalData(item, &ackLevels); err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          getInt64Attr(item, attrVersion),
+	}, nil
 }
 
 func (db *ddb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	input := messagesQuery(db.tableName(tableQueue), queueType, math.MinInt64, math.MaxInt64)
+	input.Select = aws.String(dynamodb.SelectCount)
+	input.ConsistentRead = aws.Bool(true)
+
+	var count int64
+	err := db.client.QueryPagesWithContext(ctx, input, func(output *dynamodb.QueryOutput, lastPage bool) bool {
+		count += aws.Int64Value(output.Count)
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
 }
@@ -22,6 +22,10 @@ package dynamodb
 
 import (
 	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -29,22 +33,121 @@ import (
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableExecutions)),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#rowKey)"),
+		ExpressionAttributeNames: map[string]*string{"#rowKey": aws.String(attrRowKey)},
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *ddb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, shardKey(shardID))
+	if err != nil {
+		return 0, nil, err
+	}
+	info := &nosqlplugin.ShardRow{}
+	if err := unmarshalData(item, info); err != nil {
+		return 0, nil, err
+	}
+
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return getInt64Attr(item, attrRangeID), info, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	_, err := db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                aws.String(db.tableName(tableExecutions)),
+		Key:                      shardKey(shardID),
+		UpdateExpression:         aws.String("SET #rangeID = :rangeID"),
+		ConditionExpression:      aws.String("#rangeID = :previousRangeID"),
+		ExpressionAttributeNames: map[string]*string{"#rangeID": aws.String(attrRangeID)},
+		ExpressionAttributeValues: attributeMap{
+			":rangeID":         int64Attr(rangeID),
+			":previousRangeID": int64Attr(previousRangeID),
+		},
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, shardID)
+	}
+	return err
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *ddb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	item, err := newShardItem(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableExecutions)),
+		Item:                     item,
+		ConditionExpression:      aws.String("#rangeID = :previousRangeID"),
+		ExpressionAttributeNames: map[string]*string{"#rangeID": aws.String(attrRangeID)},
+		ExpressionAttributeValues: attributeMap{
+			":previousRangeID": int64Attr(previousRangeID),
+		},
+	})
+	if db.IsConditionFailedError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return err
+}
+
+func shardKey(shardID int) attributeMap {
+	return attributeMap{
+		attrShardID: intAttr(shardID),
+		attrRowKey:  stringAttr(rowKeyShard),
+	}
+}
+
+func newShardItem(row *nosqlplugin.ShardRow) (attributeMap, error) {
+	data, err := marshalData(row)
+	if err != nil {
+		return nil, err
+	}
+	item := shardKey(row.ShardID)
+	item[attrRangeID] = int64Attr(row.RangeID)
+	item[attrData] = data
+	return item, nil
+}
+
+// newConflictedShardError reads the current shard row after a conditional write failed,
+// because DynamoDB doesn't return the previous item on condition failure of a single item write
+func (db *ddb) newConflictedShardError(ctx context.Context, shardID int) error {
+	item, err := db.getItem(ctx, tableExecutions, shardKey(shardID))
+	if err != nil {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: getInt64Attr(item, attrRangeID),
+		Details: describeItem(item),
+	}
 }
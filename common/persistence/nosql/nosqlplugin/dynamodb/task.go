@@ -22,20 +22,83 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
+	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
+)
+
+var _ nosqlplugin.TaskCRUD = (*ddb)(nil)
+
+func taskListKey(domainID, taskListName string, taskListType int) string {
+	return fmt.Sprintf("%v%v%v%v%v", domainID, rowKeySeparator, taskListName, rowKeySeparator, taskListType)
+}
+
+func taskListRowKey(filter *nosqlplugin.TaskListFilter) attributeMap {
+	return attributeMap{
+		attrTaskListKey: stringAttr(taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType)),
+		attrTaskID:      int64Attr(taskListRowTaskID),
+	}
+}
+
+func newTaskListItem(row *nosqlplugin.TaskListRow, ttlSeconds int64) (attributeMap, error) {
+	data, err := marshalData(row)
+	if err != nil {
+		return nil, err
+	}
+	item := taskListRowKey(&nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	})
+	item[attrRangeID] = int64Attr(row.RangeID)
+	item[attrData] = data
+	if ttlSeconds > 0 {
+		item[attrExpiry] = int64Attr(time.Now().Unix() + ttlSeconds)
+	}
+	return item, nil
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *ddb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableTasks, taskListRowKey(filter))
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.TaskListRow{}
+	if err := unmarshalData(item, row); err != nil {
+		return nil, err
+	}
+	row.RangeID = getInt64Attr(item, attrRangeID)
+	return row, nil
 }
 
 // InsertTaskList insert a single tasklist row
 // Return IsConditionFailedError if the row already exists, and also the existing row
 func (db *ddb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	newRow := *row
+	newRow.RangeID = initialRangeID
+	newRow.AckLevel = 0
+	item, err := newTaskListItem(&newRow, 0)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                aws.String(db.tableName(tableTasks)),
+		Item:                     item,
+		ConditionExpression:      aws.String("attribute_not_exists(#taskID)"),
+		ExpressionAttributeNames: map[string]*string{"#taskID": aws.String(attrTaskID)},
+	})
+	return db.handleTaskListWriteError(ctx, err, &newRow)
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +108,7 @@ func (db *ddb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.UpdateTaskListWithTTL(ctx, 0, row, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +121,63 @@ func (db *ddb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	item, err := newTaskListItem(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName:                 aws.String(db.tableName(tableTasks)),
+		Item:                      item,
+		ConditionExpression:       aws.String("#rangeID = :rangeID"),
+		ExpressionAttributeNames:  map[string]*string{"#rangeID": aws.String(attrRangeID)},
+		ExpressionAttributeValues: attributeMap{":rangeID": int64Attr(previousRangeID)},
+	})
+	return db.handleTaskListWriteError(ctx, err, row)
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *ddb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	// DynamoDB may take up to a few days to delete the expired items, so the tasklists are listed for TaskListScavenger
+	items, token, err := db.scanPage(ctx, &dynamodb.ScanInput{
+		TableName:                 aws.String(db.tableName(tableTasks)),
+		FilterExpression:          aws.String("#taskID = :taskID"),
+		ExpressionAttributeNames:  map[string]*string{"#taskID": aws.String(attrTaskID)},
+		ExpressionAttributeValues: attributeMap{":taskID": int64Attr(taskListRowTaskID)},
+	}, pageSize, nextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	result := &nosqlplugin.ListTaskListResult{
+		TaskLists:     make([]*nosqlplugin.TaskListRow, 0, len(items)),
+		NextPageToken: token,
+	}
+	for _, item := range items {
+		row := &nosqlplugin.TaskListRow{}
+		if err := unmarshalData(item, row); err != nil {
+			return nil, err
+		}
+		row.RangeID = getInt64Attr(item, attrRangeID)
+		result.TaskLists = append(result.TaskLists, row)
+	}
+	return result, nil
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *ddb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 aws.String(db.tableName(tableTasks)),
+		Key:                       taskListRowKey(filter),
+		ConditionExpression:       aws.String("#rangeID = :rangeID"),
+		ExpressionAttributeNames:  map[string]*string{"#rangeID": aws.String(attrRangeID)},
+		ExpressionAttributeValues: attributeMap{":rangeID": int64Attr(previousRangeID)},
+	})
+	return db.handleTaskListWriteError(ctx, err, &nosqlplugin.TaskListRow{
+		DomainID:     filter.DomainID,
+		TaskListName: filter.TaskListName,
+		TaskListType: filter.TaskListType,
+	})
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,18 +187,138 @@ func (db *ddb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	key := taskListKey(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType)
+	tableName := aws.String(db.tableName(tableTasks))
+
+	fence := func(t *transaction) {
+		t.add(transactItemTaskList, &dynamodb.TransactWriteItem{
+			ConditionCheck: &dynamodb.ConditionCheck{
+				TableName: tableName,
+				Key: taskListRowKey(&nosqlplugin.TaskListFilter{
+					DomainID:     tasklistCondition.DomainID,
+					TaskListName: tasklistCondition.TaskListName,
+					TaskListType: tasklistCondition.TaskListType,
+				}),
+				ConditionExpression:                 aws.String("#rangeID = :rangeID"),
+				ExpressionAttributeNames:            map[string]*string{"#rangeID": aws.String(attrRangeID)},
+				ExpressionAttributeValues:           attributeMap{":rangeID": int64Attr(tasklistCondition.RangeID)},
+				ReturnValuesOnConditionCheckFailure: aws.String(returnAllOldOnFailure),
+			},
+		})
+	}
+	t := &transaction{}
+	fence(t)
+
+	// a batch of tasks doesn't have to be inserted atomically, matching tolerates the tasks of a failed batch
+	tasks := &transaction{}
+	now := time.Now().Unix()
+	for _, task := range tasksToInsert {
+		data, err := marshalData(&task.TaskRow)
+		if err != nil {
+			return err
+		}
+		item := attributeMap{
+			attrTaskListKey: stringAttr(key),
+			attrTaskID:      int64Attr(task.TaskID),
+			attrData:        data,
+		}
+		if task.TTLSeconds > 0 {
+			item[attrExpiry] = int64Attr(now + int64(task.TTLSeconds))
+		}
+		tasks.add(transactItemTask, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: tableName,
+				Item:      item,
+			},
+		})
+	}
+
+	failures, err := db.executeTransactionWithOverflow(ctx, t, tasks, fence)
+	if err != nil {
+		return err
+	}
+	for _, f := range failures {
+		if f.itemType == transactItemTaskList {
+			return &nosqlplugin.TaskOperationConditionFailure{
+				RangeID: getInt64Attr(f.previous, attrRangeID),
+				Details: describeItem(f.previous),
+			}
+		}
+	}
+	return nil
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *ddb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return nil, nil
+	}
+	items, _, err := db.queryPage(ctx, tasksRangeQuery(db.tableName(tableTasks), filter), filter.BatchSize, nil)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*nosqlplugin.TaskRow, 0, len(items))
+	for _, item := range items {
+		task := &nosqlplugin.TaskRow{}
+		if err := unmarshalData(item, task); err != nil {
+			return nil, err
+		}
+		task.TaskID = getInt64Attr(item, attrTaskID)
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
 // If TTL is not implemented, then should also return the number of rows deleted, otherwise persistence.UnknownNumRowsAffected
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
-// be returned to the caller, because rowsDeleted is not supported by Cassandra
+// be returned to the caller, because DynamoDB doesn't support range deletion
 func (db *ddb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	if filter.MinTaskID >= filter.MaxTaskID {
+		return p.UnknownNumRowsAffected, nil
+	}
+	_, err = db.rangeDelete(ctx, tasksRangeQuery(db.tableName(tableTasks), filter), []string{attrTaskListKey, attrTaskID})
+	return p.UnknownNumRowsAffected, err
+}
+
+func tasksRangeQuery(tableName string, filter *nosqlplugin.TasksFilter) *dynamodb.QueryInput {
+	// the tasklist row shares the partition with tasks, and it must not be returned
+	minTaskID := filter.MinTaskID
+	if minTaskID < taskListRowTaskID {
+		minTaskID = taskListRowTaskID
+	}
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(tableName),
+		KeyConditionExpression: aws.String("#taskListKey = :taskListKey AND #taskID BETWEEN :minTaskID AND :maxTaskID"),
+		ExpressionAttributeNames: map[string]*string{
+			"#taskListKey": aws.String(attrTaskListKey),
+			"#taskID":      aws.String(attrTaskID),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":taskListKey": stringAttr(taskListKey(filter.DomainID, filter.TaskListName, filter.TaskListType)),
+			":minTaskID":   int64Attr(minTaskID + 1),
+			":maxTaskID":   int64Attr(filter.MaxTaskID),
+		},
+	}
+}
+
+// handleTaskListWriteError converts the condition failure of a tasklist write into TaskOperationConditionFailure.
+// Different from the transaction API, the single item write APIs don't return the existing item on condition failure,
+// so it's read again here for logging and returning the current rangeID.
+func (db *ddb) handleTaskListWriteError(ctx context.Context, err error, row *nosqlplugin.TaskListRow) error {
+	if err == nil || !db.IsConditionFailedError(err) {
+		return err
+	}
+	item, readErr := db.getItem(ctx, tableTasks, taskListRowKey(&nosqlplugin.TaskListFilter{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+	}))
+	if readErr != nil && !db.IsNotFoundError(readErr) {
+		return readErr
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: getInt64Attr(item, attrRangeID),
+		Details: describeItem(item),
+	}
 }
@@ -0,0 +1,461 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+)
+
+type (
+	// attributeMap is the item representation of DynamoDB
+	attributeMap = map[string]*dynamodb.AttributeValue
+
+	// transactItemType tells what a item of a transaction is for, so that a condition failure can be interpreted
+	transactItemType int
+
+	// transaction is a list of items to be written atomically by TransactWriteItems
+	transaction struct {
+		items     []*dynamodb.TransactWriteItem
+		itemTypes []transactItemType
+		itemSizes []int
+		// size is the estimated total size of the items
+		size int
+	}
+
+	// transactConditionFailure is an item of a transaction that failed on its condition expression
+	transactConditionFailure struct {
+		itemType transactItemType
+		// the previous item, only returned if ReturnValuesOnConditionCheckFailure is ALL_OLD and the item exists
+		previous attributeMap
+	}
+)
+
+const (
+	transactItemShard transactItemType = iota
+	transactItemCurrentWorkflow
+	transactItemWorkflowExecution
+	transactItemInsertedWorkflowExecution
+	transactItemWorkflowState
+	transactItemTask
+	transactItemTaskList
+	transactItemDomain
+	transactItemDomainMetadata
+	transactItemHistory
+)
+
+func (db *ddb) tableName(name string) string {
+	return db.tablePrefix + name
+}
+
+// encodeInt64Key encodes an int64 into a fixed-width string which preserves the order of the numbers
+// when being compared lexicographically, so that it can be used as part of a string sort key
+func encodeInt64Key(v int64) string {
+	return fmt.Sprintf("%016x", uint64(v)^(uint64(1)<<63))
+}
+
+func stringAttr(v string) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{S: aws.String(v)}
+}
+
+func int64Attr(v int64) *dynamodb.AttributeValue {
+	return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v, 10))}
+}
+
+func intAttr(v int) *dynamodb.AttributeValue {
+	return int64Attr(int64(v))
+}
+
+func bytesAttr(v []byte) *dynamodb.AttributeValue {
+	if len(v) == 0 {
+		// DynamoDB doesn't allow empty binary attribute in key, and it's safer to avoid it in general
+		return &dynamodb.AttributeValue{NULL: aws.Bool(true)}
+	}
+	return &dynamodb.AttributeValue{B: v}
+}
+
+func getStringAttr(item attributeMap, name string) string {
+	if v, ok := item[name]; ok && v.S != nil {
+		return *v.S
+	}
+	return ""
+}
+
+func getInt64Attr(item attributeMap, name string) int64 {
+	if v, ok := item[name]; ok && v.N != nil {
+		n, err := strconv.ParseInt(*v.N, 10, 64)
+		if err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+func getBytesAttr(item attributeMap, name string) []byte {
+	if v, ok := item[name]; ok {
+		return v.B
+	}
+	return nil
+}
+
+func hasAttr(item attributeMap, name string) bool {
+	v, ok := item[name]
+	return ok && (v.NULL == nil || !*v.NULL)
+}
+
+// marshalData serializes the non-significant columns of a row into the data attribute
+func marshalData(v interface{}) (*dynamodb.AttributeValue, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return bytesAttr(data), nil
+}
+
+// unmarshalData deserializes the data attribute of an item
+func unmarshalData(item attributeMap, v interface{}) error {
+	data := getBytesAttr(item, attrData)
+	if len(data) == 0 {
+		return fmt.Errorf("item is missing %v attribute", attrData)
+	}
+	return json.Unmarshal(data, v)
+}
+
+func serializePageToken(lastEvaluatedKey attributeMap) ([]byte, error) {
+	if len(lastEvaluatedKey) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(lastEvaluatedKey)
+}
+
+func deserializePageToken(pageToken []byte) (attributeMap, error) {
+	if len(pageToken) == 0 {
+		return nil, nil
+	}
+	var key attributeMap
+	if err := json.Unmarshal(pageToken, &key); err != nil {
+		return nil, fmt.Errorf("invalid page token: %v", err)
+	}
+	return key, nil
+}
+
+// getItem reads a single item with strong consistency. Returns errItemNotFound if the item doesn't exist
+func (db *ddb) getItem(ctx context.Context, table string, key attributeMap) (attributeMap, error) {
+	output, err := db.client.GetItemWithContext(ctx, &dynamodb.GetItemInput{
+		TableName:      aws.String(db.tableName(table)),
+		Key:            key,
+		ConsistentRead: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Item) == 0 {
+		return nil, errItemNotFound
+	}
+	return output.Item, nil
+}
+
+// queryPage runs the query until pageSize items are returned or there are no more items.
+// Because Limit in DynamoDB is applied before FilterExpression, a single request may return fewer items than requested.
+// pageSize <= 0 means reading all the items.
+func (db *ddb) queryPage(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+	pageSize int,
+	pageToken []byte,
+) ([]attributeMap, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	// global secondary indexes don't support strongly consistent reads
+	input.ConsistentRead = aws.Bool(input.IndexName == nil)
+	input.ExclusiveStartKey = startKey
+
+	var items []attributeMap
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.QueryWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+		if len(output.LastEvaluatedKey) == 0 || (pageSize > 0 && len(items) >= pageSize) {
+			break
+		}
+	}
+	nextPageToken, err := serializePageToken(input.ExclusiveStartKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, nextPageToken, nil
+}
+
+// scanPage is the same as queryPage, but runs a Scan instead. It's only used for listing all records of a table
+func (db *ddb) scanPage(
+	ctx context.Context,
+	input *dynamodb.ScanInput,
+	pageSize int,
+	pageToken []byte,
+) ([]attributeMap, []byte, error) {
+	startKey, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	input.ConsistentRead = aws.Bool(true)
+	input.ExclusiveStartKey = startKey
+
+	var items []attributeMap
+	for {
+		if pageSize > 0 {
+			input.Limit = aws.Int64(int64(pageSize - len(items)))
+		}
+		output, err := db.client.ScanWithContext(ctx, input)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, output.Items...)
+		input.ExclusiveStartKey = output.LastEvaluatedKey
+		if len(output.LastEvaluatedKey) == 0 || (pageSize > 0 && len(items) >= pageSize) {
+			break
+		}
+	}
+	nextPageToken, err := serializePageToken(input.ExclusiveStartKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, nextPageToken, nil
+}
+
+// rangeDelete deletes all the items returned by the query. DynamoDB doesn't support range deletion,
+// so the keys are read first and then deleted in batches.
+func (db *ddb) rangeDelete(
+	ctx context.Context,
+	input *dynamodb.QueryInput,
+	keyAttributes []string,
+) (int, error) {
+	input.ProjectionExpression = aws.String(projectionOf(input, keyAttributes))
+	var pageToken []byte
+	deleted := 0
+	for {
+		items, nextPageToken, err := db.queryPage(ctx, input, defaultPageSize, pageToken)
+		if err != nil {
+			return deleted, err
+		}
+		keys := make([]attributeMap, 0, len(items))
+		for _, item := range items {
+			key := make(attributeMap, len(keyAttributes))
+			for _, attr := range keyAttributes {
+				key[attr] = item[attr]
+			}
+			keys = append(keys, key)
+		}
+		if err := db.batchDelete(ctx, *input.TableName, keys); err != nil {
+			return deleted, err
+		}
+		deleted += len(keys)
+		if len(nextPageToken) == 0 {
+			return deleted, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+// projectionOf returns a projection expression of the key attributes, using the placeholder names of the query
+func projectionOf(input *dynamodb.QueryInput, keyAttributes []string) string {
+	if input.ExpressionAttributeNames == nil {
+		input.ExpressionAttributeNames = make(map[string]*string)
+	}
+	projection := ""
+	for i, attr := range keyAttributes {
+		placeholder := fmt.Sprintf("#key%v", i)
+		input.ExpressionAttributeNames[placeholder] = aws.String(attr)
+		if i > 0 {
+			projection += ", "
+		}
+		projection += placeholder
+	}
+	return projection
+}
+
+// batchDelete deletes the items by keys, the table name must be the full name including the prefix
+func (db *ddb) batchDelete(ctx context.Context, fullTableName string, keys []attributeMap) error {
+	for start := 0; start < len(keys); start += batchWriteMaxItems {
+		end := start + batchWriteMaxItems
+		if end > len(keys) {
+			end = len(keys)
+		}
+		requests := make([]*dynamodb.WriteRequest, 0, end-start)
+		for _, key := range keys[start:end] {
+			requests = append(requests, &dynamodb.WriteRequest{
+				DeleteRequest: &dynamodb.DeleteRequest{Key: key},
+			})
+		}
+		pending := map[string][]*dynamodb.WriteRequest{fullTableName: requests}
+		for len(pending) > 0 {
+			output, err := db.client.BatchWriteItemWithContext(ctx, &dynamodb.BatchWriteItemInput{
+				RequestItems: pending,
+			})
+			if err != nil {
+				return err
+			}
+			pending = output.UnprocessedItems
+		}
+	}
+	return nil
+}
+
+func (t *transaction) add(itemType transactItemType, item *dynamodb.TransactWriteItem) {
+	size := transactItemSize(item)
+	t.items = append(t.items, item)
+	t.itemTypes = append(t.itemTypes, itemType)
+	t.itemSizes = append(t.itemSizes, size)
+	t.size += size
+}
+
+// fits returns true if an item of the size can be added without exceeding the limits of a transaction
+func (t *transaction) fits(size int) bool {
+	return len(t.items) < transactWriteMaxItems && t.size+size <= transactWriteMaxBytes
+}
+
+// itemSize estimates the size of an item the way DynamoDB counts it, which is the total length of attribute names and values
+func itemSize(item attributeMap) int {
+	size := 0
+	for name, v := range item {
+		size += len(name)
+		switch {
+		case v.S != nil:
+			size += len(*v.S)
+		case v.N != nil:
+			size += len(*v.N)
+		case v.B != nil:
+			size += len(v.B)
+		default:
+			size++
+		}
+	}
+	return size
+}
+
+func transactItemSize(item *dynamodb.TransactWriteItem) int {
+	switch {
+	case item.Put != nil:
+		return itemSize(item.Put.Item)
+	case item.Delete != nil:
+		return itemSize(item.Delete.Key)
+	case item.Update != nil:
+		return itemSize(item.Update.Key)
+	case item.ConditionCheck != nil:
+		return itemSize(item.ConditionCheck.Key)
+	default:
+		return 0
+	}
+}
+
+// executeTransactionWithOverflow writes the items of t atomically, together with the overflow items which
+// don't have to be written atomically with t, e.g. the tasks of a workflow update.
+// The overflow items are added to t as long as t stays within the limits of a transaction. The rest of them
+// are written ahead of t, in transactions guarded by the same condition as t, which is added by fence.
+// Therefore it's up to the readers of the overflow items to tolerate the items left by a failed write of t.
+func (db *ddb) executeTransactionWithOverflow(
+	ctx context.Context,
+	t *transaction,
+	overflow *transaction,
+	fence func(*transaction),
+) ([]*transactConditionFailure, error) {
+	if len(t.items) > transactWriteMaxItems || t.size > transactWriteMaxBytes {
+		return nil, fmt.Errorf("transaction of %v items and %v bytes exceeds the limits of DynamoDB", len(t.items), t.size)
+	}
+
+	next := 0
+	for ; next < len(overflow.items) && t.fits(overflow.itemSizes[next]); next++ {
+		t.add(overflow.itemTypes[next], overflow.items[next])
+	}
+	for next < len(overflow.items) {
+		chunk := &transaction{}
+		fence(chunk)
+		fenceItems := len(chunk.items)
+		// an item that doesn't fit into an empty transaction is still written, so that DynamoDB reports the error
+		for ; next < len(overflow.items) && (len(chunk.items) == fenceItems || chunk.fits(overflow.itemSizes[next])); next++ {
+			chunk.add(overflow.itemTypes[next], overflow.items[next])
+		}
+		failures, err := db.executeTransaction(ctx, chunk)
+		if err != nil || len(failures) > 0 {
+			return failures, err
+		}
+	}
+	return db.executeTransaction(ctx, t)
+}
+
+// executeTransaction writes all the items of the transaction atomically.
+// If the transaction is cancelled because of condition failures, the failed items are returned without error
+func (db *ddb) executeTransaction(ctx context.Context, t *transaction) ([]*transactConditionFailure, error) {
+	if len(t.items) == 0 {
+		return nil, nil
+	}
+	_, err := db.client.TransactWriteItemsWithContext(ctx, &dynamodb.TransactWriteItemsInput{
+		TransactItems: t.items,
+	})
+	if err == nil {
+		return nil, nil
+	}
+	cancelled, ok := err.(*dynamodb.TransactionCanceledException)
+	if !ok {
+		return nil, err
+	}
+	var failures []*transactConditionFailure
+	for i, reason := range cancelled.CancellationReasons {
+		if reason == nil || aws.StringValue(reason.Code) != conditionCheckFailCode {
+			continue
+		}
+		failures = append(failures, &transactConditionFailure{
+			itemType: t.itemTypes[i],
+			previous: reason.Item,
+		})
+	}
+	if len(failures) == 0 {
+		// cancelled by other reasons, e.g. TransactionConflict
+		return nil, err
+	}
+	return failures, nil
+}
+
+func describeItem(item attributeMap) string {
+	if len(item) == 0 {
+		return "<nil>"
+	}
+	description := ""
+	for k, v := range item {
+		if k == attrData {
+			continue
+		}
+		description += fmt.Sprintf("%s=%v,", k, aws.StringValue(v.S)+aws.StringValue(v.N))
+	}
+	return description
+}
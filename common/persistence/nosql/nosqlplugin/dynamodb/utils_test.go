@@ -0,0 +1,146 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTransactClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	transactions [][]*dynamodb.TransactWriteItem
+	// failAt is the index of the transaction to be cancelled by a condition failure of its first item
+	failAt int
+}
+
+func (c *fakeTransactClient) TransactWriteItemsWithContext(
+	_ aws.Context,
+	input *dynamodb.TransactWriteItemsInput,
+	_ ...request.Option,
+) (*dynamodb.TransactWriteItemsOutput, error) {
+	c.transactions = append(c.transactions, input.TransactItems)
+	if len(c.transactions)-1 == c.failAt {
+		reasons := make([]*dynamodb.CancellationReason, len(input.TransactItems))
+		reasons[0] = &dynamodb.CancellationReason{Code: aws.String(conditionCheckFailCode)}
+		return nil, &dynamodb.TransactionCanceledException{CancellationReasons: reasons}
+	}
+	return &dynamodb.TransactWriteItemsOutput{}, nil
+}
+
+func newTaskItem(taskID int64, dataSize int) *dynamodb.TransactWriteItem {
+	item := taskKey(0, rowKeyTransferTask, taskID)
+	item[attrData] = bytesAttr([]byte(strings.Repeat("x", dataSize)))
+	return &dynamodb.TransactWriteItem{Put: &dynamodb.Put{Item: item}}
+}
+
+func newTestTransactions(numTasks int, taskSize int) (*transaction, *transaction, func(*transaction)) {
+	fence := func(t *transaction) {
+		t.add(transactItemShard, &dynamodb.TransactWriteItem{ConditionCheck: &dynamodb.ConditionCheck{Key: shardKey(0)}})
+	}
+	t := &transaction{}
+	fence(t)
+	t.add(transactItemWorkflowExecution, &dynamodb.TransactWriteItem{Put: &dynamodb.Put{Item: workflowExecutionKey(0, "d", "w", "r")}})
+	tasks := &transaction{}
+	for i := 0; i < numTasks; i++ {
+		tasks.add(transactItemTask, newTaskItem(int64(i), taskSize))
+	}
+	return t, tasks, fence
+}
+
+func TestExecuteTransactionWithOverflow(t *testing.T) {
+	testCases := []struct {
+		numTasks      int
+		taskSize      int
+		expectedSizes []int
+	}{
+		{numTasks: 0, expectedSizes: []int{2}},
+		{numTasks: 98, expectedSizes: []int{100}},
+		{numTasks: 99, expectedSizes: []int{2, 100}},
+		{numTasks: 300, expectedSizes: []int{100, 100, 5, 100}},
+		{numTasks: 10, taskSize: 1024 * 1024, expectedSizes: []int{4, 4, 2, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v tasks of %v bytes", tc.numTasks, tc.taskSize), func(t *testing.T) {
+			client := &fakeTransactClient{failAt: -1}
+			db := &ddb{client: client}
+			txn, tasks, fence := newTestTransactions(tc.numTasks, tc.taskSize)
+
+			failures, err := db.executeTransactionWithOverflow(context.Background(), txn, tasks, fence)
+			require.NoError(t, err)
+			assert.Empty(t, failures)
+
+			var sizes []int
+			written := 0
+			for _, items := range client.transactions {
+				sizes = append(sizes, len(items))
+				// every transaction is guarded by the shard condition
+				assert.NotNil(t, items[0].ConditionCheck)
+				for _, item := range items {
+					if item.Put != nil && strings.HasPrefix(*item.Put.Item[attrRowKey].S, rowKeyTransferTask) {
+						written++
+					}
+				}
+			}
+			assert.Equal(t, tc.expectedSizes, sizes)
+			assert.Equal(t, tc.numTasks, written)
+			// the execution item is written by the last transaction
+			last := client.transactions[len(client.transactions)-1]
+			assert.Equal(t, workflowExecutionKey(0, "d", "w", "r")[attrRowKey], last[1].Put.Item[attrRowKey])
+		})
+	}
+}
+
+func TestExecuteTransactionWithOverflow_FencedOverflowFails(t *testing.T) {
+	client := &fakeTransactClient{failAt: 0}
+	db := &ddb{client: client}
+	txn, tasks, fence := newTestTransactions(150, 0)
+
+	failures, err := db.executeTransactionWithOverflow(context.Background(), txn, tasks, fence)
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	assert.Equal(t, transactItemShard, failures[0].itemType)
+	// the execution items are not written after the overflow items failed
+	assert.Len(t, client.transactions, 1)
+}
+
+func TestExecuteTransactionWithOverflow_TransactionTooLarge(t *testing.T) {
+	client := &fakeTransactClient{failAt: -1}
+	db := &ddb{client: client}
+	txn, tasks, fence := newTestTransactions(0, 0)
+	for i := 0; i < transactWriteMaxItems; i++ {
+		txn.add(transactItemWorkflowExecution, newTaskItem(int64(i), 0))
+	}
+
+	_, err := db.executeTransactionWithOverflow(context.Background(), txn, tasks, fence)
+	assert.Error(t, err)
+	assert.Empty(t, client.transactions)
+}
@@ -22,16 +22,88 @@ package dynamodb
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
+var _ nosqlplugin.VisibilityCRUD = (*ddb)(nil)
+
+// visibilityPageToken keeps the position of every bucket of the domain in the index.
+// The buckets without a position are read from the beginning, and the exhausted ones are skipped.
+type visibilityPageToken struct {
+	StartKeys map[int]attributeMap `json:",omitempty"`
+	Done      map[int]bool         `json:",omitempty"`
+}
+
+func visibilityKey(domainID, runID string) attributeMap {
+	return attributeMap{
+		attrDomainID: stringAttr(domainID),
+		attrRunID:    stringAttr(runID),
+	}
+}
+
+// visibilityBucket returns the partition key of a visibility record in the indexes, which is the domainID and
+// a bucket picked by runID, so that the records of a busy domain are not written to a single partition
+func visibilityBucket(domainID string, bucket int) string {
+	return domainID + rowKeySeparator + strconv.Itoa(bucket)
+}
+
+func visibilityBucketOf(runID string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(runID))
+	return int(h.Sum32() % visibilityBuckets)
+}
+
+// newVisibilityItem returns the item of a visibility record. close_time is only set for closed workflows,
+// so that the close_time_index only contains closed workflows
+func newVisibilityItem(domainID string, row *nosqlplugin.VisibilityRow, closed bool, ttlSeconds int64) (attributeMap, error) {
+	record := *row
+	record.DomainID = domainID
+	data, err := marshalData(&record)
+	if err != nil {
+		return nil, err
+	}
+	item := visibilityKey(domainID, row.RunID)
+	item[attrDomainBucket] = stringAttr(visibilityBucket(domainID, visibilityBucketOf(row.RunID)))
+	item[attrWorkflowID] = stringAttr(row.WorkflowID)
+	item[attrWorkflowType] = stringAttr(row.TypeName)
+	item[attrStartTime] = int64Attr(row.StartTime.UnixNano())
+	item[attrData] = data
+	if closed {
+		item[attrCloseTime] = int64Attr(row.CloseTime.UnixNano())
+		if row.Status != nil {
+			item[attrCloseStatus] = int64Attr(int64(*row.Status))
+		}
+	}
+	if ttlSeconds > 0 {
+		item[attrExpiry] = int64Attr(time.Now().Unix() + ttlSeconds)
+	}
+	return item, nil
+}
+
 func (db *ddb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableVisibility)),
+		Item:      item,
+	})
+	return err
 }
 
 func (db *ddb) UpdateVisibility(
@@ -39,26 +111,222 @@ func (db *ddb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	// the record is overwritten, as there is only one table for both open and closed workflows
+	closed := !row.UpdateCloseToOpen && (row.UpdateOpenToClose || row.Status != nil)
+	item, err := newVisibilityItem(row.DomainID, &row.VisibilityRow, closed, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableVisibility)),
+		Item:      item,
+	})
+	return err
 }
 
+// SelectVisibility queries every bucket of the domain in the index, and merges the records by time in descending order.
+// Records that are expired but not deleted by DynamoDB TTL yet are filtered out.
 func (db *ddb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := filter.ListRequest
+	var indexName, timeAttr string
+	switch filter.SortType {
+	case nosqlplugin.SortByStartTime:
+		indexName, timeAttr = indexVisibilityStartTime, attrStartTime
+	case nosqlplugin.SortByClosedTime:
+		indexName, timeAttr = indexVisibilityCloseTime, attrCloseTime
+	default:
+		return nil, fmt.Errorf("unknown sort type: %v", filter.SortType)
+	}
+
+	names := map[string]*string{
+		"#domainBucket": aws.String(attrDomainBucket),
+		"#time":         aws.String(timeAttr),
+		"#expiry":       aws.String(attrExpiry),
+	}
+	values := attributeMap{
+		":earliest": int64Attr(request.EarliestTime.UnixNano()),
+		":latest":   int64Attr(request.LatestTime.UnixNano()),
+		":now":      int64Attr(time.Now().Unix()),
+	}
+	conditions := []string{"(attribute_not_exists(#expiry) OR #expiry > :now)"}
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		conditions = append(conditions, "attribute_not_exists(#closeTime)")
+		names["#closeTime"] = aws.String(attrCloseTime)
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		if filter.SortType != nosqlplugin.SortByClosedTime {
+			// the close_time_index only contains closed workflows
+			conditions = append(conditions, "attribute_exists(#closeTime)")
+			names["#closeTime"] = aws.String(attrCloseTime)
+		}
+	default:
+		return nil, fmt.Errorf("unknown filter type: %v", filter.FilterType)
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		conditions = append(conditions, "#workflowType = :workflowType")
+		names["#workflowType"] = aws.String(attrWorkflowType)
+		values[":workflowType"] = stringAttr(filter.WorkflowType)
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		conditions = append(conditions, "#workflowID = :workflowID")
+		names["#workflowID"] = aws.String(attrWorkflowID)
+		values[":workflowID"] = stringAttr(filter.WorkflowID)
+	case nosqlplugin.ClosedByClosedStatus:
+		conditions = append(conditions, "#closeStatus = :closeStatus")
+		names["#closeStatus"] = aws.String(attrCloseStatus)
+		values[":closeStatus"] = int64Attr(int64(filter.CloseStatus))
+	}
+
+	newInput := func(bucket int) *dynamodb.QueryInput {
+		input := &dynamodb.QueryInput{
+			TableName:                 aws.String(db.tableName(tableVisibility)),
+			IndexName:                 aws.String(indexName),
+			ScanIndexForward:          aws.Bool(false),
+			KeyConditionExpression:    aws.String("#domainBucket = :domainBucket AND #time BETWEEN :earliest AND :latest"),
+			FilterExpression:          aws.String(strings.Join(conditions, " AND ")),
+			ExpressionAttributeNames:  names,
+			ExpressionAttributeValues: attributeMap{":domainBucket": stringAttr(visibilityBucket(request.DomainUUID, bucket))},
+		}
+		for k, v := range values {
+			input.ExpressionAttributeValues[k] = v
+		}
+		return input
+	}
+	items, nextPageToken, err := db.queryVisibilityBuckets(ctx, newInput, timeAttr, request.PageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*nosqlplugin.VisibilityRow, 0, len(items))
+	for _, item := range items {
+		row := &nosqlplugin.VisibilityRow{}
+		if err := unmarshalData(item, row); err != nil {
+			return nil, err
+		}
+		executions = append(executions, row)
+	}
+	return &nosqlplugin.SelectVisibilityResponse{
+		Executions:    executions,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// queryVisibilityBuckets reads a page from every bucket, and merges them by timeAttr in descending order.
+// The position of every bucket is kept in the page token, right after its last returned record.
+func (db *ddb) queryVisibilityBuckets(
+	ctx context.Context,
+	newInput func(bucket int) *dynamodb.QueryInput,
+	timeAttr string,
+	pageSize int,
+	pageToken []byte,
+) ([]attributeMap, []byte, error) {
+	token := visibilityPageToken{}
+	if len(pageToken) > 0 {
+		if err := json.Unmarshal(pageToken, &token); err != nil {
+			return nil, nil, fmt.Errorf("invalid page token: %v", err)
+		}
+	}
+	if token.StartKeys == nil {
+		token.StartKeys = map[int]attributeMap{}
+	}
+	if token.Done == nil {
+		token.Done = map[int]bool{}
+	}
+
+	pages := make([][]attributeMap, visibilityBuckets)
+	lastKeys := make([]attributeMap, visibilityBuckets)
+	for bucket := 0; bucket < visibilityBuckets; bucket++ {
+		if token.Done[bucket] {
+			continue
+		}
+		startKey, err := serializePageToken(token.StartKeys[bucket])
+		if err != nil {
+			return nil, nil, err
+		}
+		items, nextPageToken, err := db.queryPage(ctx, newInput(bucket), pageSize, startKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lastKeys[bucket], err = deserializePageToken(nextPageToken); err != nil {
+			return nil, nil, err
+		}
+		pages[bucket] = items
+	}
+
+	var items []attributeMap
+	positions := make([]int, visibilityBuckets)
+	for pageSize <= 0 || len(items) < pageSize {
+		next := -1
+		for bucket, page := range pages {
+			if positions[bucket] < len(page) && (next < 0 ||
+				getInt64Attr(page[positions[bucket]], timeAttr) > getInt64Attr(pages[next][positions[next]], timeAttr)) {
+				next = bucket
+			}
+		}
+		if next < 0 {
+			break
+		}
+		items = append(items, pages[next][positions[next]])
+		positions[next]++
+	}
+
+	for bucket, page := range pages {
+		switch {
+		case token.Done[bucket] || positions[bucket] == 0:
+			// the bucket is exhausted, or none of its records are returned
+			if !token.Done[bucket] && len(page) == 0 && len(lastKeys[bucket]) == 0 {
+				token.Done[bucket] = true
+			}
+		case positions[bucket] == len(page) && len(lastKeys[bucket]) == 0:
+			token.Done[bucket] = true
+			delete(token.StartKeys, bucket)
+		default:
+			last := page[positions[bucket]-1]
+			token.StartKeys[bucket] = attributeMap{
+				attrRunID:        last[attrRunID],
+				attrDomainID:     last[attrDomainID],
+				attrDomainBucket: last[attrDomainBucket],
+				timeAttr:         last[timeAttr],
+			}
+		}
+	}
+	if len(token.Done) == visibilityBuckets {
+		return items, nil, nil
+	}
+	nextPageToken, err := json.Marshal(&token)
+	if err != nil {
+		return nil, nil, err
+	}
+	return items, nextPageToken, nil
 }
 
 func (db *ddb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableVisibility, visibilityKey(domainID, runID))
 }
 
 func (db *ddb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableVisibility, visibilityKey(domainID, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !hasAttr(item, attrCloseTime) || getStringAttr(item, attrWorkflowID) != workflowID {
+		return nil, nil
+	}
+	row := &nosqlplugin.VisibilityRow{}
+	if err := unmarshalData(item, row); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
@@ -0,0 +1,139 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// fakeVisibilityClient serves the queries of the start_time_index, ordered by start time in descending order
+type fakeVisibilityClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	items   []attributeMap
+	queries []*dynamodb.QueryInput
+}
+
+func (c *fakeVisibilityClient) QueryWithContext(
+	_ aws.Context,
+	input *dynamodb.QueryInput,
+	_ ...request.Option,
+) (*dynamodb.QueryOutput, error) {
+	c.queries = append(c.queries, input)
+	bucket := *input.ExpressionAttributeValues[":domainBucket"].S
+	var items []attributeMap
+	started := input.ExclusiveStartKey == nil
+	for _, item := range c.items {
+		if getStringAttr(item, attrDomainBucket) != bucket {
+			continue
+		}
+		if started {
+			items = append(items, item)
+		} else if getStringAttr(item, attrRunID) == getStringAttr(input.ExclusiveStartKey, attrRunID) {
+			started = true
+		}
+	}
+	output := &dynamodb.QueryOutput{Items: items}
+	if input.Limit != nil && int64(len(items)) > *input.Limit {
+		output.Items = items[:*input.Limit]
+		last := output.Items[len(output.Items)-1]
+		output.LastEvaluatedKey = attributeMap{attrRunID: last[attrRunID]}
+	}
+	return output, nil
+}
+
+func newTestVisibilityItems(t *testing.T, n int) []attributeMap {
+	var items []attributeMap
+	for i := 0; i < n; i++ {
+		item, err := newVisibilityItem(testDomainID, &nosqlplugin.VisibilityRow{
+			WorkflowID: testWorkflowID,
+			RunID:      fmt.Sprintf("run-%v", i),
+			StartTime:  time.Unix(0, int64(i)),
+		}, false, 0)
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return getInt64Attr(items[i], attrStartTime) > getInt64Attr(items[j], attrStartTime)
+	})
+	return items
+}
+
+func TestSelectVisibility_MergesBuckets(t *testing.T) {
+	client := &fakeVisibilityClient{items: newTestVisibilityItems(t, 50)}
+	db := &ddb{client: client}
+	filter := &nosqlplugin.VisibilityFilter{
+		ListRequest: persistence.InternalListWorkflowExecutionsRequest{
+			DomainUUID:   testDomainID,
+			EarliestTime: time.Unix(0, 0),
+			LatestTime:   time.Unix(0, 100),
+			PageSize:     7,
+		},
+		FilterType: nosqlplugin.AllOpen,
+		SortType:   nosqlplugin.SortByStartTime,
+	}
+
+	var startTimes []int64
+	for {
+		response, err := db.SelectVisibility(context.Background(), filter)
+		require.NoError(t, err)
+		assert.True(t, len(response.Executions) <= 7)
+		for _, row := range response.Executions {
+			startTimes = append(startTimes, row.StartTime.UnixNano())
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		filter.ListRequest.NextPageToken = response.NextPageToken
+	}
+
+	// every record is returned once, newest first
+	require.Len(t, startTimes, 50)
+	for i, startTime := range startTimes {
+		assert.Equal(t, int64(49-i), startTime)
+	}
+
+	// the records are spread over the buckets, and the expired ones are filtered out
+	buckets := map[string]bool{}
+	for _, item := range client.items {
+		buckets[getStringAttr(item, attrDomainBucket)] = true
+	}
+	assert.True(t, len(buckets) > 1)
+	for _, input := range client.queries {
+		assert.Equal(t, indexVisibilityStartTime, *input.IndexName)
+		assert.False(t, *input.ConsistentRead)
+		assert.Equal(t, "(attribute_not_exists(#expiry) OR #expiry > :now) AND attribute_not_exists(#closeTime)", *input.FilterExpression)
+	}
+}
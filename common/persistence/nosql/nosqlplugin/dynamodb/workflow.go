@@ -23,8 +23,13 @@ package dynamodb
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
@@ -41,7 +46,45 @@ func (db *ddb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	t := &transaction{}
+	db.addShardCondition(t, shardID, shardCondition.RangeID)
+
+	err := db.addCurrentWorkflowWrite(t, shardID, domainID, workflowID, currentWorkflowRequest)
+	if err != nil {
+		return err
+	}
+
+	state, err := db.addCreateWorkflowExecution(t, transactItemInsertedWorkflowExecution, shardID, domainID, workflowID, execution)
+	if err != nil {
+		return err
+	}
+
+	overflow := &transaction{}
+	err = db.addTasks(overflow, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	if err != nil {
+		return err
+	}
+	states := []*workflowStateWriter{state}
+	staged := db.addWorkflowStates(t, overflow, states)
+
+	failures, err := db.executeTransactionWithOverflow(ctx, t, overflow, db.shardFence(shardID, shardCondition.RangeID))
+	if err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		if staged {
+			db.deleteStagedWorkflowStates(ctx, states)
+		}
+		return db.toWorkflowOperationConditionFailure(ctx, failures, currentWorkflowRequest, execution, shardCondition)
+	}
+	if staged {
+		db.applyWrittenWorkflowStates(ctx, shardCondition, states)
+	}
+	return nil
 }
 
 func (db *ddb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +99,388 @@ func (db *ddb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+
+	if mutatedExecution != nil && mutatedExecution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	// the transaction is built again if the updated executions are still marked by a previous write
+	// whose staged state items haven't been applied, see workflowStateWriter.stage
+	for applied := false; ; applied = true {
+		t := &transaction{}
+		db.addShardCondition(t, shardID, shardCondition.RangeID)
+
+		err := db.addCurrentWorkflowWrite(t, shardID, domainID, workflowID, currentWorkflowRequest)
+		if err != nil {
+			return err
+		}
+
+		var states []*workflowStateWriter
+		if mutatedExecution != nil {
+			state, err := db.addUpdateWorkflowExecution(ctx, t, shardID, domainID, workflowID, mutatedExecution)
+			if err != nil {
+				return err
+			}
+			states = append(states, state)
+		}
+
+		if insertedExecution != nil {
+			state, err := db.addCreateWorkflowExecution(t, transactItemInsertedWorkflowExecution, shardID, domainID, workflowID, insertedExecution)
+			if err != nil {
+				return err
+			}
+			states = append(states, state)
+		}
+
+		if resetExecution != nil {
+			state, err := db.addUpdateWorkflowExecution(ctx, t, shardID, domainID, workflowID, resetExecution)
+			if err != nil {
+				return err
+			}
+			states = append(states, state)
+		}
+
+		overflow := &transaction{}
+		err = db.addTasks(overflow, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+		if err != nil {
+			return err
+		}
+		staged := db.addWorkflowStates(t, overflow, states)
+
+		failures, err := db.executeTransactionWithOverflow(ctx, t, overflow, db.shardFence(shardID, shardCondition.RangeID))
+		if err != nil {
+			return err
+		}
+		if len(failures) == 0 {
+			if staged {
+				db.applyWrittenWorkflowStates(ctx, shardCondition, states)
+			}
+			return nil
+		}
+		if staged {
+			db.deleteStagedWorkflowStates(ctx, states)
+		}
+		if !applied {
+			pending, err := db.applyPendingWorkflowStates(ctx, failures, shardCondition, mutatedExecution, resetExecution)
+			if err != nil {
+				return err
+			}
+			if pending {
+				continue
+			}
+		}
+		return db.toWorkflowOperationConditionFailure(ctx, failures, currentWorkflowRequest, insertedExecution, shardCondition)
+	}
 }
 
 func (db *ddb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, currentWorkflowKey(shardID, domainID, workflowID))
+	if err != nil {
+		return nil, err
+	}
+	var row nosqlplugin.CurrentWorkflowRow
+	if err := unmarshalData(item, &row); err != nil {
+		return nil, err
+	}
+	return &row, nil
 }
 
 func (db *ddb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	item, err := db.getItem(ctx, tableExecutions, workflowExecutionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return nil, err
+	}
+	execution, err := toWorkflowExecution(item)
+	if err != nil {
+		return nil, err
+	}
+	stagedWriteID := getStringAttr(item, attrStagedWriteID)
+	if err := db.selectWorkflowState(ctx, shardID, domainID, workflowID, runID, stagedWriteID, execution); err != nil {
+		return nil, err
+	}
+	return execution, nil
 }
 
 func (db *ddb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName:                 aws.String(db.tableName(tableExecutions)),
+		Key:                       currentWorkflowKey(shardID, domainID, workflowID),
+		ConditionExpression:       aws.String("#currentRunID = :currentRunID"),
+		ExpressionAttributeNames:  map[string]*string{"#currentRunID": aws.String(attrCurrentRunID)},
+		ExpressionAttributeValues: attributeMap{":currentRunID": stringAttr(currentRunIDCondition)},
+	})
+	if db.IsConditionFailedError(err) {
+		// same as Cassandra, the current workflow is not deleted if it's pointing to another run
+		return nil
+	}
+	return err
 }
 
+// DeleteWorkflowExecution deletes the workflow_execution item first, so that a partial deletion leaves only
+// state items which are not visible, and they are deleted when the deletion is retried
 func (db *ddb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	if err := db.deleteItem(ctx, tableExecutions, workflowExecutionKey(shardID, domainID, workflowID, runID)); err != nil {
+		return err
+	}
+	input := workflowStateQuery(db.tableName(tableExecutions), shardID, domainID, workflowID, runID, "")
+	_, err := db.rangeDelete(ctx, input, []string{attrShardID, attrRowKey})
+	return err
 }
 
 func (db *ddb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, rowKeyPrefixQuery(db.tableName(tableExecutions), shardID, rowKeyCurrentWorkflow), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(items))
+	for _, item := range items {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     getStringAttr(item, attrDomainID),
+			WorkflowID:   getStringAttr(item, attrWorkflowID),
+			RunID:        permanentRunID,
+			State:        int(getInt64Attr(item, attrState)),
+			CurrentRunID: getStringAttr(item, attrCurrentRunID),
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.queryPage(ctx, rowKeyPrefixQuery(db.tableName(tableExecutions), shardID, rowKeyWorkflowExecution), pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(items))
+	for _, item := range items {
+		var data workflowExecutionData
+		if err := unmarshalData(item, &data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	return executions, nextPageToken, nil
 }
 
 func (db *ddb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	_, err := db.getItem(ctx, tableExecutions, workflowExecutionKey(shardID, domainID, workflowID, runID))
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
 }
 
 func (db *ddb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	items, nextPageToken, err := db.selectTasksBetween(ctx, shardID,
+		rowKeyTransferTask+encodeInt64Key(exclusiveMinTaskID+1),
+		rowKeyTransferTask+encodeInt64Key(inclusiveMaxTaskID),
+		pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(items))
+	for _, item := range items {
+		task := &nosqlplugin.TransferTask{}
+		if err := unmarshalData(item, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, taskKey(shardID, rowKeyTransferTask, taskID))
 }
 
 func (db *ddb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasksBetween(ctx, shardID,
+		rowKeyTransferTask+encodeInt64Key(exclusiveBeginTaskID+1),
+		rowKeyTransferTask+encodeInt64Key(inclusiveEndTaskID))
 }
 
 func (db *ddb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	// "timer#<max>" is less than any timer key of the max timestamp, as the task ID follows
+	items, nextPageToken, err := db.selectTasksBetween(ctx, shardID,
+		rowKeyTimerTask+encodeInt64Key(inclusiveMinTime.UnixNano()),
+		rowKeyTimerTask+encodeInt64Key(exclusiveMaxTime.UnixNano()),
+		pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(items))
+	for _, item := range items {
+		task := &nosqlplugin.TimerTask{}
+		if err := unmarshalData(item, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, timerTaskKey(shardID, visibilityTimestamp.UnixNano(), taskID))
 }
 
 func (db *ddb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	return db.rangeDeleteTasksBetween(ctx, shardID,
+		rowKeyTimerTask+encodeInt64Key(inclusiveMinTime.UnixNano()),
+		rowKeyTimerTask+encodeInt64Key(exclusiveMaxTime.UnixNano()))
 }
 
 func (db *ddb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, shardID, rowKeyReplicationTask, pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *ddb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, taskKey(shardID, rowKeyReplicationTask, taskID))
 }
 
 func (db *ddb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	return db.rangeDeleteTasksBetween(ctx, shardID,
+		rowKeyReplicationTask+encodeInt64Key(math.MinInt64),
+		rowKeyReplicationTask+encodeInt64Key(inclusiveEndTaskID))
 }
 
 func (db *ddb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	t := &transaction{}
+	db.addShardCondition(t, condition.ShardID, condition.RangeID)
+	taskItems := &transaction{}
+	err := db.addTasks(taskItems, condition.ShardID, nil, nil, tasks, nil)
+	if err != nil {
+		return err
+	}
+
+	failures, err := db.executeTransactionWithOverflow(ctx, t, taskItems, db.shardFence(condition.ShardID, condition.RangeID))
+	if err != nil {
+		return err
+	}
+	var details []string
+	for _, f := range failures {
+		if f.itemType == transactItemShard {
+			return &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: getInt64Attr(f.previous, attrRangeID),
+			}
+		}
+		details = append(details, describeItem(f.previous))
+	}
+	if len(failures) > 0 {
+		return &nosqlplugin.ShardOperationConditionFailure{
+			RangeID: -1,
+			Details: fmt.Sprintf("%v", details),
+		}
+	}
+	return nil
 }
 
 func (db *ddb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	prefix := crossClusterTaskPrefix(targetCluster)
+	items, nextPageToken, err := db.selectTasksBetween(ctx, shardID,
+		prefix+encodeInt64Key(exclusiveMinTaskID+1),
+		prefix+encodeInt64Key(inclusiveMaxTaskID),
+		pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(items))
+	for _, item := range items {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := unmarshalData(item, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
 
 func (db *ddb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, taskKey(shardID, crossClusterTaskPrefix(targetCluster), taskID))
 }
 
 func (db *ddb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	prefix := crossClusterTaskPrefix(targetCluster)
+	return db.rangeDeleteTasksBetween(ctx, shardID,
+		prefix+encodeInt64Key(exclusiveBeginTaskID+1),
+		prefix+encodeInt64Key(inclusiveEndTaskID))
 }
 
 func (db *ddb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	item := taskKey(shardID, replicationDLQTaskPrefix(sourceCluster), task.TaskID)
+	data, err := marshalData(&task)
+	if err != nil {
+		return err
+	}
+	item[attrData] = data
+	_, err = db.client.PutItemWithContext(ctx, &dynamodb.PutItemInput{
+		TableName: aws.String(db.tableName(tableExecutions)),
+		Item:      item,
+	})
+	return err
 }
 
 func (db *ddb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, shardID, replicationDLQTaskPrefix(sourceCluster), pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *ddb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	input := rowKeyPrefixQuery(db.tableName(tableExecutions), shardID, replicationDLQTaskPrefix(sourceCluster))
+	input.Select = aws.String(dynamodb.SelectCount)
+	input.ConsistentRead = aws.Bool(true)
+
+	var count int64
+	err := db.client.QueryPagesWithContext(ctx, input, func(output *dynamodb.QueryOutput, lastPage bool) bool {
+		count += aws.Int64Value(output.Count)
+		return true
+	})
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *ddb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	return db.deleteItem(ctx, tableExecutions, taskKey(shardID, replicationDLQTaskPrefix(sourceCluster), taskID))
 }
 
 func (db *ddb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	prefix := replicationDLQTaskPrefix(sourceCluster)
+	return db.rangeDeleteTasksBetween(ctx, shardID,
+		prefix+encodeInt64Key(exclusiveBeginTaskID+1),
+		prefix+encodeInt64Key(inclusiveEndTaskID))
+}
+
+func (db *ddb) selectReplicationTasks(ctx context.Context, shardID int, prefix string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	items, nextPageToken, err := db.selectTasksBetween(ctx, shardID,
+		prefix+encodeInt64Key(exclusiveMinTaskID+1),
+		prefix+encodeInt64Key(inclusiveMaxTaskID),
+		pageSize, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(items))
+	for _, item := range items {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := unmarshalData(item, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextPageToken, nil
 }
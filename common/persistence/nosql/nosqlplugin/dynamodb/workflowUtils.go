@@ -0,0 +1,1058 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/pborman/uuid"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const returnAllOldOnFailure = dynamodb.ReturnValuesOnConditionCheckFailureAllOld
+
+// workflowExecutionData is the data attribute of a workflow_execution item.
+// The maps and buffered events of the execution are stored as separate state items, see workflowStatePrefix.
+type workflowExecutionData struct {
+	ExecutionInfo    *persistence.InternalWorkflowExecutionInfo
+	VersionHistories *persistence.DataBlob
+	Checksum         checksum.Checksum
+	LastWriteVersion int64
+}
+
+// workflowStateWriter collects the puts and deletes of the state items of an execution.
+// Both are keyed by row key, as a transaction can't have more than one operation on the same item.
+type workflowStateWriter struct {
+	shardID    int
+	domainID   string
+	workflowID string
+	runID      string
+	puts       map[string]attributeMap
+	deletes    map[string]attributeMap
+	// execution is the workflow_execution item written with the state items
+	execution attributeMap
+}
+
+func currentWorkflowKey(shardID int, domainID, workflowID string) attributeMap {
+	return attributeMap{
+		attrShardID: intAttr(shardID),
+		attrRowKey:  stringAttr(rowKeyCurrentWorkflow + domainID + rowKeySeparator + workflowID),
+	}
+}
+
+func workflowExecutionKey(shardID int, domainID, workflowID, runID string) attributeMap {
+	return attributeMap{
+		attrShardID: intAttr(shardID),
+		attrRowKey:  stringAttr(rowKeyWorkflowExecution + domainID + rowKeySeparator + workflowID + rowKeySeparator + runID),
+	}
+}
+
+func taskKey(shardID int, prefix string, taskID int64) attributeMap {
+	return attributeMap{
+		attrShardID: intAttr(shardID),
+		attrRowKey:  stringAttr(prefix + encodeInt64Key(taskID)),
+	}
+}
+
+func crossClusterTaskPrefix(targetCluster string) string {
+	return rowKeyCrossClusterTask + targetCluster + rowKeySeparator
+}
+
+func replicationDLQTaskPrefix(sourceCluster string) string {
+	return rowKeyReplicationDLQ + sourceCluster + rowKeySeparator
+}
+
+func timerTaskKey(shardID int, visibilityTimestampNanos int64, taskID int64) attributeMap {
+	return attributeMap{
+		attrShardID: intAttr(shardID),
+		attrRowKey:  stringAttr(rowKeyTimerTask + encodeInt64Key(visibilityTimestampNanos) + rowKeySeparator + encodeInt64Key(taskID)),
+	}
+}
+
+func (db *ddb) addShardCondition(t *transaction, shardID int, rangeID int64) {
+	t.add(transactItemShard, &dynamodb.TransactWriteItem{
+		ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                           aws.String(db.tableName(tableExecutions)),
+			Key:                                 shardKey(shardID),
+			ConditionExpression:                 aws.String("#rangeID = :rangeID"),
+			ExpressionAttributeNames:            map[string]*string{"#rangeID": aws.String(attrRangeID)},
+			ExpressionAttributeValues:           attributeMap{":rangeID": int64Attr(rangeID)},
+			ReturnValuesOnConditionCheckFailure: aws.String(returnAllOldOnFailure),
+		},
+	})
+}
+
+// shardFence returns a function adding the shard condition to the transactions of overflow items
+func (db *ddb) shardFence(shardID int, rangeID int64) func(*transaction) {
+	return func(t *transaction) {
+		db.addShardCondition(t, shardID, rangeID)
+	}
+}
+
+func (db *ddb) addCurrentWorkflowWrite(
+	t *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	if request.WriteMode == nosqlplugin.CurrentWorkflowWriteModeNoop {
+		return nil
+	}
+
+	row := request.Row
+	row.ShardID = shardID
+	row.DomainID = domainID
+	row.WorkflowID = workflowID
+	data, err := marshalData(&row)
+	if err != nil {
+		return err
+	}
+	item := currentWorkflowKey(shardID, domainID, workflowID)
+	item[attrDomainID] = stringAttr(domainID)
+	item[attrWorkflowID] = stringAttr(workflowID)
+	item[attrCurrentRunID] = stringAttr(row.RunID)
+	item[attrLastWriteVersion] = int64Attr(row.LastWriteVersion)
+	item[attrState] = intAttr(row.State)
+	item[attrData] = data
+
+	put := &dynamodb.Put{
+		TableName:                           aws.String(db.tableName(tableExecutions)),
+		Item:                                item,
+		ReturnValuesOnConditionCheckFailure: aws.String(returnAllOldOnFailure),
+	}
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		put.ConditionExpression = aws.String("attribute_not_exists(#rowKey)")
+		put.ExpressionAttributeNames = map[string]*string{"#rowKey": aws.String(attrRowKey)}
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		condition := "#currentRunID = :currentRunID"
+		put.ExpressionAttributeNames = map[string]*string{"#currentRunID": aws.String(attrCurrentRunID)}
+		put.ExpressionAttributeValues = attributeMap{":currentRunID": stringAttr(*request.Condition.CurrentRunID)}
+		if request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			condition += " AND #lastWriteVersion = :lastWriteVersion AND #state = :state"
+			put.ExpressionAttributeNames["#lastWriteVersion"] = aws.String(attrLastWriteVersion)
+			put.ExpressionAttributeNames["#state"] = aws.String(attrState)
+			put.ExpressionAttributeValues[":lastWriteVersion"] = int64Attr(*request.Condition.LastWriteVersion)
+			put.ExpressionAttributeValues[":state"] = intAttr(*request.Condition.State)
+		}
+		put.ConditionExpression = aws.String(condition)
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+	t.add(transactItemCurrentWorkflow, &dynamodb.TransactWriteItem{Put: put})
+	return nil
+}
+
+func newWorkflowExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) *workflowExecutionData {
+	info := execution.InternalWorkflowExecutionInfo
+	data := &workflowExecutionData{
+		ExecutionInfo:    &info,
+		VersionHistories: execution.VersionHistories,
+		LastWriteVersion: execution.LastWriteVersion,
+	}
+	if execution.Checksums != nil {
+		data.Checksum = *execution.Checksums
+	}
+	return data
+}
+
+// workflowStatePrefix is the row key prefix of the state items of an execution. Every entry of the maps and
+// every batch of buffered events is a state item, so that an execution is not bounded by the item size limit
+// of DynamoDB, and an update only writes the entries it changes.
+func workflowStatePrefix(domainID, workflowID, runID string) string {
+	return rowKeyWorkflowState + domainID + rowKeySeparator + workflowID + rowKeySeparator + runID + rowKeySeparator
+}
+
+func workflowStateKey(shardID int, domainID, workflowID, runID, kind, sortKey string) attributeMap {
+	return attributeMap{
+		attrShardID: intAttr(shardID),
+		attrRowKey:  stringAttr(workflowStatePrefix(domainID, workflowID, runID) + kind + rowKeySeparator + sortKey),
+	}
+}
+
+// workflowStateQuery queries the state items of an execution, only the ones of the kind if it's not empty.
+// IDs are not escaped in row keys, so the prefix may also match the items of another workflow whose ID
+// contains the separator. Therefore the items are filtered by IDs as well.
+func workflowStateQuery(tableName string, shardID int, domainID, workflowID, runID, kind string) *dynamodb.QueryInput {
+	prefix := workflowStatePrefix(domainID, workflowID, runID)
+	if kind != "" {
+		prefix += kind + rowKeySeparator
+	}
+	input := rowKeyPrefixQuery(tableName, shardID, prefix)
+	input.FilterExpression = aws.String("#domainID = :domainID AND #workflowID = :workflowID AND #runID = :runID")
+	input.ExpressionAttributeNames["#domainID"] = aws.String(attrDomainID)
+	input.ExpressionAttributeNames["#workflowID"] = aws.String(attrWorkflowID)
+	input.ExpressionAttributeNames["#runID"] = aws.String(attrRunID)
+	input.ExpressionAttributeValues[":domainID"] = stringAttr(domainID)
+	input.ExpressionAttributeValues[":workflowID"] = stringAttr(workflowID)
+	input.ExpressionAttributeValues[":runID"] = stringAttr(runID)
+	return input
+}
+
+func newWorkflowStateWriter(shardID int, domainID, workflowID, runID string) *workflowStateWriter {
+	return &workflowStateWriter{
+		shardID:    shardID,
+		domainID:   domainID,
+		workflowID: workflowID,
+		runID:      runID,
+		puts:       make(map[string]attributeMap),
+		deletes:    make(map[string]attributeMap),
+	}
+}
+
+// put upserts a state item. The entry key is the key in the map, and the sort key is how it's ordered in the row key
+func (w *workflowStateWriter) put(kind string, entryKey string, sortKey string, value interface{}) error {
+	data, err := marshalData(value)
+	if err != nil {
+		return err
+	}
+	item := workflowStateKey(w.shardID, w.domainID, w.workflowID, w.runID, kind, sortKey)
+	item[attrDomainID] = stringAttr(w.domainID)
+	item[attrWorkflowID] = stringAttr(w.workflowID)
+	item[attrRunID] = stringAttr(w.runID)
+	item[attrRowType] = stringAttr(kind)
+	item[attrEntryKey] = stringAttr(entryKey)
+	item[attrData] = data
+	rowKey := getStringAttr(item, attrRowKey)
+	delete(w.deletes, rowKey)
+	w.puts[rowKey] = item
+	return nil
+}
+
+func (w *workflowStateWriter) putInt64(kind string, key int64, value interface{}) error {
+	return w.put(kind, strconv.FormatInt(key, 10), encodeInt64Key(key), value)
+}
+
+func (w *workflowStateWriter) putString(kind string, key string, value interface{}) error {
+	return w.put(kind, key, key, value)
+}
+
+// delete deletes a state item, which overrides the put of the same item
+func (w *workflowStateWriter) delete(key attributeMap) {
+	rowKey := getStringAttr(key, attrRowKey)
+	delete(w.puts, rowKey)
+	w.deletes[rowKey] = key
+}
+
+func (w *workflowStateWriter) deleteInt64(kind string, key int64) {
+	w.delete(workflowStateKey(w.shardID, w.domainID, w.workflowID, w.runID, kind, encodeInt64Key(key)))
+}
+
+func (w *workflowStateWriter) deleteString(kind string, key string) {
+	w.delete(workflowStateKey(w.shardID, w.domainID, w.workflowID, w.runID, kind, key))
+}
+
+// putMaps upserts the entries of the maps of the request
+func (w *workflowStateWriter) putMaps(execution *nosqlplugin.WorkflowExecutionRequest) error {
+	for k, v := range execution.ActivityInfos {
+		if err := w.putInt64(stateKindActivity, k, v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.TimerInfos {
+		if err := w.putString(stateKindTimer, k, v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.ChildWorkflowInfos {
+		if err := w.putInt64(stateKindChild, k, v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.RequestCancelInfos {
+		if err := w.putInt64(stateKindRequestCancel, k, v); err != nil {
+			return err
+		}
+	}
+	for k, v := range execution.SignalInfos {
+		if err := w.putInt64(stateKindSignal, k, v); err != nil {
+			return err
+		}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		if err := w.putString(stateKindSignalRequested, id, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteMapKeys deletes the entries of the maps by the keys to delete of the request
+func (w *workflowStateWriter) deleteMapKeys(execution *nosqlplugin.WorkflowExecutionRequest) {
+	for _, k := range execution.ActivityInfoKeysToDelete {
+		w.deleteInt64(stateKindActivity, k)
+	}
+	for _, k := range execution.TimerInfoKeysToDelete {
+		w.deleteString(stateKindTimer, k)
+	}
+	for _, k := range execution.ChildWorkflowInfoKeysToDelete {
+		w.deleteInt64(stateKindChild, k)
+	}
+	for _, k := range execution.RequestCancelInfoKeysToDelete {
+		w.deleteInt64(stateKindRequestCancel, k)
+	}
+	for _, k := range execution.SignalInfoKeysToDelete {
+		w.deleteInt64(stateKindSignal, k)
+	}
+	for _, id := range execution.SignalRequestedIDsKeysToDelete {
+		w.deleteString(stateKindSignalRequested, id)
+	}
+}
+
+func (w *workflowStateWriter) addTo(t *transaction, tableName string) {
+	for _, item := range w.puts {
+		t.add(transactItemWorkflowState, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(tableName),
+				Item:      item,
+			},
+		})
+	}
+	for _, key := range w.deletes {
+		t.add(transactItemWorkflowState, &dynamodb.TransactWriteItem{
+			Delete: &dynamodb.Delete{
+				TableName: aws.String(tableName),
+				Key:       key,
+			},
+		})
+	}
+}
+
+// stage adds the puts and deletes as staged items of the write, and marks the execution item with the write.
+// Staged items are written ahead of the execution item when the state items don't fit into its transaction.
+// Readers only apply the staged items of the write the execution item is marked with, so the ones left by a
+// failed write are ignored. The staged items are applied to the state items after the write, or by the next
+// update of the execution, whose condition fails as long as the execution item is marked.
+func (w *workflowStateWriter) stage(t *transaction, tableName string, writeID string) {
+	for rowKey, item := range w.puts {
+		staged := w.newStagedItem(writeID, rowKey)
+		staged[attrStagedRowType] = item[attrRowType]
+		staged[attrEntryKey] = item[attrEntryKey]
+		staged[attrData] = item[attrData]
+		t.add(transactItemWorkflowState, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(tableName),
+				Item:      staged,
+			},
+		})
+	}
+	for rowKey := range w.deletes {
+		staged := w.newStagedItem(writeID, rowKey)
+		staged[attrStagedDelete] = &dynamodb.AttributeValue{BOOL: aws.Bool(true)}
+		t.add(transactItemWorkflowState, &dynamodb.TransactWriteItem{
+			Put: &dynamodb.Put{
+				TableName: aws.String(tableName),
+				Item:      staged,
+			},
+		})
+	}
+	w.execution[attrStagedWriteID] = stringAttr(writeID)
+}
+
+func (w *workflowStateWriter) stagedKey(writeID string, rowKey string) attributeMap {
+	return workflowStateKey(w.shardID, w.domainID, w.workflowID, w.runID, stateKindStaged, writeID+rowKeySeparator+rowKey)
+}
+
+func (w *workflowStateWriter) newStagedItem(writeID string, rowKey string) attributeMap {
+	item := w.stagedKey(writeID, rowKey)
+	item[attrDomainID] = stringAttr(w.domainID)
+	item[attrWorkflowID] = stringAttr(w.workflowID)
+	item[attrRunID] = stringAttr(w.runID)
+	item[attrRowType] = stringAttr(stateKindStaged)
+	item[attrStagedWriteID] = stringAttr(writeID)
+	item[attrStagedRowKey] = stringAttr(rowKey)
+	return item
+}
+
+// unstage returns the state item put by a staged item, or only the key of the state item if it's deleted
+func unstage(staged attributeMap) (attributeMap, bool) {
+	item := attributeMap{
+		attrShardID: staged[attrShardID],
+		attrRowKey:  staged[attrStagedRowKey],
+	}
+	if hasAttr(staged, attrStagedDelete) {
+		return item, true
+	}
+	item[attrDomainID] = staged[attrDomainID]
+	item[attrWorkflowID] = staged[attrWorkflowID]
+	item[attrRunID] = staged[attrRunID]
+	item[attrRowType] = staged[attrStagedRowType]
+	item[attrEntryKey] = staged[attrEntryKey]
+	item[attrData] = staged[attrData]
+	return item, false
+}
+
+// addWorkflowStates adds the state items of the executions to t if they fit, otherwise they are staged
+// by overflow, which is written ahead of t by executeTransactionWithOverflow. Returns true if they are staged.
+func (db *ddb) addWorkflowStates(t *transaction, overflow *transaction, states []*workflowStateWriter) bool {
+	tableName := db.tableName(tableExecutions)
+	items := &transaction{}
+	for _, state := range states {
+		state.addTo(items, tableName)
+	}
+	if len(t.items)+len(items.items) <= transactWriteMaxItems && t.size+items.size <= transactWriteMaxBytes {
+		for i, item := range items.items {
+			t.add(items.itemTypes[i], item)
+		}
+		return false
+	}
+	writeID := uuid.New()
+	for _, state := range states {
+		state.stage(overflow, tableName, writeID)
+	}
+	return true
+}
+
+// applyStagedWorkflowState applies the staged items of the write the execution item is marked with to the
+// state items, in transactions guarded by the shard condition. Then it deletes the applied staged items and
+// unmarks the execution item. Returns the condition failures of the shard condition.
+func (db *ddb) applyStagedWorkflowState(
+	ctx context.Context,
+	shardID int,
+	rangeID int64,
+	domainID string,
+	workflowID string,
+	runID string,
+) ([]*transactConditionFailure, error) {
+	tableName := db.tableName(tableExecutions)
+	executionKey := workflowExecutionKey(shardID, domainID, workflowID, runID)
+	execution, err := db.getItem(ctx, tableExecutions, executionKey)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	writeID := getStringAttr(execution, attrStagedWriteID)
+	if writeID == "" {
+		return nil, nil
+	}
+	input := workflowStateQuery(tableName, shardID, domainID, workflowID, runID, stateKindStaged+rowKeySeparator+writeID)
+	stagedItems, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := &transaction{}
+	stagedKeys := make([]attributeMap, 0, len(stagedItems))
+	for _, staged := range stagedItems {
+		stagedKeys = append(stagedKeys, attributeMap{attrShardID: staged[attrShardID], attrRowKey: staged[attrRowKey]})
+		item, deleted := unstage(staged)
+		if deleted {
+			applied.add(transactItemWorkflowState, &dynamodb.TransactWriteItem{
+				Delete: &dynamodb.Delete{TableName: aws.String(tableName), Key: item},
+			})
+		} else {
+			applied.add(transactItemWorkflowState, &dynamodb.TransactWriteItem{
+				Put: &dynamodb.Put{TableName: aws.String(tableName), Item: item},
+			})
+		}
+	}
+	fence := db.shardFence(shardID, rangeID)
+	t := &transaction{}
+	fence(t)
+	failures, err := db.executeTransactionWithOverflow(ctx, t, applied, fence)
+	if err != nil || len(failures) > 0 {
+		return failures, err
+	}
+	if err := db.batchDelete(ctx, tableName, stagedKeys); err != nil {
+		return nil, err
+	}
+	_, err = db.client.UpdateItemWithContext(ctx, &dynamodb.UpdateItemInput{
+		TableName:                 aws.String(tableName),
+		Key:                       executionKey,
+		UpdateExpression:          aws.String("REMOVE #stagedWriteID"),
+		ConditionExpression:       aws.String("#stagedWriteID = :stagedWriteID"),
+		ExpressionAttributeNames:  map[string]*string{"#stagedWriteID": aws.String(attrStagedWriteID)},
+		ExpressionAttributeValues: attributeMap{":stagedWriteID": stringAttr(writeID)},
+	})
+	if db.IsConditionFailedError(err) {
+		// the execution is deleted, or unmarked by another applying
+		return nil, nil
+	}
+	return nil, err
+}
+
+// applyWrittenWorkflowStates applies the staged items of a successful write. It's best effort, as the staged
+// items are visible to readers already, and they are applied by the next update of the execution otherwise.
+func (db *ddb) applyWrittenWorkflowStates(ctx context.Context, shardCondition *nosqlplugin.ShardCondition, states []*workflowStateWriter) {
+	for _, state := range states {
+		if _, err := db.applyStagedWorkflowState(
+			ctx, state.shardID, shardCondition.RangeID, state.domainID, state.workflowID, state.runID,
+		); err != nil {
+			db.logger.Warn("unable to apply staged workflow state",
+				tag.WorkflowDomainID(state.domainID), tag.WorkflowID(state.workflowID), tag.WorkflowRunID(state.runID), tag.Error(err))
+		}
+	}
+}
+
+// deleteStagedWorkflowStates deletes the staged items of a write that failed on its conditions.
+// Staged items left by a failure of the deletion are never read, and they are deleted with the execution.
+func (db *ddb) deleteStagedWorkflowStates(ctx context.Context, states []*workflowStateWriter) {
+	var keys []attributeMap
+	for _, state := range states {
+		writeID := getStringAttr(state.execution, attrStagedWriteID)
+		for rowKey := range state.puts {
+			keys = append(keys, state.stagedKey(writeID, rowKey))
+		}
+		for rowKey := range state.deletes {
+			keys = append(keys, state.stagedKey(writeID, rowKey))
+		}
+	}
+	if err := db.batchDelete(ctx, db.tableName(tableExecutions), keys); err != nil {
+		db.logger.Warn("unable to delete staged workflow state", tag.Error(err))
+	}
+}
+
+// applyPendingWorkflowStates applies the staged items of the executions whose update failed only because their
+// execution items are still marked by a previous write. Returns false if there is any other failure.
+func (db *ddb) applyPendingWorkflowStates(
+	ctx context.Context,
+	failures []*transactConditionFailure,
+	shardCondition *nosqlplugin.ShardCondition,
+	executions ...*nosqlplugin.WorkflowExecutionRequest,
+) (bool, error) {
+	for _, f := range failures {
+		if f.itemType != transactItemWorkflowExecution || !hasAttr(f.previous, attrStagedWriteID) {
+			return false, nil
+		}
+		runID := getStringAttr(f.previous, attrRunID)
+		pending := false
+		for _, execution := range executions {
+			if execution != nil && execution.RunID == runID &&
+				*execution.PreviousNextEventIDCondition == getInt64Attr(f.previous, attrNextEventID) {
+				pending = true
+			}
+		}
+		if !pending {
+			return false, nil
+		}
+	}
+	for _, f := range failures {
+		shardFailures, err := db.applyStagedWorkflowState(ctx, shardCondition.ShardID, shardCondition.RangeID,
+			getStringAttr(f.previous, attrDomainID), getStringAttr(f.previous, attrWorkflowID), getStringAttr(f.previous, attrRunID))
+		if err != nil || len(shardFailures) > 0 {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// selectWorkflowStateKeys reads the keys of the state items of an execution, only the ones of the kind if it's not empty
+func (db *ddb) selectWorkflowStateKeys(
+	ctx context.Context,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+	kind string,
+) ([]attributeMap, error) {
+	keyAttributes := []string{attrShardID, attrRowKey}
+	input := workflowStateQuery(db.tableName(tableExecutions), shardID, domainID, workflowID, runID, kind)
+	input.ProjectionExpression = aws.String(projectionOf(input, keyAttributes))
+	items, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]attributeMap, 0, len(items))
+	for _, item := range items {
+		key := make(attributeMap, len(keyAttributes))
+		for _, attr := range keyAttributes {
+			key[attr] = item[attr]
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// nextBufferedEventsSeq returns the sequence number of the next batch of buffered events of an execution
+func (db *ddb) nextBufferedEventsSeq(ctx context.Context, shardID int, domainID, workflowID, runID string) (int64, error) {
+	input := workflowStateQuery(db.tableName(tableExecutions), shardID, domainID, workflowID, runID, stateKindBufferedEvents)
+	input.ScanIndexForward = aws.Bool(false)
+	items, _, err := db.queryPage(ctx, input, 1, nil)
+	if err != nil {
+		return 0, err
+	}
+	if len(items) == 0 {
+		return 0, nil
+	}
+	seq, err := strconv.ParseInt(getStringAttr(items[0], attrEntryKey), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return seq + 1, nil
+}
+
+// selectWorkflowState reads the state items of an execution into its maps and buffered events.
+// The staged items of stagedWriteID, which the execution item is marked with, override the state items.
+func (db *ddb) selectWorkflowState(
+	ctx context.Context,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+	stagedWriteID string,
+	execution *nosqlplugin.WorkflowExecution,
+) error {
+	input := workflowStateQuery(db.tableName(tableExecutions), shardID, domainID, workflowID, runID, "")
+	items, _, err := db.queryPage(ctx, input, 0, nil)
+	if err != nil {
+		return err
+	}
+	itemsByRowKey := make(map[string]attributeMap, len(items))
+	var stagedItems []attributeMap
+	for _, item := range items {
+		if getStringAttr(item, attrRowType) != stateKindStaged {
+			itemsByRowKey[getStringAttr(item, attrRowKey)] = item
+		} else if stagedWriteID != "" && getStringAttr(item, attrStagedWriteID) == stagedWriteID {
+			stagedItems = append(stagedItems, item)
+		}
+	}
+	for _, staged := range stagedItems {
+		item, deleted := unstage(staged)
+		if deleted {
+			delete(itemsByRowKey, getStringAttr(item, attrRowKey))
+		} else {
+			itemsByRowKey[getStringAttr(item, attrRowKey)] = item
+		}
+	}
+	// the batches of buffered events are ordered by their row keys
+	rowKeys := make([]string, 0, len(itemsByRowKey))
+	for rowKey := range itemsByRowKey {
+		rowKeys = append(rowKeys, rowKey)
+	}
+	sort.Strings(rowKeys)
+
+	for _, rowKey := range rowKeys {
+		item := itemsByRowKey[rowKey]
+		kind := getStringAttr(item, attrRowType)
+		entryKey := getStringAttr(item, attrEntryKey)
+		var intKey int64
+		switch kind {
+		case stateKindActivity, stateKindChild, stateKindRequestCancel, stateKindSignal:
+			if intKey, err = strconv.ParseInt(entryKey, 10, 64); err != nil {
+				return fmt.Errorf("invalid key %v of %v item: %v", entryKey, kind, err)
+			}
+		}
+
+		switch kind {
+		case stateKindActivity:
+			info := &persistence.InternalActivityInfo{}
+			err = unmarshalData(item, info)
+			execution.ActivityInfos[intKey] = info
+		case stateKindTimer:
+			info := &persistence.TimerInfo{}
+			err = unmarshalData(item, info)
+			execution.TimerInfos[entryKey] = info
+		case stateKindChild:
+			info := &persistence.InternalChildExecutionInfo{}
+			err = unmarshalData(item, info)
+			execution.ChildExecutionInfos[intKey] = info
+		case stateKindRequestCancel:
+			info := &persistence.RequestCancelInfo{}
+			err = unmarshalData(item, info)
+			execution.RequestCancelInfos[intKey] = info
+		case stateKindSignal:
+			info := &persistence.SignalInfo{}
+			err = unmarshalData(item, info)
+			execution.SignalInfos[intKey] = info
+		case stateKindSignalRequested:
+			execution.SignalRequestedIDs[entryKey] = struct{}{}
+		case stateKindBufferedEvents:
+			batch := &persistence.DataBlob{}
+			err = unmarshalData(item, batch)
+			execution.BufferedEvents = append(execution.BufferedEvents, batch)
+		default:
+			err = fmt.Errorf("unknown kind %v of workflow state item", kind)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *ddb) newWorkflowExecutionItem(
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+	data *workflowExecutionData,
+) (attributeMap, error) {
+	blob, err := marshalData(data)
+	if err != nil {
+		return nil, err
+	}
+	item := workflowExecutionKey(shardID, domainID, workflowID, runID)
+	item[attrDomainID] = stringAttr(domainID)
+	item[attrWorkflowID] = stringAttr(workflowID)
+	item[attrRunID] = stringAttr(runID)
+	item[attrNextEventID] = int64Attr(data.ExecutionInfo.NextEventID)
+	item[attrData] = blob
+	return item, nil
+}
+
+// addCreateWorkflowExecution creates a new workflow_execution item. Returns the state items of the maps from the
+// request, which are added by addWorkflowStates
+func (db *ddb) addCreateWorkflowExecution(
+	t *transaction,
+	itemType transactItemType,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowStateWriter, error) {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return nil, fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return nil, fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	state := newWorkflowStateWriter(shardID, domainID, workflowID, execution.RunID)
+	if err := state.putMaps(execution); err != nil {
+		return nil, err
+	}
+	item, err := db.newWorkflowExecutionItem(shardID, domainID, workflowID, execution.RunID, newWorkflowExecutionData(execution))
+	if err != nil {
+		return nil, err
+	}
+	state.execution = item
+	t.add(itemType, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:                           aws.String(db.tableName(tableExecutions)),
+			Item:                                item,
+			ConditionExpression:                 aws.String("attribute_not_exists(#rowKey)"),
+			ExpressionAttributeNames:            map[string]*string{"#rowKey": aws.String(attrRowKey)},
+			ReturnValuesOnConditionCheckFailure: aws.String(returnAllOldOnFailure),
+		},
+	})
+	return state, nil
+}
+
+// addUpdateWorkflowExecution overwrites the workflow_execution item with the condition of previous nextEventID,
+// and returns the state items changed by the request, which are added by addWorkflowStates.
+// The condition also requires the execution item to be unmarked by staged items, so that the changes are not
+// computed from state items which the staged items haven't been applied to, see workflowStateWriter.stage.
+// Clearing buffered events and resetting the maps read the keys of the existing state items first. It's safe because
+// the execution has a single writer, which is guaranteed by the shard condition of the same transaction.
+func (db *ddb) addUpdateWorkflowExecution(
+	ctx context.Context,
+	t *transaction,
+	shardID int,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) (*workflowStateWriter, error) {
+	runID := execution.RunID
+	state := newWorkflowStateWriter(shardID, domainID, workflowID, runID)
+	if err := state.putMaps(execution); err != nil {
+		return nil, err
+	}
+	switch execution.MapsWriteMode {
+	case nosqlplugin.WorkflowExecutionMapsWriteModeUpdate:
+		state.deleteMapKeys(execution)
+
+		switch execution.EventBufferWriteMode {
+		case nosqlplugin.EventBufferWriteModeClear:
+			keys, err := db.selectWorkflowStateKeys(ctx, shardID, domainID, workflowID, runID, stateKindBufferedEvents)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				state.delete(key)
+			}
+		case nosqlplugin.EventBufferWriteModeAppend:
+			seq, err := db.nextBufferedEventsSeq(ctx, shardID, domainID, workflowID, runID)
+			if err != nil {
+				return nil, err
+			}
+			if err := state.putInt64(stateKindBufferedEvents, seq, execution.NewBufferedEventBatch); err != nil {
+				return nil, err
+			}
+		}
+	case nosqlplugin.WorkflowExecutionMapsWriteModeReset:
+		if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+			return nil, fmt.Errorf("should only support EventBufferWriteModeClear")
+		}
+		// the maps from the request replace all the existing state items, including the buffered events
+		keys, err := db.selectWorkflowStateKeys(ctx, shardID, domainID, workflowID, runID, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if _, ok := state.puts[getStringAttr(key, attrRowKey)]; !ok {
+				state.delete(key)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported MapsWriteMode %v for updating workflow execution", execution.MapsWriteMode)
+	}
+
+	item, err := db.newWorkflowExecutionItem(shardID, domainID, workflowID, runID, newWorkflowExecutionData(execution))
+	if err != nil {
+		return nil, err
+	}
+	t.add(transactItemWorkflowExecution, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName:           aws.String(db.tableName(tableExecutions)),
+			Item:                item,
+			ConditionExpression: aws.String("#nextEventID = :nextEventID AND attribute_not_exists(#stagedWriteID)"),
+			ExpressionAttributeNames: map[string]*string{
+				"#nextEventID":   aws.String(attrNextEventID),
+				"#stagedWriteID": aws.String(attrStagedWriteID),
+			},
+			ExpressionAttributeValues:           attributeMap{":nextEventID": int64Attr(*execution.PreviousNextEventIDCondition)},
+			ReturnValuesOnConditionCheckFailure: aws.String(returnAllOldOnFailure),
+		},
+	})
+	state.execution = item
+	return state, nil
+}
+
+func (db *ddb) addTaskPut(t *transaction, key attributeMap, task interface{}) error {
+	data, err := marshalData(task)
+	if err != nil {
+		return err
+	}
+	key[attrData] = data
+	t.add(transactItemTask, &dynamodb.TransactWriteItem{
+		Put: &dynamodb.Put{
+			TableName: aws.String(db.tableName(tableExecutions)),
+			Item:      key,
+		},
+	})
+	return nil
+}
+
+// addTasks adds the task items to t, which is written by executeTransactionWithOverflow with the execution items.
+// Timer tasks are added first, so that they are the last to be written ahead of the execution items: transfer,
+// cross-cluster and replication tasks are not read by queue processors until the shard moves its max read level
+// after the write returns, while timer tasks are read by their visibility timestamp.
+// Tasks left by a failed write are discarded by queue processors as they are stale to the mutable state.
+func (db *ddb) addTasks(
+	t *transaction,
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) error {
+	for _, task := range timerTasks {
+		if err := db.addTaskPut(t, timerTaskKey(shardID, task.VisibilityTimestamp.UnixNano(), task.TaskID), task); err != nil {
+			return err
+		}
+	}
+	for _, task := range transferTasks {
+		if err := db.addTaskPut(t, taskKey(shardID, rowKeyTransferTask, task.TaskID), task); err != nil {
+			return err
+		}
+	}
+	for _, task := range crossClusterTasks {
+		if err := db.addTaskPut(t, taskKey(shardID, crossClusterTaskPrefix(task.TargetCluster), task.TaskID), task); err != nil {
+			return err
+		}
+	}
+	for _, task := range replicationTasks {
+		if err := db.addTaskPut(t, taskKey(shardID, rowKeyReplicationTask, task.TaskID), task); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toWorkflowOperationConditionFailure interprets the failed items of a workflow transaction, in the same
+// precedence as Cassandra implementation: shard rangeID, current workflow, and then workflow execution
+func (db *ddb) toWorkflowOperationConditionFailure(
+	ctx context.Context,
+	failures []*transactConditionFailure,
+	currentWorkflowRequest *nosqlplugin.CurrentWorkflowWriteRequest,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+	shardCondition *nosqlplugin.ShardCondition,
+) error {
+	var details []string
+	byType := make(map[transactItemType]*transactConditionFailure, len(failures))
+	for _, f := range failures {
+		byType[f.itemType] = f
+		details = append(details, fmt.Sprintf("(%v)", describeItem(f.previous)))
+	}
+
+	if f, ok := byType[transactItemShard]; ok {
+		rangeID := getInt64Attr(f.previous, attrRangeID)
+		if len(f.previous) == 0 {
+			item, err := db.getItem(ctx, tableExecutions, shardKey(shardCondition.ShardID))
+			if err != nil {
+				return err
+			}
+			rangeID = getInt64Attr(item, attrRangeID)
+		}
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+		}
+	}
+
+	if f, ok := byType[transactItemCurrentWorkflow]; ok {
+		var previous nosqlplugin.CurrentWorkflowRow
+		if len(f.previous) > 0 {
+			if err := unmarshalData(f.previous, &previous); err != nil {
+				return err
+			}
+		}
+		if currentWorkflowRequest.WriteMode == nosqlplugin.CurrentWorkflowWriteModeInsert {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+				previous.WorkflowID, previous.RunID, shardCondition.RangeID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  previous.CreateRequestID,
+					RunID:            previous.RunID,
+					State:            previous.State,
+					CloseStatus:      previous.CloseStatus,
+					LastWriteVersion: previous.LastWriteVersion,
+				},
+			}
+		}
+		msg := fmt.Sprintf("Workflow execution condition failed on current workflow. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+			currentWorkflowRequest.Row.WorkflowID, currentWorkflowRequest.Condition.GetCurrentRunID(), previous.RunID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			CurrentWorkflowConditionFailInfo: &msg,
+		}
+	}
+
+	if _, ok := byType[transactItemInsertedWorkflowExecution]; ok && execution != nil {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			execution.WorkflowID, execution.RunID, shardCondition.RangeID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: execution.LastWriteVersion,
+			},
+		}
+	}
+
+	msg := fmt.Sprintf("Failed to operate on workflow execution. ShardID: %v, RangeID: %v, failed items: %v",
+		shardCondition.ShardID, shardCondition.RangeID, strings.Join(details, ","))
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		UnknownConditionFailureDetails: &msg,
+	}
+}
+
+// toWorkflowExecution converts a workflow_execution item, the maps and buffered events are read by selectWorkflowState
+func toWorkflowExecution(item attributeMap) (*nosqlplugin.WorkflowExecution, error) {
+	var data workflowExecutionData
+	if err := unmarshalData(item, &data); err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+		SignalRequestedIDs:  make(map[string]struct{}),
+		BufferedEvents:      make([]*persistence.DataBlob, 0),
+		Checksum:            data.Checksum,
+	}, nil
+}
+
+// selectTasksBetween reads a page of task items of a shard, whose row keys are between lower and upper(both inclusive)
+func (db *ddb) selectTasksBetween(
+	ctx context.Context,
+	shardID int,
+	lower string,
+	upper string,
+	pageSize int,
+	pageToken []byte,
+) ([]attributeMap, []byte, error) {
+	if lower > upper {
+		// DynamoDB rejects a BETWEEN condition with a lower bound greater than the upper bound
+		return nil, nil, nil
+	}
+	return db.queryPage(ctx, tasksBetweenQuery(db.tableName(tableExecutions), shardID, lower, upper), pageSize, pageToken)
+}
+
+func (db *ddb) rangeDeleteTasksBetween(ctx context.Context, shardID int, lower string, upper string) error {
+	if lower > upper {
+		return nil
+	}
+	_, err := db.rangeDelete(ctx, tasksBetweenQuery(db.tableName(tableExecutions), shardID, lower, upper), []string{attrShardID, attrRowKey})
+	return err
+}
+
+func tasksBetweenQuery(tableName string, shardID int, lower string, upper string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(tableName),
+		KeyConditionExpression: aws.String("#shardID = :shardID AND #rowKey BETWEEN :lower AND :upper"),
+		ExpressionAttributeNames: map[string]*string{
+			"#shardID": aws.String(attrShardID),
+			"#rowKey":  aws.String(attrRowKey),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":shardID": intAttr(shardID),
+			":lower":   stringAttr(lower),
+			":upper":   stringAttr(upper),
+		},
+	}
+}
+
+func (db *ddb) deleteItem(ctx context.Context, table string, key attributeMap) error {
+	_, err := db.client.DeleteItemWithContext(ctx, &dynamodb.DeleteItemInput{
+		TableName: aws.String(db.tableName(table)),
+		Key:       key,
+	})
+	return err
+}
+
+func rowKeyPrefixQuery(tableName string, shardID int, prefix string) *dynamodb.QueryInput {
+	return &dynamodb.QueryInput{
+		TableName:              aws.String(tableName),
+		KeyConditionExpression: aws.String("#shardID = :shardID AND begins_with(#rowKey, :prefix)"),
+		ExpressionAttributeNames: map[string]*string{
+			"#shardID": aws.String(attrShardID),
+			"#rowKey":  aws.String(attrRowKey),
+		},
+		ExpressionAttributeValues: attributeMap{
+			":shardID": intAttr(shardID),
+			":prefix":  stringAttr(prefix),
+		},
+	}
+}
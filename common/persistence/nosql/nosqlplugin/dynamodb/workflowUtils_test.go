@@ -0,0 +1,452 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dynamodb
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+type fakeQueryClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	items   []attributeMap
+	queries []*dynamodb.QueryInput
+}
+
+func (c *fakeQueryClient) QueryWithContext(
+	_ aws.Context,
+	input *dynamodb.QueryInput,
+	_ ...request.Option,
+) (*dynamodb.QueryOutput, error) {
+	c.queries = append(c.queries, input)
+	items := c.items
+	if input.ScanIndexForward != nil && !*input.ScanIndexForward {
+		items = make([]attributeMap, 0, len(c.items))
+		for i := len(c.items) - 1; i >= 0; i-- {
+			items = append(items, c.items[i])
+		}
+	}
+	if input.Limit != nil && int64(len(items)) > *input.Limit {
+		items = items[:*input.Limit]
+	}
+	return &dynamodb.QueryOutput{Items: items}, nil
+}
+
+func newTestStateItem(t *testing.T, kind string, entryKey string, sortKey string, value interface{}) attributeMap {
+	w := newWorkflowStateWriter(0, testDomainID, testWorkflowID, testRunID)
+	require.NoError(t, w.put(kind, entryKey, sortKey, value))
+	for _, item := range w.puts {
+		return item
+	}
+	return nil
+}
+
+func newTestUpdateRequest(mode nosqlplugin.WorkflowExecutionMapsWriteMode) *nosqlplugin.WorkflowExecutionRequest {
+	return &nosqlplugin.WorkflowExecutionRequest{
+		InternalWorkflowExecutionInfo: persistence.InternalWorkflowExecutionInfo{
+			DomainID:    testDomainID,
+			WorkflowID:  testWorkflowID,
+			RunID:       testRunID,
+			NextEventID: 10,
+		},
+		PreviousNextEventIDCondition: common.Int64Ptr(5),
+		MapsWriteMode:                mode,
+	}
+}
+
+// writtenStateItems returns the row keys of the state items put and deleted by the transaction
+func writtenStateItems(t *transaction) ([]string, []string) {
+	var puts, deletes []string
+	for i, item := range t.items {
+		if t.itemTypes[i] != transactItemWorkflowState {
+			continue
+		}
+		if item.Put != nil {
+			puts = append(puts, getStringAttr(item.Put.Item, attrRowKey))
+		} else {
+			deletes = append(deletes, getStringAttr(item.Delete.Key, attrRowKey))
+		}
+	}
+	sort.Strings(puts)
+	sort.Strings(deletes)
+	return puts, deletes
+}
+
+func stateRowKey(kind string, sortKey string) string {
+	return getStringAttr(workflowStateKey(0, testDomainID, testWorkflowID, testRunID, kind, sortKey), attrRowKey)
+}
+
+func TestAddUpdateWorkflowExecution_UpdateMode(t *testing.T) {
+	client := &fakeQueryClient{
+		items: []attributeMap{
+			newTestStateItem(t, stateKindBufferedEvents, "3", encodeInt64Key(3), &persistence.DataBlob{}),
+			newTestStateItem(t, stateKindBufferedEvents, "4", encodeInt64Key(4), &persistence.DataBlob{}),
+		},
+	}
+	db := &ddb{client: client}
+	request := newTestUpdateRequest(nosqlplugin.WorkflowExecutionMapsWriteModeUpdate)
+	request.ActivityInfos = map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}, 2: {ScheduleID: 2}}
+	request.ActivityInfoKeysToDelete = []int64{2, 3}
+	request.SignalRequestedIDs = []string{"signal-request"}
+	request.EventBufferWriteMode = nosqlplugin.EventBufferWriteModeAppend
+	request.NewBufferedEventBatch = &persistence.DataBlob{Data: []byte("events")}
+
+	txn := &transaction{}
+	state, err := db.addUpdateWorkflowExecution(context.Background(), txn, 0, testDomainID, testWorkflowID, request)
+	require.NoError(t, err)
+	state.addTo(txn, db.tableName(tableExecutions))
+
+	assert.Equal(t, transactItemWorkflowExecution, txn.itemTypes[0])
+	assert.Equal(t, "#nextEventID = :nextEventID AND attribute_not_exists(#stagedWriteID)", *txn.items[0].Put.ConditionExpression)
+	puts, deletes := writtenStateItems(txn)
+	assert.Equal(t, []string{
+		stateRowKey(stateKindActivity, encodeInt64Key(1)),
+		stateRowKey(stateKindBufferedEvents, encodeInt64Key(5)),
+		stateRowKey(stateKindSignalRequested, "signal-request"),
+	}, puts)
+	assert.Equal(t, []string{
+		stateRowKey(stateKindActivity, encodeInt64Key(2)),
+		stateRowKey(stateKindActivity, encodeInt64Key(3)),
+	}, deletes)
+}
+
+func TestAddUpdateWorkflowExecution_ClearBufferedEvents(t *testing.T) {
+	client := &fakeQueryClient{
+		items: []attributeMap{
+			newTestStateItem(t, stateKindBufferedEvents, "0", encodeInt64Key(0), &persistence.DataBlob{}),
+			newTestStateItem(t, stateKindBufferedEvents, "1", encodeInt64Key(1), &persistence.DataBlob{}),
+		},
+	}
+	db := &ddb{client: client}
+	request := newTestUpdateRequest(nosqlplugin.WorkflowExecutionMapsWriteModeUpdate)
+	request.EventBufferWriteMode = nosqlplugin.EventBufferWriteModeClear
+
+	txn := &transaction{}
+	state, err := db.addUpdateWorkflowExecution(context.Background(), txn, 0, testDomainID, testWorkflowID, request)
+	require.NoError(t, err)
+	state.addTo(txn, db.tableName(tableExecutions))
+
+	puts, deletes := writtenStateItems(txn)
+	assert.Empty(t, puts)
+	assert.Equal(t, []string{
+		stateRowKey(stateKindBufferedEvents, encodeInt64Key(0)),
+		stateRowKey(stateKindBufferedEvents, encodeInt64Key(1)),
+	}, deletes)
+}
+
+func TestAddUpdateWorkflowExecution_ResetMode(t *testing.T) {
+	client := &fakeQueryClient{
+		items: []attributeMap{
+			newTestStateItem(t, stateKindActivity, "1", encodeInt64Key(1), &persistence.InternalActivityInfo{}),
+			newTestStateItem(t, stateKindActivity, "7", encodeInt64Key(7), &persistence.InternalActivityInfo{}),
+			newTestStateItem(t, stateKindBufferedEvents, "0", encodeInt64Key(0), &persistence.DataBlob{}),
+		},
+	}
+	db := &ddb{client: client}
+	request := newTestUpdateRequest(nosqlplugin.WorkflowExecutionMapsWriteModeReset)
+	request.EventBufferWriteMode = nosqlplugin.EventBufferWriteModeClear
+	request.ActivityInfos = map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}}
+	request.TimerInfos = map[string]*persistence.TimerInfo{"timer": {TimerID: "timer"}}
+
+	txn := &transaction{}
+	state, err := db.addUpdateWorkflowExecution(context.Background(), txn, 0, testDomainID, testWorkflowID, request)
+	require.NoError(t, err)
+	state.addTo(txn, db.tableName(tableExecutions))
+
+	puts, deletes := writtenStateItems(txn)
+	assert.Equal(t, []string{
+		stateRowKey(stateKindActivity, encodeInt64Key(1)),
+		stateRowKey(stateKindTimer, "timer"),
+	}, puts)
+	assert.Equal(t, []string{
+		stateRowKey(stateKindActivity, encodeInt64Key(7)),
+		stateRowKey(stateKindBufferedEvents, encodeInt64Key(0)),
+	}, deletes)
+}
+
+func TestSelectWorkflowState(t *testing.T) {
+	client := &fakeQueryClient{
+		items: []attributeMap{
+			newTestStateItem(t, stateKindActivity, "1", encodeInt64Key(1), &persistence.InternalActivityInfo{ScheduleID: 1}),
+			newTestStateItem(t, stateKindBufferedEvents, "0", encodeInt64Key(0), &persistence.DataBlob{Data: []byte("first")}),
+			newTestStateItem(t, stateKindBufferedEvents, "1", encodeInt64Key(1), &persistence.DataBlob{Data: []byte("second")}),
+			newTestStateItem(t, stateKindChild, "2", encodeInt64Key(2), &persistence.InternalChildExecutionInfo{InitiatedID: 2}),
+			newTestStateItem(t, stateKindRequestCancel, "3", encodeInt64Key(3), &persistence.RequestCancelInfo{InitiatedID: 3}),
+			newTestStateItem(t, stateKindSignal, "4", encodeInt64Key(4), &persistence.SignalInfo{InitiatedID: 4}),
+			newTestStateItem(t, stateKindSignalRequested, "signal-request", "signal-request", "signal-request"),
+			newTestStateItem(t, stateKindTimer, "timer", "timer", &persistence.TimerInfo{TimerID: "timer"}),
+		},
+	}
+	db := &ddb{client: client}
+	item, err := db.newWorkflowExecutionItem(0, testDomainID, testWorkflowID, testRunID,
+		newWorkflowExecutionData(newTestUpdateRequest(nosqlplugin.WorkflowExecutionMapsWriteModeUpdate)))
+	require.NoError(t, err)
+	execution, err := toWorkflowExecution(item)
+	require.NoError(t, err)
+
+	require.NoError(t, db.selectWorkflowState(context.Background(), 0, testDomainID, testWorkflowID, testRunID, "", execution))
+	assert.Equal(t, int64(10), execution.ExecutionInfo.NextEventID)
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}}, execution.ActivityInfos)
+	assert.Equal(t, map[string]*persistence.TimerInfo{"timer": {TimerID: "timer"}}, execution.TimerInfos)
+	assert.Equal(t, map[int64]*persistence.InternalChildExecutionInfo{2: {InitiatedID: 2}}, execution.ChildExecutionInfos)
+	assert.Equal(t, map[int64]*persistence.RequestCancelInfo{3: {InitiatedID: 3}}, execution.RequestCancelInfos)
+	assert.Equal(t, map[int64]*persistence.SignalInfo{4: {InitiatedID: 4}}, execution.SignalInfos)
+	assert.Equal(t, map[string]struct{}{"signal-request": {}}, execution.SignalRequestedIDs)
+	assert.Equal(t, []*persistence.DataBlob{{Data: []byte("first")}, {Data: []byte("second")}}, execution.BufferedEvents)
+
+	// the state items are filtered by IDs, as the row key prefix may match other workflows
+	require.Len(t, client.queries, 1)
+	assert.Equal(t, "#domainID = :domainID AND #workflowID = :workflowID AND #runID = :runID", *client.queries[0].FilterExpression)
+}
+
+func newTestStateWriter(t *testing.T, numActivities int) *workflowStateWriter {
+	db := &ddb{}
+	w := newWorkflowStateWriter(0, testDomainID, testWorkflowID, testRunID)
+	for i := 0; i < numActivities; i++ {
+		require.NoError(t, w.put(stateKindActivity, strconv.Itoa(i), encodeInt64Key(int64(i)), &persistence.InternalActivityInfo{ScheduleID: int64(i)}))
+	}
+	w.delete(workflowStateKey(0, testDomainID, testWorkflowID, testRunID, stateKindTimer, "timer"))
+	item, err := db.newWorkflowExecutionItem(0, testDomainID, testWorkflowID, testRunID,
+		newWorkflowExecutionData(newTestUpdateRequest(nosqlplugin.WorkflowExecutionMapsWriteModeUpdate)))
+	require.NoError(t, err)
+	w.execution = item
+	return w
+}
+
+func TestAddWorkflowStates_Fit(t *testing.T) {
+	db := &ddb{}
+	state := newTestStateWriter(t, 10)
+	txn, overflow := &transaction{}, &transaction{}
+
+	assert.False(t, db.addWorkflowStates(txn, overflow, []*workflowStateWriter{state}))
+	puts, deletes := writtenStateItems(txn)
+	assert.Len(t, puts, 10)
+	assert.Equal(t, []string{stateRowKey(stateKindTimer, "timer")}, deletes)
+	assert.Empty(t, overflow.items)
+	assert.False(t, hasAttr(state.execution, attrStagedWriteID))
+}
+
+func TestAddWorkflowStates_Staged(t *testing.T) {
+	db := &ddb{}
+	state := newTestStateWriter(t, 120)
+	txn, overflow := &transaction{}, &transaction{}
+
+	assert.True(t, db.addWorkflowStates(txn, overflow, []*workflowStateWriter{state}))
+	assert.Empty(t, txn.items)
+	require.Len(t, overflow.items, 121)
+	writeID := getStringAttr(state.execution, attrStagedWriteID)
+	require.NotEmpty(t, writeID)
+
+	// every put and delete is staged by a put of a staged item of the write
+	stagedRowKeys := map[string]attributeMap{}
+	for _, item := range overflow.items {
+		require.NotNil(t, item.Put)
+		assert.Equal(t, stateKindStaged, getStringAttr(item.Put.Item, attrRowType))
+		assert.Equal(t, writeID, getStringAttr(item.Put.Item, attrStagedWriteID))
+		stagedRowKeys[getStringAttr(item.Put.Item, attrStagedRowKey)] = item.Put.Item
+	}
+	assert.Len(t, stagedRowKeys, 121)
+	deleted, ok := stagedRowKeys[stateRowKey(stateKindTimer, "timer")]
+	require.True(t, ok)
+	assert.True(t, hasAttr(deleted, attrStagedDelete))
+	put, ok := stagedRowKeys[stateRowKey(stateKindActivity, encodeInt64Key(7))]
+	require.True(t, ok)
+	unstaged, isDelete := unstage(put)
+	assert.False(t, isDelete)
+	assert.Equal(t, state.puts[stateRowKey(stateKindActivity, encodeInt64Key(7))], unstaged)
+}
+
+func newTestStagedItem(t *testing.T, writeID string, item attributeMap, isDelete bool) attributeMap {
+	w := newWorkflowStateWriter(0, testDomainID, testWorkflowID, testRunID)
+	w.execution = attributeMap{}
+	if isDelete {
+		w.delete(item)
+	} else {
+		w.puts[getStringAttr(item, attrRowKey)] = item
+	}
+	overflow := &transaction{}
+	w.stage(overflow, "", writeID)
+	require.Len(t, overflow.items, 1)
+	return overflow.items[0].Put.Item
+}
+
+func TestSelectWorkflowState_StagedItems(t *testing.T) {
+	activity1 := newTestStateItem(t, stateKindActivity, "1", encodeInt64Key(1), &persistence.InternalActivityInfo{ScheduleID: 1})
+	activity2 := newTestStateItem(t, stateKindActivity, "2", encodeInt64Key(2), &persistence.InternalActivityInfo{ScheduleID: 2})
+	client := &fakeQueryClient{
+		items: []attributeMap{
+			activity1,
+			activity2,
+			newTestStagedItem(t, "committed", newTestStateItem(t, stateKindActivity, "1", encodeInt64Key(1),
+				&persistence.InternalActivityInfo{ScheduleID: 1, Attempt: 3}), false),
+			newTestStagedItem(t, "committed", activity2, true),
+			newTestStagedItem(t, "committed", newTestStateItem(t, stateKindBufferedEvents, "0", encodeInt64Key(0),
+				&persistence.DataBlob{Data: []byte("first")}), false),
+			// staged items of a failed write are ignored
+			newTestStagedItem(t, "failed", newTestStateItem(t, stateKindActivity, "3", encodeInt64Key(3),
+				&persistence.InternalActivityInfo{ScheduleID: 3}), false),
+			newTestStateItem(t, stateKindBufferedEvents, "1", encodeInt64Key(1), &persistence.DataBlob{Data: []byte("second")}),
+		},
+	}
+	db := &ddb{client: client}
+
+	execution := &nosqlplugin.WorkflowExecution{}
+	require.NoError(t, db.selectWorkflowState(context.Background(), 0, testDomainID, testWorkflowID, testRunID, "committed", execution))
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1, Attempt: 3}}, execution.ActivityInfos)
+	assert.Equal(t, []*persistence.DataBlob{{Data: []byte("first")}, {Data: []byte("second")}}, execution.BufferedEvents)
+
+	// without a committed write, all the staged items are ignored
+	execution = &nosqlplugin.WorkflowExecution{}
+	require.NoError(t, db.selectWorkflowState(context.Background(), 0, testDomainID, testWorkflowID, testRunID, "", execution))
+	assert.Equal(t, map[int64]*persistence.InternalActivityInfo{1: {ScheduleID: 1}, 2: {ScheduleID: 2}}, execution.ActivityInfos)
+	assert.Equal(t, []*persistence.DataBlob{{Data: []byte("second")}}, execution.BufferedEvents)
+}
+
+// fakeStagedStateClient serves the execution item and the staged items of an execution
+type fakeStagedStateClient struct {
+	fakeTransactClient
+
+	execution   attributeMap
+	stagedItems []attributeMap
+	deleted     []attributeMap
+	updates     []*dynamodb.UpdateItemInput
+}
+
+func (c *fakeStagedStateClient) GetItemWithContext(
+	_ aws.Context,
+	_ *dynamodb.GetItemInput,
+	_ ...request.Option,
+) (*dynamodb.GetItemOutput, error) {
+	return &dynamodb.GetItemOutput{Item: c.execution}, nil
+}
+
+func (c *fakeStagedStateClient) QueryWithContext(
+	_ aws.Context,
+	_ *dynamodb.QueryInput,
+	_ ...request.Option,
+) (*dynamodb.QueryOutput, error) {
+	return &dynamodb.QueryOutput{Items: c.stagedItems}, nil
+}
+
+func (c *fakeStagedStateClient) BatchWriteItemWithContext(
+	_ aws.Context,
+	input *dynamodb.BatchWriteItemInput,
+	_ ...request.Option,
+) (*dynamodb.BatchWriteItemOutput, error) {
+	for _, requests := range input.RequestItems {
+		for _, r := range requests {
+			c.deleted = append(c.deleted, r.DeleteRequest.Key)
+		}
+	}
+	return &dynamodb.BatchWriteItemOutput{}, nil
+}
+
+func (c *fakeStagedStateClient) UpdateItemWithContext(
+	_ aws.Context,
+	input *dynamodb.UpdateItemInput,
+	_ ...request.Option,
+) (*dynamodb.UpdateItemOutput, error) {
+	c.updates = append(c.updates, input)
+	return &dynamodb.UpdateItemOutput{}, nil
+}
+
+func TestApplyPendingWorkflowStates(t *testing.T) {
+	activity := newTestStateItem(t, stateKindActivity, "1", encodeInt64Key(1), &persistence.InternalActivityInfo{ScheduleID: 1})
+	timer := workflowStateKey(0, testDomainID, testWorkflowID, testRunID, stateKindTimer, "timer")
+	execution := workflowExecutionKey(0, testDomainID, testWorkflowID, testRunID)
+	execution[attrDomainID] = stringAttr(testDomainID)
+	execution[attrWorkflowID] = stringAttr(testWorkflowID)
+	execution[attrRunID] = stringAttr(testRunID)
+	execution[attrNextEventID] = int64Attr(5)
+	execution[attrStagedWriteID] = stringAttr("committed")
+	client := &fakeStagedStateClient{
+		fakeTransactClient: fakeTransactClient{failAt: -1},
+		execution:          execution,
+		stagedItems: []attributeMap{
+			newTestStagedItem(t, "committed", activity, false),
+			newTestStagedItem(t, "committed", timer, true),
+		},
+	}
+	db := &ddb{client: client}
+	shardCondition := &nosqlplugin.ShardCondition{ShardID: 0, RangeID: 1}
+	request := newTestUpdateRequest(nosqlplugin.WorkflowExecutionMapsWriteModeUpdate)
+
+	// the update is not retried if it failed on another condition
+	pending, err := db.applyPendingWorkflowStates(context.Background(), []*transactConditionFailure{
+		{itemType: transactItemShard, previous: shardKey(0)},
+	}, shardCondition, request)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	stale := attributeMap{}
+	for k, v := range execution {
+		stale[k] = v
+	}
+	stale[attrNextEventID] = int64Attr(6)
+	pending, err = db.applyPendingWorkflowStates(context.Background(), []*transactConditionFailure{
+		{itemType: transactItemWorkflowExecution, previous: stale},
+	}, shardCondition, request)
+	require.NoError(t, err)
+	assert.False(t, pending)
+	assert.Empty(t, client.transactions)
+
+	pending, err = db.applyPendingWorkflowStates(context.Background(), []*transactConditionFailure{
+		{itemType: transactItemWorkflowExecution, previous: execution},
+	}, shardCondition, request)
+	require.NoError(t, err)
+	assert.True(t, pending)
+
+	// the staged items are applied in a transaction guarded by the shard condition
+	require.Len(t, client.transactions, 1)
+	applied := client.transactions[0]
+	require.Len(t, applied, 3)
+	assert.NotNil(t, applied[0].ConditionCheck)
+	for _, item := range applied[1:] {
+		if item.Put != nil {
+			assert.Equal(t, activity, item.Put.Item)
+		} else {
+			assert.Equal(t, timer, item.Delete.Key)
+		}
+	}
+	assert.Len(t, client.deleted, 2)
+	require.Len(t, client.updates, 1)
+	assert.Equal(t, "REMOVE #stagedWriteID", *client.updates[0].UpdateExpression)
+	assert.Equal(t, "committed", *client.updates[0].ExpressionAttributeValues[":stagedWriteID"].S)
+}
@@ -22,17 +22,101 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+var _ nosqlplugin.DomainCRUD = (*mdb)(nil)
+
+func newDomainEntry(row *nosqlplugin.DomainRow) (*cadence.DomainCollectionEntry, error) {
+	data, err := marshalData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.DomainCollectionEntry{
+		ID:                  row.Info.ID,
+		Name:                row.Info.Name,
+		NotificationVersion: row.NotificationVersion,
+		Data:                data,
+	}, nil
+}
+
+func toDomainRow(doc *cadence.DomainCollectionEntry) (*nosqlplugin.DomainRow, error) {
+	row := &nosqlplugin.DomainRow{}
+	if err := unmarshalData(doc.Data, row); err != nil {
+		return nil, err
+	}
+	row.NotificationVersion = doc.NotificationVersion
+	return row, nil
+}
+
 // Insert a new record to domain, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	collection := db.dbConn.Collection(cadence.DomainCollectionName)
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		count, err := collection.CountDocuments(sc, bson.D{{"_id", row.Info.ID}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return fmt.Errorf("CreateDomain operation failed because of uuid collision")
+		}
+		count, err = collection.CountDocuments(sc, bson.D{{"name", row.Info.Name}})
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			db.logger.Warn("Domain already exists", tag.WorkflowDomainName(row.Info.Name))
+			return &types.DomainAlreadyExistsError{
+				Message: fmt.Sprintf("Domain %v already exists", row.Info.Name),
+			}
+		}
+
+		metadataNotificationVersion, err := db.SelectDomainMetadata(sc)
+		if err != nil {
+			return err
+		}
+		newRow := *row
+		newRow.NotificationVersion = metadataNotificationVersion
+		doc, err := newDomainEntry(&newRow)
+		if err != nil {
+			return err
+		}
+		_, err = collection.InsertOne(sc, doc)
+		if err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sc, metadataNotificationVersion)
+	})
+}
+
+// updateDomainMetadata bumps the notification version of domain metadata, on the condition of current version.
+// The document is created by the first update, so it's an upsert. If the version doesn't match an existing
+// document, the upsert tries to insert another document with the same _id, which fails with a duplicate key error
+func (db *mdb) updateDomainMetadata(sc mongo.SessionContext, notificationVersion int64) error {
+	_, err := db.dbConn.Collection(cadence.DomainMetadataCollectionName).UpdateOne(
+		sc,
+		bson.D{{"_id", cadence.DomainMetadataDocumentID}, {"notificationversion", notificationVersion}},
+		bson.D{{"$set", bson.D{{"notificationversion", notificationVersion + 1}}}},
+		options.Update().SetUpsert(true),
+	)
+	if mongo.IsDuplicateKeyError(err) {
+		db.logger.Warn("Domain operation failed because of condition update failure on domain metadata record")
+		return nosqlplugin.NewConditionFailure("domain")
+	}
+	return err
 }
 
 // Update domain
@@ -40,7 +124,17 @@ func (db *mdb) UpdateDomain(
 	ctx context.Context,
 	row *nosqlplugin.DomainRow,
 ) error {
-	panic("TODO")
+	doc, err := newDomainEntry(row)
+	if err != nil {
+		return err
+	}
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		_, err := db.dbConn.Collection(cadence.DomainCollectionName).ReplaceOne(sc, bson.D{{"_id", row.Info.ID}}, doc)
+		if err != nil {
+			return err
+		}
+		return db.updateDomainMetadata(sc, row.NotificationVersion)
+	})
 }
 
 // Get one domain data, either by domainID or domainName
@@ -49,7 +143,23 @@ func (db *mdb) SelectDomain(
 	domainID *string,
 	domainName *string,
 ) (*nosqlplugin.DomainRow, error) {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil && domainName != nil {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name specified in request")
+	} else if domainID != nil {
+		filter = bson.D{{"_id", *domainID}}
+	} else if domainName != nil {
+		filter = bson.D{{"name", *domainName}}
+	} else {
+		return nil, fmt.Errorf("GetDomain operation failed.  Both ID and Name are empty")
+	}
+
+	var doc cadence.DomainCollectionEntry
+	err := db.dbConn.Collection(cadence.DomainCollectionName).FindOne(ctx, filter).Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRow(&doc)
 }
 
 // Get all domain data
@@ -58,20 +168,68 @@ func (db *mdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	panic("TODO")
+	token, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := bson.D{}
+	if token != nil {
+		filter = bson.D{{"_id", bson.D{{"$gt", token.LastStringID}}}}
+	}
+	var docs []*cadence.DomainCollectionEntry
+	err = db.findPage(ctx, cadence.DomainCollectionName, filter, bson.D{{"_id", 1}}, pageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.DomainRow, 0, len(docs))
+	for _, doc := range docs {
+		row, err := toDomainRow(doc)
+		if err != nil {
+			return nil, nil, err
+		}
+		rows = append(rows, row)
+	}
+	nextToken, err := toNextPageToken(len(docs), pageSize, func() *pageCursor {
+		return &pageCursor{LastStringID: docs[len(docs)-1].ID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, nextToken, nil
 }
 
-//  Delete a domain, either by domainID or domainName
+// Delete a domain, either by domainID or domainName
 func (db *mdb) DeleteDomain(
 	ctx context.Context,
 	domainID *string,
 	domainName *string,
 ) error {
-	panic("TODO")
+	var filter bson.D
+	if domainID != nil {
+		filter = bson.D{{"_id", *domainID}}
+	} else if domainName != nil {
+		filter = bson.D{{"name", *domainName}}
+	} else {
+		return fmt.Errorf("must provide either domainID or domainName")
+	}
+	_, err := db.dbConn.Collection(cadence.DomainCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) SelectDomainMetadata(
 	ctx context.Context,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.DomainMetadataCollectionEntry
+	err := db.dbConn.Collection(cadence.DomainMetadataCollectionName).
+		FindOne(ctx, bson.D{{"_id", cadence.DomainMetadataDocumentID}}).
+		Decode(&doc)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			// the metadata document doesn't exist until the first domain is created
+			return 0, nil
+		}
+		return -1, err
+	}
+	return doc.NotificationVersion, nil
 }
@@ -22,31 +22,227 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"sort"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+var _ nosqlplugin.HistoryEventsCRUD = (*mdb)(nil)
+
+// historyTreeDocument is a history_tree document with the _id, for paginating the scan of all branches
+type historyTreeDocument struct {
+	ObjectID                           primitive.ObjectID `bson:"_id"`
+	cadence.HistoryTreeCollectionEntry `bson:",inline"`
+}
+
+func historyTreeFilter(treeID, branchID string) bson.D {
+	return bson.D{{"treeid", treeID}, {"branchid", branchID}}
+}
+
 // InsertIntoHistoryTreeAndNode inserts one or two rows: tree row and node row(at least one of them)
 func (db *mdb) InsertIntoHistoryTreeAndNode(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow, nodeRow *nosqlplugin.HistoryNodeRow) error {
-	panic("TODO")
+	if treeRow == nil && nodeRow == nil {
+		return fmt.Errorf("require at least a tree row or a node row to insert")
+	}
+	if treeRow != nil && nodeRow != nil {
+		return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+			if err := db.insertHistoryTree(sc, treeRow); err != nil {
+				return err
+			}
+			return db.insertHistoryNode(sc, nodeRow)
+		})
+	}
+	// for perf, a single document doesn't need a transaction
+	if treeRow != nil {
+		return db.insertHistoryTree(ctx, treeRow)
+	}
+	return db.insertHistoryNode(ctx, nodeRow)
+}
+
+// insertHistoryTree writes a branch record, the existing one is overwritten same as Cassandra
+func (db *mdb) insertHistoryTree(ctx context.Context, treeRow *nosqlplugin.HistoryTreeRow) error {
+	data, err := marshalData(treeRow)
+	if err != nil {
+		return err
+	}
+	filter := historyTreeFilter(treeRow.TreeID, treeRow.BranchID)
+	_, err = db.dbConn.Collection(cadence.HistoryTreeCollectionName).ReplaceOne(
+		ctx,
+		filter,
+		&cadence.HistoryTreeCollectionEntry{
+			TreeID:   treeRow.TreeID,
+			BranchID: treeRow.BranchID,
+			Data:     data,
+		},
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
+// insertHistoryNode writes a node, the existing one is overwritten same as Cassandra
+func (db *mdb) insertHistoryNode(ctx context.Context, nodeRow *nosqlplugin.HistoryNodeRow) error {
+	var txnID int64
+	if nodeRow.TxnID != nil {
+		txnID = *nodeRow.TxnID
+	}
+	filter := append(historyTreeFilter(nodeRow.TreeID, nodeRow.BranchID),
+		bson.E{"nodeid", nodeRow.NodeID},
+		bson.E{"txnid", txnID},
+	)
+	_, err := db.dbConn.Collection(cadence.HistoryNodeCollectionName).ReplaceOne(
+		ctx,
+		filter,
+		&cadence.HistoryNodeCollectionEntry{
+			TreeID:       nodeRow.TreeID,
+			BranchID:     nodeRow.BranchID,
+			NodeID:       nodeRow.NodeID,
+			TxnID:        txnID,
+			Data:         nodeRow.Data,
+			DataEncoding: nodeRow.DataEncoding,
+		},
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 // SelectFromHistoryNode read nodes based on a filter
 func (db *mdb) SelectFromHistoryNode(ctx context.Context, filter *nosqlplugin.HistoryNodeFilter) ([]*nosqlplugin.HistoryNodeRow, []byte, error) {
-	panic("TODO")
+	token, err := deserializePageToken(filter.NextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	query := append(historyTreeFilter(filter.TreeID, filter.BranchID),
+		bson.E{"nodeid", bson.D{{"$gte", filter.MinNodeID}, {"$lt", filter.MaxNodeID}}},
+	)
+	if token != nil {
+		// nodes are ordered by nodeID ASC and txnID DESC
+		query = append(query, bson.E{"$or", bson.A{
+			bson.D{{"nodeid", bson.D{{"$gt", token.LastID}}}},
+			bson.D{{"nodeid", token.LastID}, {"txnid", bson.D{{"$lt", token.LastSubID}}}},
+		}})
+	}
+	var docs []*cadence.HistoryNodeCollectionEntry
+	err = db.findPage(ctx, cadence.HistoryNodeCollectionName, query, bson.D{{"nodeid", 1}, {"txnid", -1}}, filter.PageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryNodeRow, 0, len(docs))
+	for _, doc := range docs {
+		txnID := doc.TxnID
+		rows = append(rows, &nosqlplugin.HistoryNodeRow{
+			TreeID:       filter.TreeID,
+			BranchID:     filter.BranchID,
+			NodeID:       doc.NodeID,
+			TxnID:        &txnID,
+			Data:         doc.Data,
+			DataEncoding: doc.DataEncoding,
+		})
+	}
+	nextToken, err := toNextPageToken(len(docs), filter.PageSize, func() *pageCursor {
+		last := docs[len(docs)-1]
+		return &pageCursor{LastID: last.NodeID, LastSubID: last.TxnID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, nextToken, nil
 }
 
 // DeleteFromHistoryTreeAndNode delete a branch record, and a list of ranges of nodes.
 func (db *mdb) DeleteFromHistoryTreeAndNode(ctx context.Context, treeFilter *nosqlplugin.HistoryTreeFilter, nodeFilters []*nosqlplugin.HistoryNodeFilter) error {
-	panic("TODO")
+	if treeFilter.BranchID == nil {
+		return fmt.Errorf("require a branchID to delete a branch record")
+	}
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		_, err := db.dbConn.Collection(cadence.HistoryTreeCollectionName).
+			DeleteOne(sc, historyTreeFilter(treeFilter.TreeID, *treeFilter.BranchID))
+		if err != nil {
+			return err
+		}
+		for _, nodeFilter := range nodeFilters {
+			_, err := db.dbConn.Collection(cadence.HistoryNodeCollectionName).DeleteMany(sc, append(
+				historyTreeFilter(nodeFilter.TreeID, nodeFilter.BranchID),
+				bson.E{"nodeid", bson.D{{"$gte", nodeFilter.MinNodeID}}},
+			))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 // SelectAllHistoryTrees will return all tree branches with pagination
 func (db *mdb) SelectAllHistoryTrees(ctx context.Context, nextPageToken []byte, pageSize int) ([]*nosqlplugin.HistoryTreeRow, []byte, error) {
-	panic("TODO")
+	token, err := deserializePageToken(nextPageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := bson.D{}
+	if token != nil {
+		filter = bson.D{{"_id", bson.D{{"$gt", token.LastObjectID}}}}
+	}
+	var docs []*historyTreeDocument
+	err = db.findPage(ctx, cadence.HistoryTreeCollectionName, filter, bson.D{{"_id", 1}}, pageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.HistoryTreeRow{}
+		if err := unmarshalData(doc.Data, row); err != nil {
+			return nil, nil, err
+		}
+		// same as Cassandra, ancestors are not returned when scanning all the branches
+		row.Ancestors = nil
+		rows = append(rows, row)
+	}
+	next, err := toNextPageToken(len(docs), pageSize, func() *pageCursor {
+		return &pageCursor{LastObjectID: docs[len(docs)-1].ObjectID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, next, nil
 }
 
 // SelectFromHistoryTree read branch records for a tree
 func (db *mdb) SelectFromHistoryTree(ctx context.Context, filter *nosqlplugin.HistoryTreeFilter) ([]*nosqlplugin.HistoryTreeRow, error) {
-	panic("TODO")
+	var docs []*cadence.HistoryTreeCollectionEntry
+	err := db.findPage(ctx, cadence.HistoryTreeCollectionName, bson.D{{"treeid", filter.TreeID}}, bson.D{{"branchid", 1}}, 0, &docs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*nosqlplugin.HistoryTreeRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.HistoryTreeRow{}
+		if err := unmarshalData(doc.Data, row); err != nil {
+			return nil, err
+		}
+		ancestors := row.Ancestors
+		if len(ancestors) > 0 {
+			// sort ancestors based on EndNodeID so that we can set BeginNodeID
+			sort.Slice(ancestors, func(i, j int) bool { return ancestors[i].EndNodeID < ancestors[j].EndNodeID })
+			ancestors[0].BeginNodeID = int64(1)
+			for i := 1; i < len(ancestors); i++ {
+				ancestors[i].BeginNodeID = ancestors[i-1].EndNodeID
+			}
+		}
+		rows = append(rows, &nosqlplugin.HistoryTreeRow{
+			TreeID:    filter.TreeID,
+			BranchID:  doc.BranchID,
+			Ancestors: ancestors,
+		})
+	}
+	return rows, nil
 }
@@ -23,6 +23,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -57,7 +58,7 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger) (nosqlplugi
 }
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error) {
-	uri := fmt.Sprintf("mongodb://%v:%v@%v:%v/", cfg.User, cfg.Password, cfg.Hosts, cfg.Port)
+	uri := buildURI(cfg)
 	// TODO CreateDB/CreateAdminDB don't pass in context.Context so we are using background for now
 	// It's okay because this is being called during server startup or CLI.
 	client, err := mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
@@ -75,3 +76,21 @@ func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*mdb, error)
 		logger: logger,
 	}, err
 }
+
+// buildURI returns the connection string of the config. ConnectAttributes are passed as the options of the connection
+// string, e.g. replicaSet or directConnection. Note that a replica set is required for the multi-document transactions
+func buildURI(cfg *config.NoSQL) string {
+	credential := ""
+	if cfg.User != "" {
+		credential = fmt.Sprintf("%v:%v@", url.QueryEscape(cfg.User), url.QueryEscape(cfg.Password))
+	}
+	uri := fmt.Sprintf("mongodb://%v%v:%v/", credential, cfg.Hosts, cfg.Port)
+	if len(cfg.ConnectAttributes) > 0 {
+		query := url.Values{}
+		for k, v := range cfg.ConnectAttributes {
+			query.Set(k, v)
+		}
+		uri += "?" + query.Encode()
+	}
+	return uri
+}
@@ -22,19 +22,41 @@ package mongodb
 
 import (
 	"context"
-	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
-//Insert message into queue, return error if failed or already exists
+var _ nosqlplugin.MessageQueueCRUD = (*mdb)(nil)
+
+// messagesFilter returns the filter of messages of the queue that exclusiveBeginMessageID < messageid <= inclusiveEndMessageID
+func messagesFilter(queueType persistence.QueueType, exclusiveBeginMessageID, inclusiveEndMessageID int64) bson.D {
+	return bson.D{
+		{"queuetype", int(queueType)},
+		{"messageid", bson.D{{"$gt", exclusiveBeginMessageID}, {"$lte", inclusiveEndMessageID}}},
+	}
+}
+
+// Insert message into queue, return error if failed or already exists
 // Return ConditionFailure if the condition doesn't meet
 func (db *mdb) InsertIntoQueue(
 	ctx context.Context,
 	row *nosqlplugin.QueueMessageRow,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).InsertOne(ctx, &cadence.QueueMessageCollectionEntry{
+		QueueType: int(row.QueueType),
+		MessageID: row.ID,
+		Payload:   row.Payload,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return err
 }
 
 // Get the ID of last message inserted into the queue
@@ -42,7 +64,16 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	var doc cadence.QueueMessageCollectionEntry
+	err := db.dbConn.Collection(cadence.QueueMessageCollectionName).FindOne(
+		ctx,
+		bson.D{{"queuetype", int(queueType)}},
+		options.FindOne().SetSort(bson.D{{"messageid", -1}}).SetProjection(bson.D{{"payload", 0}}),
+	).Decode(&doc)
+	if err != nil {
+		return 0, err
+	}
+	return doc.MessageID, nil
 }
 
 // Read queue messages starting from the exclusiveBeginMessageID
@@ -52,7 +83,24 @@ func (db *mdb) SelectMessagesFrom(
 	exclusiveBeginMessageID int64,
 	maxRows int,
 ) ([]*nosqlplugin.QueueMessageRow, error) {
-	panic("TODO")
+	filter := bson.D{
+		{"queuetype", int(queueType)},
+		{"messageid", bson.D{{"$gt", exclusiveBeginMessageID}}},
+	}
+	var docs []*cadence.QueueMessageCollectionEntry
+	err := db.findPage(ctx, cadence.QueueMessageCollectionName, filter, bson.D{{"messageid", 1}}, maxRows, &docs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*nosqlplugin.QueueMessageRow, 0, len(docs))
+	for _, doc := range docs {
+		result = append(result, &nosqlplugin.QueueMessageRow{
+			QueueType: queueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	return result, nil
 }
 
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
@@ -60,7 +108,38 @@ func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
 	request nosqlplugin.SelectMessagesBetweenRequest,
 ) (*nosqlplugin.SelectMessagesBetweenResponse, error) {
-	panic("TODO")
+	token, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	exclusiveBeginMessageID := request.ExclusiveBeginMessageID
+	if token != nil && token.LastID > exclusiveBeginMessageID {
+		exclusiveBeginMessageID = token.LastID
+	}
+	filter := messagesFilter(request.QueueType, exclusiveBeginMessageID, request.InclusiveEndMessageID)
+	var docs []*cadence.QueueMessageCollectionEntry
+	err = db.findPage(ctx, cadence.QueueMessageCollectionName, filter, bson.D{{"messageid", 1}}, request.PageSize, &docs)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]nosqlplugin.QueueMessageRow, 0, len(docs))
+	for _, doc := range docs {
+		rows = append(rows, nosqlplugin.QueueMessageRow{
+			QueueType: request.QueueType,
+			ID:        doc.MessageID,
+			Payload:   doc.Payload,
+		})
+	}
+	nextToken, err := toNextPageToken(len(docs), request.PageSize, func() *pageCursor {
+		return &pageCursor{LastID: docs[len(docs)-1].MessageID}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.SelectMessagesBetweenResponse{
+		Rows:          rows,
+		NextPageToken: nextToken,
+	}, nil
 }
 
 // Delete all messages before exclusiveBeginMessageID
@@ -69,7 +148,11 @@ func (db *mdb) DeleteMessagesBefore(
 	queueType persistence.QueueType,
 	exclusiveBeginMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).DeleteMany(ctx, bson.D{
+		{"queuetype", int(queueType)},
+		{"messageid", bson.D{{"$lt", exclusiveBeginMessageID}}},
+	})
+	return err
 }
 
 // Delete all messages in a range between exclusiveBeginMessageID and inclusiveEndMessageID
@@ -79,7 +162,9 @@ func (db *mdb) DeleteMessagesInRange(
 	exclusiveBeginMessageID int64,
 	inclusiveEndMessageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).
+		DeleteMany(ctx, messagesFilter(queueType, exclusiveBeginMessageID, inclusiveEndMessageID))
+	return err
 }
 
 // Delete one message
@@ -88,7 +173,9 @@ func (db *mdb) DeleteMessage(
 	queueType persistence.QueueType,
 	messageID int64,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.QueueMessageCollectionName).
+		DeleteOne(ctx, bson.D{{"queuetype", int(queueType)}, {"messageid", messageID}})
+	return err
 }
 
 // Insert an empty metadata row, starting from a version
@@ -97,8 +184,16 @@ func (db *mdb) InsertQueueMetadata(
 	queueType persistence.QueueType,
 	version int64,
 ) error {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil
+	_, err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).InsertOne(ctx, &cadence.QueueMetadataCollectionEntry{
+		QueueType:        int(queueType),
+		Version:          version,
+		ClusterAckLevels: map[string]int64{},
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		// it's ok if the document is not inserted, which means that the record exists already.
+		return nil
+	}
+	return err
 }
 
 // **Conditionally** update a queue metadata row, if current version is matched(meaning current == row.Version - 1),
@@ -108,7 +203,22 @@ func (db *mdb) UpdateQueueMetadataCas(
 	ctx context.Context,
 	row nosqlplugin.QueueMetadataRow,
 ) error {
-	panic("TODO")
+	result, err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).ReplaceOne(
+		ctx,
+		bson.D{{"_id", int(row.QueueType)}, {"version", row.Version - 1}},
+		&cadence.QueueMetadataCollectionEntry{
+			QueueType:        int(row.QueueType),
+			Version:          row.Version,
+			ClusterAckLevels: row.ClusterAckLevels,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return nosqlplugin.NewConditionFailure("queue")
+	}
+	return nil
 }
 
 // Read a QueueMetadata
@@ -116,13 +226,30 @@ func (db *mdb) SelectQueueMetadata(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (*nosqlplugin.QueueMetadataRow, error) {
-	fmt.Println("not implemented, ignore the eror for testing")
-	return nil, nil
+	var doc cadence.QueueMetadataCollectionEntry
+	err := db.dbConn.Collection(cadence.QueueMetadataCollectionName).
+		FindOne(ctx, bson.D{{"_id", int(queueType)}}).
+		Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// if record exist but ackLevels is empty, we initialize the map
+	ackLevels := doc.ClusterAckLevels
+	if ackLevels == nil {
+		ackLevels = make(map[string]int64)
+	}
+	return &nosqlplugin.QueueMetadataRow{
+		QueueType:        queueType,
+		ClusterAckLevels: ackLevels,
+		Version:          doc.Version,
+	}, nil
 }
 
 func (db *mdb) GetQueueSize(
 	ctx context.Context,
 	queueType persistence.QueueType,
 ) (int64, error) {
-	panic("TODO")
+	return db.dbConn.Collection(cadence.QueueMessageCollectionName).
+		CountDocuments(ctx, bson.D{{"queuetype", int(queueType)}})
 }
@@ -22,32 +22,129 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
-	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+var _ nosqlplugin.ShardCRUD = (*mdb)(nil)
+
 // InsertShard creates a new shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error {
-	log.Warn("not implemented...ignore the error for testing...")
-	return nil
+	doc, err := newShardEntry(row)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.ShardCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return err
 }
 
 // SelectShard gets a shard
 func (db *mdb) SelectShard(ctx context.Context, shardID int, currentClusterName string) (int64, *nosqlplugin.ShardRow, error) {
-	panic("TODO")
+	var doc cadence.ShardCollectionEntry
+	err := db.dbConn.Collection(cadence.ShardCollectionName).FindOne(ctx, bson.D{{"_id", shardID}}).Decode(&doc)
+	if err != nil {
+		return 0, nil, err
+	}
+	info := &nosqlplugin.ShardRow{}
+	if err := unmarshalData(doc.Data, info); err != nil {
+		return 0, nil, err
+	}
+	info.RangeID = doc.RangeID
+
+	if info.ClusterTransferAckLevel == nil {
+		info.ClusterTransferAckLevel = map[string]int64{
+			currentClusterName: info.TransferAckLevel,
+		}
+	}
+	if info.ClusterTimerAckLevel == nil {
+		info.ClusterTimerAckLevel = map[string]time.Time{
+			currentClusterName: info.TimerAckLevel,
+		}
+	}
+	if info.ClusterReplicationLevel == nil {
+		info.ClusterReplicationLevel = make(map[string]int64)
+	}
+	if info.ReplicationDLQAckLevel == nil {
+		info.ReplicationDLQAckLevel = make(map[string]int64)
+	}
+	return doc.RangeID, info, nil
 }
 
 // UpdateRangeID updates the rangeID, return error is there is any
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateRangeID(ctx context.Context, shardID int, rangeID int64, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.dbConn.Collection(cadence.ShardCollectionName).UpdateOne(
+		ctx,
+		bson.D{{"_id", shardID}, {"rangeid", previousRangeID}},
+		bson.D{
+			{"$set", bson.D{{"rangeid", rangeID}}},
+			{"$inc", bson.D{{"writeversion", 1}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedShardError(ctx, shardID)
+	}
+	return nil
 }
 
 // UpdateShard updates a shard, return error is there is any.
 // Return ShardOperationConditionFailure if the condition doesn't meet
 func (db *mdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previousRangeID int64) error {
-	panic("TODO")
+	data, err := marshalData(row)
+	if err != nil {
+		return err
+	}
+	result, err := db.dbConn.Collection(cadence.ShardCollectionName).UpdateOne(
+		ctx,
+		bson.D{{"_id", row.ShardID}, {"rangeid", previousRangeID}},
+		bson.D{
+			{"$set", bson.D{{"rangeid", row.RangeID}, {"data", data}}},
+			{"$inc", bson.D{{"writeversion", 1}}},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedShardError(ctx, row.ShardID)
+	}
+	return nil
+}
+
+func newShardEntry(row *nosqlplugin.ShardRow) (*cadence.ShardCollectionEntry, error) {
+	data, err := marshalData(row)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardCollectionEntry{
+		ShardID: row.ShardID,
+		RangeID: row.RangeID,
+		Data:    data,
+	}, nil
+}
+
+// newConflictedShardError reads the current shard after a conditional write failed, to return the current rangeID
+func (db *mdb) newConflictedShardError(ctx context.Context, shardID int) error {
+	var doc cadence.ShardCollectionEntry
+	err := db.dbConn.Collection(cadence.ShardCollectionName).FindOne(ctx, bson.D{{"_id", shardID}}).Decode(&doc)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.ShardOperationConditionFailure{
+		RangeID: doc.RangeID,
+		Details: fmt.Sprintf("shardID: %v, rangeID: %v", shardID, doc.RangeID),
+	}
 }
@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,20 +22,81 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	initialRangeID = 1 // Id of the first range of a new task list
 )
 
+var _ nosqlplugin.TaskCRUD = (*mdb)(nil)
+
+func taskListFilter(domainID, taskListName string, taskListType int) bson.D {
+	return bson.D{{"domainid", domainID}, {"tasklistname", taskListName}, {"tasklisttype", taskListType}}
+}
+
+func newTaskListEntry(row *nosqlplugin.TaskListRow, ttlSeconds int64) (*cadence.TaskListCollectionEntry, error) {
+	data, err := marshalData(row)
+	if err != nil {
+		return nil, err
+	}
+	doc := &cadence.TaskListCollectionEntry{
+		DomainID:     row.DomainID,
+		TaskListName: row.TaskListName,
+		TaskListType: row.TaskListType,
+		RangeID:      row.RangeID,
+		Data:         data,
+	}
+	if ttlSeconds > 0 {
+		expiryTime := time.Now().Add(time.Duration(ttlSeconds) * time.Second)
+		doc.ExpiryTime = &expiryTime
+	}
+	return doc, nil
+}
+
 // SelectTaskList returns a single tasklist row.
 // Return IsNotFoundError if the row doesn't exist
 func (db *mdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter) (*nosqlplugin.TaskListRow, error) {
-	panic("TODO")
+	var doc cadence.TaskListCollectionEntry
+	err := db.dbConn.Collection(cadence.TaskListCollectionName).
+		FindOne(ctx, taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType)).
+		Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	row := &nosqlplugin.TaskListRow{}
+	if err := unmarshalData(doc.Data, row); err != nil {
+		return nil, err
+	}
+	row.RangeID = doc.RangeID
+	return row, nil
 }
 
 // InsertTaskList insert a single tasklist row
-// Return IsConditionFailedError if the row already exists, and also the existing row
+// Return TaskOperationConditionFailure if the row already exists
 func (db *mdb) InsertTaskList(ctx context.Context, row *nosqlplugin.TaskListRow) error {
-	panic("TODO")
+	newRow := *row
+	newRow.RangeID = initialRangeID
+	newRow.AckLevel = 0
+	doc, err := newTaskListEntry(&newRow, 0)
+	if err != nil {
+		return err
+	}
+	_, err = db.dbConn.Collection(cadence.TaskListCollectionName).InsertOne(ctx, doc)
+	if mongo.IsDuplicateKeyError(err) {
+		return db.newConflictedTaskListError(ctx, row.DomainID, row.TaskListName, row.TaskListType)
+	}
+	return err
 }
 
 // UpdateTaskList updates a single tasklist row
@@ -45,7 +106,7 @@ func (db *mdb) UpdateTaskList(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	return db.UpdateTaskListWithTTL(ctx, 0, row, previousRangeID)
 }
 
 // UpdateTaskList updates a single tasklist row, and set an TTL on the record
@@ -58,19 +119,43 @@ func (db *mdb) UpdateTaskListWithTTL(
 	row *nosqlplugin.TaskListRow,
 	previousRangeID int64,
 ) error {
-	panic("TODO")
+	doc, err := newTaskListEntry(row, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	filter := append(taskListFilter(row.DomainID, row.TaskListName, row.TaskListType), bson.E{"rangeid", previousRangeID})
+	result, err := db.dbConn.Collection(cadence.TaskListCollectionName).ReplaceOne(ctx, filter, doc)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return db.newConflictedTaskListError(ctx, row.DomainID, row.TaskListName, row.TaskListType)
+	}
+	return nil
 }
 
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
-	panic("TODO")
+	return nil, &types.InternalServiceError{
+		Message: "unsupported operation",
+	}
 }
 
 // DeleteTaskList deletes a single tasklist row
 // Return TaskOperationConditionFailure if the condition doesn't meet
 func (db *mdb) DeleteTaskList(ctx context.Context, filter *nosqlplugin.TaskListFilter, previousRangeID int64) error {
-	panic("TODO")
+	result, err := db.dbConn.Collection(cadence.TaskListCollectionName).DeleteOne(
+		ctx,
+		append(taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType), bson.E{"rangeid", previousRangeID}),
+	)
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return db.newConflictedTaskListError(ctx, filter.DomainID, filter.TaskListName, filter.TaskListType)
+	}
+	return nil
 }
 
 // InsertTasks inserts a batch of tasks
@@ -80,12 +165,66 @@ func (db *mdb) InsertTasks(
 	tasksToInsert []*nosqlplugin.TaskRowForInsert,
 	tasklistCondition *nosqlplugin.TaskListRow,
 ) error {
-	panic("TODO")
+	now := time.Now()
+	docs := make([]interface{}, 0, len(tasksToInsert))
+	for _, task := range tasksToInsert {
+		data, err := marshalData(&task.TaskRow)
+		if err != nil {
+			return err
+		}
+		doc := &cadence.TaskCollectionEntry{
+			DomainID:     tasklistCondition.DomainID,
+			TaskListName: tasklistCondition.TaskListName,
+			TaskListType: tasklistCondition.TaskListType,
+			TaskID:       task.TaskID,
+			Data:         data,
+		}
+		if task.TTLSeconds > 0 {
+			expiryTime := now.Add(time.Duration(task.TTLSeconds) * time.Second)
+			doc.ExpiryTime = &expiryTime
+		}
+		docs = append(docs, doc)
+	}
+
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		// increasing the writeversion makes the concurrent updates of the tasklist conflict with this transaction
+		var taskList cadence.TaskListCollectionEntry
+		err := db.dbConn.Collection(cadence.TaskListCollectionName).FindOneAndUpdate(
+			sc,
+			taskListFilter(tasklistCondition.DomainID, tasklistCondition.TaskListName, tasklistCondition.TaskListType),
+			bson.D{{"$inc", bson.D{{"writeversion", 1}}}},
+			options.FindOneAndUpdate().SetProjection(bson.D{{"data", 0}}),
+		).Decode(&taskList)
+		if err != nil && !db.IsNotFoundError(err) {
+			return err
+		}
+		if err != nil || taskList.RangeID != tasklistCondition.RangeID {
+			return &nosqlplugin.TaskOperationConditionFailure{
+				RangeID: taskList.RangeID,
+				Details: fmt.Sprintf("expected rangeID: %v, actual rangeID: %v", tasklistCondition.RangeID, taskList.RangeID),
+			}
+		}
+		return db.insertMany(sc, cadence.TaskCollectionName, docs)
+	})
 }
 
 // SelectTasks return tasks that associated to a tasklist
 func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) ([]*nosqlplugin.TaskRow, error) {
-	panic("TODO")
+	var docs []*cadence.TaskCollectionEntry
+	err := db.findPage(ctx, cadence.TaskCollectionName, tasksRangeFilter(filter), bson.D{{"taskid", 1}}, filter.BatchSize, &docs)
+	if err != nil {
+		return nil, err
+	}
+	tasks := make([]*nosqlplugin.TaskRow, 0, len(docs))
+	for _, doc := range docs {
+		task := &nosqlplugin.TaskRow{}
+		if err := unmarshalData(doc.Data, task); err != nil {
+			return nil, err
+		}
+		task.TaskID = doc.TaskID
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
 }
 
 // DeleteTask delete a batch tasks that taskIDs less than the row
@@ -93,5 +232,31 @@ func (db *mdb) SelectTasks(ctx context.Context, filter *nosqlplugin.TasksFilter)
 // NOTE: This API ignores the `BatchSize` request parameter i.e. either all tasks leq the task_id will be deleted or an error will
 // be returned to the caller, because rowsDeleted is not supported by Cassandra
 func (db *mdb) RangeDeleteTasks(ctx context.Context, filter *nosqlplugin.TasksFilter) (rowsDeleted int, err error) {
-	panic("TODO")
+	_, err = db.dbConn.Collection(cadence.TaskCollectionName).DeleteMany(ctx, tasksRangeFilter(filter))
+	if err != nil {
+		return 0, err
+	}
+	return p.UnknownNumRowsAffected, nil
+}
+
+func tasksRangeFilter(filter *nosqlplugin.TasksFilter) bson.D {
+	return append(
+		taskListFilter(filter.DomainID, filter.TaskListName, filter.TaskListType),
+		bson.E{"taskid", bson.D{{"$gt", filter.MinTaskID}, {"$lte", filter.MaxTaskID}}},
+	)
+}
+
+// newConflictedTaskListError reads the current tasklist after a conditional write failed, to return the current rangeID
+func (db *mdb) newConflictedTaskListError(ctx context.Context, domainID, taskListName string, taskListType int) error {
+	var doc cadence.TaskListCollectionEntry
+	err := db.dbConn.Collection(cadence.TaskListCollectionName).
+		FindOne(ctx, taskListFilter(domainID, taskListName, taskListType)).
+		Decode(&doc)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	return &nosqlplugin.TaskOperationConditionFailure{
+		RangeID: doc.RangeID,
+		Details: fmt.Sprintf("domainID: %v, tasklist: %v, type: %v, rangeID: %v", domainID, taskListName, taskListType, doc.RangeID),
+	}
 }
@@ -0,0 +1,135 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+// marshalData serializes the non-significant fields of a record into the data field
+func marshalData(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// unmarshalData deserializes the data field of a document
+func unmarshalData(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return fmt.Errorf("document is missing data field")
+	}
+	return json.Unmarshal(data, v)
+}
+
+// doTransaction runs the function in a multi-document transaction. The function may be called more than once,
+// because the transaction is retried on transient errors like write conflicts with concurrent transactions.
+func (db *mdb) doTransaction(ctx context.Context, fn func(sc mongo.SessionContext) error) error {
+	session, err := db.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+// lockShard increases the writeversion of the shard in the transaction, so that any concurrent transaction
+// on the same shard conflicts with this one. It returns the rangeID of the shard when it doesn't match.
+func (db *mdb) lockShard(sc mongo.SessionContext, shardID int, rangeID int64) (rangeIDMatched bool, actualRangeID int64, err error) {
+	var shard cadence.ShardCollectionEntry
+	err = db.dbConn.Collection(cadence.ShardCollectionName).FindOneAndUpdate(
+		sc,
+		bson.D{{"_id", shardID}},
+		bson.D{{"$inc", bson.D{{"writeversion", 1}}}},
+		options.FindOneAndUpdate().SetProjection(bson.D{{"data", 0}}),
+	).Decode(&shard)
+	if err != nil {
+		return false, 0, err
+	}
+	return shard.RangeID == rangeID, shard.RangeID, nil
+}
+
+// pageCursor is the position of the last document of a page, the next page starts right after it.
+// Only the fields of the sort keys of the query are set.
+type pageCursor struct {
+	LastObjectID  primitive.ObjectID `bson:"lastobjectid,omitempty"`
+	LastStringID  string             `bson:"laststringid,omitempty"`
+	LastTimestamp int64              `bson:"lasttimestamp,omitempty"`
+	LastID        int64              `bson:"lastid,omitempty"`
+	LastSubID     int64              `bson:"lastsubid,omitempty"`
+}
+
+func serializePageToken(token *pageCursor) ([]byte, error) {
+	if token == nil {
+		return nil, nil
+	}
+	return bson.Marshal(token)
+}
+
+func deserializePageToken(data []byte) (*pageCursor, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var token pageCursor
+	if err := bson.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("invalid page token: %v", err)
+	}
+	return &token, nil
+}
+
+// findPage reads at most pageSize documents ordered by the sort, and decodes them into results,
+// which must be a pointer to a slice. pageSize <= 0 means reading all the documents.
+func (db *mdb) findPage(
+	ctx context.Context,
+	collection string,
+	filter bson.D,
+	sort bson.D,
+	pageSize int,
+	results interface{},
+) error {
+	opts := options.Find().SetSort(sort)
+	if pageSize > 0 {
+		opts.SetLimit(int64(pageSize))
+	}
+	cursor, err := db.dbConn.Collection(collection).Find(ctx, filter, opts)
+	if err != nil {
+		return err
+	}
+	return cursor.All(ctx, results)
+}
+
+// toNextPageToken returns the token of the next page if the page is full, otherwise there are no more documents
+func toNextPageToken(size int, pageSize int, last func() *pageCursor) ([]byte, error) {
+	if pageSize <= 0 || size < pageSize {
+		return nil, nil
+	}
+	return serializePageToken(last())
+}
@@ -1,4 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -22,16 +22,74 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
 )
 
+var _ nosqlplugin.VisibilityCRUD = (*mdb)(nil)
+
+func visibilityFilter(domainID, runID string) bson.D {
+	return bson.D{{"domainid", domainID}, {"runid", runID}}
+}
+
+// newVisibilityEntry returns the document of a visibility record. closetime is only set for closed workflows
+func newVisibilityEntry(domainID string, row *nosqlplugin.VisibilityRow, closed bool, ttlSeconds int64) (*cadence.VisibilityCollectionEntry, error) {
+	record := *row
+	record.DomainID = domainID
+	data, err := marshalData(&record)
+	if err != nil {
+		return nil, err
+	}
+	doc := &cadence.VisibilityCollectionEntry{
+		DomainID:     domainID,
+		WorkflowID:   row.WorkflowID,
+		RunID:        row.RunID,
+		WorkflowType: row.TypeName,
+		StartTime:    row.StartTime.UnixNano(),
+		Data:         data,
+	}
+	if closed {
+		closeTime := row.CloseTime.UnixNano()
+		doc.CloseTime = &closeTime
+		if row.Status != nil {
+			closeStatus := int32(*row.Status)
+			doc.CloseStatus = &closeStatus
+		}
+	}
+	if ttlSeconds > 0 {
+		expiryTime := time.Now().Add(time.Duration(ttlSeconds) * time.Second)
+		doc.ExpiryTime = &expiryTime
+	}
+	return doc, nil
+}
+
+// upsertVisibility overwrites the record, as there is only one collection for both open and closed workflows
+func (db *mdb) upsertVisibility(ctx context.Context, doc *cadence.VisibilityCollectionEntry) error {
+	_, err := db.dbConn.Collection(cadence.VisibilityCollectionName).ReplaceOne(
+		ctx,
+		visibilityFilter(doc.DomainID, doc.RunID),
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
+}
+
 func (db *mdb) InsertVisibility(
 	ctx context.Context,
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForInsert,
 ) error {
-	panic("TODO")
+	doc, err := newVisibilityEntry(row.DomainID, &row.VisibilityRow, false, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.upsertVisibility(ctx, doc)
 }
 
 func (db *mdb) UpdateVisibility(
@@ -39,26 +97,124 @@ func (db *mdb) UpdateVisibility(
 	ttlSeconds int64,
 	row *nosqlplugin.VisibilityRowForUpdate,
 ) error {
-	panic("TODO")
+	closed := !row.UpdateCloseToOpen && (row.UpdateOpenToClose || row.Status != nil)
+	doc, err := newVisibilityEntry(row.DomainID, &row.VisibilityRow, closed, ttlSeconds)
+	if err != nil {
+		return err
+	}
+	return db.upsertVisibility(ctx, doc)
 }
 
 func (db *mdb) SelectVisibility(
 	ctx context.Context,
 	filter *nosqlplugin.VisibilityFilter,
 ) (*nosqlplugin.SelectVisibilityResponse, error) {
-	panic("TODO")
+	request := filter.ListRequest
+	token, err := deserializePageToken(request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var timeField string
+	switch filter.SortType {
+	case nosqlplugin.SortByStartTime:
+		timeField = "starttime"
+	case nosqlplugin.SortByClosedTime:
+		timeField = "closetime"
+	default:
+		return nil, fmt.Errorf("unknown sort type: %v", filter.SortType)
+	}
+	query := bson.D{
+		{"domainid", request.DomainUUID},
+		{timeField, bson.D{{"$gte", request.EarliestTime.UnixNano()}, {"$lte", request.LatestTime.UnixNano()}}},
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.AllOpen, nosqlplugin.OpenByWorkflowType, nosqlplugin.OpenByWorkflowID:
+		query = append(query, bson.E{"closetime", bson.D{{"$exists", false}}})
+	case nosqlplugin.AllClosed, nosqlplugin.ClosedByWorkflowType, nosqlplugin.ClosedByWorkflowID, nosqlplugin.ClosedByClosedStatus:
+		if filter.SortType != nosqlplugin.SortByClosedTime {
+			// the range of closetime already excludes the open workflows
+			query = append(query, bson.E{"closetime", bson.D{{"$exists", true}}})
+		}
+	default:
+		return nil, fmt.Errorf("unknown filter type: %v", filter.FilterType)
+	}
+
+	switch filter.FilterType {
+	case nosqlplugin.OpenByWorkflowType, nosqlplugin.ClosedByWorkflowType:
+		query = append(query, bson.E{"workflowtype", filter.WorkflowType})
+	case nosqlplugin.OpenByWorkflowID, nosqlplugin.ClosedByWorkflowID:
+		query = append(query, bson.E{"workflowid", filter.WorkflowID})
+	case nosqlplugin.ClosedByClosedStatus:
+		query = append(query, bson.E{"closestatus", filter.CloseStatus})
+	}
+
+	if token != nil {
+		// records are ordered by the time DESC and runID ASC
+		query = append(query, bson.E{"$or", bson.A{
+			bson.D{{timeField, bson.D{{"$lt", token.LastTimestamp}}}},
+			bson.D{{timeField, token.LastTimestamp}, {"runid", bson.D{{"$gt", token.LastStringID}}}},
+		}})
+	}
+
+	var docs []*cadence.VisibilityCollectionEntry
+	err = db.findPage(ctx, cadence.VisibilityCollectionName, query, bson.D{{timeField, -1}, {"runid", 1}}, request.PageSize, &docs)
+	if err != nil {
+		return nil, err
+	}
+	executions := make([]*nosqlplugin.VisibilityRow, 0, len(docs))
+	for _, doc := range docs {
+		row := &nosqlplugin.VisibilityRow{}
+		if err := unmarshalData(doc.Data, row); err != nil {
+			return nil, err
+		}
+		executions = append(executions, row)
+	}
+	nextToken, err := toNextPageToken(len(docs), request.PageSize, func() *pageCursor {
+		last := docs[len(docs)-1]
+		cursor := &pageCursor{LastStringID: last.RunID, LastTimestamp: last.StartTime}
+		if filter.SortType == nosqlplugin.SortByClosedTime {
+			cursor.LastTimestamp = *last.CloseTime
+		}
+		return cursor
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.SelectVisibilityResponse{
+		Executions:    executions,
+		NextPageToken: nextToken,
+	}, nil
 }
 
 func (db *mdb) DeleteVisibility(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.VisibilityCollectionName).DeleteOne(ctx, visibilityFilter(domainID, runID))
+	return err
 }
 
 func (db *mdb) SelectOneClosedWorkflow(
 	ctx context.Context,
 	domainID, workflowID, runID string,
 ) (*nosqlplugin.VisibilityRow, error) {
-	panic("TODO")
+	query := append(visibilityFilter(domainID, runID),
+		bson.E{"workflowid", workflowID},
+		bson.E{"closetime", bson.D{{"$exists", true}}},
+	)
+	var doc cadence.VisibilityCollectionEntry
+	err := db.dbConn.Collection(cadence.VisibilityCollectionName).FindOne(ctx, query).Decode(&doc)
+	if err != nil {
+		if db.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	row := &nosqlplugin.VisibilityRow{}
+	if err := unmarshalData(doc.Data, row); err != nil {
+		return nil, err
+	}
+	return row, nil
 }
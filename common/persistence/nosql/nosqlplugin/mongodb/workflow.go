@@ -1,5 +1,4 @@
-// Copyright (c) 2021 Uber Technologies, Inc.
-// Portions of the Software are attributed to Copyright (c) 2020 Temporal Technologies Inc.
+// Copyright (c) 2020 Uber Technologies, Inc.
 //
 // Permission is hereby granted, free of charge, to any person obtaining a copy
 // of this software and associated documentation files (the "Software"), to deal
@@ -23,10 +22,21 @@ package mongodb
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+const (
+	// same as Cassandra, the runID of current workflow returned to scanners
+	permanentRunID = "30000000-0000-f000-f000-000000000001"
 )
 
 var _ nosqlplugin.WorkflowCRUD = (*mdb)(nil)
@@ -41,7 +51,31 @@ func (db *mdb) InsertWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	domainID := execution.DomainID
+	workflowID := execution.WorkflowID
+
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		matched, rangeID, err := db.lockShard(sc, shardID, shardCondition.RangeID)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return newShardRangeIDNotMatchError(rangeID)
+		}
+
+		err = db.writeCurrentWorkflow(sc, shardCondition, domainID, workflowID, currentWorkflowRequest)
+		if err != nil {
+			return err
+		}
+
+		err = db.createWorkflowExecution(sc, shardCondition, domainID, workflowID, execution)
+		if err != nil {
+			return err
+		}
+
+		return db.insertTasks(sc, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	})
 }
 
 func (db *mdb) UpdateWorkflowExecutionWithTasks(
@@ -56,105 +90,406 @@ func (db *mdb) UpdateWorkflowExecutionWithTasks(
 	timerTasks []*nosqlplugin.TimerTask,
 	shardCondition *nosqlplugin.ShardCondition,
 ) error {
-	panic("TODO")
+	shardID := shardCondition.ShardID
+	var domainID, workflowID string
+	if mutatedExecution != nil {
+		domainID = mutatedExecution.DomainID
+		workflowID = mutatedExecution.WorkflowID
+	} else if resetExecution != nil {
+		domainID = resetExecution.DomainID
+		workflowID = resetExecution.WorkflowID
+	} else {
+		return fmt.Errorf("at least one of mutatedExecution and resetExecution should be provided")
+	}
+	if mutatedExecution != nil && mutatedExecution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeUpdate")
+	}
+
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		matched, rangeID, err := db.lockShard(sc, shardID, shardCondition.RangeID)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return newShardRangeIDNotMatchError(rangeID)
+		}
+
+		err = db.writeCurrentWorkflow(sc, shardCondition, domainID, workflowID, currentWorkflowRequest)
+		if err != nil {
+			return err
+		}
+
+		if mutatedExecution != nil {
+			err = db.updateWorkflowExecution(sc, shardCondition, domainID, workflowID, mutatedExecution)
+			if err != nil {
+				return err
+			}
+		}
+
+		if insertedExecution != nil {
+			err = db.createWorkflowExecution(sc, shardCondition, domainID, workflowID, insertedExecution)
+			if err != nil {
+				return err
+			}
+		}
+
+		if resetExecution != nil {
+			err = db.updateWorkflowExecution(sc, shardCondition, domainID, workflowID, resetExecution)
+			if err != nil {
+				return err
+			}
+		}
+
+		return db.insertTasks(sc, shardID, transferTasks, crossClusterTasks, replicationTasks, timerTasks)
+	})
 }
 
 func (db *mdb) SelectCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID string) (*nosqlplugin.CurrentWorkflowRow, error) {
-	panic("TODO")
+	var doc cadence.CurrentWorkflowCollectionEntry
+	err := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName).
+		FindOne(ctx, currentWorkflowFilter(shardID, domainID, workflowID)).
+		Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return &nosqlplugin.CurrentWorkflowRow{
+		ShardID:          doc.ShardID,
+		DomainID:         doc.DomainID,
+		WorkflowID:       doc.WorkflowID,
+		RunID:            doc.RunID,
+		State:            doc.State,
+		CloseStatus:      doc.CloseStatus,
+		CreateRequestID:  doc.CreateRequestID,
+		LastWriteVersion: doc.LastWriteVersion,
+	}, nil
 }
 
 func (db *mdb) SelectWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) (*nosqlplugin.WorkflowExecution, error) {
-	panic("TODO")
+	var doc cadence.WorkflowExecutionCollectionEntry
+	err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).
+		FindOne(ctx, workflowExecutionFilter(shardID, domainID, workflowID, runID)).
+		Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+	return toWorkflowExecution(&doc)
 }
 
 func (db *mdb) DeleteCurrentWorkflow(ctx context.Context, shardID int, domainID, workflowID, currentRunIDCondition string) error {
-	panic("TODO")
+	// same as Cassandra, the current workflow is not deleted if it's pointing to another run
+	filter := append(currentWorkflowFilter(shardID, domainID, workflowID), bson.E{"runid", currentRunIDCondition})
+	_, err := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName).DeleteOne(ctx, filter)
+	return err
 }
 
 func (db *mdb) DeleteWorkflowExecution(ctx context.Context, shardID int, domainID, workflowID, runID string) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).
+		DeleteOne(ctx, workflowExecutionFilter(shardID, domainID, workflowID, runID))
+	return err
 }
 
 func (db *mdb) SelectAllCurrentWorkflows(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.CurrentWorkflowExecution, []byte, error) {
-	panic("TODO")
+	token, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var docs []*currentWorkflowDocument
+	err = db.findPage(ctx, cadence.CurrentWorkflowCollectionName, scanFilter(shardID, token), bson.D{{"_id", 1}}, pageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.CurrentWorkflowExecution, 0, len(docs))
+	for _, doc := range docs {
+		executions = append(executions, &persistence.CurrentWorkflowExecution{
+			DomainID:     doc.DomainID,
+			WorkflowID:   doc.WorkflowID,
+			RunID:        permanentRunID,
+			State:        doc.State,
+			CurrentRunID: doc.RunID,
+		})
+	}
+	nextToken, err := toNextPageToken(len(docs), pageSize, func() *pageCursor {
+		return &pageCursor{LastObjectID: docs[len(docs)-1].ObjectID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return executions, nextToken, nil
 }
 
 func (db *mdb) SelectAllWorkflowExecutions(ctx context.Context, shardID int, pageToken []byte, pageSize int) ([]*persistence.InternalListConcreteExecutionsEntity, []byte, error) {
-	panic("TODO")
+	token, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	var docs []*workflowExecutionDocument
+	err = db.findPage(ctx, cadence.WorkflowExecutionCollectionName, scanFilter(shardID, token), bson.D{{"_id", 1}}, pageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	executions := make([]*persistence.InternalListConcreteExecutionsEntity, 0, len(docs))
+	for _, doc := range docs {
+		var data workflowExecutionData
+		if err := unmarshalData(doc.Data, &data); err != nil {
+			return nil, nil, err
+		}
+		executions = append(executions, &persistence.InternalListConcreteExecutionsEntity{
+			ExecutionInfo:    data.ExecutionInfo,
+			VersionHistories: data.VersionHistories,
+		})
+	}
+	nextToken, err := toNextPageToken(len(docs), pageSize, func() *pageCursor {
+		return &pageCursor{LastObjectID: docs[len(docs)-1].ObjectID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return executions, nextToken, nil
 }
 
 func (db *mdb) IsWorkflowExecutionExists(ctx context.Context, shardID int, domainID, workflowID, runID string) (bool, error) {
-	panic("TODO")
+	count, err := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName).
+		CountDocuments(ctx, workflowExecutionFilter(shardID, domainID, workflowID, runID))
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
 }
 
 func (db *mdb) SelectTransferTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.TransferTask, []byte, error) {
-	panic("TODO")
+	docs, nextToken, err := db.selectTasksOrderByTaskID(ctx, cadence.TransferTaskCollectionName, bson.D{{"shardid", shardID}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TransferTask, 0, len(docs))
+	for _, doc := range docs {
+		task := &nosqlplugin.TransferTask{}
+		if err := unmarshalData(doc.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextToken, nil
 }
 
 func (db *mdb) DeleteTransferTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.TransferTaskCollectionName).
+		DeleteOne(ctx, bson.D{{"shardid", shardID}, {"taskid", taskID}})
+	return err
 }
 
 func (db *mdb) RangeDeleteTransferTasks(ctx context.Context, shardID int, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.TransferTaskCollectionName).DeleteMany(ctx, bson.D{
+		{"shardid", shardID},
+		{"taskid", bson.D{{"$gt", exclusiveBeginTaskID}, {"$lte", inclusiveEndTaskID}}},
+	})
+	return err
 }
 
 func (db *mdb) SelectTimerTasksOrderByVisibilityTime(ctx context.Context, shardID, pageSize int, pageToken []byte, inclusiveMinTime, exclusiveMaxTime time.Time) ([]*nosqlplugin.TimerTask, []byte, error) {
-	panic("TODO")
+	token, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	filter := bson.D{
+		{"shardid", shardID},
+		{"visibilitytimestamp", bson.D{{"$gte", inclusiveMinTime.UnixNano()}, {"$lt", exclusiveMaxTime.UnixNano()}}},
+	}
+	if token != nil {
+		filter = append(filter, bson.E{"$or", bson.A{
+			bson.D{{"visibilitytimestamp", bson.D{{"$gt", token.LastTimestamp}}}},
+			bson.D{{"visibilitytimestamp", token.LastTimestamp}, {"taskid", bson.D{{"$gt", token.LastID}}}},
+		}})
+	}
+	var docs []*cadence.ShardTaskCollectionEntry
+	err = db.findPage(ctx, cadence.TimerTaskCollectionName, filter, bson.D{{"visibilitytimestamp", 1}, {"taskid", 1}}, pageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.TimerTask, 0, len(docs))
+	for _, doc := range docs {
+		task := &nosqlplugin.TimerTask{}
+		if err := unmarshalData(doc.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	nextToken, err := toNextPageToken(len(docs), pageSize, func() *pageCursor {
+		last := docs[len(docs)-1]
+		return &pageCursor{LastTimestamp: last.VisibilityTimestamp, LastID: last.TaskID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, nextToken, nil
 }
 
 func (db *mdb) DeleteTimerTask(ctx context.Context, shardID int, taskID int64, visibilityTimestamp time.Time) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.TimerTaskCollectionName).DeleteOne(ctx, bson.D{
+		{"shardid", shardID},
+		{"visibilitytimestamp", visibilityTimestamp.UnixNano()},
+		{"taskid", taskID},
+	})
+	return err
 }
 
 func (db *mdb) RangeDeleteTimerTasks(ctx context.Context, shardID int, inclusiveMinTime, exclusiveMaxTime time.Time) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.TimerTaskCollectionName).DeleteMany(ctx, bson.D{
+		{"shardid", shardID},
+		{"visibilitytimestamp", bson.D{{"$gte", inclusiveMinTime.UnixNano()}, {"$lt", exclusiveMaxTime.UnixNano()}}},
+	})
+	return err
 }
 
 func (db *mdb) SelectReplicationTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, cadence.ReplicationTaskCollectionName, bson.D{{"shardid", shardID}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *mdb) DeleteReplicationTask(ctx context.Context, shardID int, taskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.ReplicationTaskCollectionName).
+		DeleteOne(ctx, bson.D{{"shardid", shardID}, {"taskid", taskID}})
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationTasks(ctx context.Context, shardID int, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.ReplicationTaskCollectionName).DeleteMany(ctx, bson.D{
+		{"shardid", shardID},
+		{"taskid", bson.D{{"$lte", inclusiveEndTaskID}}},
+	})
+	return err
 }
 
 func (db *mdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, condition nosqlplugin.ShardCondition) error {
-	panic("TODO")
+	return db.doTransaction(ctx, func(sc mongo.SessionContext) error {
+		matched, rangeID, err := db.lockShard(sc, condition.ShardID, condition.RangeID)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			return &nosqlplugin.ShardOperationConditionFailure{
+				RangeID: rangeID,
+			}
+		}
+		return db.insertTasks(sc, condition.ShardID, nil, nil, tasks, nil)
+	})
 }
 
 func (db *mdb) SelectCrossClusterTasksOrderByTaskID(ctx context.Context, shardID, pageSize int, pageToken []byte, targetCluster string, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.CrossClusterTask, []byte, error) {
-	panic("TODO")
+	docs, nextToken, err := db.selectTasksOrderByTaskID(ctx, cadence.CrossClusterTaskCollectionName,
+		bson.D{{"shardid", shardID}, {"cluster", targetCluster}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.CrossClusterTask, 0, len(docs))
+	for _, doc := range docs {
+		task := &nosqlplugin.CrossClusterTask{}
+		if err := unmarshalData(doc.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextToken, nil
 }
 
 func (db *mdb) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.CrossClusterTaskCollectionName).
+		DeleteOne(ctx, bson.D{{"shardid", shardID}, {"cluster", targetCluster}, {"taskid", taskID}})
+	return err
 }
 
 func (db *mdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, targetCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.CrossClusterTaskCollectionName).DeleteMany(ctx, bson.D{
+		{"shardid", shardID},
+		{"cluster", targetCluster},
+		{"taskid", bson.D{{"$gt", exclusiveBeginTaskID}, {"$lte", inclusiveEndTaskID}}},
+	})
+	return err
 }
 
 func (db *mdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	panic("TODO")
+	doc, err := newShardTaskEntry(shardID, sourceCluster, 0, task.TaskID, &task)
+	if err != nil {
+		return err
+	}
+	// same as Cassandra, the task is overwritten if it already exists
+	_, err = db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).ReplaceOne(
+		ctx,
+		bson.D{{"shardid", shardID}, {"cluster", sourceCluster}, {"taskid", task.TaskID}},
+		doc,
+		options.Replace().SetUpsert(true),
+	)
+	return err
 }
 
 func (db *mdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	panic("TODO")
+	return db.selectReplicationTasks(ctx, cadence.ReplicationDLQTaskCollectionName,
+		bson.D{{"shardid", shardID}, {"cluster", sourceCluster}},
+		pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
 }
 
 func (db *mdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	panic("TODO")
+	count, err := db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).
+		CountDocuments(ctx, bson.D{{"shardid", shardID}, {"cluster", sourceCluster}})
+	if err != nil {
+		return -1, err
+	}
+	return count, nil
 }
 
 func (db *mdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).
+		DeleteOne(ctx, bson.D{{"shardid", shardID}, {"cluster", sourceCluster}, {"taskid", taskID}})
+	return err
 }
 
 func (db *mdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	panic("TODO")
+	_, err := db.dbConn.Collection(cadence.ReplicationDLQTaskCollectionName).DeleteMany(ctx, bson.D{
+		{"shardid", shardID},
+		{"cluster", sourceCluster},
+		{"taskid", bson.D{{"$gt", exclusiveBeginTaskID}, {"$lte", inclusiveEndTaskID}}},
+	})
+	return err
+}
+
+func (db *mdb) selectReplicationTasks(ctx context.Context, collection string, filter bson.D, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	docs, nextToken, err := db.selectTasksOrderByTaskID(ctx, collection, filter, pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	tasks := make([]*nosqlplugin.ReplicationTask, 0, len(docs))
+	for _, doc := range docs {
+		task := &nosqlplugin.ReplicationTask{}
+		if err := unmarshalData(doc.Data, task); err != nil {
+			return nil, nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nextToken, nil
+}
+
+// selectTasksOrderByTaskID reads a page of the tasks matching the filter, whose taskIDs are in the range
+func (db *mdb) selectTasksOrderByTaskID(ctx context.Context, collection string, filter bson.D, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*cadence.ShardTaskCollectionEntry, []byte, error) {
+	token, err := deserializePageToken(pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	if token != nil && token.LastID > exclusiveMinTaskID {
+		exclusiveMinTaskID = token.LastID
+	}
+	filter = append(filter, bson.E{"taskid", bson.D{{"$gt", exclusiveMinTaskID}, {"$lte", inclusiveMaxTaskID}}})
+	var docs []*cadence.ShardTaskCollectionEntry
+	err = db.findPage(ctx, collection, filter, bson.D{{"taskid", 1}}, pageSize, &docs)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextToken, err := toNextPageToken(len(docs), pageSize, func() *pageCursor {
+		return &pageCursor{LastID: docs[len(docs)-1].TaskID}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return docs, nextToken, nil
 }
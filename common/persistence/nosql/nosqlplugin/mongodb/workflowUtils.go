@@ -0,0 +1,496 @@
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mongodb
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+	"github.com/uber/cadence/schema/mongodb/cadence"
+)
+
+type (
+	// workflowExecutionData is the data field of a workflow_executions document.
+	// The six maps and buffered events are stored in the same document as the execution info, so that they can be
+	// read and written together. Note that MongoDB limits a document to 16MB.
+	workflowExecutionData struct {
+		ExecutionInfo       *persistence.InternalWorkflowExecutionInfo
+		VersionHistories    *persistence.DataBlob
+		Checksum            checksum.Checksum
+		LastWriteVersion    int64
+		ActivityInfos       map[int64]*persistence.InternalActivityInfo
+		TimerInfos          map[string]*persistence.TimerInfo
+		ChildExecutionInfos map[int64]*persistence.InternalChildExecutionInfo
+		RequestCancelInfos  map[int64]*persistence.RequestCancelInfo
+		SignalInfos         map[int64]*persistence.SignalInfo
+		SignalRequestedIDs  []string
+		BufferedEvents      []*persistence.DataBlob
+	}
+
+	// currentWorkflowDocument is a current_workflows document with the _id, for paginating the scan of a shard
+	currentWorkflowDocument struct {
+		ObjectID                               primitive.ObjectID `bson:"_id"`
+		cadence.CurrentWorkflowCollectionEntry `bson:",inline"`
+	}
+
+	// workflowExecutionDocument is a workflow_executions document with the _id, for paginating the scan of a shard
+	workflowExecutionDocument struct {
+		ObjectID                                 primitive.ObjectID `bson:"_id"`
+		cadence.WorkflowExecutionCollectionEntry `bson:",inline"`
+	}
+)
+
+func currentWorkflowFilter(shardID int, domainID, workflowID string) bson.D {
+	return bson.D{{"shardid", shardID}, {"domainid", domainID}, {"workflowid", workflowID}}
+}
+
+func workflowExecutionFilter(shardID int, domainID, workflowID, runID string) bson.D {
+	return bson.D{{"shardid", shardID}, {"domainid", domainID}, {"workflowid", workflowID}, {"runid", runID}}
+}
+
+// scanFilter returns the filter of all the documents of a shard after the page token
+func scanFilter(shardID int, token *pageCursor) bson.D {
+	filter := bson.D{{"shardid", shardID}}
+	if token != nil {
+		filter = append(filter, bson.E{"_id", bson.D{{"$gt", token.LastObjectID}}})
+	}
+	return filter
+}
+
+// writeCurrentWorkflow inserts or updates the current_workflows document in the transaction.
+// Return WorkflowOperationConditionFailure if the condition doesn't meet
+func (db *mdb) writeCurrentWorkflow(
+	sc mongo.SessionContext,
+	shardCondition *nosqlplugin.ShardCondition,
+	domainID string,
+	workflowID string,
+	request *nosqlplugin.CurrentWorkflowWriteRequest,
+) error {
+	if request.WriteMode == nosqlplugin.CurrentWorkflowWriteModeNoop {
+		return nil
+	}
+
+	shardID := shardCondition.ShardID
+	collection := db.dbConn.Collection(cadence.CurrentWorkflowCollectionName)
+	filter := currentWorkflowFilter(shardID, domainID, workflowID)
+	var previous cadence.CurrentWorkflowCollectionEntry
+	err := collection.FindOne(sc, filter).Decode(&previous)
+	exists := err == nil
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+
+	row := request.Row
+	doc := &cadence.CurrentWorkflowCollectionEntry{
+		ShardID:          shardID,
+		DomainID:         domainID,
+		WorkflowID:       workflowID,
+		RunID:            row.RunID,
+		CreateRequestID:  row.CreateRequestID,
+		State:            row.State,
+		CloseStatus:      row.CloseStatus,
+		LastWriteVersion: row.LastWriteVersion,
+	}
+
+	switch request.WriteMode {
+	case nosqlplugin.CurrentWorkflowWriteModeInsert:
+		if exists {
+			msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+				previous.WorkflowID, previous.RunID, shardCondition.RangeID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+					OtherInfo:        msg,
+					CreateRequestID:  previous.CreateRequestID,
+					RunID:            previous.RunID,
+					State:            previous.State,
+					CloseStatus:      previous.CloseStatus,
+					LastWriteVersion: previous.LastWriteVersion,
+				},
+			}
+		}
+		_, err = collection.InsertOne(sc, doc)
+		return err
+	case nosqlplugin.CurrentWorkflowWriteModeUpdate:
+		if request.Condition == nil || request.Condition.GetCurrentRunID() == "" {
+			return fmt.Errorf("CurrentWorkflowWriteModeUpdate require Condition.CurrentRunID")
+		}
+		matched := exists && previous.RunID == *request.Condition.CurrentRunID
+		if matched && request.Condition.LastWriteVersion != nil && request.Condition.State != nil {
+			matched = previous.LastWriteVersion == *request.Condition.LastWriteVersion &&
+				previous.State == *request.Condition.State
+		}
+		if !matched {
+			msg := fmt.Sprintf("Workflow execution condition failed on current workflow. WorkflowId: %v, Expected Current RunID: %v, Actual Current RunID: %v",
+				workflowID, request.Condition.GetCurrentRunID(), previous.RunID)
+			return &nosqlplugin.WorkflowOperationConditionFailure{
+				CurrentWorkflowConditionFailInfo: &msg,
+			}
+		}
+		_, err = collection.ReplaceOne(sc, filter, doc)
+		return err
+	default:
+		return fmt.Errorf("unknown mode %v", request.WriteMode)
+	}
+}
+
+func newWorkflowExecutionData(execution *nosqlplugin.WorkflowExecutionRequest) *workflowExecutionData {
+	info := execution.InternalWorkflowExecutionInfo
+	data := &workflowExecutionData{
+		ExecutionInfo:       &info,
+		VersionHistories:    execution.VersionHistories,
+		LastWriteVersion:    execution.LastWriteVersion,
+		ActivityInfos:       make(map[int64]*persistence.InternalActivityInfo),
+		TimerInfos:          make(map[string]*persistence.TimerInfo),
+		ChildExecutionInfos: make(map[int64]*persistence.InternalChildExecutionInfo),
+		RequestCancelInfos:  make(map[int64]*persistence.RequestCancelInfo),
+		SignalInfos:         make(map[int64]*persistence.SignalInfo),
+	}
+	if execution.Checksums != nil {
+		data.Checksum = *execution.Checksums
+	}
+	return data
+}
+
+// mergeMaps upserts the entries of the maps from request, and deletes the entries by keys if it's in update mode
+func (data *workflowExecutionData) mergeMaps(execution *nosqlplugin.WorkflowExecutionRequest) {
+	for k, v := range execution.ActivityInfos {
+		data.ActivityInfos[k] = v
+	}
+	for k, v := range execution.TimerInfos {
+		data.TimerInfos[k] = v
+	}
+	for k, v := range execution.ChildWorkflowInfos {
+		data.ChildExecutionInfos[k] = v
+	}
+	for k, v := range execution.RequestCancelInfos {
+		data.RequestCancelInfos[k] = v
+	}
+	for k, v := range execution.SignalInfos {
+		data.SignalInfos[k] = v
+	}
+	signalRequested := make(map[string]struct{})
+	for _, id := range data.SignalRequestedIDs {
+		signalRequested[id] = struct{}{}
+	}
+	for _, id := range execution.SignalRequestedIDs {
+		signalRequested[id] = struct{}{}
+	}
+
+	if execution.MapsWriteMode == nosqlplugin.WorkflowExecutionMapsWriteModeUpdate {
+		for _, k := range execution.ActivityInfoKeysToDelete {
+			delete(data.ActivityInfos, k)
+		}
+		for _, k := range execution.TimerInfoKeysToDelete {
+			delete(data.TimerInfos, k)
+		}
+		for _, k := range execution.ChildWorkflowInfoKeysToDelete {
+			delete(data.ChildExecutionInfos, k)
+		}
+		for _, k := range execution.RequestCancelInfoKeysToDelete {
+			delete(data.RequestCancelInfos, k)
+		}
+		for _, k := range execution.SignalInfoKeysToDelete {
+			delete(data.SignalInfos, k)
+		}
+		for _, id := range execution.SignalRequestedIDsKeysToDelete {
+			delete(signalRequested, id)
+		}
+	}
+
+	data.SignalRequestedIDs = make([]string, 0, len(signalRequested))
+	for id := range signalRequested {
+		data.SignalRequestedIDs = append(data.SignalRequestedIDs, id)
+	}
+}
+
+func (data *workflowExecutionData) ensureMaps() {
+	if data.ActivityInfos == nil {
+		data.ActivityInfos = make(map[int64]*persistence.InternalActivityInfo)
+	}
+	if data.TimerInfos == nil {
+		data.TimerInfos = make(map[string]*persistence.TimerInfo)
+	}
+	if data.ChildExecutionInfos == nil {
+		data.ChildExecutionInfos = make(map[int64]*persistence.InternalChildExecutionInfo)
+	}
+	if data.RequestCancelInfos == nil {
+		data.RequestCancelInfos = make(map[int64]*persistence.RequestCancelInfo)
+	}
+	if data.SignalInfos == nil {
+		data.SignalInfos = make(map[int64]*persistence.SignalInfo)
+	}
+}
+
+func newWorkflowExecutionEntry(
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+	data *workflowExecutionData,
+) (*cadence.WorkflowExecutionCollectionEntry, error) {
+	blob, err := marshalData(data)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.WorkflowExecutionCollectionEntry{
+		ShardID:     shardID,
+		DomainID:    domainID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+		NextEventID: data.ExecutionInfo.NextEventID,
+		Data:        blob,
+	}, nil
+}
+
+// createWorkflowExecution inserts a new workflow_executions document in the transaction, with the maps from the request
+// Return WorkflowOperationConditionFailure if the execution already exists
+func (db *mdb) createWorkflowExecution(
+	sc mongo.SessionContext,
+	shardCondition *nosqlplugin.ShardCondition,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeNone {
+		return fmt.Errorf("should only support EventBufferWriteModeNone")
+	}
+	if execution.MapsWriteMode != nosqlplugin.WorkflowExecutionMapsWriteModeCreate {
+		return fmt.Errorf("should only support WorkflowExecutionMapsWriteModeCreate")
+	}
+
+	shardID := shardCondition.ShardID
+	collection := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName)
+	count, err := collection.CountDocuments(sc, workflowExecutionFilter(shardID, domainID, workflowID, execution.RunID))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		msg := fmt.Sprintf("Workflow execution already running. WorkflowId: %v, RunId: %v, rangeID: %v",
+			execution.WorkflowID, execution.RunID, shardCondition.RangeID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			WorkflowExecutionAlreadyExists: &nosqlplugin.WorkflowExecutionAlreadyExists{
+				OtherInfo:        msg,
+				CreateRequestID:  execution.CreateRequestID,
+				RunID:            execution.RunID,
+				State:            execution.State,
+				CloseStatus:      execution.CloseStatus,
+				LastWriteVersion: execution.LastWriteVersion,
+			},
+		}
+	}
+
+	data := newWorkflowExecutionData(execution)
+	data.mergeMaps(execution)
+	doc, err := newWorkflowExecutionEntry(shardID, domainID, workflowID, execution.RunID, data)
+	if err != nil {
+		return err
+	}
+	_, err = collection.InsertOne(sc, doc)
+	return err
+}
+
+// updateWorkflowExecution overwrites the workflow_executions document in the transaction, with the condition
+// of previous nextEventID. In update mode, the maps and buffered events are merged into the existing ones.
+// Return WorkflowOperationConditionFailure if the condition doesn't meet
+func (db *mdb) updateWorkflowExecution(
+	sc mongo.SessionContext,
+	shardCondition *nosqlplugin.ShardCondition,
+	domainID string,
+	workflowID string,
+	execution *nosqlplugin.WorkflowExecutionRequest,
+) error {
+	if execution.MapsWriteMode == nosqlplugin.WorkflowExecutionMapsWriteModeReset &&
+		execution.EventBufferWriteMode != nosqlplugin.EventBufferWriteModeClear {
+		return fmt.Errorf("should only support EventBufferWriteModeClear")
+	}
+
+	shardID := shardCondition.ShardID
+	collection := db.dbConn.Collection(cadence.WorkflowExecutionCollectionName)
+	filter := workflowExecutionFilter(shardID, domainID, workflowID, execution.RunID)
+	var previous cadence.WorkflowExecutionCollectionEntry
+	err := collection.FindOne(sc, filter).Decode(&previous)
+	if err != nil && !db.IsNotFoundError(err) {
+		return err
+	}
+	if err != nil || previous.NextEventID != *execution.PreviousNextEventIDCondition {
+		msg := fmt.Sprintf("Failed to update workflow execution. ShardID: %v, RangeID: %v, WorkflowId: %v, RunId: %v, Expected NextEventID: %v, Actual NextEventID: %v",
+			shardID, shardCondition.RangeID, workflowID, execution.RunID, *execution.PreviousNextEventIDCondition, previous.NextEventID)
+		return &nosqlplugin.WorkflowOperationConditionFailure{
+			UnknownConditionFailureDetails: &msg,
+		}
+	}
+
+	data := newWorkflowExecutionData(execution)
+	switch execution.MapsWriteMode {
+	case nosqlplugin.WorkflowExecutionMapsWriteModeUpdate:
+		var previousData workflowExecutionData
+		if err := unmarshalData(previous.Data, &previousData); err != nil {
+			return err
+		}
+		data.ActivityInfos = previousData.ActivityInfos
+		data.TimerInfos = previousData.TimerInfos
+		data.ChildExecutionInfos = previousData.ChildExecutionInfos
+		data.RequestCancelInfos = previousData.RequestCancelInfos
+		data.SignalInfos = previousData.SignalInfos
+		data.SignalRequestedIDs = previousData.SignalRequestedIDs
+		data.BufferedEvents = previousData.BufferedEvents
+		data.ensureMaps()
+		data.mergeMaps(execution)
+
+		switch execution.EventBufferWriteMode {
+		case nosqlplugin.EventBufferWriteModeClear:
+			data.BufferedEvents = nil
+		case nosqlplugin.EventBufferWriteModeAppend:
+			data.BufferedEvents = append(data.BufferedEvents, execution.NewBufferedEventBatch)
+		}
+	case nosqlplugin.WorkflowExecutionMapsWriteModeReset:
+		data.mergeMaps(execution)
+	default:
+		return fmt.Errorf("unsupported MapsWriteMode %v for updating workflow execution", execution.MapsWriteMode)
+	}
+
+	doc, err := newWorkflowExecutionEntry(shardID, domainID, workflowID, execution.RunID, data)
+	if err != nil {
+		return err
+	}
+	_, err = collection.ReplaceOne(sc, filter, doc)
+	return err
+}
+
+func newShardTaskEntry(shardID int, cluster string, visibilityTimestamp int64, taskID int64, task interface{}) (interface{}, error) {
+	data, err := marshalData(task)
+	if err != nil {
+		return nil, err
+	}
+	return &cadence.ShardTaskCollectionEntry{
+		ShardID:             shardID,
+		Cluster:             cluster,
+		VisibilityTimestamp: visibilityTimestamp,
+		TaskID:              taskID,
+		Data:                data,
+	}, nil
+}
+
+func (db *mdb) insertMany(sc mongo.SessionContext, collection string, docs []interface{}) error {
+	if len(docs) == 0 {
+		return nil
+	}
+	_, err := db.dbConn.Collection(collection).InsertMany(sc, docs)
+	return err
+}
+
+// insertTasks inserts the tasks of the shard in the transaction
+func (db *mdb) insertTasks(
+	sc mongo.SessionContext,
+	shardID int,
+	transferTasks []*nosqlplugin.TransferTask,
+	crossClusterTasks []*nosqlplugin.CrossClusterTask,
+	replicationTasks []*nosqlplugin.ReplicationTask,
+	timerTasks []*nosqlplugin.TimerTask,
+) error {
+	docs := make([]interface{}, 0, len(transferTasks))
+	for _, task := range transferTasks {
+		doc, err := newShardTaskEntry(shardID, "", 0, task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	if err := db.insertMany(sc, cadence.TransferTaskCollectionName, docs); err != nil {
+		return err
+	}
+
+	docs = make([]interface{}, 0, len(crossClusterTasks))
+	for _, task := range crossClusterTasks {
+		doc, err := newShardTaskEntry(shardID, task.TargetCluster, 0, task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	if err := db.insertMany(sc, cadence.CrossClusterTaskCollectionName, docs); err != nil {
+		return err
+	}
+
+	docs = make([]interface{}, 0, len(replicationTasks))
+	for _, task := range replicationTasks {
+		doc, err := newShardTaskEntry(shardID, "", 0, task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	if err := db.insertMany(sc, cadence.ReplicationTaskCollectionName, docs); err != nil {
+		return err
+	}
+
+	docs = make([]interface{}, 0, len(timerTasks))
+	for _, task := range timerTasks {
+		doc, err := newShardTaskEntry(shardID, "", task.VisibilityTimestamp.UnixNano(), task.TaskID, task)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+	return db.insertMany(sc, cadence.TimerTaskCollectionName, docs)
+}
+
+// newShardRangeIDNotMatchError is returned when the rangeID of the shard is changed by another host
+func newShardRangeIDNotMatchError(rangeID int64) error {
+	return &nosqlplugin.WorkflowOperationConditionFailure{
+		ShardRangeIDNotMatch: common.Int64Ptr(rangeID),
+	}
+}
+
+func toWorkflowExecution(doc *cadence.WorkflowExecutionCollectionEntry) (*nosqlplugin.WorkflowExecution, error) {
+	var data workflowExecutionData
+	if err := unmarshalData(doc.Data, &data); err != nil {
+		return nil, err
+	}
+	data.ensureMaps()
+	signalRequestedIDs := make(map[string]struct{}, len(data.SignalRequestedIDs))
+	for _, id := range data.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	bufferedEvents := data.BufferedEvents
+	if bufferedEvents == nil {
+		bufferedEvents = make([]*persistence.DataBlob, 0)
+	}
+	return &nosqlplugin.WorkflowExecution{
+		ExecutionInfo:       data.ExecutionInfo,
+		VersionHistories:    data.VersionHistories,
+		ActivityInfos:       data.ActivityInfos,
+		TimerInfos:          data.TimerInfos,
+		ChildExecutionInfos: data.ChildExecutionInfos,
+		RequestCancelInfos:  data.RequestCancelInfos,
+		SignalInfos:         data.SignalInfos,
+		SignalRequestedIDs:  signalRequestedIDs,
+		BufferedEvents:      bufferedEvents,
+		Checksum:            data.Checksum,
+	}, nil
+}
@@ -217,6 +217,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *persistence.DataBlob
+		ArchivalRetention        time.Duration
 	}
 
 	// SelectMessagesBetweenRequest is a request struct for SelectMessagesBetween
@@ -22,7 +22,10 @@ package persistencetests
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -49,6 +52,8 @@ type (
 		// not merely log an error
 		*require.Assertions
 		VisibilityMgr p.VisibilityManager
+		// supportsQuery is true if the visibility store supports the advanced visibility queries
+		supportsQuery bool
 	}
 )
 
@@ -63,6 +68,7 @@ func (s *DBVisibilityPersistenceSuite) SetupSuite() {
 	}
 	clusterName := s.ClusterMetadata.GetCurrentClusterName()
 	vCfg := s.VisibilityTestCluster.Config()
+	s.supportsQuery = vCfg.DataStores[vCfg.VisibilityStore].SQL != nil
 	visibilityFactory := client.NewFactory(&vCfg, nil, clusterName, nil, s.Logger)
 	// SQL currently doesn't have support for visibility manager
 	var err error
@@ -839,10 +845,200 @@ func (s *DBVisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 	}
 
 	for _, test := range tests {
+		if s.supportsQuery {
+			// SQL visibility store persists all the upserts
+			test.expected = nil
+		}
 		s.Equal(test.expected, s.VisibilityMgr.UpsertWorkflowExecution(ctx, test.request))
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if !s.supportsQuery {
+		s.T().Skip("visibility store does not support the advanced visibility queries")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(-time.Minute).Truncate(time.Second).UnixNano()
+	count := 5
+	for i := 0; i < count; i++ {
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID: testDomainUUID,
+			Execution: types.WorkflowExecution{
+				WorkflowID: "visibility-query-workflow-test",
+				RunID:      uuid.New(),
+			},
+			WorkflowTypeName: "visibility-query-workflow",
+			StartTimestamp:   startTime + int64(i)*int64(time.Second),
+			TaskList:         "visibility-query-tasklist",
+			SearchAttributes: map[string][]byte{
+				"CustomIntField":     []byte(strconv.Itoa(i)),
+				"CustomKeywordField": []byte(`"keyword"`),
+			},
+		})
+		s.Nil(err)
+	}
+
+	closedExecution := types.WorkflowExecution{
+		WorkflowID: "visibility-query-workflow-test",
+		RunID:      uuid.New(),
+	}
+	err := s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+	})
+	s.Nil(err)
+	err = s.VisibilityMgr.UpsertWorkflowExecution(ctx, &p.UpsertWorkflowExecutionRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"upserted"`),
+		},
+	})
+	s.Nil(err)
+	err = s.VisibilityMgr.RecordWorkflowExecutionClosed(ctx, &p.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        closedExecution,
+		WorkflowTypeName: "visibility-query-workflow",
+		StartTimestamp:   startTime,
+		Status:           types.WorkflowExecutionCloseStatusFailed,
+		CloseTimestamp:   time.Now().UnixNano(),
+		HistoryLength:    3,
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"upserted"`),
+		},
+	})
+	s.Nil(err)
+
+	var executions []*types.WorkflowExecutionInfo
+	var nextPageToken []byte
+	for {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID:    testDomainUUID,
+			PageSize:      2,
+			NextPageToken: nextPageToken,
+			Query:         "`Attr.CustomKeywordField` = 'keyword' and CloseTime = missing",
+		})
+		s.Nil(err)
+		executions = append(executions, resp.Executions...)
+		if nextPageToken = resp.NextPageToken; len(nextPageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(count, len(executions))
+	for i, execution := range executions {
+		s.Equal(startTime+int64(count-1-i)*int64(time.Second), execution.GetStartTime())
+		s.Equal("visibility-query-tasklist", execution.GetTaskList())
+		s.Nil(execution.CloseStatus)
+	}
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "`Attr.CustomIntField` >= 3 order by `Attr.CustomIntField` desc",
+	})
+	s.Nil(err)
+	s.Equal(2, len(resp.Executions))
+	s.Equal(startTime+4*int64(time.Second), resp.Executions[0].GetStartTime())
+	s.Equal(startTime+3*int64(time.Second), resp.Executions[1].GetStartTime())
+
+	resp, err = s.VisibilityMgr.ScanWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		PageSize:   10,
+		Query:      "CloseStatus = 'failed' and `Attr.CustomKeywordField` = 'upserted'",
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(closedExecution.RunID, resp.Executions[0].Execution.GetRunID())
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "WorkflowType = 'visibility-query-workflow'",
+	})
+	s.Nil(err)
+	s.Equal(int64(count+1), countResp.Count)
+
+	_, err = s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "CloseStatus like 'fail%'",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+// TestListWorkflowExecutionsByQueryDoubleAndDatetime test
+func (s *DBVisibilityPersistenceSuite) TestListWorkflowExecutionsByQueryDoubleAndDatetime() {
+	if !s.supportsQuery {
+		s.T().Skip("visibility store does not support the advanced visibility queries")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	testDomainUUID := uuid.New()
+	startTime := time.Now().Add(-time.Minute).Truncate(time.Second).UnixNano()
+	baseTime := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+	// the datetimes are in different time zones, so they are ordered differently as strings
+	searchAttributes := []struct {
+		double   string
+		datetime time.Time
+	}{
+		{double: "4.6", datetime: baseTime},
+		{double: "5", datetime: baseTime.Add(time.Hour).In(time.FixedZone("UTC+8", 8*3600))},
+		{double: "5.4", datetime: baseTime.Add(2 * time.Hour).In(time.FixedZone("UTC-8", -8*3600))},
+		{double: "10", datetime: baseTime.Add(3 * time.Hour).In(time.FixedZone("UTC+1", 3600))},
+	}
+	for i, attr := range searchAttributes {
+		datetime, err := json.Marshal(attr.datetime)
+		s.Nil(err)
+		err = s.VisibilityMgr.RecordWorkflowExecutionStarted(ctx, &p.RecordWorkflowExecutionStartedRequest{
+			DomainUUID: testDomainUUID,
+			Execution: types.WorkflowExecution{
+				WorkflowID: "visibility-query-typed-workflow-test",
+				RunID:      uuid.New(),
+			},
+			WorkflowTypeName: "visibility-query-typed-workflow",
+			StartTimestamp:   startTime + int64(i)*int64(time.Second),
+			SearchAttributes: map[string][]byte{
+				"CustomDoubleField":   []byte(attr.double),
+				"CustomDatetimeField": datetime,
+			},
+		})
+		s.Nil(err)
+	}
+
+	assertStartTimes := func(query string, expected ...int) {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutions(ctx, &p.ListWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			PageSize:   10,
+			Query:      query,
+		})
+		s.Nil(err, query)
+		s.Equal(len(expected), len(resp.Executions), query)
+		for i, execution := range resp.Executions {
+			s.Equal(startTime+int64(expected[i])*int64(time.Second), execution.GetStartTime(), query)
+		}
+	}
+
+	assertStartTimes("`Attr.CustomDoubleField` > 5 order by `Attr.CustomDoubleField`", 2, 3)
+	assertStartTimes("`Attr.CustomDoubleField` = 5", 1)
+	assertStartTimes("`Attr.CustomDoubleField` between 4.5 and 5.5 order by `Attr.CustomDoubleField` desc", 2, 1, 0)
+	assertStartTimes("`Attr.CustomDatetimeField` > '2021-05-01T10:30:00Z' order by `Attr.CustomDatetimeField` desc", 3, 2, 1)
+	assertStartTimes("`Attr.CustomDatetimeField` <= '2021-05-01T13:00:00+02:00' order by `Attr.CustomDatetimeField`", 0, 1)
+	assertStartTimes(fmt.Sprintf("`Attr.CustomDatetimeField` = %d", baseTime.Add(2*time.Hour).UnixNano()), 2)
+
+	_, err := s.VisibilityMgr.CountWorkflowExecutions(ctx, &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainUUID,
+		Query:      "`Attr.CustomIntField` = 5.4",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *DBVisibilityPersistenceSuite) assertClosedExecutionEquals(
 	req *p.RecordWorkflowExecutionClosedRequest, resp *types.WorkflowExecutionInfo) {
 	s.Equal(req.Execution.RunID, resp.Execution.RunID)
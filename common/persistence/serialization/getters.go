@@ -372,6 +372,14 @@ func (d *DomainInfo) GetLastUpdatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetArchivalRetention internal sql blob getter
+func (d *DomainInfo) GetArchivalRetention() time.Duration {
+	if d != nil {
+		return d.ArchivalRetention
+	}
+	return time.Duration(0)
+}
+
 // GetCreatedTimestamp internal sql blob getter
 func (h *HistoryTreeInfo) GetCreatedTimestamp() time.Time {
 	if h != nil {
@@ -84,6 +84,7 @@ type (
 		FailoverEndTimestamp        *time.Time // TODO: There is logic checking if it's nil, should revisit this
 		PreviousFailoverVersion     int64
 		LastUpdatedTimestamp        time.Time
+		ArchivalRetention           time.Duration
 	}
 
 	// HistoryBranchRange blob in a serialization agnostic format
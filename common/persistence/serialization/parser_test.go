@@ -44,3 +44,28 @@ func TestParse(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, domainInfo, decodedDomainInfo)
 }
+
+func TestParseProtoWithThriftFallback(t *testing.T) {
+	thriftParser, err := NewParser(common.EncodingTypeThriftRW, common.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	protoParser, err := NewParser(common.EncodingTypeProto, common.EncodingTypeThriftRW, common.EncodingTypeProto)
+	assert.NoError(t, err)
+	domainInfo := &DomainInfo{
+		Name: "test_name",
+		Data: map[string]string{"test_key": "test_value"},
+	}
+
+	db, err := protoParser.DomainInfoToBlob(domainInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeProto, db.Encoding)
+	decodedDomainInfo, err := protoParser.DomainInfoFromBlob(db.Data, string(db.Encoding))
+	assert.NoError(t, err)
+	assert.Equal(t, domainInfo, decodedDomainInfo)
+
+	// blobs written before switching to proto3 are still readable
+	db, err = thriftParser.DomainInfoToBlob(domainInfo)
+	assert.NoError(t, err)
+	decodedDomainInfo, err = protoParser.DomainInfoFromBlob(db.Data, string(db.Encoding))
+	assert.NoError(t, err)
+	assert.Equal(t, domainInfo, decodedDomainInfo)
+}
@@ -0,0 +1,791 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serialization
+
+import (
+	"time"
+
+	gogo "github.com/gogo/protobuf/types"
+
+	sqlblobsv1 "github.com/uber/cadence/.gen/proto/sqlblobs/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func shardInfoToProto(info *ShardInfo) *sqlblobsv1.ShardInfo {
+	if info == nil {
+		return nil
+	}
+	result := &sqlblobsv1.ShardInfo{
+		StolenSinceRenew:                          info.StolenSinceRenew,
+		UpdatedAt:                                 timeToProto(info.UpdatedAt),
+		ReplicationAckLevel:                       info.ReplicationAckLevel,
+		TransferAckLevel:                          info.TransferAckLevel,
+		TimerAckLevel:                             timeToProto(info.TimerAckLevel),
+		DomainNotificationVersion:                 info.DomainNotificationVersion,
+		ClusterTransferAckLevel:                   info.ClusterTransferAckLevel,
+		Owner:                                     info.Owner,
+		ClusterReplicationLevel:                   info.ClusterReplicationLevel,
+		PendingFailoverMarkers:                    info.PendingFailoverMarkers,
+		PendingFailoverMarkersEncoding:            info.PendingFailoverMarkersEncoding,
+		ReplicationDlqAckLevel:                    info.ReplicationDlqAckLevel,
+		TransferProcessingQueueStates:             info.TransferProcessingQueueStates,
+		TransferProcessingQueueStatesEncoding:     info.TransferProcessingQueueStatesEncoding,
+		CrossClusterProcessingQueueStates:         info.CrossClusterProcessingQueueStates,
+		CrossClusterProcessingQueueStatesEncoding: info.CrossClusterProcessingQueueStatesEncoding,
+		TimerProcessingQueueStates:                info.TimerProcessingQueueStates,
+		TimerProcessingQueueStatesEncoding:        info.TimerProcessingQueueStatesEncoding,
+	}
+	if info.ClusterTimerAckLevel != nil {
+		result.ClusterTimerAckLevel = make(map[string]*gogo.Timestamp, len(info.ClusterTimerAckLevel))
+		for k, v := range info.ClusterTimerAckLevel {
+			result.ClusterTimerAckLevel[k] = timeToProto(v)
+		}
+	}
+	return result
+}
+
+func shardInfoFromProto(info *sqlblobsv1.ShardInfo) *ShardInfo {
+	if info == nil {
+		return nil
+	}
+	result := &ShardInfo{
+		StolenSinceRenew:                          info.StolenSinceRenew,
+		UpdatedAt:                                 timeFromProto(info.UpdatedAt),
+		ReplicationAckLevel:                       info.ReplicationAckLevel,
+		TransferAckLevel:                          info.TransferAckLevel,
+		TimerAckLevel:                             timeFromProto(info.TimerAckLevel),
+		DomainNotificationVersion:                 info.DomainNotificationVersion,
+		ClusterTransferAckLevel:                   info.ClusterTransferAckLevel,
+		Owner:                                     info.Owner,
+		ClusterReplicationLevel:                   info.ClusterReplicationLevel,
+		PendingFailoverMarkers:                    info.PendingFailoverMarkers,
+		PendingFailoverMarkersEncoding:            info.PendingFailoverMarkersEncoding,
+		ReplicationDlqAckLevel:                    info.ReplicationDlqAckLevel,
+		TransferProcessingQueueStates:             info.TransferProcessingQueueStates,
+		TransferProcessingQueueStatesEncoding:     info.TransferProcessingQueueStatesEncoding,
+		CrossClusterProcessingQueueStates:         info.CrossClusterProcessingQueueStates,
+		CrossClusterProcessingQueueStatesEncoding: info.CrossClusterProcessingQueueStatesEncoding,
+		TimerProcessingQueueStates:                info.TimerProcessingQueueStates,
+		TimerProcessingQueueStatesEncoding:        info.TimerProcessingQueueStatesEncoding,
+	}
+	if info.ClusterTimerAckLevel != nil {
+		result.ClusterTimerAckLevel = make(map[string]time.Time, len(info.ClusterTimerAckLevel))
+		for k, v := range info.ClusterTimerAckLevel {
+			result.ClusterTimerAckLevel[k] = timeFromProto(v)
+		}
+	}
+	return result
+}
+
+func domainInfoToProto(info *DomainInfo) *sqlblobsv1.DomainInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.DomainInfo{
+		Name:                        info.Name,
+		Description:                 info.Description,
+		Owner:                       info.Owner,
+		Status:                      info.Status,
+		Retention:                   durationToProto(info.Retention),
+		EmitMetric:                  info.EmitMetric,
+		ArchivalBucket:              info.ArchivalBucket,
+		ArchivalStatus:              int32(info.ArchivalStatus),
+		ConfigVersion:               info.ConfigVersion,
+		NotificationVersion:         info.NotificationVersion,
+		FailoverNotificationVersion: info.FailoverNotificationVersion,
+		FailoverVersion:             info.FailoverVersion,
+		ActiveClusterName:           info.ActiveClusterName,
+		Clusters:                    info.Clusters,
+		Data:                        info.Data,
+		BadBinaries:                 info.BadBinaries,
+		BadBinariesEncoding:         info.BadBinariesEncoding,
+		HistoryArchivalStatus:       int32(info.HistoryArchivalStatus),
+		HistoryArchivalUri:          info.HistoryArchivalURI,
+		VisibilityArchivalStatus:    int32(info.VisibilityArchivalStatus),
+		VisibilityArchivalUri:       info.VisibilityArchivalURI,
+		FailoverEndTimestamp:        timePtrToProto(info.FailoverEndTimestamp),
+		PreviousFailoverVersion:     info.PreviousFailoverVersion,
+		LastUpdatedTimestamp:        timeToProto(info.LastUpdatedTimestamp),
+		ArchivalRetention:           durationToProto(info.ArchivalRetention),
+	}
+}
+
+func domainInfoFromProto(info *sqlblobsv1.DomainInfo) *DomainInfo {
+	if info == nil {
+		return nil
+	}
+	return &DomainInfo{
+		Name:                        info.Name,
+		Description:                 info.Description,
+		Owner:                       info.Owner,
+		Status:                      info.Status,
+		Retention:                   durationFromProto(info.Retention),
+		EmitMetric:                  info.EmitMetric,
+		ArchivalBucket:              info.ArchivalBucket,
+		ArchivalStatus:              int16(info.ArchivalStatus),
+		ConfigVersion:               info.ConfigVersion,
+		NotificationVersion:         info.NotificationVersion,
+		FailoverNotificationVersion: info.FailoverNotificationVersion,
+		FailoverVersion:             info.FailoverVersion,
+		ActiveClusterName:           info.ActiveClusterName,
+		Clusters:                    info.Clusters,
+		Data:                        info.Data,
+		BadBinaries:                 info.BadBinaries,
+		BadBinariesEncoding:         info.BadBinariesEncoding,
+		HistoryArchivalStatus:       int16(info.HistoryArchivalStatus),
+		HistoryArchivalURI:          info.HistoryArchivalUri,
+		VisibilityArchivalStatus:    int16(info.VisibilityArchivalStatus),
+		VisibilityArchivalURI:       info.VisibilityArchivalUri,
+		FailoverEndTimestamp:        timePtrFromProto(info.FailoverEndTimestamp),
+		PreviousFailoverVersion:     info.PreviousFailoverVersion,
+		LastUpdatedTimestamp:        timeFromProto(info.LastUpdatedTimestamp),
+		ArchivalRetention:           durationFromProto(info.ArchivalRetention),
+	}
+}
+
+func historyTreeInfoToProto(info *HistoryTreeInfo) *sqlblobsv1.HistoryTreeInfo {
+	if info == nil {
+		return nil
+	}
+	result := &sqlblobsv1.HistoryTreeInfo{
+		CreatedTimestamp: timeToProto(info.CreatedTimestamp),
+		Info:             info.Info,
+	}
+	if info.Ancestors != nil {
+		result.Ancestors = make([]*sqlblobsv1.HistoryBranchRange, 0, len(info.Ancestors))
+		for _, ancestor := range info.Ancestors {
+			if ancestor == nil {
+				continue
+			}
+			result.Ancestors = append(result.Ancestors, &sqlblobsv1.HistoryBranchRange{
+				BranchId:    ancestor.BranchID,
+				BeginNodeId: ancestor.BeginNodeID,
+				EndNodeId:   ancestor.EndNodeID,
+			})
+		}
+	}
+	return result
+}
+
+func historyTreeInfoFromProto(info *sqlblobsv1.HistoryTreeInfo) *HistoryTreeInfo {
+	if info == nil {
+		return nil
+	}
+	result := &HistoryTreeInfo{
+		CreatedTimestamp: timeFromProto(info.CreatedTimestamp),
+		Info:             info.Info,
+	}
+	if info.Ancestors != nil {
+		result.Ancestors = make([]*types.HistoryBranchRange, 0, len(info.Ancestors))
+		for _, ancestor := range info.Ancestors {
+			result.Ancestors = append(result.Ancestors, &types.HistoryBranchRange{
+				BranchID:    ancestor.BranchId,
+				BeginNodeID: ancestor.BeginNodeId,
+				EndNodeID:   ancestor.EndNodeId,
+			})
+		}
+	}
+	return result
+}
+
+func workflowExecutionInfoToProto(info *WorkflowExecutionInfo) *sqlblobsv1.WorkflowExecutionInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.WorkflowExecutionInfo{
+		ParentDomainId:                     info.ParentDomainID,
+		ParentWorkflowId:                   info.ParentWorkflowID,
+		ParentRunId:                        info.ParentRunID,
+		InitiatedId:                        info.InitiatedID,
+		CompletionEventBatchId:             int64ValueToProto(info.CompletionEventBatchID),
+		CompletionEvent:                    info.CompletionEvent,
+		CompletionEventEncoding:            info.CompletionEventEncoding,
+		TaskList:                           info.TaskList,
+		IsCron:                             info.IsCron,
+		WorkflowTypeName:                   info.WorkflowTypeName,
+		WorkflowTimeout:                    durationToProto(info.WorkflowTimeout),
+		DecisionTaskTimeout:                durationToProto(info.DecisionTaskTimeout),
+		ExecutionContext:                   info.ExecutionContext,
+		State:                              info.State,
+		CloseStatus:                        info.CloseStatus,
+		StartVersion:                       info.StartVersion,
+		LastWriteEventId:                   int64ValueToProto(info.LastWriteEventID),
+		LastEventTaskId:                    info.LastEventTaskID,
+		LastFirstEventId:                   info.LastFirstEventID,
+		LastProcessedEvent:                 info.LastProcessedEvent,
+		StartTimestamp:                     timeToProto(info.StartTimestamp),
+		LastUpdatedTimestamp:               timeToProto(info.LastUpdatedTimestamp),
+		DecisionVersion:                    info.DecisionVersion,
+		DecisionScheduleId:                 info.DecisionScheduleID,
+		DecisionStartedId:                  info.DecisionStartedID,
+		DecisionTimeout:                    durationToProto(info.DecisionTimeout),
+		DecisionAttempt:                    info.DecisionAttempt,
+		DecisionStartedTimestamp:           timeToProto(info.DecisionStartedTimestamp),
+		DecisionScheduledTimestamp:         timeToProto(info.DecisionScheduledTimestamp),
+		CancelRequested:                    info.CancelRequested,
+		DecisionOriginalScheduledTimestamp: timeToProto(info.DecisionOriginalScheduledTimestamp),
+		CreateRequestId:                    info.CreateRequestID,
+		DecisionRequestId:                  info.DecisionRequestID,
+		CancelRequestId:                    info.CancelRequestID,
+		StickyTaskList:                     info.StickyTaskList,
+		StickyScheduleToStartTimeout:       durationToProto(info.StickyScheduleToStartTimeout),
+		RetryAttempt:                       info.RetryAttempt,
+		RetryInitialInterval:               durationToProto(info.RetryInitialInterval),
+		RetryMaximumInterval:               durationToProto(info.RetryMaximumInterval),
+		RetryMaximumAttempts:               info.RetryMaximumAttempts,
+		RetryExpiration:                    durationToProto(info.RetryExpiration),
+		RetryBackoffCoefficient:            info.RetryBackoffCoefficient,
+		RetryExpirationTimestamp:           timeToProto(info.RetryExpirationTimestamp),
+		RetryNonRetryableErrors:            info.RetryNonRetryableErrors,
+		HasRetryPolicy:                     info.HasRetryPolicy,
+		CronSchedule:                       info.CronSchedule,
+		EventStoreVersion:                  info.EventStoreVersion,
+		EventBranchToken:                   info.EventBranchToken,
+		SignalCount:                        info.SignalCount,
+		HistorySize:                        info.HistorySize,
+		ClientLibraryVersion:               info.ClientLibraryVersion,
+		ClientFeatureVersion:               info.ClientFeatureVersion,
+		ClientImpl:                         info.ClientImpl,
+		AutoResetPoints:                    info.AutoResetPoints,
+		AutoResetPointsEncoding:            info.AutoResetPointsEncoding,
+		SearchAttributes:                   info.SearchAttributes,
+		Memo:                               info.Memo,
+		VersionHistories:                   info.VersionHistories,
+		VersionHistoriesEncoding:           info.VersionHistoriesEncoding,
+	}
+}
+
+func workflowExecutionInfoFromProto(info *sqlblobsv1.WorkflowExecutionInfo) *WorkflowExecutionInfo {
+	if info == nil {
+		return nil
+	}
+	return &WorkflowExecutionInfo{
+		ParentDomainID:                     info.ParentDomainId,
+		ParentWorkflowID:                   info.ParentWorkflowId,
+		ParentRunID:                        info.ParentRunId,
+		InitiatedID:                        info.InitiatedId,
+		CompletionEventBatchID:             int64ValueFromProto(info.CompletionEventBatchId),
+		CompletionEvent:                    info.CompletionEvent,
+		CompletionEventEncoding:            info.CompletionEventEncoding,
+		TaskList:                           info.TaskList,
+		IsCron:                             info.IsCron,
+		WorkflowTypeName:                   info.WorkflowTypeName,
+		WorkflowTimeout:                    durationFromProto(info.WorkflowTimeout),
+		DecisionTaskTimeout:                durationFromProto(info.DecisionTaskTimeout),
+		ExecutionContext:                   info.ExecutionContext,
+		State:                              info.State,
+		CloseStatus:                        info.CloseStatus,
+		StartVersion:                       info.StartVersion,
+		LastWriteEventID:                   int64ValueFromProto(info.LastWriteEventId),
+		LastEventTaskID:                    info.LastEventTaskId,
+		LastFirstEventID:                   info.LastFirstEventId,
+		LastProcessedEvent:                 info.LastProcessedEvent,
+		StartTimestamp:                     timeFromProto(info.StartTimestamp),
+		LastUpdatedTimestamp:               timeFromProto(info.LastUpdatedTimestamp),
+		DecisionVersion:                    info.DecisionVersion,
+		DecisionScheduleID:                 info.DecisionScheduleId,
+		DecisionStartedID:                  info.DecisionStartedId,
+		DecisionTimeout:                    durationFromProto(info.DecisionTimeout),
+		DecisionAttempt:                    info.DecisionAttempt,
+		DecisionStartedTimestamp:           timeFromProto(info.DecisionStartedTimestamp),
+		DecisionScheduledTimestamp:         timeFromProto(info.DecisionScheduledTimestamp),
+		CancelRequested:                    info.CancelRequested,
+		DecisionOriginalScheduledTimestamp: timeFromProto(info.DecisionOriginalScheduledTimestamp),
+		CreateRequestID:                    info.CreateRequestId,
+		DecisionRequestID:                  info.DecisionRequestId,
+		CancelRequestID:                    info.CancelRequestId,
+		StickyTaskList:                     info.StickyTaskList,
+		StickyScheduleToStartTimeout:       durationFromProto(info.StickyScheduleToStartTimeout),
+		RetryAttempt:                       info.RetryAttempt,
+		RetryInitialInterval:               durationFromProto(info.RetryInitialInterval),
+		RetryMaximumInterval:               durationFromProto(info.RetryMaximumInterval),
+		RetryMaximumAttempts:               info.RetryMaximumAttempts,
+		RetryExpiration:                    durationFromProto(info.RetryExpiration),
+		RetryBackoffCoefficient:            info.RetryBackoffCoefficient,
+		RetryExpirationTimestamp:           timeFromProto(info.RetryExpirationTimestamp),
+		RetryNonRetryableErrors:            info.RetryNonRetryableErrors,
+		HasRetryPolicy:                     info.HasRetryPolicy,
+		CronSchedule:                       info.CronSchedule,
+		EventStoreVersion:                  info.EventStoreVersion,
+		EventBranchToken:                   info.EventBranchToken,
+		SignalCount:                        info.SignalCount,
+		HistorySize:                        info.HistorySize,
+		ClientLibraryVersion:               info.ClientLibraryVersion,
+		ClientFeatureVersion:               info.ClientFeatureVersion,
+		ClientImpl:                         info.ClientImpl,
+		AutoResetPoints:                    info.AutoResetPoints,
+		AutoResetPointsEncoding:            info.AutoResetPointsEncoding,
+		SearchAttributes:                   info.SearchAttributes,
+		Memo:                               info.Memo,
+		VersionHistories:                   info.VersionHistories,
+		VersionHistoriesEncoding:           info.VersionHistoriesEncoding,
+	}
+}
+
+func activityInfoToProto(info *ActivityInfo) *sqlblobsv1.ActivityInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.ActivityInfo{
+		Version:                  info.Version,
+		ScheduledEventBatchId:    info.ScheduledEventBatchID,
+		ScheduledEvent:           info.ScheduledEvent,
+		ScheduledEventEncoding:   info.ScheduledEventEncoding,
+		ScheduledTimestamp:       timeToProto(info.ScheduledTimestamp),
+		StartedId:                info.StartedID,
+		StartedEvent:             info.StartedEvent,
+		StartedEventEncoding:     info.StartedEventEncoding,
+		StartedTimestamp:         timeToProto(info.StartedTimestamp),
+		ActivityId:               info.ActivityID,
+		RequestId:                info.RequestID,
+		ScheduleToStartTimeout:   durationToProto(info.ScheduleToStartTimeout),
+		ScheduleToCloseTimeout:   durationToProto(info.ScheduleToCloseTimeout),
+		StartToCloseTimeout:      durationToProto(info.StartToCloseTimeout),
+		HeartbeatTimeout:         durationToProto(info.HeartbeatTimeout),
+		CancelRequested:          info.CancelRequested,
+		CancelRequestId:          info.CancelRequestID,
+		TimerTaskStatus:          info.TimerTaskStatus,
+		Attempt:                  info.Attempt,
+		TaskList:                 info.TaskList,
+		StartedIdentity:          info.StartedIdentity,
+		HasRetryPolicy:           info.HasRetryPolicy,
+		RetryInitialInterval:     durationToProto(info.RetryInitialInterval),
+		RetryMaximumInterval:     durationToProto(info.RetryMaximumInterval),
+		RetryMaximumAttempts:     info.RetryMaximumAttempts,
+		RetryExpirationTimestamp: timeToProto(info.RetryExpirationTimestamp),
+		RetryBackoffCoefficient:  info.RetryBackoffCoefficient,
+		RetryNonRetryableErrors:  info.RetryNonRetryableErrors,
+		RetryLastFailureReason:   info.RetryLastFailureReason,
+		RetryLastWorkerIdentity:  info.RetryLastWorkerIdentity,
+		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+	}
+}
+
+func activityInfoFromProto(info *sqlblobsv1.ActivityInfo) *ActivityInfo {
+	if info == nil {
+		return nil
+	}
+	return &ActivityInfo{
+		Version:                  info.Version,
+		ScheduledEventBatchID:    info.ScheduledEventBatchId,
+		ScheduledEvent:           info.ScheduledEvent,
+		ScheduledEventEncoding:   info.ScheduledEventEncoding,
+		ScheduledTimestamp:       timeFromProto(info.ScheduledTimestamp),
+		StartedID:                info.StartedId,
+		StartedEvent:             info.StartedEvent,
+		StartedEventEncoding:     info.StartedEventEncoding,
+		StartedTimestamp:         timeFromProto(info.StartedTimestamp),
+		ActivityID:               info.ActivityId,
+		RequestID:                info.RequestId,
+		ScheduleToStartTimeout:   durationFromProto(info.ScheduleToStartTimeout),
+		ScheduleToCloseTimeout:   durationFromProto(info.ScheduleToCloseTimeout),
+		StartToCloseTimeout:      durationFromProto(info.StartToCloseTimeout),
+		HeartbeatTimeout:         durationFromProto(info.HeartbeatTimeout),
+		CancelRequested:          info.CancelRequested,
+		CancelRequestID:          info.CancelRequestId,
+		TimerTaskStatus:          info.TimerTaskStatus,
+		Attempt:                  info.Attempt,
+		TaskList:                 info.TaskList,
+		StartedIdentity:          info.StartedIdentity,
+		HasRetryPolicy:           info.HasRetryPolicy,
+		RetryInitialInterval:     durationFromProto(info.RetryInitialInterval),
+		RetryMaximumInterval:     durationFromProto(info.RetryMaximumInterval),
+		RetryMaximumAttempts:     info.RetryMaximumAttempts,
+		RetryExpirationTimestamp: timeFromProto(info.RetryExpirationTimestamp),
+		RetryBackoffCoefficient:  info.RetryBackoffCoefficient,
+		RetryNonRetryableErrors:  info.RetryNonRetryableErrors,
+		RetryLastFailureReason:   info.RetryLastFailureReason,
+		RetryLastWorkerIdentity:  info.RetryLastWorkerIdentity,
+		RetryLastFailureDetails:  info.RetryLastFailureDetails,
+	}
+}
+
+func childExecutionInfoToProto(info *ChildExecutionInfo) *sqlblobsv1.ChildExecutionInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.ChildExecutionInfo{
+		Version:                info.Version,
+		InitiatedEventBatchId:  info.InitiatedEventBatchID,
+		StartedId:              info.StartedID,
+		InitiatedEvent:         info.InitiatedEvent,
+		InitiatedEventEncoding: info.InitiatedEventEncoding,
+		StartedWorkflowId:      info.StartedWorkflowID,
+		StartedRunId:           info.StartedRunID,
+		StartedEvent:           info.StartedEvent,
+		StartedEventEncoding:   info.StartedEventEncoding,
+		CreateRequestId:        info.CreateRequestID,
+		DomainId:               info.DomainID,
+		DomainName:             info.DomainNameDEPRECATED,
+		WorkflowTypeName:       info.WorkflowTypeName,
+		ParentClosePolicy:      info.ParentClosePolicy,
+	}
+}
+
+func childExecutionInfoFromProto(info *sqlblobsv1.ChildExecutionInfo) *ChildExecutionInfo {
+	if info == nil {
+		return nil
+	}
+	return &ChildExecutionInfo{
+		Version:                info.Version,
+		InitiatedEventBatchID:  info.InitiatedEventBatchId,
+		StartedID:              info.StartedId,
+		InitiatedEvent:         info.InitiatedEvent,
+		InitiatedEventEncoding: info.InitiatedEventEncoding,
+		StartedWorkflowID:      info.StartedWorkflowId,
+		StartedRunID:           info.StartedRunId,
+		StartedEvent:           info.StartedEvent,
+		StartedEventEncoding:   info.StartedEventEncoding,
+		CreateRequestID:        info.CreateRequestId,
+		DomainID:               info.DomainId,
+		DomainNameDEPRECATED:   info.DomainName,
+		WorkflowTypeName:       info.WorkflowTypeName,
+		ParentClosePolicy:      info.ParentClosePolicy,
+	}
+}
+
+func signalInfoToProto(info *SignalInfo) *sqlblobsv1.SignalInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.SignalInfo{
+		Version:               info.Version,
+		InitiatedEventBatchId: info.InitiatedEventBatchID,
+		RequestId:             info.RequestID,
+		Name:                  info.Name,
+		Input:                 info.Input,
+		Control:               info.Control,
+	}
+}
+
+func signalInfoFromProto(info *sqlblobsv1.SignalInfo) *SignalInfo {
+	if info == nil {
+		return nil
+	}
+	return &SignalInfo{
+		Version:               info.Version,
+		InitiatedEventBatchID: info.InitiatedEventBatchId,
+		RequestID:             info.RequestId,
+		Name:                  info.Name,
+		Input:                 info.Input,
+		Control:               info.Control,
+	}
+}
+
+func requestCancelInfoToProto(info *RequestCancelInfo) *sqlblobsv1.RequestCancelInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.RequestCancelInfo{
+		Version:               info.Version,
+		InitiatedEventBatchId: info.InitiatedEventBatchID,
+		CancelRequestId:       info.CancelRequestID,
+	}
+}
+
+func requestCancelInfoFromProto(info *sqlblobsv1.RequestCancelInfo) *RequestCancelInfo {
+	if info == nil {
+		return nil
+	}
+	return &RequestCancelInfo{
+		Version:               info.Version,
+		InitiatedEventBatchID: info.InitiatedEventBatchId,
+		CancelRequestID:       info.CancelRequestId,
+	}
+}
+
+func timerInfoToProto(info *TimerInfo) *sqlblobsv1.TimerInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.TimerInfo{
+		Version:         info.Version,
+		StartedId:       info.StartedID,
+		ExpiryTimestamp: timeToProto(info.ExpiryTimestamp),
+		TaskId:          info.TaskID,
+	}
+}
+
+func timerInfoFromProto(info *sqlblobsv1.TimerInfo) *TimerInfo {
+	if info == nil {
+		return nil
+	}
+	return &TimerInfo{
+		Version:         info.Version,
+		StartedID:       info.StartedId,
+		ExpiryTimestamp: timeFromProto(info.ExpiryTimestamp),
+		TaskID:          info.TaskId,
+	}
+}
+
+func taskInfoToProto(info *TaskInfo) *sqlblobsv1.TaskInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.TaskInfo{
+		WorkflowId:       info.WorkflowID,
+		RunId:            info.RunID,
+		ScheduleId:       info.ScheduleID,
+		ExpiryTimestamp:  timeToProto(info.ExpiryTimestamp),
+		CreatedTimestamp: timeToProto(info.CreatedTimestamp),
+	}
+}
+
+func taskInfoFromProto(info *sqlblobsv1.TaskInfo) *TaskInfo {
+	if info == nil {
+		return nil
+	}
+	return &TaskInfo{
+		WorkflowID:       info.WorkflowId,
+		RunID:            info.RunId,
+		ScheduleID:       info.ScheduleId,
+		ExpiryTimestamp:  timeFromProto(info.ExpiryTimestamp),
+		CreatedTimestamp: timeFromProto(info.CreatedTimestamp),
+	}
+}
+
+func taskListInfoToProto(info *TaskListInfo) *sqlblobsv1.TaskListInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.TaskListInfo{
+		Kind:            int32(info.Kind),
+		AckLevel:        info.AckLevel,
+		ExpiryTimestamp: timeToProto(info.ExpiryTimestamp),
+		LastUpdated:     timeToProto(info.LastUpdated),
+	}
+}
+
+func taskListInfoFromProto(info *sqlblobsv1.TaskListInfo) *TaskListInfo {
+	if info == nil {
+		return nil
+	}
+	return &TaskListInfo{
+		Kind:            int16(info.Kind),
+		AckLevel:        info.AckLevel,
+		ExpiryTimestamp: timeFromProto(info.ExpiryTimestamp),
+		LastUpdated:     timeFromProto(info.LastUpdated),
+	}
+}
+
+func transferTaskInfoToProto(info *TransferTaskInfo) *sqlblobsv1.TransferTaskInfo {
+	if info == nil {
+		return nil
+	}
+	protoTaskInfo := &sqlblobsv1.TransferTaskInfo{
+		DomainId:       info.DomainID,
+		WorkflowId:     info.WorkflowID,
+		RunId:          info.RunID,
+		TaskType:       int32(info.TaskType),
+		TargetDomainId: info.TargetDomainID,
+		// TargetDomainIDs will be assigned below
+		TargetWorkflowId:        info.TargetWorkflowID,
+		TargetRunId:             info.TargetRunID,
+		TaskList:                info.TaskList,
+		TargetChildWorkflowOnly: info.TargetChildWorkflowOnly,
+		ScheduleId:              info.ScheduleID,
+		Version:                 info.Version,
+		VisibilityTimestamp:     timeToProto(info.VisibilityTimestamp),
+	}
+	if len(info.TargetDomainIDs) > 0 {
+		protoTaskInfo.TargetDomainIds = make([][]byte, 0, len(info.TargetDomainIDs))
+		for _, domainID := range info.TargetDomainIDs {
+			protoTaskInfo.TargetDomainIds = append(protoTaskInfo.TargetDomainIds, domainID)
+		}
+	}
+	return protoTaskInfo
+}
+
+func transferTaskInfoFromProto(info *sqlblobsv1.TransferTaskInfo) *TransferTaskInfo {
+	if info == nil {
+		return nil
+	}
+	transferTaskInfo := &TransferTaskInfo{
+		DomainID:       info.DomainId,
+		WorkflowID:     info.WorkflowId,
+		RunID:          info.RunId,
+		TaskType:       int16(info.TaskType),
+		TargetDomainID: info.TargetDomainId,
+		// TargetDomainIDs will be assigned below
+		TargetWorkflowID:        info.TargetWorkflowId,
+		TargetRunID:             info.TargetRunId,
+		TaskList:                info.TaskList,
+		TargetChildWorkflowOnly: info.TargetChildWorkflowOnly,
+		ScheduleID:              info.ScheduleId,
+		Version:                 info.Version,
+		VisibilityTimestamp:     timeFromProto(info.VisibilityTimestamp),
+	}
+	if len(info.TargetDomainIds) > 0 {
+		transferTaskInfo.TargetDomainIDs = make([]UUID, 0, len(info.TargetDomainIds))
+		for _, domainID := range info.TargetDomainIds {
+			transferTaskInfo.TargetDomainIDs = append(transferTaskInfo.TargetDomainIDs, domainID)
+		}
+	}
+	return transferTaskInfo
+}
+
+func crossClusterTaskInfoToProto(info *CrossClusterTaskInfo) *sqlblobsv1.TransferTaskInfo {
+	return transferTaskInfoToProto(info)
+}
+
+func crossClusterTaskInfoFromProto(info *sqlblobsv1.TransferTaskInfo) *CrossClusterTaskInfo {
+	return transferTaskInfoFromProto(info)
+}
+
+func timerTaskInfoToProto(info *TimerTaskInfo) *sqlblobsv1.TimerTaskInfo {
+	if info == nil {
+		return nil
+	}
+	result := &sqlblobsv1.TimerTaskInfo{
+		DomainId:        info.DomainID,
+		WorkflowId:      info.WorkflowID,
+		RunId:           info.RunID,
+		TaskType:        int32(info.TaskType),
+		Version:         info.Version,
+		ScheduleAttempt: info.ScheduleAttempt,
+		EventId:         info.EventID,
+	}
+	if info.TimeoutType != nil {
+		result.TimeoutType = &gogo.Int32Value{Value: int32(*info.TimeoutType)}
+	}
+	return result
+}
+
+func timerTaskInfoFromProto(info *sqlblobsv1.TimerTaskInfo) *TimerTaskInfo {
+	if info == nil {
+		return nil
+	}
+	result := &TimerTaskInfo{
+		DomainID:        info.DomainId,
+		WorkflowID:      info.WorkflowId,
+		RunID:           info.RunId,
+		TaskType:        int16(info.TaskType),
+		Version:         info.Version,
+		ScheduleAttempt: info.ScheduleAttempt,
+		EventID:         info.EventId,
+	}
+	if info.TimeoutType != nil {
+		result.TimeoutType = common.Int16Ptr(int16(info.TimeoutType.Value))
+	}
+	return result
+}
+
+func replicationTaskInfoToProto(info *ReplicationTaskInfo) *sqlblobsv1.ReplicationTaskInfo {
+	if info == nil {
+		return nil
+	}
+	return &sqlblobsv1.ReplicationTaskInfo{
+		DomainId:                info.DomainID,
+		WorkflowId:              info.WorkflowID,
+		RunId:                   info.RunID,
+		TaskType:                int32(info.TaskType),
+		Version:                 info.Version,
+		FirstEventId:            info.FirstEventID,
+		NextEventId:             info.NextEventID,
+		ScheduledId:             info.ScheduledID,
+		EventStoreVersion:       info.EventStoreVersion,
+		NewRunEventStoreVersion: info.NewRunEventStoreVersion,
+		BranchToken:             info.BranchToken,
+		NewRunBranchToken:       info.NewRunBranchToken,
+		CreationTimestamp:       timeToProto(info.CreationTimestamp),
+	}
+}
+
+func replicationTaskInfoFromProto(info *sqlblobsv1.ReplicationTaskInfo) *ReplicationTaskInfo {
+	if info == nil {
+		return nil
+	}
+	return &ReplicationTaskInfo{
+		DomainID:                info.DomainId,
+		WorkflowID:              info.WorkflowId,
+		RunID:                   info.RunId,
+		TaskType:                int16(info.TaskType),
+		Version:                 info.Version,
+		FirstEventID:            info.FirstEventId,
+		NextEventID:             info.NextEventId,
+		ScheduledID:             info.ScheduledId,
+		EventStoreVersion:       info.EventStoreVersion,
+		NewRunEventStoreVersion: info.NewRunEventStoreVersion,
+		BranchToken:             info.BranchToken,
+		NewRunBranchToken:       info.NewRunBranchToken,
+		CreationTimestamp:       timeFromProto(info.CreationTimestamp),
+	}
+}
+
+// timeToProto leaves zero time unset, so that it decodes back to zero time
+func timeToProto(t time.Time) *gogo.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return &gogo.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+func timePtrToProto(t *time.Time) *gogo.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return &gogo.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+func timeFromProto(t *gogo.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return time.Unix(t.Seconds, int64(t.Nanos))
+}
+
+func timePtrFromProto(t *gogo.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	return common.TimePtr(time.Unix(t.Seconds, int64(t.Nanos)))
+}
+
+func durationToProto(d time.Duration) *gogo.Duration {
+	if d == 0 {
+		return nil
+	}
+	return gogo.DurationProto(d)
+}
+
+func durationFromProto(d *gogo.Duration) time.Duration {
+	if d == nil {
+		return 0
+	}
+	return time.Duration(d.Seconds)*time.Second + time.Duration(d.Nanos)
+}
+
+func int64ValueToProto(v *int64) *gogo.Int64Value {
+	if v == nil {
+		return nil
+	}
+	return &gogo.Int64Value{Value: *v}
+}
+
+func int64ValueFromProto(v *gogo.Int64Value) *int64 {
+	if v == nil {
+		return nil
+	}
+	return common.Int64Ptr(v.Value)
+}
@@ -0,0 +1,318 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package serialization
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestProtoShardInfo(t *testing.T) {
+	now := time.Unix(0, time.Now().UnixNano())
+	expected := &ShardInfo{
+		StolenSinceRenew:                          int32(rand.Intn(1000)),
+		UpdatedAt:                                 now,
+		ReplicationAckLevel:                       int64(rand.Intn(1000)),
+		TransferAckLevel:                          int64(rand.Intn(1000)),
+		TimerAckLevel:                             now.Add(-time.Minute),
+		DomainNotificationVersion:                 int64(rand.Intn(1000)),
+		ClusterTransferAckLevel:                   map[string]int64{"key_1": int64(rand.Intn(1000)), "key_2": int64(rand.Intn(1000))},
+		ClusterTimerAckLevel:                      map[string]time.Time{"key_1": now, "key_2": now.Add(time.Second)},
+		Owner:                                     "test_owner",
+		ClusterReplicationLevel:                   map[string]int64{"key_1": int64(rand.Intn(1000))},
+		PendingFailoverMarkers:                    []byte("PendingFailoverMarkers"),
+		PendingFailoverMarkersEncoding:            "PendingFailoverMarkersEncoding",
+		ReplicationDlqAckLevel:                    map[string]int64{"key_1": int64(rand.Intn(1000))},
+		TransferProcessingQueueStates:             []byte("TransferProcessingQueueStates"),
+		TransferProcessingQueueStatesEncoding:     "TransferProcessingQueueStatesEncoding",
+		CrossClusterProcessingQueueStates:         []byte("CrossClusterProcessingQueueStates"),
+		CrossClusterProcessingQueueStatesEncoding: "CrossClusterProcessingQueueStatesEncoding",
+		TimerProcessingQueueStates:                []byte("TimerProcessingQueueStates"),
+		TimerProcessingQueueStatesEncoding:        "TimerProcessingQueueStatesEncoding",
+	}
+	blob, err := newProtoEncoder().shardInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().shardInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtoDomainInfo(t *testing.T) {
+	now := time.Unix(0, time.Now().UnixNano())
+	expected := &DomainInfo{
+		Name:                        "domain_name",
+		Description:                 "description",
+		Owner:                       "owner",
+		Status:                      int32(rand.Intn(1000)),
+		Retention:                   common.DaysToDuration(int32(rand.Intn(30))),
+		EmitMetric:                  true,
+		ArchivalBucket:              "archival_bucket",
+		ArchivalStatus:              int16(rand.Intn(1000)),
+		ConfigVersion:               int64(rand.Intn(1000)),
+		NotificationVersion:         int64(rand.Intn(1000)),
+		FailoverNotificationVersion: int64(rand.Intn(1000)),
+		FailoverVersion:             int64(rand.Intn(1000)),
+		ActiveClusterName:           "ActiveClusterName",
+		Clusters:                    []string{"cluster_a", "cluster_b"},
+		Data:                        map[string]string{"key_1": "value_1", "key_2": "value_2"},
+		BadBinaries:                 []byte("BadBinaries"),
+		BadBinariesEncoding:         "BadBinariesEncoding",
+		HistoryArchivalStatus:       int16(rand.Intn(1000)),
+		HistoryArchivalURI:          "HistoryArchivalURI",
+		VisibilityArchivalStatus:    int16(rand.Intn(1000)),
+		VisibilityArchivalURI:       "VisibilityArchivalURI",
+		FailoverEndTimestamp:        common.TimePtr(now),
+		PreviousFailoverVersion:     int64(rand.Intn(1000)),
+		LastUpdatedTimestamp:        now,
+	}
+	blob, err := newProtoEncoder().domainInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().domainInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	// zero values must survive the round trip as well
+	expected = &DomainInfo{Name: "domain_name"}
+	blob, err = newProtoEncoder().domainInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err = newProtoDecoder().domainInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+	assert.Nil(t, actual.FailoverEndTimestamp)
+	assert.True(t, actual.LastUpdatedTimestamp.IsZero())
+}
+
+func TestProtoHistoryTreeInfo(t *testing.T) {
+	expected := &HistoryTreeInfo{
+		CreatedTimestamp: time.Unix(0, time.Now().UnixNano()),
+		Ancestors: []*types.HistoryBranchRange{
+			{
+				BranchID:    "branch_id",
+				BeginNodeID: int64(rand.Intn(1000)),
+				EndNodeID:   int64(rand.Intn(1000)),
+			},
+			{
+				BranchID:    "branch_id",
+				BeginNodeID: int64(rand.Intn(1000)),
+				EndNodeID:   int64(rand.Intn(1000)),
+			},
+		},
+		Info: "info",
+	}
+	blob, err := newProtoEncoder().historyTreeInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().historyTreeInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtoWorkflowExecutionInfo(t *testing.T) {
+	now := time.Unix(0, time.Now().UnixNano())
+	expected := &WorkflowExecutionInfo{
+		ParentDomainID:                     UUID(uuid.NewRandom()),
+		ParentWorkflowID:                   "ParentWorkflowID",
+		ParentRunID:                        UUID(uuid.NewRandom()),
+		InitiatedID:                        int64(rand.Intn(1000)),
+		CompletionEventBatchID:             common.Int64Ptr(0),
+		CompletionEvent:                    []byte("CompletionEvent"),
+		CompletionEventEncoding:            "CompletionEventEncoding",
+		TaskList:                           "TaskList",
+		IsCron:                             true,
+		WorkflowTypeName:                   "WorkflowTypeName",
+		WorkflowTimeout:                    time.Minute * time.Duration(rand.Intn(10)+1),
+		DecisionTaskTimeout:                time.Second * time.Duration(rand.Intn(10)+1),
+		ExecutionContext:                   []byte("ExecutionContext"),
+		State:                              int32(rand.Intn(1000)),
+		CloseStatus:                        int32(rand.Intn(1000)),
+		StartVersion:                       int64(rand.Intn(1000)),
+		LastWriteEventID:                   common.Int64Ptr(int64(rand.Intn(1000))),
+		LastEventTaskID:                    int64(rand.Intn(1000)),
+		LastFirstEventID:                   int64(rand.Intn(1000)),
+		LastProcessedEvent:                 int64(rand.Intn(1000)),
+		StartTimestamp:                     now,
+		LastUpdatedTimestamp:               now.Add(time.Second),
+		DecisionVersion:                    int64(rand.Intn(1000)),
+		DecisionScheduleID:                 int64(rand.Intn(1000)),
+		DecisionStartedID:                  int64(rand.Intn(1000)),
+		DecisionTimeout:                    time.Second * time.Duration(rand.Intn(10)+1),
+		DecisionAttempt:                    int64(rand.Intn(1000)),
+		DecisionStartedTimestamp:           now.Add(2 * time.Second),
+		DecisionScheduledTimestamp:         now.Add(3 * time.Second),
+		CancelRequested:                    true,
+		DecisionOriginalScheduledTimestamp: now.Add(4 * time.Second),
+		CreateRequestID:                    "CreateRequestID",
+		DecisionRequestID:                  "DecisionRequestID",
+		CancelRequestID:                    "CancelRequestID",
+		StickyTaskList:                     "StickyTaskList",
+		StickyScheduleToStartTimeout:       time.Second * time.Duration(rand.Intn(10)+1),
+		RetryAttempt:                       int64(rand.Intn(1000)),
+		RetryInitialInterval:               time.Second * time.Duration(rand.Intn(10)+1),
+		RetryMaximumInterval:               time.Minute * time.Duration(rand.Intn(10)+1),
+		RetryMaximumAttempts:               int32(rand.Intn(1000)),
+		RetryExpiration:                    time.Hour * time.Duration(rand.Intn(10)+1),
+		RetryBackoffCoefficient:            rand.Float64() * 1000,
+		RetryExpirationTimestamp:           now.Add(time.Hour),
+		RetryNonRetryableErrors:            []string{"RetryNonRetryableError1", "RetryNonRetryableError2"},
+		HasRetryPolicy:                     true,
+		CronSchedule:                       "CronSchedule",
+		EventStoreVersion:                  int32(rand.Intn(1000)),
+		EventBranchToken:                   []byte("EventBranchToken"),
+		SignalCount:                        int64(rand.Intn(1000)),
+		HistorySize:                        int64(rand.Intn(1000)),
+		ClientLibraryVersion:               "ClientLibraryVersion",
+		ClientFeatureVersion:               "ClientFeatureVersion",
+		ClientImpl:                         "ClientImpl",
+		AutoResetPoints:                    []byte("AutoResetPoints"),
+		AutoResetPointsEncoding:            "AutoResetPointsEncoding",
+		SearchAttributes:                   map[string][]byte{"key_1": []byte("SearchAttribute1")},
+		Memo:                               map[string][]byte{"key_1": []byte("Memo1")},
+		VersionHistories:                   []byte("VersionHistories"),
+		VersionHistoriesEncoding:           "VersionHistoriesEncoding",
+	}
+	blob, err := newProtoEncoder().workflowExecutionInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().workflowExecutionInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtoActivityInfo(t *testing.T) {
+	now := time.Unix(0, time.Now().UnixNano())
+	expected := &ActivityInfo{
+		Version:                  int64(rand.Intn(1000)),
+		ScheduledEventBatchID:    int64(rand.Intn(1000)),
+		ScheduledEvent:           []byte("ScheduledEvent"),
+		ScheduledEventEncoding:   "ScheduledEventEncoding",
+		ScheduledTimestamp:       now,
+		StartedID:                int64(rand.Intn(1000)),
+		StartedEvent:             []byte("StartedEvent"),
+		StartedEventEncoding:     "StartedEventEncoding",
+		StartedTimestamp:         now.Add(time.Second),
+		ActivityID:               "ActivityID",
+		RequestID:                "RequestID",
+		ScheduleToStartTimeout:   time.Second * time.Duration(rand.Intn(10)+1),
+		ScheduleToCloseTimeout:   time.Second * time.Duration(rand.Intn(10)+1),
+		StartToCloseTimeout:      time.Second * time.Duration(rand.Intn(10)+1),
+		HeartbeatTimeout:         time.Second * time.Duration(rand.Intn(10)+1),
+		CancelRequested:          true,
+		CancelRequestID:          int64(rand.Intn(1000)),
+		TimerTaskStatus:          int32(rand.Intn(1000)),
+		Attempt:                  int32(rand.Intn(1000)),
+		TaskList:                 "TaskList",
+		StartedIdentity:          "StartedIdentity",
+		HasRetryPolicy:           true,
+		RetryInitialInterval:     time.Second * time.Duration(rand.Intn(10)+1),
+		RetryMaximumInterval:     time.Second * time.Duration(rand.Intn(10)+1),
+		RetryMaximumAttempts:     int32(rand.Intn(1000)),
+		RetryExpirationTimestamp: now.Add(time.Hour),
+		RetryBackoffCoefficient:  rand.Float64() * 1000,
+		RetryNonRetryableErrors:  []string{"RetryNonRetryableError1", "RetryNonRetryableError2"},
+		RetryLastFailureReason:   "RetryLastFailureReason",
+		RetryLastWorkerIdentity:  "RetryLastWorkerIdentity",
+		RetryLastFailureDetails:  []byte("RetryLastFailureDetails"),
+	}
+	blob, err := newProtoEncoder().activityInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().activityInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtoTransferTaskInfo(t *testing.T) {
+	expected := &TransferTaskInfo{
+		DomainID:                UUID(uuid.NewRandom()),
+		WorkflowID:              "WorkflowID",
+		RunID:                   UUID(uuid.NewRandom()),
+		TaskType:                int16(rand.Intn(1000)),
+		TargetDomainID:          UUID(uuid.NewRandom()),
+		TargetDomainIDs:         []UUID{UUID(uuid.NewRandom()), UUID(uuid.NewRandom())},
+		TargetWorkflowID:        "TargetWorkflowID",
+		TargetRunID:             UUID(uuid.NewRandom()),
+		TaskList:                "TaskList",
+		TargetChildWorkflowOnly: true,
+		ScheduleID:              int64(rand.Intn(1000)),
+		Version:                 int64(rand.Intn(1000)),
+		VisibilityTimestamp:     time.Unix(0, time.Now().UnixNano()),
+	}
+	blob, err := newProtoEncoder().transferTaskInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().transferTaskInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
+
+func TestProtoTimerTaskInfo(t *testing.T) {
+	expected := &TimerTaskInfo{
+		DomainID:        UUID(uuid.NewRandom()),
+		WorkflowID:      "WorkflowID",
+		RunID:           UUID(uuid.NewRandom()),
+		TaskType:        int16(rand.Intn(1000)),
+		TimeoutType:     common.Int16Ptr(0),
+		Version:         int64(rand.Intn(1000)),
+		ScheduleAttempt: int64(rand.Intn(1000)),
+		EventID:         int64(rand.Intn(1000)),
+	}
+	blob, err := newProtoEncoder().timerTaskInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().timerTaskInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	expected.TimeoutType = nil
+	blob, err = newProtoEncoder().timerTaskInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err = newProtoDecoder().timerTaskInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Nil(t, actual.TimeoutType)
+}
+
+func TestProtoReplicationTaskInfo(t *testing.T) {
+	expected := &ReplicationTaskInfo{
+		DomainID:                UUID(uuid.NewRandom()),
+		WorkflowID:              "WorkflowID",
+		RunID:                   UUID(uuid.NewRandom()),
+		TaskType:                int16(rand.Intn(1000)),
+		Version:                 int64(rand.Intn(1000)),
+		FirstEventID:            int64(rand.Intn(1000)),
+		NextEventID:             int64(rand.Intn(1000)),
+		ScheduledID:             int64(rand.Intn(1000)),
+		EventStoreVersion:       int32(rand.Intn(1000)),
+		NewRunEventStoreVersion: int32(rand.Intn(1000)),
+		BranchToken:             []byte("BranchToken"),
+		NewRunBranchToken:       []byte("NewRunBranchToken"),
+		CreationTimestamp:       time.Unix(0, time.Now().UnixNano()),
+	}
+	blob, err := newProtoEncoder().replicationTaskInfoToBlob(expected)
+	require.NoError(t, err)
+	actual, err := newProtoDecoder().replicationTaskInfoFromBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+}
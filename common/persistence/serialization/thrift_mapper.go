@@ -126,6 +126,7 @@ func domainInfoToThrift(info *DomainInfo) *sqlblobs.DomainInfo {
 		RetentionDays:               durationToDaysInt16Ptr(info.Retention),
 		FailoverEndTime:             unixNanoPtr(info.FailoverEndTimestamp),
 		LastUpdatedTime:             timeToUnixNanoPtr(info.LastUpdatedTimestamp),
+		ArchivalRetentionDays:       common.Int32Ptr(common.DurationToDays(info.ArchivalRetention)),
 	}
 }
 
@@ -158,6 +159,7 @@ func domainInfoFromThrift(info *sqlblobs.DomainInfo) *DomainInfo {
 		Retention:                   common.DaysToDuration(int32(info.GetRetentionDays())),
 		FailoverEndTimestamp:        timePtr(info.FailoverEndTime),
 		LastUpdatedTimestamp:        timeFromUnixNano(info.GetLastUpdatedTime()),
+		ArchivalRetention:           common.DaysToDuration(info.GetArchivalRetentionDays()),
 	}
 }
 
@@ -28,6 +28,7 @@ import (
 	"github.com/uber/cadence/common/persistence/serialization"
 
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
@@ -41,6 +42,9 @@ type (
 		clusterName string
 		logger      log.Logger
 		parser      serialization.Parser
+		// below are only set when resharding is configured, dbConn is then the source placement
+		targetDBConn dbConn
+		placement    *reshardingPlacement
 	}
 
 	// dbConn represents a logical mysql connection - its a
@@ -62,12 +66,25 @@ func NewFactory(
 	logger log.Logger,
 	parser serialization.Parser,
 ) *Factory {
+	if cfg.Resharding == nil {
+		return &Factory{
+			cfg:         cfg,
+			clusterName: clusterName,
+			logger:      logger,
+			dbConn:      newRefCountedDBConn(&cfg),
+			parser:      parser,
+		}
+	}
+
+	sourceCfg := newReshardingConfig(cfg, cfg.NumShards)
 	return &Factory{
-		cfg:         cfg,
-		clusterName: clusterName,
-		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg),
-		parser:      parser,
+		cfg:          *sourceCfg,
+		clusterName:  clusterName,
+		logger:       logger,
+		dbConn:       newRefCountedDBConn(sourceCfg),
+		parser:       parser,
+		targetDBConn: newRefCountedDBConn(newReshardingConfig(cfg, cfg.Resharding.TargetNumShards)),
+		placement:    newReshardingPlacement(cfg.NumShards, cfg.Resharding),
 	}
 }
 
@@ -77,7 +94,7 @@ func (f *Factory) NewTaskStore() (p.TaskStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newTaskPersistence(conn, f.cfg.NumShards, f.logger, f.parser)
+	return newTaskPersistence(conn, f.logger, f.parser)
 }
 
 // NewShardStore returns a new shard store
@@ -86,7 +103,19 @@ func (f *Factory) NewShardStore() (p.ShardStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewShardPersistence(conn, f.clusterName, f.logger, f.parser)
+	store, err := NewShardPersistence(conn, f.clusterName, f.logger, f.parser)
+	if err != nil || f.placement == nil {
+		return store, err
+	}
+	targetConn, err := f.targetDBConn.get()
+	if err != nil {
+		return nil, err
+	}
+	target, err := NewShardPersistence(targetConn, f.clusterName, f.logger, f.parser)
+	if err != nil {
+		return nil, err
+	}
+	return newReshardingShardStore(f.placement, store, target), nil
 }
 
 // NewHistoryStore returns a new history store
@@ -95,7 +124,19 @@ func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewHistoryV2Persistence(conn, f.logger, f.parser)
+	store, err := NewHistoryV2Persistence(conn, f.logger, f.parser)
+	if err != nil || f.placement == nil {
+		return store, err
+	}
+	targetConn, err := f.targetDBConn.get()
+	if err != nil {
+		return nil, err
+	}
+	target, err := NewHistoryV2Persistence(targetConn, f.logger, f.parser)
+	if err != nil {
+		return nil, err
+	}
+	return newReshardingHistoryStore(f.placement, store, target), nil
 }
 
 // NewDomainStore returns a new metadata store
@@ -113,13 +154,36 @@ func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return NewSQLExecutionStore(conn, f.logger, shardID, f.parser)
+	store, err := NewSQLExecutionStore(conn, f.logger, shardID, f.parser)
+	if err != nil || f.placement == nil {
+		return store, err
+	}
+	targetConn, err := f.targetDBConn.get()
+	if err != nil {
+		return nil, err
+	}
+	target, err := NewSQLExecutionStore(targetConn, f.logger, shardID, f.parser)
+	if err != nil {
+		return nil, err
+	}
+	targetShardsConn, err := f.targetDBConn.get()
+	if err != nil {
+		return nil, err
+	}
+	targetShards, err := NewShardPersistence(targetShardsConn, f.clusterName, f.logger, f.parser)
+	if err != nil {
+		return nil, err
+	}
+	return newReshardingExecutionStore(shardID, f.placement, store, target, targetShards), nil
 }
 
 // NewVisibilityStore returns a visibility store
 // TODO sortByCloseTime will be removed and implemented for https://github.com/uber/cadence/issues/3621
-func (f *Factory) NewVisibilityStore(sortByCloseTime bool) (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+func (f *Factory) NewVisibilityStore(
+	sortByCloseTime bool,
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+) (p.VisibilityStore, error) {
+	return NewSQLVisibilityStore(f.cfg, validSearchAttributes, f.logger)
 }
 
 // NewQueue returns a new queue backed by sql
@@ -140,6 +204,9 @@ func (f *Factory) NewConfigStore() (p.ConfigStore, error) {
 // Close closes the factory
 func (f *Factory) Close() {
 	f.dbConn.forceClose()
+	if f.placement != nil {
+		f.targetDBConn.forceClose()
+	}
 }
 
 // newRefCountedDBConn returns a  logical mysql connection that
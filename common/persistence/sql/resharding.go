@@ -0,0 +1,567 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/uber/cadence/common/config"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// reshardingPlacement decides per history shard whether the current (source) or the
+	// target placement of an ongoing resharding serves the requests
+	reshardingPlacement struct {
+		numShards  int
+		resharding *config.SQLResharding
+		// targetReady caches the history shards whose shard row exists in the target placement,
+		// i.e. the copy workflow has finished the final catch-up and source must not be read anymore
+		targetReady sync.Map
+	}
+
+	// reshardingShardStore routes shard rows to the placement of the history shard
+	reshardingShardStore struct {
+		placement *reshardingPlacement
+		source    p.ShardStore
+		target    p.ShardStore
+	}
+
+	// reshardingExecutionStore routes execution rows of a single history shard to its placement
+	reshardingExecutionStore struct {
+		shardID   int
+		placement *reshardingPlacement
+		source    p.ExecutionStore
+		target    p.ExecutionStore
+		// targetShards is only used to look up the target shard row
+		targetShards p.ShardStore
+	}
+
+	// reshardingHistoryStore routes history trees to the placement of the history shard owning them,
+	// writes go to both placements until the history shard is cut over
+	reshardingHistoryStore struct {
+		placement *reshardingPlacement
+		source    p.HistoryStore
+		target    p.HistoryStore
+	}
+)
+
+var _ p.ShardStore = (*reshardingShardStore)(nil)
+var _ p.ExecutionStore = (*reshardingExecutionStore)(nil)
+var _ p.HistoryStore = (*reshardingHistoryStore)(nil)
+
+// newReshardingConfig returns a config of the same database with numShards DB shards,
+// using the first numShards entries of MultipleDatabasesConfig
+func newReshardingConfig(cfg config.SQL, numShards int) *config.SQL {
+	view := cfg
+	view.Resharding = nil
+	view.NumShards = numShards
+	if !cfg.UseMultipleDatabases {
+		return &view
+	}
+	view.MultipleDatabasesConfig = cfg.MultipleDatabasesConfig[:numShards]
+	if numShards == 1 {
+		entry := cfg.MultipleDatabasesConfig[0]
+		view.UseMultipleDatabases = false
+		view.MultipleDatabasesConfig = nil
+		view.User = entry.User
+		view.Password = entry.Password
+		view.DatabaseName = entry.DatabaseName
+		view.ConnectAddr = entry.ConnectAddr
+	}
+	return &view
+}
+
+func newReshardingPlacement(numShards int, resharding *config.SQLResharding) *reshardingPlacement {
+	return &reshardingPlacement{
+		numShards:  numShards,
+		resharding: resharding,
+	}
+}
+
+func (r *reshardingPlacement) isCutover(shardID int) bool {
+	return r.resharding.Cutover != nil && r.resharding.Cutover(shardID)
+}
+
+// isTreeMoved returns false if a history tree is on the same database in both placements
+func (r *reshardingPlacement) isTreeMoved(treeID string) bool {
+	treeUUID := serialization.MustParseUUID(treeID)
+	return sqlplugin.GetDBShardIDFromTreeID(treeUUID, r.numShards) !=
+		sqlplugin.GetDBShardIDFromTreeID(treeUUID, r.resharding.TargetNumShards)
+}
+
+// shouldReadSource returns true if a history shard has been cut over but the copy workflow has not
+// created its shard row in the target placement yet. Reads missing in target fall back to source then,
+// and the shard row cannot be updated.
+func (r *reshardingPlacement) shouldReadSource(
+	ctx context.Context,
+	shardID int,
+	targetShards p.ShardStore,
+) (bool, error) {
+	if _, ok := r.targetReady.Load(shardID); ok {
+		return false, nil
+	}
+	_, err := targetShards.GetShard(ctx, &p.InternalGetShardRequest{ShardID: shardID})
+	switch err.(type) {
+	case nil:
+		r.targetReady.Store(shardID, struct{}{})
+		return false, nil
+	case *types.EntityNotExistsError:
+		return true, nil
+	default:
+		return false, err
+	}
+}
+
+func newReshardingShardStore(
+	placement *reshardingPlacement,
+	source p.ShardStore,
+	target p.ShardStore,
+) p.ShardStore {
+	return &reshardingShardStore{
+		placement: placement,
+		source:    source,
+		target:    target,
+	}
+}
+
+func (s *reshardingShardStore) GetName() string {
+	return s.source.GetName()
+}
+
+func (s *reshardingShardStore) Close() {
+	s.source.Close()
+	s.target.Close()
+}
+
+func (s *reshardingShardStore) CreateShard(
+	ctx context.Context,
+	request *p.InternalCreateShardRequest,
+) error {
+	shardID := request.ShardInfo.ShardID
+	if !s.placement.isCutover(shardID) {
+		return s.source.CreateShard(ctx, request)
+	}
+	// the shard row is created in target by the copy workflow once all rows are moved,
+	// creating it here would reset the shard while its data is still in source
+	if _, err := s.source.GetShard(ctx, &p.InternalGetShardRequest{ShardID: shardID}); err == nil {
+		return &p.ShardAlreadyExistError{
+			Msg: "CreateShard operation failed. Shard is being moved to the target placement of resharding.",
+		}
+	}
+	return s.target.CreateShard(ctx, request)
+}
+
+func (s *reshardingShardStore) GetShard(
+	ctx context.Context,
+	request *p.InternalGetShardRequest,
+) (*p.InternalGetShardResponse, error) {
+	if !s.placement.isCutover(request.ShardID) {
+		return s.source.GetShard(ctx, request)
+	}
+	resp, err := s.target.GetShard(ctx, request)
+	if _, ok := err.(*types.EntityNotExistsError); ok {
+		return s.source.GetShard(ctx, request)
+	}
+	return resp, err
+}
+
+func (s *reshardingShardStore) UpdateShard(
+	ctx context.Context,
+	request *p.InternalUpdateShardRequest,
+) error {
+	shardID := request.ShardInfo.ShardID
+	if !s.placement.isCutover(shardID) {
+		return s.source.UpdateShard(ctx, request)
+	}
+	// the shard must not be updated in either placement until the copy workflow has caught up and
+	// created the shard row in target, source is fenced off by then and target has no row to update
+	readSource, err := s.placement.shouldReadSource(ctx, shardID, s.target)
+	if err != nil {
+		return err
+	}
+	if readSource {
+		return &p.ShardOwnershipLostError{
+			ShardID: shardID,
+			Msg:     "UpdateShard operation failed. Shard is being moved to the target placement of resharding.",
+		}
+	}
+	return s.target.UpdateShard(ctx, request)
+}
+
+func newReshardingExecutionStore(
+	shardID int,
+	placement *reshardingPlacement,
+	source p.ExecutionStore,
+	target p.ExecutionStore,
+	targetShards p.ShardStore,
+) p.ExecutionStore {
+	return &reshardingExecutionStore{
+		shardID:      shardID,
+		placement:    placement,
+		source:       source,
+		target:       target,
+		targetShards: targetShards,
+	}
+}
+
+func (s *reshardingExecutionStore) store() p.ExecutionStore {
+	if s.placement.isCutover(s.shardID) {
+		return s.target
+	}
+	return s.source
+}
+
+// readSource returns true if a read missing in target should be retried against source
+func (s *reshardingExecutionStore) readSource(ctx context.Context, err error) bool {
+	if _, ok := err.(*types.EntityNotExistsError); !ok {
+		return false
+	}
+	readSource, lookupErr := s.placement.shouldReadSource(ctx, s.shardID, s.targetShards)
+	return lookupErr == nil && readSource
+}
+
+func (s *reshardingExecutionStore) GetName() string {
+	return s.source.GetName()
+}
+
+func (s *reshardingExecutionStore) GetShardID() int {
+	return s.shardID
+}
+
+func (s *reshardingExecutionStore) Close() {
+	s.source.Close()
+	s.target.Close()
+	s.targetShards.Close()
+}
+
+func (s *reshardingExecutionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalGetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	if !s.placement.isCutover(s.shardID) {
+		return s.source.GetWorkflowExecution(ctx, request)
+	}
+	resp, err := s.target.GetWorkflowExecution(ctx, request)
+	if s.readSource(ctx, err) {
+		return s.source.GetWorkflowExecution(ctx, request)
+	}
+	return resp, err
+}
+
+func (s *reshardingExecutionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalUpdateWorkflowExecutionRequest,
+) error {
+	return s.store().UpdateWorkflowExecution(ctx, request)
+}
+
+func (s *reshardingExecutionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	return s.store().ConflictResolveWorkflowExecution(ctx, request)
+}
+
+func (s *reshardingExecutionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *p.InternalCreateWorkflowExecutionRequest,
+) (*p.CreateWorkflowExecutionResponse, error) {
+	return s.store().CreateWorkflowExecution(ctx, request)
+}
+
+func (s *reshardingExecutionStore) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteWorkflowExecutionRequest,
+) error {
+	return s.store().DeleteWorkflowExecution(ctx, request)
+}
+
+func (s *reshardingExecutionStore) DeleteCurrentWorkflowExecution(
+	ctx context.Context,
+	request *p.DeleteCurrentWorkflowExecutionRequest,
+) error {
+	return s.store().DeleteCurrentWorkflowExecution(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetCurrentExecution(
+	ctx context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.GetCurrentExecutionResponse, error) {
+	if !s.placement.isCutover(s.shardID) {
+		return s.source.GetCurrentExecution(ctx, request)
+	}
+	resp, err := s.target.GetCurrentExecution(ctx, request)
+	if s.readSource(ctx, err) {
+		return s.source.GetCurrentExecution(ctx, request)
+	}
+	return resp, err
+}
+
+func (s *reshardingExecutionStore) IsWorkflowExecutionExists(
+	ctx context.Context,
+	request *p.IsWorkflowExecutionExistsRequest,
+) (*p.IsWorkflowExecutionExistsResponse, error) {
+	return s.store().IsWorkflowExecutionExists(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetTransferTasks(
+	ctx context.Context,
+	request *p.GetTransferTasksRequest,
+) (*p.GetTransferTasksResponse, error) {
+	return s.store().GetTransferTasks(ctx, request)
+}
+
+func (s *reshardingExecutionStore) CompleteTransferTask(
+	ctx context.Context,
+	request *p.CompleteTransferTaskRequest,
+) error {
+	return s.store().CompleteTransferTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) RangeCompleteTransferTask(
+	ctx context.Context,
+	request *p.RangeCompleteTransferTaskRequest,
+) (*p.RangeCompleteTransferTaskResponse, error) {
+	return s.store().RangeCompleteTransferTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetCrossClusterTasks(
+	ctx context.Context,
+	request *p.GetCrossClusterTasksRequest,
+) (*p.GetCrossClusterTasksResponse, error) {
+	return s.store().GetCrossClusterTasks(ctx, request)
+}
+
+func (s *reshardingExecutionStore) CompleteCrossClusterTask(
+	ctx context.Context,
+	request *p.CompleteCrossClusterTaskRequest,
+) error {
+	return s.store().CompleteCrossClusterTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) RangeCompleteCrossClusterTask(
+	ctx context.Context,
+	request *p.RangeCompleteCrossClusterTaskRequest,
+) (*p.RangeCompleteCrossClusterTaskResponse, error) {
+	return s.store().RangeCompleteCrossClusterTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetReplicationTasks(
+	ctx context.Context,
+	request *p.GetReplicationTasksRequest,
+) (*p.InternalGetReplicationTasksResponse, error) {
+	return s.store().GetReplicationTasks(ctx, request)
+}
+
+func (s *reshardingExecutionStore) CompleteReplicationTask(
+	ctx context.Context,
+	request *p.CompleteReplicationTaskRequest,
+) error {
+	return s.store().CompleteReplicationTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) RangeCompleteReplicationTask(
+	ctx context.Context,
+	request *p.RangeCompleteReplicationTaskRequest,
+) (*p.RangeCompleteReplicationTaskResponse, error) {
+	return s.store().RangeCompleteReplicationTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) PutReplicationTaskToDLQ(
+	ctx context.Context,
+	request *p.InternalPutReplicationTaskToDLQRequest,
+) error {
+	return s.store().PutReplicationTaskToDLQ(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetReplicationTasksFromDLQ(
+	ctx context.Context,
+	request *p.GetReplicationTasksFromDLQRequest,
+) (*p.InternalGetReplicationTasksFromDLQResponse, error) {
+	return s.store().GetReplicationTasksFromDLQ(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetReplicationDLQSize(
+	ctx context.Context,
+	request *p.GetReplicationDLQSizeRequest,
+) (*p.GetReplicationDLQSizeResponse, error) {
+	return s.store().GetReplicationDLQSize(ctx, request)
+}
+
+func (s *reshardingExecutionStore) DeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.DeleteReplicationTaskFromDLQRequest,
+) error {
+	return s.store().DeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (s *reshardingExecutionStore) RangeDeleteReplicationTaskFromDLQ(
+	ctx context.Context,
+	request *p.RangeDeleteReplicationTaskFromDLQRequest,
+) (*p.RangeDeleteReplicationTaskFromDLQResponse, error) {
+	return s.store().RangeDeleteReplicationTaskFromDLQ(ctx, request)
+}
+
+func (s *reshardingExecutionStore) CreateFailoverMarkerTasks(
+	ctx context.Context,
+	request *p.CreateFailoverMarkersRequest,
+) error {
+	return s.store().CreateFailoverMarkerTasks(ctx, request)
+}
+
+func (s *reshardingExecutionStore) GetTimerIndexTasks(
+	ctx context.Context,
+	request *p.GetTimerIndexTasksRequest,
+) (*p.GetTimerIndexTasksResponse, error) {
+	return s.store().GetTimerIndexTasks(ctx, request)
+}
+
+func (s *reshardingExecutionStore) CompleteTimerTask(
+	ctx context.Context,
+	request *p.CompleteTimerTaskRequest,
+) error {
+	return s.store().CompleteTimerTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) RangeCompleteTimerTask(
+	ctx context.Context,
+	request *p.RangeCompleteTimerTaskRequest,
+) (*p.RangeCompleteTimerTaskResponse, error) {
+	return s.store().RangeCompleteTimerTask(ctx, request)
+}
+
+func (s *reshardingExecutionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
+) (*p.InternalListConcreteExecutionsResponse, error) {
+	return s.store().ListConcreteExecutions(ctx, request)
+}
+
+func (s *reshardingExecutionStore) ListCurrentExecutions(
+	ctx context.Context,
+	request *p.ListCurrentExecutionsRequest,
+) (*p.ListCurrentExecutionsResponse, error) {
+	return s.store().ListCurrentExecutions(ctx, request)
+}
+
+func newReshardingHistoryStore(
+	placement *reshardingPlacement,
+	source p.HistoryStore,
+	target p.HistoryStore,
+) p.HistoryStore {
+	return &reshardingHistoryStore{
+		placement: placement,
+		source:    source,
+		target:    target,
+	}
+}
+
+func (s *reshardingHistoryStore) store(shardID int) p.HistoryStore {
+	if s.placement.isCutover(shardID) {
+		return s.target
+	}
+	return s.source
+}
+
+func (s *reshardingHistoryStore) GetName() string {
+	return s.source.GetName()
+}
+
+func (s *reshardingHistoryStore) Close() {
+	s.source.Close()
+	s.target.Close()
+}
+
+// AppendHistoryNodes writes to both placements until the history shard is cut over,
+// so that the target placement is complete once the copy workflow has copied the existing trees
+func (s *reshardingHistoryStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *p.InternalAppendHistoryNodesRequest,
+) error {
+	if s.placement.isCutover(request.ShardID) {
+		return s.target.AppendHistoryNodes(ctx, request)
+	}
+	if err := s.source.AppendHistoryNodes(ctx, request); err != nil || !s.placement.isTreeMoved(request.BranchInfo.TreeID) {
+		return err
+	}
+	return s.target.AppendHistoryNodes(ctx, request)
+}
+
+func (s *reshardingHistoryStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	return s.store(request.ShardID).ReadHistoryBranch(ctx, request)
+}
+
+func (s *reshardingHistoryStore) ForkHistoryBranch(
+	ctx context.Context,
+	request *p.InternalForkHistoryBranchRequest,
+) (*p.InternalForkHistoryBranchResponse, error) {
+	if s.placement.isCutover(request.ShardID) {
+		return s.target.ForkHistoryBranch(ctx, request)
+	}
+	resp, err := s.source.ForkHistoryBranch(ctx, request)
+	if err != nil || !s.placement.isTreeMoved(request.ForkBranchInfo.TreeID) {
+		return resp, err
+	}
+	if _, err := s.target.ForkHistoryBranch(ctx, request); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *reshardingHistoryStore) DeleteHistoryBranch(
+	ctx context.Context,
+	request *p.InternalDeleteHistoryBranchRequest,
+) error {
+	if s.placement.isCutover(request.ShardID) {
+		return s.target.DeleteHistoryBranch(ctx, request)
+	}
+	if err := s.source.DeleteHistoryBranch(ctx, request); err != nil || !s.placement.isTreeMoved(request.BranchInfo.TreeID) {
+		return err
+	}
+	return s.target.DeleteHistoryBranch(ctx, request)
+}
+
+func (s *reshardingHistoryStore) GetHistoryTree(
+	ctx context.Context,
+	request *p.InternalGetHistoryTreeRequest,
+) (*p.InternalGetHistoryTreeResponse, error) {
+	if request.ShardID == nil {
+		return s.source.GetHistoryTree(ctx, request)
+	}
+	return s.store(*request.ShardID).GetHistoryTree(ctx, request)
+}
+
+// GetAllHistoryTreeBranches scans the source placement only, it is used by the history scavenger
+// which should be paused while a resharding is in progress
+func (s *reshardingHistoryStore) GetAllHistoryTreeBranches(
+	ctx context.Context,
+	request *p.GetAllHistoryTreeBranchesRequest,
+) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	return s.source.GetAllHistoryTreeBranches(ctx, request)
+}
@@ -0,0 +1,913 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	// ShardCopier copies the rows of history shards from the current placement of a resharding
+	// SQL persistence to the target placement
+	ShardCopier struct {
+		source       sqlplugin.DB
+		target       sqlplugin.DB
+		placement    *reshardingPlacement
+		parser       serialization.Parser
+		serializer   p.PayloadSerializer
+		decoder      codec.BinaryEncoder
+		clusterNames []string
+		pageSize     int
+		logger       log.Logger
+	}
+
+	// ShardCopyStats contains the number of rows written to the target placement
+	ShardCopyStats struct {
+		Executions        int
+		DeletedExecutions int
+		HistoryNodes      int
+		Tasks             int
+	}
+)
+
+var (
+	minTimerTaskTimestamp = time.Unix(0, 0)
+	maxTimerTaskTimestamp = time.Unix(0, math.MaxInt64)
+)
+
+// NewShardCopier returns a copier for the resharding configured in cfg. clusterNames must contain
+// all clusters of the deployment, they are needed to copy the cross cluster and replication DLQ tasks.
+func NewShardCopier(
+	cfg config.SQL,
+	parser serialization.Parser,
+	clusterNames []string,
+	pageSize int,
+	logger log.Logger,
+) (*ShardCopier, error) {
+	if cfg.Resharding == nil {
+		return nil, fmt.Errorf("sql persistence config has no resharding")
+	}
+	source, err := NewSQLDB(newReshardingConfig(cfg, cfg.NumShards))
+	if err != nil {
+		return nil, err
+	}
+	target, err := NewSQLDB(newReshardingConfig(cfg, cfg.Resharding.TargetNumShards))
+	if err != nil {
+		source.Close()
+		return nil, err
+	}
+	return &ShardCopier{
+		source:       source,
+		target:       target,
+		placement:    newReshardingPlacement(cfg.NumShards, cfg.Resharding),
+		parser:       parser,
+		serializer:   p.NewPayloadSerializer(),
+		decoder:      codec.NewThriftRWEncoder(),
+		clusterNames: clusterNames,
+		pageSize:     pageSize,
+		logger:       logger,
+	}, nil
+}
+
+// Close closes the connections to both placements
+func (c *ShardCopier) Close() {
+	c.source.Close()
+	c.target.Close()
+}
+
+// WithPageSize returns a copier sharing the connections of c which reads pageSize rows at a time
+func (c *ShardCopier) WithPageSize(pageSize int) *ShardCopier {
+	copier := *c
+	copier.pageSize = pageSize
+	return &copier
+}
+
+// Target returns the database of the target placement
+func (c *ShardCopier) Target() sqlplugin.DB {
+	return c.target
+}
+
+// IsMoved returns false if a history shard is on the same database in both placements, only its
+// history trees need to be copied then
+func (c *ShardCopier) IsMoved(shardID int) bool {
+	return sqlplugin.GetDBShardIDFromHistoryShardID(shardID, c.source.GetTotalNumDBShards()) !=
+		sqlplugin.GetDBShardIDFromHistoryShardID(shardID, c.target.GetTotalNumDBShards())
+}
+
+// IsCompleted returns true if the shard row of a history shard exists in the target placement.
+// The shard row is written last by CatchUp, history hosts own the shard in target from then on.
+func (c *ShardCopier) IsCompleted(ctx context.Context, shardID int) (bool, error) {
+	if !c.IsMoved(shardID) {
+		return false, nil
+	}
+	_, err := c.target.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(shardID)})
+	if err == nil {
+		return true, nil
+	}
+	if c.target.IsNotFoundError(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// CopyExecutions copies the executions of a history shard together with their history trees while
+// the shard is still served by the current placement. History written meanwhile goes to both placements,
+// the executions changed meanwhile are copied again by CatchUp.
+func (c *ShardCopier) CopyExecutions(
+	ctx context.Context,
+	shardID int,
+	heartbeat func(),
+) (*ShardCopyStats, error) {
+	completed, err := c.IsCompleted(ctx, shardID)
+	if err != nil {
+		return nil, err
+	}
+	if completed {
+		return nil, fmt.Errorf("history shard %v is already served by the target placement", shardID)
+	}
+	stats := &ShardCopyStats{}
+	if err := c.syncExecutions(ctx, shardID, true, stats, heartbeat); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// CatchUp copies the rows of a history shard changed since CopyExecutions and creates the shard row in
+// the target placement. It must only be called once all history hosts route the shard to the target
+// placement, the shard cannot be updated in either placement until CatchUp has finished.
+func (c *ShardCopier) CatchUp(
+	ctx context.Context,
+	shardID int,
+	heartbeat func(),
+) (*ShardCopyStats, error) {
+	stats := &ShardCopyStats{}
+	if !c.IsMoved(shardID) {
+		return stats, nil
+	}
+	completed, err := c.IsCompleted(ctx, shardID)
+	if err != nil || completed {
+		return stats, err
+	}
+
+	shard, err := c.source.SelectFromShards(ctx, &sqlplugin.ShardsFilter{ShardID: int64(shardID)})
+	if err != nil {
+		return nil, err
+	}
+	// fence off the hosts which still write the shard to the current placement
+	shard.RangeID++
+	if _, err := c.source.UpdateShards(ctx, shard); err != nil {
+		return nil, err
+	}
+
+	if err := c.syncExecutions(ctx, shardID, false, stats, heartbeat); err != nil {
+		return nil, err
+	}
+	if err := c.copyTasks(ctx, shardID, stats, heartbeat); err != nil {
+		return nil, err
+	}
+
+	shard.RangeID++
+	if _, err := c.target.InsertIntoShards(ctx, shard); err != nil && !c.target.IsDupEntryError(err) {
+		return nil, err
+	}
+	c.logger.Info("Resharding of history shard completed",
+		tag.ShardID(shardID),
+		tag.Counter(stats.Executions),
+		tag.Number(int64(stats.Tasks)))
+	return stats, nil
+}
+
+func (c *ShardCopier) syncExecutions(
+	ctx context.Context,
+	shardID int,
+	copyHistory bool,
+	stats *ShardCopyStats,
+	heartbeat func(),
+) error {
+	moved := c.IsMoved(shardID)
+	if !moved && !copyHistory {
+		return nil
+	}
+
+	var pageToken []byte
+	for {
+		rows, nextPageToken, err := c.source.SelectFromExecutionsAllShards(ctx, &sqlplugin.ExecutionsFilter{
+			ShardID: shardID,
+			Size:    c.pageSize,
+		}, pageToken)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			row := &rows[i]
+			if moved {
+				if err := c.syncExecution(ctx, row, stats); err != nil {
+					return err
+				}
+				if i == 0 || rows[i-1].WorkflowID != row.WorkflowID || rows[i-1].DomainID.String() != row.DomainID.String() {
+					if err := c.syncCurrentExecution(ctx, shardID, row.DomainID, row.WorkflowID); err != nil {
+						return err
+					}
+				}
+			}
+			if copyHistory {
+				if err := c.copyHistoryTrees(ctx, row, stats); err != nil {
+					return err
+				}
+			}
+		}
+		heartbeat()
+		if len(nextPageToken) == 0 {
+			break
+		}
+		pageToken = nextPageToken
+	}
+
+	if moved {
+		return c.deleteRemovedExecutions(ctx, shardID, stats, heartbeat)
+	}
+	return nil
+}
+
+func (c *ShardCopier) syncExecution(
+	ctx context.Context,
+	row *sqlplugin.ExecutionsRow,
+	stats *ShardCopyStats,
+) error {
+	existing, err := c.target.SelectFromExecutions(ctx, executionFilter(row))
+	switch {
+	case err == nil:
+		if isExecutionRowEqual(&existing[0], row) {
+			return nil
+		}
+		if _, err := c.target.DeleteFromExecutions(ctx, executionFilter(row)); err != nil {
+			return err
+		}
+	case !c.target.IsNotFoundError(err):
+		return err
+	}
+
+	if err := c.copyExecutionChildren(ctx, row); err != nil {
+		return err
+	}
+	if _, err := c.target.InsertIntoExecutions(ctx, row); err != nil {
+		return err
+	}
+	stats.Executions++
+	return nil
+}
+
+func (c *ShardCopier) deleteRemovedExecutions(
+	ctx context.Context,
+	shardID int,
+	stats *ShardCopyStats,
+	heartbeat func(),
+) error {
+	var pageToken []byte
+	for {
+		rows, nextPageToken, err := c.target.SelectFromExecutionsAllShards(ctx, &sqlplugin.ExecutionsFilter{
+			ShardID: shardID,
+			Size:    c.pageSize,
+		}, pageToken)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			row := &rows[i]
+			_, err := c.source.SelectFromExecutions(ctx, executionFilter(row))
+			if err == nil {
+				continue
+			}
+			if !c.source.IsNotFoundError(err) {
+				return err
+			}
+			if err := c.deleteExecution(ctx, row); err != nil {
+				return err
+			}
+			if err := c.syncCurrentExecution(ctx, shardID, row.DomainID, row.WorkflowID); err != nil {
+				return err
+			}
+			stats.DeletedExecutions++
+		}
+		heartbeat()
+		if len(nextPageToken) == 0 {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func (c *ShardCopier) deleteExecution(
+	ctx context.Context,
+	row *sqlplugin.ExecutionsRow,
+) error {
+	if err := c.deleteExecutionChildren(ctx, row); err != nil {
+		return err
+	}
+	_, err := c.target.DeleteFromExecutions(ctx, executionFilter(row))
+	return err
+}
+
+func (c *ShardCopier) deleteExecutionChildren(
+	ctx context.Context,
+	row *sqlplugin.ExecutionsRow,
+) error {
+	shardID := int64(row.ShardID)
+	if _, err := c.target.DeleteFromActivityInfoMaps(ctx, &sqlplugin.ActivityInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	}); err != nil {
+		return err
+	}
+	if _, err := c.target.DeleteFromTimerInfoMaps(ctx, &sqlplugin.TimerInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	}); err != nil {
+		return err
+	}
+	if _, err := c.target.DeleteFromChildExecutionInfoMaps(ctx, &sqlplugin.ChildExecutionInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	}); err != nil {
+		return err
+	}
+	if _, err := c.target.DeleteFromRequestCancelInfoMaps(ctx, &sqlplugin.RequestCancelInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	}); err != nil {
+		return err
+	}
+	if _, err := c.target.DeleteFromSignalInfoMaps(ctx, &sqlplugin.SignalInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	}); err != nil {
+		return err
+	}
+	if _, err := c.target.DeleteFromSignalsRequestedSets(ctx, &sqlplugin.SignalsRequestedSetsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	}); err != nil {
+		return err
+	}
+	_, err := c.target.DeleteFromBufferedEvents(ctx, &sqlplugin.BufferedEventsFilter{
+		ShardID: row.ShardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	return err
+}
+
+// copyExecutionChildren replaces the rows of the map tables and the buffered events of an execution
+func (c *ShardCopier) copyExecutionChildren(
+	ctx context.Context,
+	row *sqlplugin.ExecutionsRow,
+) error {
+	if err := c.deleteExecutionChildren(ctx, row); err != nil {
+		return err
+	}
+	shardID := int64(row.ShardID)
+
+	activities, err := c.source.SelectFromActivityInfoMaps(ctx, &sqlplugin.ActivityInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	if len(activities) > 0 {
+		if _, err := c.target.ReplaceIntoActivityInfoMaps(ctx, activities); err != nil {
+			return err
+		}
+	}
+
+	timers, err := c.source.SelectFromTimerInfoMaps(ctx, &sqlplugin.TimerInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	if len(timers) > 0 {
+		if _, err := c.target.ReplaceIntoTimerInfoMaps(ctx, timers); err != nil {
+			return err
+		}
+	}
+
+	children, err := c.source.SelectFromChildExecutionInfoMaps(ctx, &sqlplugin.ChildExecutionInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	if len(children) > 0 {
+		if _, err := c.target.ReplaceIntoChildExecutionInfoMaps(ctx, children); err != nil {
+			return err
+		}
+	}
+
+	requestCancels, err := c.source.SelectFromRequestCancelInfoMaps(ctx, &sqlplugin.RequestCancelInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	if len(requestCancels) > 0 {
+		if _, err := c.target.ReplaceIntoRequestCancelInfoMaps(ctx, requestCancels); err != nil {
+			return err
+		}
+	}
+
+	signals, err := c.source.SelectFromSignalInfoMaps(ctx, &sqlplugin.SignalInfoMapsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	if len(signals) > 0 {
+		if _, err := c.target.ReplaceIntoSignalInfoMaps(ctx, signals); err != nil {
+			return err
+		}
+	}
+
+	signalsRequested, err := c.source.SelectFromSignalsRequestedSets(ctx, &sqlplugin.SignalsRequestedSetsFilter{
+		ShardID: shardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	if len(signalsRequested) > 0 {
+		if _, err := c.target.InsertIntoSignalsRequestedSets(ctx, signalsRequested); err != nil {
+			return err
+		}
+	}
+
+	bufferedEvents, err := c.source.SelectFromBufferedEvents(ctx, &sqlplugin.BufferedEventsFilter{
+		ShardID: row.ShardID, DomainID: row.DomainID, WorkflowID: row.WorkflowID, RunID: row.RunID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+	for i := range bufferedEvents {
+		bufferedEvents[i].ShardID = row.ShardID
+		bufferedEvents[i].DomainID = row.DomainID
+		bufferedEvents[i].WorkflowID = row.WorkflowID
+		bufferedEvents[i].RunID = row.RunID
+	}
+	if len(bufferedEvents) > 0 {
+		if _, err := c.target.InsertIntoBufferedEvents(ctx, bufferedEvents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ShardCopier) syncCurrentExecution(
+	ctx context.Context,
+	shardID int,
+	domainID serialization.UUID,
+	workflowID string,
+) error {
+	filter := &sqlplugin.CurrentExecutionsFilter{
+		ShardID:    int64(shardID),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+	}
+	current, err := c.source.SelectFromCurrentExecutions(ctx, filter)
+	if err != nil {
+		if !c.source.IsNotFoundError(err) {
+			return err
+		}
+		existing, err := c.target.SelectFromCurrentExecutions(ctx, filter)
+		if err != nil {
+			if c.target.IsNotFoundError(err) {
+				return nil
+			}
+			return err
+		}
+		filter.RunID = existing.RunID
+		_, err = c.target.DeleteFromCurrentExecutions(ctx, filter)
+		return err
+	}
+
+	current.ShardID = int64(shardID)
+	current.DomainID = domainID
+	current.WorkflowID = workflowID
+	if _, err := c.target.InsertIntoCurrentExecutions(ctx, current); err != nil {
+		if !c.target.IsDupEntryError(err) {
+			return err
+		}
+		if _, err := c.target.UpdateCurrentExecutions(ctx, current); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyHistoryTrees copies the history trees referenced by an execution which are not on the same
+// database in both placements
+func (c *ShardCopier) copyHistoryTrees(
+	ctx context.Context,
+	row *sqlplugin.ExecutionsRow,
+	stats *ShardCopyStats,
+) error {
+	treeIDs, err := c.getHistoryTreeIDs(row)
+	if err != nil {
+		return err
+	}
+	for _, treeID := range treeIDs {
+		if !c.placement.isTreeMoved(treeID) {
+			continue
+		}
+		if err := c.copyHistoryTree(ctx, row.ShardID, serialization.MustParseUUID(treeID), stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ShardCopier) getHistoryTreeIDs(row *sqlplugin.ExecutionsRow) ([]string, error) {
+	info, err := c.parser.WorkflowExecutionInfoFromBlob(row.Data, row.DataEncoding)
+	if err != nil {
+		return nil, err
+	}
+	var branchTokens [][]byte
+	if len(info.EventBranchToken) > 0 {
+		branchTokens = append(branchTokens, info.EventBranchToken)
+	}
+	if len(info.VersionHistories) > 0 {
+		versionHistories, err := c.serializer.DeserializeVersionHistories(
+			p.NewDataBlob(info.VersionHistories, common.EncodingType(info.VersionHistoriesEncoding)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, versionHistory := range versionHistories.GetHistories() {
+			if len(versionHistory.GetBranchToken()) > 0 {
+				branchTokens = append(branchTokens, versionHistory.GetBranchToken())
+			}
+		}
+	}
+
+	seen := make(map[string]struct{})
+	var treeIDs []string
+	for _, branchToken := range branchTokens {
+		var branch workflow.HistoryBranch
+		if err := c.decoder.Decode(branchToken, &branch); err != nil {
+			return nil, err
+		}
+		if _, ok := seen[branch.GetTreeID()]; ok {
+			continue
+		}
+		seen[branch.GetTreeID()] = struct{}{}
+		treeIDs = append(treeIDs, branch.GetTreeID())
+	}
+	return treeIDs, nil
+}
+
+func (c *ShardCopier) copyHistoryTree(
+	ctx context.Context,
+	shardID int,
+	treeID serialization.UUID,
+	stats *ShardCopyStats,
+) error {
+	branches, err := c.source.SelectFromHistoryTree(ctx, &sqlplugin.HistoryTreeFilter{
+		ShardID: shardID,
+		TreeID:  treeID,
+	})
+	if err != nil && !c.source.IsNotFoundError(err) {
+		return err
+	}
+
+	// nodes of a forked branch are stored under the branch IDs of its ancestors
+	branchIDs := make(map[string]struct{})
+	for i := range branches {
+		branch := &branches[i]
+		branch.ShardID = shardID
+		branch.TreeID = treeID
+		if _, err := c.target.InsertIntoHistoryTree(ctx, branch); err != nil && !c.target.IsDupEntryError(err) {
+			return err
+		}
+		treeInfo, err := c.parser.HistoryTreeInfoFromBlob(branch.Data, branch.DataEncoding)
+		if err != nil {
+			return err
+		}
+		branchIDs[branch.BranchID.String()] = struct{}{}
+		for _, ancestor := range treeInfo.Ancestors {
+			branchIDs[ancestor.BranchID] = struct{}{}
+		}
+	}
+
+	for branchID := range branchIDs {
+		if err := c.copyHistoryNodes(ctx, shardID, treeID, serialization.MustParseUUID(branchID), stats); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ShardCopier) copyHistoryNodes(
+	ctx context.Context,
+	shardID int,
+	treeID serialization.UUID,
+	branchID serialization.UUID,
+	stats *ShardCopyStats,
+) error {
+	minNodeID := int64(0)
+	maxNodeID := int64(math.MaxInt64)
+	for {
+		rows, err := c.source.SelectFromHistoryNode(ctx, &sqlplugin.HistoryNodeFilter{
+			ShardID:   shardID,
+			TreeID:    treeID,
+			BranchID:  branchID,
+			MinNodeID: &minNodeID,
+			MaxNodeID: &maxNodeID,
+			PageSize:  c.pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			row := &rows[i]
+			row.ShardID = shardID
+			row.TreeID = treeID
+			row.BranchID = branchID
+			if _, err := c.target.InsertIntoHistoryNode(ctx, row); err != nil {
+				if !c.target.IsDupEntryError(err) {
+					return err
+				}
+				continue
+			}
+			stats.HistoryNodes++
+		}
+		if len(rows) < c.pageSize {
+			return nil
+		}
+		// a page can end within the transactions of a node, so the last node is read again
+		// unless the page has no other node
+		lastNodeID := rows[len(rows)-1].NodeID
+		if rows[0].NodeID == lastNodeID {
+			minNodeID = lastNodeID + 1
+		} else {
+			minNodeID = lastNodeID
+		}
+	}
+}
+
+func (c *ShardCopier) copyTasks(
+	ctx context.Context,
+	shardID int,
+	stats *ShardCopyStats,
+	heartbeat func(),
+) error {
+	if err := c.copyTransferTasks(ctx, shardID, stats); err != nil {
+		return err
+	}
+	heartbeat()
+	if err := c.copyTimerTasks(ctx, shardID, stats); err != nil {
+		return err
+	}
+	heartbeat()
+	if err := c.copyReplicationTasks(ctx, shardID, stats); err != nil {
+		return err
+	}
+	heartbeat()
+	for _, clusterName := range c.clusterNames {
+		if err := c.copyCrossClusterTasks(ctx, shardID, clusterName, stats); err != nil {
+			return err
+		}
+		if err := c.copyReplicationTasksDLQ(ctx, shardID, clusterName, stats); err != nil {
+			return err
+		}
+		heartbeat()
+	}
+	return nil
+}
+
+func (c *ShardCopier) copyTransferTasks(
+	ctx context.Context,
+	shardID int,
+	stats *ShardCopyStats,
+) error {
+	minTaskID := int64(math.MinInt64)
+	for {
+		rows, err := c.source.SelectFromTransferTasks(ctx, &sqlplugin.TransferTasksFilter{
+			ShardID:   shardID,
+			MinTaskID: minTaskID,
+			MaxTaskID: math.MaxInt64,
+			PageSize:  c.pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			rows[i].ShardID = shardID
+		}
+		if err := c.insertTasks(len(rows), func(start, end int) error {
+			_, err := c.target.InsertIntoTransferTasks(ctx, rows[start:end])
+			return err
+		}); err != nil {
+			return err
+		}
+		stats.Tasks += len(rows)
+		minTaskID = rows[len(rows)-1].TaskID
+	}
+}
+
+func (c *ShardCopier) copyTimerTasks(
+	ctx context.Context,
+	shardID int,
+	stats *ShardCopyStats,
+) error {
+	minTimestamp := minTimerTaskTimestamp
+	minTaskID := int64(math.MinInt64)
+	for {
+		rows, err := c.source.SelectFromTimerTasks(ctx, &sqlplugin.TimerTasksFilter{
+			ShardID:                shardID,
+			TaskID:                 minTaskID,
+			MinVisibilityTimestamp: minTimestamp,
+			MaxVisibilityTimestamp: maxTimerTaskTimestamp,
+			PageSize:               c.pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			rows[i].ShardID = shardID
+		}
+		if err := c.insertTasks(len(rows), func(start, end int) error {
+			_, err := c.target.InsertIntoTimerTasks(ctx, rows[start:end])
+			return err
+		}); err != nil {
+			return err
+		}
+		stats.Tasks += len(rows)
+		minTimestamp = rows[len(rows)-1].VisibilityTimestamp
+		minTaskID = rows[len(rows)-1].TaskID + 1
+	}
+}
+
+func (c *ShardCopier) copyReplicationTasks(
+	ctx context.Context,
+	shardID int,
+	stats *ShardCopyStats,
+) error {
+	minTaskID := int64(math.MinInt64)
+	for {
+		rows, err := c.source.SelectFromReplicationTasks(ctx, &sqlplugin.ReplicationTasksFilter{
+			ShardID:   shardID,
+			MinTaskID: minTaskID,
+			MaxTaskID: math.MaxInt64,
+			PageSize:  c.pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			rows[i].ShardID = shardID
+		}
+		if err := c.insertTasks(len(rows), func(start, end int) error {
+			_, err := c.target.InsertIntoReplicationTasks(ctx, rows[start:end])
+			return err
+		}); err != nil {
+			return err
+		}
+		stats.Tasks += len(rows)
+		minTaskID = rows[len(rows)-1].TaskID
+	}
+}
+
+func (c *ShardCopier) copyCrossClusterTasks(
+	ctx context.Context,
+	shardID int,
+	targetCluster string,
+	stats *ShardCopyStats,
+) error {
+	minTaskID := int64(math.MinInt64)
+	for {
+		rows, err := c.source.SelectFromCrossClusterTasks(ctx, &sqlplugin.CrossClusterTasksFilter{
+			TargetCluster: targetCluster,
+			ShardID:       shardID,
+			MinTaskID:     minTaskID,
+			MaxTaskID:     math.MaxInt64,
+			PageSize:      c.pageSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			rows[i].TargetCluster = targetCluster
+			rows[i].ShardID = shardID
+		}
+		if err := c.insertTasks(len(rows), func(start, end int) error {
+			_, err := c.target.InsertIntoCrossClusterTasks(ctx, rows[start:end])
+			return err
+		}); err != nil {
+			return err
+		}
+		stats.Tasks += len(rows)
+		minTaskID = rows[len(rows)-1].TaskID
+	}
+}
+
+func (c *ShardCopier) copyReplicationTasksDLQ(
+	ctx context.Context,
+	shardID int,
+	sourceCluster string,
+	stats *ShardCopyStats,
+) error {
+	minTaskID := int64(math.MinInt64)
+	for {
+		rows, err := c.source.SelectFromReplicationTasksDLQ(ctx, &sqlplugin.ReplicationTasksDLQFilter{
+			ReplicationTasksFilter: sqlplugin.ReplicationTasksFilter{
+				ShardID:   shardID,
+				MinTaskID: minTaskID,
+				MaxTaskID: math.MaxInt64,
+				PageSize:  c.pageSize,
+			},
+			SourceClusterName: sourceCluster,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		for i := range rows {
+			if _, err := c.target.InsertIntoReplicationTasksDLQ(ctx, &sqlplugin.ReplicationTaskDLQRow{
+				SourceClusterName: sourceCluster,
+				ShardID:           shardID,
+				TaskID:            rows[i].TaskID,
+				Data:              rows[i].Data,
+				DataEncoding:      rows[i].DataEncoding,
+			}); err != nil && !c.target.IsDupEntryError(err) {
+				return err
+			}
+		}
+		stats.Tasks += len(rows)
+		minTaskID = rows[len(rows)-1].TaskID
+	}
+}
+
+// insertTasks inserts a page of tasks in one statement. A previous CatchUp attempt may have copied
+// some of them already, the tasks are inserted one by one skipping the existing ones then.
+func (c *ShardCopier) insertTasks(count int, insert func(start, end int) error) error {
+	err := insert(0, count)
+	if err == nil || !c.target.IsDupEntryError(err) {
+		return err
+	}
+	for i := 0; i < count; i++ {
+		if err := insert(i, i+1); err != nil && !c.target.IsDupEntryError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func executionFilter(row *sqlplugin.ExecutionsRow) *sqlplugin.ExecutionsFilter {
+	return &sqlplugin.ExecutionsFilter{
+		ShardID:    row.ShardID,
+		DomainID:   row.DomainID,
+		WorkflowID: row.WorkflowID,
+		RunID:      row.RunID,
+	}
+}
+
+func isExecutionRowEqual(a *sqlplugin.ExecutionsRow, b *sqlplugin.ExecutionsRow) bool {
+	return a.NextEventID == b.NextEventID &&
+		a.LastWriteVersion == b.LastWriteVersion &&
+		a.DataEncoding == b.DataEncoding &&
+		string(a.Data) == string(b.Data)
+}
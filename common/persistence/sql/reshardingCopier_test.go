@@ -0,0 +1,577 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/suite"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	shardCopierSuite struct {
+		suite.Suite
+		source     *fakeDB
+		target     *fakeDB
+		parser     serialization.Parser
+		domainID   serialization.UUID
+		copier     *ShardCopier
+		heartbeats int
+	}
+
+	// fakeDB keeps the tables read and written by the copier in memory, the map tables,
+	// buffered events and all tasks but transfer tasks are always empty
+	fakeDB struct {
+		sqlplugin.DB
+		numDBShards       int
+		shards            map[int64]sqlplugin.ShardsRow
+		executions        map[string]sqlplugin.ExecutionsRow
+		currentExecutions map[string]sqlplugin.CurrentExecutionsRow
+		historyTrees      map[string]sqlplugin.HistoryTreeRow
+		historyNodes      map[string]sqlplugin.HistoryNodeRow
+		transferTasks     map[int64]sqlplugin.TransferTasksRow
+	}
+
+	fakeResult struct{}
+)
+
+const (
+	// notMovedShardID is on DB shard 0 in both placements
+	notMovedShardID = 2
+	copierPageSize  = 2
+)
+
+var errFakeDupEntry = errors.New("duplicate entry")
+
+func TestShardCopierSuite(t *testing.T) {
+	suite.Run(t, new(shardCopierSuite))
+}
+
+func (s *shardCopierSuite) SetupTest() {
+	parser, err := serialization.NewParser(common.EncodingTypeThriftRW, common.EncodingTypeThriftRW)
+	s.Require().NoError(err)
+	s.parser = parser
+	s.domainID = serialization.MustParseUUID(uuid.New())
+	s.source = newFakeDB(1)
+	s.target = newFakeDB(2)
+	s.heartbeats = 0
+	s.copier = &ShardCopier{
+		source:       s.source,
+		target:       s.target,
+		placement:    newReshardingPlacement(1, &config.SQLResharding{TargetNumShards: 2}),
+		parser:       parser,
+		serializer:   p.NewPayloadSerializer(),
+		decoder:      codec.NewThriftRWEncoder(),
+		clusterNames: []string{"active", "standby"},
+		pageSize:     copierPageSize,
+		logger:       loggerimpl.NewNopLogger(),
+	}
+}
+
+func (s *shardCopierSuite) TestIsMoved() {
+	s.True(s.copier.IsMoved(movedShardID))
+	s.False(s.copier.IsMoved(notMovedShardID))
+}
+
+func (s *shardCopierSuite) TestCopyExecutions_MovedShard() {
+	treeID := newTreeID(s.copier.placement, true)
+	s.source.shards[movedShardID] = sqlplugin.ShardsRow{ShardID: movedShardID, RangeID: 5}
+	// more runs of a workflow than fit on a page
+	runs := []sqlplugin.ExecutionsRow{
+		s.newExecution(movedShardID, "wid-a", ""),
+		s.newExecution(movedShardID, "wid-a", ""),
+		s.newExecution(movedShardID, "wid-a", treeID),
+		s.newExecution(movedShardID, "wid-b", ""),
+	}
+	for _, row := range runs {
+		s.source.putExecution(row)
+	}
+	s.source.putCurrentExecution(runs[2])
+	s.source.putCurrentExecution(runs[3])
+	s.source.putHistoryTree(s.newHistoryTree(movedShardID, treeID), 3)
+
+	// an outdated copy of an execution and an execution deleted since a previous copy attempt
+	outdated := runs[0]
+	outdated.NextEventID = 1
+	s.target.putExecution(outdated)
+	deleted := s.newExecution(movedShardID, "wid-c", "")
+	s.target.putExecution(deleted)
+	s.target.putCurrentExecution(deleted)
+
+	stats, err := s.copier.CopyExecutions(context.Background(), movedShardID, s.heartbeat)
+	s.NoError(err)
+	s.Equal(&ShardCopyStats{Executions: 4, DeletedExecutions: 1, HistoryNodes: 3}, stats)
+	s.Equal(s.source.executions, s.target.executions)
+	s.Equal(s.source.currentExecutions, s.target.currentExecutions)
+	s.Equal(s.source.historyTrees, s.target.historyTrees)
+	s.Equal(s.source.historyNodes, s.target.historyNodes)
+	s.NotZero(s.heartbeats)
+	// the shard stays with the current placement until the catch up
+	s.Empty(s.target.shards)
+	completed, err := s.copier.IsCompleted(context.Background(), movedShardID)
+	s.NoError(err)
+	s.False(completed)
+
+	stats, err = s.copier.CopyExecutions(context.Background(), movedShardID, s.heartbeat)
+	s.NoError(err)
+	s.Equal(&ShardCopyStats{}, stats)
+}
+
+func (s *shardCopierSuite) TestCopyExecutions_NotMovedShard() {
+	movedTreeID := newTreeID(s.copier.placement, true)
+	treeID := newTreeID(s.copier.placement, false)
+	s.source.putExecution(s.newExecution(notMovedShardID, "wid-a", movedTreeID))
+	s.source.putExecution(s.newExecution(notMovedShardID, "wid-b", treeID))
+	s.source.putHistoryTree(s.newHistoryTree(notMovedShardID, movedTreeID), 2)
+	s.source.putHistoryTree(s.newHistoryTree(notMovedShardID, treeID), 2)
+
+	stats, err := s.copier.CopyExecutions(context.Background(), notMovedShardID, s.heartbeat)
+	s.NoError(err)
+	s.Equal(&ShardCopyStats{HistoryNodes: 2}, stats)
+	// executions stay on the same database, only the trees moving to another database are copied
+	s.Empty(s.target.executions)
+	s.Len(s.target.historyTrees, 1)
+	for _, row := range s.target.historyTrees {
+		s.Equal(movedTreeID, row.TreeID.String())
+	}
+
+	stats, err = s.copier.CatchUp(context.Background(), notMovedShardID, s.heartbeat)
+	s.NoError(err)
+	s.Equal(&ShardCopyStats{}, stats)
+	s.Empty(s.target.shards)
+}
+
+func (s *shardCopierSuite) TestCopyExecutions_Completed() {
+	s.target.shards[movedShardID] = sqlplugin.ShardsRow{ShardID: movedShardID, RangeID: 7}
+
+	_, err := s.copier.CopyExecutions(context.Background(), movedShardID, s.heartbeat)
+	s.Error(err)
+}
+
+func (s *shardCopierSuite) TestCatchUp() {
+	s.source.shards[movedShardID] = sqlplugin.ShardsRow{ShardID: movedShardID, RangeID: 5}
+	updated := s.newExecution(movedShardID, "wid-a", "")
+	deleted := s.newExecution(movedShardID, "wid-b", "")
+	s.source.putExecution(updated)
+	s.source.putExecution(deleted)
+	s.source.putCurrentExecution(updated)
+	s.source.putCurrentExecution(deleted)
+	_, err := s.copier.CopyExecutions(context.Background(), movedShardID, s.heartbeat)
+	s.NoError(err)
+
+	// changes made by history hosts until the shard is cut over
+	updated.NextEventID = 10
+	s.source.putExecution(updated)
+	s.source.deleteExecution(deleted)
+	added := s.newExecution(movedShardID, "wid-c", "")
+	s.source.putExecution(added)
+	s.source.putCurrentExecution(added)
+	for taskID := int64(1); taskID <= 5; taskID++ {
+		s.source.transferTasks[taskID] = sqlplugin.TransferTasksRow{ShardID: movedShardID, TaskID: taskID}
+	}
+	// a previous attempt copied some of the tasks already
+	s.target.transferTasks[2] = s.source.transferTasks[2]
+
+	stats, err := s.copier.CatchUp(context.Background(), movedShardID, s.heartbeat)
+	s.NoError(err)
+	s.Equal(&ShardCopyStats{Executions: 2, DeletedExecutions: 1, Tasks: 5}, stats)
+	s.Equal(s.source.executions, s.target.executions)
+	s.Equal(s.source.currentExecutions, s.target.currentExecutions)
+	s.Equal(s.source.transferTasks, s.target.transferTasks)
+	// hosts still owning the shard in source are fenced off, target gets a new range
+	s.Equal(int64(6), s.source.shards[movedShardID].RangeID)
+	s.Equal(int64(7), s.target.shards[movedShardID].RangeID)
+	completed, err := s.copier.IsCompleted(context.Background(), movedShardID)
+	s.NoError(err)
+	s.True(completed)
+
+	stats, err = s.copier.CatchUp(context.Background(), movedShardID, s.heartbeat)
+	s.NoError(err)
+	s.Equal(&ShardCopyStats{}, stats)
+	s.Equal(int64(7), s.target.shards[movedShardID].RangeID)
+}
+
+func (s *shardCopierSuite) heartbeat() {
+	s.heartbeats++
+}
+
+func (s *shardCopierSuite) newExecution(shardID int, workflowID string, treeID string) sqlplugin.ExecutionsRow {
+	info := &serialization.WorkflowExecutionInfo{}
+	if treeID != "" {
+		branchID := uuid.New()
+		token, err := codec.NewThriftRWEncoder().Encode(&workflow.HistoryBranch{
+			TreeID:   &treeID,
+			BranchID: &branchID,
+		})
+		s.Require().NoError(err)
+		info.EventBranchToken = token
+	}
+	blob, err := s.parser.WorkflowExecutionInfoToBlob(info)
+	s.Require().NoError(err)
+	return sqlplugin.ExecutionsRow{
+		ShardID:      shardID,
+		DomainID:     s.domainID,
+		WorkflowID:   workflowID,
+		RunID:        serialization.MustParseUUID(uuid.New()),
+		NextEventID:  2,
+		Data:         blob.Data,
+		DataEncoding: string(blob.Encoding),
+	}
+}
+
+func (s *shardCopierSuite) newHistoryTree(shardID int, treeID string) sqlplugin.HistoryTreeRow {
+	blob, err := s.parser.HistoryTreeInfoToBlob(&serialization.HistoryTreeInfo{})
+	s.Require().NoError(err)
+	return sqlplugin.HistoryTreeRow{
+		ShardID:      shardID,
+		TreeID:       serialization.MustParseUUID(treeID),
+		BranchID:     serialization.MustParseUUID(uuid.New()),
+		Data:         blob.Data,
+		DataEncoding: string(blob.Encoding),
+	}
+}
+
+func newFakeDB(numDBShards int) *fakeDB {
+	return &fakeDB{
+		numDBShards:       numDBShards,
+		shards:            make(map[int64]sqlplugin.ShardsRow),
+		executions:        make(map[string]sqlplugin.ExecutionsRow),
+		currentExecutions: make(map[string]sqlplugin.CurrentExecutionsRow),
+		historyTrees:      make(map[string]sqlplugin.HistoryTreeRow),
+		historyNodes:      make(map[string]sqlplugin.HistoryNodeRow),
+		transferTasks:     make(map[int64]sqlplugin.TransferTasksRow),
+	}
+}
+
+func (db *fakeDB) putExecution(row sqlplugin.ExecutionsRow) {
+	db.executions[row.WorkflowID+"/"+row.RunID.String()] = row
+}
+
+func (db *fakeDB) deleteExecution(row sqlplugin.ExecutionsRow) {
+	delete(db.executions, row.WorkflowID+"/"+row.RunID.String())
+	delete(db.currentExecutions, row.WorkflowID)
+}
+
+func (db *fakeDB) putCurrentExecution(row sqlplugin.ExecutionsRow) {
+	db.currentExecutions[row.WorkflowID] = sqlplugin.CurrentExecutionsRow{
+		ShardID:    int64(row.ShardID),
+		DomainID:   row.DomainID,
+		WorkflowID: row.WorkflowID,
+		RunID:      row.RunID,
+	}
+}
+
+func (db *fakeDB) putHistoryTree(row sqlplugin.HistoryTreeRow, numNodes int) {
+	db.historyTrees[row.TreeID.String()+"/"+row.BranchID.String()] = row
+	for nodeID := int64(1); nodeID <= int64(numNodes); nodeID++ {
+		txnID := -nodeID
+		db.historyNodes[historyNodeKey(row.TreeID, row.BranchID, nodeID)] = sqlplugin.HistoryNodeRow{
+			ShardID:  row.ShardID,
+			TreeID:   row.TreeID,
+			BranchID: row.BranchID,
+			NodeID:   nodeID,
+			TxnID:    &txnID,
+		}
+	}
+}
+
+func historyNodeKey(treeID serialization.UUID, branchID serialization.UUID, nodeID int64) string {
+	return fmt.Sprintf("%v/%v/%v", treeID, branchID, nodeID)
+}
+
+func (db *fakeDB) GetTotalNumDBShards() int {
+	return db.numDBShards
+}
+
+func (db *fakeDB) IsNotFoundError(err error) bool {
+	return err == sql.ErrNoRows
+}
+
+func (db *fakeDB) IsDupEntryError(err error) bool {
+	return err == errFakeDupEntry
+}
+
+func (db *fakeDB) SelectFromShards(_ context.Context, filter *sqlplugin.ShardsFilter) (*sqlplugin.ShardsRow, error) {
+	row, ok := db.shards[filter.ShardID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &row, nil
+}
+
+func (db *fakeDB) InsertIntoShards(_ context.Context, row *sqlplugin.ShardsRow) (sql.Result, error) {
+	if _, ok := db.shards[row.ShardID]; ok {
+		return nil, errFakeDupEntry
+	}
+	db.shards[row.ShardID] = *row
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) UpdateShards(_ context.Context, row *sqlplugin.ShardsRow) (sql.Result, error) {
+	db.shards[row.ShardID] = *row
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) SelectFromExecutions(_ context.Context, filter *sqlplugin.ExecutionsFilter) ([]sqlplugin.ExecutionsRow, error) {
+	row, ok := db.executions[filter.WorkflowID+"/"+filter.RunID.String()]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return []sqlplugin.ExecutionsRow{row}, nil
+}
+
+func (db *fakeDB) SelectFromExecutionsAllShards(
+	_ context.Context,
+	filter *sqlplugin.ExecutionsFilter,
+	pageToken []byte,
+) ([]sqlplugin.ExecutionsRow, []byte, error) {
+	var keys []string
+	for key, row := range db.executions {
+		if row.ShardID == filter.ShardID && key > string(pageToken) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if len(keys) < filter.Size {
+		var rows []sqlplugin.ExecutionsRow
+		for _, key := range keys {
+			rows = append(rows, db.executions[key])
+		}
+		return rows, nil, nil
+	}
+	var rows []sqlplugin.ExecutionsRow
+	for _, key := range keys[:filter.Size] {
+		rows = append(rows, db.executions[key])
+	}
+	return rows, []byte(keys[filter.Size-1]), nil
+}
+
+func (db *fakeDB) InsertIntoExecutions(_ context.Context, row *sqlplugin.ExecutionsRow) (sql.Result, error) {
+	if _, ok := db.executions[row.WorkflowID+"/"+row.RunID.String()]; ok {
+		return nil, errFakeDupEntry
+	}
+	db.putExecution(*row)
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromExecutions(_ context.Context, filter *sqlplugin.ExecutionsFilter) (sql.Result, error) {
+	delete(db.executions, filter.WorkflowID+"/"+filter.RunID.String())
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) SelectFromCurrentExecutions(
+	_ context.Context,
+	filter *sqlplugin.CurrentExecutionsFilter,
+) (*sqlplugin.CurrentExecutionsRow, error) {
+	row, ok := db.currentExecutions[filter.WorkflowID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &row, nil
+}
+
+func (db *fakeDB) InsertIntoCurrentExecutions(_ context.Context, row *sqlplugin.CurrentExecutionsRow) (sql.Result, error) {
+	if _, ok := db.currentExecutions[row.WorkflowID]; ok {
+		return nil, errFakeDupEntry
+	}
+	db.currentExecutions[row.WorkflowID] = *row
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) UpdateCurrentExecutions(_ context.Context, row *sqlplugin.CurrentExecutionsRow) (sql.Result, error) {
+	db.currentExecutions[row.WorkflowID] = *row
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromCurrentExecutions(_ context.Context, filter *sqlplugin.CurrentExecutionsFilter) (sql.Result, error) {
+	if row, ok := db.currentExecutions[filter.WorkflowID]; ok && row.RunID.String() == filter.RunID.String() {
+		delete(db.currentExecutions, filter.WorkflowID)
+	}
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) SelectFromHistoryTree(_ context.Context, filter *sqlplugin.HistoryTreeFilter) ([]sqlplugin.HistoryTreeRow, error) {
+	var rows []sqlplugin.HistoryTreeRow
+	for _, row := range db.historyTrees {
+		if row.TreeID.String() == filter.TreeID.String() {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+func (db *fakeDB) InsertIntoHistoryTree(_ context.Context, row *sqlplugin.HistoryTreeRow) (sql.Result, error) {
+	key := row.TreeID.String() + "/" + row.BranchID.String()
+	if _, ok := db.historyTrees[key]; ok {
+		return nil, errFakeDupEntry
+	}
+	db.historyTrees[key] = *row
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) SelectFromHistoryNode(_ context.Context, filter *sqlplugin.HistoryNodeFilter) ([]sqlplugin.HistoryNodeRow, error) {
+	var rows []sqlplugin.HistoryNodeRow
+	for _, row := range db.historyNodes {
+		if row.TreeID.String() == filter.TreeID.String() && row.BranchID.String() == filter.BranchID.String() &&
+			row.NodeID >= *filter.MinNodeID && row.NodeID < *filter.MaxNodeID {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].NodeID < rows[j].NodeID
+	})
+	if len(rows) > filter.PageSize {
+		rows = rows[:filter.PageSize]
+	}
+	return rows, nil
+}
+
+func (db *fakeDB) InsertIntoHistoryNode(_ context.Context, row *sqlplugin.HistoryNodeRow) (sql.Result, error) {
+	key := historyNodeKey(row.TreeID, row.BranchID, row.NodeID)
+	if _, ok := db.historyNodes[key]; ok {
+		return nil, errFakeDupEntry
+	}
+	db.historyNodes[key] = *row
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) SelectFromTransferTasks(_ context.Context, filter *sqlplugin.TransferTasksFilter) ([]sqlplugin.TransferTasksRow, error) {
+	var rows []sqlplugin.TransferTasksRow
+	for taskID, row := range db.transferTasks {
+		if taskID > filter.MinTaskID && taskID <= filter.MaxTaskID {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].TaskID < rows[j].TaskID
+	})
+	if len(rows) > filter.PageSize {
+		rows = rows[:filter.PageSize]
+	}
+	return rows, nil
+}
+
+func (db *fakeDB) InsertIntoTransferTasks(_ context.Context, rows []sqlplugin.TransferTasksRow) (sql.Result, error) {
+	for _, row := range rows {
+		if _, ok := db.transferTasks[row.TaskID]; ok {
+			return nil, errFakeDupEntry
+		}
+	}
+	for _, row := range rows {
+		db.transferTasks[row.TaskID] = row
+	}
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) SelectFromTimerTasks(context.Context, *sqlplugin.TimerTasksFilter) ([]sqlplugin.TimerTasksRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromReplicationTasks(context.Context, *sqlplugin.ReplicationTasksFilter) ([]sqlplugin.ReplicationTasksRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromCrossClusterTasks(context.Context, *sqlplugin.CrossClusterTasksFilter) ([]sqlplugin.CrossClusterTasksRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromReplicationTasksDLQ(context.Context, *sqlplugin.ReplicationTasksDLQFilter) ([]sqlplugin.ReplicationTasksRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromActivityInfoMaps(context.Context, *sqlplugin.ActivityInfoMapsFilter) ([]sqlplugin.ActivityInfoMapsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromTimerInfoMaps(context.Context, *sqlplugin.TimerInfoMapsFilter) ([]sqlplugin.TimerInfoMapsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromChildExecutionInfoMaps(context.Context, *sqlplugin.ChildExecutionInfoMapsFilter) ([]sqlplugin.ChildExecutionInfoMapsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromRequestCancelInfoMaps(context.Context, *sqlplugin.RequestCancelInfoMapsFilter) ([]sqlplugin.RequestCancelInfoMapsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromSignalInfoMaps(context.Context, *sqlplugin.SignalInfoMapsFilter) ([]sqlplugin.SignalInfoMapsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromSignalsRequestedSets(context.Context, *sqlplugin.SignalsRequestedSetsFilter) ([]sqlplugin.SignalsRequestedSetsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) SelectFromBufferedEvents(context.Context, *sqlplugin.BufferedEventsFilter) ([]sqlplugin.BufferedEventsRow, error) {
+	return nil, nil
+}
+
+func (db *fakeDB) DeleteFromActivityInfoMaps(context.Context, *sqlplugin.ActivityInfoMapsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromTimerInfoMaps(context.Context, *sqlplugin.TimerInfoMapsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromChildExecutionInfoMaps(context.Context, *sqlplugin.ChildExecutionInfoMapsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromRequestCancelInfoMaps(context.Context, *sqlplugin.RequestCancelInfoMapsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromSignalInfoMaps(context.Context, *sqlplugin.SignalInfoMapsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromSignalsRequestedSets(context.Context, *sqlplugin.SignalsRequestedSetsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (db *fakeDB) DeleteFromBufferedEvents(context.Context, *sqlplugin.BufferedEventsFilter) (sql.Result, error) {
+	return fakeResult{}, nil
+}
+
+func (fakeResult) LastInsertId() (int64, error) {
+	return 0, nil
+}
+
+func (fakeResult) RowsAffected() (int64, error) {
+	return 1, nil
+}
@@ -0,0 +1,402 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/config"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	reshardingSuite struct {
+		suite.Suite
+		cutover   map[int]bool
+		placement *reshardingPlacement
+	}
+
+	fakeShardStore struct {
+		p.ShardStore
+		shards  map[int]*p.InternalShardInfo
+		gets    int
+		updates int
+	}
+
+	fakeExecutionStore struct {
+		p.ExecutionStore
+		runs    map[string]string
+		updates int
+	}
+
+	fakeHistoryStore struct {
+		p.HistoryStore
+		appended []string
+		deleted  []string
+		reads    int
+	}
+)
+
+// movedShardID is on DB shard 0 of 1 in source and on DB shard 1 of 2 in target
+const movedShardID = 3
+
+func TestReshardingSuite(t *testing.T) {
+	suite.Run(t, new(reshardingSuite))
+}
+
+func (s *reshardingSuite) SetupTest() {
+	s.cutover = make(map[int]bool)
+	s.placement = newReshardingPlacement(1, &config.SQLResharding{
+		TargetNumShards: 2,
+		Cutover: func(shardID int) bool {
+			return s.cutover[shardID]
+		},
+	})
+}
+
+func (s *reshardingSuite) TestNewReshardingConfig() {
+	cfg := config.SQL{
+		PluginName:           "mysql",
+		NumShards:            2,
+		UseMultipleDatabases: true,
+		MultipleDatabasesConfig: []config.MultipleDatabasesConfigEntry{
+			{DatabaseName: "db0", ConnectAddr: "host0"},
+			{DatabaseName: "db1", ConnectAddr: "host1"},
+			{DatabaseName: "db2", ConnectAddr: "host2"},
+		},
+		Resharding: &config.SQLResharding{TargetNumShards: 3},
+	}
+
+	view := newReshardingConfig(cfg, 3)
+	s.Nil(view.Resharding)
+	s.Equal(3, view.NumShards)
+	s.True(view.UseMultipleDatabases)
+	s.Len(view.MultipleDatabasesConfig, 3)
+
+	view = newReshardingConfig(cfg, 1)
+	s.Nil(view.Resharding)
+	s.Equal(1, view.NumShards)
+	s.False(view.UseMultipleDatabases)
+	s.Nil(view.MultipleDatabasesConfig)
+	s.Equal("db0", view.DatabaseName)
+	s.Equal("host0", view.ConnectAddr)
+	s.NotNil(cfg.Resharding)
+}
+
+func (s *reshardingSuite) TestIsCutover() {
+	s.False(s.placement.isCutover(movedShardID))
+	s.cutover[movedShardID] = true
+	s.True(s.placement.isCutover(movedShardID))
+	s.False(newReshardingPlacement(1, &config.SQLResharding{TargetNumShards: 2}).isCutover(movedShardID))
+}
+
+func (s *reshardingSuite) TestShardStore_BeforeCutover() {
+	source := newFakeShardStore(movedShardID)
+	target := newFakeShardStore()
+	store := newReshardingShardStore(s.placement, source, target)
+
+	resp, err := store.GetShard(context.Background(), &p.InternalGetShardRequest{ShardID: movedShardID})
+	s.NoError(err)
+	s.Equal(movedShardID, resp.ShardInfo.ShardID)
+
+	s.NoError(store.UpdateShard(context.Background(), newUpdateShardRequest(movedShardID)))
+	s.Equal(1, source.updates)
+	s.Equal(0, target.updates)
+
+	s.NoError(store.CreateShard(context.Background(), &p.InternalCreateShardRequest{
+		ShardInfo: &p.InternalShardInfo{ShardID: 5},
+	}))
+	s.Contains(source.shards, 5)
+	s.NotContains(target.shards, 5)
+}
+
+func (s *reshardingSuite) TestShardStore_CutoverBeforeCopyCompleted() {
+	source := newFakeShardStore(movedShardID)
+	target := newFakeShardStore()
+	store := newReshardingShardStore(s.placement, source, target)
+	s.cutover[movedShardID] = true
+
+	resp, err := store.GetShard(context.Background(), &p.InternalGetShardRequest{ShardID: movedShardID})
+	s.NoError(err)
+	s.Equal(movedShardID, resp.ShardInfo.ShardID)
+
+	err = store.UpdateShard(context.Background(), newUpdateShardRequest(movedShardID))
+	s.IsType(&p.ShardOwnershipLostError{}, err)
+	s.Equal(0, source.updates)
+	s.Equal(0, target.updates)
+
+	err = store.CreateShard(context.Background(), &p.InternalCreateShardRequest{
+		ShardInfo: &p.InternalShardInfo{ShardID: movedShardID},
+	})
+	s.IsType(&p.ShardAlreadyExistError{}, err)
+	s.NotContains(target.shards, movedShardID)
+}
+
+func (s *reshardingSuite) TestShardStore_CutoverAfterCopyCompleted() {
+	source := newFakeShardStore(movedShardID)
+	target := newFakeShardStore()
+	store := newReshardingShardStore(s.placement, source, target)
+	s.cutover[movedShardID] = true
+
+	// the copy workflow creates the shard row in target once it has caught up
+	target.shards[movedShardID] = &p.InternalShardInfo{ShardID: movedShardID, RangeID: 2}
+
+	resp, err := store.GetShard(context.Background(), &p.InternalGetShardRequest{ShardID: movedShardID})
+	s.NoError(err)
+	s.Equal(int64(2), resp.ShardInfo.RangeID)
+
+	s.NoError(store.UpdateShard(context.Background(), newUpdateShardRequest(movedShardID)))
+	s.NoError(store.UpdateShard(context.Background(), newUpdateShardRequest(movedShardID)))
+	s.Equal(0, source.updates)
+	s.Equal(2, target.updates)
+	// the target shard row is only looked up until it is found
+	s.Equal(2, target.gets)
+}
+
+func (s *reshardingSuite) TestShardStore_CreateShardAfterCutover() {
+	source := newFakeShardStore()
+	target := newFakeShardStore()
+	store := newReshardingShardStore(s.placement, source, target)
+	s.cutover[movedShardID] = true
+
+	s.NoError(store.CreateShard(context.Background(), &p.InternalCreateShardRequest{
+		ShardInfo: &p.InternalShardInfo{ShardID: movedShardID},
+	}))
+	s.NotContains(source.shards, movedShardID)
+	s.Contains(target.shards, movedShardID)
+}
+
+func (s *reshardingSuite) TestExecutionStore_BeforeCutover() {
+	source := newFakeExecutionStore("wid", "rid")
+	target := newFakeExecutionStore()
+	store := newReshardingExecutionStore(movedShardID, s.placement, source, target, newFakeShardStore())
+
+	resp, err := store.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{WorkflowID: "wid"})
+	s.NoError(err)
+	s.Equal("rid", resp.RunID)
+
+	s.NoError(store.UpdateWorkflowExecution(context.Background(), &p.InternalUpdateWorkflowExecutionRequest{}))
+	s.Equal(1, source.updates)
+	s.Equal(0, target.updates)
+}
+
+func (s *reshardingSuite) TestExecutionStore_CutoverBeforeCopyCompleted() {
+	source := newFakeExecutionStore("wid", "rid")
+	target := newFakeExecutionStore("other-wid", "other-rid")
+	store := newReshardingExecutionStore(movedShardID, s.placement, source, target, newFakeShardStore())
+	s.cutover[movedShardID] = true
+
+	resp, err := store.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{WorkflowID: "wid"})
+	s.NoError(err)
+	s.Equal("rid", resp.RunID)
+	resp, err = store.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{WorkflowID: "other-wid"})
+	s.NoError(err)
+	s.Equal("other-rid", resp.RunID)
+	_, err = store.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{WorkflowID: "missing-wid"})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	s.NoError(store.UpdateWorkflowExecution(context.Background(), &p.InternalUpdateWorkflowExecutionRequest{}))
+	s.Equal(0, source.updates)
+	s.Equal(1, target.updates)
+}
+
+func (s *reshardingSuite) TestExecutionStore_CutoverAfterCopyCompleted() {
+	source := newFakeExecutionStore("wid", "rid")
+	target := newFakeExecutionStore()
+	store := newReshardingExecutionStore(movedShardID, s.placement, source, target, newFakeShardStore(movedShardID))
+	s.cutover[movedShardID] = true
+
+	// executions deleted from target after the copy must not be read from source anymore
+	_, err := store.GetCurrentExecution(context.Background(), &p.GetCurrentExecutionRequest{WorkflowID: "wid"})
+	s.IsType(&types.EntityNotExistsError{}, err)
+	_, err = store.GetWorkflowExecution(context.Background(), &p.InternalGetWorkflowExecutionRequest{
+		Execution: types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+func (s *reshardingSuite) TestHistoryStore_BeforeCutover() {
+	source := &fakeHistoryStore{}
+	target := &fakeHistoryStore{}
+	store := newReshardingHistoryStore(s.placement, source, target)
+	movedTreeID := newTreeID(s.placement, true)
+	treeID := newTreeID(s.placement, false)
+
+	for _, id := range []string{movedTreeID, treeID} {
+		s.NoError(store.AppendHistoryNodes(context.Background(), &p.InternalAppendHistoryNodesRequest{
+			BranchInfo: types.HistoryBranch{TreeID: id},
+			ShardID:    movedShardID,
+		}))
+		s.NoError(store.DeleteHistoryBranch(context.Background(), &p.InternalDeleteHistoryBranchRequest{
+			BranchInfo: types.HistoryBranch{TreeID: id},
+			ShardID:    movedShardID,
+		}))
+	}
+	s.Equal([]string{movedTreeID, treeID}, source.appended)
+	s.Equal([]string{movedTreeID, treeID}, source.deleted)
+	// the target placement gets the writes of the trees which are on another database there
+	s.Equal([]string{movedTreeID}, target.appended)
+	s.Equal([]string{movedTreeID}, target.deleted)
+
+	_, err := store.ReadHistoryBranch(context.Background(), &p.InternalReadHistoryBranchRequest{
+		TreeID:  movedTreeID,
+		ShardID: movedShardID,
+	})
+	s.NoError(err)
+	s.Equal(1, source.reads)
+	s.Equal(0, target.reads)
+}
+
+func (s *reshardingSuite) TestHistoryStore_AfterCutover() {
+	source := &fakeHistoryStore{}
+	target := &fakeHistoryStore{}
+	store := newReshardingHistoryStore(s.placement, source, target)
+	s.cutover[movedShardID] = true
+	treeID := newTreeID(s.placement, true)
+
+	s.NoError(store.AppendHistoryNodes(context.Background(), &p.InternalAppendHistoryNodesRequest{
+		BranchInfo: types.HistoryBranch{TreeID: treeID},
+		ShardID:    movedShardID,
+	}))
+	s.NoError(store.DeleteHistoryBranch(context.Background(), &p.InternalDeleteHistoryBranchRequest{
+		BranchInfo: types.HistoryBranch{TreeID: treeID},
+		ShardID:    movedShardID,
+	}))
+	_, err := store.ReadHistoryBranch(context.Background(), &p.InternalReadHistoryBranchRequest{
+		TreeID:  treeID,
+		ShardID: movedShardID,
+	})
+	s.NoError(err)
+
+	s.Empty(source.appended)
+	s.Empty(source.deleted)
+	s.Equal(0, source.reads)
+	s.Equal([]string{treeID}, target.appended)
+	s.Equal([]string{treeID}, target.deleted)
+	s.Equal(1, target.reads)
+}
+
+// newTreeID returns a tree ID which is on another database in the target placement if moved is true
+func newTreeID(placement *reshardingPlacement, moved bool) string {
+	for {
+		treeID := uuid.New()
+		if placement.isTreeMoved(treeID) == moved {
+			return treeID
+		}
+	}
+}
+
+func newUpdateShardRequest(shardID int) *p.InternalUpdateShardRequest {
+	return &p.InternalUpdateShardRequest{
+		ShardInfo: &p.InternalShardInfo{ShardID: shardID},
+	}
+}
+
+func newFakeShardStore(shardIDs ...int) *fakeShardStore {
+	s := &fakeShardStore{shards: make(map[int]*p.InternalShardInfo)}
+	for _, shardID := range shardIDs {
+		s.shards[shardID] = &p.InternalShardInfo{ShardID: shardID}
+	}
+	return s
+}
+
+func (s *fakeShardStore) CreateShard(_ context.Context, request *p.InternalCreateShardRequest) error {
+	if _, ok := s.shards[request.ShardInfo.ShardID]; ok {
+		return &p.ShardAlreadyExistError{}
+	}
+	s.shards[request.ShardInfo.ShardID] = request.ShardInfo
+	return nil
+}
+
+func (s *fakeShardStore) GetShard(_ context.Context, request *p.InternalGetShardRequest) (*p.InternalGetShardResponse, error) {
+	s.gets++
+	shard, ok := s.shards[request.ShardID]
+	if !ok {
+		return nil, &types.EntityNotExistsError{}
+	}
+	return &p.InternalGetShardResponse{ShardInfo: shard}, nil
+}
+
+func (s *fakeShardStore) UpdateShard(_ context.Context, request *p.InternalUpdateShardRequest) error {
+	if _, ok := s.shards[request.ShardInfo.ShardID]; !ok {
+		return &types.InternalServiceError{}
+	}
+	s.shards[request.ShardInfo.ShardID] = request.ShardInfo
+	s.updates++
+	return nil
+}
+
+func newFakeExecutionStore(workflowIDAndRunIDs ...string) *fakeExecutionStore {
+	s := &fakeExecutionStore{runs: make(map[string]string)}
+	for i := 0; i < len(workflowIDAndRunIDs); i += 2 {
+		s.runs[workflowIDAndRunIDs[i]] = workflowIDAndRunIDs[i+1]
+	}
+	return s
+}
+
+func (s *fakeExecutionStore) GetWorkflowExecution(
+	_ context.Context,
+	request *p.InternalGetWorkflowExecutionRequest,
+) (*p.InternalGetWorkflowExecutionResponse, error) {
+	if s.runs[request.Execution.WorkflowID] != request.Execution.RunID {
+		return nil, &types.EntityNotExistsError{}
+	}
+	return &p.InternalGetWorkflowExecutionResponse{}, nil
+}
+
+func (s *fakeExecutionStore) GetCurrentExecution(
+	_ context.Context,
+	request *p.GetCurrentExecutionRequest,
+) (*p.GetCurrentExecutionResponse, error) {
+	runID, ok := s.runs[request.WorkflowID]
+	if !ok {
+		return nil, &types.EntityNotExistsError{}
+	}
+	return &p.GetCurrentExecutionResponse{RunID: runID}, nil
+}
+
+func (s *fakeExecutionStore) UpdateWorkflowExecution(_ context.Context, _ *p.InternalUpdateWorkflowExecutionRequest) error {
+	s.updates++
+	return nil
+}
+
+func (s *fakeHistoryStore) AppendHistoryNodes(_ context.Context, request *p.InternalAppendHistoryNodesRequest) error {
+	s.appended = append(s.appended, request.BranchInfo.TreeID)
+	return nil
+}
+
+func (s *fakeHistoryStore) DeleteHistoryBranch(_ context.Context, request *p.InternalDeleteHistoryBranchRequest) error {
+	s.deleted = append(s.deleted, request.BranchInfo.TreeID)
+	return nil
+}
+
+func (s *fakeHistoryStore) ReadHistoryBranch(
+	_ context.Context,
+	_ *p.InternalReadHistoryBranchRequest,
+) (*p.InternalReadHistoryBranchResponse, error) {
+	s.reads++
+	return &p.InternalReadHistoryBranchResponse{}, nil
+}
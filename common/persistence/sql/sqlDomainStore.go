@@ -119,6 +119,7 @@ func (m *sqlDomainStore) CreateDomain(
 		HistoryArchivalURI:          request.Config.HistoryArchivalURI,
 		VisibilityArchivalStatus:    int16(request.Config.VisibilityArchivalStatus),
 		VisibilityArchivalURI:       request.Config.VisibilityArchivalURI,
+		ArchivalRetention:           request.Config.ArchivalRetention,
 		ActiveClusterName:           request.ReplicationConfig.ActiveClusterName,
 		Clusters:                    clusters,
 		ConfigVersion:               request.ConfigVersion,
@@ -245,6 +246,7 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 			VisibilityArchivalStatus: types.ArchivalStatus(domainInfo.GetVisibilityArchivalStatus()),
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
+			ArchivalRetention:        domainInfo.GetArchivalRetention(),
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: cluster.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
@@ -291,6 +293,7 @@ func (m *sqlDomainStore) UpdateDomain(
 		HistoryArchivalURI:          request.Config.HistoryArchivalURI,
 		VisibilityArchivalStatus:    int16(request.Config.VisibilityArchivalStatus),
 		VisibilityArchivalURI:       request.Config.VisibilityArchivalURI,
+		ArchivalRetention:           request.Config.ArchivalRetention,
 		ActiveClusterName:           request.ReplicationConfig.ActiveClusterName,
 		Clusters:                    clusters,
 		ConfigVersion:               request.ConfigVersion,
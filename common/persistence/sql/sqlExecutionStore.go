@@ -780,44 +780,17 @@ func (m *sqlExecutionStore) ListConcreteExecutions(
 	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
 
-	filter := &sqlplugin.ExecutionsFilter{}
-	if len(request.PageToken) > 0 {
-		err := gobDeserialize(request.PageToken, &filter)
-		if err != nil {
-			return nil, &types.InternalServiceError{
-				Message: fmt.Sprintf("ListConcreteExecutions failed. Error: %v", err),
-			}
-		}
-	} else {
-		filter = &sqlplugin.ExecutionsFilter{
-			ShardID:    m.shardID,
-			WorkflowID: "",
-		}
-	}
-	filter.Size = request.PageSize
-
-	executions, err := m.db.SelectFromExecutions(ctx, filter)
+	executions, token, err := m.db.SelectFromExecutionsAllShards(ctx, &sqlplugin.ExecutionsFilter{
+		ShardID: m.shardID,
+		Size:    request.PageSize,
+	}, request.PageToken)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return &p.InternalListConcreteExecutionsResponse{}, nil
-		}
 		return nil, convertCommonErrors(m.db, "ListConcreteExecutions", "", err)
 	}
 
 	if len(executions) == 0 {
 		return &p.InternalListConcreteExecutionsResponse{}, nil
 	}
-	lastExecution := executions[len(executions)-1]
-	nextFilter := &sqlplugin.ExecutionsFilter{
-		ShardID:    m.shardID,
-		WorkflowID: lastExecution.WorkflowID,
-	}
-	token, err := gobSerialize(nextFilter)
-	if err != nil {
-		return nil, &types.InternalServiceError{
-			Message: fmt.Sprintf("ListConcreteExecutions failed. Error: %v", err),
-		}
-	}
 	concreteExecutions, err := m.populateInternalListConcreteExecutions(executions)
 	if err != nil {
 		return nil, &types.InternalServiceError{
@@ -25,7 +25,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"math"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -38,7 +37,6 @@ import (
 
 type sqlTaskStore struct {
 	sqlStore
-	nShards int
 }
 
 var (
@@ -48,7 +46,6 @@ var (
 // newTaskPersistence creates a new instance of TaskManager
 func newTaskPersistence(
 	db sqlplugin.DB,
-	nShards int,
 	log log.Logger,
 	parser serialization.Parser,
 ) (persistence.TaskStore, error) {
@@ -58,7 +55,6 @@ func newTaskPersistence(
 			logger: log,
 			parser: parser,
 		},
-		nShards: nShards,
 	}, nil
 }
 
@@ -248,63 +244,16 @@ func (m *sqlTaskStore) UpdateTaskList(
 	return resp, err
 }
 
-type taskListPageToken struct {
-	ShardID  int
-	DomainID serialization.UUID
-	Name     string
-	TaskType int64
-}
-
-// ListTaskList lists tasklist from DB
-// DomainID translates into byte array in SQL. The minUUID is not the minimum byte array.
-// This API could return incomplete result set.
-// https://github.com/uber/cadence/issues/3911
+// ListTaskList lists the task lists of all db shards
 func (m *sqlTaskStore) ListTaskList(
 	ctx context.Context,
 	request *persistence.ListTaskListRequest,
 ) (*persistence.ListTaskListResponse, error) {
-	pageToken := taskListPageToken{TaskType: math.MinInt16, DomainID: serialization.UUID{}}
-	if request.PageToken != nil {
-		if err := gobDeserialize(request.PageToken, &pageToken); err != nil {
-			return nil, &types.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
-		}
-	}
-	var err error
-	var rows []sqlplugin.TaskListsRow
-	domainID := pageToken.DomainID
-	for pageToken.ShardID < m.nShards {
-		rows, err = m.db.SelectFromTaskLists(ctx, &sqlplugin.TaskListsFilter{
-			ShardID:             pageToken.ShardID,
-			DomainIDGreaterThan: &domainID,
-			NameGreaterThan:     &pageToken.Name,
-			TaskTypeGreaterThan: &pageToken.TaskType,
-			PageSize:            &request.PageSize,
-		})
-		if err != nil {
-			return nil, convertCommonErrors(m.db, "ListTaskList", "", err)
-		}
-		if len(rows) > 0 {
-			break
-		}
-		pageToken = taskListPageToken{ShardID: pageToken.ShardID + 1, TaskType: math.MinInt16, DomainID: serialization.UUID{}}
-	}
-
-	var nextPageToken []byte
-	switch {
-	case len(rows) >= request.PageSize:
-		lastRow := &rows[request.PageSize-1]
-		nextPageToken, err = gobSerialize(&taskListPageToken{
-			ShardID:  pageToken.ShardID,
-			DomainID: lastRow.DomainID,
-			Name:     lastRow.Name,
-			TaskType: lastRow.TaskType,
-		})
-	case pageToken.ShardID+1 < m.nShards:
-		nextPageToken, err = gobSerialize(&taskListPageToken{ShardID: pageToken.ShardID + 1, TaskType: math.MinInt16, DomainID: serialization.UUID{}})
-	}
-
+	rows, nextPageToken, err := m.db.SelectFromTaskListsAllShards(ctx, &sqlplugin.TaskListsFilter{
+		PageSize: &request.PageSize,
+	}, request.PageToken)
 	if err != nil {
-		return nil, &types.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		return nil, convertCommonErrors(m.db, "ListTaskList", "", err)
 	}
 
 	resp := &persistence.ListTaskListResponse{
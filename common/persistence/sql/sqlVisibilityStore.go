@@ -21,6 +21,7 @@
 package sql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -30,7 +31,10 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 	"github.com/uber/cadence/common/types"
@@ -40,25 +44,41 @@ import (
 type (
 	sqlVisibilityStore struct {
 		sqlStore
+		validSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
 	visibilityPageToken struct {
 		Time  time.Time
 		RunID string
+		// Offset is only used by the queries with order by
+		Offset int `json:",omitempty"`
+		// ShardsPageToken is the position of every db shard, the shards without a position continue from Time and RunID
+		ShardsPageToken []byte `json:",omitempty"`
 	}
 )
 
-// NewSQLVisibilityStore creates an instance of ExecutionStore
-func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
+const defaultVisibilityQueryPageSize = 1000
+
+// NewSQLVisibilityStore creates an instance of VisibilityStore,
+// validSearchAttributes defaults to the default indexed keys when it is nil
+func NewSQLVisibilityStore(
+	cfg config.SQL,
+	validSearchAttributes dynamicconfig.MapPropertyFn,
+	logger log.Logger,
+) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
 	if err != nil {
 		return nil, err
 	}
+	if validSearchAttributes == nil {
+		validSearchAttributes = dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys())
+	}
 	return &sqlVisibilityStore{
 		sqlStore: sqlStore{
 			db:     db,
 			logger: logger,
 		},
+		validSearchAttributes: validSearchAttributes,
 	}, nil
 }
 
@@ -66,7 +86,11 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionStartedRequest,
 ) error {
-	_, err := s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes, s.searchAttributeTypes())
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -75,8 +99,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(
 		WorkflowTypeName: request.WorkflowTypeName,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
 		IsCron:           request.IsCron,
 		NumClusters:      request.NumClusters,
+		SearchAttributes: searchAttributes,
 	})
 
 	if err != nil {
@@ -89,6 +115,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 	ctx context.Context,
 	request *p.InternalRecordWorkflowExecutionClosedRequest,
 ) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes, s.searchAttributeTypes())
+	if err != nil {
+		return err
+	}
 	closeTime := request.CloseTimestamp
 	result, err := s.db.ReplaceIntoVisibility(ctx, &sqlplugin.VisibilityRow{
 		DomainID:         request.DomainUUID,
@@ -102,8 +132,10 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
 		IsCron:           request.IsCron,
 		NumClusters:      request.NumClusters,
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return convertCommonErrors(s.db, "RecordWorkflowExecutionClosed", "", err)
@@ -126,25 +158,43 @@ func (s *sqlVisibilityStore) UpsertWorkflowExecution(
 	ctx context.Context,
 	request *p.InternalUpsertWorkflowExecutionRequest,
 ) error {
-	if p.IsNopUpsertWorkflowRequest(request) {
-		return nil
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes, s.searchAttributeTypes())
+	if err != nil {
+		return err
+	}
+	_, err = s.db.UpsertIntoVisibility(ctx, &sqlplugin.VisibilityRow{
+		DomainID:         request.DomainUUID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        request.StartTimestamp,
+		ExecutionTime:    request.ExecutionTimestamp,
+		WorkflowTypeName: request.WorkflowTypeName,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
+		IsCron:           request.IsCron,
+		NumClusters:      request.NumClusters,
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return convertCommonErrors(s.db, "UpsertWorkflowExecution", "", err)
 	}
-	return p.NewOperationNotSupportErrorForVis()
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(
 	ctx context.Context,
 	request *p.InternalListWorkflowExecutionsRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutions", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &request.EarliestTime,
 				MaxStartTime: &readLevel.Time,
 				RunID:        &readLevel.RunID,
 				PageSize:     &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -152,16 +202,16 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutions(
 	ctx context.Context,
 	request *p.InternalListWorkflowExecutionsRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutions", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutions", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &request.EarliestTime,
 				MaxStartTime: &readLevel.Time,
 				Closed:       true,
 				RunID:        &readLevel.RunID,
 				PageSize:     &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -169,16 +219,16 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByType(
 	ctx context.Context,
 	request *p.InternalListWorkflowExecutionsByTypeRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByType", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:         request.DomainUUID,
 				MinStartTime:     &request.EarliestTime,
 				MaxStartTime:     &readLevel.Time,
 				RunID:            &readLevel.RunID,
 				WorkflowTypeName: &request.WorkflowTypeName,
 				PageSize:         &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -186,9 +236,9 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(
 	ctx context.Context,
 	request *p.InternalListWorkflowExecutionsByTypeRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByType", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:         request.DomainUUID,
 				MinStartTime:     &request.EarliestTime,
 				MaxStartTime:     &readLevel.Time,
@@ -196,7 +246,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByType(
 				RunID:            &readLevel.RunID,
 				WorkflowTypeName: &request.WorkflowTypeName,
 				PageSize:         &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -204,16 +254,16 @@ func (s *sqlVisibilityStore) ListOpenWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *p.InternalListWorkflowExecutionsByWorkflowIDRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListOpenWorkflowExecutionsByWorkflowID", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &request.EarliestTime,
 				MaxStartTime: &readLevel.Time,
 				RunID:        &readLevel.RunID,
 				WorkflowID:   &request.WorkflowID,
 				PageSize:     &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -221,9 +271,9 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 	ctx context.Context,
 	request *p.InternalListWorkflowExecutionsByWorkflowIDRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByWorkflowID", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &request.EarliestTime,
 				MaxStartTime: &readLevel.Time,
@@ -231,7 +281,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByWorkflowID(
 				RunID:        &readLevel.RunID,
 				WorkflowID:   &request.WorkflowID,
 				PageSize:     &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -239,9 +289,9 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 	ctx context.Context,
 	request *p.InternalListClosedWorkflowExecutionsByStatusRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", request.NextPageToken, request.EarliestTime, request.LatestTime,
-		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error) {
-			return s.db.SelectFromVisibility(ctx, &sqlplugin.VisibilityFilter{
+	return s.listWorkflowExecutions("ListClosedWorkflowExecutionsByStatus", request.NextPageToken, request.LatestTime,
+		func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error) {
+			return s.db.SelectFromVisibilityAllShards(ctx, &sqlplugin.VisibilityFilter{
 				DomainID:     request.DomainUUID,
 				MinStartTime: &request.EarliestTime,
 				MaxStartTime: &readLevel.Time,
@@ -249,7 +299,7 @@ func (s *sqlVisibilityStore) ListClosedWorkflowExecutionsByStatus(
 				RunID:        &readLevel.RunID,
 				CloseStatus:  common.Int32Ptr(int32(*thrift.FromWorkflowExecutionCloseStatus(&request.Status))),
 				PageSize:     &request.PageSize,
-			})
+			}, readLevel.ShardsPageToken)
 		})
 }
 
@@ -296,21 +346,91 @@ func (s *sqlVisibilityStore) ListWorkflowExecutions(
 	ctx context.Context,
 	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.listWorkflowExecutionsByQuery(ctx, "ListWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(
 	ctx context.Context,
 	request *p.ListWorkflowExecutionsByQueryRequest,
 ) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.listWorkflowExecutionsByQuery(ctx, "ScanWorkflowExecutions", request)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(
 	ctx context.Context,
 	request *p.CountWorkflowExecutionsRequest,
 ) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.searchAttributeTypes())
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	count, err := s.db.CountFromVisibilityByQuery(ctx, &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(s.db, "CountWorkflowExecutions", "", err)
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+// listWorkflowExecutionsByQuery pages the rows by start time and run id like the other list APIs,
+// or by offset if the query has its own order
+func (s *sqlVisibilityStore) listWorkflowExecutionsByQuery(
+	ctx context.Context,
+	opName string,
+	request *p.ListWorkflowExecutionsByQueryRequest,
+) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query, s.searchAttributeTypes())
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultVisibilityQueryPageSize
+	}
+	filter := &sqlplugin.VisibilityQueryFilter{
+		DomainID: request.DomainUUID,
+		Query:    query,
+		PageSize: pageSize,
+	}
+	if len(request.NextPageToken) > 0 {
+		token, err := s.deserializePageToken(request.NextPageToken)
+		if err != nil {
+			return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid next page token: %v", err)}
+		}
+		if query.HasOrder() {
+			filter.Offset = token.Offset
+		} else {
+			filter.MaxStartTime = &token.Time
+			filter.RunID = &token.RunID
+		}
+	}
+
+	rows, err := s.db.SelectFromVisibilityByQuery(ctx, filter)
+	if err != nil {
+		return nil, convertCommonErrors(s.db, opName, "", err)
+	}
+	infos := make([]*p.InternalVisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		lastRow := rows[len(rows)-1]
+		token := &visibilityPageToken{Time: lastRow.StartTime, RunID: lastRow.RunID}
+		if query.HasOrder() {
+			token = &visibilityPageToken{Offset: filter.Offset + len(rows)}
+		}
+		if nextPageToken, err = s.serializePageToken(token); err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.InternalVisibilityWorkflowExecutionInfo {
@@ -323,10 +443,21 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 		TypeName:      row.WorkflowTypeName,
 		StartTime:     row.StartTime,
 		ExecutionTime: row.ExecutionTime,
+		TaskList:      row.TaskList,
 		IsCron:        row.IsCron,
 		NumClusters:   row.NumClusters,
 		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
 	}
+	if len(row.SearchAttributes) > 0 {
+		searchAttributes, err := deserializeSearchAttributes(row.SearchAttributes)
+		if err != nil {
+			s.logger.Error("failed to deserialize search attributes",
+				tag.WorkflowID(row.WorkflowID),
+				tag.WorkflowRunID(row.RunID),
+				tag.Error(err))
+		}
+		info.SearchAttributes = searchAttributes
+	}
 	if row.CloseStatus != nil {
 		status := workflow.WorkflowExecutionCloseStatus(*row.CloseStatus)
 		info.Status = thrift.ToWorkflowExecutionCloseStatus(&status)
@@ -336,7 +467,7 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Internal
 	return info
 }
 
-func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, earliestTime time.Time, latestTime time.Time, selectOp func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, error)) (*p.InternalListWorkflowExecutionsResponse, error) {
+func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []byte, latestTime time.Time, selectOp func(readLevel *visibilityPageToken) ([]sqlplugin.VisibilityRow, []byte, error)) (*p.InternalListWorkflowExecutionsResponse, error) {
 	var readLevel *visibilityPageToken
 	var err error
 	if len(pageToken) > 0 {
@@ -347,7 +478,7 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 	} else {
 		readLevel = &visibilityPageToken{Time: latestTime, RunID: ""}
 	}
-	rows, err := selectOp(readLevel)
+	rows, shardsPageToken, err := selectOp(readLevel)
 	if err != nil {
 		return nil, convertCommonErrors(s.db, opName, "", err)
 	}
@@ -360,12 +491,12 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 		infos[i] = s.rowToInfo(&row)
 	}
 	var nextPageToken []byte
-	lastRow := rows[len(rows)-1]
-	lastStartTime := lastRow.StartTime
-	if lastStartTime.Sub(earliestTime).Nanoseconds() > 0 {
+	if len(shardsPageToken) > 0 {
+		lastRow := rows[len(rows)-1]
 		nextPageToken, err = s.serializePageToken(&visibilityPageToken{
-			Time:  lastStartTime,
-			RunID: lastRow.RunID,
+			Time:            lastRow.StartTime,
+			RunID:           lastRow.RunID,
+			ShardsPageToken: shardsPageToken,
 		})
 		if err != nil {
 			return nil, err
@@ -387,3 +518,63 @@ func (s *sqlVisibilityStore) serializePageToken(token *visibilityPageToken) ([]b
 	data, err := json.Marshal(token)
 	return data, err
 }
+
+// searchAttributeTypes returns the declared types of the valid search attributes
+func (s *sqlVisibilityStore) searchAttributeTypes() map[string]types.IndexedValueType {
+	validSearchAttributes := s.validSearchAttributes()
+	result := make(map[string]types.IndexedValueType, len(validSearchAttributes))
+	for key, valueType := range validSearchAttributes {
+		result[key] = thrift.ToIndexedValueType(common.ConvertIndexedValueTypeToThriftType(valueType, s.logger))
+	}
+	return result
+}
+
+// serializeSearchAttributes encodes the JSON encoded search attributes to a JSON object,
+// Datetime values are stored in sqlplugin.SearchAttributeDatetimeFormat so that they can be compared by queries
+func serializeSearchAttributes(
+	searchAttributes map[string][]byte,
+	searchAttributeTypes map[string]types.IndexedValueType,
+) ([]byte, error) {
+	if len(searchAttributes) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]json.RawMessage, len(searchAttributes))
+	for key, value := range searchAttributes {
+		if searchAttributeTypes[key] == types.IndexedValueTypeDatetime {
+			value = normalizeDatetimeSearchAttribute(value)
+		}
+		fields[key] = value
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid search attributes: %v", err)}
+	}
+	return data, nil
+}
+
+// normalizeDatetimeSearchAttribute converts a JSON encoded RFC3339 time or unix nanoseconds
+// to sqlplugin.SearchAttributeDatetimeFormat, values of other forms are returned as is
+func normalizeDatetimeSearchAttribute(value []byte) []byte {
+	var t time.Time
+	var nanos int64
+	switch {
+	case json.Unmarshal(value, &t) == nil:
+	case json.Unmarshal(value, &nanos) == nil:
+		t = time.Unix(0, nanos)
+	default:
+		return value
+	}
+	normalized, err := json.Marshal(t.UTC().Format(sqlplugin.SearchAttributeDatetimeFormat))
+	if err != nil {
+		return value
+	}
+	return normalized
+}
+
+func deserializeSearchAttributes(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var searchAttributes map[string]interface{}
+	err := decoder.Decode(&searchAttributes)
+	return searchAttributes, err
+}
@@ -51,6 +51,10 @@ type (
 		SelectForSchemaQuery(dbShardID int, dest interface{}, query string, args ...interface{}) error
 		// GetForSchemaQuery executes a get query for schema(returning single row).
 		GetForSchemaQuery(dbShardID int, dest interface{}, query string, args ...interface{}) error
+		// SelectPageFromAllShards reads the next page of an ordered listing from all shards into dest(a pointer to a slice).
+		// pageToken is the position of every shard returned by the previous page, nil for the first page.
+		// The returned page token is nil when all shards are exhausted. See ShardedPage.
+		SelectPageFromAllShards(ctx context.Context, dest interface{}, page *ShardedPage, pageToken []byte) ([]byte, error)
 	}
 
 	// the methods can be executed from either a started or transaction(then need to call Commit/Rollback), or without a transaction
@@ -24,6 +24,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"reflect"
+	"sync"
 
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/multierr"
@@ -39,8 +41,13 @@ type (
 		useTx         bool       // if tx is not nil, the methods from commonOfDbAndTx should use tx
 		currTxShardID int        // which shard is current tx started from
 	}
+
+	// allShardsResult is the sql.Result of a statement executed on all shards
+	allShardsResult []sql.Result
 )
 
+var _ sql.Result = allShardsResult(nil)
+
 // newShardedSQLDriver returns a driver querying a group of SQL databases as sharded solution.
 // xdbs is the list of connections to the sql instances. The length of the list of the list is the totalNumShards
 // dbShardID is needed when tx is not nil. It means a started transaction in the shard.
@@ -59,9 +66,17 @@ func newShardedSQLDriver(xdbs []*sqlx.DB, xtx *sqlx.Tx, dbShardID int) Driver {
 // below are shared by transactional and non-transactional, if s.tx is not nil then use s.tx, otherwise use s.db
 
 func (s *sharded) ExecContext(ctx context.Context, dbShardID int, query string, args ...interface{}) (sql.Result, error) {
-	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
+	if dbShardID == sqlplugin.DbShardUndefined {
 		return nil, fmt.Errorf("invalid dbShardID %v shouldn't be used to ExecContext, there must be a bug", dbShardID)
 	}
+	if dbShardID == sqlplugin.DbAllShards {
+		if s.useTx {
+			return nil, getCrossShardTxnError(s.currTxShardID)
+		}
+		return s.execAllShards(func(db *sqlx.DB) (sql.Result, error) {
+			return db.ExecContext(ctx, query, args...)
+		})
+	}
 	if s.useTx {
 		if s.currTxShardID != dbShardID {
 			return nil, getUnmatchedTxnError(dbShardID, s.currTxShardID)
@@ -72,9 +87,17 @@ func (s *sharded) ExecContext(ctx context.Context, dbShardID int, query string,
 }
 
 func (s *sharded) NamedExecContext(ctx context.Context, dbShardID int, query string, arg interface{}) (sql.Result, error) {
-	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
+	if dbShardID == sqlplugin.DbShardUndefined {
 		return nil, fmt.Errorf("invalid dbShardID %v shouldn't be used to NamedExecContext, there must be a bug", dbShardID)
 	}
+	if dbShardID == sqlplugin.DbAllShards {
+		if s.useTx {
+			return nil, getCrossShardTxnError(s.currTxShardID)
+		}
+		return s.execAllShards(func(db *sqlx.DB) (sql.Result, error) {
+			return db.NamedExecContext(ctx, query, arg)
+		})
+	}
 	if s.useTx {
 		if s.currTxShardID != dbShardID {
 			return nil, getUnmatchedTxnError(dbShardID, s.currTxShardID)
@@ -85,9 +108,15 @@ func (s *sharded) NamedExecContext(ctx context.Context, dbShardID int, query str
 }
 
 func (s *sharded) GetContext(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error {
-	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
+	if dbShardID == sqlplugin.DbShardUndefined {
 		return fmt.Errorf("invalid dbShardID %v shouldn't be used to GetContext, there must be a bug", dbShardID)
 	}
+	if dbShardID == sqlplugin.DbAllShards {
+		if s.useTx {
+			return getCrossShardTxnError(s.currTxShardID)
+		}
+		return s.getAllShards(ctx, dest, query, args...)
+	}
 	if s.useTx {
 		if s.currTxShardID != dbShardID {
 			return getUnmatchedTxnError(dbShardID, s.currTxShardID)
@@ -98,9 +127,15 @@ func (s *sharded) GetContext(ctx context.Context, dbShardID int, dest interface{
 }
 
 func (s *sharded) SelectContext(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error {
-	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
+	if dbShardID == sqlplugin.DbShardUndefined {
 		return fmt.Errorf("invalid dbShardID %v shouldn't be used to SelectContext, there must be a bug", dbShardID)
 	}
+	if dbShardID == sqlplugin.DbAllShards {
+		if s.useTx {
+			return getCrossShardTxnError(s.currTxShardID)
+		}
+		return s.selectAllShards(ctx, dest, query, args...)
+	}
 	if s.useTx {
 		if s.currTxShardID != dbShardID {
 			return getUnmatchedTxnError(dbShardID, s.currTxShardID)
@@ -131,6 +166,15 @@ func (s *sharded) GetForSchemaQuery(dbShardID int, dest interface{}, query strin
 	return fmt.Errorf("sharded SQL driver shouldn't be used to GetForSchemaQuery, there must be a bug")
 }
 
+func (s *sharded) SelectPageFromAllShards(ctx context.Context, dest interface{}, page *ShardedPage, pageToken []byte) ([]byte, error) {
+	if s.useTx {
+		return nil, getCrossShardTxnError(s.currTxShardID)
+	}
+	return selectPageFromShards(ctx, len(s.dbs), func(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error {
+		return s.dbs[dbShardID].SelectContext(ctx, dest, query, args...)
+	}, dest, page, pageToken)
+}
+
 func (s *sharded) BeginTxx(ctx context.Context, dbShardID int, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	if dbShardID == sqlplugin.DbShardUndefined || dbShardID == sqlplugin.DbAllShards {
 		return nil, fmt.Errorf("invalid dbShardID %v shouldn't be used to BeginTxx, there must be a bug", dbShardID)
@@ -162,6 +206,135 @@ func (s *sharded) Rollback() error {
 	return s.tx.Rollback()
 }
 
+// below are the fan-out helpers for queries using DbAllShards
+
+// selectAllShards runs the query against every shard concurrently and appends the rows of all shards to dest,
+// which must be a pointer to a slice. Rows are appended in the order of the shards without any merging,
+// listings which need an ordering or pagination use SelectPageFromAllShards.
+func (s *sharded) selectAllShards(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || destValue.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("dest must be a non-nil pointer to a slice to SelectContext from all shards, but got %T", dest)
+	}
+	sliceType := destValue.Elem().Type()
+
+	results := make([]reflect.Value, len(s.dbs))
+	errs := make([]error, len(s.dbs))
+	var wg sync.WaitGroup
+	for idx := range s.dbs {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			shardDest := reflect.New(sliceType)
+			if err := s.dbs[idx].SelectContext(ctx, shardDest.Interface(), query, args...); err != nil {
+				errs[idx] = fmt.Errorf("failed to select from dbShardID %v: %v", idx, err)
+				return
+			}
+			results[idx] = shardDest.Elem()
+		}(idx)
+	}
+	wg.Wait()
+	if err := multierr.Combine(errs...); err != nil {
+		return err
+	}
+
+	merged := destValue.Elem()
+	for _, rows := range results {
+		merged = reflect.AppendSlice(merged, rows)
+	}
+	destValue.Elem().Set(merged)
+	return nil
+}
+
+// getAllShards runs the query against every shard concurrently and scans the row of the first shard(in the order of
+// the shards) that returns one into dest. It returns sql.ErrNoRows if no shard has the row. This is for looking up
+// a row without knowing its shard.
+func (s *sharded) getAllShards(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() {
+		return fmt.Errorf("dest must be a non-nil pointer to GetContext from all shards, but got %T", dest)
+	}
+	destType := destValue.Elem().Type()
+
+	results := make([]reflect.Value, len(s.dbs))
+	errs := make([]error, len(s.dbs))
+	var wg sync.WaitGroup
+	for idx := range s.dbs {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			shardDest := reflect.New(destType)
+			err := s.dbs[idx].GetContext(ctx, shardDest.Interface(), query, args...)
+			switch err {
+			case nil:
+				results[idx] = shardDest.Elem()
+			case sql.ErrNoRows:
+			default:
+				errs[idx] = fmt.Errorf("failed to get from dbShardID %v: %v", idx, err)
+			}
+		}(idx)
+	}
+	wg.Wait()
+	if err := multierr.Combine(errs...); err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		if result.IsValid() {
+			destValue.Elem().Set(result)
+			return nil
+		}
+	}
+	return sql.ErrNoRows
+}
+
+// execAllShards runs the statement against every shard concurrently. The returned result reports
+// the total number of rows affected across the shards
+func (s *sharded) execAllShards(exec func(db *sqlx.DB) (sql.Result, error)) (sql.Result, error) {
+	results := make([]sql.Result, len(s.dbs))
+	errs := make([]error, len(s.dbs))
+	var wg sync.WaitGroup
+	for idx := range s.dbs {
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			result, err := exec(s.dbs[idx])
+			if err != nil {
+				errs[idx] = fmt.Errorf("failed to exec on dbShardID %v: %v", idx, err)
+				return
+			}
+			results[idx] = result
+		}(idx)
+	}
+	wg.Wait()
+	if err := multierr.Combine(errs...); err != nil {
+		return nil, err
+	}
+	return allShardsResult(results), nil
+}
+
+// LastInsertId is not meaningful for a statement executed on multiple shards
+func (r allShardsResult) LastInsertId() (int64, error) {
+	return 0, fmt.Errorf("LastInsertId is not supported for a statement executed on all shards")
+}
+
+// RowsAffected returns the sum of rows affected in every shard
+func (r allShardsResult) RowsAffected() (int64, error) {
+	var total int64
+	for _, result := range r {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		total += rows
+	}
+	return total, nil
+}
+
+func getCrossShardTxnError(startedShardID int) error {
+	return fmt.Errorf("dbShardID %v(all shards) can't be used in a transaction started in shardID %v, must be a bug", sqlplugin.DbAllShards, startedShardID)
+}
+
 func getUnmatchedTxnError(requestShardID, startedShardId int) error {
 	return fmt.Errorf("requested dbShardID %v doesn't match with started transaction shardID %v, must be a bug", requestShardID, startedShardId)
 }
@@ -0,0 +1,220 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqldriver
+
+import (
+	"bytes"
+	"context"
+	"encoding/gob"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.uber.org/multierr"
+)
+
+type (
+	// ShardedPage describes an ordered listing read from all db shards. Every shard runs Query continuing after
+	// the last row it has returned, and the rows of all shards are merged in the order of Less. The position of
+	// every shard is kept in the page token, so a shard resumes exactly where it stopped even if Less doesn't
+	// fully agree with the ordering of the database (e.g. collations), and an exhausted shard isn't queried again.
+	ShardedPage struct {
+		// Query is run in every shard that isn't exhausted yet. It must return the rows after the cursor,
+		// ordered like Less and limited to PageSize rows
+		Query string
+		// Args returns the query args of a shard. cursor is the last row returned from the shard (see Cursor),
+		// or nil if the shard hasn't returned any row yet
+		Args func(dbShardID int, cursor interface{}) []interface{}
+		// Less reports whether row a is ordered before row b. Rows with the same key from more than one shard,
+		// e.g. a row which is being copied to another shard, are returned once
+		Less func(a, b interface{}) bool
+		// Cursor returns the part of a row kept in the page token as the position of its shard. It has to keep
+		// the columns used by Args and return a value of the row type. The whole row is kept if it's nil
+		Cursor func(row interface{}) interface{}
+		// PageSize is the max number of rows of a page
+		PageSize int
+	}
+
+	// shardedPageToken is the header of the page token of a ShardedPage, it's followed by the cursors of the shards
+	shardedPageToken struct {
+		// Started tells the shard has returned rows, so that its cursor is set
+		Started []bool
+		// Done tells the shard has no more rows
+		Done []bool
+	}
+
+	// selectShardFn runs a select query in a single shard
+	selectShardFn func(ctx context.Context, dbShardID int, dest interface{}, query string, args ...interface{}) error
+)
+
+// selectPageFromShards reads the next page of an ordered listing from numShards shards into dest, which must be a
+// pointer to a slice. pageToken is the position of every shard returned by the previous page, nil for the first page.
+// The returned page token is nil when all the shards are exhausted.
+func selectPageFromShards(
+	ctx context.Context,
+	numShards int,
+	selectShard selectShardFn,
+	dest interface{},
+	page *ShardedPage,
+	pageToken []byte,
+) ([]byte, error) {
+	destValue := reflect.ValueOf(dest)
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() || destValue.Elem().Kind() != reflect.Slice {
+		return nil, fmt.Errorf("dest must be a non-nil pointer to a slice to select a page from all shards, but got %T", dest)
+	}
+	if page.PageSize <= 0 {
+		return nil, fmt.Errorf("invalid page size %v to select a page from all shards", page.PageSize)
+	}
+	sliceType := destValue.Elem().Type()
+
+	token, cursors, err := deserializeShardedPageToken(pageToken, numShards, sliceType)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]reflect.Value, numShards)
+	errs := make([]error, numShards)
+	var wg sync.WaitGroup
+	for idx := 0; idx < numShards; idx++ {
+		if token.Done[idx] {
+			continue
+		}
+		var cursor interface{}
+		if token.Started[idx] {
+			cursor = cursors.Index(idx).Interface()
+		}
+		args := page.Args(idx, cursor)
+		wg.Add(1)
+		go func(idx int) {
+			defer wg.Done()
+			shardDest := reflect.New(sliceType)
+			if err := selectShard(ctx, idx, shardDest.Interface(), page.Query, args...); err != nil {
+				errs[idx] = fmt.Errorf("failed to select from dbShardID %v: %v", idx, err)
+				return
+			}
+			results[idx] = shardDest.Elem()
+		}(idx)
+	}
+	wg.Wait()
+	if err := multierr.Combine(errs...); err != nil {
+		return nil, err
+	}
+
+	merged := reflect.MakeSlice(sliceType, 0, page.PageSize)
+	heads := make([]int, numShards)
+	for merged.Len() < page.PageSize {
+		next := -1
+		for idx := 0; idx < numShards; idx++ {
+			if token.Done[idx] {
+				continue
+			}
+			if heads[idx] == results[idx].Len() {
+				if results[idx].Len() < page.PageSize {
+					// the shard has returned all its rows
+					continue
+				}
+				// the shard may have more rows ordered before the heads of the other shards
+				next = -1
+				break
+			}
+			if next == -1 || page.Less(results[idx].Index(heads[idx]).Interface(), results[next].Index(heads[next]).Interface()) {
+				next = idx
+			}
+		}
+		if next == -1 {
+			break
+		}
+		row := results[next].Index(heads[next])
+		merged = reflect.Append(merged, row)
+		for idx := 0; idx < numShards; idx++ {
+			if token.Done[idx] || heads[idx] == results[idx].Len() {
+				continue
+			}
+			if idx == next || !page.Less(row.Interface(), results[idx].Index(heads[idx]).Interface()) {
+				heads[idx]++
+			}
+		}
+	}
+
+	hasMore := false
+	for idx := 0; idx < numShards; idx++ {
+		if token.Done[idx] {
+			continue
+		}
+		if heads[idx] > 0 {
+			row := results[idx].Index(heads[idx] - 1)
+			if page.Cursor != nil {
+				row = reflect.ValueOf(page.Cursor(row.Interface()))
+				if row.Type() != sliceType.Elem() {
+					return nil, fmt.Errorf("cursor of type %v doesn't match the row type %v", row.Type(), sliceType.Elem())
+				}
+			}
+			cursors.Index(idx).Set(row)
+			token.Started[idx] = true
+		}
+		if heads[idx] == results[idx].Len() && results[idx].Len() < page.PageSize {
+			token.Done[idx] = true
+			continue
+		}
+		hasMore = true
+	}
+	destValue.Elem().Set(merged)
+
+	if !hasMore {
+		return nil, nil
+	}
+	return serializeShardedPageToken(token, cursors)
+}
+
+func serializeShardedPageToken(token *shardedPageToken, cursors reflect.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := gob.NewEncoder(&buf)
+	if err := encoder.Encode(token); err != nil {
+		return nil, fmt.Errorf("failed to serialize page token of all shards: %v", err)
+	}
+	if err := encoder.EncodeValue(cursors); err != nil {
+		return nil, fmt.Errorf("failed to serialize page token of all shards: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func deserializeShardedPageToken(data []byte, numShards int, sliceType reflect.Type) (*shardedPageToken, reflect.Value, error) {
+	if len(data) == 0 {
+		return &shardedPageToken{
+			Started: make([]bool, numShards),
+			Done:    make([]bool, numShards),
+		}, reflect.MakeSlice(sliceType, numShards, numShards), nil
+	}
+
+	decoder := gob.NewDecoder(bytes.NewReader(data))
+	token := &shardedPageToken{}
+	if err := decoder.Decode(token); err != nil {
+		return nil, reflect.Value{}, fmt.Errorf("failed to deserialize page token of all shards: %v", err)
+	}
+	cursors := reflect.New(sliceType)
+	if err := decoder.DecodeValue(cursors); err != nil {
+		return nil, reflect.Value{}, fmt.Errorf("failed to deserialize page token of all shards: %v", err)
+	}
+	if len(token.Started) != numShards || len(token.Done) != numShards || cursors.Elem().Len() != numShards {
+		return nil, reflect.Value{}, fmt.Errorf("page token of %v shards doesn't match the number of shards %v", len(token.Done), numShards)
+	}
+	return token, cursors.Elem(), nil
+}
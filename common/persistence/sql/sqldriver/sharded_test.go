@@ -0,0 +1,298 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqldriver
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // needed to register the sqlite3 driver used as test shards
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+type (
+	shardedSuite struct {
+		suite.Suite
+		dbs []*sqlx.DB
+	}
+
+	testRow struct {
+		ID    int64
+		Value string
+	}
+)
+
+func TestShardedSuite(t *testing.T) {
+	suite.Run(t, new(shardedSuite))
+}
+
+func (s *shardedSuite) SetupTest() {
+	s.dbs = nil
+	for shard := 0; shard < 3; shard++ {
+		db, err := sqlx.Connect("sqlite3", ":memory:")
+		s.Require().NoError(err)
+		// every connection to :memory: is a separate database
+		db.SetMaxOpenConns(1)
+		_, err = db.Exec(`CREATE TABLE test_rows (id INTEGER PRIMARY KEY, value TEXT NOT NULL)`)
+		s.Require().NoError(err)
+		for i := 0; i < shard+1; i++ {
+			_, err = db.Exec(`INSERT INTO test_rows (id, value) VALUES (?, ?)`, shard*10+i, "v")
+			s.Require().NoError(err)
+		}
+		s.dbs = append(s.dbs, db)
+	}
+}
+
+func (s *shardedSuite) TearDownTest() {
+	for _, db := range s.dbs {
+		s.NoError(db.Close())
+	}
+}
+
+func (s *shardedSuite) TestSelectContext_AllShards() {
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	var rows []testRow
+	err := driver.SelectContext(context.Background(), sqlplugin.DbAllShards, &rows, `SELECT id, value FROM test_rows ORDER BY id`)
+	s.NoError(err)
+	s.Equal([]testRow{
+		{ID: 0, Value: "v"},
+		{ID: 10, Value: "v"}, {ID: 11, Value: "v"},
+		{ID: 20, Value: "v"}, {ID: 21, Value: "v"}, {ID: 22, Value: "v"},
+	}, rows)
+
+	var ids []int64
+	err = driver.SelectContext(context.Background(), sqlplugin.DbAllShards, &ids, `SELECT id FROM test_rows WHERE id > ? ORDER BY id LIMIT ?`, 10, 1)
+	s.NoError(err)
+	s.Equal([]int64{11, 20}, ids)
+}
+
+func (s *shardedSuite) TestSelectContext_AllShards_Error() {
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	var rows []testRow
+	s.Error(driver.SelectContext(context.Background(), sqlplugin.DbAllShards, rows, `SELECT id, value FROM test_rows`))
+	s.Error(driver.SelectContext(context.Background(), sqlplugin.DbAllShards, &rows, `SELECT id, value FROM missing_table`))
+	s.Error(driver.GetContext(context.Background(), sqlplugin.DbAllShards, &testRow{}, `SELECT id, value FROM missing_table`))
+	s.Error(driver.SelectContext(context.Background(), sqlplugin.DbShardUndefined, &rows, `SELECT id, value FROM test_rows`))
+}
+
+func (s *shardedSuite) TestExecContext_AllShards() {
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	result, err := driver.ExecContext(context.Background(), sqlplugin.DbAllShards, `DELETE FROM test_rows WHERE id % 10 = 0`)
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(int64(3), rowsAffected)
+	_, err = result.LastInsertId()
+	s.Error(err)
+
+	var count int
+	s.NoError(driver.GetContext(context.Background(), 2, &count, `SELECT count(1) FROM test_rows`))
+	s.Equal(2, count)
+}
+
+func (s *shardedSuite) TestAllShards_InTransaction() {
+	tx, err := s.dbs[1].BeginTxx(context.Background(), nil)
+	s.Require().NoError(err)
+	defer tx.Rollback()
+	driver := newShardedSQLDriver(s.dbs, tx, 1)
+
+	var rows []testRow
+	s.Error(driver.SelectContext(context.Background(), sqlplugin.DbAllShards, &rows, `SELECT id, value FROM test_rows`))
+	_, err = driver.ExecContext(context.Background(), sqlplugin.DbAllShards, `DELETE FROM test_rows`)
+	s.Error(err)
+	_, err = driver.NamedExecContext(context.Background(), sqlplugin.DbAllShards, `DELETE FROM test_rows WHERE id = :id`, &testRow{ID: 1})
+	s.Error(err)
+	s.Error(driver.GetContext(context.Background(), sqlplugin.DbAllShards, &testRow{}, `SELECT id, value FROM test_rows`))
+	_, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM test_rows`, 1), nil)
+	s.Error(err)
+}
+
+func (s *shardedSuite) TestGetContext_AllShards() {
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	var row testRow
+	s.NoError(driver.GetContext(context.Background(), sqlplugin.DbAllShards, &row, `SELECT id, value FROM test_rows WHERE id = ?`, 21))
+	s.Equal(testRow{ID: 21, Value: "v"}, row)
+
+	var id int64
+	s.NoError(driver.GetContext(context.Background(), sqlplugin.DbAllShards, &id, `SELECT id FROM test_rows WHERE id < ? ORDER BY id DESC LIMIT 1`, 20))
+	s.Equal(int64(0), id, "should return the row of the first shard")
+
+	s.Equal(sql.ErrNoRows, driver.GetContext(context.Background(), sqlplugin.DbAllShards, &row, `SELECT id, value FROM test_rows WHERE id = ?`, 3))
+}
+
+func (s *shardedSuite) TestNamedExecContext_AllShards() {
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	result, err := driver.NamedExecContext(context.Background(), sqlplugin.DbAllShards, `UPDATE test_rows SET value = :value WHERE id % 10 = :id`, &testRow{ID: 1, Value: "updated"})
+	s.NoError(err)
+	rowsAffected, err := result.RowsAffected()
+	s.NoError(err)
+	s.Equal(int64(2), rowsAffected)
+
+	var rows []testRow
+	s.NoError(driver.SelectContext(context.Background(), sqlplugin.DbAllShards, &rows, `SELECT id, value FROM test_rows WHERE value = ?`, "updated"))
+	s.Equal([]testRow{{ID: 11, Value: "updated"}, {ID: 21, Value: "updated"}}, rows)
+}
+
+func (s *shardedSuite) TestSelectPageFromAllShards() {
+	s.insertPageRows(map[int][]int64{
+		0: {0, 3, 6, 9, 12},
+		1: {1, 4},
+		2: {2, 5, 6, 8}, // 6 is also in shard 0, like a row being copied to another shard
+	})
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	var pages [][]int64
+	var pageToken []byte
+	for {
+		var rows []testRow
+		var err error
+		pageToken, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 3), pageToken)
+		s.Require().NoError(err)
+		pages = append(pages, testRowIDs(rows))
+		if pageToken == nil {
+			break
+		}
+		s.Require().True(len(pages) < 10, "pagination doesn't stop")
+	}
+	s.Equal([][]int64{{0, 1, 2}, {3, 4, 5}, {6, 8, 9}, {12}}, pages)
+}
+
+func (s *shardedSuite) TestSelectPageFromAllShards_ExhaustedShardNotQueried() {
+	s.insertPageRows(map[int][]int64{
+		0: {0},
+		1: {1, 2, 3},
+	})
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	var rows []testRow
+	pageToken, err := driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), nil)
+	s.NoError(err)
+	s.Equal([]int64{0, 1}, testRowIDs(rows))
+	s.NotNil(pageToken)
+
+	// shard 0 and 2 are exhausted, so the query of the next page doesn't have to work in them
+	for _, shard := range []int{0, 2} {
+		_, err := s.dbs[shard].Exec(`DROP TABLE page_rows`)
+		s.Require().NoError(err)
+	}
+	pageToken, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), pageToken)
+	s.NoError(err)
+	s.Equal([]int64{2, 3}, testRowIDs(rows))
+	s.NotNil(pageToken)
+
+	pageToken, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), pageToken)
+	s.NoError(err)
+	s.Empty(rows)
+	s.Nil(pageToken)
+}
+
+func (s *shardedSuite) TestSelectPageFromAllShards_Singleton() {
+	s.insertPageRows(map[int][]int64{
+		0: {0, 1, 2},
+	})
+	driver := newSingletonSQLDriver(s.dbs[0], nil, 0)
+
+	var rows []testRow
+	pageToken, err := driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), nil)
+	s.NoError(err)
+	s.Equal([]int64{0, 1}, testRowIDs(rows))
+	s.NotNil(pageToken)
+
+	pageToken, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), pageToken)
+	s.NoError(err)
+	s.Equal([]int64{2}, testRowIDs(rows))
+	s.Nil(pageToken)
+
+	// the page token of a sharded driver can't be used with a different number of shards
+	shardedToken, err := newShardedSQLDriver(s.dbs, nil, 0).SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM test_rows WHERE id > ? ORDER BY id LIMIT ?`, 1), nil)
+	s.NoError(err)
+	_, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM page_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), shardedToken)
+	s.Error(err)
+}
+
+func (s *shardedSuite) TestSelectPageFromAllShards_Error() {
+	driver := newShardedSQLDriver(s.dbs, nil, 0)
+
+	var rows []testRow
+	_, err := driver.SelectPageFromAllShards(context.Background(), rows, s.newPage(`SELECT id, value FROM test_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), nil)
+	s.Error(err)
+	_, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM test_rows WHERE id > ? ORDER BY id LIMIT ?`, 0), nil)
+	s.Error(err)
+	_, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM missing_table WHERE id > ? ORDER BY id LIMIT ?`, 2), nil)
+	s.Error(err)
+	_, err = driver.SelectPageFromAllShards(context.Background(), &rows, s.newPage(`SELECT id, value FROM test_rows WHERE id > ? ORDER BY id LIMIT ?`, 2), []byte("invalid"))
+	s.Error(err)
+
+	page := s.newPage(`SELECT id, value FROM test_rows WHERE id > ? ORDER BY id LIMIT ?`, 2)
+	page.Cursor = func(row interface{}) interface{} {
+		return row.(testRow).ID
+	}
+	_, err = driver.SelectPageFromAllShards(context.Background(), &rows, page, nil)
+	s.Error(err)
+}
+
+func (s *shardedSuite) newPage(query string, pageSize int) *ShardedPage {
+	return &ShardedPage{
+		Query: query,
+		Args: func(_ int, cursor interface{}) []interface{} {
+			lastID := int64(-1)
+			if cursor != nil {
+				lastID = cursor.(testRow).ID
+			}
+			return []interface{}{lastID, pageSize}
+		},
+		Less: func(a, b interface{}) bool {
+			return a.(testRow).ID < b.(testRow).ID
+		},
+		Cursor: func(row interface{}) interface{} {
+			return testRow{ID: row.(testRow).ID}
+		},
+		PageSize: pageSize,
+	}
+}
+
+func (s *shardedSuite) insertPageRows(shardRows map[int][]int64) {
+	for shard, db := range s.dbs {
+		_, err := db.Exec(`CREATE TABLE page_rows (id INTEGER PRIMARY KEY, value TEXT NOT NULL)`)
+		s.Require().NoError(err)
+		for _, id := range shardRows[shard] {
+			_, err = db.Exec(`INSERT INTO page_rows (id, value) VALUES (?, ?)`, id, "v")
+			s.Require().NoError(err)
+		}
+	}
+}
+
+func testRowIDs(rows []testRow) []int64 {
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	return ids
+}
@@ -23,6 +23,7 @@ package sqldriver
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 )
@@ -94,6 +95,15 @@ func (s *singleton) GetForSchemaQuery(_ int, dest interface{}, query string, arg
 	return s.db.Get(dest, query, args...)
 }
 
+func (s *singleton) SelectPageFromAllShards(ctx context.Context, dest interface{}, page *ShardedPage, pageToken []byte) ([]byte, error) {
+	if s.useTx {
+		return nil, fmt.Errorf("SelectPageFromAllShards can't be used in a transaction, there must be a bug")
+	}
+	return selectPageFromShards(ctx, 1, func(ctx context.Context, _ int, dest interface{}, query string, args ...interface{}) error {
+		return s.db.SelectContext(ctx, dest, query, args...)
+	}, dest, page, pageToken)
+}
+
 func (s *singleton) BeginTxx(ctx context.Context, _ int, opts *sql.TxOptions) (*sqlx.Tx, error) {
 	return s.db.BeginTxx(ctx, opts)
 }
@@ -32,7 +32,10 @@ const (
 	DbDefaultShard = 0
 	// this is should never being used in sharded SQL driver. It is used in admin/schema operation in singleton driver, which ignores all the shardID parameter
 	DbShardUndefined = -1
-	// this means the query needs to execute in all dbShards in sharded SQL driver (currently not supported)
+	// this means the query needs to execute in all dbShards in sharded SQL driver.
+	// Only non-transactional queries support it: ExecContext and NamedExecContext run in every shard, GetContext returns
+	// the row of the first shard that has it, and SelectContext appends the rows of all shards together without any
+	// ordering. Ordered and paginated listings of all shards use SelectPageFromAllShards of the driver instead.
 	DbAllShards = -2
 )
 
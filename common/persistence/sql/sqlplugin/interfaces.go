@@ -536,8 +536,11 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskList         string
 		IsCron           bool
 		NumClusters      int16
+		// SearchAttributes is a JSON object of the custom search attributes
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -612,6 +615,11 @@ type (
 		//  to read a single row: {shardID, domainID, name, taskType}
 		//  to range read multiple rows: {shardID, domainIDGreaterThan, nameGreaterThan, taskTypeGreaterThan, pageSize}
 		SelectFromTaskLists(ctx context.Context, filter *TaskListsFilter) ([]TaskListsRow, error)
+		// SelectFromTaskListsAllShards returns a page of rows from task_lists table of all db shards, ordered by
+		// domainID, name and taskType. pageToken is the position of every db shard returned by the previous page,
+		// nil for the first page, and the returned page token is nil when all db shards are exhausted.
+		// Required filter params: {pageSize}
+		SelectFromTaskListsAllShards(ctx context.Context, filter *TaskListsFilter, pageToken []byte) ([]TaskListsRow, []byte, error)
 		DeleteFromTaskLists(ctx context.Context, filter *TaskListsFilter) (sql.Result, error)
 		LockTaskLists(ctx context.Context, filter *TaskListsFilter) (int64, error)
 
@@ -627,6 +635,12 @@ type (
 		InsertIntoExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(ctx context.Context, row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(ctx context.Context, filter *ExecutionsFilter) ([]ExecutionsRow, error)
+		// SelectFromExecutionsAllShards returns a page of rows of a history shard from executions table of all db shards,
+		// ordered by workflowID and runID, so that the rows are found wherever the history shard is placed.
+		// pageToken is the position of every db shard returned by the previous page, nil for the first page,
+		// and the returned page token is nil when all db shards are exhausted.
+		// Required filter params: {shardID, size}
+		SelectFromExecutionsAllShards(ctx context.Context, filter *ExecutionsFilter, pageToken []byte) ([]ExecutionsRow, []byte, error)
 		DeleteFromExecutions(ctx context.Context, filter *ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error)
 		WriteLockExecutions(ctx context.Context, filter *ExecutionsFilter) (int, error)
@@ -784,7 +798,19 @@ type (
 		//   - OPTIONALLY specify one of following params
 		//     - workflowID, workflowTypeName, closeStatus (along with closed=true)
 		SelectFromVisibility(ctx context.Context, filter *VisibilityFilter) ([]VisibilityRow, error)
+		// SelectFromVisibilityAllShards returns a page of rows from visibility table of all db shards, for the range
+		// queries of SelectFromVisibility, ordered by startTime desc and runID. maxStartTime and runID are the position
+		// of the db shards which have no position in pageToken yet. pageToken is the position of every db shard returned
+		// by the previous page, nil for the first page, and the returned page token is nil when all db shards are exhausted.
+		SelectFromVisibilityAllShards(ctx context.Context, filter *VisibilityFilter, pageToken []byte) ([]VisibilityRow, []byte, error)
 		DeleteFromVisibility(ctx context.Context, filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts the row of an open workflow into visibility table. If the row already exist,
+		// only its memo and search attributes are updated
+		UpsertIntoVisibility(ctx context.Context, row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of rows of visibility table matching an advanced visibility query
+		SelectFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibilityByQuery returns the number of rows of visibility table matching an advanced visibility query
+		CountFromVisibilityByQuery(ctx context.Context, filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
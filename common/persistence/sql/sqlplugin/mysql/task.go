@@ -24,7 +24,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
 
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
@@ -64,6 +67,12 @@ task_type = :task_type
 		`WHERE shard_id = ? AND ((domain_id = ? AND name = ? AND task_type > ?) OR (domain_id=? AND name > ?) OR (domain_id > ?)) ` +
 		`ORDER BY domain_id,name,task_type LIMIT ?`
 
+	// listTaskListAllShardsQry is run in every db shard to list the task lists of all db shards
+	listTaskListAllShardsQry = `SELECT shard_id, domain_id, range_id, name, task_type, data, data_encoding ` +
+		`FROM task_lists ` +
+		`WHERE (domain_id = ? AND name = ? AND task_type > ?) OR (domain_id=? AND name > ?) OR (domain_id > ?) ` +
+		`ORDER BY domain_id,name,task_type LIMIT ?`
+
 	getTaskListQry = `SELECT domain_id, range_id, name, task_type, data, data_encoding ` +
 		`FROM task_lists ` +
 		`WHERE shard_id = ? AND domain_id = ? AND name = ? AND task_type = ?`
@@ -97,7 +106,7 @@ task_type = :task_type
 		`WHERE NOT EXISTS ( ` +
 		`	SELECT domain_id, name, task_type FROM task_lists AS tl ` +
 		`	WHERE t.domain_id=tl.domain_id and t.task_list_name=tl.name and t.task_type=tl.task_type ` +
-		`) LIMIT ?;`
+		`) ORDER BY domain_id, task_list_name, task_type, task_id LIMIT ?;`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -143,8 +152,14 @@ func (mdb *db) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasks
 		return nil, fmt.Errorf("missing limit parameter")
 	}
 	var rows []sqlplugin.TaskKeyRow
-
-	err := mdb.driver.SelectContext(ctx, sqlplugin.DbAllShards, &rows, getOrphanTaskQry, *filter.Limit)
+	_, err := mdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: getOrphanTaskQry,
+		Args: func(int, interface{}) []interface{} {
+			return []interface{}{*filter.Limit}
+		},
+		Less:     sqlplugin.TaskKeyRowLess,
+		PageSize: *filter.Limit,
+	}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +212,31 @@ func (mdb *db) rangeSelectFromTaskLists(ctx context.Context, filter *sqlplugin.T
 	return rows, nil
 }
 
+// SelectFromTaskListsAllShards reads a page of rows from task_lists table of all db shards
+func (mdb *db) SelectFromTaskListsAllShards(ctx context.Context, filter *sqlplugin.TaskListsFilter, pageToken []byte) ([]sqlplugin.TaskListsRow, []byte, error) {
+	if filter.PageSize == nil {
+		return nil, nil, fmt.Errorf("missing page size parameter")
+	}
+	var rows []sqlplugin.TaskListsRow
+	nextPageToken, err := mdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: listTaskListAllShardsQry,
+		Args: func(_ int, lastRow interface{}) []interface{} {
+			cursor := sqlplugin.TaskListsRow{DomainID: serialization.UUID{}, TaskType: math.MinInt16}
+			if lastRow != nil {
+				cursor = lastRow.(sqlplugin.TaskListsRow)
+			}
+			return []interface{}{cursor.DomainID, cursor.Name, cursor.TaskType, cursor.DomainID, cursor.Name, cursor.DomainID, *filter.PageSize}
+		},
+		Less:     sqlplugin.TaskListsRowLess,
+		Cursor:   sqlplugin.TaskListsRowCursor,
+		PageSize: *filter.PageSize,
+	}, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, nextPageToken, nil
+}
+
 // DeleteFromTaskLists deletes a row from task_lists table
 func (mdb *db) DeleteFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) (sql.Result, error) {
 	return mdb.driver.ExecContext(ctx, filter.ShardID, deleteTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType, *filter.RangeID)
@@ -25,18 +25,28 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON DUPLICATE KEY UPDATE memo = VALUES(memo), encoding = VALUES(encoding), search_attributes = VALUES(search_attributes)`
+
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND domain_id = ?
@@ -76,6 +86,10 @@ const (
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
 
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
 func (mdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
@@ -92,8 +106,10 @@ func (mdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.WorkflowTypeName,
 		row.Memo,
 		row.Encoding,
+		row.TaskList,
 		row.IsCron,
-		row.NumClusters)
+		row.NumClusters,
+		toMySQLJSON(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -117,8 +133,10 @@ func (mdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
+			row.TaskList,
 			row.IsCron,
-			row.NumClusters)
+			row.NumClusters,
+			toMySQLJSON(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -148,71 +166,195 @@ func (mdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 		if err == nil {
 			rows = append(rows, row)
 		}
+	default:
+		var qry string
+		var args []interface{}
+		qry, args, err = mdb.rangeSelectFromVisibilityQuery(filter)
+		if err != nil {
+			return nil, err
+		}
+		err = mdb.driver.SelectContext(ctx, dbShardID, &rows, qry, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	mdb.fromDBVisibilityRows(rows)
+	return rows, err
+}
+
+// SelectFromVisibilityAllShards reads a page of rows of the range queries of SelectFromVisibility from visibility table
+// of all db shards
+func (mdb *db) SelectFromVisibilityAllShards(ctx context.Context, filter *sqlplugin.VisibilityFilter, pageToken []byte) ([]sqlplugin.VisibilityRow, []byte, error) {
+	if filter.MinStartTime == nil || filter.MaxStartTime == nil || filter.RunID == nil || filter.PageSize == nil {
+		return nil, nil, fmt.Errorf("invalid query filter")
+	}
+	qry, _, err := mdb.rangeSelectFromVisibilityQuery(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows []sqlplugin.VisibilityRow
+	nextPageToken, err := mdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: qry,
+		Args: func(_ int, lastRow interface{}) []interface{} {
+			shardFilter := *filter
+			if lastRow != nil {
+				cursor := lastRow.(sqlplugin.VisibilityRow)
+				shardFilter.MaxStartTime = &cursor.StartTime
+				shardFilter.RunID = &cursor.RunID
+			}
+			_, args, _ := mdb.rangeSelectFromVisibilityQuery(&shardFilter)
+			return args
+		},
+		Less:     sqlplugin.VisibilityRowLess,
+		Cursor:   sqlplugin.VisibilityRowCursor,
+		PageSize: *filter.PageSize,
+	}, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	mdb.fromDBVisibilityRows(rows)
+	return rows, nextPageToken, nil
+}
+
+// rangeSelectFromVisibilityQuery returns the query and args of the range queries of SelectFromVisibility, which read
+// the rows after MaxStartTime and RunID of the filter
+func (mdb *db) rangeSelectFromVisibilityQuery(filter *sqlplugin.VisibilityFilter) (string, []interface{}, error) {
+	switch {
 	case filter.MinStartTime != nil && filter.WorkflowID != nil:
 		qry := templateGetOpenWorkflowExecutionsByID
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutionsByID
 		}
-		err = mdb.driver.SelectContext(ctx,
-			dbShardID,
-			&rows,
-			qry,
+		return qry, []interface{}{
 			*filter.WorkflowID,
 			filter.DomainID,
 			mdb.converter.ToMySQLDateTime(*filter.MinStartTime),
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			*filter.MinStartTime,
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	case filter.MinStartTime != nil && filter.WorkflowTypeName != nil:
 		qry := templateGetOpenWorkflowExecutionsByType
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutionsByType
 		}
-		err = mdb.driver.SelectContext(ctx,
-			dbShardID,
-			&rows,
-			qry,
+		return qry, []interface{}{
 			*filter.WorkflowTypeName,
 			filter.DomainID,
 			mdb.converter.ToMySQLDateTime(*filter.MinStartTime),
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			*filter.MaxStartTime,
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	case filter.MinStartTime != nil && filter.CloseStatus != nil:
-		err = mdb.driver.SelectContext(ctx,
-			dbShardID,
-			&rows,
-			templateGetClosedWorkflowExecutionsByStatus,
+		return templateGetClosedWorkflowExecutionsByStatus, []interface{}{
 			*filter.CloseStatus,
 			filter.DomainID,
 			mdb.converter.ToMySQLDateTime(*filter.MinStartTime),
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	case filter.MinStartTime != nil:
 		qry := templateGetOpenWorkflowExecutions
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutions
 		}
-		err = mdb.driver.SelectContext(ctx,
-			dbShardID,
-			&rows,
-			qry,
+		return qry, []interface{}{
 			filter.DomainID,
 			mdb.converter.ToMySQLDateTime(*filter.MinStartTime),
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			mdb.converter.ToMySQLDateTime(*filter.MaxStartTime),
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	default:
-		return nil, fmt.Errorf("invalid query filter")
+		return "", nil, fmt.Errorf("invalid query filter")
 	}
-	if err != nil {
+}
+
+// UpsertIntoVisibility inserts the row of an open workflow into visibility table, or updates the memo and
+// search attributes of the existing row
+func (mdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = mdb.converter.ToMySQLDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, mdb.GetTotalNumDBShards())
+	return mdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		toMySQLJSON(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows matching an advanced visibility query from visibility table
+func (mdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilitySelectQuery(&visibilityQueryDialect{converter: mdb.converter}, templateQueryFieldNames, filter)
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
 		return nil, err
 	}
+	mdb.fromDBVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows matching an advanced visibility query in visibility table
+func (mdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, mdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilityCountQuery(&visibilityQueryDialect{converter: mdb.converter}, filter)
+	var count int64
+	err := mdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// toMySQLJSON converts the JSON to string, as MySQL rejects JSON values in binary character set
+func toMySQLJSON(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+func (d *visibilityQueryDialect) Placeholder(int) string {
+	return "?"
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(name string, valueType types.IndexedValueType) string {
+	value := fmt.Sprintf("JSON_EXTRACT(search_attributes, '$.%s')", name)
+	switch valueType {
+	case types.IndexedValueTypeInt:
+		return fmt.Sprintf("CAST(%s AS SIGNED)", value)
+	case types.IndexedValueTypeDouble:
+		return fmt.Sprintf("(%s + 0.0)", value)
+	case types.IndexedValueTypeBool:
+		return fmt.Sprintf("(%s = CAST('true' AS JSON))", value)
+	default:
+		return fmt.Sprintf("JSON_UNQUOTE(%s)", value)
+	}
+}
+
+func (d *visibilityQueryDialect) SearchAttributeForOrder(name string) string {
+	return fmt.Sprintf("JSON_EXTRACT(search_attributes, '$.%s')", name)
+}
+
+func (d *visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToMySQLDateTime(t)
+}
+
+// fromDBVisibilityRows converts the values read from visibility table
+func (mdb *db) fromDBVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
@@ -221,5 +363,4 @@ func (mdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 			rows[i].CloseTime = &closeTime
 		}
 	}
-	return rows, err
 }
@@ -24,6 +24,8 @@ import (
 	"context"
 	"database/sql"
 
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
@@ -43,6 +45,10 @@ const (
 	listExecutionQuery = `SELECT ` + executionsColumns + ` FROM executions
  WHERE shard_id = $1 AND workflow_id > $2 ORDER BY workflow_id LIMIT $3`
 
+	// listExecutionAllShardsQuery is run in every db shard to list the executions of a history shard of all db shards
+	listExecutionAllShardsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (workflow_id > $2 OR (workflow_id = $2 AND run_id > $3)) ORDER BY workflow_id, run_id LIMIT $4`
+
 	deleteExecutionQuery = `DELETE FROM executions
  WHERE shard_id = $1 AND domain_id = $2 AND workflow_id = $3 AND run_id = $4`
 
@@ -214,6 +220,28 @@ func (pdb *db) SelectFromExecutions(ctx context.Context, filter *sqlplugin.Execu
 	return rows, err
 }
 
+// SelectFromExecutionsAllShards reads a page of rows of a history shard from executions table of all db shards
+func (pdb *db) SelectFromExecutionsAllShards(ctx context.Context, filter *sqlplugin.ExecutionsFilter, pageToken []byte) ([]sqlplugin.ExecutionsRow, []byte, error) {
+	var rows []sqlplugin.ExecutionsRow
+	nextPageToken, err := pdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: listExecutionAllShardsQuery,
+		Args: func(_ int, lastRow interface{}) []interface{} {
+			cursor := sqlplugin.ExecutionsRow{RunID: serialization.UUID{}}
+			if lastRow != nil {
+				cursor = lastRow.(sqlplugin.ExecutionsRow)
+			}
+			return []interface{}{filter.ShardID, cursor.WorkflowID, cursor.RunID, filter.Size}
+		},
+		Less:     sqlplugin.ExecutionsRowLess,
+		Cursor:   sqlplugin.ExecutionsRowCursor,
+		PageSize: filter.Size,
+	}, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, nextPageToken, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (pdb *db) DeleteFromExecutions(ctx context.Context, filter *sqlplugin.ExecutionsFilter) (sql.Result, error) {
 	dbShardID := sqlplugin.GetDBShardIDFromHistoryShardID(int(filter.ShardID), pdb.GetTotalNumDBShards())
@@ -24,7 +24,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"math"
 
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
@@ -64,6 +67,12 @@ task_type = :task_type
 		`WHERE shard_id = $1 AND ((domain_id = $2 AND name = $3 AND task_type > $4) OR (domain_id=$2 AND name > $3) OR (domain_id > $2)) ` +
 		`ORDER BY domain_id,name,task_type LIMIT $5`
 
+	// listTaskListAllShardsQry is run in every db shard to list the task lists of all db shards
+	listTaskListAllShardsQry = `SELECT shard_id, domain_id, range_id, name, task_type, data, data_encoding ` +
+		`FROM task_lists ` +
+		`WHERE (domain_id = $1 AND name = $2 AND task_type > $3) OR (domain_id=$1 AND name > $2) OR (domain_id > $1) ` +
+		`ORDER BY domain_id,name,task_type LIMIT $4`
+
 	getTaskListQry = `SELECT domain_id, range_id, name, task_type, data, data_encoding ` +
 		`FROM task_lists ` +
 		`WHERE shard_id = $1 AND domain_id = $2 AND name = $3 AND task_type = $4`
@@ -98,7 +107,7 @@ task_type = :task_type
 		`WHERE NOT EXISTS ( ` +
 		`	SELECT domain_id, name, task_type FROM task_lists AS tl ` +
 		`	WHERE t.domain_id=tl.domain_id and t.task_list_name=tl.name and t.task_type=tl.task_type ` +
-		`) LIMIT $1;`
+		`) ORDER BY domain_id, task_list_name, task_type, task_id LIMIT $1;`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -144,7 +153,14 @@ func (pdb *db) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasks
 		return nil, fmt.Errorf("missing limit parameter")
 	}
 	var rows []sqlplugin.TaskKeyRow
-	err := pdb.driver.SelectContext(ctx, sqlplugin.DbAllShards, &rows, getOrphanTaskQry, *filter.Limit)
+	_, err := pdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: getOrphanTaskQry,
+		Args: func(int, interface{}) []interface{} {
+			return []interface{}{*filter.Limit}
+		},
+		Less:     sqlplugin.TaskKeyRowLess,
+		PageSize: *filter.Limit,
+	}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -197,6 +213,31 @@ func (pdb *db) rangeSelectFromTaskLists(ctx context.Context, filter *sqlplugin.T
 	return rows, nil
 }
 
+// SelectFromTaskListsAllShards reads a page of rows from task_lists table of all db shards
+func (pdb *db) SelectFromTaskListsAllShards(ctx context.Context, filter *sqlplugin.TaskListsFilter, pageToken []byte) ([]sqlplugin.TaskListsRow, []byte, error) {
+	if filter.PageSize == nil {
+		return nil, nil, fmt.Errorf("missing page size parameter")
+	}
+	var rows []sqlplugin.TaskListsRow
+	nextPageToken, err := pdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: listTaskListAllShardsQry,
+		Args: func(_ int, lastRow interface{}) []interface{} {
+			cursor := sqlplugin.TaskListsRow{DomainID: serialization.UUID{}, TaskType: math.MinInt16}
+			if lastRow != nil {
+				cursor = lastRow.(sqlplugin.TaskListsRow)
+			}
+			return []interface{}{cursor.DomainID, cursor.Name, cursor.TaskType, *filter.PageSize}
+		},
+		Less:     sqlplugin.TaskListsRowLess,
+		Cursor:   sqlplugin.TaskListsRowCursor,
+		PageSize: *filter.PageSize,
+	}, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rows, nextPageToken, nil
+}
+
 // DeleteFromTaskLists deletes a row from task_lists table
 func (pdb *db) DeleteFromTaskLists(ctx context.Context, filter *sqlplugin.TaskListsFilter) (sql.Result, error) {
 	return pdb.driver.ExecContext(ctx, filter.ShardID, deleteTaskListQry, filter.ShardID, *filter.DomainID, *filter.Name, *filter.TaskType, *filter.RangeID)
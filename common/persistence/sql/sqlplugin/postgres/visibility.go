@@ -26,19 +26,22 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
          ON CONFLICT (domain_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, is_cron, num_clusters) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 		ON CONFLICT (domain_id, run_id) DO UPDATE
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -49,8 +52,20 @@ const (
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
 			  encoding = excluded.encoding,
+			  task_list = excluded.task_list,
 				is_cron = excluded.is_cron,
-				num_clusters = excluded.num_clusters`
+				num_clusters = excluded.num_clusters,
+				search_attributes = excluded.search_attributes`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (domain_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+		      encoding = excluded.encoding,
+		      search_attributes = excluded.search_attributes`
+
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND domain_id = $1
@@ -97,6 +112,10 @@ const (
 
 var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
 
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
 // its left as such and no update will be made
 func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
@@ -111,8 +130,10 @@ func (pdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.Visibili
 		row.WorkflowTypeName,
 		row.Memo,
 		row.Encoding,
+		row.TaskList,
 		row.IsCron,
-		row.NumClusters)
+		row.NumClusters,
+		toPostgresJSON(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -134,8 +155,10 @@ func (pdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.Visibil
 			*row.HistoryLength,
 			row.Memo,
 			row.Encoding,
+			row.TaskList,
 			row.IsCron,
-			row.NumClusters)
+			row.NumClusters,
+			toPostgresJSON(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -165,44 +188,98 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 		if err == nil {
 			rows = append(rows, row)
 		}
+	default:
+		var qry string
+		var args []interface{}
+		qry, args, err = pdb.rangeSelectFromVisibilityQuery(filter)
+		if err != nil {
+			return nil, err
+		}
+		err = pdb.driver.SelectContext(ctx, dbShardID, &rows, qry, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	pdb.fromDBVisibilityRows(rows)
+	return rows, err
+}
+
+// SelectFromVisibilityAllShards reads a page of rows of the range queries of SelectFromVisibility from visibility table
+// of all db shards
+func (pdb *db) SelectFromVisibilityAllShards(ctx context.Context, filter *sqlplugin.VisibilityFilter, pageToken []byte) ([]sqlplugin.VisibilityRow, []byte, error) {
+	if filter.MinStartTime == nil || filter.MaxStartTime == nil || filter.RunID == nil || filter.PageSize == nil {
+		return nil, nil, fmt.Errorf("invalid query filter")
+	}
+	qry, _, err := pdb.rangeSelectFromVisibilityQuery(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows []sqlplugin.VisibilityRow
+	nextPageToken, err := pdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: qry,
+		Args: func(_ int, lastRow interface{}) []interface{} {
+			shardFilter := *filter
+			if lastRow != nil {
+				cursor := lastRow.(sqlplugin.VisibilityRow)
+				shardFilter.MaxStartTime = &cursor.StartTime
+				shardFilter.RunID = &cursor.RunID
+			}
+			_, args, _ := pdb.rangeSelectFromVisibilityQuery(&shardFilter)
+			return args
+		},
+		Less:     sqlplugin.VisibilityRowLess,
+		Cursor:   sqlplugin.VisibilityRowCursor,
+		PageSize: *filter.PageSize,
+	}, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	pdb.fromDBVisibilityRows(rows)
+	return rows, nextPageToken, nil
+}
+
+// rangeSelectFromVisibilityQuery returns the query and args of the range queries of SelectFromVisibility, which read
+// the rows after MaxStartTime and RunID of the filter
+func (pdb *db) rangeSelectFromVisibilityQuery(filter *sqlplugin.VisibilityFilter) (string, []interface{}, error) {
+	switch {
 	case filter.MinStartTime != nil && filter.WorkflowID != nil:
 		qry := templateGetOpenWorkflowExecutionsByID
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutionsByID
 		}
-		err = pdb.driver.SelectContext(ctx, dbShardID, &rows,
-			qry,
+		return qry, []interface{}{
 			*filter.WorkflowID,
 			filter.DomainID,
 			pdb.converter.ToPostgresDateTime(*filter.MinStartTime),
 			pdb.converter.ToPostgresDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			*filter.MinStartTime,
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	case filter.MinStartTime != nil && filter.WorkflowTypeName != nil:
 		qry := templateGetOpenWorkflowExecutionsByType
 		if filter.Closed {
 			qry = templateGetClosedWorkflowExecutionsByType
 		}
-		err = pdb.driver.SelectContext(ctx, dbShardID, &rows,
-			qry,
+		return qry, []interface{}{
 			*filter.WorkflowTypeName,
 			filter.DomainID,
 			pdb.converter.ToPostgresDateTime(*filter.MinStartTime),
 			pdb.converter.ToPostgresDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			*filter.MaxStartTime,
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	case filter.MinStartTime != nil && filter.CloseStatus != nil:
-		err = pdb.driver.SelectContext(ctx, dbShardID, &rows,
-			templateGetClosedWorkflowExecutionsByStatus,
+		return templateGetClosedWorkflowExecutionsByStatus, []interface{}{
 			*filter.CloseStatus,
 			filter.DomainID,
 			pdb.converter.ToPostgresDateTime(*filter.MinStartTime),
 			pdb.converter.ToPostgresDateTime(*filter.MaxStartTime),
 			*filter.RunID,
 			pdb.converter.ToPostgresDateTime(*filter.MaxStartTime),
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	case filter.MinStartTime != nil:
 		qry := templateGetOpenWorkflowExecutions
 		if filter.Closed {
@@ -210,20 +287,96 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 		}
 		minSt := pdb.converter.ToPostgresDateTime(*filter.MinStartTime)
 		maxSt := pdb.converter.ToPostgresDateTime(*filter.MaxStartTime)
-		err = pdb.driver.SelectContext(ctx, dbShardID, &rows,
-			qry,
+		return qry, []interface{}{
 			filter.DomainID,
 			minSt,
 			maxSt,
 			*filter.RunID,
 			maxSt,
-			*filter.PageSize)
+			*filter.PageSize,
+		}, nil
 	default:
-		return nil, fmt.Errorf("invalid query filter")
+		return "", nil, fmt.Errorf("invalid query filter")
 	}
-	if err != nil {
+}
+
+// UpsertIntoVisibility inserts the row of an open workflow into visibility table, or updates the memo and
+// search attributes of the existing row
+func (pdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, pdb.GetTotalNumDBShards())
+	row.StartTime = pdb.converter.ToPostgresDateTime(row.StartTime)
+	return pdb.driver.ExecContext(ctx, dbShardID, templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		toPostgresJSON(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows matching an advanced visibility query from visibility table
+func (pdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilitySelectQuery(&visibilityQueryDialect{converter: pdb.converter}, templateQueryFieldNames, filter)
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
 		return nil, err
 	}
+	pdb.fromDBVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows matching an advanced visibility query in visibility table
+func (pdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, pdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilityCountQuery(&visibilityQueryDialect{converter: pdb.converter}, filter)
+	var count int64
+	err := pdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// toPostgresJSON converts the JSON to string, otherwise it's sent as bytea which can't be cast to jsonb
+func toPostgresJSON(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+func (d *visibilityQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (d *visibilityQueryDialect) SearchAttribute(name string, valueType types.IndexedValueType) string {
+	value := fmt.Sprintf("(search_attributes->>'%s')", name)
+	switch valueType {
+	case types.IndexedValueTypeInt:
+		return value + "::bigint"
+	case types.IndexedValueTypeDouble:
+		return value + "::double precision"
+	case types.IndexedValueTypeBool:
+		return value + "::boolean"
+	default:
+		return value
+	}
+}
+
+func (d *visibilityQueryDialect) SearchAttributeForOrder(name string) string {
+	return fmt.Sprintf("(search_attributes->'%s')", name)
+}
+
+func (d *visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToPostgresDateTime(t)
+}
+
+// fromDBVisibilityRows converts the values read from visibility table
+func (pdb *db) fromDBVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
@@ -234,5 +387,4 @@ func (pdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.Visib
 		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
 		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
 	}
-	return rows, err
 }
@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"bytes"
+)
+
+// The functions below are the orderings and page cursors of the listings read from all db shards, which are shared by
+// the plugins. The orderings have to match the ORDER BY clause of the queries.
+
+// TaskListsRowLess orders the rows of task_lists table by domainID, name and taskType
+func TaskListsRowLess(a, b interface{}) bool {
+	rowA, rowB := a.(TaskListsRow), b.(TaskListsRow)
+	if c := bytes.Compare(rowA.DomainID, rowB.DomainID); c != 0 {
+		return c < 0
+	}
+	if rowA.Name != rowB.Name {
+		return rowA.Name < rowB.Name
+	}
+	return rowA.TaskType < rowB.TaskType
+}
+
+// TaskListsRowCursor returns the key of a row of task_lists table as the position of its db shard
+func TaskListsRowCursor(row interface{}) interface{} {
+	taskList := row.(TaskListsRow)
+	return TaskListsRow{
+		DomainID: taskList.DomainID,
+		Name:     taskList.Name,
+		TaskType: taskList.TaskType,
+	}
+}
+
+// TaskKeyRowLess orders the keys of the rows of tasks table by domainID, taskListName, taskType and taskID
+func TaskKeyRowLess(a, b interface{}) bool {
+	rowA, rowB := a.(TaskKeyRow), b.(TaskKeyRow)
+	if c := bytes.Compare(rowA.DomainID, rowB.DomainID); c != 0 {
+		return c < 0
+	}
+	if rowA.TaskListName != rowB.TaskListName {
+		return rowA.TaskListName < rowB.TaskListName
+	}
+	if rowA.TaskType != rowB.TaskType {
+		return rowA.TaskType < rowB.TaskType
+	}
+	return rowA.TaskID < rowB.TaskID
+}
+
+// ExecutionsRowLess orders the rows of executions table of a history shard by workflowID and runID
+func ExecutionsRowLess(a, b interface{}) bool {
+	rowA, rowB := a.(ExecutionsRow), b.(ExecutionsRow)
+	if rowA.WorkflowID != rowB.WorkflowID {
+		return rowA.WorkflowID < rowB.WorkflowID
+	}
+	return bytes.Compare(rowA.RunID, rowB.RunID) < 0
+}
+
+// ExecutionsRowCursor returns the key of a row of executions table as the position of its db shard
+func ExecutionsRowCursor(row interface{}) interface{} {
+	execution := row.(ExecutionsRow)
+	return ExecutionsRow{
+		WorkflowID: execution.WorkflowID,
+		RunID:      execution.RunID,
+	}
+}
+
+// VisibilityRowLess orders the rows of visibility table by startTime desc and runID
+func VisibilityRowLess(a, b interface{}) bool {
+	rowA, rowB := a.(VisibilityRow), b.(VisibilityRow)
+	if !rowA.StartTime.Equal(rowB.StartTime) {
+		return rowA.StartTime.After(rowB.StartTime)
+	}
+	return rowA.RunID < rowB.RunID
+}
+
+// VisibilityRowCursor returns the key of a row of visibility table as the position of its db shard
+func VisibilityRowCursor(row interface{}) interface{} {
+	visibility := row.(VisibilityRow)
+	return VisibilityRow{
+		StartTime: visibility.StartTime,
+		RunID:     visibility.RunID,
+	}
+}
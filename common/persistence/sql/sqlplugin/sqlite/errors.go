@@ -0,0 +1,48 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:build cgo
+// +build cgo
+
+package sqlite
+
+import (
+	"github.com/mattn/go-sqlite3"
+)
+
+// isConstraintViolation returns true if a unique or primary key constraint is violated,
+// which indicates the row already exists, so we don't do the insert and return a ConditionalUpdate error.
+func isConstraintViolation(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && sqlErr.Code == sqlite3.ErrConstraint &&
+		(sqlErr.ExtendedCode == sqlite3.ErrConstraintUnique || sqlErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey)
+}
+
+// isInterrupted returns true if the query is interrupted when its context is done
+func isInterrupted(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && sqlErr.Code == sqlite3.ErrInterrupt
+}
+
+// isLocked returns true if the database stayed locked by another writer for longer than the configured busy timeout
+func isLocked(err error) bool {
+	sqlErr, ok := err.(sqlite3.Error)
+	return ok && (sqlErr.Code == sqlite3.ErrBusy || sqlErr.Code == sqlite3.ErrLocked)
+}
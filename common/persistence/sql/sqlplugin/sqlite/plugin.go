@@ -0,0 +1,169 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/uber/cadence/common/config"
+	pt "github.com/uber/cadence/common/persistence/persistence-tests"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	// driverName is the name go-sqlite3 registers itself with in database/sql
+	driverName = "sqlite3"
+	// inMemoryDatabase is used when no database file is configured, e.g. by the admin connection of the schema tool
+	inMemoryDatabase = ":memory:"
+	dsnFmt           = "file:%s"
+)
+
+// dsnAttrDefaults are applied unless the same attribute is provided in ConnectAttributes.
+// sqlite doesn't support row level locks (SELECT ... FOR UPDATE), so every transaction
+// takes the database write lock upfront (_txlock=immediate) to get the same serialization
+// guarantees the other plugins get from their locking reads.
+var dsnAttrDefaults = map[string]string{
+	"_busy_timeout": "10000",
+	"_journal_mode": "WAL",
+	"_txlock":       "immediate",
+}
+
+type plugin struct{}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	conns, err := sqldriver.CreateDBConnections(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
+		return p.createSingleDBConn(cfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+}
+
+// CreateAdminDB initialize the adminDb object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	conns, err := sqldriver.CreateDBConnections(cfg, func(cfg *config.SQL) (*sqlx.DB, error) {
+		return p.createSingleDBConn(cfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newDB(conns, nil, sqlplugin.DbShardUndefined, cfg.NumShards)
+}
+
+func (p *plugin) createSingleDBConn(cfg *config.SQL) (*sqlx.DB, error) {
+	db, err := sqlx.Connect(driverName, buildDSN(cfg))
+	if err != nil {
+		return nil, err
+	}
+	if isInMemory(cfg.DatabaseName) {
+		// every connection to an in-memory database gets its own private database,
+		// so the pool must never hand out a second one
+		db.SetMaxOpenConns(1)
+		db.SetMaxIdleConns(1)
+		db.SetConnMaxLifetime(0)
+	} else {
+		if cfg.MaxConns > 0 {
+			db.SetMaxOpenConns(cfg.MaxConns)
+		}
+		if cfg.MaxIdleConns > 0 {
+			db.SetMaxIdleConns(cfg.MaxIdleConns)
+		}
+		if cfg.MaxConnLifetime > 0 {
+			db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+		}
+	}
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+func buildDSN(cfg *config.SQL) string {
+	name := cfg.DatabaseName
+	if isInMemory(name) {
+		name = inMemoryDatabase
+	}
+	dsn := fmt.Sprintf(dsnFmt, name)
+	if attrs := buildDSNAttrs(cfg); attrs != "" {
+		dsn = dsn + "?" + attrs
+	}
+	return dsn
+}
+
+func buildDSNAttrs(cfg *config.SQL) string {
+	attrs := url.Values{}
+	for k, v := range dsnAttrDefaults {
+		attrs.Set(k, v)
+	}
+	for k, v := range cfg.ConnectAttributes {
+		attrs.Set(strings.TrimSpace(k), strings.TrimSpace(v))
+	}
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+"="+url.QueryEscape(attrs.Get(k)))
+	}
+	return strings.Join(pairs, "&")
+}
+
+func isInMemory(name string) bool {
+	return name == "" || name == inMemoryDatabase
+}
+
+const (
+	testSchemaDir = "schema/sqlite"
+)
+
+// GetTestClusterOption return test options
+// Every call points to a new database file in the temp dir, which is removed when the test database is torn down
+func GetTestClusterOption() *pt.TestBaseOptions {
+	return &pt.TestBaseOptions{
+		DBPluginName: PluginName,
+		DBName:       filepath.Join(os.TempDir(), "cadence_test_"+pt.GenerateRandomDBName(10)+".db"),
+		SchemaDir:    testSchemaDir,
+		StoreType:    config.StoreTypeSQL,
+	}
+}
@@ -0,0 +1,359 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber/cadence/common/persistence/sql/sqldriver"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	templateCreateWorkflowExecutionStarted = `INSERT OR IGNORE INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`domain_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, is_cron, num_clusters, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT (domain_id, run_id) DO UPDATE
+		 SET memo = excluded.memo, encoding = excluded.encoding, search_attributes = excluded.search_attributes`
+
+	templateQueryFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, close_status, history_length, memo, encoding, task_list, is_cron, search_attributes`
+
+	// RunID condition is needed for correct pagination
+	templateConditions = ` AND domain_id = ?
+		 AND start_time >= ?
+		 AND start_time <= ?
+ 		 AND (run_id > ? OR start_time < ?)
+         ORDER BY start_time DESC, run_id
+         LIMIT ?`
+
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, is_cron`
+	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE close_status IS NULL `
+
+	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, close_status, history_length
+		 FROM executions_visibility WHERE close_status IS NOT NULL `
+
+	templateGetOpenWorkflowExecutions = templateOpenSelect + templateConditions
+
+	templateGetClosedWorkflowExecutions = templateClosedSelect + templateConditions
+
+	templateGetOpenWorkflowExecutionsByType = templateOpenSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByType = templateClosedSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetOpenWorkflowExecutionsByID = templateOpenSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND close_status = ?` + templateConditions
+
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, close_status, history_length, is_cron
+		 FROM executions_visibility
+		 WHERE domain_id = ? AND close_status IS NOT NULL
+		 AND run_id = ?`
+
+	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE domain_id=? AND run_id=?"
+)
+
+var errCloseParams = errors.New("missing one of {closeStatus, closeTime, historyLength} params")
+
+type visibilityQueryDialect struct {
+	converter DataConverter
+}
+
+// InsertIntoVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (sdb *db) InsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, sdb.GetTotalNumDBShards())
+	return sdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateCreateWorkflowExecutionStarted,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		toSQLiteJSON(row.SearchAttributes))
+}
+
+// ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (sdb *db) ReplaceIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, sdb.GetTotalNumDBShards())
+	switch {
+	case row.CloseStatus != nil && row.CloseTime != nil && row.HistoryLength != nil:
+		row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+		closeTime := sdb.converter.ToSQLiteDateTime(*row.CloseTime)
+		return sdb.driver.ExecContext(ctx,
+			dbShardID,
+			templateCreateWorkflowExecutionClosed,
+			row.DomainID,
+			row.WorkflowID,
+			row.RunID,
+			row.StartTime,
+			row.ExecutionTime,
+			row.WorkflowTypeName,
+			closeTime,
+			*row.CloseStatus,
+			*row.HistoryLength,
+			row.Memo,
+			row.Encoding,
+			row.TaskList,
+			row.IsCron,
+			row.NumClusters,
+			toSQLiteJSON(row.SearchAttributes))
+	default:
+		return nil, errCloseParams
+	}
+}
+
+// DeleteFromVisibility deletes a row from visibility table if it exist
+func (sdb *db) DeleteFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, sdb.GetTotalNumDBShards())
+	return sdb.driver.ExecContext(ctx, dbShardID, templateDeleteWorkflowExecution, filter.DomainID, filter.RunID)
+}
+
+// SelectFromVisibility reads one or more rows from visibility table
+func (sdb *db) SelectFromVisibility(ctx context.Context, filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, sdb.GetTotalNumDBShards())
+	var err error
+	var rows []sqlplugin.VisibilityRow
+	if filter.MinStartTime != nil {
+		*filter.MinStartTime = sdb.converter.ToSQLiteDateTime(*filter.MinStartTime)
+	}
+	if filter.MaxStartTime != nil {
+		*filter.MaxStartTime = sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime)
+	}
+	switch {
+	case filter.MinStartTime == nil && filter.RunID != nil && filter.Closed:
+		var row sqlplugin.VisibilityRow
+		err = sdb.driver.GetContext(ctx, dbShardID, &row, templateGetClosedWorkflowExecution, filter.DomainID, *filter.RunID)
+		if err == nil {
+			rows = append(rows, row)
+		}
+	default:
+		var qry string
+		var args []interface{}
+		qry, args, err = sdb.rangeSelectFromVisibilityQuery(filter)
+		if err != nil {
+			return nil, err
+		}
+		err = sdb.driver.SelectContext(ctx, dbShardID, &rows, qry, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sdb.fromDBVisibilityRows(rows)
+	return rows, err
+}
+
+// SelectFromVisibilityAllShards reads a page of rows of the range queries of SelectFromVisibility from visibility table
+// of all db shards
+func (sdb *db) SelectFromVisibilityAllShards(ctx context.Context, filter *sqlplugin.VisibilityFilter, pageToken []byte) ([]sqlplugin.VisibilityRow, []byte, error) {
+	if filter.MinStartTime == nil || filter.MaxStartTime == nil || filter.RunID == nil || filter.PageSize == nil {
+		return nil, nil, fmt.Errorf("invalid query filter")
+	}
+	qry, _, err := sdb.rangeSelectFromVisibilityQuery(filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rows []sqlplugin.VisibilityRow
+	nextPageToken, err := sdb.driver.SelectPageFromAllShards(ctx, &rows, &sqldriver.ShardedPage{
+		Query: qry,
+		Args: func(_ int, lastRow interface{}) []interface{} {
+			shardFilter := *filter
+			if lastRow != nil {
+				cursor := lastRow.(sqlplugin.VisibilityRow)
+				shardFilter.MaxStartTime = &cursor.StartTime
+				shardFilter.RunID = &cursor.RunID
+			}
+			_, args, _ := sdb.rangeSelectFromVisibilityQuery(&shardFilter)
+			return args
+		},
+		Less:     sqlplugin.VisibilityRowLess,
+		Cursor:   sqlplugin.VisibilityRowCursor,
+		PageSize: *filter.PageSize,
+	}, pageToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	sdb.fromDBVisibilityRows(rows)
+	return rows, nextPageToken, nil
+}
+
+// rangeSelectFromVisibilityQuery returns the query and args of the range queries of SelectFromVisibility, which read
+// the rows after MaxStartTime and RunID of the filter
+func (sdb *db) rangeSelectFromVisibilityQuery(filter *sqlplugin.VisibilityFilter) (string, []interface{}, error) {
+	switch {
+	case filter.MinStartTime != nil && filter.WorkflowID != nil:
+		qry := templateGetOpenWorkflowExecutionsByID
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutionsByID
+		}
+		return qry, []interface{}{
+			*filter.WorkflowID,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			*filter.MinStartTime,
+			*filter.PageSize,
+		}, nil
+	case filter.MinStartTime != nil && filter.WorkflowTypeName != nil:
+		qry := templateGetOpenWorkflowExecutionsByType
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutionsByType
+		}
+		return qry, []interface{}{
+			*filter.WorkflowTypeName,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			*filter.MaxStartTime,
+			*filter.PageSize,
+		}, nil
+	case filter.MinStartTime != nil && filter.CloseStatus != nil:
+		return templateGetClosedWorkflowExecutionsByStatus, []interface{}{
+			*filter.CloseStatus,
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.PageSize,
+		}, nil
+	case filter.MinStartTime != nil:
+		qry := templateGetOpenWorkflowExecutions
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutions
+		}
+		return qry, []interface{}{
+			filter.DomainID,
+			sdb.converter.ToSQLiteDateTime(*filter.MinStartTime),
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.RunID,
+			sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime),
+			*filter.PageSize,
+		}, nil
+	default:
+		return "", nil, fmt.Errorf("invalid query filter")
+	}
+}
+
+// UpsertIntoVisibility inserts the row of an open workflow into visibility table, or updates the memo and
+// search attributes of the existing row
+func (sdb *db) UpsertIntoVisibility(ctx context.Context, row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	row.StartTime = sdb.converter.ToSQLiteDateTime(row.StartTime)
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(row.DomainID, sdb.GetTotalNumDBShards())
+	return sdb.driver.ExecContext(ctx,
+		dbShardID,
+		templateUpsertWorkflowExecution,
+		row.DomainID,
+		row.WorkflowID,
+		row.RunID,
+		row.StartTime,
+		row.ExecutionTime,
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		row.IsCron,
+		row.NumClusters,
+		toSQLiteJSON(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows matching an advanced visibility query from visibility table
+func (sdb *db) SelectFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, sdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilitySelectQuery(&visibilityQueryDialect{converter: sdb.converter}, templateQueryFieldNames, filter)
+	var rows []sqlplugin.VisibilityRow
+	if err := sdb.driver.SelectContext(ctx, dbShardID, &rows, query, args...); err != nil {
+		return nil, err
+	}
+	sdb.fromDBVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibilityByQuery counts the rows matching an advanced visibility query in visibility table
+func (sdb *db) CountFromVisibilityByQuery(ctx context.Context, filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainID(filter.DomainID, sdb.GetTotalNumDBShards())
+	query, args := sqlplugin.BuildVisibilityCountQuery(&visibilityQueryDialect{converter: sdb.converter}, filter)
+	var count int64
+	err := sdb.driver.GetContext(ctx, dbShardID, &count, query, args...)
+	return count, err
+}
+
+// toSQLiteJSON converts the JSON to string, as the JSON functions of SQLite reject blobs
+func toSQLiteJSON(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+func (d *visibilityQueryDialect) Placeholder(int) string {
+	return "?"
+}
+
+// SearchAttribute returns the same expression for all the types, since json_extract returns
+// the SQL values of JSON numbers and strings, and 1 or 0 for booleans
+func (d *visibilityQueryDialect) SearchAttribute(name string, _ types.IndexedValueType) string {
+	return fmt.Sprintf("json_extract(search_attributes, '$.%s')", name)
+}
+
+func (d *visibilityQueryDialect) SearchAttributeForOrder(name string) string {
+	return fmt.Sprintf("json_extract(search_attributes, '$.%s')", name)
+}
+
+func (d *visibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return d.converter.ToSQLiteDateTime(t)
+}
+
+// fromDBVisibilityRows converts the values read from visibility table
+func (sdb *db) fromDBVisibilityRows(rows []sqlplugin.VisibilityRow) {
+	for i := range rows {
+		rows[i].StartTime = sdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = sdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := sdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+}
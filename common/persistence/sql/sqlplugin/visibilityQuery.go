@@ -0,0 +1,617 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	visibilityQueryDateTimeFormat = time.RFC3339
+	visibilityQueryMissingValue   = "missing"
+
+	// SearchAttributeDatetimeFormat is the format Datetime search attributes are stored in,
+	// it has fixed width in UTC so that the values can be compared as strings
+	SearchAttributeDatetimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+)
+
+const (
+	visibilityQueryTypeString visibilityQueryType = iota
+	visibilityQueryTypeInt
+	visibilityQueryTypeBool
+	visibilityQueryTypeTime
+	visibilityQueryTypeCloseStatus
+	visibilityQueryTypeSearchAttribute
+)
+
+type (
+	// VisibilityQueryDialect renders the database specific parts of an advanced visibility query
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind parameter of the n-th query argument, n starts from 1
+		Placeholder(n int) string
+		// SearchAttribute returns the expression reading a custom search attribute
+		// from the search_attributes JSON column as a value of the given type
+		SearchAttribute(name string, valueType types.IndexedValueType) string
+		// SearchAttributeForOrder returns the expression ordering the rows by a custom search attribute
+		SearchAttributeForOrder(name string) string
+		// DateTime converts a time argument to the datetime stored in the database
+		DateTime(t time.Time) time.Time
+	}
+
+	// VisibilityQuery is an advanced visibility query parsed by ParseVisibilityQuery.
+	// It supports and, or, parentheses, comparison, in, like, between and `= missing`
+	// on the system fields and the custom search attributes, and order by.
+	VisibilityQuery struct {
+		filter  visibilityQueryExpr
+		orderBy []*visibilityQueryOrder
+	}
+
+	// VisibilityQueryFilter contains the params to select or count the rows of executions_visibility
+	// table matching an advanced visibility query
+	VisibilityQueryFilter struct {
+		DomainID string
+		Query    *VisibilityQuery
+		// MaxStartTime and RunID are from the last row of the previous page, they are only used
+		// when the query has no order by, where the rows are ordered by start_time desc and run_id
+		MaxStartTime *time.Time
+		RunID        *string
+		// Offset is the number of rows skipped when the query has order by
+		Offset   int
+		PageSize int
+	}
+
+	visibilityQueryExpr interface {
+		build(b *visibilityQueryBuilder)
+	}
+
+	visibilityQueryAnd struct {
+		exprs []visibilityQueryExpr
+	}
+
+	visibilityQueryOr struct {
+		exprs []visibilityQueryExpr
+	}
+
+	visibilityQueryCondition struct {
+		field    *visibilityQueryField
+		operator string
+		values   []interface{}
+	}
+
+	visibilityQueryOrder struct {
+		field *visibilityQueryField
+		desc  bool
+	}
+
+	visibilityQueryField struct {
+		// column is empty for custom search attributes
+		column    string
+		attribute string
+		fieldType visibilityQueryType
+		// valueType is the declared type of custom search attributes
+		valueType types.IndexedValueType
+	}
+
+	visibilityQueryParser struct {
+		searchAttributes map[string]types.IndexedValueType
+	}
+
+	visibilityQueryBuilder struct {
+		dialect VisibilityQueryDialect
+		buf     strings.Builder
+		args    []interface{}
+	}
+
+	visibilityQueryType int
+)
+
+var (
+	visibilityQueryColumns = map[string]*visibilityQueryField{
+		definition.DomainID:      {column: "domain_id", fieldType: visibilityQueryTypeString},
+		definition.WorkflowID:    {column: "workflow_id", fieldType: visibilityQueryTypeString},
+		definition.RunID:         {column: "run_id", fieldType: visibilityQueryTypeString},
+		definition.WorkflowType:  {column: "workflow_type_name", fieldType: visibilityQueryTypeString},
+		definition.TaskList:      {column: "task_list", fieldType: visibilityQueryTypeString},
+		definition.StartTime:     {column: "start_time", fieldType: visibilityQueryTypeTime},
+		definition.ExecutionTime: {column: "execution_time", fieldType: visibilityQueryTypeTime},
+		definition.CloseTime:     {column: "close_time", fieldType: visibilityQueryTypeTime},
+		definition.CloseStatus:   {column: "close_status", fieldType: visibilityQueryTypeCloseStatus},
+		definition.HistoryLength: {column: "history_length", fieldType: visibilityQueryTypeInt},
+		definition.NumClusters:   {column: "num_clusters", fieldType: visibilityQueryTypeInt},
+		definition.IsCron:        {column: "is_cron", fieldType: visibilityQueryTypeBool},
+	}
+
+	// search attribute names are embedded in the JSON paths of the query
+	searchAttributeNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// ParseVisibilityQuery parses and validates the where and order by clauses of an advanced visibility query,
+// where the custom search attributes may have the Attr prefix added by the frontend validator.
+// The custom search attributes must be in searchAttributes, their values are converted to the declared types.
+func ParseVisibilityQuery(query string, searchAttributes map[string]types.IndexedValueType) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return &VisibilityQuery{}, nil
+	}
+	var placeholderQuery string
+	if common.IsJustOrderByClause(query) {
+		placeholderQuery = fmt.Sprintf("select * from dummy %s", query)
+	} else {
+		placeholderQuery = fmt.Sprintf("select * from dummy where %s", query)
+	}
+	stmt, err := sqlparser.Parse(placeholderQuery)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid select query")
+	}
+	if sel.Limit != nil {
+		return nil, errors.New("limit is not supported")
+	}
+
+	parser := &visibilityQueryParser{searchAttributes: searchAttributes}
+	result := &VisibilityQuery{}
+	if sel.Where != nil {
+		if result.filter, err = parser.convertExpr(sel.Where.Expr); err != nil {
+			return nil, err
+		}
+	}
+	for _, order := range sel.OrderBy {
+		field, err := parser.newField(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		result.orderBy = append(result.orderBy, &visibilityQueryOrder{
+			field: field,
+			desc:  order.Direction == sqlparser.DescScr,
+		})
+	}
+	return result, nil
+}
+
+// HasOrder returns true if the query specifies the order of the rows
+func (q *VisibilityQuery) HasOrder() bool {
+	return len(q.orderBy) != 0
+}
+
+// BuildVisibilitySelectQuery returns the statement and arguments selecting the fields of a page of rows
+// matching the filter
+func BuildVisibilitySelectQuery(
+	dialect VisibilityQueryDialect,
+	fields string,
+	filter *VisibilityQueryFilter,
+) (string, []interface{}) {
+	b := &visibilityQueryBuilder{dialect: dialect}
+	b.write("SELECT ", fields, " FROM executions_visibility")
+	b.buildWhere(filter)
+
+	if filter.Query.HasOrder() {
+		b.write(" ORDER BY ")
+		for _, order := range filter.Query.orderBy {
+			order.build(b)
+			b.write(", ")
+		}
+		b.write("run_id LIMIT ", b.arg(filter.PageSize), " OFFSET ", b.arg(filter.Offset))
+	} else {
+		if filter.MaxStartTime != nil && filter.RunID != nil {
+			maxStartTime := dialect.DateTime(*filter.MaxStartTime)
+			b.write(" AND (start_time < ", b.arg(maxStartTime),
+				" OR (start_time = ", b.arg(maxStartTime), " AND run_id > ", b.arg(*filter.RunID), "))")
+		}
+		b.write(" ORDER BY start_time DESC, run_id LIMIT ", b.arg(filter.PageSize))
+	}
+	return b.buf.String(), b.args
+}
+
+// BuildVisibilityCountQuery returns the statement and arguments counting the rows matching the filter
+func BuildVisibilityCountQuery(
+	dialect VisibilityQueryDialect,
+	filter *VisibilityQueryFilter,
+) (string, []interface{}) {
+	b := &visibilityQueryBuilder{dialect: dialect}
+	b.write("SELECT COUNT(*) FROM executions_visibility")
+	b.buildWhere(filter)
+	return b.buf.String(), b.args
+}
+
+func (b *visibilityQueryBuilder) buildWhere(filter *VisibilityQueryFilter) {
+	b.write(" WHERE domain_id = ", b.arg(filter.DomainID))
+	if filter.Query.filter != nil {
+		b.write(" AND ")
+		filter.Query.filter.build(b)
+	}
+}
+
+func (b *visibilityQueryBuilder) write(parts ...string) {
+	for _, part := range parts {
+		b.buf.WriteString(part)
+	}
+}
+
+func (b *visibilityQueryBuilder) arg(value interface{}) string {
+	if t, ok := value.(time.Time); ok {
+		value = b.dialect.DateTime(t)
+	}
+	b.args = append(b.args, value)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+func (e *visibilityQueryAnd) build(b *visibilityQueryBuilder) {
+	buildVisibilityQueryExprs(b, e.exprs, " AND ")
+}
+
+func (e *visibilityQueryOr) build(b *visibilityQueryBuilder) {
+	buildVisibilityQueryExprs(b, e.exprs, " OR ")
+}
+
+func buildVisibilityQueryExprs(b *visibilityQueryBuilder, exprs []visibilityQueryExpr, separator string) {
+	b.write("(")
+	for i, expr := range exprs {
+		if i > 0 {
+			b.write(separator)
+		}
+		expr.build(b)
+	}
+	b.write(")")
+}
+
+func (c *visibilityQueryCondition) build(b *visibilityQueryBuilder) {
+	field := c.field.expression(b.dialect)
+	switch c.operator {
+	case sqlparser.IsNullStr, sqlparser.IsNotNullStr:
+		b.write(field, " ", c.operator)
+	case sqlparser.InStr, sqlparser.NotInStr:
+		b.write(field, " ", c.operator, " (")
+		for i, value := range c.values {
+			if i > 0 {
+				b.write(", ")
+			}
+			b.write(b.arg(value))
+		}
+		b.write(")")
+	case sqlparser.BetweenStr, sqlparser.NotBetweenStr:
+		b.write(field, " ", c.operator, " ", b.arg(c.values[0]), " AND ", b.arg(c.values[1]))
+	default:
+		b.write(field, " ", c.operator, " ", b.arg(c.values[0]))
+	}
+}
+
+func (o *visibilityQueryOrder) build(b *visibilityQueryBuilder) {
+	if o.field.column != "" {
+		b.write(o.field.column)
+	} else {
+		b.write(b.dialect.SearchAttributeForOrder(o.field.attribute))
+	}
+	if o.desc {
+		b.write(" DESC")
+	} else {
+		b.write(" ASC")
+	}
+}
+
+func (f *visibilityQueryField) expression(dialect VisibilityQueryDialect) string {
+	if f.column != "" {
+		return f.column
+	}
+	return dialect.SearchAttribute(f.attribute, f.valueType)
+}
+
+func (p *visibilityQueryParser) convertExpr(expr sqlparser.Expr) (visibilityQueryExpr, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := p.convertExprs(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityQueryAnd{exprs: []visibilityQueryExpr{left, right}}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := p.convertExprs(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &visibilityQueryOr{exprs: []visibilityQueryExpr{left, right}}, nil
+	case *sqlparser.ParenExpr:
+		return p.convertExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return p.convertComparison(expr)
+	case *sqlparser.RangeCond:
+		return p.convertRange(expr)
+	case nil:
+		return nil, errors.New("where expression is nil")
+	default:
+		return nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (p *visibilityQueryParser) convertExprs(left, right sqlparser.Expr) (visibilityQueryExpr, visibilityQueryExpr, error) {
+	leftExpr, err := p.convertExpr(left)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightExpr, err := p.convertExpr(right)
+	if err != nil {
+		return nil, nil, err
+	}
+	return leftExpr, rightExpr, nil
+}
+
+func (p *visibilityQueryParser) convertComparison(expr *sqlparser.ComparisonExpr) (visibilityQueryExpr, error) {
+	field, err := p.newField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	// `CloseTime = missing` is how the open workflows are queried
+	if colName, ok := expr.Right.(*sqlparser.ColName); ok && colName.Name.EqualString(visibilityQueryMissingValue) {
+		switch expr.Operator {
+		case sqlparser.EqualStr:
+			return &visibilityQueryCondition{field: field, operator: sqlparser.IsNullStr}, nil
+		case sqlparser.NotEqualStr:
+			return &visibilityQueryCondition{field: field, operator: sqlparser.IsNotNullStr}, nil
+		default:
+			return nil, fmt.Errorf("operator %s is not supported for missing value", expr.Operator)
+		}
+	}
+
+	var valueExprs sqlparser.Exprs
+	switch expr.Operator {
+	case sqlparser.EqualStr, sqlparser.NotEqualStr,
+		sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr,
+		sqlparser.LikeStr, sqlparser.NotLikeStr:
+		valueExprs = sqlparser.Exprs{expr.Right}
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, fmt.Errorf("invalid values: %s", sqlparser.String(expr.Right))
+		}
+		valueExprs = sqlparser.Exprs(tuple)
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+	return newVisibilityQueryCondition(field, expr.Operator, valueExprs)
+}
+
+func (p *visibilityQueryParser) convertRange(expr *sqlparser.RangeCond) (visibilityQueryExpr, error) {
+	field, err := p.newField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityQueryCondition(field, expr.Operator, sqlparser.Exprs{expr.From, expr.To})
+}
+
+func (p *visibilityQueryParser) newField(expr sqlparser.Expr) (*visibilityQueryField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, fmt.Errorf("invalid field name: %s", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	qualifier := colName.Qualifier.Name.String()
+	switch {
+	case qualifier == "" && strings.HasPrefix(name, definition.Attr+"."):
+		// the frontend validator renames custom search attributes to `Attr.Name`
+		name = strings.TrimPrefix(name, definition.Attr+".")
+	case qualifier == "":
+		if field, ok := visibilityQueryColumns[name]; ok {
+			copied := *field
+			return &copied, nil
+		}
+	case qualifier != definition.Attr:
+		return nil, fmt.Errorf("invalid field name: %s", sqlparser.String(expr))
+	}
+	if !searchAttributeNameRegex.MatchString(name) {
+		return nil, fmt.Errorf("invalid search attribute name: %s", name)
+	}
+	valueType, ok := p.searchAttributes[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown search attribute: %s", name)
+	}
+	return &visibilityQueryField{
+		attribute: name,
+		fieldType: visibilityQueryTypeSearchAttribute,
+		valueType: valueType,
+	}, nil
+}
+
+func newVisibilityQueryCondition(
+	field *visibilityQueryField,
+	operator string,
+	exprs sqlparser.Exprs,
+) (*visibilityQueryCondition, error) {
+	condition := &visibilityQueryCondition{
+		field:    field,
+		operator: operator,
+	}
+	for _, expr := range exprs {
+		value, err := convertVisibilityQueryValue(expr)
+		if err != nil {
+			return nil, err
+		}
+		if value, err = field.convertValue(value); err != nil {
+			return nil, err
+		}
+		condition.values = append(condition.values, value)
+	}
+
+	isLike := operator == sqlparser.LikeStr || operator == sqlparser.NotLikeStr
+	switch field.fieldType {
+	case visibilityQueryTypeString:
+	case visibilityQueryTypeSearchAttribute:
+		switch field.valueType {
+		case types.IndexedValueTypeString, types.IndexedValueTypeKeyword:
+		case types.IndexedValueTypeBool:
+			switch operator {
+			case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+			default:
+				return nil, fmt.Errorf("operator %s is not supported for %s", operator, field.attribute)
+			}
+		default:
+			if isLike {
+				return nil, fmt.Errorf("operator %s is not supported for %s", operator, field.attribute)
+			}
+		}
+	case visibilityQueryTypeCloseStatus, visibilityQueryTypeBool:
+		switch operator {
+		case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.InStr, sqlparser.NotInStr:
+		default:
+			return nil, fmt.Errorf("operator %s is not supported for %s", operator, field.column)
+		}
+	default:
+		if isLike {
+			return nil, fmt.Errorf("operator %s is not supported for %s", operator, field.column)
+		}
+	}
+	return condition, nil
+}
+
+func (f *visibilityQueryField) convertValue(value interface{}) (interface{}, error) {
+	switch f.fieldType {
+	case visibilityQueryTypeString:
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("value of %s must be a string: %v", f.column, value)
+		}
+	case visibilityQueryTypeInt:
+		if _, ok := value.(int64); !ok {
+			return nil, fmt.Errorf("value of %s must be an integer: %v", f.column, value)
+		}
+	case visibilityQueryTypeBool:
+		if s, ok := value.(string); ok {
+			return strconv.ParseBool(s)
+		}
+		if _, ok := value.(bool); !ok {
+			return nil, fmt.Errorf("value of %s must be a boolean: %v", f.column, value)
+		}
+	case visibilityQueryTypeTime:
+		return convertVisibilityQueryTimeValue(value)
+	case visibilityQueryTypeCloseStatus:
+		return convertVisibilityQueryCloseStatusValue(value)
+	case visibilityQueryTypeSearchAttribute:
+		return f.convertSearchAttributeValue(value)
+	}
+	return value, nil
+}
+
+// convertSearchAttributeValue converts the value to the declared type of the custom search attribute,
+// Datetime values are converted to the strings they are stored as
+func (f *visibilityQueryField) convertSearchAttributeValue(value interface{}) (interface{}, error) {
+	switch f.valueType {
+	case types.IndexedValueTypeString, types.IndexedValueTypeKeyword:
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("value of %s must be a string: %v", f.attribute, value)
+		}
+	case types.IndexedValueTypeInt:
+		if _, ok := value.(int64); !ok {
+			return nil, fmt.Errorf("value of %s must be an integer: %v", f.attribute, value)
+		}
+	case types.IndexedValueTypeDouble:
+		switch v := value.(type) {
+		case int64:
+			return float64(v), nil
+		case float64:
+		default:
+			return nil, fmt.Errorf("value of %s must be a number: %v", f.attribute, value)
+		}
+	case types.IndexedValueTypeBool:
+		if s, ok := value.(string); ok {
+			return strconv.ParseBool(s)
+		}
+		if _, ok := value.(bool); !ok {
+			return nil, fmt.Errorf("value of %s must be a boolean: %v", f.attribute, value)
+		}
+	case types.IndexedValueTypeDatetime:
+		t, err := convertVisibilityQueryTimeValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return t.Format(SearchAttributeDatetimeFormat), nil
+	default:
+		return nil, fmt.Errorf("unknown type of %s: %v", f.attribute, f.valueType)
+	}
+	return value, nil
+}
+
+func convertVisibilityQueryValue(expr sqlparser.Expr) (interface{}, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		switch expr.Type {
+		case sqlparser.StrVal:
+			return string(expr.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(expr.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(expr.Val), 64)
+		}
+	case sqlparser.BoolVal:
+		return bool(expr), nil
+	}
+	return nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr))
+}
+
+// convertVisibilityQueryTimeValue converts unix nanoseconds or RFC3339 strings to time
+func convertVisibilityQueryTimeValue(value interface{}) (time.Time, error) {
+	switch value := value.(type) {
+	case int64:
+		return time.Unix(0, value).UTC(), nil
+	case string:
+		if nanos, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return time.Unix(0, nanos).UTC(), nil
+		}
+		parsedTime, err := time.Parse(visibilityQueryDateTimeFormat, value)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return parsedTime.UTC(), nil
+	default:
+		return time.Time{}, fmt.Errorf("invalid time value: %v", value)
+	}
+}
+
+// convertVisibilityQueryCloseStatusValue converts the close status names or values
+// to the values stored in close_status column
+func convertVisibilityQueryCloseStatusValue(value interface{}) (int32, error) {
+	var status types.WorkflowExecutionCloseStatus
+	switch value := value.(type) {
+	case int64:
+		status = types.WorkflowExecutionCloseStatus(value)
+	case string:
+		if err := status.UnmarshalText([]byte(strings.ToUpper(strings.TrimSpace(value)))); err != nil {
+			return 0, fmt.Errorf("unknown workflow close status: %s", value)
+		}
+	default:
+		return 0, fmt.Errorf("unknown workflow close status: %v", value)
+	}
+	if status < types.WorkflowExecutionCloseStatusCompleted || status > types.WorkflowExecutionCloseStatusTimedOut {
+		return 0, fmt.Errorf("unknown workflow close status: %v", value)
+	}
+	return int32(status), nil
+}
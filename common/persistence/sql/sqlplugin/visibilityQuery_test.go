@@ -0,0 +1,201 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type testVisibilityQueryDialect struct{}
+
+var testSearchAttributes = map[string]types.IndexedValueType{
+	"CustomStringField":   types.IndexedValueTypeString,
+	"CustomKeywordField":  types.IndexedValueTypeKeyword,
+	"CustomIntField":      types.IndexedValueTypeInt,
+	"CustomDoubleField":   types.IndexedValueTypeDouble,
+	"CustomBoolField":     types.IndexedValueTypeBool,
+	"CustomDatetimeField": types.IndexedValueTypeDatetime,
+}
+
+func (d testVisibilityQueryDialect) Placeholder(n int) string {
+	return "?"
+}
+
+func (d testVisibilityQueryDialect) SearchAttribute(name string, valueType types.IndexedValueType) string {
+	return fmt.Sprintf("attr(%s, %s)", name, valueType)
+}
+
+func (d testVisibilityQueryDialect) SearchAttributeForOrder(name string) string {
+	return fmt.Sprintf("attr(%s)", name)
+}
+
+func (d testVisibilityQueryDialect) DateTime(t time.Time) time.Time {
+	return t
+}
+
+func TestBuildVisibilitySelectQuery(t *testing.T) {
+	const (
+		domainID = "domain-id"
+		fields   = "workflow_id, run_id"
+		prefix   = "SELECT workflow_id, run_id FROM executions_visibility WHERE domain_id = ?"
+		suffix   = " ORDER BY start_time DESC, run_id LIMIT ?"
+	)
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		query        string
+		expectedSQL  string
+		expectedArgs []interface{}
+	}{
+		"empty query": {
+			query:        "",
+			expectedSQL:  prefix + suffix,
+			expectedArgs: []interface{}{domainID, 10},
+		},
+		"open workflows": {
+			query:        "WorkflowID = 'wid' and CloseTime = missing",
+			expectedSQL:  prefix + " AND (workflow_id = ? AND close_time is null)" + suffix,
+			expectedArgs: []interface{}{domainID, "wid", 10},
+		},
+		"close status names and values": {
+			query:        "CloseStatus = 'failed' or CloseStatus in (2, 'TIMED_OUT')",
+			expectedSQL:  prefix + " AND (close_status = ? OR close_status in (?, ?))" + suffix,
+			expectedArgs: []interface{}{domainID, int32(1), int32(2), int32(5), 10},
+		},
+		"time range": {
+			query:        "StartTime between '2020-01-01T00:00:00Z' and 1577923200000000000",
+			expectedSQL:  prefix + " AND start_time between ? AND ?" + suffix,
+			expectedArgs: []interface{}{domainID, startTime, startTime.Add(24 * time.Hour), 10},
+		},
+		"custom search attributes": {
+			query:        "`Attr.CustomIntField` >= 1 and (Attr.CustomDoubleField in (1, 2.5) or `Attr.CustomKeywordField` like 'abc%')",
+			expectedSQL:  prefix + " AND (attr(CustomIntField, INT) >= ? AND (attr(CustomDoubleField, DOUBLE) in (?, ?) OR attr(CustomKeywordField, KEYWORD) like ?))" + suffix,
+			expectedArgs: []interface{}{domainID, int64(1), float64(1), 2.5, "abc%", 10},
+		},
+		"custom datetime search attribute": {
+			query:        "`Attr.CustomDatetimeField` between '2020-01-01T08:00:00+08:00' and 1577923200000000000",
+			expectedSQL:  prefix + " AND attr(CustomDatetimeField, DATETIME) between ? AND ?" + suffix,
+			expectedArgs: []interface{}{domainID, "2020-01-01T00:00:00.000000000Z", "2020-01-02T00:00:00.000000000Z", 10},
+		},
+		"custom bool search attribute": {
+			query:        "`Attr.CustomBoolField` = 'true' and `Attr.CustomStringField` = 'abc'",
+			expectedSQL:  prefix + " AND (attr(CustomBoolField, BOOL) = ? AND attr(CustomStringField, STRING) = ?)" + suffix,
+			expectedArgs: []interface{}{domainID, true, "abc", 10},
+		},
+		"custom search attribute missing": {
+			query:        "`Attr.CustomBoolField` != missing",
+			expectedSQL:  prefix + " AND attr(CustomBoolField, BOOL) is not null" + suffix,
+			expectedArgs: []interface{}{domainID, 10},
+		},
+		"order by": {
+			query:        "WorkflowType = 'type' order by `Attr.CustomIntField` desc, CloseTime",
+			expectedSQL:  prefix + " AND workflow_type_name = ? ORDER BY attr(CustomIntField) DESC, close_time ASC, run_id LIMIT ? OFFSET ?",
+			expectedArgs: []interface{}{domainID, "type", 10, 0},
+		},
+		"just order by": {
+			query:        "order by StartTime",
+			expectedSQL:  prefix + " ORDER BY start_time ASC, run_id LIMIT ? OFFSET ?",
+			expectedArgs: []interface{}{domainID, 10, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			query, err := ParseVisibilityQuery(test.query, testSearchAttributes)
+			require.NoError(t, err)
+
+			sql, args := BuildVisibilitySelectQuery(testVisibilityQueryDialect{}, fields, &VisibilityQueryFilter{
+				DomainID: domainID,
+				Query:    query,
+				PageSize: 10,
+			})
+			assert.Equal(t, test.expectedSQL, sql)
+			assert.Equal(t, test.expectedArgs, args)
+		})
+	}
+}
+
+func TestBuildVisibilitySelectQuery_NextPage(t *testing.T) {
+	query, err := ParseVisibilityQuery("WorkflowID = 'wid'", testSearchAttributes)
+	require.NoError(t, err)
+
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	runID := "run-id"
+	sql, args := BuildVisibilitySelectQuery(testVisibilityQueryDialect{}, "*", &VisibilityQueryFilter{
+		DomainID:     "domain-id",
+		Query:        query,
+		MaxStartTime: &startTime,
+		RunID:        &runID,
+		PageSize:     10,
+	})
+	assert.Equal(t, "SELECT * FROM executions_visibility WHERE domain_id = ? AND workflow_id = ?"+
+		" AND (start_time < ? OR (start_time = ? AND run_id > ?)) ORDER BY start_time DESC, run_id LIMIT ?", sql)
+	assert.Equal(t, []interface{}{"domain-id", "wid", startTime, startTime, runID, 10}, args)
+}
+
+func TestBuildVisibilityCountQuery(t *testing.T) {
+	query, err := ParseVisibilityQuery("IsCron = 'true' and HistoryLength > 100", testSearchAttributes)
+	require.NoError(t, err)
+
+	sql, args := BuildVisibilityCountQuery(testVisibilityQueryDialect{}, &VisibilityQueryFilter{
+		DomainID: "domain-id",
+		Query:    query,
+	})
+	assert.Equal(t, "SELECT COUNT(*) FROM executions_visibility WHERE domain_id = ? AND (is_cron = ? AND history_length > ?)", sql)
+	assert.Equal(t, []interface{}{"domain-id", true, int64(100)}, args)
+}
+
+func TestParseVisibilityQuery_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"invalid syntax":           "WorkflowID = ",
+		"limit":                    "WorkflowID = 'wid' limit 10",
+		"unknown qualifier":        "t.WorkflowID = 'wid'",
+		"invalid time":             "StartTime > 'yesterday'",
+		"unknown close status":     "CloseStatus = 'unknown'",
+		"like on close status":     "CloseStatus like 'fail%'",
+		"range on bool":            "IsCron > 'false'",
+		"string for int":           "HistoryLength = 'long'",
+		"like on number attribute": "`Attr.CustomIntField` like 1",
+		"mixed attribute types":    "`Attr.CustomKeywordField` in ('a', 1)",
+		"double for int attribute": "`Attr.CustomIntField` = 5.4",
+		"string for int attribute": "`Attr.CustomIntField` = '5'",
+		"invalid datetime":         "`Attr.CustomDatetimeField` > 'yesterday'",
+		"range on bool attribute":  "`Attr.CustomBoolField` > false",
+		"unknown attribute":        "`Attr.UnknownField` = 'abc'",
+		"unknown attribute order":  "order by `Attr.UnknownField`",
+		"function call":            "lower(WorkflowID) = 'wid'",
+		"missing with range":       "CloseTime > missing",
+	}
+
+	for name, query := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseVisibilityQuery(query, testSearchAttributes)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -54,6 +54,12 @@ type (
 		Execution
 	}
 
+	// OpenVisibilityRecord is an open visibility record of an execution,
+	// which is checked for records left behind by executions which no longer exist.
+	OpenVisibilityRecord struct {
+		Execution
+	}
+
 	// Timer is a timer scheduled to be fired
 	Timer struct {
 		ShardID             int
@@ -150,6 +156,11 @@ func (curre *CurrentExecution) Validate() error {
 	return nil
 }
 
+// Validate returns an error if OpenVisibilityRecord is not valid, nil otherwise.
+func (ovr *OpenVisibilityRecord) Validate() error {
+	return validateExecution(&ovr.Execution)
+}
+
 // Clone will return a new copy of ConcreteExecution
 func (ConcreteExecution) Clone() Entity {
 	return &ConcreteExecution{}
@@ -160,6 +171,11 @@ func (CurrentExecution) Clone() Entity {
 	return &CurrentExecution{}
 }
 
+// Clone will return a new copy of OpenVisibilityRecord
+func (OpenVisibilityRecord) Clone() Entity {
+	return &OpenVisibilityRecord{}
+}
+
 // GetShardID returns shard id
 func (ce *ConcreteExecution) GetShardID() int {
 	return ce.Execution.ShardID
@@ -170,6 +186,11 @@ func (curre *CurrentExecution) GetShardID() int {
 	return curre.Execution.ShardID
 }
 
+// GetShardID returns shard id
+func (ovr *OpenVisibilityRecord) GetShardID() int {
+	return ovr.Execution.ShardID
+}
+
 // GetDomainID returns the domain id
 func (ce *ConcreteExecution) GetDomainID() string {
 	return ce.DomainID
@@ -180,6 +201,11 @@ func (curre *CurrentExecution) GetDomainID() string {
 	return curre.DomainID
 }
 
+// GetDomainID returns the domain id
+func (ovr *OpenVisibilityRecord) GetDomainID() string {
+	return ovr.DomainID
+}
+
 // Entity allows to deserialize and validate different type of executions
 type Entity interface {
 	Validate() error
@@ -0,0 +1,126 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fetcher
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+)
+
+type openVisibilityRecordsToken struct {
+	domainIndex int
+	pageToken   []byte
+}
+
+// OpenVisibilityRecordIterator is used to retrieve the open visibility records of the shard,
+// which are the records of the workflows mapped to the shard in all the domains
+func OpenVisibilityRecordIterator(
+	ctx context.Context,
+	retryer persistence.Retryer,
+	visibilityManager persistence.VisibilityManager,
+	domainCache cache.DomainCache,
+	numberOfShards int,
+	pageSize int,
+) pagination.Iterator {
+	var domains []*cache.DomainCacheEntry
+	for _, domain := range domainCache.GetAllDomain() {
+		domains = append(domains, domain)
+	}
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].GetInfo().ID < domains[j].GetInfo().ID
+	})
+	if len(domains) == 0 {
+		return pagination.NewIterator(ctx, nil, func(context.Context, pagination.PageToken) (pagination.Page, error) {
+			return pagination.Page{}, nil
+		})
+	}
+	return pagination.NewIterator(
+		ctx,
+		openVisibilityRecordsToken{},
+		getOpenVisibilityRecords(retryer.GetShardID(), visibilityManager, domains, numberOfShards, pageSize, time.Now()),
+	)
+}
+
+func getOpenVisibilityRecords(
+	shardID int,
+	visibilityManager persistence.VisibilityManager,
+	domains []*cache.DomainCacheEntry,
+	numberOfShards int,
+	pageSize int,
+	latestTime time.Time,
+) pagination.FetchFn {
+	return func(ctx context.Context, token pagination.PageToken) (pagination.Page, error) {
+		currentToken := token.(openVisibilityRecordsToken)
+		domainInfo := domains[currentToken.domainIndex].GetInfo()
+		resp, err := visibilityManager.ListOpenWorkflowExecutions(ctx, &persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:    domainInfo.ID,
+			Domain:        domainInfo.Name,
+			EarliestTime:  0,
+			LatestTime:    latestTime.UnixNano(),
+			PageSize:      pageSize,
+			NextPageToken: currentToken.pageToken,
+		})
+		if err != nil {
+			return pagination.Page{}, err
+		}
+		var records []pagination.Entity
+		for _, e := range resp.Executions {
+			workflowID := e.GetExecution().GetWorkflowID()
+			if common.WorkflowIDToHistoryShard(workflowID, numberOfShards) != shardID {
+				continue
+			}
+			record := &entity.OpenVisibilityRecord{
+				Execution: entity.Execution{
+					ShardID:    shardID,
+					DomainID:   domainInfo.ID,
+					WorkflowID: workflowID,
+					RunID:      e.GetExecution().GetRunID(),
+					State:      persistence.WorkflowStateRunning,
+				},
+			}
+			if err := record.Validate(); err != nil {
+				return pagination.Page{}, err
+			}
+			records = append(records, record)
+		}
+		var nextToken interface{}
+		switch {
+		case len(resp.NextPageToken) != 0:
+			nextToken = openVisibilityRecordsToken{domainIndex: currentToken.domainIndex, pageToken: resp.NextPageToken}
+		case currentToken.domainIndex+1 < len(domains):
+			nextToken = openVisibilityRecordsToken{domainIndex: currentToken.domainIndex + 1}
+		}
+		return pagination.Page{
+			CurrentToken: token,
+			NextToken:    nextToken,
+			Entities:     records,
+		}, nil
+	}
+}
@@ -0,0 +1,108 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestOpenVisibilityRecords(t *testing.T) {
+	const (
+		shardID        = 1
+		numberOfShards = 4
+	)
+	domains := []*cache.DomainCacheEntry{
+		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-id-1", Name: "domain-1"}, nil, "", nil),
+		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: "domain-id-2", Name: "domain-2"}, nil, "", nil),
+	}
+	getRecords := func(first int, last int) []*types.WorkflowExecutionInfo {
+		var records []*types.WorkflowExecutionInfo
+		for i := first; i < last; i++ {
+			records = append(records, &types.WorkflowExecutionInfo{
+				Execution: &types.WorkflowExecution{
+					WorkflowID: fmt.Sprintf("workflow-%v", i),
+					RunID:      fmt.Sprintf("run-%v", i),
+				},
+			})
+		}
+		return records
+	}
+	listRequest := func(domainID string, pageToken []byte) interface{} {
+		return mock.MatchedBy(func(request *persistence.ListWorkflowExecutionsRequest) bool {
+			return request.DomainUUID == domainID && string(request.NextPageToken) == string(pageToken) && request.PageSize == 10
+		})
+	}
+	visibilityManager := &mocks.VisibilityManager{}
+	visibilityManager.On("ListOpenWorkflowExecutions", mock.Anything, listRequest("domain-id-1", nil)).
+		Return(&persistence.ListWorkflowExecutionsResponse{Executions: getRecords(0, 10), NextPageToken: []byte("token")}, nil).Once()
+	visibilityManager.On("ListOpenWorkflowExecutions", mock.Anything, listRequest("domain-id-1", []byte("token"))).
+		Return(&persistence.ListWorkflowExecutionsResponse{Executions: getRecords(10, 15)}, nil).Once()
+	visibilityManager.On("ListOpenWorkflowExecutions", mock.Anything, listRequest("domain-id-2", nil)).
+		Return(&persistence.ListWorkflowExecutionsResponse{Executions: getRecords(15, 20)}, nil).Once()
+
+	var expected []pagination.Entity
+	for i, record := range append(getRecords(0, 15), getRecords(15, 20)...) {
+		if common.WorkflowIDToHistoryShard(record.Execution.WorkflowID, numberOfShards) != shardID {
+			continue
+		}
+		domainID := "domain-id-1"
+		if i >= 15 {
+			domainID = "domain-id-2"
+		}
+		expected = append(expected, &entity.OpenVisibilityRecord{
+			Execution: entity.Execution{
+				ShardID:    shardID,
+				DomainID:   domainID,
+				WorkflowID: record.Execution.WorkflowID,
+				RunID:      record.Execution.RunID,
+				State:      persistence.WorkflowStateRunning,
+			},
+		})
+	}
+
+	fetchFn := getOpenVisibilityRecords(shardID, visibilityManager, domains, numberOfShards, 10, time.Now())
+	var actual []pagination.Entity
+	var token interface{} = openVisibilityRecordsToken{}
+	for token != nil {
+		page, err := fetchFn(context.Background(), token)
+		require.NoError(t, err)
+		actual = append(actual, page.Entities...)
+		token = page.NextToken
+	}
+	require.Equal(t, expected, actual)
+	visibilityManager.AssertExpectations(t)
+}
@@ -48,6 +48,10 @@ const (
 	OpenCurrentExecution Name = "open_current_execution"
 	// ConcreteExecutionExists asserts that an open current execution must have a valid concrete execution
 	ConcreteExecutionExists Name = "concrete_execution_exists"
+	// DBVisibilityConsistent asserts that the database visibility store has an up to date record of an execution
+	DBVisibilityConsistent Name = "db_visibility_consistent"
+	// ESVisibilityConsistent asserts that the advanced visibility store has an up to date record of an execution
+	ESVisibilityConsistent Name = "es_visibility_consistent"
 
 	// CollectionMutableState is the collection of invariants relating to mutable state
 	CollectionMutableState Collection = 0
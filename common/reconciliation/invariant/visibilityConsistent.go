@@ -0,0 +1,665 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// visibilityWriteDelay is how long the visibility record of an execution may lag behind its mutable state,
+	// as records are written asynchronously by transfer tasks, and may go through Kafka
+	visibilityWriteDelay = 10 * time.Minute
+	// visibilityClockSkew is added to the time ranges used to look up the records of an execution
+	visibilityClockSkew = time.Minute
+	// visibilityListPageSize is the page size used to list the records of a workflow
+	visibilityListPageSize = 100
+	// visibilityVerifyAttempts is how many times a written record is looked up to verify it's updated,
+	// as the store ignores the write if it already has a newer version of the record
+	visibilityVerifyAttempts = 5
+	// visibilityVerifyInterval is the interval between the lookups of a written record,
+	// as ElasticSearch only returns the record in search results after the index is refreshed
+	visibilityVerifyInterval = time.Second
+
+	secondsInDay = int64(24 * time.Hour / time.Second)
+)
+
+type (
+	visibilityConsistent struct {
+		name              Name
+		pr                persistence.Retryer
+		visibilityManager persistence.VisibilityManager
+		domainCache       cache.DomainCache
+		verifyInterval    time.Duration
+	}
+
+	// visibilityState is what the check found out about the records of an inconsistent execution
+	visibilityState struct {
+		mutableState *persistence.WorkflowMutableState
+		domainEntry  *cache.DomainCacheEntry
+		// recordOutdated is true if the record of the execution is missing or stale,
+		// and needs to be written again from its mutable state
+		recordOutdated bool
+		// orphanedRunIDs are the other runs of the workflow which have open records but no longer exist
+		orphanedRunIDs []string
+	}
+)
+
+// NewDBVisibilityConsistent returns a new invariant for checking the record of an execution in the database visibility store
+func NewDBVisibilityConsistent(
+	pr persistence.Retryer,
+	visibilityManager persistence.VisibilityManager,
+	domainCache cache.DomainCache,
+) Invariant {
+	return &visibilityConsistent{
+		name:              DBVisibilityConsistent,
+		pr:                pr,
+		visibilityManager: visibilityManager,
+		domainCache:       domainCache,
+		verifyInterval:    visibilityVerifyInterval,
+	}
+}
+
+// NewESVisibilityConsistent returns a new invariant for checking the record of an execution in the advanced visibility store
+func NewESVisibilityConsistent(
+	pr persistence.Retryer,
+	visibilityManager persistence.VisibilityManager,
+	domainCache cache.DomainCache,
+) Invariant {
+	return &visibilityConsistent{
+		name:              ESVisibilityConsistent,
+		pr:                pr,
+		visibilityManager: visibilityManager,
+		domainCache:       domainCache,
+		verifyInterval:    visibilityVerifyInterval,
+	}
+}
+
+// Check checks that the visibility store has a record of a concrete execution matching its mutable state,
+// and that the other runs of the workflow which no longer exist don't have open records.
+// For an open visibility record, it checks that the execution of the record still exists.
+func (v *visibilityConsistent) Check(
+	ctx context.Context,
+	execution interface{},
+) CheckResult {
+	if checkResult := validateCheckContext(ctx, v.Name()); checkResult != nil {
+		return *checkResult
+	}
+
+	switch e := execution.(type) {
+	case *entity.ConcreteExecution:
+		_, checkResult := v.check(ctx, e)
+		return checkResult
+	case *entity.OpenVisibilityRecord:
+		return v.checkOpenRecord(ctx, e)
+	default:
+		return CheckResult{
+			CheckResultType: CheckResultTypeFailed,
+			InvariantName:   v.Name(),
+			Info:            "failed to check: expected concrete execution or open visibility record",
+		}
+	}
+}
+
+// Fix writes the record of a concrete execution again from its mutable state if it's missing or stale,
+// and deletes the open records of the other runs which no longer exist.
+// For an open visibility record, it deletes the record if its execution no longer exists.
+func (v *visibilityConsistent) Fix(
+	ctx context.Context,
+	execution interface{},
+) FixResult {
+	if fixResult := validateFixContext(ctx, v.Name()); fixResult != nil {
+		return *fixResult
+	}
+
+	switch e := execution.(type) {
+	case *entity.ConcreteExecution:
+		return v.fixConcreteExecution(ctx, e)
+	case *entity.OpenVisibilityRecord:
+		return v.fixOpenRecord(ctx, e)
+	default:
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: v.Name(),
+			Info:          "failed to fix: expected concrete execution or open visibility record",
+		}
+	}
+}
+
+func (v *visibilityConsistent) Name() Name {
+	return v.name
+}
+
+func (v *visibilityConsistent) fixConcreteExecution(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+) FixResult {
+	state, checkResult := v.check(ctx, concreteExecution)
+	if fixResult := v.skipFix(checkResult); fixResult != nil {
+		return *fixResult
+	}
+
+	if state.recordOutdated {
+		if err := v.writeRecord(ctx, concreteExecution.BranchToken, state); err != nil {
+			return FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: v.Name(),
+				CheckResult:   checkResult,
+				Info:          "failed to write visibility record",
+				InfoDetails:   err.Error(),
+			}
+		}
+	}
+	for _, runID := range state.orphanedRunIDs {
+		if err := v.deleteRecord(ctx, concreteExecution.DomainID, concreteExecution.WorkflowID, runID); err != nil {
+			return FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: v.Name(),
+				CheckResult:   checkResult,
+				Info:          "failed to delete orphaned visibility record",
+				InfoDetails:   err.Error(),
+			}
+		}
+	}
+	if state.recordOutdated {
+		// writes of a version older than the one in the store are ignored without an error,
+		// so the record is looked up again to find out if it's really updated
+		if fixResult := v.verifyRecord(ctx, concreteExecution, checkResult); fixResult != nil {
+			return *fixResult
+		}
+	}
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: v.Name(),
+		CheckResult:   checkResult,
+	}
+}
+
+func (v *visibilityConsistent) fixOpenRecord(
+	ctx context.Context,
+	record *entity.OpenVisibilityRecord,
+) FixResult {
+	checkResult := v.checkOpenRecord(ctx, record)
+	if fixResult := v.skipFix(checkResult); fixResult != nil {
+		return *fixResult
+	}
+
+	if err := v.deleteRecord(ctx, record.DomainID, record.WorkflowID, record.RunID); err != nil {
+		return FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: v.Name(),
+			CheckResult:   checkResult,
+			Info:          "failed to delete orphaned visibility record",
+			InfoDetails:   err.Error(),
+		}
+	}
+	return FixResult{
+		FixResultType: FixResultTypeFixed,
+		InvariantName: v.Name(),
+		CheckResult:   checkResult,
+	}
+}
+
+// skipFix returns the result of the fix if there is nothing to fix according to the check result
+func (v *visibilityConsistent) skipFix(checkResult CheckResult) *FixResult {
+	switch checkResult.CheckResultType {
+	case CheckResultTypeHealthy:
+		return &FixResult{
+			FixResultType: FixResultTypeSkipped,
+			InvariantName: v.Name(),
+			CheckResult:   checkResult,
+			Info:          "skipped fix because execution was healthy",
+		}
+	case CheckResultTypeFailed:
+		return &FixResult{
+			FixResultType: FixResultTypeFailed,
+			InvariantName: v.Name(),
+			CheckResult:   checkResult,
+			Info:          "failed fix because check failed",
+		}
+	default:
+		return nil
+	}
+}
+
+// verifyRecord looks up the record of the execution after it's written until it's no longer outdated,
+// and returns a failed fix result if it's still outdated after all the attempts
+func (v *visibilityConsistent) verifyRecord(
+	ctx context.Context,
+	concreteExecution *entity.ConcreteExecution,
+	checkResult CheckResult,
+) *FixResult {
+	for attempt := 1; ; attempt++ {
+		state, verifyResult := v.check(ctx, concreteExecution)
+		if verifyResult.CheckResultType == CheckResultTypeFailed {
+			return &FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: v.Name(),
+				CheckResult:   checkResult,
+				Info:          "failed to verify visibility record",
+				InfoDetails:   verifyResult.InfoDetails,
+			}
+		}
+		if state == nil || !state.recordOutdated {
+			return nil
+		}
+		if attempt == visibilityVerifyAttempts {
+			return &FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: v.Name(),
+				CheckResult:   checkResult,
+				Info:          "visibility record is not updated, the store may have a newer version of it",
+				InfoDetails:   verifyResult.Info,
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return &FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: v.Name(),
+				CheckResult:   checkResult,
+				Info:          "failed to verify visibility record",
+				InfoDetails:   ctx.Err().Error(),
+			}
+		case <-time.After(v.verifyInterval):
+		}
+	}
+}
+
+// deleteRecord deletes the open or closed record of an execution
+func (v *visibilityConsistent) deleteRecord(
+	ctx context.Context,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+	// cassandra only deletes open records when it's done by admin
+	deleteCtx := context.WithValue(ctx, persistence.VisibilityAdminDeletionKey("visibilityAdminDelete"), true)
+	return v.visibilityManager.DeleteWorkflowExecution(deleteCtx, &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+		TaskID:     math.MaxInt64,
+	})
+}
+
+// checkOpenRecord checks that the execution of an open record still exists,
+// as the records of a workflow whose runs are all deleted are never checked along with its concrete executions
+func (v *visibilityConsistent) checkOpenRecord(
+	ctx context.Context,
+	record *entity.OpenVisibilityRecord,
+) CheckResult {
+	exists, err := v.pr.IsWorkflowExecutionExists(ctx, &persistence.IsWorkflowExecutionExistsRequest{
+		DomainID:   record.DomainID,
+		WorkflowID: record.WorkflowID,
+		RunID:      record.RunID,
+	})
+	if err != nil {
+		return v.failed("failed to check if the run of an open visibility record exists", err)
+	}
+	if exists.Exists {
+		// the record is checked along with the concrete execution
+		return v.healthy()
+	}
+	domainEntry, err := v.domainCache.GetDomainByID(record.DomainID)
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			return v.healthy()
+		}
+		return v.failed("failed to get domain", err)
+	}
+	// the record may be closed or deleted since it's listed
+	openRecords, err := v.listRecords(
+		ctx,
+		record.DomainID,
+		domainEntry.GetInfo().Name,
+		record.WorkflowID,
+		true,
+		time.Unix(0, 0),
+		time.Now().Add(visibilityClockSkew),
+	)
+	if err != nil {
+		return v.failed("failed to list open visibility records", err)
+	}
+	for _, openRecord := range openRecords {
+		if openRecord.GetExecution().GetRunID() == record.RunID {
+			return CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   v.Name(),
+				Info:            "open visibility record of a run which no longer exists",
+			}
+		}
+	}
+	return v.healthy()
+}
+
+func (v *visibilityConsistent) check(
+	ctx context.Context,
+	execution *entity.ConcreteExecution,
+) (*visibilityState, CheckResult) {
+	resp, err := v.pr.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: execution.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		},
+	})
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			// the execution is deleted since it's listed
+			return nil, v.healthy()
+		}
+		return nil, v.failed("failed to get mutable state", err)
+	}
+	executionInfo := resp.State.ExecutionInfo
+	domainEntry, err := v.domainCache.GetDomainByID(executionInfo.DomainID)
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			return nil, v.healthy()
+		}
+		return nil, v.failed("failed to get domain", err)
+	}
+	isOpen := Open(executionInfo.State)
+	if !isOpen && executionInfo.State != persistence.WorkflowStateCompleted {
+		// zombie, void or corrupted workflows are not visible
+		return nil, v.healthy()
+	}
+	if domainEntry.IsSampledForLongerRetentionEnabled(executionInfo.WorkflowID) &&
+		!domainEntry.IsSampledForLongerRetention(executionInfo.WorkflowID) {
+		return nil, v.healthy()
+	}
+	now := time.Now()
+	lastChangeTime := executionInfo.LastUpdatedTimestamp
+	if isOpen {
+		lastChangeTime = executionInfo.StartTimestamp
+	}
+	if now.Sub(lastChangeTime) < visibilityWriteDelay {
+		// the record may not be written yet
+		return nil, v.healthy()
+	}
+
+	state := &visibilityState{
+		mutableState: resp.State,
+		domainEntry:  domainEntry,
+	}
+	domainName := domainEntry.GetInfo().Name
+	openRecords, err := v.listRecords(
+		ctx,
+		execution.DomainID,
+		domainName,
+		execution.WorkflowID,
+		true,
+		time.Unix(0, 0),
+		now.Add(visibilityClockSkew),
+	)
+	if err != nil {
+		return nil, v.failed("failed to list open visibility records", err)
+	}
+	var openRecord *types.WorkflowExecutionInfo
+	for _, record := range openRecords {
+		runID := record.GetExecution().GetRunID()
+		if runID == execution.RunID {
+			openRecord = record
+			continue
+		}
+		// at most one run of a workflow is open, and all its runs are in the same shard,
+		// so the open record of another run is orphaned if the run no longer exists
+		exists, err := v.pr.IsWorkflowExecutionExists(ctx, &persistence.IsWorkflowExecutionExistsRequest{
+			DomainID:   execution.DomainID,
+			WorkflowID: execution.WorkflowID,
+			RunID:      runID,
+		})
+		if err != nil {
+			return nil, v.failed("failed to check if the run of an open visibility record exists", err)
+		}
+		if !exists.Exists {
+			state.orphanedRunIDs = append(state.orphanedRunIDs, runID)
+		}
+	}
+
+	var info string
+	if isOpen {
+		if openRecord == nil {
+			stillOpen, err := ExecutionStillOpen(ctx, &execution.Execution, v.pr)
+			if err != nil {
+				return nil, v.failed("failed to check if execution is still open", err)
+			}
+			if stillOpen {
+				info = "visibility record is missing"
+			}
+		}
+	} else {
+		closedRecords, err := v.listRecords(
+			ctx,
+			execution.DomainID,
+			domainName,
+			execution.WorkflowID,
+			false,
+			executionInfo.StartTimestamp.Add(-visibilityClockSkew),
+			now.Add(visibilityClockSkew),
+		)
+		if err != nil {
+			return nil, v.failed("failed to list closed visibility records", err)
+		}
+		var closedRecord *types.WorkflowExecutionInfo
+		for _, record := range closedRecords {
+			if record.GetExecution().GetRunID() == execution.RunID {
+				closedRecord = record
+				break
+			}
+		}
+		closeStatus := persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+		switch {
+		case closedRecord == nil && openRecord == nil:
+			info = "visibility record is missing"
+		case closedRecord == nil || openRecord != nil:
+			info = "visibility record is open but execution is closed"
+		case closeStatus != nil && closedRecord.GetCloseStatus() != *closeStatus:
+			info = fmt.Sprintf("visibility record has close status %v but execution has close status %v",
+				closedRecord.GetCloseStatus(), *closeStatus)
+		}
+	}
+	state.recordOutdated = info != ""
+
+	switch {
+	case state.recordOutdated:
+		return state, CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            info,
+		}
+	case len(state.orphanedRunIDs) != 0:
+		return state, CheckResult{
+			CheckResultType: CheckResultTypeCorrupted,
+			InvariantName:   v.Name(),
+			Info:            "open visibility records of runs which no longer exist",
+			InfoDetails:     fmt.Sprintf("orphaned runs: %v", strings.Join(state.orphanedRunIDs, ", ")),
+		}
+	default:
+		return nil, v.healthy()
+	}
+}
+
+// listRecords returns the open or closed records of a workflow in a time range,
+// which is the range of start time for open records and close time for closed records
+func (v *visibilityConsistent) listRecords(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+	workflowID string,
+	open bool,
+	earliestTime time.Time,
+	latestTime time.Time,
+) ([]*types.WorkflowExecutionInfo, error) {
+	request := &persistence.ListWorkflowExecutionsByWorkflowIDRequest{
+		ListWorkflowExecutionsRequest: persistence.ListWorkflowExecutionsRequest{
+			DomainUUID:   domainID,
+			Domain:       domainName,
+			EarliestTime: earliestTime.UnixNano(),
+			LatestTime:   latestTime.UnixNano(),
+			PageSize:     visibilityListPageSize,
+		},
+		WorkflowID: workflowID,
+	}
+	var records []*types.WorkflowExecutionInfo
+	for {
+		var resp *persistence.ListWorkflowExecutionsResponse
+		var err error
+		if open {
+			resp, err = v.visibilityManager.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+		} else {
+			resp, err = v.visibilityManager.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, resp.Executions...)
+		if len(resp.NextPageToken) == 0 {
+			return records, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// writeRecord writes the record of the execution built the same way as history transfer tasks
+func (v *visibilityConsistent) writeRecord(
+	ctx context.Context,
+	branchToken []byte,
+	state *visibilityState,
+) error {
+	executionInfo := state.mutableState.ExecutionInfo
+	domainEntry := state.domainEntry
+	startEvent, err := v.getHistoryEvent(ctx, branchToken, common.FirstEventID, common.FirstEventID)
+	if err != nil {
+		return err
+	}
+
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}
+	var executionTimestamp int64
+	if backoffSeconds := startEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds(); backoffSeconds != 0 {
+		executionTimestamp = startEvent.GetTimestamp() + int64(backoffSeconds)*int64(time.Second)
+	}
+	var memo *types.Memo
+	if executionInfo.Memo != nil {
+		memo = &types.Memo{Fields: executionInfo.Memo}
+	}
+	isCron := len(executionInfo.CronSchedule) > 0
+	numClusters := int16(len(domainEntry.GetReplicationConfig().Clusters))
+
+	if Open(executionInfo.State) {
+		return v.visibilityManager.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:         executionInfo.DomainID,
+			Domain:             domainEntry.GetInfo().Name,
+			Execution:          workflowExecution,
+			WorkflowTypeName:   executionInfo.WorkflowTypeName,
+			StartTimestamp:     startEvent.GetTimestamp(),
+			ExecutionTimestamp: executionTimestamp,
+			WorkflowTimeout:    int64(executionInfo.WorkflowTimeout),
+			TaskID:             executionInfo.LastEventTaskID,
+			Memo:               memo,
+			TaskList:           executionInfo.TaskList,
+			IsCron:             isCron,
+			NumClusters:        numClusters,
+			SearchAttributes:   executionInfo.SearchAttributes,
+		})
+	}
+
+	closeStatus := persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+	if closeStatus == nil {
+		return fmt.Errorf("execution has invalid close status %v", executionInfo.CloseStatus)
+	}
+	completionEvent, err := v.getHistoryEvent(ctx, branchToken, executionInfo.CompletionEventBatchID, executionInfo.NextEventID-1)
+	if err != nil {
+		return err
+	}
+	return v.visibilityManager.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         executionInfo.DomainID,
+		Domain:             domainEntry.GetInfo().Name,
+		Execution:          workflowExecution,
+		WorkflowTypeName:   executionInfo.WorkflowTypeName,
+		StartTimestamp:     startEvent.GetTimestamp(),
+		ExecutionTimestamp: executionTimestamp,
+		CloseTimestamp:     completionEvent.GetTimestamp(),
+		Status:             *closeStatus,
+		HistoryLength:      executionInfo.NextEventID - 1,
+		RetentionSeconds:   int64(domainEntry.GetRetentionDays(executionInfo.WorkflowID)) * secondsInDay,
+		TaskID:             executionInfo.LastEventTaskID,
+		Memo:               memo,
+		TaskList:           executionInfo.TaskList,
+		SearchAttributes:   executionInfo.SearchAttributes,
+		IsCron:             isCron,
+		NumClusters:        numClusters,
+	})
+}
+
+// getHistoryEvent reads an event from the batch starting at firstEventID
+func (v *visibilityConsistent) getHistoryEvent(
+	ctx context.Context,
+	branchToken []byte,
+	firstEventID int64,
+	eventID int64,
+) (*types.HistoryEvent, error) {
+	resp, err := v.pr.ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  firstEventID,
+		MaxEventID:  eventID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(v.pr.GetShardID()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range resp.HistoryEvents {
+		if event.ID == eventID {
+			return event, nil
+		}
+	}
+	return nil, fmt.Errorf("history event %v not found", eventID)
+}
+
+func (v *visibilityConsistent) healthy() CheckResult {
+	return CheckResult{
+		CheckResultType: CheckResultTypeHealthy,
+		InvariantName:   v.Name(),
+	}
+}
+
+func (v *visibilityConsistent) failed(info string, err error) CheckResult {
+	return CheckResult{
+		CheckResultType: CheckResultTypeFailed,
+		InvariantName:   v.Name(),
+		Info:            info,
+		InfoDetails:     err.Error(),
+	}
+}
@@ -0,0 +1,592 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package invariant
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	orphanedRunID = "test-orphaned-run-id"
+	testDomain    = "test-domain"
+)
+
+type VisibilityConsistentSuite struct {
+	suite.Suite
+
+	controller *gomock.Controller
+}
+
+func TestVisibilityConsistentSuite(t *testing.T) {
+	suite.Run(t, new(VisibilityConsistentSuite))
+}
+
+func (s *VisibilityConsistentSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+}
+
+func (s *VisibilityConsistentSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *VisibilityConsistentSuite) TestCheck() {
+	now := time.Now()
+	longAgo := now.Add(-time.Hour)
+	testCases := []struct {
+		name           string
+		entity         interface{}
+		getExecResp    *persistence.GetWorkflowExecutionResponse
+		getExecErr     error
+		openRecords    []*types.WorkflowExecutionInfo
+		closedRecords  []*types.WorkflowExecutionInfo
+		orphanExists   bool
+		expectedResult CheckResult
+	}{
+		{
+			name:   "not a concrete execution",
+			entity: &entity.Timer{},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "failed to check: expected concrete execution or open visibility record",
+			},
+		},
+		{
+			name:       "execution does not exist",
+			entity:     getOpenConcreteExecution(),
+			getExecErr: &types.EntityNotExistsError{},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:       "failed to get execution",
+			entity:     getOpenConcreteExecution(),
+			getExecErr: errors.New("random error"),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "failed to get mutable state",
+				InfoDetails:     "random error",
+			},
+		},
+		{
+			name:        "execution started recently",
+			entity:      getOpenConcreteExecution(),
+			getExecResp: getMutableState(openState, persistence.WorkflowCloseStatusNone, now, now),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:        "zombie execution",
+			entity:      getOpenConcreteExecution(),
+			getExecResp: getMutableState(persistence.WorkflowStateZombie, persistence.WorkflowCloseStatusNone, longAgo, longAgo),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:        "open execution with open record",
+			entity:      getOpenConcreteExecution(),
+			getExecResp: getMutableState(openState, persistence.WorkflowCloseStatusNone, longAgo, longAgo),
+			openRecords: []*types.WorkflowExecutionInfo{getRecord(runID, nil)},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:        "open execution without record",
+			entity:      getOpenConcreteExecution(),
+			getExecResp: getMutableState(openState, persistence.WorkflowCloseStatusNone, longAgo, longAgo),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "visibility record is missing",
+			},
+		},
+		{
+			name:        "open record of run which no longer exists",
+			entity:      getOpenConcreteExecution(),
+			getExecResp: getMutableState(openState, persistence.WorkflowCloseStatusNone, longAgo, longAgo),
+			openRecords: []*types.WorkflowExecutionInfo{getRecord(runID, nil), getRecord(orphanedRunID, nil)},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "open visibility records of runs which no longer exist",
+				InfoDetails:     "orphaned runs: " + orphanedRunID,
+			},
+		},
+		{
+			name:         "open record of run which still exists",
+			entity:       getOpenConcreteExecution(),
+			getExecResp:  getMutableState(openState, persistence.WorkflowCloseStatusNone, longAgo, longAgo),
+			openRecords:  []*types.WorkflowExecutionInfo{getRecord(runID, nil), getRecord(orphanedRunID, nil)},
+			orphanExists: true,
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:          "closed execution with closed record",
+			entity:        getClosedConcreteExecution(),
+			getExecResp:   getMutableState(closedState, persistence.WorkflowCloseStatusCompleted, longAgo, longAgo),
+			closedRecords: []*types.WorkflowExecutionInfo{getRecord(runID, types.WorkflowExecutionCloseStatusCompleted.Ptr())},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:        "closed execution with open record",
+			entity:      getClosedConcreteExecution(),
+			getExecResp: getMutableState(closedState, persistence.WorkflowCloseStatusCompleted, longAgo, longAgo),
+			openRecords: []*types.WorkflowExecutionInfo{getRecord(runID, nil)},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "visibility record is open but execution is closed",
+			},
+		},
+		{
+			name:        "closed execution without record",
+			entity:      getClosedConcreteExecution(),
+			getExecResp: getMutableState(closedState, persistence.WorkflowCloseStatusCompleted, longAgo, longAgo),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "visibility record is missing",
+			},
+		},
+		{
+			name:          "closed execution with different close status",
+			entity:        getClosedConcreteExecution(),
+			getExecResp:   getMutableState(closedState, persistence.WorkflowCloseStatusCompleted, longAgo, longAgo),
+			closedRecords: []*types.WorkflowExecutionInfo{getRecord(runID, types.WorkflowExecutionCloseStatusFailed.Ptr())},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "visibility record has close status FAILED but execution has close status COMPLETED",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			execManager := &mocks.ExecutionManager{}
+			execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(tc.getExecResp, tc.getExecErr)
+			execManager.On("IsWorkflowExecutionExists", mock.Anything, mock.Anything).
+				Return(&persistence.IsWorkflowExecutionExistsResponse{Exists: tc.orphanExists}, nil)
+			visibilityManager := &mocks.VisibilityManager{}
+			visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{Executions: tc.openRecords}, nil)
+			visibilityManager.On("ListClosedWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{Executions: tc.closedRecords}, nil)
+			i := NewDBVisibilityConsistent(
+				persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy()),
+				visibilityManager,
+				s.getDomainCache(),
+			)
+			s.Equal(tc.expectedResult, i.Check(context.Background(), tc.entity))
+		})
+	}
+}
+
+func (s *VisibilityConsistentSuite) TestFix() {
+	longAgo := time.Now().Add(-time.Hour)
+	testCases := []struct {
+		name           string
+		openRecords    []*types.WorkflowExecutionInfo
+		deleteErr      error
+		expectDelete   bool
+		expectedResult FixResult
+	}{
+		{
+			name:        "healthy execution",
+			openRecords: []*types.WorkflowExecutionInfo{getRecord(runID, nil)},
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeSkipped,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeHealthy,
+					InvariantName:   ESVisibilityConsistent,
+				},
+				Info: "skipped fix because execution was healthy",
+			},
+		},
+		{
+			name:         "orphaned record is deleted",
+			openRecords:  []*types.WorkflowExecutionInfo{getRecord(runID, nil), getRecord(orphanedRunID, nil)},
+			expectDelete: true,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeFixed,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   ESVisibilityConsistent,
+					Info:            "open visibility records of runs which no longer exist",
+					InfoDetails:     "orphaned runs: " + orphanedRunID,
+				},
+			},
+		},
+		{
+			name:         "failed to delete orphaned record",
+			openRecords:  []*types.WorkflowExecutionInfo{getRecord(runID, nil), getRecord(orphanedRunID, nil)},
+			deleteErr:    errors.New("random error"),
+			expectDelete: true,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   ESVisibilityConsistent,
+					Info:            "open visibility records of runs which no longer exist",
+					InfoDetails:     "orphaned runs: " + orphanedRunID,
+				},
+				Info:        "failed to delete orphaned visibility record",
+				InfoDetails: "random error",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			execManager := &mocks.ExecutionManager{}
+			execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+				Return(getMutableState(openState, persistence.WorkflowCloseStatusNone, longAgo, longAgo), nil)
+			execManager.On("IsWorkflowExecutionExists", mock.Anything, mock.Anything).
+				Return(&persistence.IsWorkflowExecutionExistsResponse{Exists: false}, nil)
+			visibilityManager := &mocks.VisibilityManager{}
+			visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{Executions: tc.openRecords}, nil)
+			if tc.expectDelete {
+				visibilityManager.On("DeleteWorkflowExecution", mock.Anything, mock.MatchedBy(
+					func(request *persistence.VisibilityDeleteWorkflowExecutionRequest) bool {
+						return request.RunID == orphanedRunID
+					},
+				)).Return(tc.deleteErr).Once()
+			}
+			i := NewESVisibilityConsistent(
+				persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy()),
+				visibilityManager,
+				s.getDomainCache(),
+			)
+			s.Equal(tc.expectedResult, i.Fix(context.Background(), getOpenConcreteExecution()))
+			visibilityManager.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *VisibilityConsistentSuite) TestFixRecordOutdated() {
+	longAgo := time.Now().Add(-time.Hour)
+	testCases := []struct {
+		name           string
+		recordUpdated  bool
+		expectedResult FixResult
+	}{
+		{
+			name:          "record is written",
+			recordUpdated: true,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeFixed,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   ESVisibilityConsistent,
+					Info:            "visibility record is missing",
+				},
+			},
+		},
+		{
+			name:          "write of record is ignored",
+			recordUpdated: false,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   ESVisibilityConsistent,
+					Info:            "visibility record is missing",
+				},
+				Info:        "visibility record is not updated, the store may have a newer version of it",
+				InfoDetails: "visibility record is missing",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			execManager := &mocks.ExecutionManager{}
+			execManager.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+				Return(getMutableState(openState, persistence.WorkflowCloseStatusNone, longAgo, longAgo), nil)
+			historyManager := &mocks.HistoryV2Manager{}
+			historyManager.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+				Return(&persistence.ReadHistoryBranchResponse{
+					HistoryEvents: []*types.HistoryEvent{{
+						ID:                                      common.FirstEventID,
+						Timestamp:                               common.Int64Ptr(longAgo.UnixNano()),
+						WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{},
+					}},
+				}, nil)
+			visibilityManager := &mocks.VisibilityManager{}
+			visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{}, nil).Once()
+			var recordsAfterWrite []*types.WorkflowExecutionInfo
+			if tc.recordUpdated {
+				recordsAfterWrite = []*types.WorkflowExecutionInfo{getRecord(runID, nil)}
+			}
+			visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{Executions: recordsAfterWrite}, nil)
+			visibilityManager.On("RecordWorkflowExecutionStarted", mock.Anything, mock.MatchedBy(
+				func(request *persistence.RecordWorkflowExecutionStartedRequest) bool {
+					return request.Execution.GetRunID() == runID && request.StartTimestamp == longAgo.UnixNano()
+				},
+			)).Return(nil).Once()
+			i := NewESVisibilityConsistent(
+				persistence.NewPersistenceRetryer(execManager, historyManager, common.CreatePersistenceRetryPolicy()),
+				visibilityManager,
+				s.getDomainCache(),
+			)
+			i.(*visibilityConsistent).verifyInterval = 0
+			s.Equal(tc.expectedResult, i.Fix(context.Background(), getOpenConcreteExecution()))
+			visibilityManager.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *VisibilityConsistentSuite) TestCheckOpenRecord() {
+	testCases := []struct {
+		name           string
+		exists         bool
+		existsErr      error
+		openRecords    []*types.WorkflowExecutionInfo
+		expectedResult CheckResult
+	}{
+		{
+			name:   "execution exists",
+			exists: true,
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+		{
+			name:      "failed to check if execution exists",
+			existsErr: errors.New("random error"),
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeFailed,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "failed to check if the run of an open visibility record exists",
+				InfoDetails:     "random error",
+			},
+		},
+		{
+			name:        "execution does not exist",
+			openRecords: []*types.WorkflowExecutionInfo{getRecord(runID, nil)},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeCorrupted,
+				InvariantName:   DBVisibilityConsistent,
+				Info:            "open visibility record of a run which no longer exists",
+			},
+		},
+		{
+			name:        "record is no longer open",
+			openRecords: []*types.WorkflowExecutionInfo{getRecord(orphanedRunID, nil)},
+			expectedResult: CheckResult{
+				CheckResultType: CheckResultTypeHealthy,
+				InvariantName:   DBVisibilityConsistent,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			execManager := &mocks.ExecutionManager{}
+			execManager.On("IsWorkflowExecutionExists", mock.Anything, mock.MatchedBy(
+				func(request *persistence.IsWorkflowExecutionExistsRequest) bool {
+					return request.DomainID == domainID && request.WorkflowID == workflowID && request.RunID == runID
+				},
+			)).Return(&persistence.IsWorkflowExecutionExistsResponse{Exists: tc.exists}, tc.existsErr)
+			visibilityManager := &mocks.VisibilityManager{}
+			visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{Executions: tc.openRecords}, nil)
+			i := NewDBVisibilityConsistent(
+				persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy()),
+				visibilityManager,
+				s.getDomainCache(),
+			)
+			s.Equal(tc.expectedResult, i.Check(context.Background(), getOpenVisibilityRecord()))
+		})
+	}
+}
+
+func (s *VisibilityConsistentSuite) TestFixOpenRecord() {
+	testCases := []struct {
+		name           string
+		exists         bool
+		deleteErr      error
+		expectDelete   bool
+		expectedResult FixResult
+	}{
+		{
+			name:   "execution exists",
+			exists: true,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeSkipped,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeHealthy,
+					InvariantName:   ESVisibilityConsistent,
+				},
+				Info: "skipped fix because execution was healthy",
+			},
+		},
+		{
+			name:         "orphaned record is deleted",
+			expectDelete: true,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeFixed,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   ESVisibilityConsistent,
+					Info:            "open visibility record of a run which no longer exists",
+				},
+			},
+		},
+		{
+			name:         "failed to delete orphaned record",
+			deleteErr:    errors.New("random error"),
+			expectDelete: true,
+			expectedResult: FixResult{
+				FixResultType: FixResultTypeFailed,
+				InvariantName: ESVisibilityConsistent,
+				CheckResult: CheckResult{
+					CheckResultType: CheckResultTypeCorrupted,
+					InvariantName:   ESVisibilityConsistent,
+					Info:            "open visibility record of a run which no longer exists",
+				},
+				Info:        "failed to delete orphaned visibility record",
+				InfoDetails: "random error",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			execManager := &mocks.ExecutionManager{}
+			execManager.On("IsWorkflowExecutionExists", mock.Anything, mock.Anything).
+				Return(&persistence.IsWorkflowExecutionExistsResponse{Exists: tc.exists}, nil)
+			visibilityManager := &mocks.VisibilityManager{}
+			visibilityManager.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything, mock.Anything).
+				Return(&persistence.ListWorkflowExecutionsResponse{
+					Executions: []*types.WorkflowExecutionInfo{getRecord(runID, nil)},
+				}, nil)
+			if tc.expectDelete {
+				visibilityManager.On("DeleteWorkflowExecution", mock.Anything, mock.MatchedBy(
+					func(request *persistence.VisibilityDeleteWorkflowExecutionRequest) bool {
+						return request.WorkflowID == workflowID && request.RunID == runID
+					},
+				)).Return(tc.deleteErr).Once()
+			}
+			i := NewESVisibilityConsistent(
+				persistence.NewPersistenceRetryer(execManager, nil, common.CreatePersistenceRetryPolicy()),
+				visibilityManager,
+				s.getDomainCache(),
+			)
+			s.Equal(tc.expectedResult, i.Fix(context.Background(), getOpenVisibilityRecord()))
+			visibilityManager.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *VisibilityConsistentSuite) getDomainCache() cache.DomainCache {
+	domainCache := cache.NewMockDomainCache(s.controller)
+	domainCache.EXPECT().GetDomainByID(domainID).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: testDomain},
+		&persistence.DomainConfig{},
+		"",
+		nil,
+	), nil).AnyTimes()
+	return domainCache
+}
+
+func getMutableState(
+	state int,
+	closeStatus int,
+	startTime time.Time,
+	lastUpdatedTime time.Time,
+) *persistence.GetWorkflowExecutionResponse {
+	return &persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:             domainID,
+				WorkflowID:           workflowID,
+				RunID:                runID,
+				State:                state,
+				CloseStatus:          closeStatus,
+				StartTimestamp:       startTime,
+				LastUpdatedTimestamp: lastUpdatedTime,
+			},
+		},
+	}
+}
+
+func getRecord(recordRunID string, closeStatus *types.WorkflowExecutionCloseStatus) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      recordRunID,
+		},
+		CloseStatus: closeStatus,
+	}
+}
+
+func getOpenVisibilityRecord() *entity.OpenVisibilityRecord {
+	return &entity.OpenVisibilityRecord{
+		Execution: entity.Execution{
+			ShardID:    shardID,
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+			State:      persistence.WorkflowStateRunning,
+		},
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/pagination"
@@ -39,14 +40,15 @@ type (
 )
 
 // NewBlobstoreIterator constructs a new iterator backed by blobstore.
+// Each output is read as the first of the given entity types it's valid for.
 func NewBlobstoreIterator(
 	ctx context.Context,
 	client blobstore.Client,
 	keys Keys,
-	entity entity.Entity,
+	entities ...entity.Entity,
 ) ScanOutputIterator {
 	return &blobstoreIterator{
-		itr: pagination.NewIterator(ctx, keys.MinPage, getBlobstoreFetchPageFn(client, keys, entity)),
+		itr: pagination.NewIterator(ctx, keys.MinPage, getBlobstoreFetchPageFn(client, keys, entities)),
 	}
 }
 
@@ -67,7 +69,7 @@ func (i *blobstoreIterator) HasNext() bool {
 func getBlobstoreFetchPageFn(
 	client blobstore.Client,
 	keys Keys,
-	entity entity.Entity,
+	entities []entity.Entity,
 ) pagination.FetchFn {
 	return func(ctx context.Context, token pagination.PageToken) (pagination.Page, error) {
 		index := token.(int)
@@ -85,7 +87,7 @@ func getBlobstoreFetchPageFn(
 			if len(p) == 0 {
 				continue
 			}
-			soe, err := deserialize(p, entity)
+			soe, err := deserialize(p, entities)
 			if err != nil {
 				return pagination.Page{}, err
 			}
@@ -103,17 +105,23 @@ func getBlobstoreFetchPageFn(
 	}
 }
 
-func deserialize(data []byte, blob entity.Entity) (*ScanOutputEntity, error) {
-	soe := &ScanOutputEntity{
-		Execution: blob.Clone(),
-	}
+func deserialize(data []byte, blobs []entity.Entity) (*ScanOutputEntity, error) {
+	var err error
+	for _, blob := range blobs {
+		soe := &ScanOutputEntity{
+			Execution: blob.Clone(),
+		}
 
-	if err := json.Unmarshal(data, soe); err != nil {
-		return nil, err
-	}
+		if err = json.Unmarshal(data, soe); err != nil {
+			return nil, err
+		}
 
-	if err := soe.Execution.(entity.Entity).Validate(); err != nil {
-		return nil, err
+		if err = soe.Execution.(entity.Entity).Validate(); err == nil {
+			return soe, nil
+		}
+	}
+	if err == nil {
+		err = errors.New("no entity type to deserialize into")
 	}
-	return soe, nil
+	return nil, err
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
@@ -56,6 +57,7 @@ type (
 		ReplicatorConfig         config.Replicator
 		MetricsClient            metrics.Client
 		MessagingClient          messaging.Client
+		KafkaVisibilityEnabled   bool // NOTE: MessagingClient can be created for audit logs only, visibility goes through Kafka only if this is true
 		BlobstoreClient          blobstore.Client
 		ESClient                 es.GenericClient
 		ESConfig                 *config.ElasticSearchConfig
@@ -66,5 +68,7 @@ type (
 		ArchiverProvider         provider.ArchiverProvider
 		Authorizer               authorization.Authorizer // NOTE: this can be nil. If nil, AccessControlledHandlerImpl will initiate one with config.Authorization
 		AuthorizationConfig      config.Authorization     // NOTE: empty(default) struct will get a authorization.NoopAuthorizer
+		AuditLogger              audit.Logger             // NOTE: this can be nil. If nil, frontend service will initiate one with config.Audit
+		AuditConfig              config.Audit             // NOTE: empty(default) struct will get a no-op audit logger
 	}
 )
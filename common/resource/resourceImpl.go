@@ -170,11 +170,12 @@ func New(
 		params.MetricsClient,
 		logger,
 	), &persistenceClient.Params{
-		PersistenceConfig: params.PersistenceConfig,
-		MetricsClient:     params.MetricsClient,
-		MessagingClient:   params.MessagingClient,
-		ESClient:          params.ESClient,
-		ESConfig:          params.ESConfig,
+		PersistenceConfig:      params.PersistenceConfig,
+		MetricsClient:          params.MetricsClient,
+		MessagingClient:        params.MessagingClient,
+		KafkaVisibilityEnabled: params.KafkaVisibilityEnabled,
+		ESClient:               params.ESClient,
+		ESConfig:               params.ESConfig,
 	}, serviceConfig)
 	if err != nil {
 		return nil, err
@@ -21,6 +21,7 @@
 package common
 
 import (
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -73,6 +74,18 @@ func LoadRSAPublicKey(path string) (*rsa.PublicKey, error) {
 	return key.(*rsa.PublicKey), err
 }
 
+func LoadECDSAPublicKey(path string) (*ecdsa.PublicKey, error) {
+	key, err := loadRSAKey(path, KeyTypePublic)
+	if err != nil {
+		return nil, err
+	}
+	ecdsaKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s %s is not an ECDSA key", KeyTypePublic, path)
+	}
+	return ecdsaKey, nil
+}
+
 func LoadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
 	key, err := loadRSAKey(path, KeyTypePrivate)
 	if err != nil {
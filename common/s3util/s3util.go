@@ -0,0 +1,92 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package s3util contains the helpers shared by the S3 backed archiver and blobstore
+package s3util
+
+import (
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/uber/cadence/common/config"
+)
+
+// NewSession creates an AWS session from the S3 config
+func NewSession(cfg *config.S3Archiver) (*session.Session, error) {
+	s3Config := &aws.Config{
+		Endpoint:         cfg.Endpoint,
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+	}
+	if cfg.TLS.Enabled {
+		tlsConfig, err := cfg.TLS.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		s3Config.HTTPClient = &http.Client{Transport: transport}
+	}
+	return session.NewSession(s3Config)
+}
+
+// IsNotFoundError returns true if the error is returned for a missing object or bucket
+func IsNotFoundError(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		if aerr.Code() == s3.ErrCodeNoSuchKey || aerr.Code() == "NotFound" {
+			return true
+		}
+	}
+	if rerr, ok := err.(awserr.RequestFailure); ok {
+		return rerr.StatusCode() == http.StatusNotFound
+	}
+	return false
+}
+
+// IsRetryableError returns true if the error is retryable false otherwise
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if aerr, ok := err.(awserr.Error); ok {
+		return isStatusCodeRetryable(aerr) || request.IsErrorRetryable(aerr) || request.IsErrorThrottle(aerr)
+	}
+	return false
+}
+
+func isStatusCodeRetryable(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		if rerr, ok := err.(awserr.RequestFailure); ok {
+			if rerr.StatusCode() == http.StatusTooManyRequests {
+				return true
+			}
+			if rerr.StatusCode() >= http.StatusInternalServerError && rerr.StatusCode() != http.StatusNotImplemented {
+				return true
+			}
+		}
+		return isStatusCodeRetryable(aerr.OrigErr())
+	}
+	return false
+}
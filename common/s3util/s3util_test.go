@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3util
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsNotFoundError(t *testing.T) {
+	assert.True(t, IsNotFoundError(awserr.New(s3.ErrCodeNoSuchKey, "", nil)))
+	assert.True(t, IsNotFoundError(awserr.New("NotFound", "", nil)))
+	assert.True(t, IsNotFoundError(awserr.NewRequestFailure(awserr.New("Unknown", "", nil), http.StatusNotFound, "")))
+	assert.False(t, IsNotFoundError(awserr.New("NoSuchBucketPolicy", "", nil)))
+	assert.False(t, IsNotFoundError(errors.New("NotFound")))
+	assert.False(t, IsNotFoundError(nil))
+}
+
+func TestIsRetryableError(t *testing.T) {
+	assert.True(t, IsRetryableError(awserr.NewRequestFailure(awserr.New("Unknown", "", nil), http.StatusTooManyRequests, "")))
+	assert.True(t, IsRetryableError(awserr.NewRequestFailure(awserr.New("Unknown", "", nil), http.StatusServiceUnavailable, "")))
+	assert.True(t, IsRetryableError(awserr.New(request.ErrCodeResponseTimeout, "", nil)))
+	assert.True(t, IsRetryableError(awserr.New("Throttling", "", nil)))
+	assert.False(t, IsRetryableError(awserr.NewRequestFailure(awserr.New("Unknown", "", nil), http.StatusNotImplemented, "")))
+	assert.False(t, IsRetryableError(awserr.NewRequestFailure(awserr.New(s3.ErrCodeNoSuchKey, "", nil), http.StatusNotFound, "")))
+	assert.False(t, IsRetryableError(errors.New("error")))
+	assert.False(t, IsRetryableError(nil))
+}
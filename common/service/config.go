@@ -45,5 +45,11 @@ type (
 		ESIndexMaxResultWindow dynamicconfig.IntPropertyFn                 `yaml:"-" json:"-"`
 		ValidSearchAttributes  dynamicconfig.MapPropertyFn                 `yaml:"-" json:"-"`
 		ESVisibilityListMaxQPS dynamicconfig.IntPropertyFnWithDomainFilter `yaml:"-" json:"-"`
+
+		// configs for writing es visibility without kafka
+		EnableESVisibilityDirectWrite        dynamicconfig.BoolPropertyFn     `yaml:"-" json:"-"`
+		ESVisibilityDirectWriteNumOfWorkers  dynamicconfig.IntPropertyFn      `yaml:"-" json:"-"`
+		ESVisibilityDirectWriteBulkActions   dynamicconfig.IntPropertyFn      `yaml:"-" json:"-"`
+		ESVisibilityDirectWriteFlushInterval dynamicconfig.DurationPropertyFn `yaml:"-" json:"-"`
 	}
 )
@@ -532,6 +532,31 @@ func (v *AdminDeleteWorkflowResponse) GetVisibilityDeleted() (o bool) {
 type AdminMaintainWorkflowRequest = AdminDeleteWorkflowRequest
 type AdminMaintainWorkflowResponse = AdminDeleteWorkflowResponse
 
+type AdminSearchArchivedHistoryRequest = ListArchivedWorkflowExecutionsRequest
+type AdminSearchArchivedHistoryResponse = ListArchivedWorkflowExecutionsResponse
+
+// AdminRestoreWorkflowRequest is an internal type (TBD...)
+type AdminRestoreWorkflowRequest struct {
+	Domain    string             `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *AdminRestoreWorkflowRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetExecution is an internal getter (TBD...)
+func (v *AdminRestoreWorkflowRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+	return
+}
+
 type ListDynamicConfigRequest struct {
 	ConfigName string `json:"configName,omitempty"`
 }
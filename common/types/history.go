@@ -1097,6 +1097,28 @@ func (v *HistoryRefreshWorkflowTasksRequest) GetRequest() (o *RefreshWorkflowTas
 	return
 }
 
+// HistoryRestoreWorkflowRequest is an internal type (TBD...)
+type HistoryRestoreWorkflowRequest struct {
+	DomainUUID string                       `json:"domainUUID,omitempty"`
+	Request    *AdminRestoreWorkflowRequest `json:"request,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistoryRestoreWorkflowRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetRequest is an internal getter (TBD...)
+func (v *HistoryRestoreWorkflowRequest) GetRequest() (o *AdminRestoreWorkflowRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+	return
+}
+
 // RemoveSignalMutableStateRequest is an internal type (TBD...)
 type RemoveSignalMutableStateRequest struct {
 	DomainUUID        string             `json:"domainUUID,omitempty"`
@@ -947,6 +947,76 @@ func ToAdminMaintainWorkflowResponse(t *adminv1.AdminMaintainWorkflowResponse) *
 	}
 }
 
+// FromAdminRestoreWorkflowRequest converts internal AdminRestoreWorkflowRequest type to proto
+func FromAdminRestoreWorkflowRequest(t *types.AdminRestoreWorkflowRequest) *adminv1.RestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.RestoreWorkflowRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToAdminRestoreWorkflowRequest converts proto RestoreWorkflowRequest type to internal
+func ToAdminRestoreWorkflowRequest(t *adminv1.RestoreWorkflowRequest) *types.AdminRestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AdminRestoreWorkflowRequest{
+		Domain:    t.Domain,
+		Execution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromAdminSearchArchivedHistoryRequest converts internal AdminSearchArchivedHistoryRequest type to proto
+func FromAdminSearchArchivedHistoryRequest(t *types.AdminSearchArchivedHistoryRequest) *adminv1.SearchArchivedHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.SearchArchivedHistoryRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+		Query:         t.Query,
+	}
+}
+
+// ToAdminSearchArchivedHistoryRequest converts proto SearchArchivedHistoryRequest type to internal
+func ToAdminSearchArchivedHistoryRequest(t *adminv1.SearchArchivedHistoryRequest) *types.AdminSearchArchivedHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AdminSearchArchivedHistoryRequest{
+		Domain:        t.Domain,
+		PageSize:      t.PageSize,
+		NextPageToken: t.NextPageToken,
+		Query:         t.Query,
+	}
+}
+
+// FromAdminSearchArchivedHistoryResponse converts internal AdminSearchArchivedHistoryResponse type to proto
+func FromAdminSearchArchivedHistoryResponse(t *types.AdminSearchArchivedHistoryResponse) *adminv1.SearchArchivedHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.SearchArchivedHistoryResponse{
+		Executions:    FromWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// ToAdminSearchArchivedHistoryResponse converts proto SearchArchivedHistoryResponse type to internal
+func ToAdminSearchArchivedHistoryResponse(t *adminv1.SearchArchivedHistoryResponse) *types.AdminSearchArchivedHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.AdminSearchArchivedHistoryResponse{
+		Executions:    ToWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
 //FromListDynamicConfigRequest converts internal ListDynamicConfigRequest type to proto
 func FromListDynamicConfigRequest(t *types.ListDynamicConfigRequest) *adminv1.ListDynamicConfigRequest {
 	if t == nil {
@@ -157,6 +157,21 @@ func TestAdminRefreshWorkflowTasksRequest(t *testing.T) {
 		assert.Equal(t, item, ToAdminRefreshWorkflowTasksRequest(FromAdminRefreshWorkflowTasksRequest(item)))
 	}
 }
+func TestAdminRestoreWorkflowRequest(t *testing.T) {
+	for _, item := range []*types.AdminRestoreWorkflowRequest{nil, {}, &testdata.AdminRestoreWorkflowRequest} {
+		assert.Equal(t, item, ToAdminRestoreWorkflowRequest(FromAdminRestoreWorkflowRequest(item)))
+	}
+}
+func TestAdminSearchArchivedHistoryRequest(t *testing.T) {
+	for _, item := range []*types.AdminSearchArchivedHistoryRequest{nil, {}, &testdata.AdminSearchArchivedHistoryRequest} {
+		assert.Equal(t, item, ToAdminSearchArchivedHistoryRequest(FromAdminSearchArchivedHistoryRequest(item)))
+	}
+}
+func TestAdminSearchArchivedHistoryResponse(t *testing.T) {
+	for _, item := range []*types.AdminSearchArchivedHistoryResponse{nil, {}, &testdata.AdminSearchArchivedHistoryResponse} {
+		assert.Equal(t, item, ToAdminSearchArchivedHistoryResponse(FromAdminSearchArchivedHistoryResponse(item)))
+	}
+}
 func TestAdminRemoveTaskRequest(t *testing.T) {
 	for _, item := range []*types.RemoveTaskRequest{nil, {}, &testdata.AdminRemoveTaskRequest} {
 		assert.Equal(t, item, ToAdminRemoveTaskRequest(FromAdminRemoveTaskRequest(item)))
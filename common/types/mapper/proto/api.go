@@ -1074,6 +1074,9 @@ func FromDescribeDomainResponseDomain(t *types.DescribeDomainResponse) *apiv1.Do
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		if config.ArchivalRetentionPeriodInDays != 0 {
+			domain.XXX_unrecognized = appendDurationField(nil, domainArchivalRetentionPeriodField, daysToDuration(&config.ArchivalRetentionPeriodInDays))
+		}
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -1115,6 +1118,7 @@ func ToDescribeDomainResponseDomain(t *apiv1.Domain) *types.DescribeDomainRespon
 			HistoryArchivalURI:                     t.HistoryArchivalUri,
 			VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalUri,
+			ArchivalRetentionPeriodInDays:          common.Int32Default(durationToDays(durationField(t.XXX_unrecognized, domainArchivalRetentionPeriodField))),
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.ActiveClusterName,
@@ -2483,7 +2487,7 @@ func FromRegisterDomainRequest(t *types.RegisterDomainRequest) *apiv1.RegisterDo
 	if t == nil {
 		return nil
 	}
-	return &apiv1.RegisterDomainRequest{
+	request := apiv1.RegisterDomainRequest{
 		Name:                             t.Name,
 		Description:                      t.Description,
 		OwnerEmail:                       t.OwnerEmail,
@@ -2498,6 +2502,10 @@ func FromRegisterDomainRequest(t *types.RegisterDomainRequest) *apiv1.RegisterDo
 		VisibilityArchivalStatus:         FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalUri:            t.VisibilityArchivalURI,
 	}
+	if t.ArchivalRetentionPeriodInDays != 0 {
+		request.XXX_unrecognized = appendDurationField(nil, registerDomainRequestArchivalRetentionPeriodField, daysToDuration(&t.ArchivalRetentionPeriodInDays))
+	}
+	return &request
 }
 
 func ToRegisterDomainRequest(t *apiv1.RegisterDomainRequest) *types.RegisterDomainRequest {
@@ -2519,6 +2527,7 @@ func ToRegisterDomainRequest(t *apiv1.RegisterDomainRequest) *types.RegisterDoma
 		HistoryArchivalURI:                     t.HistoryArchivalUri,
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.VisibilityArchivalUri,
+		ArchivalRetentionPeriodInDays:          common.Int32Default(durationToDays(durationField(t.XXX_unrecognized, registerDomainRequestArchivalRetentionPeriodField))),
 	}
 }
 
@@ -4026,6 +4035,7 @@ const (
 	DomainUpdateClustersField                 = "clusters"
 	DomainUpdateDeleteBadBinaryField          = "delete_bad_binary"
 	DomainUpdateFailoverTimeoutField          = "failover_timeout"
+	DomainUpdateArchivalRetentionPeriodField  = "archival_retention_period"
 )
 
 func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
@@ -4091,6 +4101,10 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *apiv1.UpdateDomainRe
 		request.FailoverTimeout = secondsToDuration(t.FailoverTimeoutInSeconds)
 		fields = append(fields, DomainUpdateFailoverTimeoutField)
 	}
+	if t.ArchivalRetentionPeriodInDays != nil {
+		request.XXX_unrecognized = appendDurationField(request.XXX_unrecognized, updateDomainRequestArchivalRetentionPeriodField, daysToDuration(t.ArchivalRetentionPeriodInDays))
+		fields = append(fields, DomainUpdateArchivalRetentionPeriodField)
+	}
 
 	request.UpdateMask = newFieldMask(fields)
 
@@ -4146,6 +4160,9 @@ func ToUpdateDomainRequest(t *apiv1.UpdateDomainRequest) *types.UpdateDomainRequ
 	if fs.isSet(DomainUpdateFailoverTimeoutField) {
 		request.FailoverTimeoutInSeconds = durationToSeconds(t.FailoverTimeout)
 	}
+	if fs.isSet(DomainUpdateArchivalRetentionPeriodField) {
+		request.ArchivalRetentionPeriodInDays = durationToDays(durationField(t.XXX_unrecognized, updateDomainRequestArchivalRetentionPeriodField))
+	}
 
 	return &request
 }
@@ -4173,6 +4190,9 @@ func FromUpdateDomainResponse(t *types.UpdateDomainResponse) *apiv1.UpdateDomain
 		domain.HistoryArchivalUri = config.HistoryArchivalURI
 		domain.VisibilityArchivalStatus = FromArchivalStatus(config.VisibilityArchivalStatus)
 		domain.VisibilityArchivalUri = config.VisibilityArchivalURI
+		if config.ArchivalRetentionPeriodInDays != 0 {
+			domain.XXX_unrecognized = appendDurationField(nil, domainArchivalRetentionPeriodField, daysToDuration(&config.ArchivalRetentionPeriodInDays))
+		}
 	}
 	if repl := t.ReplicationConfiguration; repl != nil {
 		domain.ActiveClusterName = repl.ActiveClusterName
@@ -4204,6 +4224,7 @@ func ToUpdateDomainResponse(t *apiv1.UpdateDomainResponse) *types.UpdateDomainRe
 			HistoryArchivalURI:                     t.Domain.HistoryArchivalUri,
 			VisibilityArchivalStatus:               ToArchivalStatus(t.Domain.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.Domain.VisibilityArchivalUri,
+			ArchivalRetentionPeriodInDays:          common.Int32Default(durationToDays(durationField(t.Domain.XXX_unrecognized, domainArchivalRetentionPeriodField))),
 		},
 		ReplicationConfiguration: &types.DomainReplicationConfiguration{
 			ActiveClusterName: t.Domain.ActiveClusterName,
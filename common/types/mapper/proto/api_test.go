@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"github.com/uber/cadence/common"
@@ -210,6 +211,13 @@ func TestDescribeDomainResponse_Domain(t *testing.T) {
 		assert.Equal(t, item, ToDescribeDomainResponseDomain(FromDescribeDomainResponseDomain(item)))
 	}
 }
+func TestDescribeDomainResponse_ArchivalRetentionOnWire(t *testing.T) {
+	data, err := FromDescribeDomainResponse(&testdata.DescribeDomainResponse).Marshal()
+	require.NoError(t, err)
+	var decoded apiv1.DescribeDomainResponse
+	require.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, &testdata.DescribeDomainResponse, ToDescribeDomainResponse(&decoded))
+}
 func TestDescribeDomainResponse(t *testing.T) {
 	for _, item := range []*types.DescribeDomainResponse{nil, &testdata.DescribeDomainResponse} {
 		assert.Equal(t, item, ToDescribeDomainResponse(FromDescribeDomainResponse(item)))
@@ -430,6 +438,13 @@ func TestRegisterDomainRequest(t *testing.T) {
 		assert.Equal(t, item, ToRegisterDomainRequest(FromRegisterDomainRequest(item)))
 	}
 }
+func TestRegisterDomainRequest_ArchivalRetentionOnWire(t *testing.T) {
+	data, err := FromRegisterDomainRequest(&testdata.RegisterDomainRequest).Marshal()
+	require.NoError(t, err)
+	var decoded apiv1.RegisterDomainRequest
+	require.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, &testdata.RegisterDomainRequest, ToRegisterDomainRequest(&decoded))
+}
 func TestRequestCancelActivityTaskDecisionAttributes(t *testing.T) {
 	for _, item := range []*types.RequestCancelActivityTaskDecisionAttributes{nil, {}, &testdata.RequestCancelActivityTaskDecisionAttributes} {
 		assert.Equal(t, item, ToRequestCancelActivityTaskDecisionAttributes(FromRequestCancelActivityTaskDecisionAttributes(item)))
@@ -690,6 +705,18 @@ func TestUpdateDomainRequest(t *testing.T) {
 		assert.Equal(t, item, ToUpdateDomainRequest(FromUpdateDomainRequest(item)))
 	}
 }
+func TestUpdateDomainRequest_ArchivalRetentionOnWire(t *testing.T) {
+	request := &types.UpdateDomainRequest{
+		Name:                          testdata.DomainName,
+		ArchivalRetentionPeriodInDays: common.Int32Ptr(testdata.DomainArchivalRetention),
+	}
+	data, err := FromUpdateDomainRequest(request).Marshal()
+	require.NoError(t, err)
+	var decoded apiv1.UpdateDomainRequest
+	require.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, []string{DomainUpdateArchivalRetentionPeriodField}, decoded.UpdateMask.Paths)
+	assert.Equal(t, request, ToUpdateDomainRequest(&decoded))
+}
 func TestUpdateDomainResponse(t *testing.T) {
 	for _, item := range []*types.UpdateDomainResponse{nil, &testdata.UpdateDomainResponse} {
 		assert.Equal(t, item, ToUpdateDomainResponse(FromUpdateDomainResponse(item)))
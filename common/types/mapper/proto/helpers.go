@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"time"
 
+	gogoproto "github.com/gogo/protobuf/proto"
 	gogo "github.com/gogo/protobuf/types"
 
 	"github.com/uber/cadence/common"
@@ -166,3 +167,69 @@ func (fs fieldSet) isSet(field string) bool {
 func newFieldMask(fields []string) *gogo.FieldMask {
 	return &gogo.FieldMask{Paths: fields}
 }
+
+// The published api.v1 domain messages do not declare the archival retention period yet.
+// Until they do, it is carried as an unknown field under the number reserved for it in
+// cadence-idl, which gogo proto keeps in XXX_unrecognized on both marshal and unmarshal.
+const (
+	domainArchivalRetentionPeriodField                = 18
+	registerDomainRequestArchivalRetentionPeriodField = 14
+	updateDomainRequestArchivalRetentionPeriodField   = 24
+)
+
+func appendDurationField(b []byte, field uint64, d *gogo.Duration) []byte {
+	if d == nil {
+		return b
+	}
+	data, err := d.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	b = append(b, gogoproto.EncodeVarint(field<<3|gogoproto.WireBytes)...)
+	b = append(b, gogoproto.EncodeVarint(uint64(len(data)))...)
+	return append(b, data...)
+}
+
+// durationField returns the last duration encoded under the given field number, or nil
+// if there is none. Malformed input is ignored the same way unknown fields are.
+func durationField(b []byte, field uint64) *gogo.Duration {
+	var result *gogo.Duration
+	for len(b) > 0 {
+		key, n := gogoproto.DecodeVarint(b)
+		if n == 0 {
+			return result
+		}
+		b = b[n:]
+		var value []byte
+		switch key & 7 {
+		case gogoproto.WireVarint:
+			if _, n = gogoproto.DecodeVarint(b); n == 0 {
+				return result
+			}
+		case gogoproto.WireFixed64:
+			n = 8
+		case gogoproto.WireFixed32:
+			n = 4
+		case gogoproto.WireBytes:
+			length, m := gogoproto.DecodeVarint(b)
+			if m == 0 || length > uint64(len(b)-m) {
+				return result
+			}
+			n = m + int(length)
+			value = b[m:n]
+		default:
+			return result
+		}
+		if n > len(b) {
+			return result
+		}
+		b = b[n:]
+		if key>>3 == field && value != nil {
+			d := &gogo.Duration{}
+			if err := d.Unmarshal(value); err == nil {
+				result = d
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package proto
+
+import (
+	"testing"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+)
+
+func TestDurationField(t *testing.T) {
+	retention := daysToDuration(common.Int32Ptr(7))
+
+	var b []byte
+	b = append(b, gogoproto.EncodeVarint(1<<3|gogoproto.WireVarint)...)
+	b = append(b, gogoproto.EncodeVarint(300)...)
+	b = append(b, gogoproto.EncodeVarint(2<<3|gogoproto.WireFixed64)...)
+	b = append(b, make([]byte, 8)...)
+	b = append(b, gogoproto.EncodeVarint(3<<3|gogoproto.WireBytes)...)
+	b = append(b, gogoproto.EncodeVarint(3)...)
+	b = append(b, "abc"...)
+	b = appendDurationField(b, 14, retention)
+
+	assert.Equal(t, retention, durationField(b, 14))
+	assert.Nil(t, durationField(b, 15))
+	assert.Nil(t, durationField(nil, 14))
+	assert.Nil(t, durationField(appendDurationField(nil, 14, nil), 14))
+
+	truncated := appendDurationField(nil, 14, retention)
+	assert.Nil(t, durationField(truncated[:len(truncated)-1], 14))
+}
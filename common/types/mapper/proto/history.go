@@ -906,6 +906,30 @@ func ToHistoryRefreshWorkflowTasksRequest(t *historyv1.RefreshWorkflowTasksReque
 	}
 }
 
+func FromHistoryRestoreWorkflowRequest(t *types.HistoryRestoreWorkflowRequest) *historyv1.RestoreWorkflowRequest {
+	if t == nil || t.Request == nil {
+		return nil
+	}
+	return &historyv1.RestoreWorkflowRequest{
+		DomainId:          t.DomainUUID,
+		Domain:            t.Request.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.Request.Execution),
+	}
+}
+
+func ToHistoryRestoreWorkflowRequest(t *historyv1.RestoreWorkflowRequest) *types.HistoryRestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRestoreWorkflowRequest{
+		Request: &types.AdminRestoreWorkflowRequest{
+			Domain:    t.Domain,
+			Execution: ToWorkflowExecution(t.WorkflowExecution),
+		},
+		DomainUUID: t.DomainId,
+	}
+}
+
 func FromHistoryRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *historyv1.RemoveSignalMutableStateRequest {
 	if t == nil {
 		return nil
@@ -202,6 +202,12 @@ func TestHistoryRefreshWorkflowTasksRequest(t *testing.T) {
 	}
 	assert.Nil(t, FromHistoryRefreshWorkflowTasksRequest(&types.HistoryRefreshWorkflowTasksRequest{}))
 }
+func TestHistoryRestoreWorkflowRequest(t *testing.T) {
+	for _, item := range []*types.HistoryRestoreWorkflowRequest{nil, &testdata.HistoryRestoreWorkflowRequest} {
+		assert.Equal(t, item, ToHistoryRestoreWorkflowRequest(FromHistoryRestoreWorkflowRequest(item)))
+	}
+	assert.Nil(t, FromHistoryRestoreWorkflowRequest(&types.HistoryRestoreWorkflowRequest{}))
+}
 func TestHistoryRemoveSignalMutableStateRequest(t *testing.T) {
 	for _, item := range []*types.RemoveSignalMutableStateRequest{nil, {}, &testdata.HistoryRemoveSignalMutableStateRequest} {
 		assert.Equal(t, item, ToHistoryRemoveSignalMutableStateRequest(FromHistoryRemoveSignalMutableStateRequest(item)))
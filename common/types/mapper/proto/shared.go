@@ -513,7 +513,7 @@ func FromDomainTaskAttributes(t *types.DomainTaskAttributes) *sharedv1.DomainTas
 	if t == nil {
 		return nil
 	}
-	return &sharedv1.DomainTaskAttributes{
+	attributes := &sharedv1.DomainTaskAttributes{
 		DomainOperation: FromDomainOperation(t.DomainOperation),
 		Id:              t.ID,
 		Domain: FromDescribeDomainResponseDomain(&types.DescribeDomainResponse{
@@ -525,6 +525,10 @@ func FromDomainTaskAttributes(t *types.DomainTaskAttributes) *sharedv1.DomainTas
 		FailoverVersion:         t.FailoverVersion,
 		PreviousFailoverVersion: t.PreviousFailoverVersion,
 	}
+	if t.Config != nil {
+		attributes.ArchivalRetentionPeriod = daysToDuration(&t.Config.ArchivalRetentionPeriodInDays)
+	}
+	return attributes
 }
 
 func ToDomainTaskAttributes(t *sharedv1.DomainTaskAttributes) *types.DomainTaskAttributes {
@@ -532,6 +536,7 @@ func ToDomainTaskAttributes(t *sharedv1.DomainTaskAttributes) *types.DomainTaskA
 		return nil
 	}
 	domain := ToDescribeDomainResponseDomain(t.Domain)
+	domain.Configuration.ArchivalRetentionPeriodInDays = common.Int32Default(durationToDays(t.ArchivalRetentionPeriod))
 	return &types.DomainTaskAttributes{
 		DomainOperation:         ToDomainOperation(t.DomainOperation),
 		ID:                      t.Id,
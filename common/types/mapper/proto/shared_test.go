@@ -24,7 +24,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	sharedv1 "github.com/uber/cadence/.gen/proto/shared/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/testdata"
@@ -90,6 +92,14 @@ func TestDomainTaskAttributes(t *testing.T) {
 		assert.Equal(t, item, ToDomainTaskAttributes(FromDomainTaskAttributes(item)))
 	}
 }
+func TestDomainTaskAttributesArchivalRetention(t *testing.T) {
+	data, err := FromDomainTaskAttributes(&testdata.DomainTaskAttributes).Marshal()
+	require.NoError(t, err)
+	var decoded sharedv1.DomainTaskAttributes
+	require.NoError(t, decoded.Unmarshal(data))
+	assert.Equal(t, common.Int32Ptr(testdata.DomainArchivalRetention), durationToDays(decoded.ArchivalRetentionPeriod))
+	assert.Equal(t, &testdata.DomainTaskAttributes, ToDomainTaskAttributes(&decoded))
+}
 func TestFailoverMarkerAttributes(t *testing.T) {
 	for _, item := range []*types.FailoverMarkerAttributes{nil, {}, &testdata.FailoverMarkerAttributes} {
 		assert.Equal(t, item, ToFailoverMarkerAttributes(FromFailoverMarkerAttributes(item)))
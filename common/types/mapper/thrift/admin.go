@@ -646,6 +646,28 @@ func ToAdminMaintainWorkflowResponse(t *admin.AdminMaintainWorkflowResponse) *ty
 	}
 }
 
+// FromAdminRestoreWorkflowRequest converts internal AdminRestoreWorkflowRequest type to thrift
+func FromAdminRestoreWorkflowRequest(t *types.AdminRestoreWorkflowRequest) *admin.AdminRestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.AdminRestoreWorkflowRequest{
+		Domain:    &t.Domain,
+		Execution: FromWorkflowExecution(t.Execution),
+	}
+}
+
+// ToAdminRestoreWorkflowRequest converts thrift AdminRestoreWorkflowRequest type to internal
+func ToAdminRestoreWorkflowRequest(t *admin.AdminRestoreWorkflowRequest) *types.AdminRestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.AdminRestoreWorkflowRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToWorkflowExecution(t.Execution),
+	}
+}
+
 //FromListDynamicConfigResponse converts internal ListDynamicConfigResponse type to thrift
 func FromListDynamicConfigResponse(t *types.ListDynamicConfigResponse) *admin.ListDynamicConfigResponse {
 	if t == nil {
@@ -676,6 +676,30 @@ func ToHistoryRefreshWorkflowTasksRequest(t *history.RefreshWorkflowTasksRequest
 	}
 }
 
+// FromHistoryRestoreWorkflowRequest converts internal HistoryRestoreWorkflowRequest type to thrift
+func FromHistoryRestoreWorkflowRequest(t *types.HistoryRestoreWorkflowRequest) *history.RestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.RestoreWorkflowRequest{
+		DomainUUID: &t.DomainUUID,
+		Execution:  FromWorkflowExecution(t.GetRequest().GetExecution()),
+	}
+}
+
+// ToHistoryRestoreWorkflowRequest converts thrift RestoreWorkflowRequest type to internal
+func ToHistoryRestoreWorkflowRequest(t *history.RestoreWorkflowRequest) *types.HistoryRestoreWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryRestoreWorkflowRequest{
+		DomainUUID: t.GetDomainUUID(),
+		Request: &types.AdminRestoreWorkflowRequest{
+			Execution: ToWorkflowExecution(t.Execution),
+		},
+	}
+}
+
 // FromRemoveSignalMutableStateRequest converts internal RemoveSignalMutableStateRequest type to thrift
 func FromRemoveSignalMutableStateRequest(t *types.RemoveSignalMutableStateRequest) *history.RemoveSignalMutableStateRequest {
 	if t == nil {
@@ -1857,6 +1857,7 @@ func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfigu
 		HistoryArchivalURI:                     &t.HistoryArchivalURI,
 		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  &t.VisibilityArchivalURI,
+		ArchivalRetentionPeriodInDays:          &t.ArchivalRetentionPeriodInDays,
 	}
 }
 
@@ -1873,6 +1874,7 @@ func ToDomainConfiguration(t *shared.DomainConfiguration) *types.DomainConfigura
 		HistoryArchivalURI:                     t.GetHistoryArchivalURI(),
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.GetVisibilityArchivalURI(),
+		ArchivalRetentionPeriodInDays:          t.GetArchivalRetentionPeriodInDays(),
 	}
 }
 
@@ -4020,6 +4022,7 @@ func FromRegisterDomainRequest(t *types.RegisterDomainRequest) *shared.RegisterD
 		HistoryArchivalURI:                     &t.HistoryArchivalURI,
 		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  &t.VisibilityArchivalURI,
+		ArchivalRetentionPeriodInDays:          &t.ArchivalRetentionPeriodInDays,
 	}
 }
 
@@ -4043,6 +4046,7 @@ func ToRegisterDomainRequest(t *shared.RegisterDomainRequest) *types.RegisterDom
 		HistoryArchivalURI:                     t.GetHistoryArchivalURI(),
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.GetVisibilityArchivalURI(),
+		ArchivalRetentionPeriodInDays:          t.GetArchivalRetentionPeriodInDays(),
 	}
 }
 
@@ -5698,7 +5702,8 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 		t.HistoryArchivalStatus != nil ||
 		t.HistoryArchivalURI != nil ||
 		t.VisibilityArchivalStatus != nil ||
-		t.VisibilityArchivalURI != nil {
+		t.VisibilityArchivalURI != nil ||
+		t.ArchivalRetentionPeriodInDays != nil {
 		request.Configuration = &shared.DomainConfiguration{
 			WorkflowExecutionRetentionPeriodInDays: t.WorkflowExecutionRetentionPeriodInDays,
 			EmitMetric:                             t.EmitMetric,
@@ -5707,6 +5712,7 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 			HistoryArchivalURI:                     t.HistoryArchivalURI,
 			VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  t.VisibilityArchivalURI,
+			ArchivalRetentionPeriodInDays:          t.ArchivalRetentionPeriodInDays,
 		}
 	}
 	if t.ActiveClusterName != nil || t.Clusters != nil {
@@ -5742,6 +5748,7 @@ func ToUpdateDomainRequest(t *shared.UpdateDomainRequest) *types.UpdateDomainReq
 		request.HistoryArchivalURI = t.Configuration.HistoryArchivalURI
 		request.VisibilityArchivalStatus = ToArchivalStatus(t.Configuration.VisibilityArchivalStatus)
 		request.VisibilityArchivalURI = t.Configuration.VisibilityArchivalURI
+		request.ArchivalRetentionPeriodInDays = t.Configuration.ArchivalRetentionPeriodInDays
 	}
 	if t.ReplicationConfiguration != nil {
 		request.ActiveClusterName = t.ReplicationConfiguration.ActiveClusterName
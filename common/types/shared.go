@@ -2694,6 +2694,7 @@ type DomainConfiguration struct {
 	HistoryArchivalURI                     string          `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus               *ArchivalStatus `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  string          `json:"visibilityArchivalURI,omitempty"`
+	ArchivalRetentionPeriodInDays          int32           `json:"archivalRetentionPeriodInDays,omitempty"`
 }
 
 // GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
@@ -2752,6 +2753,14 @@ func (v *DomainConfiguration) GetVisibilityArchivalURI() (o string) {
 	return
 }
 
+// GetArchivalRetentionPeriodInDays is an internal getter (TBD...)
+func (v *DomainConfiguration) GetArchivalRetentionPeriodInDays() (o int32) {
+	if v != nil {
+		return v.ArchivalRetentionPeriodInDays
+	}
+	return
+}
+
 // DomainInfo is an internal type (TBD...)
 type DomainInfo struct {
 	Name        string            `json:"name,omitempty"`
@@ -5995,6 +6004,7 @@ type RegisterDomainRequest struct {
 	HistoryArchivalURI                     string                             `json:"historyArchivalURI,omitempty"`
 	VisibilityArchivalStatus               *ArchivalStatus                    `json:"visibilityArchivalStatus,omitempty"`
 	VisibilityArchivalURI                  string                             `json:"visibilityArchivalURI,omitempty"`
+	ArchivalRetentionPeriodInDays          int32                              `json:"archivalRetentionPeriodInDays,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -6110,6 +6120,14 @@ func (v *RegisterDomainRequest) GetVisibilityArchivalURI() (o string) {
 	return
 }
 
+// GetArchivalRetentionPeriodInDays is an internal getter (TBD...)
+func (v *RegisterDomainRequest) GetArchivalRetentionPeriodInDays() (o int32) {
+	if v != nil {
+		return v.ArchivalRetentionPeriodInDays
+	}
+	return
+}
+
 // RemoteSyncMatchedError is an internal type (TBD...)
 type RemoteSyncMatchedError struct {
 	Message string `json:"message,required"`
@@ -8931,6 +8949,7 @@ type UpdateDomainRequest struct {
 	SecurityToken                          string                             `json:"securityToken,omitempty"`
 	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
 	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+	ArchivalRetentionPeriodInDays          *int32                             `json:"archivalRetentionPeriodInDays,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -8981,6 +9000,14 @@ func (v *UpdateDomainRequest) GetVisibilityArchivalURI() (o string) {
 	return
 }
 
+// GetArchivalRetentionPeriodInDays is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetArchivalRetentionPeriodInDays() (o int32) {
+	if v != nil && v.ArchivalRetentionPeriodInDays != nil {
+		return *v.ArchivalRetentionPeriodInDays
+	}
+	return
+}
+
 // UpdateDomainResponse is an internal type (TBD...)
 type UpdateDomainResponse struct {
 	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
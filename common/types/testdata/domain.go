@@ -25,14 +25,15 @@ import (
 )
 
 const (
-	DomainID          = "DomainID"
-	DomainName        = "DomainName"
-	DomainDescription = "DomainDescription"
-	DomainOwnerEmail  = "DomainOwnerEmail"
-	DomainDataKey     = "DomainDataKey"
-	DomainDataValue   = "DomainDataValue"
-	DomainRetention   = 3
-	DomainEmitMetric  = true
+	DomainID                = "DomainID"
+	DomainName              = "DomainName"
+	DomainDescription       = "DomainDescription"
+	DomainOwnerEmail        = "DomainOwnerEmail"
+	DomainDataKey           = "DomainDataKey"
+	DomainDataValue         = "DomainDataValue"
+	DomainRetention         = 3
+	DomainEmitMetric        = true
+	DomainArchivalRetention = 30
 
 	ClusterName1 = "ClusterName1"
 	ClusterName2 = "ClusterName2"
@@ -79,6 +80,16 @@ var (
 		VisibilityArchivalStatus:               &ArchivalStatus,
 		VisibilityArchivalURI:                  VisibilityArchivalURI,
 	}
+	DomainConfigurationWithArchivalRetention = types.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: DomainRetention,
+		EmitMetric:                             DomainEmitMetric,
+		BadBinaries:                            &BadBinaries,
+		HistoryArchivalStatus:                  &ArchivalStatus,
+		HistoryArchivalURI:                     HistoryArchivalURI,
+		VisibilityArchivalStatus:               &ArchivalStatus,
+		VisibilityArchivalURI:                  VisibilityArchivalURI,
+		ArchivalRetentionPeriodInDays:          DomainArchivalRetention,
+	}
 	DomainReplicationConfiguration = types.DomainReplicationConfiguration{
 		ActiveClusterName: ClusterName1,
 		Clusters:          ClusterReplicationConfigurationArray,
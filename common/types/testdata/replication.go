@@ -100,7 +100,7 @@ var (
 		DomainOperation:         types.DomainOperationUpdate.Ptr(),
 		ID:                      DomainID,
 		Info:                    &DomainInfo,
-		Config:                  &DomainConfiguration,
+		Config:                  &DomainConfigurationWithArchivalRetention,
 		ReplicationConfig:       &DomainReplicationConfiguration,
 		ConfigVersion:           Version1,
 		FailoverVersion:         FailoverVersion1,
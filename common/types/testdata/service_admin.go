@@ -151,6 +151,20 @@ var (
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
 	}
+	AdminRestoreWorkflowRequest = types.AdminRestoreWorkflowRequest{
+		Domain:    DomainName,
+		Execution: &WorkflowExecution,
+	}
+	AdminSearchArchivedHistoryRequest = types.AdminSearchArchivedHistoryRequest{
+		Domain:        DomainName,
+		PageSize:      PageSize,
+		NextPageToken: NextPageToken,
+		Query:         VisibilityQuery,
+	}
+	AdminSearchArchivedHistoryResponse = types.AdminSearchArchivedHistoryResponse{
+		Executions:    WorkflowExecutionInfoArray,
+		NextPageToken: NextPageToken,
+	}
 	AdminRemoveTaskRequest = types.RemoveTaskRequest{
 		ShardID:             ShardID,
 		Type:                common.Int32Ptr(QueueType),
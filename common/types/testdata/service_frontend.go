@@ -41,6 +41,7 @@ var (
 		HistoryArchivalURI:                     HistoryArchivalURI,
 		VisibilityArchivalStatus:               &ArchivalStatus,
 		VisibilityArchivalURI:                  VisibilityArchivalURI,
+		ArchivalRetentionPeriodInDays:          DomainArchivalRetention,
 	}
 	DescribeDomainRequest_ID = types.DescribeDomainRequest{
 		UUID: common.StringPtr(DomainID),
@@ -50,7 +51,7 @@ var (
 	}
 	DescribeDomainResponse = types.DescribeDomainResponse{
 		DomainInfo:               &DomainInfo,
-		Configuration:            &DomainConfiguration,
+		Configuration:            &DomainConfigurationWithArchivalRetention,
 		ReplicationConfiguration: &DomainReplicationConfiguration,
 		FailoverVersion:          FailoverVersion1,
 		IsGlobalDomain:           true,
@@ -79,10 +80,11 @@ var (
 		SecurityToken:                          SecurityToken,
 		DeleteBadBinary:                        common.StringPtr(DeleteBadBinary),
 		FailoverTimeoutInSeconds:               &Duration1,
+		ArchivalRetentionPeriodInDays:          common.Int32Ptr(DomainArchivalRetention),
 	}
 	UpdateDomainResponse = types.UpdateDomainResponse{
 		DomainInfo:               &DomainInfo,
-		Configuration:            &DomainConfiguration,
+		Configuration:            &DomainConfigurationWithArchivalRetention,
 		ReplicationConfiguration: &DomainReplicationConfiguration,
 		FailoverVersion:          FailoverVersion1,
 		IsGlobalDomain:           true,
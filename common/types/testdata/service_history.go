@@ -174,6 +174,10 @@ var (
 		DomainUIID: DomainID,
 		Request:    &AdminRefreshWorkflowTasksRequest,
 	}
+	HistoryRestoreWorkflowRequest = types.HistoryRestoreWorkflowRequest{
+		DomainUUID: DomainID,
+		Request:    &AdminRestoreWorkflowRequest,
+	}
 	HistoryRemoveSignalMutableStateRequest = types.RemoveSignalMutableStateRequest{
 		DomainUUID:        DomainID,
 		WorkflowExecution: &WorkflowExecution,
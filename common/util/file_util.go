@@ -26,7 +26,9 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"path"
 	"strings"
+	"time"
 )
 
 var (
@@ -136,3 +138,29 @@ func ListFilesByPrefix(dirPath string, prefix string) ([]string, error) {
 	}
 	return filteredFileNames, nil
 }
+
+// DeleteFilesModifiedBefore deletes all files in directory with prefix that were last modified before the given time.
+func DeleteFilesModifiedBefore(dirPath string, prefix string, before time.Time) error {
+	fileNames, err := ListFilesByPrefix(dirPath, prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range fileNames {
+		filePath := path.Join(dirPath, name)
+		info, err := os.Stat(filePath)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return err
+		}
+		if info.IsDir() || !info.ModTime().Before(before) {
+			continue
+		}
+		if err := os.Remove(filePath); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -177,6 +178,24 @@ func (s *FileUtilSuite) TestListFilesByPrefix() {
 	s.Equal(len(expectedFileNames), len(actualFileNames))
 }
 
+func (s *FileUtilSuite) TestDeleteFilesModifiedBefore() {
+	dir, err := ioutil.TempDir("", "TestDeleteFilesModifiedBefore")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	for _, f := range []string{"file_old", "file_new", "other_old"} {
+		s.createFile(dir, f)
+	}
+	s.NoError(os.Chtimes(filepath.Join(dir, "file_old"), now.Add(-time.Hour), now.Add(-time.Hour)))
+	s.NoError(os.Chtimes(filepath.Join(dir, "other_old"), now.Add(-time.Hour), now.Add(-time.Hour)))
+
+	s.NoError(DeleteFilesModifiedBefore(dir, "file_", now.Add(-time.Minute)))
+	fileNames, err := ListFiles(dir)
+	s.NoError(err)
+	s.ElementsMatch([]string{"file_new", "other_old"}, fileNames)
+}
+
 func (s *FileUtilSuite) assertCorrectFileMode(path string) {
 	info, err := os.Stat(path)
 	s.NoError(err)
@@ -46,7 +46,8 @@ func CreateESClient(s suite.Suite, url string, version string) ESClient {
 	switch version {
 	case "v6":
 		client, err = newV6Client(url)
-	case "v7":
+	case "v7", "opensearch":
+		// OpenSearch serves the ElasticSearch v7 APIs used by the tests
 		client, err = newV7Client(url)
 	default:
 		s.Fail("not supported ES version")
@@ -54,6 +54,7 @@ import (
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin/sqlite"
 )
 
 type (
@@ -210,6 +211,8 @@ func NewPersistenceTestCluster(clusterConfig *TestClusterConfig) testcluster.Per
 			ops = mysql.GetTestClusterOption()
 		} else if TestFlags.SQLPluginName == postgres.PluginName {
 			ops = postgres.GetTestClusterOption()
+		} else if TestFlags.SQLPluginName == sqlite.PluginName {
+			ops = sqlite.GetTestClusterOption()
 		} else {
 			panic("not supported plugin " + TestFlags.SQLPluginName)
 		}
@@ -20,11 +20,33 @@
 
 package cadence
 
+import "time"
+
 // below are the names of all mongoDB collections
 const (
-	ClusterConfigCollectionName = "cluster_config"
+	ClusterConfigCollectionName      = "cluster_config"
+	ShardCollectionName              = "shards"
+	CurrentWorkflowCollectionName    = "current_workflows"
+	WorkflowExecutionCollectionName  = "workflow_executions"
+	TransferTaskCollectionName       = "transfer_tasks"
+	CrossClusterTaskCollectionName   = "cross_cluster_tasks"
+	ReplicationTaskCollectionName    = "replication_tasks"
+	ReplicationDLQTaskCollectionName = "replication_dlq_tasks"
+	TimerTaskCollectionName          = "timer_tasks"
+	HistoryTreeCollectionName        = "history_tree"
+	HistoryNodeCollectionName        = "history_node"
+	DomainCollectionName             = "domains"
+	DomainMetadataCollectionName     = "domain_metadata"
+	QueueMessageCollectionName       = "queue_messages"
+	QueueMetadataCollectionName      = "queue_metadata"
+	TaskListCollectionName           = "tasklists"
+	TaskCollectionName               = "tasks"
+	VisibilityCollectionName         = "visibility"
 )
 
+// DomainMetadataDocumentID is the _id of the only document in domain_metadata collection
+const DomainMetadataDocumentID = 0
+
 // NOTE1: MongoDB collection is schemaless -- there is no schema file for collection. We use Go lang structs to define the collection fields.
 
 // NOTE2: MongoDB doesn't allow using camel case or underscore in the field names
@@ -39,3 +61,131 @@ type ClusterConfigCollectionEntry struct {
 	DataEncoding         string `json:"dataencoding"`
 	UnixTimestampSeconds int64  `json:"unixtimestampseconds"`
 }
+
+// NOTE3: the non-significant fields(the ones that are never used in any filter or index) of a record are serialized
+// into the Data field, so that the collection schema doesn't need to change when adding new fields to the records.
+
+// ShardCollectionEntry is the schema of shards
+// WriteVersion is increased by every write that is conditioned on the RangeID, so that concurrent transactions conflict
+type ShardCollectionEntry struct {
+	ShardID      int    `bson:"_id"`
+	RangeID      int64  `bson:"rangeid"`
+	WriteVersion int64  `bson:"writeversion"`
+	Data         []byte `bson:"data"`
+}
+
+// CurrentWorkflowCollectionEntry is the schema of current_workflows
+type CurrentWorkflowCollectionEntry struct {
+	ShardID          int    `bson:"shardid"`
+	DomainID         string `bson:"domainid"`
+	WorkflowID       string `bson:"workflowid"`
+	RunID            string `bson:"runid"`
+	CreateRequestID  string `bson:"createrequestid"`
+	State            int    `bson:"state"`
+	CloseStatus      int    `bson:"closestatus"`
+	LastWriteVersion int64  `bson:"lastwriteversion"`
+}
+
+// WorkflowExecutionCollectionEntry is the schema of workflow_executions
+// Data contains the execution info, and also the activity/timer/child/requestCancel/signal maps and buffered events
+type WorkflowExecutionCollectionEntry struct {
+	ShardID     int    `bson:"shardid"`
+	DomainID    string `bson:"domainid"`
+	WorkflowID  string `bson:"workflowid"`
+	RunID       string `bson:"runid"`
+	NextEventID int64  `bson:"nexteventid"`
+	Data        []byte `bson:"data"`
+}
+
+// ShardTaskCollectionEntry is the schema of transfer_tasks, cross_cluster_tasks, replication_tasks,
+// replication_dlq_tasks and timer_tasks
+// Cluster is only for cross_cluster_tasks(target cluster) and replication_dlq_tasks(source cluster).
+// VisibilityTimestamp is in UnixNano, and only for timer_tasks.
+type ShardTaskCollectionEntry struct {
+	ShardID             int    `bson:"shardid"`
+	Cluster             string `bson:"cluster"`
+	VisibilityTimestamp int64  `bson:"visibilitytimestamp"`
+	TaskID              int64  `bson:"taskid"`
+	Data                []byte `bson:"data"`
+}
+
+// HistoryTreeCollectionEntry is the schema of history_tree
+type HistoryTreeCollectionEntry struct {
+	TreeID   string `bson:"treeid"`
+	BranchID string `bson:"branchid"`
+	Data     []byte `bson:"data"`
+}
+
+// HistoryNodeCollectionEntry is the schema of history_node
+type HistoryNodeCollectionEntry struct {
+	TreeID       string `bson:"treeid"`
+	BranchID     string `bson:"branchid"`
+	NodeID       int64  `bson:"nodeid"`
+	TxnID        int64  `bson:"txnid"`
+	Data         []byte `bson:"data"`
+	DataEncoding string `bson:"dataencoding"`
+}
+
+// DomainCollectionEntry is the schema of domains
+type DomainCollectionEntry struct {
+	ID                  string `bson:"_id"`
+	Name                string `bson:"name"`
+	NotificationVersion int64  `bson:"notificationversion"`
+	Data                []byte `bson:"data"`
+}
+
+// DomainMetadataCollectionEntry is the schema of domain_metadata
+type DomainMetadataCollectionEntry struct {
+	ID                  int   `bson:"_id"`
+	NotificationVersion int64 `bson:"notificationversion"`
+}
+
+// QueueMessageCollectionEntry is the schema of queue_messages
+type QueueMessageCollectionEntry struct {
+	QueueType int    `bson:"queuetype"`
+	MessageID int64  `bson:"messageid"`
+	Payload   []byte `bson:"payload"`
+}
+
+// QueueMetadataCollectionEntry is the schema of queue_metadata
+type QueueMetadataCollectionEntry struct {
+	QueueType        int              `bson:"_id"`
+	Version          int64            `bson:"version"`
+	ClusterAckLevels map[string]int64 `bson:"clusteracklevels"`
+}
+
+// TaskListCollectionEntry is the schema of tasklists
+// WriteVersion is increased by every write that is conditioned on the RangeID, so that concurrent transactions conflict
+type TaskListCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	RangeID      int64      `bson:"rangeid"`
+	WriteVersion int64      `bson:"writeversion"`
+	Data         []byte     `bson:"data"`
+	ExpiryTime   *time.Time `bson:"expirytime,omitempty"`
+}
+
+// TaskCollectionEntry is the schema of tasks
+type TaskCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	TaskListName string     `bson:"tasklistname"`
+	TaskListType int        `bson:"tasklisttype"`
+	TaskID       int64      `bson:"taskid"`
+	Data         []byte     `bson:"data"`
+	ExpiryTime   *time.Time `bson:"expirytime,omitempty"`
+}
+
+// VisibilityCollectionEntry is the schema of visibility
+// CloseTime and CloseStatus are only set for closed workflows
+type VisibilityCollectionEntry struct {
+	DomainID     string     `bson:"domainid"`
+	WorkflowID   string     `bson:"workflowid"`
+	RunID        string     `bson:"runid"`
+	WorkflowType string     `bson:"workflowtype"`
+	StartTime    int64      `bson:"starttime"`
+	CloseTime    *int64     `bson:"closetime,omitempty"`
+	CloseStatus  *int32     `bson:"closestatus,omitempty"`
+	Data         []byte     `bson:"data"`
+	ExpiryTime   *time.Time `bson:"expirytime,omitempty"`
+}
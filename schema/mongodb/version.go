@@ -23,4 +23,4 @@ package mongodb
 // NOTE: whenever there is a new data base schema update, plz update the following versions
 
 // Version is the MongoDB database schema release version
-const Version = "0.1"
+const Version = "0.2"
@@ -22,7 +22,9 @@ package frontend
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -34,13 +36,20 @@ import (
 type AccessControlledWorkflowAdminHandler struct {
 	AdminHandler
 
-	authorizer authorization.Authorizer
+	authorizer  authorization.Authorizer
+	auditLogger audit.Logger
 }
 
 var _ AdminHandler = (*AccessControlledWorkflowAdminHandler)(nil)
 
 // NewAccessControlledAdminHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledAdminHandlerImpl(adminHandler AdminHandler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowAdminHandler {
+func NewAccessControlledAdminHandlerImpl(
+	adminHandler AdminHandler,
+	resource resource.Resource,
+	authorizer authorization.Authorizer,
+	cfg config.Authorization,
+	auditLogger audit.Logger,
+) *AccessControlledWorkflowAdminHandler {
 	if authorizer == nil {
 		var err error
 		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
@@ -51,14 +60,18 @@ func NewAccessControlledAdminHandlerImpl(adminHandler AdminHandler, resource res
 	return &AccessControlledWorkflowAdminHandler{
 		AdminHandler: adminHandler,
 		authorizer:   authorizer,
+		auditLogger:  auditLogger,
 	}
 }
 
-func (a *AccessControlledWorkflowAdminHandler) AddSearchAttribute(ctx context.Context, request *types.AddSearchAttributeRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) AddSearchAttribute(ctx context.Context, request *types.AddSearchAttributeRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "AddSearchAttribute",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -70,11 +83,16 @@ func (a *AccessControlledWorkflowAdminHandler) AddSearchAttribute(ctx context.Co
 	return a.AdminHandler.AddSearchAttribute(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) CloseShard(ctx context.Context, request *types.CloseShardRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) CloseShard(ctx context.Context, request *types.CloseShardRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "CloseShard",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("ShardID: %v", request.GetShardID()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -230,11 +248,16 @@ func (a *AccessControlledWorkflowAdminHandler) GetWorkflowExecutionRawHistoryV2(
 	return a.AdminHandler.GetWorkflowExecutionRawHistoryV2(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) MergeDLQMessages(ctx context.Context, request *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error) {
+func (a *AccessControlledWorkflowAdminHandler) MergeDLQMessages(ctx context.Context, request *types.MergeDLQMessagesRequest) (resp *types.MergeDLQMessagesResponse, retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "MergeDLQMessages",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("Type: %v, ShardID: %v, SourceCluster: %v", request.GetType(), request.GetShardID(), request.GetSourceCluster()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
@@ -246,11 +269,16 @@ func (a *AccessControlledWorkflowAdminHandler) MergeDLQMessages(ctx context.Cont
 	return a.AdminHandler.MergeDLQMessages(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) PurgeDLQMessages(ctx context.Context, request *types.PurgeDLQMessagesRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) PurgeDLQMessages(ctx context.Context, request *types.PurgeDLQMessagesRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "PurgeDLQMessages",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("Type: %v, ShardID: %v, SourceCluster: %v", request.GetType(), request.GetShardID(), request.GetSourceCluster()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -278,11 +306,18 @@ func (a *AccessControlledWorkflowAdminHandler) ReadDLQMessages(ctx context.Conte
 	return a.AdminHandler.ReadDLQMessages(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) ReapplyEvents(ctx context.Context, request *types.ReapplyEventsRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) ReapplyEvents(ctx context.Context, request *types.ReapplyEventsRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "ReapplyEvents",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			DomainName: request.GetDomainName(),
+			WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+			RunID:      request.GetWorkflowExecution().GetRunID(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -294,11 +329,18 @@ func (a *AccessControlledWorkflowAdminHandler) ReapplyEvents(ctx context.Context
 	return a.AdminHandler.ReapplyEvents(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.Context, request *types.RefreshWorkflowTasksRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.Context, request *types.RefreshWorkflowTasksRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "RefreshWorkflowTasks",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			DomainName: request.GetDomain(),
+			WorkflowID: request.GetExecution().GetWorkflowID(),
+			RunID:      request.GetExecution().GetRunID(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -310,11 +352,16 @@ func (a *AccessControlledWorkflowAdminHandler) RefreshWorkflowTasks(ctx context.
 	return a.AdminHandler.RefreshWorkflowTasks(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) RemoveTask(ctx context.Context, request *types.RemoveTaskRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "RemoveTask",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("ShardID: %v, Type: %v, TaskID: %v", request.GetShardID(), request.GetType(), request.GetTaskID()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -326,11 +373,18 @@ func (a *AccessControlledWorkflowAdminHandler) RemoveTask(ctx context.Context, r
 	return a.AdminHandler.RemoveTask(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) ResendReplicationTasks(ctx context.Context, request *types.ResendReplicationTasksRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) ResendReplicationTasks(ctx context.Context, request *types.ResendReplicationTasksRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "ResendReplicationTasks",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowID(),
+			RunID:      request.GetRunID(),
+			Details:    fmt.Sprintf("DomainID: %v, RemoteCluster: %v", request.GetDomainID(), request.GetRemoteCluster()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -342,11 +396,16 @@ func (a *AccessControlledWorkflowAdminHandler) ResendReplicationTasks(ctx contex
 	return a.AdminHandler.ResendReplicationTasks(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) ResetQueue(ctx context.Context, request *types.ResetQueueRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) ResetQueue(ctx context.Context, request *types.ResetQueueRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "ResetQueue",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("ShardID: %v, ClusterName: %v, Type: %v", request.GetShardID(), request.GetClusterName(), request.GetType()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -390,11 +449,16 @@ func (a *AccessControlledWorkflowAdminHandler) GetDynamicConfig(ctx context.Cont
 	return a.AdminHandler.GetDynamicConfig(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "UpdateDynamicConfig",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("ConfigName: %v", request.GetConfigName()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -406,11 +470,16 @@ func (a *AccessControlledWorkflowAdminHandler) UpdateDynamicConfig(ctx context.C
 	return a.AdminHandler.UpdateDynamicConfig(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) RestoreDynamicConfig(ctx context.Context, request *types.RestoreDynamicConfigRequest) error {
+func (a *AccessControlledWorkflowAdminHandler) RestoreDynamicConfig(ctx context.Context, request *types.RestoreDynamicConfigRequest) (retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "RestoreDynamicConfig",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			Details: fmt.Sprintf("ConfigName: %v", request.GetConfigName()),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return err
@@ -422,11 +491,18 @@ func (a *AccessControlledWorkflowAdminHandler) RestoreDynamicConfig(ctx context.
 	return a.AdminHandler.RestoreDynamicConfig(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) DeleteWorkflow(ctx context.Context, request *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error) {
+func (a *AccessControlledWorkflowAdminHandler) DeleteWorkflow(ctx context.Context, request *types.AdminDeleteWorkflowRequest) (resp *types.AdminDeleteWorkflowResponse, retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "DeleteWorkflow",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			DomainName: request.GetDomain(),
+			WorkflowID: request.GetExecution().GetWorkflowID(),
+			RunID:      request.GetExecution().GetRunID(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
@@ -438,11 +514,18 @@ func (a *AccessControlledWorkflowAdminHandler) DeleteWorkflow(ctx context.Contex
 	return a.AdminHandler.DeleteWorkflow(ctx, request)
 }
 
-func (a *AccessControlledWorkflowAdminHandler) MaintainCorruptWorkflow(ctx context.Context, request *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error) {
+func (a *AccessControlledWorkflowAdminHandler) MaintainCorruptWorkflow(ctx context.Context, request *types.AdminMaintainWorkflowRequest) (resp *types.AdminMaintainWorkflowResponse, retError error) {
 	attr := &authorization.Attributes{
 		APIName:    "MaintainCorruptWorkflow",
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			DomainName: request.GetDomain(),
+			WorkflowID: request.GetExecution().GetWorkflowID(),
+			RunID:      request.GetExecution().GetRunID(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr)
 	if err != nil {
 		return nil, err
@@ -454,6 +537,45 @@ func (a *AccessControlledWorkflowAdminHandler) MaintainCorruptWorkflow(ctx conte
 	return a.AdminHandler.MaintainCorruptWorkflow(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) RestoreWorkflow(ctx context.Context, request *types.AdminRestoreWorkflowRequest) (retError error) {
+	attr := &authorization.Attributes{
+		APIName:    "RestoreWorkflow",
+		Permission: authorization.PermissionAdmin,
+	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			DomainName: request.GetDomain(),
+			WorkflowID: request.GetExecution().GetWorkflowID(),
+			RunID:      request.GetExecution().GetRunID(),
+		}, retError)
+	}()
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.RestoreWorkflow(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) SearchArchivedHistory(ctx context.Context, request *types.AdminSearchArchivedHistoryRequest) (*types.AdminSearchArchivedHistoryResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "SearchArchivedHistory",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.SearchArchivedHistory(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Context, request *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error) {
 	attr := &authorization.Attributes{
 		APIName:    "ListDynamicConfig",
@@ -470,6 +592,16 @@ func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Con
 	return a.AdminHandler.ListDynamicConfig(ctx, request)
 }
 
+// auditLog records the result of a mutating admin API call
+func (a *AccessControlledWorkflowAdminHandler) auditLog(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	record *audit.Record,
+	err error,
+) {
+	a.auditLogger.Log(ctx, fillAuditRecord(record, attr, err))
+}
+
 func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
@@ -23,6 +23,8 @@ package frontend
 import (
 	"context"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log/tag"
@@ -39,12 +41,20 @@ type AccessControlledWorkflowHandler struct {
 
 	frontendHandler Handler
 	authorizer      authorization.Authorizer
+	tokenSerializer common.TaskTokenSerializer
+	auditLogger     audit.Logger
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
 
 // NewAccessControlledHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowHandler {
+func NewAccessControlledHandlerImpl(
+	wfHandler Handler,
+	resource resource.Resource,
+	authorizer authorization.Authorizer,
+	cfg config.Authorization,
+	auditLogger audit.Logger,
+) *AccessControlledWorkflowHandler {
 	if authorizer == nil {
 		var err error
 		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
@@ -56,6 +66,8 @@ func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resourc
 		Resource:        resource,
 		frontendHandler: wfHandler,
 		authorizer:      authorizer,
+		tokenSerializer: common.NewJSONTaskTokenSerializer(),
+		auditLogger:     auditLogger,
 	}
 }
 
@@ -92,7 +104,7 @@ func (a *AccessControlledWorkflowHandler) CountWorkflowExecutions(
 func (a *AccessControlledWorkflowHandler) DeprecateDomain(
 	ctx context.Context,
 	request *types.DeprecateDomainRequest,
-) error {
+) (retError error) {
 
 	scope := a.getMetricsScopeWithDomainName(metrics.FrontendDeprecateDomainScope, request.GetName())
 
@@ -101,6 +113,9 @@ func (a *AccessControlledWorkflowHandler) DeprecateDomain(
 		DomainName: request.GetName(),
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return err
@@ -147,6 +162,7 @@ func (a *AccessControlledWorkflowHandler) DescribeTaskList(
 	attr := &authorization.Attributes{
 		APIName:    "DescribeTaskList",
 		DomainName: request.GetDomain(),
+		TaskList:   request.TaskList,
 		Permission: authorization.PermissionRead,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
@@ -419,7 +435,19 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *types.RecordActivityTaskHeartbeatRequest,
 ) (*types.RecordActivityTaskHeartbeatResponse, error) {
-	// TODO(vancexu): add auth check for service API
+
+	attr, scope, err := a.getTaskTokenAttributes(metrics.FrontendRecordActivityTaskHeartbeatScope, "RecordActivityTaskHeartbeat", request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RecordActivityTaskHeartbeat(ctx, request)
 }
 
@@ -428,6 +456,22 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeatByID(
 	ctx context.Context,
 	request *types.RecordActivityTaskHeartbeatByIDRequest,
 ) (*types.RecordActivityTaskHeartbeatResponse, error) {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRecordActivityTaskHeartbeatByIDScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "RecordActivityTaskHeartbeatByID",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RecordActivityTaskHeartbeatByID(ctx, request)
 }
 
@@ -435,7 +479,7 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeatByID(
 func (a *AccessControlledWorkflowHandler) RegisterDomain(
 	ctx context.Context,
 	request *types.RegisterDomainRequest,
-) error {
+) (retError error) {
 
 	scope := a.getMetricsScopeWithDomainName(metrics.FrontendRegisterDomainScope, request.GetName())
 
@@ -444,6 +488,9 @@ func (a *AccessControlledWorkflowHandler) RegisterDomain(
 		DomainName: request.GetName(),
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return err
@@ -459,7 +506,7 @@ func (a *AccessControlledWorkflowHandler) RegisterDomain(
 func (a *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
 	ctx context.Context,
 	request *types.RequestCancelWorkflowExecutionRequest,
-) error {
+) (retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendRequestCancelWorkflowExecutionScope, request)
 
@@ -468,6 +515,13 @@ func (a *AccessControlledWorkflowHandler) RequestCancelWorkflowExecution(
 		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionWrite,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+			RunID:      request.GetWorkflowExecution().GetRunID(),
+			Identity:   request.GetIdentity(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return err
@@ -507,7 +561,7 @@ func (a *AccessControlledWorkflowHandler) ResetStickyTaskList(
 func (a *AccessControlledWorkflowHandler) ResetWorkflowExecution(
 	ctx context.Context,
 	request *types.ResetWorkflowExecutionRequest,
-) (*types.ResetWorkflowExecutionResponse, error) {
+) (resp *types.ResetWorkflowExecutionResponse, retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendResetWorkflowExecutionScope, request)
 
@@ -516,6 +570,13 @@ func (a *AccessControlledWorkflowHandler) ResetWorkflowExecution(
 		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionWrite,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+			RunID:      request.GetWorkflowExecution().GetRunID(),
+			Reason:     request.GetReason(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return nil, err
@@ -532,6 +593,19 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *types.RespondActivityTaskCanceledRequest,
 ) error {
+
+	attr, scope, err := a.getTaskTokenAttributes(metrics.FrontendRespondActivityTaskCanceledScope, "RespondActivityTaskCanceled", request.GetTaskToken())
+	if err != nil {
+		return err
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCanceled(ctx, request)
 }
 
@@ -540,6 +614,22 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceledByID(
 	ctx context.Context,
 	request *types.RespondActivityTaskCanceledByIDRequest,
 ) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRespondActivityTaskCanceledByIDScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "RespondActivityTaskCanceledByID",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCanceledByID(ctx, request)
 }
 
@@ -548,6 +638,19 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCompleted(
 	ctx context.Context,
 	request *types.RespondActivityTaskCompletedRequest,
 ) error {
+
+	attr, scope, err := a.getTaskTokenAttributes(metrics.FrontendRespondActivityTaskCompletedScope, "RespondActivityTaskCompleted", request.GetTaskToken())
+	if err != nil {
+		return err
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCompleted(ctx, request)
 }
 
@@ -556,6 +659,22 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCompletedByID(
 	ctx context.Context,
 	request *types.RespondActivityTaskCompletedByIDRequest,
 ) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRespondActivityTaskCompletedByIDScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "RespondActivityTaskCompletedByID",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCompletedByID(ctx, request)
 }
 
@@ -564,6 +683,19 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskFailed(
 	ctx context.Context,
 	request *types.RespondActivityTaskFailedRequest,
 ) error {
+
+	attr, scope, err := a.getTaskTokenAttributes(metrics.FrontendRespondActivityTaskFailedScope, "RespondActivityTaskFailed", request.GetTaskToken())
+	if err != nil {
+		return err
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskFailed(ctx, request)
 }
 
@@ -572,6 +704,22 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskFailedByID(
 	ctx context.Context,
 	request *types.RespondActivityTaskFailedByIDRequest,
 ) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendRespondActivityTaskFailedByIDScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "RespondActivityTaskFailedByID",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskFailedByID(ctx, request)
 }
 
@@ -580,6 +728,19 @@ func (a *AccessControlledWorkflowHandler) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	request *types.RespondDecisionTaskCompletedRequest,
 ) (*types.RespondDecisionTaskCompletedResponse, error) {
+
+	attr, scope, err := a.getTaskTokenAttributes(metrics.FrontendRespondDecisionTaskCompletedScope, "RespondDecisionTaskCompleted", request.GetTaskToken())
+	if err != nil {
+		return nil, err
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondDecisionTaskCompleted(ctx, request)
 }
 
@@ -588,6 +749,19 @@ func (a *AccessControlledWorkflowHandler) RespondDecisionTaskFailed(
 	ctx context.Context,
 	request *types.RespondDecisionTaskFailedRequest,
 ) error {
+
+	attr, scope, err := a.getTaskTokenAttributes(metrics.FrontendRespondDecisionTaskFailedScope, "RespondDecisionTaskFailed", request.GetTaskToken())
+	if err != nil {
+		return err
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondDecisionTaskFailed(ctx, request)
 }
 
@@ -596,6 +770,34 @@ func (a *AccessControlledWorkflowHandler) RespondQueryTaskCompleted(
 	ctx context.Context,
 	request *types.RespondQueryTaskCompletedRequest,
 ) error {
+
+	if request.GetTaskToken() == nil {
+		return errTaskTokenNotSet
+	}
+	queryTaskToken, err := a.tokenSerializer.DeserializeQueryTaskToken(request.TaskToken)
+	if err != nil || queryTaskToken.DomainID == "" {
+		return errInvalidTaskToken
+	}
+	domainName, err := a.GetDomainCache().GetDomainName(queryTaskToken.DomainID)
+	if err != nil {
+		return err
+	}
+	scope := a.getMetricsScopeWithDomainName(metrics.FrontendRespondQueryTaskCompletedScope, domainName)
+
+	attr := &authorization.Attributes{
+		APIName:    "RespondQueryTaskCompleted",
+		DomainName: domainName,
+		TaskList:   &types.TaskList{Name: queryTaskToken.TaskList},
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
 	return a.frontendHandler.RespondQueryTaskCompleted(ctx, request)
 }
 
@@ -627,7 +829,7 @@ func (a *AccessControlledWorkflowHandler) ScanWorkflowExecutions(
 func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *types.SignalWithStartWorkflowExecutionRequest,
-) (*types.StartWorkflowExecutionResponse, error) {
+) (resp *types.StartWorkflowExecutionResponse, retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalWithStartWorkflowExecutionScope, request)
 
@@ -636,7 +838,16 @@ func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 		DomainName:   request.GetDomain(),
 		Permission:   authorization.PermissionWrite,
 		WorkflowType: request.WorkflowType,
-	}
+		TaskList:     request.TaskList,
+	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowID(),
+			RunID:      resp.GetRunID(),
+			Reason:     request.GetSignalName(),
+			Identity:   request.GetIdentity(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return nil, err
@@ -652,7 +863,7 @@ func (a *AccessControlledWorkflowHandler) SignalWithStartWorkflowExecution(
 func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 	ctx context.Context,
 	request *types.SignalWorkflowExecutionRequest,
-) error {
+) (retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalWorkflowExecutionScope, request)
 
@@ -661,6 +872,14 @@ func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionWrite,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+			RunID:      request.GetWorkflowExecution().GetRunID(),
+			Reason:     request.GetSignalName(),
+			Identity:   request.GetIdentity(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return err
@@ -676,7 +895,7 @@ func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 	ctx context.Context,
 	request *types.StartWorkflowExecutionRequest,
-) (*types.StartWorkflowExecutionResponse, error) {
+) (resp *types.StartWorkflowExecutionResponse, retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendStartWorkflowExecutionScope, request)
 
@@ -685,7 +904,15 @@ func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 		DomainName:   request.GetDomain(),
 		Permission:   authorization.PermissionWrite,
 		WorkflowType: request.WorkflowType,
-	}
+		TaskList:     request.TaskList,
+	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowID(),
+			RunID:      resp.GetRunID(),
+			Identity:   request.GetIdentity(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return nil, err
@@ -701,7 +928,7 @@ func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 	ctx context.Context,
 	request *types.TerminateWorkflowExecutionRequest,
-) error {
+) (retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendTerminateWorkflowExecutionScope, request)
 
@@ -710,6 +937,14 @@ func (a *AccessControlledWorkflowHandler) TerminateWorkflowExecution(
 		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionWrite,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetWorkflowExecution().GetWorkflowID(),
+			RunID:      request.GetWorkflowExecution().GetRunID(),
+			Reason:     request.GetReason(),
+			Identity:   request.GetIdentity(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return err
@@ -732,6 +967,7 @@ func (a *AccessControlledWorkflowHandler) ListTaskListPartitions(
 	attr := &authorization.Attributes{
 		APIName:    "ListTaskListPartitions",
 		DomainName: request.GetDomain(),
+		TaskList:   request.TaskList,
 		Permission: authorization.PermissionRead,
 	}
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
@@ -769,7 +1005,7 @@ func (a *AccessControlledWorkflowHandler) GetTaskListsByDomain(
 	return a.frontendHandler.GetTaskListsByDomain(ctx, request)
 }
 
-func (a *AccessControlledWorkflowHandler) RefreshWorkflowTasks(ctx context.Context, request *types.RefreshWorkflowTasksRequest) error {
+func (a *AccessControlledWorkflowHandler) RefreshWorkflowTasks(ctx context.Context, request *types.RefreshWorkflowTasksRequest) (retError error) {
 
 	scope := a.getMetricsScopeWithDomain(metrics.FrontendRefreshWorkflowTasksScope, request)
 
@@ -778,6 +1014,12 @@ func (a *AccessControlledWorkflowHandler) RefreshWorkflowTasks(ctx context.Conte
 		DomainName: request.GetDomain(),
 		Permission: authorization.PermissionWrite,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{
+			WorkflowID: request.GetExecution().GetWorkflowID(),
+			RunID:      request.GetExecution().GetRunID(),
+		}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return err
@@ -793,7 +1035,7 @@ func (a *AccessControlledWorkflowHandler) RefreshWorkflowTasks(ctx context.Conte
 func (a *AccessControlledWorkflowHandler) UpdateDomain(
 	ctx context.Context,
 	request *types.UpdateDomainRequest,
-) (*types.UpdateDomainResponse, error) {
+) (resp *types.UpdateDomainResponse, retError error) {
 
 	scope := a.getMetricsScopeWithDomainName(metrics.FrontendUpdateDomainScope, request.GetName())
 
@@ -802,6 +1044,9 @@ func (a *AccessControlledWorkflowHandler) UpdateDomain(
 		DomainName: request.GetName(),
 		Permission: authorization.PermissionAdmin,
 	}
+	defer func() {
+		a.auditLog(ctx, attr, &audit.Record{}, retError)
+	}()
 	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
 	if err != nil {
 		return nil, err
@@ -833,6 +1078,62 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	return isAuth, nil
 }
 
+// auditLog records the result of a mutating API call
+func (a *AccessControlledWorkflowHandler) auditLog(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	record *audit.Record,
+	err error,
+) {
+	a.auditLogger.Log(ctx, fillAuditRecord(record, attr, err))
+}
+
+func fillAuditRecord(record *audit.Record, attr *authorization.Attributes, err error) *audit.Record {
+	record.APIName = attr.APIName
+	if attr.DomainName != "" {
+		record.DomainName = attr.DomainName
+	}
+	switch err {
+	case nil:
+		record.Result = audit.ResultSuccess
+	case errUnauthorized:
+		record.Result = audit.ResultUnauthorized
+	default:
+		record.Result = audit.ResultFailure
+		record.Error = err.Error()
+	}
+	return record
+}
+
+// getTaskTokenAttributes returns the authorization attributes and metrics scope for the APIs called with a task token
+func (a *AccessControlledWorkflowHandler) getTaskTokenAttributes(
+	scope int,
+	apiName string,
+	taskToken []byte,
+) (*authorization.Attributes, metrics.Scope, error) {
+	if taskToken == nil {
+		return nil, nil, errTaskTokenNotSet
+	}
+	token, err := a.tokenSerializer.Deserialize(taskToken)
+	if err != nil || token.DomainID == "" {
+		return nil, nil, errInvalidTaskToken
+	}
+	domainName, err := a.GetDomainCache().GetDomainName(token.DomainID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attr := &authorization.Attributes{
+		APIName:    apiName,
+		DomainName: domainName,
+		Permission: authorization.PermissionWrite,
+	}
+	if token.WorkflowType != "" {
+		attr.WorkflowType = &types.WorkflowType{Name: token.WorkflowType}
+	}
+	return attr, a.getMetricsScopeWithDomainName(scope, domainName), nil
+}
+
 // getMetricsScopeWithDomain return metrics scope with domain tag
 func (a *AccessControlledWorkflowHandler) getMetricsScopeWithDomain(
 	scope int,
@@ -29,11 +29,15 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -46,11 +50,25 @@ type (
 		mockFrontendHandler *MockHandler
 		mockAuthorizer      *authorization.MockAuthorizer
 		mockMetricsScope    *mocks.Scope
+		auditSink           *auditSink
 
 		handler *AccessControlledWorkflowHandler
 	}
 )
 
+type auditSink struct {
+	records []*audit.Record
+}
+
+func (s *auditSink) Write(_ context.Context, record *audit.Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *auditSink) Close() error {
+	return nil
+}
+
 func TestAccessControlledHandlerSuite(t *testing.T) {
 	s := new(accessControlledHandlerSuite)
 	suite.Run(t, s)
@@ -63,7 +81,9 @@ func (s *accessControlledHandlerSuite) SetupTest() {
 	s.mockFrontendHandler = NewMockHandler(s.controller)
 	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
 	s.mockMetricsScope = &mocks.Scope{}
-	s.handler = NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, config.Authorization{})
+	s.auditSink = &auditSink{}
+	auditLogger := audit.NewLoggerWithSink(s.auditSink, clock.NewRealTimeSource(), s.mockResource.GetLogger())
+	s.handler = NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, config.Authorization{}, auditLogger)
 }
 
 func (s *accessControlledHandlerSuite) TearDownTest() {
@@ -116,3 +136,107 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	s.False(res)
 	s.NoError(err)
 }
+
+func (s *accessControlledHandlerSuite) TestRespondDecisionTaskCompleted() {
+	ctx := context.Background()
+	taskToken, err := common.NewJSONTaskTokenSerializer().Serialize(&common.TaskToken{
+		DomainID:     "test-domain-id",
+		WorkflowID:   "test-workflow-id",
+		WorkflowType: "test-workflow-type",
+		RunID:        "test-run-id",
+	})
+	s.NoError(err)
+	request := &types.RespondDecisionTaskCompletedRequest{TaskToken: taskToken}
+
+	s.mockResource.DomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:      "RespondDecisionTaskCompleted",
+		DomainName:   "test-domain",
+		WorkflowType: &types.WorkflowType{Name: "test-workflow-type"},
+		Permission:   authorization.PermissionWrite,
+	}).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockFrontendHandler.EXPECT().RespondDecisionTaskCompleted(ctx, request).
+		Return(&types.RespondDecisionTaskCompletedResponse{}, nil).Times(1)
+
+	_, err = s.handler.RespondDecisionTaskCompleted(ctx, request)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondDecisionTaskCompleted_InvalidToken() {
+	_, err := s.handler.RespondDecisionTaskCompleted(context.Background(), &types.RespondDecisionTaskCompletedRequest{})
+	s.Equal(errTaskTokenNotSet, err)
+
+	_, err = s.handler.RespondDecisionTaskCompleted(context.Background(), &types.RespondDecisionTaskCompletedRequest{TaskToken: []byte("invalid")})
+	s.Equal(errInvalidTaskToken, err)
+}
+
+func (s *accessControlledHandlerSuite) TestRespondQueryTaskCompleted_Unauthorized() {
+	ctx := context.Background()
+	taskToken, err := common.NewJSONTaskTokenSerializer().SerializeQueryTaskToken(&common.QueryTaskToken{
+		DomainID: "test-domain-id",
+		TaskList: "test-task-list",
+		TaskID:   "test-task-id",
+	})
+	s.NoError(err)
+
+	s.mockResource.DomainCache.EXPECT().GetDomainName("test-domain-id").Return("test-domain", nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:    "RespondQueryTaskCompleted",
+		DomainName: "test-domain",
+		TaskList:   &types.TaskList{Name: "test-task-list"},
+		Permission: authorization.PermissionWrite,
+	}).Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+
+	err = s.handler.RespondQueryTaskCompleted(ctx, &types.RespondQueryTaskCompletedRequest{TaskToken: taskToken})
+	s.Equal(errUnauthorized, err)
+}
+
+func (s *accessControlledHandlerSuite) TestTerminateWorkflowExecution_Audit() {
+	ctx := context.Background()
+	request := &types.TerminateWorkflowExecutionRequest{
+		Domain:            "test-domain",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "test-workflow-id", RunID: "test-run-id"},
+		Reason:            "test-reason",
+		Identity:          "test-identity",
+	}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+	s.mockFrontendHandler.EXPECT().TerminateWorkflowExecution(ctx, request).Return(nil).Times(1)
+
+	s.NoError(s.handler.TerminateWorkflowExecution(ctx, request))
+	s.Equal(errUnauthorized, s.handler.TerminateWorkflowExecution(ctx, request))
+
+	s.Len(s.auditSink.records, 2)
+	for i, result := range []string{audit.ResultSuccess, audit.ResultUnauthorized} {
+		record := s.auditSink.records[i]
+		s.Equal("TerminateWorkflowExecution", record.APIName)
+		s.Equal("test-domain", record.DomainName)
+		s.Equal("test-workflow-id", record.WorkflowID)
+		s.Equal("test-run-id", record.RunID)
+		s.Equal("test-reason", record.Reason)
+		s.Equal("test-identity", record.Identity)
+		s.Equal(result, record.Result)
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestStartWorkflowExecution_AuditFailure() {
+	ctx := context.Background()
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:     "test-domain",
+		WorkflowID: "test-workflow-id",
+	}
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockFrontendHandler.EXPECT().StartWorkflowExecution(ctx, request).
+		Return(nil, &types.WorkflowExecutionAlreadyStartedError{Message: "already started"}).Times(1)
+
+	_, err := s.handler.StartWorkflowExecution(ctx, request)
+	s.Error(err)
+
+	s.Len(s.auditSink.records, 1)
+	s.Equal("test-workflow-id", s.auditSink.records[0].WorkflowID)
+	s.Equal(audit.ResultFailure, s.auditSink.records[0].Result)
+	s.Equal(err.Error(), s.auditSink.records[0].Error)
+}
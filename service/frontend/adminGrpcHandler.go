@@ -171,6 +171,16 @@ func (g adminGRPCHandler) MaintainCorruptWorkflow(ctx context.Context, request *
 	return proto.FromAdminMaintainWorkflowResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) RestoreWorkflow(ctx context.Context, request *adminv1.RestoreWorkflowRequest) (*adminv1.RestoreWorkflowResponse, error) {
+	err := g.h.RestoreWorkflow(ctx, proto.ToAdminRestoreWorkflowRequest(request))
+	return &adminv1.RestoreWorkflowResponse{}, proto.FromError(err)
+}
+
+func (g adminGRPCHandler) SearchArchivedHistory(ctx context.Context, request *adminv1.SearchArchivedHistoryRequest) (*adminv1.SearchArchivedHistoryResponse, error) {
+	response, err := g.h.SearchArchivedHistory(ctx, proto.ToAdminSearchArchivedHistoryRequest(request))
+	return proto.FromAdminSearchArchivedHistoryResponse(response), proto.FromError(err)
+}
+
 func (g adminGRPCHandler) ListDynamicConfig(ctx context.Context, request *adminv1.ListDynamicConfigRequest) (*adminv1.ListDynamicConfigResponse, error) {
 	response, err := g.h.ListDynamicConfig(ctx, proto.ToListDynamicConfigRequest(request))
 	return proto.FromListDynamicConfigResponse(response), proto.FromError(err)
@@ -35,6 +35,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/codec"
@@ -61,6 +62,7 @@ const (
 
 var (
 	errInvalidFilters = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+	errRunIDNotSet    = &types.BadRequestError{Message: "RunId is not set on request."}
 )
 
 type (
@@ -95,6 +97,8 @@ type (
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
 		DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
 		MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
+		RestoreWorkflow(context.Context, *types.AdminRestoreWorkflowRequest) error
+		SearchArchivedHistory(context.Context, *types.AdminSearchArchivedHistoryRequest) (*types.AdminSearchArchivedHistoryResponse, error)
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -1272,6 +1276,119 @@ func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// RestoreWorkflow re-creates a closed workflow run from its archived history
+func (adh *adminHandlerImpl) RestoreWorkflow(
+	ctx context.Context,
+	request *types.AdminRestoreWorkflowRequest,
+) (err error) {
+	defer log.CapturePanic(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRestoreWorkflowScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.GetExecution().GetRunID() == "" {
+		return adh.error(errRunIDNotSet, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetHistoryClient().RestoreWorkflow(ctx, &types.HistoryRestoreWorkflowRequest{
+		DomainUUID: domainEntry.GetInfo().ID,
+		Request:    request,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// SearchArchivedHistory returns the archived workflow runs whose histories match the query
+func (adh *adminHandlerImpl) SearchArchivedHistory(
+	ctx context.Context,
+	request *types.AdminSearchArchivedHistoryRequest,
+) (resp *types.AdminSearchArchivedHistoryResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminSearchArchivedHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetPageSize() <= 0 {
+		request.PageSize = int32(adh.config.VisibilityMaxPageSize(request.GetDomain()))
+	}
+	maxPageSize := adh.config.VisibilityArchivalQueryMaxPageSize()
+	if int(request.GetPageSize()) > maxPageSize {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Pagesize is larger than allowed %d", maxPageSize)}, scope)
+	}
+
+	if !adh.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
+		return nil, adh.error(&types.BadRequestError{Message: "Cluster is not configured for history archival"}, scope)
+	}
+	if !adh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled() {
+		return nil, adh.error(&types.BadRequestError{Message: "Cluster is not configured for reading archived histories"}, scope)
+	}
+
+	entry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if entry.GetConfig().HistoryArchivalStatus != types.ArchivalStatusEnabled {
+		return nil, adh.error(&types.BadRequestError{Message: "Domain is not configured for history archival"}, scope)
+	}
+
+	URI, err := archiver.NewURI(entry.GetConfig().HistoryArchivalURI)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	historyArchiver, err := adh.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.Frontend)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	historySearcher, ok := historyArchiver.(archiver.HistorySearcher)
+	if !ok {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("History archiver of scheme %v doesn't support searching", URI.Scheme())}, scope)
+	}
+
+	archiverResponse, err := historySearcher.Search(ctx, URI, &archiver.SearchHistoryRequest{
+		DomainID:      entry.GetInfo().ID,
+		Query:         request.GetQuery(),
+		PageSize:      int(request.GetPageSize()),
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp = &types.AdminSearchArchivedHistoryResponse{
+		NextPageToken: archiverResponse.NextPageToken,
+	}
+	for _, indexEntry := range archiverResponse.Executions {
+		resp.Executions = append(resp.Executions, &types.WorkflowExecutionInfo{
+			Execution: &types.WorkflowExecution{
+				WorkflowID: indexEntry.WorkflowID,
+				RunID:      indexEntry.RunID,
+			},
+		})
+	}
+	return resp, nil
+}
+
 // ResendReplicationTasks requests replication task from remote cluster
 func (adh *adminHandlerImpl) ResendReplicationTasks(
 	ctx context.Context,
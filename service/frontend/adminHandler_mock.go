@@ -450,6 +450,35 @@ func (mr *MockAdminHandlerMockRecorder) RestoreDynamicConfig(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).RestoreDynamicConfig), arg0, arg1)
 }
 
+// RestoreWorkflow mocks base method
+func (m *MockAdminHandler) RestoreWorkflow(arg0 context.Context, arg1 *types.AdminRestoreWorkflowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkflow indicates an expected call of RestoreWorkflow
+func (mr *MockAdminHandlerMockRecorder) RestoreWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflow", reflect.TypeOf((*MockAdminHandler)(nil).RestoreWorkflow), arg0, arg1)
+}
+
+// SearchArchivedHistory mocks base method
+func (m *MockAdminHandler) SearchArchivedHistory(arg0 context.Context, arg1 *types.AdminSearchArchivedHistoryRequest) (*types.AdminSearchArchivedHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchArchivedHistory", arg0, arg1)
+	ret0, _ := ret[0].(*types.AdminSearchArchivedHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchArchivedHistory indicates an expected call of SearchArchivedHistory
+func (mr *MockAdminHandlerMockRecorder) SearchArchivedHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArchivedHistory", reflect.TypeOf((*MockAdminHandler)(nil).SearchArchivedHistory), arg0, arg1)
+}
+
 // ListDynamicConfig mocks base method
 func (m *MockAdminHandler) ListDynamicConfig(arg0 context.Context, arg1 *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
@@ -669,6 +670,45 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 	}
 }
 
+func (s *adminHandlerSuite) Test_SearchArchivedHistory_InvalidRequest() {
+	ctx := context.Background()
+	handler := s.handler
+
+	_, err := handler.SearchArchivedHistory(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.SearchArchivedHistory(ctx, &types.AdminSearchArchivedHistoryRequest{
+		Query: "SignalName = 'some signal'",
+	})
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_SearchArchivedHistory_SearchNotSupported() {
+	ctx := context.Background()
+	handler := s.handler
+	handler.config.VisibilityArchivalQueryMaxPageSize = dynamicconfig.GetIntPropertyFn(1000)
+
+	s.mockResource.ArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig(
+		"enabled", dynamicconfig.GetStringPropertyFn("enabled"), dynamicconfig.GetBoolPropertyFn(true), "disabled", ""))
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    "test:///history/archival",
+		},
+		"",
+		nil)
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(domainEntry, nil).Times(1)
+	s.mockResource.ArchiverProvider.On("GetHistoryArchiver", "test", mock.Anything).Return(&archiver.HistoryArchiverMock{}, nil).Once()
+
+	_, err := handler.SearchArchivedHistory(ctx, &types.AdminSearchArchivedHistoryRequest{
+		Domain:   s.domainName,
+		PageSize: 10,
+		Query:    "SignalName = 'some signal'",
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *adminHandlerSuite) Test_ConfigStore_NilRequest() {
 	ctx := context.Background()
 	handler := s.handler
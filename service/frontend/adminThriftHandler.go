@@ -202,6 +202,18 @@ func (t AdminThriftHandler) MaintainCorruptWorkflow(ctx context.Context, request
 	return thrift.FromAdminMaintainWorkflowResponse(response), thrift.FromError(err)
 }
 
+// RestoreWorkflow forwards request to the underlying handler
+func (t AdminThriftHandler) RestoreWorkflow(ctx context.Context, request *admin.AdminRestoreWorkflowRequest) error {
+	err := t.h.RestoreWorkflow(ctx, thrift.ToAdminRestoreWorkflowRequest(request))
+	return thrift.FromError(err)
+}
+
+// SearchArchivedHistory forwards request to the underlying handler
+func (t AdminThriftHandler) SearchArchivedHistory(ctx context.Context, request *shared.ListArchivedWorkflowExecutionsRequest) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+	response, err := t.h.SearchArchivedHistory(ctx, thrift.ToListArchivedWorkflowExecutionsRequest(request))
+	return thrift.FromListArchivedWorkflowExecutionsResponse(response), thrift.FromError(err)
+}
+
 // ListDynamicConfig fetches all values associated to specified dc parameters or all otherwise
 func (t AdminThriftHandler) ListDynamicConfig(ctx context.Context, request *admin.ListDynamicConfigRequest) (*admin.ListDynamicConfigResponse, error) {
 	response, err := t.h.ListDynamicConfig(ctx, thrift.ToListDynamicConfigRequest(request))
@@ -144,6 +144,17 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.ResetQueue(ctx, &shared.ResetQueueRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RestoreWorkflow", func(t *testing.T) {
+		h.EXPECT().RestoreWorkflow(ctx, &types.AdminRestoreWorkflowRequest{}).Return(internalErr).Times(1)
+		err := th.RestoreWorkflow(ctx, &admin.AdminRestoreWorkflowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("SearchArchivedHistory", func(t *testing.T) {
+		h.EXPECT().SearchArchivedHistory(ctx, &types.AdminSearchArchivedHistoryRequest{}).Return(&types.AdminSearchArchivedHistoryResponse{}, internalErr).Times(1)
+		resp, err := th.SearchArchivedHistory(ctx, &shared.ListArchivedWorkflowExecutionsRequest{})
+		assert.Equal(t, shared.ListArchivedWorkflowExecutionsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetCrossClusterTasks", func(t *testing.T) {
 		h.EXPECT().GetCrossClusterTasks(ctx, &types.GetCrossClusterTasksRequest{}).Return(&types.GetCrossClusterTasksResponse{}, internalErr).Times(1)
 		resp, err := th.GetCrossClusterTasks(ctx, &shared.GetCrossClusterTasksRequest{})
@@ -27,10 +27,12 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/audit"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
@@ -188,6 +190,7 @@ type Service struct {
 	status       int32
 	handler      *WorkflowHandler
 	adminHandler AdminHandler
+	auditLogger  audit.Logger // the audit logger created by the service, closed when the service is stopped
 	stopC        chan struct{}
 	config       *Config
 	params       *resource.Params
@@ -272,7 +275,17 @@ func (s *Service) Start() {
 		handler = NewClusterRedirectionHandler(handler, s, s.config, *s.params.ClusterRedirectionPolicy)
 	}
 
-	handler = NewAccessControlledHandlerImpl(handler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	auditLogger := s.params.AuditLogger
+	if auditLogger == nil {
+		var err error
+		auditLogger, err = audit.NewLogger(s.params.AuditConfig, s.GetMessagingClient(), s.GetMetricsClient(), logger)
+		if err != nil {
+			logger.Fatal("Error when initiating the audit logger", tag.Error(err))
+		}
+		s.auditLogger = auditLogger
+	}
+
+	handler = NewAccessControlledHandlerImpl(handler, s, s.params.Authorizer, s.params.AuthorizationConfig, auditLogger)
 
 	// Register the latest (most decorated) handler
 	thriftHandler := NewThriftHandler(handler)
@@ -282,7 +295,7 @@ func (s *Service) Start() {
 	grpcHandler.register(s.GetDispatcher())
 
 	s.adminHandler = NewAdminHandler(s, s.params, s.config)
-	s.adminHandler = NewAccessControlledAdminHandlerImpl(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	s.adminHandler = NewAccessControlledAdminHandlerImpl(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig, auditLogger)
 
 	adminThriftHandler := NewAdminThriftHandler(s.adminHandler)
 	adminThriftHandler.register(s.GetDispatcher())
@@ -330,6 +343,12 @@ func (s *Service) Stop() {
 	s.GetLogger().Info("ShutdownHandler: Draining traffic")
 	time.Sleep(requestDrainTime)
 
+	if s.auditLogger != nil {
+		if err := s.auditLogger.Close(); err != nil {
+			s.GetLogger().Error("failed to close the audit logger", tag.Error(err))
+		}
+	}
+
 	close(s.stopC)
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
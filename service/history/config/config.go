@@ -53,6 +53,7 @@ type Config struct {
 	VisibilityOpenMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
 	VisibilityClosedMaxQPS          dynamicconfig.IntPropertyFnWithDomainFilter
 	AdvancedVisibilityWritingMode   dynamicconfig.StringPropertyFn
+	EnableESVisibilityDirectWrite   dynamicconfig.BoolPropertyFn
 	EmitShardDiffLog                dynamicconfig.BoolPropertyFn
 	MaxAutoResetPoints              dynamicconfig.IntPropertyFnWithDomainFilter
 	ThrottledLogRPS                 dynamicconfig.IntPropertyFn
@@ -252,6 +253,11 @@ type Config struct {
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// ElasticSearch direct write settings, used when visibility is not written through Kafka
+	ESVisibilityDirectWriteNumOfWorkers  dynamicconfig.IntPropertyFn
+	ESVisibilityDirectWriteBulkActions   dynamicconfig.IntPropertyFn
+	ESVisibilityDirectWriteFlushInterval dynamicconfig.DurationPropertyFn
+
 	// Decision settings
 	// StickyTTL is to expire a sticky tasklist if no update more than this duration
 	// TODO https://github.com/uber/cadence/issues/2357
@@ -371,6 +377,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		MaxAutoResetPoints:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryMaxAutoResetPoints, DefaultHistoryMaxAutoResetPoints),
 		MaxDecisionStartToCloseSeconds:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseSeconds, 240),
 		AdvancedVisibilityWritingMode:        dc.GetStringProperty(dynamicconfig.AdvancedVisibilityWritingMode, common.GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist)),
+		EnableESVisibilityDirectWrite:        dc.GetBoolProperty(dynamicconfig.EnableESVisibilityDirectWrite, false),
+		ESVisibilityDirectWriteNumOfWorkers:  dc.GetIntProperty(dynamicconfig.HistoryESVisibilityDirectWriteNumOfWorkers, 1),
+		ESVisibilityDirectWriteBulkActions:   dc.GetIntProperty(dynamicconfig.HistoryESVisibilityDirectWriteBulkActions, 500),
+		ESVisibilityDirectWriteFlushInterval: dc.GetDurationProperty(dynamicconfig.HistoryESVisibilityDirectWriteFlushInterval, 100*time.Millisecond),
 		EmitShardDiffLog:                     dc.GetBoolProperty(dynamicconfig.EmitShardDiffLog, false),
 		HistoryCacheInitialSize:              dc.GetIntProperty(dynamicconfig.HistoryCacheInitialSize, 128),
 		HistoryCacheMaxSize:                  dc.GetIntProperty(dynamicconfig.HistoryCacheMaxSize, 512),
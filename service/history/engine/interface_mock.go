@@ -592,6 +592,20 @@ func (mr *MockEngineMockRecorder) RefreshWorkflowTasks(ctx, domainUUID, executio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockEngine)(nil).RefreshWorkflowTasks), ctx, domainUUID, execution)
 }
 
+// RestoreWorkflow mocks base method
+func (m *MockEngine) RestoreWorkflow(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflow", ctx, domainUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkflow indicates an expected call of RestoreWorkflow
+func (mr *MockEngineMockRecorder) RestoreWorkflow(ctx, domainUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflow", reflect.TypeOf((*MockEngine)(nil).RestoreWorkflow), ctx, domainUUID, execution)
+}
+
 // ResetTransferQueue mocks base method
 func (m *MockEngine) ResetTransferQueue(ctx context.Context, clusterName string) error {
 	m.ctrl.T.Helper()
@@ -158,6 +158,11 @@ func (g grpcHandler) RefreshWorkflowTasks(ctx context.Context, request *historyv
 	return &historyv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) RestoreWorkflow(ctx context.Context, request *historyv1.RestoreWorkflowRequest) (*historyv1.RestoreWorkflowResponse, error) {
+	err := g.h.RestoreWorkflow(ctx, proto.ToHistoryRestoreWorkflowRequest(request))
+	return &historyv1.RestoreWorkflowResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) RemoveSignalMutableState(ctx context.Context, request *historyv1.RemoveSignalMutableStateRequest) (*historyv1.RemoveSignalMutableStateResponse, error) {
 	err := g.h.RemoveSignalMutableState(ctx, proto.ToHistoryRemoveSignalMutableStateRequest(request))
 	return &historyv1.RemoveSignalMutableStateResponse{}, proto.FromError(err)
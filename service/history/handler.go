@@ -85,6 +85,7 @@ type (
 		RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest) error
 		RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
+		RestoreWorkflow(context.Context, *types.HistoryRestoreWorkflowRequest) error
 		RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
@@ -1861,6 +1862,42 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// RestoreWorkflow re-creates a deleted workflow run from its archived history
+func (h *handlerImpl) RestoreWorkflow(
+	ctx context.Context,
+	request *types.HistoryRestoreWorkflowRequest) (retError error) {
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryRestoreWorkflowScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.DomainUUID
+	execution := request.GetRequest().GetExecution()
+	workflowID := execution.GetWorkflowID()
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	err = engine.RestoreWorkflow(
+		ctx,
+		domainID,
+		types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		},
+	)
+
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // NotifyFailoverMarkers sends the failover markers to failover coordinator.
 // The coordinator decides when the failover finishes based on received failover marker.
 func (h *handlerImpl) NotifyFailoverMarkers(
@@ -421,6 +421,20 @@ func (mr *MockHandlerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHandler)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// RestoreWorkflow mocks base method
+func (m *MockHandler) RestoreWorkflow(arg0 context.Context, arg1 *types.HistoryRestoreWorkflowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreWorkflow", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreWorkflow indicates an expected call of RestoreWorkflow
+func (mr *MockHandlerMockRecorder) RestoreWorkflow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWorkflow", reflect.TypeOf((*MockHandler)(nil).RestoreWorkflow), arg0, arg1)
+}
+
 // RemoveSignalMutableState mocks base method
 func (m *MockHandler) RemoveSignalMutableState(arg0 context.Context, arg1 *types.RemoveSignalMutableStateRequest) error {
 	m.ctrl.T.Helper()
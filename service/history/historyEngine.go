@@ -36,6 +36,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -3400,6 +3401,206 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// RestoreWorkflow re-creates a closed workflow run from its archived history.
+// The run is rebuilt on a new history branch and inserted without touching the
+// current execution record of the workflow ID. The restored run is kept for the
+// retention period of the domain counted from the time of the restore.
+func (e *historyEngineImpl) RestoreWorkflow(
+	ctx context.Context,
+	domainUUID string,
+	workflowExecution types.WorkflowExecution,
+) (retError error) {
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(domainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	URIString := domainEntry.GetConfig().HistoryArchivalURI
+	if URIString == "" {
+		return &types.BadRequestError{Message: "Domain is not configured for history archival."}
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return err
+	}
+	historyArchiver, err := e.shard.GetService().GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.History)
+	if err != nil {
+		return err
+	}
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	_, err = wfContext.LoadWorkflowExecution(ctx)
+	switch err.(type) {
+	case nil:
+		return &types.BadRequestError{Message: "Workflow execution still exists, only deleted runs can be restored."}
+	case *types.EntityNotExistsError:
+	default:
+		return err
+	}
+
+	workflowIdentifier := definition.NewWorkflowIdentifier(domainID, workflowExecution.GetWorkflowID(), workflowExecution.GetRunID())
+	branchToken, err := persistence.NewHistoryBranchToken(workflowExecution.GetRunID())
+	if err != nil {
+		return err
+	}
+
+	// the branch is removed if the restore fails before the run referencing it is created,
+	// so that retries don't leave orphan history branches behind
+	branchCreated, executionCreated := false, false
+	defer func() {
+		if retError != nil && branchCreated && !executionCreated {
+			e.deleteRestoredHistoryBranch(ctx, domainEntry.GetInfo().Name, branchToken)
+		}
+	}()
+
+	var lastEvent *types.HistoryEvent
+	var historySize int64
+	request := &archiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowID(),
+		RunID:      workflowExecution.GetRunID(),
+		PageSize:   execution.NDCDefaultPageSize,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, URI, request)
+		if err != nil {
+			return err
+		}
+		for _, batch := range resp.HistoryBatches {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			workflowEvents := &persistence.WorkflowEvents{
+				DomainID:    domainID,
+				WorkflowID:  workflowExecution.GetWorkflowID(),
+				RunID:       workflowExecution.GetRunID(),
+				BranchToken: branchToken,
+				Events:      batch.Events,
+			}
+			var size int64
+			branchCreated = true
+			if lastEvent == nil {
+				size, err = wfContext.PersistStartWorkflowBatchEvents(ctx, workflowEvents)
+			} else {
+				size, err = wfContext.PersistNonStartWorkflowBatchEvents(ctx, workflowEvents)
+			}
+			if err != nil {
+				return err
+			}
+			historySize += size
+			lastEvent = batch.Events[len(batch.Events)-1]
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	if lastEvent == nil {
+		return &types.EntityNotExistsError{Message: "Archived workflow history is empty."}
+	}
+
+	now := e.timeSource.Now()
+	mutableState, _, err := execution.NewStateRebuilder(e.shard, e.logger).Rebuild(
+		ctx,
+		now,
+		workflowIdentifier,
+		branchToken,
+		lastEvent.ID,
+		lastEvent.Version,
+		workflowIdentifier,
+		branchToken,
+		uuid.New(),
+	)
+	if err != nil {
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		return &types.BadRequestError{Message: "Archived workflow history does not end with a close event."}
+	}
+
+	// persistence only allows a closed run to be created as a zombie, so insert
+	// the run without tasks first and complete it with a bypass-current update
+	executionInfo := mutableState.GetExecutionInfo()
+	closeState, closeStatus := executionInfo.State, executionInfo.CloseStatus
+	executionInfo.State = persistence.WorkflowStateZombie
+	snapshot, _, err := mutableState.CloseTransactionAsSnapshot(now, execution.TransactionPolicyPassive)
+	if err != nil {
+		return err
+	}
+	snapshot.TransferTasks = nil
+	snapshot.CrossClusterTasks = nil
+	snapshot.ReplicationTasks = nil
+	snapshot.TimerTasks = nil
+	if err := wfContext.CreateWorkflowExecution(
+		ctx,
+		snapshot,
+		historySize,
+		persistence.CreateWorkflowModeZombie,
+		"",
+		0,
+	); err != nil {
+		// the run may have been created if the write timed out
+		_, executionCreated = err.(*persistence.TimeoutError)
+		return err
+	}
+	executionCreated = true
+
+	wfContext.Clear()
+	mutableState, err = wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	if err := mutableState.GetExecutionInfo().UpdateWorkflowStateCloseStatus(closeState, closeStatus); err != nil {
+		return err
+	}
+	// only the visibility close record and the retention timer are generated, the other
+	// close tasks, e.g. archival and parent close policy, were done when the run closed
+	// the first time, and its original close time is already past the retention
+	retention := time.Duration(domainEntry.GetRetentionDays(workflowExecution.GetWorkflowID())) * time.Hour * 24
+	mutableState.AddTransferTasks(&persistence.RecordWorkflowClosedTask{
+		// TaskID and VisibilityTimestamp are set by shard context
+		Version: lastEvent.Version,
+	})
+	mutableState.AddTimerTasks(&persistence.DeleteHistoryEventTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now.Add(retention),
+		Version:             lastEvent.Version,
+	})
+	return wfContext.UpdateWorkflowExecutionWithNew(
+		ctx,
+		now,
+		persistence.UpdateWorkflowModeBypassCurrent,
+		nil,
+		nil,
+		execution.TransactionPolicyPassive,
+		nil,
+	)
+}
+
+func (e *historyEngineImpl) deleteRestoredHistoryBranch(
+	ctx context.Context,
+	domainName string,
+	branchToken []byte,
+) {
+	ctx, cancel := e.newChildContext(ctx)
+	defer cancel()
+
+	if err := e.historyV2Mgr.DeleteHistoryBranch(ctx, &persistence.DeleteHistoryBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     common.IntPtr(e.shard.GetShardID()),
+	}); err != nil {
+		e.logger.Warn("Failed to delete history branch of failed workflow restore",
+			tag.WorkflowDomainName(domainName),
+			tag.Error(err))
+	}
+}
+
 func (e *historyEngineImpl) GetCrossClusterTasks(
 	ctx context.Context,
 	targetCluster string,
@@ -40,6 +40,7 @@ import (
 	hclient "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
@@ -48,6 +49,7 @@ import (
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/service/history/config"
@@ -5234,6 +5236,198 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestRestoreWorkflow_ArchivalNotConfigured() {
+	domainEntry := s.setupRestoreDomain("")
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-restore-workflow",
+		RunID:      constants.TestRunID,
+	}
+
+	err := s.mockHistoryEngine.RestoreWorkflow(context.Background(), domainEntry.GetInfo().ID, workflowExecution)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRestoreWorkflow_ExecutionExists() {
+	domainEntry := s.setupRestoreDomain("test:///history/archival")
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-restore-workflow",
+		RunID:      constants.TestRunID,
+	}
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	s.mockShard.Resource.ArchiverProvider.On("GetHistoryArchiver", "test", service.History).Return(historyArchiver, nil).Once()
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		domainEntry,
+	)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+
+	err := s.mockHistoryEngine.RestoreWorkflow(context.Background(), domainEntry.GetInfo().ID, workflowExecution)
+	s.IsType(&types.BadRequestError{}, err)
+	historyArchiver.AssertExpectations(s.T())
+}
+
+func (s *engineSuite) TestRestoreWorkflow_Success() {
+	domainEntry := s.setupRestoreDomain("test:///history/archival")
+	domainID := domainEntry.GetInfo().ID
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-restore-workflow",
+		RunID:      constants.TestRunID,
+	}
+	history := s.archivedHistoryForRestore(domainEntry, workflowExecution)
+	lastEvent := history[len(history)-1].Events[len(history[len(history)-1].Events)-1]
+
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	s.mockShard.Resource.ArchiverProvider.On("GetHistoryArchiver", "test", service.History).Return(historyArchiver, nil).Once()
+	historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.DomainID == domainID && request.WorkflowID == workflowExecution.WorkflowID && request.RunID == workflowExecution.RunID
+	})).Return(&archiver.GetHistoryResponse{HistoryBatches: history}, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 100}, nil).Times(len(history))
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: history,
+		Size:    100 * len(history),
+	}, nil).Once()
+
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		createRequest = request
+		return true
+	})).Return(&persistence.CreateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(
+		func(context.Context, *persistence.GetWorkflowExecutionRequest) *persistence.GetWorkflowExecutionResponse {
+			snapshot := createRequest.NewWorkflowSnapshot
+			return &persistence.GetWorkflowExecutionResponse{State: &persistence.WorkflowMutableState{
+				ExecutionInfo:       snapshot.ExecutionInfo,
+				ExecutionStats:      snapshot.ExecutionStats,
+				VersionHistories:    snapshot.VersionHistories,
+				ActivityInfos:       map[int64]*persistence.ActivityInfo{},
+				TimerInfos:          map[string]*persistence.TimerInfo{},
+				ChildExecutionInfos: map[int64]*persistence.ChildExecutionInfo{},
+				RequestCancelInfos:  map[int64]*persistence.RequestCancelInfo{},
+				SignalInfos:         map[int64]*persistence.SignalInfo{},
+				SignalRequestedIDs:  map[string]struct{}{},
+			}}
+		}, nil).Once()
+	var updateRequest *persistence.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		updateRequest = request
+		return true
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	before := time.Now()
+	err := s.mockHistoryEngine.RestoreWorkflow(context.Background(), domainID, workflowExecution)
+	after := time.Now()
+	s.NoError(err)
+	historyArchiver.AssertExpectations(s.T())
+
+	// the run is created as a zombie without tasks
+	s.Equal(persistence.CreateWorkflowModeZombie, createRequest.Mode)
+	s.Equal(persistence.WorkflowStateZombie, createRequest.NewWorkflowSnapshot.ExecutionInfo.State)
+	s.Empty(createRequest.NewWorkflowSnapshot.TransferTasks)
+	s.Empty(createRequest.NewWorkflowSnapshot.TimerTasks)
+
+	// and completed with the visibility close record and a retention timer starting now
+	mutation := updateRequest.UpdateWorkflowMutation
+	s.Equal(persistence.UpdateWorkflowModeBypassCurrent, updateRequest.Mode)
+	s.Equal(persistence.WorkflowStateCompleted, mutation.ExecutionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusCompleted, mutation.ExecutionInfo.CloseStatus)
+	s.Len(mutation.TransferTasks, 1)
+	s.IsType(&persistence.RecordWorkflowClosedTask{}, mutation.TransferTasks[0])
+	s.Empty(mutation.CrossClusterTasks)
+	s.Len(mutation.TimerTasks, 1)
+	deleteTask, ok := mutation.TimerTasks[0].(*persistence.DeleteHistoryEventTask)
+	s.True(ok)
+	s.Equal(lastEvent.Version, deleteTask.Version)
+	retention := time.Duration(domainEntry.GetRetentionDays(workflowExecution.WorkflowID)) * time.Hour * 24
+	s.False(deleteTask.VisibilityTimestamp.Before(before.Add(retention)))
+	s.False(deleteTask.VisibilityTimestamp.After(after.Add(retention)))
+}
+
+func (s *engineSuite) TestRestoreWorkflow_DeleteBranchOnFailure() {
+	domainEntry := s.setupRestoreDomain("test:///history/archival")
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "test-restore-workflow",
+		RunID:      constants.TestRunID,
+	}
+	history := s.archivedHistoryForRestore(domainEntry, workflowExecution)
+
+	historyArchiver := &archiver.HistoryArchiverMock{}
+	s.mockShard.Resource.ArchiverProvider.On("GetHistoryArchiver", "test", service.History).Return(historyArchiver, nil).Once()
+	historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.NextPageToken == nil
+	})).Return(&archiver.GetHistoryResponse{HistoryBatches: history[:1], NextPageToken: []byte("next page")}, nil).Once()
+	historyArchiver.On("Get", mock.Anything, mock.Anything, mock.MatchedBy(func(request *archiver.GetHistoryRequest) bool {
+		return request.NextPageToken != nil
+	})).Return(nil, errors.New("archival storage is unavailable")).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{}).Once()
+
+	var branchToken []byte
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.MatchedBy(func(request *persistence.AppendHistoryNodesRequest) bool {
+		branchToken = request.BranchToken
+		return true
+	})).Return(&persistence.AppendHistoryNodesResponse{Size: 100}, nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything, mock.MatchedBy(func(request *persistence.DeleteHistoryBranchRequest) bool {
+		return request.BranchToken != nil && string(request.BranchToken) == string(branchToken)
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RestoreWorkflow(context.Background(), domainEntry.GetInfo().ID, workflowExecution)
+	s.EqualError(err, "archival storage is unavailable")
+	historyArchiver.AssertExpectations(s.T())
+}
+
+func (s *engineSuite) setupRestoreDomain(historyArchivalURI string) *cache.DomainCacheEntry {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: uuid.New(), Name: "test-restore-domain"},
+		&persistence.DomainConfig{
+			Retention:             3,
+			HistoryArchivalStatus: types.ArchivalStatusEnabled,
+			HistoryArchivalURI:    historyArchivalURI,
+		},
+		cluster.TestCurrentClusterName,
+		constants.TestClusterMetadata,
+	)
+	domainID := domainEntry.GetInfo().ID
+	s.mockDomainCache.EXPECT().GetActiveDomainByID(domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainName(domainID).Return(domainEntry.GetInfo().Name, nil).AnyTimes()
+	return domainEntry
+}
+
+// archivedHistoryForRestore returns the history batches of a run which completed a month ago
+func (s *engineSuite) archivedHistoryForRestore(
+	domainEntry *cache.DomainCacheEntry,
+	workflowExecution types.WorkflowExecution,
+) []*types.History {
+	msBuilder := execution.NewMutableStateBuilderWithVersionHistoriesWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		domainEntry.GetFailoverVersion(),
+		workflowExecution.GetRunID(),
+		domainEntry,
+	)
+	identity := "testIdentity"
+	tl := "testTaskList"
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tl, []byte("input"), 100, 10, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	completedEvent := test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.ID, nil, identity)
+	test.AddCompleteWorkflowEvent(msBuilder, completedEvent.ID, nil)
+
+	events := msBuilder.GetHistoryBuilder().GetHistory().Events
+	closeTime := time.Now().Add(-30 * 24 * time.Hour).UnixNano()
+	for _, event := range events {
+		event.Timestamp = common.Int64Ptr(closeTime)
+	}
+	return []*types.History{
+		{Events: events[:2]},
+		{Events: events[2:3]},
+		{Events: events[3:]},
+	}
+}
+
 func (s *engineSuite) getBuilder(testDomainID string, we types.WorkflowExecution) execution.MutableState {
 	context, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(testDomainID, we)
 	if err != nil {
@@ -104,7 +104,12 @@ func New(
 
 			ESVisibilityListMaxQPS: nil, // history service never read,
 			ESIndexMaxResultWindow: nil, // history service never read,
-			ValidSearchAttributes:  nil, // history service never read,
+			ValidSearchAttributes:  config.ValidSearchAttributes,
+
+			EnableESVisibilityDirectWrite:        config.EnableESVisibilityDirectWrite,
+			ESVisibilityDirectWriteNumOfWorkers:  config.ESVisibilityDirectWriteNumOfWorkers,
+			ESVisibilityDirectWriteBulkActions:   config.ESVisibilityDirectWriteBulkActions,
+			ESVisibilityDirectWriteFlushInterval: config.ESVisibilityDirectWriteFlushInterval,
 		},
 	)
 	if err != nil {
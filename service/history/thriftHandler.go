@@ -181,6 +181,12 @@ func (t ThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *h.Refr
 	return thrift.FromError(err)
 }
 
+// RestoreWorkflow forwards request to the underlying handler
+func (t ThriftHandler) RestoreWorkflow(ctx context.Context, request *h.RestoreWorkflowRequest) error {
+	err := t.h.RestoreWorkflow(ctx, thrift.ToHistoryRestoreWorkflowRequest(request))
+	return thrift.FromError(err)
+}
+
 // RemoveSignalMutableState forwards request to the underlying handler
 func (t ThriftHandler) RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error {
 	err := t.h.RemoveSignalMutableState(ctx, thrift.ToRemoveSignalMutableStateRequest(request))
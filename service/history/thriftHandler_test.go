@@ -193,6 +193,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("RestoreWorkflow", func(t *testing.T) {
+		h.EXPECT().RestoreWorkflow(ctx, &types.HistoryRestoreWorkflowRequest{Request: &types.AdminRestoreWorkflowRequest{}}).Return(internalErr).Times(1)
+		err := th.RestoreWorkflow(ctx, &hist.RestoreWorkflowRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveSignalMutableState", func(t *testing.T) {
 		h.EXPECT().RemoveSignalMutableState(ctx, &types.RemoveSignalMutableStateRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveSignalMutableState(ctx, &hist.RemoveSignalMutableStateRequest{})
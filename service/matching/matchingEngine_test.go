@@ -989,10 +989,11 @@ func (s *matchingEngineSuite) TestConcurrentPublishConsumeDecisions() {
 				s.EqualValues(startedEventID, result.StartedEventID)
 				s.EqualValues(workflowExecution, *result.WorkflowExecution)
 				token := &common.TaskToken{
-					DomainID:   domainID,
-					WorkflowID: workflowID,
-					RunID:      runID,
-					ScheduleID: scheduleID,
+					DomainID:     domainID,
+					WorkflowID:   workflowID,
+					WorkflowType: workflowTypeName,
+					RunID:        runID,
+					ScheduleID:   scheduleID,
 				}
 				resultToken, err := s.matchingEngine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -1305,10 +1306,11 @@ func (s *matchingEngineSuite) TestMultipleEnginesDecisionsRangeStealing() {
 				s.EqualValues(startedEventID, result.StartedEventID)
 				s.EqualValues(workflowExecution, *result.WorkflowExecution)
 				token := &common.TaskToken{
-					DomainID:   domainID,
-					WorkflowID: workflowID,
-					RunID:      runID,
-					ScheduleID: scheduleID,
+					DomainID:     domainID,
+					WorkflowID:   workflowID,
+					WorkflowType: workflowType.Name,
+					RunID:        runID,
+					ScheduleID:   scheduleID,
 				}
 				resultToken, err := engine.tokenSerializer.Deserialize(result.TaskToken)
 				if err != nil {
@@ -0,0 +1,126 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/archiver"
+)
+
+type (
+	// Config defines the configuration for the archival backfill workflows
+	Config struct {
+		// ArchivalBackfillRPS is the rate limit of the closed workflows submitted for archival on a worker host
+		ArchivalBackfillRPS dynamicconfig.IntPropertyFn
+		// NumArchiveSystemWorkflows is the number of archival system workflows the archive requests are spread over
+		NumArchiveSystemWorkflows dynamicconfig.IntPropertyFn
+		// AllowArchivingIncompleteHistory continues archiving a history on errors like history mutated
+		AllowArchivingIncompleteHistory dynamicconfig.BoolPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the archival backfiller
+	BootstrapParams struct {
+		// Config contains the configuration for the archival backfiller
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// Resource gives access to the domain cache, visibility, persistence and archivers
+		Resource resource.Resource
+		// NumShards is the number of history shards
+		NumShards int
+	}
+
+	// Backfiller runs the workflows archiving the closed workflows of a domain which were
+	// closed before archival was enabled for the domain
+	Backfiller struct {
+		cfg            Config
+		svcClient      workflowserviceclient.Interface
+		metricsClient  metrics.Client
+		tallyScope     tally.Scope
+		logger         log.Logger
+		resource       resource.Resource
+		numShards      int
+		archiverClient archiver.Client
+		rateLimiter    quotas.Limiter
+	}
+)
+
+// New returns a new instance of Backfiller
+func New(params *BootstrapParams) *Backfiller {
+	cfg := params.Config
+	rps := cfg.ArchivalBackfillRPS.AsFloat64()
+	return &Backfiller{
+		cfg:           cfg,
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger,
+		resource:      params.Resource,
+		numShards:     params.NumShards,
+		archiverClient: archiver.NewClient(
+			params.MetricsClient,
+			params.Logger,
+			params.ServiceClient,
+			cfg.NumArchiveSystemWorkflows,
+			// the backfill never archives inline, the inline rate limiters are unused
+			quotas.NewDynamicRateLimiter(rps),
+			quotas.NewDynamicRateLimiter(rps),
+			quotas.NewDynamicRateLimiter(rps),
+			params.Resource.GetArchiverProvider(),
+			cfg.AllowArchivingIncompleteHistory,
+		),
+		rateLimiter: quotas.NewDynamicRateLimiter(rps),
+	}
+}
+
+// Start starts the worker
+func (b *Backfiller) Start() error {
+	ctx := context.WithValue(context.Background(), backfillerContextKey, b)
+	workerOpts := worker.Options{
+		MetricsScope:              b.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	backfillWorker := worker.New(b.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	backfillWorker.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	backfillWorker.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	return backfillWorker.Start()
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/archiver"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	backfillerContextKey contextKey = "archivalBackfillerContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-archival-backfill-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-archival-backfill-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow IDs, there is one workflow per domain
+	WorkflowIDPrefix = "cadence-archival-backfill-"
+
+	backfillActivityName = "cadence-sys-archival-backfill-activity"
+
+	defaultPageSize = 100
+
+	errMsgParamsIsNil        = "params is nil"
+	errMsgDomainNameIsEmpty  = "domain name is empty"
+	errMsgTimeRangeIsInvalid = "earliest time is after latest time"
+	errMsgArchivalNotEnabled = "neither history nor visibility archival is enabled for the domain"
+)
+
+var errNothingToArchive = errors.New("nothing to archive")
+
+type (
+	// BackfillParams is the arg for BackfillWorkflow
+	BackfillParams struct {
+		// DomainName is the domain whose closed workflows are archived
+		DomainName string
+		// EarliestTime and LatestTime in unix nanos bound the closed workflows to archive,
+		// they are matched the same way as by ListClosedWorkflowExecutions
+		EarliestTime int64
+		LatestTime   int64
+		// PageSize is the number of closed workflows read from visibility at a time
+		PageSize int
+	}
+
+	// BackfillResult is the workflow and activity result
+	BackfillResult struct {
+		// Submitted is the number of closed workflows submitted to the archival system workflows
+		Submitted int
+		// Skipped is the number of closed workflows with nothing left to archive
+		Skipped int
+		// Failed is the number of closed workflows which couldn't be submitted
+		Failed int
+	}
+
+	heartbeatDetails struct {
+		NextPageToken []byte
+		Result        BackfillResult
+	}
+)
+
+// BackfillWorkflow is the workflow that submits the closed workflows of a domain for archival
+func BackfillWorkflow(ctx workflow.Context, params *BackfillParams) (*BackfillResult, error) {
+	err := validateParams(params)
+	if err != nil {
+		return nil, err
+	}
+	if params.LatestTime <= 0 {
+		params.LatestTime = workflow.Now(ctx).UnixNano()
+	}
+
+	var result BackfillResult
+	ao := workflow.WithActivityOptions(ctx, getBackfillActivityOptions())
+	err = workflow.ExecuteActivity(ao, BackfillActivity, params).Get(ctx, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func getBackfillActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    7 * 24 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       7 * 24 * time.Hour,
+			NonRetriableErrorReasons: []string{errMsgArchivalNotEnabled},
+		},
+	}
+}
+
+func validateParams(params *BackfillParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.DomainName == "" {
+		return errors.New(errMsgDomainNameIsEmpty)
+	}
+	if params.LatestTime > 0 && params.EarliestTime > params.LatestTime {
+		return errors.New(errMsgTimeRangeIsInvalid)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	return nil
+}
+
+// BackfillActivity walks the closed workflows of a domain in visibility and submits them for archival,
+// it resumes from the last page recorded in its heartbeat when it's retried
+func BackfillActivity(ctx context.Context, params *BackfillParams) (*BackfillResult, error) {
+	b := getBackfiller(ctx)
+	logger := activity.GetLogger(ctx).With(zap.String("DomainName", params.DomainName))
+	domainEntry, err := b.resource.GetDomainCache().GetDomain(params.DomainName)
+	if err != nil {
+		return nil, err
+	}
+	archivalMetadata := b.resource.GetArchivalMetadata()
+	archiveHistory := archivalMetadata.GetHistoryConfig().ClusterConfiguredForArchival() &&
+		domainEntry.GetConfig().HistoryArchivalStatus == types.ArchivalStatusEnabled
+	archiveVisibility := archivalMetadata.GetVisibilityConfig().ClusterConfiguredForArchival() &&
+		domainEntry.GetConfig().VisibilityArchivalStatus == types.ArchivalStatusEnabled
+	if !archiveHistory && !archiveVisibility {
+		return nil, errors.New(errMsgArchivalNotEnabled)
+	}
+
+	var hbd heartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			logger.Warn("Failed to get heartbeat details, starting from the first page", zap.Error(err))
+			hbd = heartbeatDetails{}
+		}
+	}
+
+	scope := b.metricsClient.Scope(metrics.ArchivalBackfillScope, metrics.DomainTag(params.DomainName))
+	for {
+		response, err := b.resource.GetFrontendClient().ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+			Domain:          params.DomainName,
+			MaximumPageSize: int32(params.PageSize),
+			NextPageToken:   hbd.NextPageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(params.EarliestTime),
+				LatestTime:   common.Int64Ptr(params.LatestTime),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range response.Executions {
+			if err := b.rateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+			err := b.archive(ctx, domainEntry, info, archiveHistory, archiveVisibility)
+			switch {
+			case err == errNothingToArchive:
+				hbd.Result.Skipped++
+				scope.IncCounter(metrics.ArchivalBackfillNumWorkflowsSkipped)
+			case err != nil:
+				logger.Error("Failed to submit closed workflow for archival",
+					zap.String("WorkflowID", info.GetExecution().GetWorkflowID()),
+					zap.String("RunID", info.GetExecution().GetRunID()),
+					zap.Error(err))
+				hbd.Result.Failed++
+				scope.IncCounter(metrics.ArchivalBackfillNumSubmitFailed)
+			default:
+				hbd.Result.Submitted++
+				scope.IncCounter(metrics.ArchivalBackfillNumWorkflowsSubmitted)
+			}
+		}
+		hbd.NextPageToken = response.NextPageToken
+		activity.RecordHeartbeat(ctx, hbd)
+		if len(hbd.NextPageToken) == 0 {
+			return &hbd.Result, nil
+		}
+	}
+}
+
+// archive submits a closed workflow to the archival system workflows, its history is only archived
+// if it's still in persistence
+func (b *Backfiller) archive(
+	ctx context.Context,
+	domainEntry *cache.DomainCacheEntry,
+	info *types.WorkflowExecutionInfo,
+	archiveHistory bool,
+	archiveVisibility bool,
+) error {
+	config := domainEntry.GetConfig()
+	request := &archiver.ArchiveRequest{
+		DomainID:           domainEntry.GetInfo().ID,
+		DomainName:         domainEntry.GetInfo().Name,
+		WorkflowID:         info.GetExecution().GetWorkflowID(),
+		RunID:              info.GetExecution().GetRunID(),
+		URI:                config.HistoryArchivalURI,
+		WorkflowTypeName:   info.GetType().GetName(),
+		StartTimestamp:     info.GetStartTime(),
+		ExecutionTimestamp: info.GetExecutionTime(),
+		CloseTimestamp:     info.GetCloseTime(),
+		CloseStatus:        info.GetCloseStatus(),
+		HistoryLength:      info.GetHistoryLength(),
+		Memo:               info.GetMemo(),
+		SearchAttributes:   info.GetSearchAttributes().GetIndexedFields(),
+		VisibilityURI:      config.VisibilityArchivalURI,
+	}
+	if archiveHistory {
+		found, err := b.fillHistory(ctx, request)
+		if err != nil {
+			return err
+		}
+		if found {
+			request.Targets = append(request.Targets, archiver.ArchiveTargetHistory)
+		}
+	}
+	if archiveVisibility {
+		request.Targets = append(request.Targets, archiver.ArchiveTargetVisibility)
+	}
+	if len(request.Targets) == 0 {
+		return errNothingToArchive
+	}
+
+	_, err := b.archiverClient.Archive(ctx, &archiver.ClientRequest{
+		ArchiveRequest: request,
+		CallerService:  service.Worker,
+	})
+	return err
+}
+
+// fillHistory sets the history branch of a closed workflow on the archive request,
+// it returns false if the workflow is already deleted from persistence
+func (b *Backfiller) fillHistory(ctx context.Context, request *archiver.ArchiveRequest) (bool, error) {
+	shardID := common.WorkflowIDToHistoryShard(request.WorkflowID, b.numShards)
+	executionManager, err := b.resource.GetExecutionManager(shardID)
+	if err != nil {
+		return false, err
+	}
+	response, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID: request.DomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: request.WorkflowID,
+			RunID:      request.RunID,
+		},
+	})
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	executionInfo := response.State.ExecutionInfo
+	request.ShardID = shardID
+	request.BranchToken = executionInfo.BranchToken
+	request.NextEventID = executionInfo.NextEventID
+	request.CloseFailoverVersion = common.EmptyVersion
+	if versionHistories := response.State.VersionHistories; versionHistories != nil {
+		currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return false, err
+		}
+		lastItem, err := currentVersionHistory.GetLastItem()
+		if err != nil {
+			return false, err
+		}
+		request.BranchToken = currentVersionHistory.GetBranchToken()
+		request.CloseFailoverVersion = lastItem.Version
+	}
+	return true, nil
+}
+
+func getBackfiller(ctx context.Context) *Backfiller {
+	return ctx.Value(backfillerContextKey).(*Backfiller)
+}
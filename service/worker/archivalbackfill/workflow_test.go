@@ -0,0 +1,368 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalbackfill
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/archiver"
+)
+
+const (
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+	testNumShards  = 4
+)
+
+type backfillActivityTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	controller     *gomock.Controller
+	resource       *resource.Test
+	archiverClient *archiver.ClientMock
+	backfiller     *Backfiller
+	activityEnv    *testsuite.TestActivityEnvironment
+}
+
+type backfillWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestBackfillWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(backfillWorkflowTestSuite))
+}
+
+func (s *backfillWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+}
+
+func (s *backfillWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *backfillWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	s.Error(validateParams(&BackfillParams{}))
+	s.Error(validateParams(&BackfillParams{DomainName: "test-domain", EarliestTime: 2, LatestTime: 1}))
+
+	params := &BackfillParams{DomainName: "test-domain", EarliestTime: 2}
+	s.NoError(validateParams(params))
+	s.Equal(defaultPageSize, params.PageSize)
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow() {
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.MatchedBy(func(params *BackfillParams) bool {
+		return params.DomainName == "test-domain" && params.LatestTime > 0 && params.PageSize == defaultPageSize
+	})).Return(&BackfillResult{Submitted: 10, Skipped: 2, Failed: 1}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &BackfillParams{DomainName: "test-domain"})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	var result BackfillResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(BackfillResult{Submitted: 10, Skipped: 2, Failed: 1}, result)
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &BackfillParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func TestBackfillActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(backfillActivityTestSuite))
+}
+
+func (s *backfillActivityTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.resource = resource.NewTest(s.controller, metrics.Worker)
+	s.resource.ArchivalMetadata.On("GetHistoryConfig").Return(s.archivalConfig()).Maybe()
+	s.resource.ArchivalMetadata.On("GetVisibilityConfig").Return(s.archivalConfig()).Maybe()
+	s.archiverClient = &archiver.ClientMock{}
+
+	s.backfiller = &Backfiller{
+		metricsClient:  metrics.NewNoopMetricsClient(),
+		logger:         s.resource.GetLogger(),
+		resource:       s.resource,
+		numShards:      testNumShards,
+		archiverClient: s.archiverClient,
+		rateLimiter:    quotas.NewSimpleRateLimiter(10000),
+	}
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), backfillerContextKey, s.backfiller),
+	})
+}
+
+func (s *backfillActivityTestSuite) TearDownTest() {
+	s.controller.Finish()
+	s.resource.Finish(s.T())
+	s.archiverClient.AssertExpectations(s.T())
+}
+
+func (s *backfillActivityTestSuite) TestBackfillActivity() {
+	s.mockDomain(types.ArchivalStatusEnabled, types.ArchivalStatusEnabled)
+	s.mockListClosed(nil, []byte("next"), "archived", "deleted")
+	s.mockListClosed([]byte("next"), nil, "failed")
+	s.mockExecution("archived", true)
+	s.mockExecution("deleted", false)
+	s.mockExecution("failed", true)
+	s.mockArchive("archived", nil, archiver.ArchiveTargetHistory, archiver.ArchiveTargetVisibility)
+	s.mockArchive("deleted", nil, archiver.ArchiveTargetVisibility)
+	s.mockArchive("failed", errors.New("some error"), archiver.ArchiveTargetHistory, archiver.ArchiveTargetVisibility)
+
+	value, err := s.activityEnv.ExecuteActivity(backfillActivityName, &BackfillParams{
+		DomainName:   testDomainName,
+		EarliestTime: 1,
+		LatestTime:   2,
+		PageSize:     2,
+	})
+	s.NoError(err)
+	var result BackfillResult
+	s.NoError(value.Get(&result))
+	s.Equal(BackfillResult{Submitted: 2, Failed: 1}, result)
+}
+
+func (s *backfillActivityTestSuite) TestBackfillActivity_ResumeFromHeartbeat() {
+	s.mockDomain(types.ArchivalStatusEnabled, types.ArchivalStatusDisabled)
+	s.mockListClosed([]byte("next"), nil, "archived", "deleted")
+	s.mockExecution("archived", true)
+	s.mockExecution("deleted", false)
+	s.mockArchive("archived", nil, archiver.ArchiveTargetHistory)
+
+	s.activityEnv.SetHeartbeatDetails(heartbeatDetails{
+		NextPageToken: []byte("next"),
+		Result:        BackfillResult{Submitted: 5, Failed: 1},
+	})
+	value, err := s.activityEnv.ExecuteActivity(backfillActivityName, &BackfillParams{
+		DomainName:   testDomainName,
+		EarliestTime: 1,
+		LatestTime:   2,
+		PageSize:     2,
+	})
+	s.NoError(err)
+	var result BackfillResult
+	s.NoError(value.Get(&result))
+	s.Equal(BackfillResult{Submitted: 6, Skipped: 1, Failed: 1}, result)
+}
+
+func (s *backfillActivityTestSuite) TestBackfillActivity_ArchivalNotEnabled() {
+	s.mockDomain(types.ArchivalStatusDisabled, types.ArchivalStatusDisabled)
+
+	_, err := s.activityEnv.ExecuteActivity(backfillActivityName, &BackfillParams{
+		DomainName: testDomainName,
+		LatestTime: 2,
+		PageSize:   2,
+	})
+	s.Error(err)
+	s.Contains(err.Error(), errMsgArchivalNotEnabled)
+}
+
+func (s *backfillActivityTestSuite) TestArchive_HistoryDeleted() {
+	domainEntry := s.newDomainEntry(types.ArchivalStatusEnabled, types.ArchivalStatusDisabled)
+	s.mockExecution("deleted", false)
+
+	err := s.backfiller.archive(context.Background(), domainEntry, getClosedRecord("deleted"), true, false)
+	s.Equal(errNothingToArchive, err)
+}
+
+func (s *backfillActivityTestSuite) TestFillHistory() {
+	s.mockExecution("archived", true)
+	request := &archiver.ArchiveRequest{DomainID: testDomainID, WorkflowID: "archived", RunID: "archived-run"}
+	found, err := s.backfiller.fillHistory(context.Background(), request)
+	s.NoError(err)
+	s.True(found)
+	s.Equal(common.WorkflowIDToHistoryShard("archived", testNumShards), request.ShardID)
+	s.Equal([]byte("current-branch"), request.BranchToken)
+	s.Equal(int64(10), request.NextEventID)
+	s.Equal(int64(7), request.CloseFailoverVersion)
+}
+
+func (s *backfillActivityTestSuite) TestFillHistory_WithoutVersionHistories() {
+	s.resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(&persistence.GetWorkflowExecutionResponse{
+			State: &persistence.WorkflowMutableState{
+				ExecutionInfo: &persistence.WorkflowExecutionInfo{
+					BranchToken: []byte("branch"),
+					NextEventID: 10,
+				},
+			},
+		}, nil).Once()
+	request := &archiver.ArchiveRequest{DomainID: testDomainID, WorkflowID: "archived", RunID: "archived-run"}
+	found, err := s.backfiller.fillHistory(context.Background(), request)
+	s.NoError(err)
+	s.True(found)
+	s.Equal([]byte("branch"), request.BranchToken)
+	s.Equal(common.EmptyVersion, request.CloseFailoverVersion)
+}
+
+func (s *backfillActivityTestSuite) TestFillHistory_Error() {
+	s.resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).
+		Return(nil, errors.New("some error")).Once()
+	found, err := s.backfiller.fillHistory(context.Background(), &archiver.ArchiveRequest{
+		DomainID:   testDomainID,
+		WorkflowID: "archived",
+		RunID:      "archived-run",
+	})
+	s.Error(err)
+	s.False(found)
+}
+
+func (s *backfillActivityTestSuite) archivalConfig() carchiver.ArchivalConfig {
+	return carchiver.NewArchivalConfig(
+		"enabled",
+		dynamicconfig.GetStringPropertyFn("enabled"),
+		dynamicconfig.GetBoolPropertyFn(true),
+		"disabled",
+		"",
+	)
+}
+
+func (s *backfillActivityTestSuite) newDomainEntry(historyStatus types.ArchivalStatus, visibilityStatus types.ArchivalStatus) *cache.DomainCacheEntry {
+	return cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName},
+		&persistence.DomainConfig{
+			Retention:                3,
+			HistoryArchivalStatus:    historyStatus,
+			HistoryArchivalURI:       "file:///history",
+			VisibilityArchivalStatus: visibilityStatus,
+			VisibilityArchivalURI:    "file:///visibility",
+		},
+		"active",
+		nil,
+	)
+}
+
+func (s *backfillActivityTestSuite) mockDomain(historyStatus types.ArchivalStatus, visibilityStatus types.ArchivalStatus) {
+	s.resource.DomainCache.EXPECT().GetDomain(testDomainName).Return(s.newDomainEntry(historyStatus, visibilityStatus), nil)
+}
+
+func (s *backfillActivityTestSuite) mockListClosed(pageToken []byte, nextPageToken []byte, workflowIDs ...string) {
+	var executions []*types.WorkflowExecutionInfo
+	for _, workflowID := range workflowIDs {
+		executions = append(executions, getClosedRecord(workflowID))
+	}
+	s.resource.FrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), &types.ListClosedWorkflowExecutionsRequest{
+		Domain:          testDomainName,
+		MaximumPageSize: 2,
+		NextPageToken:   pageToken,
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(1),
+			LatestTime:   common.Int64Ptr(2),
+		},
+	}).Return(&types.ListClosedWorkflowExecutionsResponse{
+		Executions:    executions,
+		NextPageToken: nextPageToken,
+	}, nil)
+}
+
+func (s *backfillActivityTestSuite) mockExecution(workflowID string, exists bool) {
+	call := s.resource.ExecutionMgr.On("GetWorkflowExecution", mock.Anything, &persistence.GetWorkflowExecutionRequest{
+		DomainID: testDomainID,
+		Execution: types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      workflowID + "-run",
+		},
+	})
+	if !exists {
+		call.Return(nil, &types.EntityNotExistsError{}).Once()
+		return
+	}
+	versionHistory := persistence.NewVersionHistory([]byte("current-branch"), []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(9, 7),
+	})
+	call.Return(&persistence.GetWorkflowExecutionResponse{
+		State: &persistence.WorkflowMutableState{
+			ExecutionInfo: &persistence.WorkflowExecutionInfo{
+				DomainID:    testDomainID,
+				WorkflowID:  workflowID,
+				RunID:       workflowID + "-run",
+				BranchToken: []byte("branch"),
+				NextEventID: 10,
+			},
+			VersionHistories: persistence.NewVersionHistories(versionHistory),
+		},
+	}, nil).Once()
+}
+
+func (s *backfillActivityTestSuite) mockArchive(workflowID string, err error, targets ...archiver.ArchivalTarget) {
+	s.archiverClient.On("Archive", mock.Anything, mock.MatchedBy(func(request *archiver.ClientRequest) bool {
+		archiveRequest := request.ArchiveRequest
+		if archiveRequest.WorkflowID != workflowID || request.AttemptArchiveInline {
+			return false
+		}
+		if len(archiveRequest.Targets) != len(targets) {
+			return false
+		}
+		for i, target := range targets {
+			if archiveRequest.Targets[i] != target {
+				return false
+			}
+		}
+		hasHistory := len(targets) != 0 && targets[0] == archiver.ArchiveTargetHistory
+		return archiveRequest.DomainID == testDomainID &&
+			archiveRequest.RunID == workflowID+"-run" &&
+			archiveRequest.URI == "file:///history" &&
+			archiveRequest.VisibilityURI == "file:///visibility" &&
+			archiveRequest.CloseStatus == types.WorkflowExecutionCloseStatusCompleted &&
+			bytes.Equal(archiveRequest.BranchToken, []byte("current-branch")) == hasHistory
+	})).Return(&archiver.ClientResponse{}, err).Once()
+}
+
+func getClosedRecord(workflowID string) *types.WorkflowExecutionInfo {
+	return &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      workflowID + "-run",
+		},
+		Type:        &types.WorkflowType{Name: "test-workflow-type"},
+		StartTime:   common.Int64Ptr(1),
+		CloseTime:   common.Int64Ptr(2),
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalscavenger
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+type (
+	// Scavenger is the background sub-system that deletes the archived histories and visibility
+	// records of the domains which are older than the archival retention period of the domain
+	Scavenger struct {
+		svcClient          workflowserviceclient.Interface
+		logger             log.Logger
+		scopedMetricClient metrics.Scope
+		tallyScope         tally.Scope
+		resource           resource.Resource
+		domainCache        cache.DomainCache
+		config             *Config
+	}
+
+	// Config contains all configs for the archival scavenger
+	Config struct {
+		ArchivalScavengerPause dynamicconfig.BoolPropertyFn
+	}
+)
+
+const startUpDelay = time.Second * 10
+
+// New returns a new instance as daemon
+func New(
+	svcClient workflowserviceclient.Interface,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	tallyScope tally.Scope,
+	resource resource.Resource,
+	domainCache cache.DomainCache,
+	config *Config,
+) *Scavenger {
+	return &Scavenger{
+		svcClient:          svcClient,
+		logger:             logger,
+		scopedMetricClient: metricsClient.Scope(metrics.ArchivalScavengerScope),
+		tallyScope:         tallyScope,
+		resource:           resource,
+		domainCache:        domainCache,
+		config:             config,
+	}
+}
+
+// Start starts the scavenger
+func (s *Scavenger) Start() error {
+	ctx := context.Background()
+	s.StartWorkflow(ctx)
+
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	scavengerWorker := worker.New(s.svcClient, common.SystemLocalDomainName, taskListName, workerOpts)
+	return scavengerWorker.Start()
+}
+
+// StartWorkflow starts the cron workflow of the scavenger if it is not running yet
+func (s *Scavenger) StartWorkflow(ctx context.Context) {
+	initWorkflow(s)
+	go workercommon.StartWorkflowWithRetry(scavengerWFTypeName, startUpDelay, s.resource, func(client cclient.Client) error {
+		_, err := client.StartWorkflow(ctx, wfOptions, scavengerWFTypeName)
+		switch err.(type) {
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			return nil
+		default:
+			s.logger.Error("Failed to start archival scavenger", tag.Error(err))
+			return err
+		}
+	})
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalscavenger
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/provider"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	// workflow constants
+	scavengerWFID          = "cadence-sys-archival-scavenger"
+	taskListName           = "cadence-sys-archival-scavenger-tl"
+	scavengerWFTypeName    = "cadence-sys-archival-scavenger-workflow"
+	getDomainsActivity     = "cadence-sys-archival-scavenger-get-domains"
+	scavengeDomainActivity = "cadence-sys-archival-scavenger-scavenge-domain"
+)
+
+type (
+	// Workflow is the workflow of the archival scavenger
+	Workflow struct {
+		scavenger *Scavenger
+	}
+)
+
+var (
+	retryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: time.Hour,
+	}
+
+	getDomainsOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &retryPolicy,
+	}
+	scavengeDomainOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		RetryPolicy:            &retryPolicy,
+	}
+
+	wfOptions = cclient.StartWorkflowOptions{
+		ID:                           scavengerWFID,
+		TaskList:                     taskListName,
+		ExecutionStartToCloseTimeout: 24 * time.Hour,
+		CronSchedule:                 "0 0 * * *", // "At 00:00" => every day
+	}
+)
+
+func initWorkflow(s *Scavenger) {
+	w := Workflow{scavenger: s}
+	workflow.RegisterWithOptions(w.workflowFunc, workflow.RegisterOptions{Name: scavengerWFTypeName})
+	activity.RegisterWithOptions(w.getDomains, activity.RegisterOptions{Name: getDomainsActivity})
+	activity.RegisterWithOptions(w.scavengeDomain, activity.RegisterOptions{Name: scavengeDomainActivity})
+}
+
+// workflowFunc deletes the archived data older than the archival retention period of each domain
+func (w *Workflow) workflowFunc(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+	if w.scavenger.config.ArchivalScavengerPause() {
+		logger.Info("Skipping archival scavenger execution cycle since it was paused")
+		return nil
+	}
+
+	var domains []string
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, getDomainsOptions),
+		getDomainsActivity,
+	).Get(ctx, &domains)
+	if err != nil {
+		return err
+	}
+
+	for _, domainName := range domains {
+		err := workflow.ExecuteActivity(
+			workflow.WithActivityOptions(ctx, scavengeDomainOptions),
+			scavengeDomainActivity,
+			domainName,
+		).Get(ctx, nil)
+		if err != nil {
+			// a domain which can't be scavenged shouldn't block the scavenging of the others
+			logger.Error("Failed to scavenge archived data of domain",
+				zap.Error(err),
+				zap.String("DomainName", domainName))
+		}
+	}
+	return nil
+}
+
+// getDomains is activity to get the domains with an archival retention period and an archival URI
+func (w *Workflow) getDomains(ctx context.Context) ([]string, error) {
+	var domains []string
+	for _, domainEntry := range w.scavenger.domainCache.GetAllDomain() {
+		if !shouldScavenge(domainEntry.GetConfig()) {
+			continue
+		}
+		domains = append(domains, domainEntry.GetInfo().Name)
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// scavengeDomain is activity to delete the archived histories and visibility records of a domain
+// which were archived before the archival retention period of the domain
+func (w *Workflow) scavengeDomain(ctx context.Context, domainName string) error {
+	logger := activity.GetLogger(ctx).With(zap.String("DomainName", domainName))
+	domainEntry, err := w.scavenger.domainCache.GetDomain(domainName)
+	if err != nil {
+		logger.Error("Failed to get domain entry", zap.Error(err))
+		return err
+	}
+	config := domainEntry.GetConfig()
+	if !shouldScavenge(config) {
+		return nil
+	}
+
+	domainID := domainEntry.GetInfo().ID
+	archivedBefore := time.Now().Add(-common.DaysToDuration(config.ArchivalRetention)).UnixNano()
+	scope := w.scavenger.scopedMetricClient.Tagged(metrics.DomainTag(domainName))
+	archiverProvider := w.scavenger.resource.GetArchiverProvider()
+
+	if config.HistoryArchivalURI != "" {
+		err := w.deleteHistory(ctx, archiverProvider, config.HistoryArchivalURI, &carchiver.DeleteHistoryRequest{
+			DomainID:       domainID,
+			ArchivedBefore: archivedBefore,
+		})
+		if err != nil {
+			logger.Error("Failed to delete archived histories", zap.Error(err))
+			scope.IncCounter(metrics.ArchivalScavengerNumDeleteHistoryFailed)
+			return err
+		}
+	}
+	if config.VisibilityArchivalURI != "" {
+		err := w.deleteVisibility(ctx, archiverProvider, config.VisibilityArchivalURI, &carchiver.DeleteVisibilityRequest{
+			DomainID:       domainID,
+			ArchivedBefore: archivedBefore,
+		})
+		if err != nil {
+			logger.Error("Failed to delete archived visibility records", zap.Error(err))
+			scope.IncCounter(metrics.ArchivalScavengerNumDeleteVisibilityFailed)
+			return err
+		}
+	}
+	scope.IncCounter(metrics.ArchivalScavengerNumDomainsScavenged)
+	return nil
+}
+
+func (w *Workflow) deleteHistory(
+	ctx context.Context,
+	archiverProvider provider.ArchiverProvider,
+	uri string,
+	request *carchiver.DeleteHistoryRequest,
+) error {
+	URI, err := carchiver.NewURI(uri)
+	if err != nil {
+		return err
+	}
+	historyArchiver, err := archiverProvider.GetHistoryArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		return err
+	}
+	return historyArchiver.Delete(ctx, URI, request)
+}
+
+func (w *Workflow) deleteVisibility(
+	ctx context.Context,
+	archiverProvider provider.ArchiverProvider,
+	uri string,
+	request *carchiver.DeleteVisibilityRequest,
+) error {
+	URI, err := carchiver.NewURI(uri)
+	if err != nil {
+		return err
+	}
+	visibilityArchiver, err := archiverProvider.GetVisibilityArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		return err
+	}
+	return visibilityArchiver.Delete(ctx, URI, request)
+}
+
+func shouldScavenge(config *persistence.DomainConfig) bool {
+	return config != nil &&
+		config.ArchivalRetention > 0 &&
+		(config.HistoryArchivalURI != "" || config.VisibilityArchivalURI != "")
+}
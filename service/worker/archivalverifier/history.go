@@ -0,0 +1,247 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"strings"
+
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/persistence"
+	persistenceutils "github.com/uber/cadence/common/persistence/persistence-utils"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// sourceHistory is the history of a closed workflow which is still in persistence
+	sourceHistory struct {
+		shardID              int
+		branchToken          []byte
+		nextEventID          int64
+		closeFailoverVersion int64
+		events               []*types.HistoryEvent
+	}
+
+	verificationStatus int
+)
+
+const (
+	statusVerified verificationStatus = iota
+	statusArchiveMissing
+	statusArchiveInvalid
+	statusArchiveMismatch
+)
+
+var closeEventTypes = map[types.EventType]struct{}{
+	types.EventTypeWorkflowExecutionCompleted:      {},
+	types.EventTypeWorkflowExecutionFailed:         {},
+	types.EventTypeWorkflowExecutionTimedOut:       {},
+	types.EventTypeWorkflowExecutionCanceled:       {},
+	types.EventTypeWorkflowExecutionTerminated:     {},
+	types.EventTypeWorkflowExecutionContinuedAsNew: {},
+}
+
+func (s verificationStatus) String() string {
+	switch s {
+	case statusVerified:
+		return "verified"
+	case statusArchiveMissing:
+		return "archive missing"
+	case statusArchiveInvalid:
+		return "archive invalid"
+	case statusArchiveMismatch:
+		return "archive mismatch"
+	default:
+		return "unknown"
+	}
+}
+
+func (s *sourceHistory) getEvents() []*types.HistoryEvent {
+	if s == nil {
+		return nil
+	}
+	return s.events
+}
+
+// getSourceHistory reads the history of a closed workflow from persistence,
+// it returns nil if the workflow or its history is already deleted
+func (v *Verifier) getSourceHistory(
+	ctx context.Context,
+	domainID string,
+	execution *types.WorkflowExecution,
+) (*sourceHistory, error) {
+	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowID(), v.numShards)
+	executionManager, err := v.resource.GetExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	response, err := executionManager.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		DomainID:  domainID,
+		Execution: *execution,
+	})
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	executionInfo := response.State.ExecutionInfo
+	source := &sourceHistory{
+		shardID:              shardID,
+		branchToken:          executionInfo.BranchToken,
+		nextEventID:          executionInfo.NextEventID,
+		closeFailoverVersion: common.EmptyVersion,
+	}
+	if versionHistories := response.State.VersionHistories; versionHistories != nil {
+		currentVersionHistory, err := versionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return nil, err
+		}
+		lastItem, err := currentVersionHistory.GetLastItem()
+		if err != nil {
+			return nil, err
+		}
+		source.branchToken = currentVersionHistory.GetBranchToken()
+		source.closeFailoverVersion = lastItem.Version
+	}
+
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: source.branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  source.nextEventID,
+		PageSize:    common.GetHistoryMaxPageSize,
+		ShardID:     common.IntPtr(shardID),
+	}
+	for {
+		events, _, nextPageToken, err := persistenceutils.ReadFullPageV2Events(ctx, v.resource.GetHistoryManager(), request)
+		if err != nil {
+			if common.IsEntityNotExistsError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		source.events = append(source.events, events...)
+		if len(nextPageToken) == 0 {
+			return source, nil
+		}
+		request.NextPageToken = nextPageToken
+	}
+}
+
+// readArchivedHistory reads all the pages of an archived history,
+// it returns nil if the history is not archived
+func readArchivedHistory(
+	ctx context.Context,
+	historyArchiver carchiver.HistoryArchiver,
+	URI carchiver.URI,
+	request *carchiver.GetHistoryRequest,
+) ([]*types.HistoryEvent, error) {
+	getRequest := *request
+	getRequest.NextPageToken = nil
+	var events []*types.HistoryEvent
+	for {
+		response, err := historyArchiver.Get(ctx, URI, &getRequest)
+		if err != nil {
+			if isHistoryNotArchivedError(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for _, batch := range response.HistoryBatches {
+			events = append(events, batch.Events...)
+		}
+		if len(response.NextPageToken) == 0 {
+			return events, nil
+		}
+		getRequest.NextPageToken = response.NextPageToken
+	}
+}
+
+func isHistoryNotArchivedError(err error) bool {
+	return common.IsEntityNotExistsError(err) || strings.Contains(err.Error(), carchiver.ErrHistoryNotExist.Error())
+}
+
+// verifyArchivedHistory validates the continuity of the archived history and compares it with
+// the history in persistence if it's given
+func verifyArchivedHistory(archived []*types.HistoryEvent, source []*types.HistoryEvent) verificationStatus {
+	if len(archived) == 0 {
+		return statusArchiveMissing
+	}
+	if err := validateHistoryEvents(archived); err != nil {
+		return statusArchiveInvalid
+	}
+	if source == nil {
+		return statusVerified
+	}
+	if len(archived) != len(source) {
+		return statusArchiveMismatch
+	}
+	archivedChecksum, err := historyChecksum(archived)
+	if err != nil {
+		return statusArchiveInvalid
+	}
+	sourceChecksum, err := historyChecksum(source)
+	if err != nil || archivedChecksum != sourceChecksum {
+		return statusArchiveMismatch
+	}
+	return statusVerified
+}
+
+// validateHistoryEvents checks that the history starts with the workflow started event,
+// ends with a workflow close event and has consecutive event IDs and non-decreasing versions
+func validateHistoryEvents(events []*types.HistoryEvent) error {
+	if len(events) == 0 {
+		return fmt.Errorf("history is empty")
+	}
+	if events[0].GetEventType() != types.EventTypeWorkflowExecutionStarted {
+		return fmt.Errorf("first event type is %v, expected %v", events[0].GetEventType(), types.EventTypeWorkflowExecutionStarted)
+	}
+	if _, ok := closeEventTypes[events[len(events)-1].GetEventType()]; !ok {
+		return fmt.Errorf("last event type %v is not a workflow close event", events[len(events)-1].GetEventType())
+	}
+	for i, event := range events {
+		if expectedID := common.FirstEventID + int64(i); event.ID != expectedID {
+			return fmt.Errorf("event ID is %v, expected %v", event.ID, expectedID)
+		}
+		if i > 0 && event.Version < events[i-1].Version {
+			return fmt.Errorf("event %v has version %v lower than the previous event", event.ID, event.Version)
+		}
+	}
+	return nil
+}
+
+// historyChecksum is the crc32 checksum of the JSON encoded history events
+func historyChecksum(events []*types.HistoryEvent) (uint32, error) {
+	var checksum uint32
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return 0, err
+		}
+		checksum = crc32.Update(checksum, crc32.IEEETable, data)
+	}
+	return checksum, nil
+}
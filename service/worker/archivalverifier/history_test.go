@@ -0,0 +1,90 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type historyVerificationSuite struct {
+	suite.Suite
+}
+
+func TestHistoryVerificationSuite(t *testing.T) {
+	suite.Run(t, new(historyVerificationSuite))
+}
+
+func (s *historyVerificationSuite) TestValidateHistoryEvents() {
+	s.NoError(validateHistoryEvents(s.historyEvents(5)))
+
+	s.Error(validateHistoryEvents(nil))
+
+	events := s.historyEvents(5)
+	events[0].EventType = types.EventTypeDecisionTaskScheduled.Ptr()
+	s.Error(validateHistoryEvents(events))
+
+	events = s.historyEvents(5)
+	events[4].EventType = types.EventTypeDecisionTaskCompleted.Ptr()
+	s.Error(validateHistoryEvents(events))
+
+	events = s.historyEvents(5)
+	events = append(events[:2], events[3:]...)
+	s.Error(validateHistoryEvents(events))
+
+	events = s.historyEvents(5)
+	events[3].Version = 0
+	s.Error(validateHistoryEvents(events))
+}
+
+func (s *historyVerificationSuite) TestVerifyArchivedHistory() {
+	s.Equal(statusArchiveMissing, verifyArchivedHistory(nil, s.historyEvents(5)))
+	s.Equal(statusVerified, verifyArchivedHistory(s.historyEvents(5), nil))
+	s.Equal(statusVerified, verifyArchivedHistory(s.historyEvents(5), s.historyEvents(5)))
+
+	archived := s.historyEvents(5)
+	archived[4].EventType = types.EventTypeDecisionTaskCompleted.Ptr()
+	s.Equal(statusArchiveInvalid, verifyArchivedHistory(archived, s.historyEvents(5)))
+
+	s.Equal(statusArchiveMismatch, verifyArchivedHistory(s.historyEvents(5), s.historyEvents(6)))
+
+	archived = s.historyEvents(5)
+	archived[2].Timestamp = common.Int64Ptr(12345)
+	s.Equal(statusArchiveMismatch, verifyArchivedHistory(archived, s.historyEvents(5)))
+}
+
+func (s *historyVerificationSuite) historyEvents(count int) []*types.HistoryEvent {
+	events := make([]*types.HistoryEvent, count)
+	for i := range events {
+		events[i] = &types.HistoryEvent{
+			ID:        common.FirstEventID + int64(i),
+			Version:   int64(i / 2),
+			EventType: types.EventTypeDecisionTaskScheduled.Ptr(),
+		}
+	}
+	events[0].EventType = types.EventTypeWorkflowExecutionStarted.Ptr()
+	events[count-1].EventType = types.EventTypeWorkflowExecutionCompleted.Ptr()
+	return events
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+type (
+	// Verifier is the background sub-system that samples closed workflows, reads their archived
+	// history back and re-archives the histories which are missing or broken in the archive
+	Verifier struct {
+		svcClient          workflowserviceclient.Interface
+		frontendClient     frontend.Client
+		logger             log.Logger
+		scopedMetricClient metrics.Scope
+		tallyScope         tally.Scope
+		resource           resource.Resource
+		domainCache        cache.DomainCache
+		numShards          int
+		config             *Config
+	}
+
+	// Config contains all configs for the archival verifier
+	Config struct {
+		ArchivalVerifierPause            dynamicconfig.BoolPropertyFn
+		ArchivalVerifierSamplesPerDomain dynamicconfig.IntPropertyFnWithDomainFilter
+		ArchivalVerifierTimeWindow       dynamicconfig.DurationPropertyFn
+		ArchivalVerifierMinCloseAge      dynamicconfig.DurationPropertyFn
+		ArchivalVerifierEnableReArchive  dynamicconfig.BoolPropertyFn
+	}
+)
+
+const startUpDelay = time.Second * 10
+
+// New returns a new instance as daemon
+func New(
+	svcClient workflowserviceclient.Interface,
+	frontendClient frontend.Client,
+	logger log.Logger,
+	metricsClient metrics.Client,
+	tallyScope tally.Scope,
+	resource resource.Resource,
+	domainCache cache.DomainCache,
+	numShards int,
+	config *Config,
+) *Verifier {
+	return &Verifier{
+		svcClient:          svcClient,
+		frontendClient:     frontendClient,
+		logger:             logger,
+		scopedMetricClient: metricsClient.Scope(metrics.ArchivalVerifierScope),
+		tallyScope:         tallyScope,
+		resource:           resource,
+		domainCache:        domainCache,
+		numShards:          numShards,
+		config:             config,
+	}
+}
+
+// Start starts the verifier
+func (v *Verifier) Start() error {
+	ctx := context.Background()
+	v.StartWorkflow(ctx)
+
+	workerOpts := worker.Options{
+		MetricsScope:              v.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	verifierWorker := worker.New(v.svcClient, common.SystemLocalDomainName, taskListName, workerOpts)
+	return verifierWorker.Start()
+}
+
+// StartWorkflow starts the cron workflow of the verifier if it is not running yet
+func (v *Verifier) StartWorkflow(ctx context.Context) {
+	initWorkflow(v)
+	go workercommon.StartWorkflowWithRetry(verifierWFTypeName, startUpDelay, v.resource, func(client cclient.Client) error {
+		_, err := client.StartWorkflow(ctx, wfOptions, verifierWFTypeName)
+		switch err.(type) {
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			return nil
+		default:
+			v.logger.Error("Failed to start archival verifier", tag.Error(err))
+			return err
+		}
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archivalverifier
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	carchiver "github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// workflow constants
+	verifierWFID         = "cadence-sys-archival-verifier"
+	taskListName         = "cadence-sys-archival-verifier-tl"
+	verifierWFTypeName   = "cadence-sys-archival-verifier-workflow"
+	getDomainsActivity   = "cadence-sys-archival-verifier-get-domains"
+	verifyDomainActivity = "cadence-sys-archival-verifier-verify-domain"
+)
+
+type (
+	// Workflow is the workflow of the archival verifier
+	Workflow struct {
+		verifier *Verifier
+	}
+)
+
+var (
+	retryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: time.Hour,
+	}
+
+	getDomainsOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &retryPolicy,
+	}
+	verifyDomainOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    30 * time.Minute,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &retryPolicy,
+	}
+
+	wfOptions = cclient.StartWorkflowOptions{
+		ID:                           verifierWFID,
+		TaskList:                     taskListName,
+		ExecutionStartToCloseTimeout: 24 * time.Hour,
+		CronSchedule:                 "0 * * * *", // "At minute 0" => every hour
+	}
+)
+
+func initWorkflow(v *Verifier) {
+	w := Workflow{verifier: v}
+	workflow.RegisterWithOptions(w.workflowFunc, workflow.RegisterOptions{Name: verifierWFTypeName})
+	activity.RegisterWithOptions(w.getDomains, activity.RegisterOptions{Name: getDomainsActivity})
+	activity.RegisterWithOptions(w.verifyDomain, activity.RegisterOptions{Name: verifyDomainActivity})
+}
+
+// workflowFunc verifies the archived history of a sample of closed workflows in each domain
+func (w *Workflow) workflowFunc(ctx workflow.Context) error {
+	logger := workflow.GetLogger(ctx)
+	if w.verifier.config.ArchivalVerifierPause() {
+		logger.Info("Skipping archival verifier execution cycle since it was paused")
+		return nil
+	}
+
+	var domains []string
+	err := workflow.ExecuteActivity(
+		workflow.WithActivityOptions(ctx, getDomainsOptions),
+		getDomainsActivity,
+	).Get(ctx, &domains)
+	if err != nil {
+		return err
+	}
+
+	for _, domainName := range domains {
+		err := workflow.ExecuteActivity(
+			workflow.WithActivityOptions(ctx, verifyDomainOptions),
+			verifyDomainActivity,
+			domainName,
+		).Get(ctx, nil)
+		if err != nil {
+			// a domain which can't be verified shouldn't block the verification of the others
+			logger.Error("Failed to verify archived histories of domain",
+				zap.Error(err),
+				zap.String("DomainName", domainName))
+		}
+	}
+	return nil
+}
+
+// getDomains is activity to get the domains with history archival enabled
+func (w *Workflow) getDomains(ctx context.Context) ([]string, error) {
+	var domains []string
+	for _, domainEntry := range w.verifier.domainCache.GetAllDomain() {
+		config := domainEntry.GetConfig()
+		if config == nil ||
+			config.HistoryArchivalStatus != types.ArchivalStatusEnabled ||
+			config.HistoryArchivalURI == "" {
+			continue
+		}
+		domains = append(domains, domainEntry.GetInfo().Name)
+	}
+	sort.Strings(domains)
+	return domains, nil
+}
+
+// verifyDomain is activity to verify the archived history of a sample of closed workflows in a domain
+// and to re-archive the histories which are missing or broken if they are still in persistence
+func (w *Workflow) verifyDomain(ctx context.Context, domainName string) error {
+	logger := activity.GetLogger(ctx).With(zap.String("DomainName", domainName))
+	domainEntry, err := w.verifier.domainCache.GetDomain(domainName)
+	if err != nil {
+		logger.Error("Failed to get domain entry", zap.Error(err))
+		return err
+	}
+	URI, err := carchiver.NewURI(domainEntry.GetConfig().HistoryArchivalURI)
+	if err != nil {
+		logger.Error("Failed to parse history archival URI", zap.Error(err))
+		return err
+	}
+	historyArchiver, err := w.verifier.resource.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.Worker)
+	if err != nil {
+		logger.Error("Failed to get history archiver", zap.Error(err))
+		return err
+	}
+
+	now := time.Now()
+	response, err := w.verifier.frontendClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+		Domain:          domainName,
+		MaximumPageSize: int32(w.verifier.config.ArchivalVerifierSamplesPerDomain(domainName)),
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(now.Add(-w.verifier.config.ArchivalVerifierTimeWindow()).UnixNano()),
+			LatestTime:   common.Int64Ptr(now.Add(-w.verifier.config.ArchivalVerifierMinCloseAge()).UnixNano()),
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to list closed workflows", zap.Error(err))
+		return err
+	}
+
+	scope := w.verifier.scopedMetricClient.Tagged(metrics.DomainTag(domainName))
+	for i, info := range response.Executions {
+		activity.RecordHeartbeat(ctx, i)
+		scope.IncCounter(metrics.ArchivalVerifierNumWorkflowsSampled)
+		w.verifyWorkflow(ctx, logger, scope, domainEntry, URI, historyArchiver, info.GetExecution())
+	}
+	return nil
+}
+
+func (w *Workflow) verifyWorkflow(
+	ctx context.Context,
+	logger *zap.Logger,
+	scope metrics.Scope,
+	domainEntry *cache.DomainCacheEntry,
+	URI carchiver.URI,
+	historyArchiver carchiver.HistoryArchiver,
+	execution *types.WorkflowExecution,
+) {
+	logger = logger.With(
+		zap.String("WorkflowID", execution.GetWorkflowID()),
+		zap.String("RunID", execution.GetRunID()))
+	domainID := domainEntry.GetInfo().ID
+
+	source, err := w.verifier.getSourceHistory(ctx, domainID, execution)
+	if err != nil {
+		logger.Error("Failed to read workflow history from persistence", zap.Error(err))
+		scope.IncCounter(metrics.ArchivalVerifierNumVerifyFailed)
+		return
+	}
+	getRequest := &carchiver.GetHistoryRequest{
+		DomainID:   domainID,
+		WorkflowID: execution.GetWorkflowID(),
+		RunID:      execution.GetRunID(),
+		PageSize:   common.GetHistoryMaxPageSize,
+	}
+	if source != nil {
+		getRequest.CloseFailoverVersion = common.Int64Ptr(source.closeFailoverVersion)
+	}
+	archived, err := readArchivedHistory(ctx, historyArchiver, URI, getRequest)
+	if err != nil {
+		logger.Error("Failed to read archived workflow history", zap.Error(err))
+		scope.IncCounter(metrics.ArchivalVerifierNumVerifyFailed)
+		return
+	}
+
+	status := verifyArchivedHistory(archived, source.getEvents())
+	switch status {
+	case statusVerified:
+		scope.IncCounter(metrics.ArchivalVerifierNumWorkflowsVerified)
+		return
+	case statusArchiveMissing:
+		scope.IncCounter(metrics.ArchivalVerifierNumArchiveMissing)
+	case statusArchiveInvalid:
+		scope.IncCounter(metrics.ArchivalVerifierNumArchiveInvalid)
+	case statusArchiveMismatch:
+		scope.IncCounter(metrics.ArchivalVerifierNumArchiveMismatch)
+	}
+	logger.Warn("Archived workflow history failed verification", zap.String("Status", status.String()))
+
+	if source == nil {
+		// history is already deleted from persistence after the retention period, nothing to archive from
+		scope.IncCounter(metrics.ArchivalVerifierNumUnrecoverable)
+		return
+	}
+	if !w.verifier.config.ArchivalVerifierEnableReArchive() {
+		return
+	}
+
+	err = historyArchiver.Archive(ctx, URI, &carchiver.ArchiveHistoryRequest{
+		ShardID:              source.shardID,
+		DomainID:             domainID,
+		DomainName:           domainEntry.GetInfo().Name,
+		WorkflowID:           execution.GetWorkflowID(),
+		RunID:                execution.GetRunID(),
+		BranchToken:          source.branchToken,
+		NextEventID:          source.nextEventID,
+		CloseFailoverVersion: source.closeFailoverVersion,
+	})
+	if err == nil {
+		archived, err = readArchivedHistory(ctx, historyArchiver, URI, getRequest)
+	}
+	if err != nil {
+		logger.Error("Failed to re-archive workflow history", zap.Error(err))
+		scope.IncCounter(metrics.ArchivalVerifierNumReArchiveFailed)
+		return
+	}
+	// some archivers keep the blobs which already exist, so only missing blobs can be repaired
+	if status := verifyArchivedHistory(archived, source.events); status != statusVerified {
+		logger.Error("Re-archived workflow history failed verification", zap.String("Status", status.String()))
+		scope.IncCounter(metrics.ArchivalVerifierNumReArchiveFailed)
+		return
+	}
+	logger.Info("Re-archived workflow history")
+	scope.IncCounter(metrics.ArchivalVerifierNumReArchived)
+}
@@ -22,7 +22,6 @@ package indexer
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"github.com/uber-go/tally"
@@ -122,7 +121,7 @@ func (p *esProcessorImpl) bulkAfterAction(id int64, requests []es.GenericBulkabl
 		// When cluster back to live, processor will re-commit those failure requests
 		p.logger.Error("Error commit bulk request.", tag.Error(err.Details))
 
-		isRetryable := isResponseRetriable(err.Status)
+		isRetryable := es.IsResponseRetriable(err.Status)
 		for _, request := range requests {
 			if !isRetryable {
 				key := p.processor.RetrieveKafkaKey(request, p.logger, p.metricsClient)
@@ -154,12 +153,12 @@ func (p *esProcessorImpl) bulkAfterAction(id int64, requests []es.GenericBulkabl
 		responseItem := responseItems[i]
 		for _, resp := range responseItem {
 			switch {
-			case isResponseSuccess(resp.Status):
+			case es.IsResponseSuccess(resp.Status):
 				p.ackKafkaMsg(key)
-			case !isResponseRetriable(resp.Status):
+			case !es.IsResponseRetriable(resp.Status):
 				wid, rid, domainID := p.getMsgWithInfo(key)
 				p.logger.Error("ES request failed.",
-					tag.ESResponseStatus(resp.Status), tag.ESResponseError(getErrorMsgFromESResp(resp)), tag.WorkflowID(wid), tag.WorkflowRunID(rid),
+					tag.ESResponseStatus(resp.Status), tag.ESResponseError(es.GetErrorMsgFromESResp(resp)), tag.WorkflowID(wid), tag.WorkflowRunID(rid),
 					tag.WorkflowDomainID(domainID))
 				p.nackKafkaMsg(key)
 			default: // bulk processor will retry
@@ -228,37 +227,6 @@ func (p *esProcessorImpl) hashFn(key interface{}) uint32 {
 	return uint32(common.WorkflowIDToHistoryShard(id, numOfShards))
 }
 
-// 409 - Version Conflict
-// 404 - Not Found
-func isResponseSuccess(status int) bool {
-	if status >= 200 && status < 300 || status == 409 || status == 404 {
-		return true
-	}
-	return false
-}
-
-// isResponseRetriable is complaint with GenericBulkProcessorService.RetryItemStatusCodes
-// responses with these status will be kept in queue and retried until success
-// 408 - Request Timeout
-// 429 - Too Many Requests
-// 500 - Node not connected
-// 503 - Service Unavailable
-// 507 - Insufficient Storage
-var retryableStatusCode = map[int]struct{}{408: {}, 429: {}, 500: {}, 503: {}, 507: {}}
-
-func isResponseRetriable(status int) bool {
-	_, ok := retryableStatusCode[status]
-	return ok
-}
-
-func getErrorMsgFromESResp(resp *es.GenericBulkResponseItem) string {
-	var errMsg string
-	if resp.Error != nil {
-		errMsg = fmt.Sprintf("%v", resp.Error)
-	}
-	return errMsg
-}
-
 func newKafkaMessageWithMetrics(kafkaMsg messaging.Message, stopwatch *tally.Stopwatch) *kafkaMessageWithMetrics {
 	return &kafkaMessageWithMetrics{
 		message:        kafkaMsg,
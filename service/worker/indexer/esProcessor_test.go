@@ -361,47 +361,3 @@ func (s *esProcessorSuite) TestGetMsgInfo_Error() {
 	s.Equal("", rid)
 	s.Equal("", domainID)
 }
-
-func (s *esProcessorSuite) TestIsResponseSuccess() {
-	for i := 200; i < 300; i++ {
-		s.True(isResponseSuccess(i))
-	}
-	status := []int{409, 404}
-	for _, code := range status {
-		s.True(isResponseSuccess(code))
-	}
-	status = []int{100, 199, 300, 400, 500, 408, 429, 503, 507}
-	for _, code := range status {
-		s.False(isResponseSuccess(code))
-	}
-}
-
-func (s *esProcessorSuite) TestIsResponseRetriable() {
-	status := []int{408, 429, 500, 503, 507}
-	for _, code := range status {
-		s.True(isResponseRetriable(code))
-	}
-}
-
-func (s *esProcessorSuite) TestIsErrorRetriable() {
-	tests := []struct {
-		input    *es.GenericError
-		expected bool
-	}{
-		{
-			input:    &es.GenericError{Status: 400},
-			expected: false,
-		},
-		{
-			input:    &es.GenericError{Status: 408},
-			expected: true,
-		},
-		{
-			input:    &es.GenericError{},
-			expected: false,
-		},
-	}
-	for _, test := range tests {
-		s.Equal(test.expected, isResponseRetriable(test.input.Status))
-	}
-}
@@ -21,7 +21,6 @@
 package indexer
 
 import (
-	"encoding/json"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -30,7 +29,6 @@ import (
 	"github.com/uber/cadence/.gen/go/indexer"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
-	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/elasticsearch"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
@@ -210,7 +208,8 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 	switch indexMsg.GetMessageType() {
 	case indexer.MessageTypeIndex:
 		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
-		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
+		doc := es.GenerateESDoc(indexMsg, keyToKafkaMsg, p.config.ValidSearchAttributes(), p.logger,
+			p.metricsClient.Scope(metrics.IndexProcessorScope), metrics.IndexProcessorCorruptedData)
 		req.Doc = doc
 		req.IsDelete = false
 	case indexer.MessageTypeDelete:
@@ -225,68 +224,3 @@ func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg mess
 	p.esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
 	return nil
 }
-
-func (p *indexProcessor) generateESDoc(msg *indexer.Message, keyToKafkaMsg string) map[string]interface{} {
-	doc := p.dumpFieldsToMap(msg.Fields)
-	fulfillDoc(doc, msg, keyToKafkaMsg)
-	return doc
-}
-
-func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) interface{} {
-	var val interface{}
-	err := json.Unmarshal(bytes, &val)
-	if err != nil {
-		p.logger.Error("Error when decode search attributes values.", tag.Error(err), tag.ESField(key))
-		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
-	}
-	return val
-}
-
-func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
-	doc := make(map[string]interface{})
-	attr := make(map[string]interface{})
-	for k, v := range fields {
-		if !p.isValidFieldToES(k) {
-			p.logger.Error("Unregistered field.", tag.ESField(k))
-			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
-			continue
-		}
-
-		switch v.GetType() {
-		case indexer.FieldTypeString:
-			doc[k] = v.GetStringData()
-		case indexer.FieldTypeInt:
-			doc[k] = v.GetIntData()
-		case indexer.FieldTypeBool:
-			doc[k] = v.GetBoolData()
-		case indexer.FieldTypeBinary:
-			if k == definition.Memo {
-				doc[k] = v.GetBinaryData()
-			} else { // custom search attributes
-				attr[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
-			}
-		default:
-			// must be bug in code and bad deployment, check data sent from producer
-			p.logger.Fatal("Unknown field type")
-		}
-	}
-	doc[definition.Attr] = attr
-	return doc
-}
-
-func (p *indexProcessor) isValidFieldToES(field string) bool {
-	if _, ok := p.config.ValidSearchAttributes()[field]; ok {
-		return true
-	}
-	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
-		return true
-	}
-	return false
-}
-
-func fulfillDoc(doc map[string]interface{}, msg *indexer.Message, keyToKafkaMsg string) {
-	doc[definition.DomainID] = msg.GetDomainID()
-	doc[definition.WorkflowID] = msg.GetWorkflowID()
-	doc[definition.RunID] = msg.GetRunID()
-	doc[definition.KafkaKey] = keyToKafkaMsg
-}
@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+type (
+	// Config defines the configuration for the visibility reindex workflows
+	Config struct {
+		// VisibilityReindexRPS is the rate limit of the workflows written to the target visibility store on a worker host
+		VisibilityReindexRPS dynamicconfig.IntPropertyFn
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the visibility reindexer
+	BootstrapParams struct {
+		// Config contains the configuration for the visibility reindexer
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+		// Resource gives access to the domain cache and persistence
+		Resource resource.Resource
+		// VisibilityStores gives access to the target visibility stores
+		VisibilityStores *workercommon.VisibilityStores
+		// NumShards is the number of history shards
+		NumShards int
+	}
+
+	// Reindexer runs the workflows rebuilding the visibility records of existing workflows
+	// from their mutable states, and writing them to a visibility store
+	Reindexer struct {
+		cfg              Config
+		svcClient        workflowserviceclient.Interface
+		metricsClient    metrics.Client
+		tallyScope       tally.Scope
+		logger           log.Logger
+		resource         resource.Resource
+		numShards        int
+		rateLimiter      quotas.Limiter
+		visibilityStores visibilityStores
+	}
+
+	// visibilityStores returns the visibility managers of the target stores, it's implemented by
+	// workercommon.VisibilityStores
+	visibilityStores interface {
+		GetVisibilityManager(store string) (persistence.VisibilityManager, error)
+		GetESVisibilityManager(index string) (persistence.VisibilityManager, error)
+	}
+)
+
+// New returns a new instance of Reindexer
+func New(params *BootstrapParams) *Reindexer {
+	return &Reindexer{
+		cfg:              params.Config,
+		svcClient:        params.ServiceClient,
+		metricsClient:    params.MetricsClient,
+		tallyScope:       params.TallyScope,
+		logger:           params.Logger,
+		resource:         params.Resource,
+		numShards:        params.NumShards,
+		rateLimiter:      quotas.NewDynamicRateLimiter(params.Config.VisibilityReindexRPS.AsFloat64()),
+		visibilityStores: params.VisibilityStores,
+	}
+}
+
+// Start starts the worker
+func (r *Reindexer) Start() error {
+	ctx := context.WithValue(context.Background(), reindexerContextKey, r)
+	workerOpts := worker.Options{
+		MetricsScope:              r.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	reindexWorker := worker.New(r.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	reindexWorker.RegisterWorkflowWithOptions(ReindexWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	reindexWorker.RegisterActivityWithOptions(ReindexShardActivity, activity.RegisterOptions{Name: reindexShardActivityName})
+	return reindexWorker.Start()
+}
@@ -0,0 +1,487 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	reindexerContextKey contextKey = "visibilityReindexerContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-visibility-reindex-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-visibility-reindex-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow IDs, there is one workflow per target store and index
+	WorkflowIDPrefix = "cadence-visibility-reindex-"
+	// QueryTypeProgress is the query type returning the ReindexProgress of a workflow
+	QueryTypeProgress = "progress"
+
+	// TargetES is the advanced visibility store
+	TargetES = workercommon.VisibilityStoreES
+	// TargetDB is the database visibility store
+	TargetDB = workercommon.VisibilityStoreDB
+
+	reindexShardActivityName = "cadence-sys-visibility-reindex-shard-activity"
+
+	defaultPageSize = 100
+	// shardsPerRun is the number of shards reindexed by a run before the workflow continues as new
+	shardsPerRun = 100
+
+	secondsInDay = int64(24 * time.Hour / time.Second)
+
+	errMsgParamsIsNil           = "params is nil"
+	errMsgUnknownTarget         = workercommon.ErrMsgUnknownVisibilityStore
+	errMsgTargetNotConfigured   = workercommon.ErrMsgVisibilityStoreNotConfigured
+	errMsgShardBoundsAreInvalid = "lower shard bound is larger than upper shard bound"
+	errMsgIndexRequiresES       = "index can only be set when the target is es"
+)
+
+var errNothingToReindex = errors.New("nothing to reindex")
+
+type (
+	// ReindexParams is the arg for ReindexWorkflow
+	ReindexParams struct {
+		// Target is the visibility store to write, TargetES or TargetDB
+		Target string
+		// Index optionally sets the ElasticSearch index written when Target is TargetES,
+		// it defaults to the visibility index of the ElasticSearch config
+		Index string
+		// DomainName optionally limits the reindex to the workflows of a domain
+		DomainName string
+		// LowerShardBound and UpperShardBound are the inclusive range of history shards to reindex,
+		// a negative UpperShardBound means the last shard
+		LowerShardBound int
+		UpperShardBound int
+		// PageSize is the number of workflows read from a shard and written to the target store at a time
+		PageSize int
+		// Progress is carried over when the workflow continues as new, it's not set when the workflow is started
+		Progress *ReindexProgress
+	}
+
+	// ReindexProgress is the checkpoint of a reindex workflow, it's also returned by QueryTypeProgress
+	ReindexProgress struct {
+		// NextShardID is the first shard which is not reindexed yet
+		NextShardID int
+		// Result is the result of the shards already reindexed
+		Result ReindexResult
+	}
+
+	// ReindexResult is the workflow result
+	ReindexResult struct {
+		// Reindexed is the number of workflows written to the target store
+		Reindexed int
+		// Skipped is the number of workflows which have no visibility record, like zombie workflows,
+		// workflows of deleted domains or not sampled for longer retention
+		Skipped int
+		// Failed is the number of workflows which couldn't be reindexed
+		Failed int
+	}
+
+	// ReindexShardParams is the arg for ReindexShardActivity
+	ReindexShardParams struct {
+		Target     string
+		Index      string
+		DomainName string
+		ShardID    int
+		PageSize   int
+	}
+
+	// ReindexShardResult is the result of ReindexShardActivity
+	ReindexShardResult struct {
+		Result ReindexResult
+		// NumShards is the number of history shards of the cluster
+		NumShards int
+	}
+
+	heartbeatDetails struct {
+		PageToken []byte
+		Result    ReindexResult
+	}
+)
+
+// ReindexWorkflow is the workflow that rebuilds the visibility records of the workflows in a range of history shards,
+// and writes them to the target visibility store. The shards are reindexed one after another, and the workflow
+// continues as new every shardsPerRun shards with its progress.
+func ReindexWorkflow(ctx workflow.Context, params *ReindexParams) (*ReindexResult, error) {
+	if err := validateParams(params); err != nil {
+		return nil, err
+	}
+	progress := params.Progress
+	if progress == nil {
+		progress = &ReindexProgress{NextShardID: params.LowerShardBound}
+	}
+	if err := workflow.SetQueryHandler(ctx, QueryTypeProgress, func() (*ReindexProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getReindexShardActivityOptions())
+	for i := 0; i < shardsPerRun; i++ {
+		if params.UpperShardBound >= 0 && progress.NextShardID > params.UpperShardBound {
+			return &progress.Result, nil
+		}
+		var shardResult ReindexShardResult
+		if err := workflow.ExecuteActivity(ao, ReindexShardActivity, &ReindexShardParams{
+			Target:     params.Target,
+			Index:      params.Index,
+			DomainName: params.DomainName,
+			ShardID:    progress.NextShardID,
+			PageSize:   params.PageSize,
+		}).Get(ctx, &shardResult); err != nil {
+			return nil, err
+		}
+		progress.Result.add(shardResult.Result)
+		progress.NextShardID++
+		if params.UpperShardBound < 0 || params.UpperShardBound >= shardResult.NumShards {
+			params.UpperShardBound = shardResult.NumShards - 1
+		}
+	}
+	if progress.NextShardID > params.UpperShardBound {
+		return &progress.Result, nil
+	}
+	params.Progress = progress
+	return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+}
+
+func getReindexShardActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       7 * 24 * time.Hour,
+			NonRetriableErrorReasons: []string{errMsgUnknownTarget, errMsgTargetNotConfigured},
+		},
+	}
+}
+
+func validateParams(params *ReindexParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.Target != TargetES && params.Target != TargetDB {
+		return errors.New(errMsgUnknownTarget)
+	}
+	if params.Index != "" && params.Target != TargetES {
+		return errors.New(errMsgIndexRequiresES)
+	}
+	if params.LowerShardBound < 0 {
+		params.LowerShardBound = 0
+	}
+	if params.UpperShardBound >= 0 && params.LowerShardBound > params.UpperShardBound {
+		return errors.New(errMsgShardBoundsAreInvalid)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	return nil
+}
+
+// ReindexShardActivity rebuilds the visibility records of the workflows in a shard from their mutable states
+// and writes them to the target store a page at a time, it resumes from the last page recorded in its heartbeat
+// when it's retried
+func ReindexShardActivity(ctx context.Context, params *ReindexShardParams) (*ReindexShardResult, error) {
+	r := getReindexer(ctx)
+	if params.ShardID >= r.numShards {
+		return &ReindexShardResult{NumShards: r.numShards}, nil
+	}
+	logger := activity.GetLogger(ctx).With(zap.Int("ShardID", params.ShardID))
+
+	visibilityManager, err := r.getVisibilityManager(params.Target, params.Index)
+	if err != nil {
+		return nil, cadence.NewCustomError(err.Error())
+	}
+	var domainID string
+	if params.DomainName != "" {
+		if domainID, err = r.resource.GetDomainCache().GetDomainID(params.DomainName); err != nil {
+			return nil, err
+		}
+	}
+	executionManager, err := r.resource.GetExecutionManager(params.ShardID)
+	if err != nil {
+		return nil, err
+	}
+
+	var hbd heartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			logger.Warn("Failed to get heartbeat details, starting from the first page", zap.Error(err))
+			hbd = heartbeatDetails{}
+		}
+	}
+
+	scope := r.metricsClient.Scope(metrics.VisibilityReindexScope)
+	for {
+		response, err := executionManager.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			PageSize:  params.PageSize,
+			PageToken: hbd.PageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var executions []*persistence.ListConcreteExecutionsEntity
+		for _, execution := range response.Executions {
+			if domainID == "" || execution.ExecutionInfo.DomainID == domainID {
+				executions = append(executions, execution)
+			}
+		}
+		pageResult, err := r.reindexPage(ctx, params.ShardID, executions, visibilityManager, scope, logger)
+		if err != nil {
+			return nil, err
+		}
+		hbd.Result.add(pageResult)
+		hbd.PageToken = response.PageToken
+		activity.RecordHeartbeat(ctx, hbd)
+		if len(hbd.PageToken) == 0 {
+			return &ReindexShardResult{Result: hbd.Result, NumShards: r.numShards}, nil
+		}
+	}
+}
+
+func (r *Reindexer) getVisibilityManager(target string, index string) (persistence.VisibilityManager, error) {
+	if index != "" {
+		return r.visibilityStores.GetESVisibilityManager(index)
+	}
+	return r.visibilityStores.GetVisibilityManager(target)
+}
+
+// reindexPage writes the visibility records of a page of workflows concurrently,
+// so that they can be batched by the target store
+func (r *Reindexer) reindexPage(
+	ctx context.Context,
+	shardID int,
+	executions []*persistence.ListConcreteExecutionsEntity,
+	visibilityManager persistence.VisibilityManager,
+	scope metrics.Scope,
+	logger *zap.Logger,
+) (ReindexResult, error) {
+	var result ReindexResult
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, execution := range executions {
+		if err := r.rateLimiter.Wait(ctx); err != nil {
+			wg.Wait()
+			return result, err
+		}
+		wg.Add(1)
+		go func(execution *persistence.ListConcreteExecutionsEntity) {
+			defer wg.Done()
+			err := r.reindex(ctx, shardID, execution, visibilityManager)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == errNothingToReindex:
+				result.Skipped++
+				scope.IncCounter(metrics.VisibilityReindexNumWorkflowsSkipped)
+			case err != nil:
+				logger.Error("Failed to reindex workflow",
+					zap.String("DomainID", execution.ExecutionInfo.DomainID),
+					zap.String("WorkflowID", execution.ExecutionInfo.WorkflowID),
+					zap.String("RunID", execution.ExecutionInfo.RunID),
+					zap.Error(err))
+				result.Failed++
+				scope.IncCounter(metrics.VisibilityReindexNumReindexFailed)
+			default:
+				result.Reindexed++
+				scope.IncCounter(metrics.VisibilityReindexNumWorkflowsReindexed)
+			}
+		}(execution)
+	}
+	wg.Wait()
+	return result, nil
+}
+
+// reindex rebuilds the visibility record of a workflow the same way as the history transfer tasks,
+// and writes it to the target store
+func (r *Reindexer) reindex(
+	ctx context.Context,
+	shardID int,
+	execution *persistence.ListConcreteExecutionsEntity,
+	visibilityManager persistence.VisibilityManager,
+) error {
+	executionInfo := execution.ExecutionInfo
+	isRunning := executionInfo.State == persistence.WorkflowStateCreated || executionInfo.State == persistence.WorkflowStateRunning
+	if !isRunning && executionInfo.State != persistence.WorkflowStateCompleted {
+		// zombie, void or corrupted workflows are not visible
+		return errNothingToReindex
+	}
+
+	domainEntry, err := r.resource.GetDomainCache().GetDomainByID(executionInfo.DomainID)
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			return errNothingToReindex
+		}
+		return err
+	}
+	if domainEntry.IsSampledForLongerRetentionEnabled(executionInfo.WorkflowID) &&
+		!domainEntry.IsSampledForLongerRetention(executionInfo.WorkflowID) {
+		return errNothingToReindex
+	}
+
+	branchToken := executionInfo.BranchToken
+	if execution.VersionHistories != nil {
+		currentVersionHistory, err := execution.VersionHistories.GetCurrentVersionHistory()
+		if err != nil {
+			return err
+		}
+		branchToken = currentVersionHistory.GetBranchToken()
+	}
+	startEvent, err := r.getHistoryEvent(ctx, shardID, branchToken, common.FirstEventID, common.FirstEventID)
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			// history is already deleted
+			return errNothingToReindex
+		}
+		return err
+	}
+
+	domainName := domainEntry.GetInfo().Name
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: executionInfo.WorkflowID,
+		RunID:      executionInfo.RunID,
+	}
+	startTimestamp := startEvent.GetTimestamp()
+	executionTimestamp := getWorkflowExecutionTimestamp(startEvent)
+	var memo *types.Memo
+	if executionInfo.Memo != nil {
+		memo = &types.Memo{Fields: executionInfo.Memo}
+	}
+	isCron := len(executionInfo.CronSchedule) > 0
+	numClusters := int16(len(domainEntry.GetReplicationConfig().Clusters))
+
+	if isRunning {
+		return visibilityManager.RecordWorkflowExecutionStarted(ctx, &persistence.RecordWorkflowExecutionStartedRequest{
+			DomainUUID:         executionInfo.DomainID,
+			Domain:             domainName,
+			Execution:          workflowExecution,
+			WorkflowTypeName:   executionInfo.WorkflowTypeName,
+			StartTimestamp:     startTimestamp,
+			ExecutionTimestamp: executionTimestamp,
+			WorkflowTimeout:    int64(executionInfo.WorkflowTimeout),
+			TaskID:             executionInfo.LastEventTaskID,
+			Memo:               memo,
+			TaskList:           executionInfo.TaskList,
+			IsCron:             isCron,
+			NumClusters:        numClusters,
+			SearchAttributes:   executionInfo.SearchAttributes,
+		})
+	}
+
+	completionEvent, err := r.getHistoryEvent(ctx, shardID, branchToken, executionInfo.CompletionEventBatchID, executionInfo.NextEventID-1)
+	if err != nil {
+		if common.IsEntityNotExistsError(err) {
+			return errNothingToReindex
+		}
+		return err
+	}
+	closeStatus := persistence.ToInternalWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+	if closeStatus == nil {
+		return errNothingToReindex
+	}
+	return visibilityManager.RecordWorkflowExecutionClosed(ctx, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         executionInfo.DomainID,
+		Domain:             domainName,
+		Execution:          workflowExecution,
+		WorkflowTypeName:   executionInfo.WorkflowTypeName,
+		StartTimestamp:     startTimestamp,
+		ExecutionTimestamp: executionTimestamp,
+		CloseTimestamp:     completionEvent.GetTimestamp(),
+		Status:             *closeStatus,
+		HistoryLength:      executionInfo.NextEventID - 1,
+		RetentionSeconds:   int64(domainEntry.GetRetentionDays(executionInfo.WorkflowID)) * secondsInDay,
+		TaskID:             executionInfo.LastEventTaskID,
+		Memo:               memo,
+		TaskList:           executionInfo.TaskList,
+		SearchAttributes:   executionInfo.SearchAttributes,
+		IsCron:             isCron,
+		NumClusters:        numClusters,
+	})
+}
+
+// getHistoryEvent reads an event from the batch starting at firstEventID
+func (r *Reindexer) getHistoryEvent(
+	ctx context.Context,
+	shardID int,
+	branchToken []byte,
+	firstEventID int64,
+	eventID int64,
+) (*types.HistoryEvent, error) {
+	response, err := r.resource.GetHistoryManager().ReadHistoryBranch(ctx, &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  firstEventID,
+		MaxEventID:  eventID + 1,
+		PageSize:    1,
+		ShardID:     common.IntPtr(shardID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range response.HistoryEvents {
+		if event.ID == eventID {
+			return event, nil
+		}
+	}
+	return nil, &types.EntityNotExistsError{Message: "history event not found"}
+}
+
+// getWorkflowExecutionTimestamp is the same as the one used by history transfer tasks,
+// 0 means the workflow doesn't need backoff
+func getWorkflowExecutionTimestamp(startEvent *types.HistoryEvent) int64 {
+	if backoffSeconds := startEvent.WorkflowExecutionStartedEventAttributes.GetFirstDecisionTaskBackoffSeconds(); backoffSeconds != 0 {
+		return startEvent.GetTimestamp() + int64(backoffSeconds)*int64(time.Second)
+	}
+	return 0
+}
+
+func (r *ReindexResult) add(other ReindexResult) {
+	r.Reindexed += other.Reindexed
+	r.Skipped += other.Skipped
+	r.Failed += other.Failed
+}
+
+func getReindexer(ctx context.Context) *Reindexer {
+	return ctx.Value(reindexerContextKey).(*Reindexer)
+}
@@ -0,0 +1,416 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package reindexer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+type reindexWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestReindexWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(reindexWorkflowTestSuite))
+}
+
+func (s *reindexWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ReindexWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(ReindexShardActivity, activity.RegisterOptions{Name: reindexShardActivityName})
+}
+
+func (s *reindexWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *reindexWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	s.Error(validateParams(&ReindexParams{}))
+	s.Error(validateParams(&ReindexParams{Target: "kafka"}))
+	s.Error(validateParams(&ReindexParams{Target: TargetES, LowerShardBound: 2, UpperShardBound: 1}))
+	s.Error(validateParams(&ReindexParams{Target: TargetDB, Index: "cadence-visibility-v2"}))
+
+	params := &ReindexParams{Target: TargetDB, LowerShardBound: -1, UpperShardBound: -1}
+	s.NoError(validateParams(params))
+	s.Equal(0, params.LowerShardBound)
+	s.Equal(defaultPageSize, params.PageSize)
+}
+
+func (s *reindexWorkflowTestSuite) TestWorkflow() {
+	for shardID := 2; shardID <= 4; shardID++ {
+		shardID := shardID
+		s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, mock.MatchedBy(func(params *ReindexShardParams) bool {
+			return params.Target == TargetES && params.DomainName == "test-domain" && params.ShardID == shardID && params.PageSize == defaultPageSize
+		})).Return(&ReindexShardResult{Result: ReindexResult{Reindexed: 10, Skipped: 2, Failed: 1}, NumShards: 16}, nil).Once()
+	}
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReindexParams{
+		Target:          TargetES,
+		DomainName:      "test-domain",
+		LowerShardBound: 2,
+		UpperShardBound: 4,
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	var result ReindexResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(ReindexResult{Reindexed: 30, Skipped: 6, Failed: 3}, result)
+}
+
+func (s *reindexWorkflowTestSuite) TestWorkflow_AllShards() {
+	s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, mock.Anything).
+		Return(&ReindexShardResult{Result: ReindexResult{Reindexed: 1}, NumShards: 4}, nil).Times(4)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReindexParams{Target: TargetDB, UpperShardBound: -1})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	var result ReindexResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(ReindexResult{Reindexed: 4}, result)
+}
+
+func (s *reindexWorkflowTestSuite) TestWorkflow_ContinueAsNew() {
+	s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, mock.Anything).
+		Return(&ReindexShardResult{Result: ReindexResult{Reindexed: 1}, NumShards: 1024}, nil).Times(shardsPerRun)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReindexParams{Target: TargetES, UpperShardBound: -1})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	_, ok := s.workflowEnv.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+}
+
+func (s *reindexWorkflowTestSuite) TestWorkflow_TargetNotConfigured() {
+	s.workflowEnv.OnActivity(reindexShardActivityName, mock.Anything, mock.Anything).
+		Return(nil, cadence.NewCustomError(errMsgTargetNotConfigured)).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReindexParams{Target: TargetES, UpperShardBound: 0})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+type (
+	reindexActivityTestSuite struct {
+		suite.Suite
+		testsuite.WorkflowTestSuite
+
+		controller        *gomock.Controller
+		resource          *resource.Test
+		visibilityManager *mocks.VisibilityManager
+		visibilityStores  *testVisibilityStores
+		reindexer         *Reindexer
+		activityEnv       *testsuite.TestActivityEnvironment
+		domainEntry       *cache.DomainCacheEntry
+	}
+
+	testVisibilityStores struct {
+		visibilityManager persistence.VisibilityManager
+		esIndex           string
+	}
+)
+
+const (
+	testDomainID   = "deadbeef-0123-4567-890a-bcdef0123456"
+	testDomainName = "test-domain"
+	testNumShards  = 4
+	testStartTime  = int64(1600000000000000000)
+	testCloseTime  = testStartTime + int64(time.Hour)
+)
+
+func TestReindexActivityTestSuite(t *testing.T) {
+	suite.Run(t, new(reindexActivityTestSuite))
+}
+
+func (s *reindexActivityTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.resource = resource.NewTest(s.controller, metrics.Worker)
+	s.visibilityManager = &mocks.VisibilityManager{}
+	s.visibilityStores = &testVisibilityStores{visibilityManager: s.visibilityManager}
+	s.domainEntry = cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: testDomainID, Name: testDomainName, Data: map[string]string{}},
+		&persistence.DomainConfig{Retention: 3},
+		"active",
+		nil,
+	)
+	s.resource.DomainCache.EXPECT().GetDomainByID(testDomainID).Return(s.domainEntry, nil).AnyTimes()
+	s.resource.DomainCache.EXPECT().GetDomainID(testDomainName).Return(testDomainID, nil).AnyTimes()
+
+	s.reindexer = &Reindexer{
+		metricsClient:    metrics.NewNoopMetricsClient(),
+		logger:           s.resource.GetLogger(),
+		resource:         s.resource,
+		numShards:        testNumShards,
+		rateLimiter:      quotas.NewSimpleRateLimiter(10000),
+		visibilityStores: s.visibilityStores,
+	}
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.RegisterActivityWithOptions(ReindexShardActivity, activity.RegisterOptions{Name: reindexShardActivityName})
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), reindexerContextKey, s.reindexer),
+	})
+}
+
+func (s *reindexActivityTestSuite) TearDownTest() {
+	s.controller.Finish()
+	s.resource.Finish(s.T())
+	s.visibilityManager.AssertExpectations(s.T())
+}
+
+func (s *reindexActivityTestSuite) TestReindexShardActivity() {
+	running := s.newExecution("running", persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone)
+	otherDomain := s.newExecution("other-domain", persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone)
+	otherDomain.ExecutionInfo.DomainID = "other-domain-id"
+	closed := s.newExecution("closed", persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusFailed)
+
+	s.resource.ExecutionMgr.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 2}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{running, otherDomain},
+			PageToken:  []byte("next"),
+		}, nil).Once()
+	s.resource.ExecutionMgr.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 2, PageToken: []byte("next")}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{closed},
+		}, nil).Once()
+	s.mockHistory(running, true)
+	s.mockHistory(closed, true)
+	s.visibilityManager.On("RecordWorkflowExecutionStarted", mock.Anything, &persistence.RecordWorkflowExecutionStartedRequest{
+		DomainUUID:         testDomainID,
+		Domain:             testDomainName,
+		Execution:          types.WorkflowExecution{WorkflowID: "running", RunID: "running-run"},
+		WorkflowTypeName:   "test-workflow-type",
+		StartTimestamp:     testStartTime,
+		ExecutionTimestamp: testStartTime + int64(10*time.Second),
+		WorkflowTimeout:    60,
+		TaskID:             100,
+		TaskList:           "test-tasklist",
+		NumClusters:        1,
+	}).Return(nil).Once()
+	s.visibilityManager.On("RecordWorkflowExecutionClosed", mock.Anything, &persistence.RecordWorkflowExecutionClosedRequest{
+		DomainUUID:         testDomainID,
+		Domain:             testDomainName,
+		Execution:          types.WorkflowExecution{WorkflowID: "closed", RunID: "closed-run"},
+		WorkflowTypeName:   "test-workflow-type",
+		StartTimestamp:     testStartTime,
+		ExecutionTimestamp: testStartTime + int64(10*time.Second),
+		CloseTimestamp:     testCloseTime,
+		Status:             types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:      9,
+		RetentionSeconds:   3 * secondsInDay,
+		TaskID:             100,
+		TaskList:           "test-tasklist",
+		NumClusters:        1,
+	}).Return(nil).Once()
+
+	value, err := s.activityEnv.ExecuteActivity(reindexShardActivityName, &ReindexShardParams{
+		Target:     TargetES,
+		Index:      "cadence-visibility-v2",
+		DomainName: testDomainName,
+		ShardID:    1,
+		PageSize:   2,
+	})
+	s.NoError(err)
+	var result ReindexShardResult
+	s.NoError(value.Get(&result))
+	s.Equal(ReindexShardResult{Result: ReindexResult{Reindexed: 2}, NumShards: testNumShards}, result)
+	s.Equal("cadence-visibility-v2", s.visibilityStores.esIndex)
+}
+
+func (s *reindexActivityTestSuite) TestReindexShardActivity_ResumeFromHeartbeat() {
+	failed := s.newExecution("failed", persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone)
+	s.resource.ExecutionMgr.On("ListConcreteExecutions", mock.Anything, &persistence.ListConcreteExecutionsRequest{PageSize: 2, PageToken: []byte("next")}).
+		Return(&persistence.ListConcreteExecutionsResponse{
+			Executions: []*persistence.ListConcreteExecutionsEntity{failed},
+		}, nil).Once()
+	s.mockHistory(failed, true)
+	s.visibilityManager.On("RecordWorkflowExecutionStarted", mock.Anything, mock.Anything).Return(errors.New("some error")).Once()
+
+	s.activityEnv.SetHeartbeatDetails(heartbeatDetails{
+		PageToken: []byte("next"),
+		Result:    ReindexResult{Reindexed: 5, Skipped: 1},
+	})
+	value, err := s.activityEnv.ExecuteActivity(reindexShardActivityName, &ReindexShardParams{
+		Target:   TargetDB,
+		ShardID:  1,
+		PageSize: 2,
+	})
+	s.NoError(err)
+	var result ReindexShardResult
+	s.NoError(value.Get(&result))
+	s.Equal(ReindexShardResult{Result: ReindexResult{Reindexed: 5, Skipped: 1, Failed: 1}, NumShards: testNumShards}, result)
+	s.Empty(s.visibilityStores.esIndex)
+}
+
+func (s *reindexActivityTestSuite) TestReindexShardActivity_ShardOutOfRange() {
+	value, err := s.activityEnv.ExecuteActivity(reindexShardActivityName, &ReindexShardParams{
+		Target:  TargetES,
+		ShardID: testNumShards,
+	})
+	s.NoError(err)
+	var result ReindexShardResult
+	s.NoError(value.Get(&result))
+	s.Equal(ReindexShardResult{NumShards: testNumShards}, result)
+}
+
+func (s *reindexActivityTestSuite) TestReindex_InvisibleStates() {
+	for _, state := range []int{persistence.WorkflowStateZombie, persistence.WorkflowStateVoid, persistence.WorkflowStateCorrupted} {
+		execution := s.newExecution("wid", state, persistence.WorkflowCloseStatusNone)
+		s.Equal(errNothingToReindex, s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+	}
+}
+
+func (s *reindexActivityTestSuite) TestReindex_NotSampled() {
+	s.domainEntry.GetInfo().Data[cache.SampleRateKey] = "0"
+	execution := s.newExecution("wid", persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted)
+	s.Equal(errNothingToReindex, s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+
+	s.domainEntry.GetInfo().Data[cache.SampleRateKey] = "1"
+	s.domainEntry.GetInfo().Data[cache.SampleRetentionKey] = "30"
+	s.mockHistory(execution, true)
+	s.visibilityManager.On("RecordWorkflowExecutionClosed", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.RetentionSeconds == 30*secondsInDay
+	})).Return(nil).Once()
+	s.NoError(s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+}
+
+func (s *reindexActivityTestSuite) TestReindex_DomainDeleted() {
+	execution := s.newExecution("wid", persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone)
+	execution.ExecutionInfo.DomainID = "deleted-domain-id"
+	s.resource.DomainCache.EXPECT().GetDomainByID("deleted-domain-id").
+		Return(nil, &types.EntityNotExistsError{Message: "domain not found"}).Times(1)
+	s.Equal(errNothingToReindex, s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+}
+
+func (s *reindexActivityTestSuite) TestReindex_HistoryDeleted() {
+	execution := s.newExecution("wid", persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted)
+	s.resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).
+		Return(nil, &types.EntityNotExistsError{Message: "history not found"}).Once()
+	s.Equal(errNothingToReindex, s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+}
+
+func (s *reindexActivityTestSuite) TestReindex_CloseEventNotInBatch() {
+	execution := s.newExecution("wid", persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted)
+	s.mockHistory(execution, false)
+	s.Equal(errNothingToReindex, s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+}
+
+func (s *reindexActivityTestSuite) TestReindex_CurrentVersionHistoryBranch() {
+	execution := s.newExecution("wid", persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusTimedOut)
+	execution.VersionHistories = persistence.NewVersionHistories(persistence.NewVersionHistory([]byte("current-branch"), nil))
+	s.mockHistory(execution, true)
+	s.visibilityManager.On("RecordWorkflowExecutionClosed", mock.Anything, mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.Status == types.WorkflowExecutionCloseStatusTimedOut && request.CloseTimestamp == testCloseTime
+	})).Return(nil).Once()
+	s.NoError(s.reindexer.reindex(context.Background(), 1, execution, s.visibilityManager))
+}
+
+func (s *reindexActivityTestSuite) newExecution(workflowID string, state int, closeStatus int) *persistence.ListConcreteExecutionsEntity {
+	return &persistence.ListConcreteExecutionsEntity{
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{
+			DomainID:               testDomainID,
+			WorkflowID:             workflowID,
+			RunID:                  workflowID + "-run",
+			WorkflowTypeName:       "test-workflow-type",
+			TaskList:               "test-tasklist",
+			WorkflowTimeout:        60,
+			State:                  state,
+			CloseStatus:            closeStatus,
+			BranchToken:            []byte("execution-branch"),
+			CompletionEventBatchID: 7,
+			NextEventID:            10,
+			LastEventTaskID:        100,
+		},
+	}
+}
+
+// mockHistory mocks the start event and the batch of the completion event on the branch of the execution,
+// the completion event is the last event of the batch if completionEventInBatch is true
+func (s *reindexActivityTestSuite) mockHistory(execution *persistence.ListConcreteExecutionsEntity, completionEventInBatch bool) {
+	branchToken := execution.ExecutionInfo.BranchToken
+	if execution.VersionHistories != nil {
+		currentVersionHistory, err := execution.VersionHistories.GetCurrentVersionHistory()
+		s.NoError(err)
+		branchToken = currentVersionHistory.GetBranchToken()
+	}
+	onBranch := func(minEventID int64, maxEventID int64) func(*persistence.ReadHistoryBranchRequest) bool {
+		return func(request *persistence.ReadHistoryBranchRequest) bool {
+			return bytes.Equal(request.BranchToken, branchToken) && request.MinEventID == minEventID &&
+				request.MaxEventID == maxEventID && *request.ShardID == 1
+		}
+	}
+	s.resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(onBranch(common.FirstEventID, common.FirstEventID+1))).
+		Return(&persistence.ReadHistoryBranchResponse{
+			HistoryEvents: []*types.HistoryEvent{{
+				ID:        common.FirstEventID,
+				Timestamp: common.Int64Ptr(testStartTime),
+				WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+					FirstDecisionTaskBackoffSeconds: common.Int32Ptr(10),
+				},
+			}},
+		}, nil).Maybe()
+
+	completionBatch := []*types.HistoryEvent{
+		{ID: 7, Timestamp: common.Int64Ptr(testCloseTime - 2)},
+		{ID: 8, Timestamp: common.Int64Ptr(testCloseTime - 1)},
+	}
+	if completionEventInBatch {
+		completionBatch = append(completionBatch, &types.HistoryEvent{ID: 9, Timestamp: common.Int64Ptr(testCloseTime)})
+	}
+	s.resource.HistoryMgr.On("ReadHistoryBranch", mock.Anything, mock.MatchedBy(onBranch(7, 10))).
+		Return(&persistence.ReadHistoryBranchResponse{HistoryEvents: completionBatch}, nil).Maybe()
+}
+
+func (s *testVisibilityStores) GetVisibilityManager(store string) (persistence.VisibilityManager, error) {
+	if store != TargetES && store != TargetDB {
+		return nil, errors.New(errMsgUnknownTarget)
+	}
+	return s.visibilityManager, nil
+}
+
+func (s *testVisibilityStores) GetESVisibilityManager(index string) (persistence.VisibilityManager, error) {
+	s.esIndex = index
+	return s.visibilityManager, nil
+}
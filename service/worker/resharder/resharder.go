@@ -0,0 +1,171 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resharder
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/serialization"
+	"github.com/uber/cadence/common/persistence/sql"
+)
+
+type (
+	// Config defines the configuration for the SQL resharding workflows
+	Config struct {
+		// Persistence is the persistence config, its default store must be a SQL store with resharding configured
+		Persistence *config.Persistence
+		// ClusterMetadata contains the metadata for this cluster
+		ClusterMetadata cluster.Metadata
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the resharder
+	BootstrapParams struct {
+		// Config contains the configuration for the resharder
+		Config Config
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// Resharder runs the workflows moving history shards to the target placement of a resharding SQL persistence
+	Resharder struct {
+		cfg           Config
+		svcClient     workflowserviceclient.Interface
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+		worker        worker.Worker
+		sqlCfg        *config.SQL
+		parser        serialization.Parser
+		copier        *sql.ShardCopier
+	}
+)
+
+// New returns a new instance of Resharder
+func New(params *BootstrapParams) *Resharder {
+	return &Resharder{
+		cfg:           params.Config,
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentSQLResharder),
+	}
+}
+
+// Start starts the worker
+func (r *Resharder) Start() error {
+	store, ok := r.cfg.Persistence.DataStores[r.cfg.Persistence.DefaultStore]
+	if !ok || store.SQL == nil || store.SQL.Resharding == nil {
+		return errors.New("default store is not a SQL store with resharding configured")
+	}
+	r.sqlCfg = store.SQL
+
+	var decodingTypes []common.EncodingType
+	for _, dt := range r.sqlCfg.DecodingTypes {
+		decodingTypes = append(decodingTypes, common.EncodingType(dt))
+	}
+	parser, err := serialization.NewParser(common.EncodingType(r.sqlCfg.EncodingType), decodingTypes...)
+	if err != nil {
+		return err
+	}
+	r.parser = parser
+
+	var clusterNames []string
+	for clusterName := range r.cfg.ClusterMetadata.GetAllClusterInfo() {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	copier, err := sql.NewShardCopier(*r.sqlCfg, parser, clusterNames, defaultPageSize, r.logger)
+	if err != nil {
+		return err
+	}
+	r.copier = copier
+
+	ctx := context.WithValue(context.Background(), resharderContextKey, r)
+	workerOpts := worker.Options{
+		MetricsScope:              r.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	reshardingWorker := worker.New(r.svcClient, common.SystemLocalDomainName, TaskListName, workerOpts)
+	reshardingWorker.RegisterWorkflowWithOptions(ReshardingWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	reshardingWorker.RegisterActivityWithOptions(CopyActivity, activity.RegisterOptions{Name: copyActivityName})
+	reshardingWorker.RegisterActivityWithOptions(IsCutoverActivity, activity.RegisterOptions{Name: isCutoverActivityName})
+	reshardingWorker.RegisterActivityWithOptions(CatchUpActivity, activity.RegisterOptions{Name: catchUpActivityName})
+	reshardingWorker.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+	r.worker = reshardingWorker
+	return reshardingWorker.Start()
+}
+
+// Stop stops the worker
+func (r *Resharder) Stop() {
+	if r.worker != nil {
+		r.worker.Stop()
+	}
+	if r.copier != nil {
+		r.copier.Close()
+	}
+}
+
+func (r *Resharder) isCutover(shardID int) bool {
+	return r.sqlCfg.Resharding.Cutover != nil && r.sqlCfg.Resharding.Cutover(shardID)
+}
+
+// newTargetRetryer returns a retryer reading a history shard from the target placement
+func (r *Resharder) newTargetRetryer(shardID int) (persistence.Retryer, error) {
+	executionStore, err := sql.NewSQLExecutionStore(r.copier.Target(), r.logger, shardID, r.parser)
+	if err != nil {
+		return nil, err
+	}
+	historyStore, err := sql.NewHistoryV2Persistence(r.copier.Target(), r.logger, r.parser)
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewPersistenceRetryer(
+		persistence.NewExecutionManagerImpl(executionStore, r.logger),
+		persistence.NewHistoryV2ManagerImpl(
+			historyStore,
+			r.logger,
+			dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit),
+		),
+		common.CreatePersistenceRetryPolicy(),
+	), nil
+}
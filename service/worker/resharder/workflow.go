@@ -0,0 +1,331 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resharder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	resharderContextKey contextKey = "resharderContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-sql-resharding-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-sql-resharding-workflow"
+	// WorkflowIDPrefix is the prefix of the workflow IDs, there is one workflow per history shard
+	WorkflowIDPrefix = "cadence-sql-resharding-"
+
+	copyActivityName      = "cadence-sys-sql-resharding-copy-activity"
+	isCutoverActivityName = "cadence-sys-sql-resharding-isCutover-activity"
+	catchUpActivityName   = "cadence-sys-sql-resharding-catchUp-activity"
+	verifyActivityName    = "cadence-sys-sql-resharding-verify-activity"
+
+	defaultPageSize            = 500
+	defaultCutoverWaitTime     = 2 * time.Minute
+	defaultCutoverPollInterval = 30 * time.Second
+	maxCorruptedExecutions     = 100
+
+	errMsgParamsIsNil      = "params is nil"
+	errMsgShardIDIsInvalid = "shardID is negative"
+
+	// QueryType for resharding workflow
+	QueryType = "state"
+
+	// workflow states for query
+
+	// WorkflowInitialized state
+	WorkflowInitialized = "initialized"
+	// WorkflowCopying state
+	WorkflowCopying = "copying"
+	// WorkflowWaitingForCutover state
+	WorkflowWaitingForCutover = "waitingForCutover"
+	// WorkflowCatchingUp state
+	WorkflowCatchingUp = "catchingUp"
+	// WorkflowVerifying state
+	WorkflowVerifying = "verifying"
+	// WorkflowCompleted state
+	WorkflowCompleted = "complete"
+)
+
+type (
+	// ReshardingParams is the arg for ReshardingWorkflow
+	ReshardingParams struct {
+		// ShardID is the history shard to move to the target placement
+		ShardID int
+		// PageSize is the number of rows read from the database at a time
+		PageSize int
+		// CutoverWaitTime is waited after the cutover is observed before catching up, it must be
+		// longer than the time all history hosts need to observe a dynamic config change
+		CutoverWaitTime time.Duration
+		// CutoverPollInterval is the interval of checking the cutover
+		CutoverPollInterval time.Duration
+	}
+
+	// ReshardingResult is workflow result
+	ReshardingResult struct {
+		Copied   sql.ShardCopyStats
+		CaughtUp sql.ShardCopyStats
+		Verified VerifyActivityResult
+	}
+
+	// ShardActivityParams params for the copy, catch up and verify activities
+	ShardActivityParams struct {
+		ShardID  int
+		PageSize int
+	}
+
+	// VerifyActivityResult result for verify activity
+	VerifyActivityResult struct {
+		Executions   int
+		FailedChecks int
+		// CorruptedExecutions contains at most 100 executions as workflowID:runID
+		CorruptedExecutions []string
+	}
+
+	// QueryResult for resharding progress
+	QueryResult struct {
+		ShardID  int
+		State    string
+		Copied   *sql.ShardCopyStats
+		CaughtUp *sql.ShardCopyStats
+		Verified *VerifyActivityResult
+	}
+)
+
+// ReshardingWorkflow is the workflow that moves a history shard to the target placement of a resharding SQL persistence
+func ReshardingWorkflow(ctx workflow.Context, params *ReshardingParams) (*ReshardingResult, error) {
+	err := validateParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	// define query properties
+	var copied, caughtUp sql.ShardCopyStats
+	var verified VerifyActivityResult
+	var copyDone, catchUpDone, verifyDone bool
+	wfState := WorkflowInitialized
+	err = workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*QueryResult, error) {
+		result := &QueryResult{
+			ShardID: params.ShardID,
+			State:   wfState,
+		}
+		if copyDone {
+			result.Copied = &copied
+		}
+		if catchUpDone {
+			result.CaughtUp = &caughtUp
+		}
+		if verifyDone {
+			result.Verified = &verified
+		}
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	activityParams := &ShardActivityParams{
+		ShardID:  params.ShardID,
+		PageSize: params.PageSize,
+	}
+	ao := workflow.WithActivityOptions(ctx, getCopyActivityOptions())
+
+	wfState = WorkflowCopying
+	err = workflow.ExecuteActivity(ao, CopyActivity, activityParams).Get(ctx, &copied)
+	if err != nil {
+		return nil, err
+	}
+	copyDone = true
+
+	wfState = WorkflowWaitingForCutover
+	cutoverAo := workflow.WithActivityOptions(ctx, getIsCutoverActivityOptions())
+	for {
+		var isCutover bool
+		err = workflow.ExecuteActivity(cutoverAo, IsCutoverActivity, params.ShardID).Get(ctx, &isCutover)
+		if err != nil {
+			return nil, err
+		}
+		if isCutover {
+			break
+		}
+		workflow.Sleep(ctx, params.CutoverPollInterval)
+	}
+	workflow.Sleep(ctx, params.CutoverWaitTime)
+
+	wfState = WorkflowCatchingUp
+	err = workflow.ExecuteActivity(ao, CatchUpActivity, activityParams).Get(ctx, &caughtUp)
+	if err != nil {
+		return nil, err
+	}
+	catchUpDone = true
+
+	wfState = WorkflowVerifying
+	err = workflow.ExecuteActivity(ao, VerifyActivity, activityParams).Get(ctx, &verified)
+	if err != nil {
+		return nil, err
+	}
+	verifyDone = true
+	wfState = WorkflowCompleted
+
+	return &ReshardingResult{
+		Copied:   copied,
+		CaughtUp: caughtUp,
+		Verified: verified,
+	}, nil
+}
+
+func getCopyActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    24 * time.Hour,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:          10 * time.Second,
+			BackoffCoefficient:       2,
+			MaximumInterval:          5 * time.Minute,
+			ExpirationInterval:       24 * time.Hour,
+			NonRetriableErrorReasons: []string{errMsgParamsIsNil, errMsgShardIDIsInvalid},
+		},
+	}
+}
+
+func getIsCutoverActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    10 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+}
+
+func validateParams(params *ReshardingParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if params.ShardID < 0 {
+		return errors.New(errMsgShardIDIsInvalid)
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = defaultPageSize
+	}
+	if params.CutoverWaitTime <= 0 {
+		params.CutoverWaitTime = defaultCutoverWaitTime
+	}
+	if params.CutoverPollInterval <= 0 {
+		params.CutoverPollInterval = defaultCutoverPollInterval
+	}
+	return nil
+}
+
+// CopyActivity copies the executions and history trees of a history shard while it is served by the current placement
+func CopyActivity(ctx context.Context, params *ShardActivityParams) (*sql.ShardCopyStats, error) {
+	copier := getResharder(ctx).copier.WithPageSize(params.PageSize)
+	return copier.CopyExecutions(ctx, params.ShardID, func() {
+		activity.RecordHeartbeat(ctx)
+	})
+}
+
+// IsCutoverActivity returns true once the dynamic config routes a history shard to the target placement
+func IsCutoverActivity(ctx context.Context, shardID int) (bool, error) {
+	return getResharder(ctx).isCutover(shardID), nil
+}
+
+// CatchUpActivity copies the rows of a history shard changed since CopyActivity and hands the shard over to the target placement
+func CatchUpActivity(ctx context.Context, params *ShardActivityParams) (*sql.ShardCopyStats, error) {
+	copier := getResharder(ctx).copier.WithPageSize(params.PageSize)
+	return copier.CatchUp(ctx, params.ShardID, func() {
+		activity.RecordHeartbeat(ctx)
+	})
+}
+
+// VerifyActivity checks the executions of a history shard in the target placement
+func VerifyActivity(ctx context.Context, params *ShardActivityParams) (*VerifyActivityResult, error) {
+	retryer, err := getResharder(ctx).newTargetRetryer(params.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	manager := invariant.NewInvariantManager([]invariant.Invariant{
+		invariant.NewHistoryExists(retryer),
+		invariant.NewOpenCurrentExecution(retryer),
+	})
+	iter := fetcher.ConcreteExecutionIterator(ctx, retryer, params.PageSize)
+	return verifyExecutions(ctx, iter, manager, params.PageSize, func(executions int) {
+		activity.RecordHeartbeat(ctx, executions)
+	})
+}
+
+// verifyExecutions runs the checks of manager on the executions of iter, heartbeat is called after every pageSize executions
+func verifyExecutions(
+	ctx context.Context,
+	iter pagination.Iterator,
+	manager invariant.Manager,
+	pageSize int,
+	heartbeat func(executions int),
+) (*VerifyActivityResult, error) {
+	result := &VerifyActivityResult{}
+	for iter.HasNext() {
+		e, err := iter.Next()
+		if err != nil {
+			return nil, err
+		}
+		result.Executions++
+		checkResult := manager.RunChecks(ctx, e)
+		switch checkResult.CheckResultType {
+		case invariant.CheckResultTypeCorrupted:
+			if len(result.CorruptedExecutions) < maxCorruptedExecutions {
+				execution := e.(*entity.ConcreteExecution)
+				result.CorruptedExecutions = append(result.CorruptedExecutions,
+					fmt.Sprintf("%v:%v", execution.WorkflowID, execution.RunID))
+			}
+		case invariant.CheckResultTypeFailed:
+			result.FailedChecks++
+		}
+		if result.Executions%pageSize == 0 {
+			heartbeat(result.Executions)
+		}
+	}
+	return result, nil
+}
+
+func getResharder(ctx context.Context) *Resharder {
+	return ctx.Value(resharderContextKey).(*Resharder)
+}
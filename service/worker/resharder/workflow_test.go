@@ -0,0 +1,160 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package resharder
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence/sql"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+)
+
+type reshardingWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	workflowEnv *testsuite.TestWorkflowEnvironment
+}
+
+func TestReshardingWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(reshardingWorkflowTestSuite))
+}
+
+func (s *reshardingWorkflowTestSuite) SetupTest() {
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ReshardingWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(CopyActivity, activity.RegisterOptions{Name: copyActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(IsCutoverActivity, activity.RegisterOptions{Name: isCutoverActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(CatchUpActivity, activity.RegisterOptions{Name: catchUpActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(VerifyActivity, activity.RegisterOptions{Name: verifyActivityName})
+}
+
+func (s *reshardingWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+}
+
+func (s *reshardingWorkflowTestSuite) TestValidateParams() {
+	s.Error(validateParams(nil))
+	s.Error(validateParams(&ReshardingParams{ShardID: -1}))
+
+	params := &ReshardingParams{ShardID: 1}
+	s.NoError(validateParams(params))
+	s.Equal(defaultPageSize, params.PageSize)
+	s.Equal(defaultCutoverWaitTime, params.CutoverWaitTime)
+	s.Equal(defaultCutoverPollInterval, params.CutoverPollInterval)
+}
+
+func (s *reshardingWorkflowTestSuite) TestWorkflow() {
+	params := &ReshardingParams{
+		ShardID:         3,
+		CutoverWaitTime: time.Minute,
+	}
+	activityParams := &ShardActivityParams{ShardID: 3, PageSize: defaultPageSize}
+	s.workflowEnv.OnActivity(copyActivityName, mock.Anything, activityParams).
+		Return(&sql.ShardCopyStats{Executions: 10, HistoryNodes: 100}, nil).Once()
+	s.workflowEnv.OnActivity(isCutoverActivityName, mock.Anything, 3).Return(false, nil).Once()
+	s.workflowEnv.OnActivity(isCutoverActivityName, mock.Anything, 3).Return(true, nil).Once()
+	s.workflowEnv.OnActivity(catchUpActivityName, mock.Anything, activityParams).
+		Return(&sql.ShardCopyStats{Executions: 1, Tasks: 5}, nil).Once()
+	s.workflowEnv.OnActivity(verifyActivityName, mock.Anything, activityParams).
+		Return(&VerifyActivityResult{Executions: 10}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+
+	var result ReshardingResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(10, result.Copied.Executions)
+	s.Equal(5, result.CaughtUp.Tasks)
+	s.Equal(10, result.Verified.Executions)
+
+	queryResult, err := s.workflowEnv.QueryWorkflow(QueryType)
+	s.NoError(err)
+	var state QueryResult
+	s.NoError(queryResult.Get(&state))
+	s.Equal(WorkflowCompleted, state.State)
+	s.Equal(3, state.ShardID)
+}
+
+func (s *reshardingWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &ReshardingParams{ShardID: -1})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *reshardingWorkflowTestSuite) TestVerifyExecutions() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	iter := pagination.NewMockIterator(ctrl)
+	manager := invariant.NewMockManager(ctrl)
+
+	checkResults := map[string]invariant.CheckResultType{
+		"healthy-rid":   invariant.CheckResultTypeHealthy,
+		"corrupted-rid": invariant.CheckResultTypeCorrupted,
+		"failed-rid":    invariant.CheckResultTypeFailed,
+	}
+	for runID, checkResult := range checkResults {
+		execution := &entity.ConcreteExecution{
+			Execution: entity.Execution{ShardID: 3, WorkflowID: "wid", RunID: runID},
+		}
+		iter.EXPECT().HasNext().Return(true)
+		iter.EXPECT().Next().Return(execution, nil)
+		manager.EXPECT().RunChecks(gomock.Any(), execution).Return(invariant.ManagerCheckResult{CheckResultType: checkResult})
+	}
+	iter.EXPECT().HasNext().Return(false)
+
+	var heartbeats []int
+	result, err := verifyExecutions(context.Background(), iter, manager, 2, func(executions int) {
+		heartbeats = append(heartbeats, executions)
+	})
+	s.NoError(err)
+	s.Equal(&VerifyActivityResult{
+		Executions:          3,
+		FailedChecks:        1,
+		CorruptedExecutions: []string{"wid:corrupted-rid"},
+	}, result)
+	s.Equal([]int{2}, heartbeats)
+}
+
+func (s *reshardingWorkflowTestSuite) TestVerifyExecutions_IteratorError() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	iter := pagination.NewMockIterator(ctrl)
+	manager := invariant.NewMockManager(ctrl)
+
+	iter.EXPECT().HasNext().Return(true)
+	iter.EXPECT().Next().Return(nil, errors.New("persistence error"))
+
+	_, err := verifyExecutions(context.Background(), iter, manager, 2, func(int) {})
+	s.Error(err)
+}
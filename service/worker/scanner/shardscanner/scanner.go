@@ -213,5 +213,10 @@ func (s *ShardScanner) getDomainIDFromEntity(e interface{}) (*string, error) {
 		return &timer.DomainID, nil
 	}
 
+	openVisibilityRecord, ok := e.(*entity.OpenVisibilityRecord)
+	if ok {
+		return &openVisibilityRecord.DomainID, nil
+	}
+
 	return nil, fmt.Errorf("unknown entity type in scanner: %T", e)
 }
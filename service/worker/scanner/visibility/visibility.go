@@ -0,0 +1,303 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package visibility
+
+import (
+	"context"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/pagination"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
+	"github.com/uber/cadence/common/reconciliation/fetcher"
+	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/common/reconciliation/store"
+	"github.com/uber/cadence/service/worker/scanner/shardscanner"
+	"github.com/uber/cadence/service/worker/workercommon"
+)
+
+const (
+	// ScannerWFTypeName defines workflow type name for visibility scanner
+	ScannerWFTypeName   = "cadence-sys-visibility-scanner-workflow"
+	wfid                = "cadence-sys-visibility-scanner"
+	scannerTaskListName = "cadence-sys-visibility-scanner-tasklist-0"
+
+	// FixerWFTypeName defines workflow type name for visibility fixer
+	FixerWFTypeName    = "cadence-sys-visibility-fixer-workflow"
+	fixerTaskListName  = "cadence-sys-visibility-fixer-tasklist-0"
+	fixerwfid          = "cadence-sys-visibility-fixer"
+	shardSampleRateKey = "shard_sample_rate"
+
+	_defaultShardSampleRate = 1.0
+)
+
+// ScannerWorkflow starts visibility scanner.
+func ScannerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.ScannerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewScannerWorkflow(ctx, ScannerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// FixerWorkflow starts visibility fixer.
+func FixerWorkflow(
+	ctx workflow.Context,
+	params shardscanner.FixerWorkflowParams,
+) error {
+	wf, err := shardscanner.NewFixerWorkflow(ctx, FixerWFTypeName, params)
+	if err != nil {
+		return err
+	}
+
+	return wf.Start(ctx)
+}
+
+// ScannerHooks provides hooks for visibility scanner.
+func ScannerHooks(stores *workercommon.VisibilityStores) func() *shardscanner.ScannerHooks {
+	return func() *shardscanner.ScannerHooks {
+		h, err := shardscanner.NewScannerHooks(Manager(stores), Iterator(stores))
+		if err != nil {
+			return nil
+		}
+		h.SetConfig(Config(stores))
+
+		return h
+	}
+}
+
+// FixerHooks provides hooks needed for visibility fixer.
+func FixerHooks(stores *workercommon.VisibilityStores) func() *shardscanner.FixerHooks {
+	return func() *shardscanner.FixerHooks {
+		h, err := shardscanner.NewFixerHooks(FixerManager(stores), FixerIterator)
+		if err != nil {
+			return nil
+		}
+		return h
+	}
+}
+
+// Manager provides invariant manager for visibility scanner,
+// which checks the visibility stores enabled in the scanner config.
+func Manager(stores *workercommon.VisibilityStores) shardscanner.ManagerCB {
+	return func(
+		ctx context.Context,
+		pr persistence.Retryer,
+		params shardscanner.ScanShardActivityParams,
+	) invariant.Manager {
+		scannerCtx, err := shardscanner.GetScannerContext(ctx)
+		if err != nil {
+			return invariant.NewInvariantManager(nil)
+		}
+		return invariant.NewInvariantManager(
+			getInvariants(pr, stores, getEnabledStores(params), scannerCtx.Resource.GetDomainCache(), scannerCtx.Logger),
+		)
+	}
+}
+
+// Iterator provides iterator for visibility scanner, it iterates through the concrete executions
+// of the shard, and then the open records of the shard in the enabled stores, if the shard is sampled for this run.
+// The open records are iterated for the records of the workflows which no longer have concrete executions.
+func Iterator(stores *workercommon.VisibilityStores) shardscanner.IteratorCB {
+	return func(
+		ctx context.Context,
+		pr persistence.Retryer,
+		params shardscanner.ScanShardActivityParams,
+	) pagination.Iterator {
+		sampleRate, err := strconv.ParseFloat(params.ScannerConfig[shardSampleRateKey], 64)
+		if err != nil {
+			sampleRate = _defaultShardSampleRate
+		}
+		if rand.Float64() >= sampleRate {
+			return pagination.NewChainedIterator()
+		}
+		newIterators := []func() pagination.Iterator{
+			func() pagination.Iterator {
+				return fetcher.ConcreteExecutionIterator(ctx, pr, params.PageSize)
+			},
+		}
+		scannerCtx, err := shardscanner.GetScannerContext(ctx)
+		if err != nil {
+			return pagination.NewChainedIterator(newIterators...)
+		}
+		for _, storeName := range getEnabledStores(params) {
+			visibilityManager, err := stores.GetVisibilityManager(storeName)
+			if err != nil {
+				scannerCtx.Logger.Error("failed to get visibility manager, skip iterating the store", tag.Value(storeName), tag.Error(err))
+				continue
+			}
+			newIterators = append(newIterators, func() pagination.Iterator {
+				return fetcher.OpenVisibilityRecordIterator(
+					ctx,
+					pr,
+					visibilityManager,
+					scannerCtx.Resource.GetDomainCache(),
+					stores.NumHistoryShards(),
+					params.PageSize,
+				)
+			})
+		}
+		return pagination.NewChainedIterator(newIterators...)
+	}
+}
+
+// FixerIterator provides iterator for visibility fixer.
+func FixerIterator(
+	ctx context.Context,
+	client blobstore.Client,
+	keys store.Keys,
+	_ shardscanner.FixShardActivityParams,
+) store.ScanOutputIterator {
+	return store.NewBlobstoreIterator(ctx, client, keys, &entity.ConcreteExecution{}, &entity.OpenVisibilityRecord{})
+}
+
+// FixerManager provides invariant manager for visibility fixer,
+// which fixes the visibility stores currently written by the cluster.
+func FixerManager(stores *workercommon.VisibilityStores) shardscanner.FixerManagerCB {
+	return func(
+		ctx context.Context,
+		pr persistence.Retryer,
+		_ shardscanner.FixShardActivityParams,
+	) invariant.Manager {
+		fixerCtx, err := shardscanner.GetFixerContext(ctx)
+		if err != nil {
+			return invariant.NewInvariantManager(nil)
+		}
+		storeNames := getWrittenStores(fixerCtx.Config.DynamicCollection, stores)
+		return invariant.NewInvariantManager(
+			getInvariants(pr, stores, storeNames, fixerCtx.Resource.GetDomainCache(), fixerCtx.Logger),
+		)
+	}
+}
+
+// Config resolves dynamic config for visibility scanner.
+func Config(stores *workercommon.VisibilityStores) func(ctx shardscanner.Context) shardscanner.CustomScannerConfig {
+	return func(ctx shardscanner.Context) shardscanner.CustomScannerConfig {
+		res := shardscanner.CustomScannerConfig{}
+		for _, storeName := range getWrittenStores(ctx.Config.DynamicCollection, stores) {
+			res[storeName] = strconv.FormatBool(true)
+		}
+		sampleRate := ctx.Config.DynamicCollection.GetFloat64Property(dynamicconfig.VisibilityScannerShardSampleRate, _defaultShardSampleRate)()
+		res[shardSampleRateKey] = strconv.FormatFloat(sampleRate, 'f', -1, 64)
+		return res
+	}
+}
+
+// ScannerConfig configures visibility scanner
+func ScannerConfig(dc *dynamicconfig.Collection, stores *workercommon.VisibilityStores) *shardscanner.ScannerConfig {
+	return &shardscanner.ScannerConfig{
+		ScannerWFTypeName: ScannerWFTypeName,
+		FixerWFTypeName:   FixerWFTypeName,
+		DynamicParams: shardscanner.DynamicParams{
+			ScannerEnabled:          dc.GetBoolProperty(dynamicconfig.VisibilityScannerEnabled, false),
+			FixerEnabled:            dc.GetBoolProperty(dynamicconfig.VisibilityFixerEnabled, false),
+			Concurrency:             dc.GetIntProperty(dynamicconfig.VisibilityScannerConcurrency, 5),
+			PageSize:                dc.GetIntProperty(dynamicconfig.VisibilityScannerPersistencePageSize, 100),
+			BlobstoreFlushThreshold: dc.GetIntProperty(dynamicconfig.VisibilityScannerBlobstoreFlushThreshold, 100),
+			ActivityBatchSize:       dc.GetIntProperty(dynamicconfig.VisibilityScannerActivityBatchSize, 25),
+			AllowDomain:             dc.GetBoolPropertyFilteredByDomain(dynamicconfig.VisibilityFixerDomainAllow, false),
+		},
+		DynamicCollection: dc,
+		ScannerHooks:      ScannerHooks(stores),
+		FixerHooks:        FixerHooks(stores),
+
+		StartWorkflowOptions: client.StartWorkflowOptions{
+			ID:                           wfid,
+			TaskList:                     scannerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "* * * * *",
+		},
+		StartFixerOptions: client.StartWorkflowOptions{
+			ID:                           fixerwfid,
+			TaskList:                     fixerTaskListName,
+			ExecutionStartToCloseTimeout: 20 * 365 * 24 * time.Hour,
+			WorkflowIDReusePolicy:        client.WorkflowIDReusePolicyAllowDuplicate,
+			CronSchedule:                 "* * * * *",
+		},
+	}
+}
+
+// getEnabledStores returns the visibility stores enabled in the scanner config
+func getEnabledStores(params shardscanner.ScanShardActivityParams) []string {
+	var storeNames []string
+	for _, storeName := range []string{workercommon.VisibilityStoreDB, workercommon.VisibilityStoreES} {
+		if enabled, err := strconv.ParseBool(params.ScannerConfig[storeName]); err == nil && enabled {
+			storeNames = append(storeNames, storeName)
+		}
+	}
+	return storeNames
+}
+
+// getWrittenStores returns the visibility stores written by the cluster according to the visibility writing mode
+func getWrittenStores(dc *dynamicconfig.Collection, stores *workercommon.VisibilityStores) []string {
+	writeMode := dc.GetStringProperty(
+		dynamicconfig.AdvancedVisibilityWritingMode,
+		common.GetDefaultAdvancedVisibilityWritingMode(stores.IsConfigured(workercommon.VisibilityStoreES)),
+	)()
+	var storeNames []string
+	if writeMode == common.AdvancedVisibilityWritingModeOff || writeMode == common.AdvancedVisibilityWritingModeDual {
+		storeNames = append(storeNames, workercommon.VisibilityStoreDB)
+	}
+	if writeMode == common.AdvancedVisibilityWritingModeOn || writeMode == common.AdvancedVisibilityWritingModeDual {
+		storeNames = append(storeNames, workercommon.VisibilityStoreES)
+	}
+	return storeNames
+}
+
+func getInvariants(
+	pr persistence.Retryer,
+	stores *workercommon.VisibilityStores,
+	storeNames []string,
+	domainCache cache.DomainCache,
+	logger log.Logger,
+) []invariant.Invariant {
+	var ivs []invariant.Invariant
+	for _, storeName := range storeNames {
+		visibilityManager, err := stores.GetVisibilityManager(storeName)
+		if err != nil {
+			logger.Error("failed to get visibility manager, skip checking the store", tag.Value(storeName), tag.Error(err))
+			continue
+		}
+		switch storeName {
+		case workercommon.VisibilityStoreDB:
+			ivs = append(ivs, invariant.NewDBVisibilityConsistent(pr, visibilityManager, domainCache))
+		case workercommon.VisibilityStoreES:
+			ivs = append(ivs, invariant.NewESVisibilityConsistent(pr, visibilityManager, domainCache))
+		}
+	}
+	return ivs
+}
@@ -38,20 +38,27 @@ import (
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/archivalbackfill"
+	"github.com/uber/cadence/service/worker/archivalscavenger"
+	"github.com/uber/cadence/service/worker/archivalverifier"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
+	"github.com/uber/cadence/service/worker/reindexer"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/resharder"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 	"github.com/uber/cadence/service/worker/scanner/timers"
+	"github.com/uber/cadence/service/worker/scanner/visibility"
 	"github.com/uber/cadence/service/worker/shadower"
 	"github.com/uber/cadence/service/worker/watchdog"
+	"github.com/uber/cadence/service/worker/workercommon"
 )
 
 type (
@@ -76,7 +83,12 @@ type (
 		BatcherCfg                          *batcher.Config
 		ESAnalyzerCfg                       *esanalyzer.Config
 		WatchdogConfig                      *watchdog.Config
+		ArchivalVerifierCfg                 *archivalverifier.Config
+		ArchivalScavengerCfg                *archivalscavenger.Config
+		ArchivalBackfillCfg                 *archivalbackfill.Config
+		ReindexerCfg                        *reindexer.Config
 		failoverManagerCfg                  *failovermanager.Config
+		VisibilityStores                    *workercommon.VisibilityStores
 		ThrottledLogRPS                     dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                   dynamicconfig.IntPropertyFn
@@ -88,6 +100,8 @@ type (
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
 		EnableWatchDog                      dynamicconfig.BoolPropertyFn
+		EnableArchivalVerifier              dynamicconfig.BoolPropertyFn
+		EnableArchivalScavenger             dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -128,6 +142,12 @@ func NewConfig(params *resource.Params) *Config {
 		params.Logger,
 		dynamicconfig.ClusterNameFilter(params.ClusterMetadata.GetCurrentClusterName()),
 	)
+	visibilityStores := workercommon.NewVisibilityStores(params, &workercommon.VisibilityStoresConfig{
+		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.WorkerPersistenceMaxQPS, 500),
+		ValidSearchAttributes:           dc.GetMapProperty(dynamicconfig.ValidSearchAttributes, definition.GetDefaultIndexedKeys()),
+		ESIndexMaxResultWindow:          dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
+		EnableReadFromClosedExecutionV2: dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
+	})
 	config := &Config{
 		ArchiverConfig: &archiver.Config{
 			ArchiverConcurrency:             dc.GetIntProperty(dynamicconfig.WorkerArchiverConcurrency, 50),
@@ -151,6 +171,7 @@ func NewConfig(params *resource.Params) *Config {
 				executions.ConcreteExecutionScannerConfig(dc),
 				executions.CurrentExecutionScannerConfig(dc),
 				timers.ScannerConfig(dc),
+				visibility.ScannerConfig(dc, visibilityStores),
 			},
 			MaxWorkflowRetentionInDays: dc.GetIntProperty(dynamicconfig.MaxRetentionDays, domain.DefaultMaxWorkflowRetentionInDays),
 		},
@@ -177,11 +198,32 @@ func NewConfig(params *resource.Params) *Config {
 		WatchdogConfig: &watchdog.Config{
 			CorruptWorkflowWatchdogPause: dc.GetBoolProperty(dynamicconfig.CorruptWorkflowWatchdogPause, common.DefaultCorruptWorkflowWatchdogPause),
 		},
+		ArchivalVerifierCfg: &archivalverifier.Config{
+			ArchivalVerifierPause:            dc.GetBoolProperty(dynamicconfig.ArchivalVerifierPause, common.DefaultArchivalVerifierPause),
+			ArchivalVerifierSamplesPerDomain: dc.GetIntPropertyFilteredByDomain(dynamicconfig.ArchivalVerifierSamplesPerDomain, common.DefaultArchivalVerifierSamplesPerDomain),
+			ArchivalVerifierTimeWindow:       dc.GetDurationProperty(dynamicconfig.ArchivalVerifierTimeWindow, common.DefaultArchivalVerifierTimeWindow),
+			ArchivalVerifierMinCloseAge:      dc.GetDurationProperty(dynamicconfig.ArchivalVerifierMinCloseAge, common.DefaultArchivalVerifierMinCloseAge),
+			ArchivalVerifierEnableReArchive:  dc.GetBoolProperty(dynamicconfig.ArchivalVerifierEnableReArchive, common.DefaultArchivalVerifierEnableReArchive),
+		},
+		ArchivalScavengerCfg: &archivalscavenger.Config{
+			ArchivalScavengerPause: dc.GetBoolProperty(dynamicconfig.ArchivalScavengerPause, common.DefaultArchivalScavengerPause),
+		},
+		ArchivalBackfillCfg: &archivalbackfill.Config{
+			ArchivalBackfillRPS:             dc.GetIntProperty(dynamicconfig.ArchivalBackfillRPS, common.DefaultArchivalBackfillRPS),
+			NumArchiveSystemWorkflows:       dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
+			AllowArchivingIncompleteHistory: dc.GetBoolProperty(dynamicconfig.AllowArchivingIncompleteHistory, false),
+		},
+		ReindexerCfg: &reindexer.Config{
+			VisibilityReindexRPS: dc.GetIntProperty(dynamicconfig.VisibilityReindexRPS, common.DefaultVisibilityReindexRPS),
+		},
+		VisibilityStores:                    visibilityStores,
 		EnableBatcher:                       dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer, false),
 		EnableWatchDog:                      dc.GetBoolProperty(dynamicconfig.EnableWatchDog, false),
+		EnableArchivalVerifier:              dc.GetBoolProperty(dynamicconfig.EnableArchivalVerifier, false),
+		EnableArchivalScavenger:             dc.GetBoolProperty(dynamicconfig.EnableArchivalScavenger, false),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
 		EnableWorkflowShadower:              dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower, true),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
@@ -220,15 +262,32 @@ func (s *Service) Start() {
 	s.ensureDomainExists(common.SystemLocalDomainName)
 	s.startScanner()
 	s.startFixerWorkflowWorker()
+	s.startReindexer()
 	if s.config.IndexerCfg != nil {
-		s.startIndexer()
+		if s.params.KafkaVisibilityEnabled {
+			s.startIndexer()
+		} else {
+			// history writes advanced visibility to ElasticSearch directly when Kafka is not configured for visibility
+			logger.Info("Kafka is not configured for visibility, skip starting visibility indexer")
+		}
 	}
 
 	if s.GetClusterMetadata().IsGlobalDomainEnabled() {
 		s.startReplicator()
 	}
-	if s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
+	historyArchival := s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
+	if historyArchival || s.GetArchivalMetadata().GetVisibilityConfig().ClusterConfiguredForArchival() {
+		// archive requests of both history and visibility are processed by the archival system workflows
 		s.startArchiver()
+		s.startArchivalBackfiller()
+	}
+	if historyArchival {
+		if s.config.EnableArchivalVerifier() {
+			s.startArchivalVerifier()
+		}
+		if s.config.EnableArchivalScavenger() {
+			s.startArchivalScavenger()
+		}
 	}
 	if s.config.EnableBatcher() {
 		s.ensureDomainExists(common.BatcherLocalDomainName)
@@ -250,6 +309,10 @@ func (s *Service) Start() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
 	}
+	if store, ok := s.params.PersistenceConfig.DataStores[s.params.PersistenceConfig.DefaultStore]; ok &&
+		store.SQL != nil && store.SQL.Resharding != nil {
+		s.startResharder()
+	}
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -323,6 +386,71 @@ func (s *Service) startWatchDog() {
 	}
 }
 
+func (s *Service) startArchivalVerifier() {
+	verifier := archivalverifier.New(
+		s.params.PublicClient,
+		s.GetFrontendClient(),
+		s.GetLogger(),
+		s.GetMetricsClient(),
+		s.params.MetricScope,
+		s.Resource,
+		s.GetDomainCache(),
+		s.params.PersistenceConfig.NumHistoryShards,
+		s.config.ArchivalVerifierCfg,
+	)
+
+	if err := verifier.Start(); err != nil {
+		s.GetLogger().Fatal("error starting archival verifier", tag.Error(err))
+	}
+}
+
+func (s *Service) startArchivalScavenger() {
+	scavenger := archivalscavenger.New(
+		s.params.PublicClient,
+		s.GetLogger(),
+		s.GetMetricsClient(),
+		s.params.MetricScope,
+		s.Resource,
+		s.GetDomainCache(),
+		s.config.ArchivalScavengerCfg,
+	)
+
+	if err := scavenger.Start(); err != nil {
+		s.GetLogger().Fatal("error starting archival scavenger", tag.Error(err))
+	}
+}
+
+func (s *Service) startArchivalBackfiller() {
+	params := &archivalbackfill.BootstrapParams{
+		Config:        *s.config.ArchivalBackfillCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		Resource:      s.Resource,
+		NumShards:     s.params.PersistenceConfig.NumHistoryShards,
+	}
+	if err := archivalbackfill.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting archival backfiller", tag.Error(err))
+	}
+}
+
+func (s *Service) startReindexer() {
+	params := &reindexer.BootstrapParams{
+		Config:           *s.config.ReindexerCfg,
+		ServiceClient:    s.params.PublicClient,
+		MetricsClient:    s.GetMetricsClient(),
+		Logger:           s.GetLogger(),
+		TallyScope:       s.params.MetricScope,
+		Resource:         s.Resource,
+		VisibilityStores: s.config.VisibilityStores,
+		NumShards:        s.params.PersistenceConfig.NumHistoryShards,
+	}
+	if err := reindexer.New(params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting visibility reindexer", tag.Error(err))
+	}
+}
+
 func (s *Service) startBatcher() {
 	params := &batcher.BootstrapParams{
 		Config:        *s.config.BatcherCfg,
@@ -427,6 +555,23 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startResharder() {
+	params := &resharder.BootstrapParams{
+		Config: resharder.Config{
+			Persistence:     &s.params.PersistenceConfig,
+			ClusterMetadata: s.params.ClusterMetadata,
+		},
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+	}
+	if err := resharder.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting sql resharder", tag.Error(err))
+	}
+}
+
 func (s *Service) startWorkflowShadower() {
 	params := &shadower.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
@@ -0,0 +1,205 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workercommon
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/service"
+)
+
+const (
+	// VisibilityStoreES is the advanced visibility store
+	VisibilityStoreES = "es"
+	// VisibilityStoreDB is the database visibility store
+	VisibilityStoreDB = "db"
+
+	// ErrMsgUnknownVisibilityStore indicates the visibility store is neither VisibilityStoreES nor VisibilityStoreDB
+	ErrMsgUnknownVisibilityStore = "unknown visibility store, must be es or db"
+	// ErrMsgVisibilityStoreNotConfigured indicates the visibility store is not configured for the cluster
+	ErrMsgVisibilityStoreNotConfigured = "visibility store is not configured"
+	// ErrMsgESIndexNotSet indicates the ElasticSearch index is empty
+	ErrMsgESIndexNotSet = "elasticsearch index is not set"
+
+	// bulk settings used when writing to ElasticSearch
+	esBulkActions   = 1000
+	esFlushInterval = 200 * time.Millisecond
+)
+
+type (
+	// VisibilityStoresConfig defines the configuration of the visibility managers created by VisibilityStores
+	VisibilityStoresConfig struct {
+		// PersistenceMaxQPS is the rate limit of the calls made to a visibility store on a worker host
+		PersistenceMaxQPS dynamicconfig.IntPropertyFn
+		// ValidSearchAttributes is legal indexed keys written to ElasticSearch
+		ValidSearchAttributes dynamicconfig.MapPropertyFn
+		// ESIndexMaxResultWindow is the max result window of the ElasticSearch visibility index
+		ESIndexMaxResultWindow dynamicconfig.IntPropertyFn
+		// EnableReadFromClosedExecutionV2 is whether closed records are read from the v2 table of the database store
+		EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
+	}
+
+	// VisibilityStores gives access to each of the visibility stores of the cluster separately,
+	// unlike the visibility manager of a service which reads from and writes to the stores based on dynamic config
+	VisibilityStores struct {
+		cfg           *VisibilityStoresConfig
+		params        *persistenceClient.Params
+		clusterName   string
+		metricsClient metrics.Client
+		logger        log.Logger
+
+		sync.Mutex
+		persistenceFactory persistenceClient.Factory
+		visibilityManagers map[string]persistence.VisibilityManager // store or ES index -> visibility manager
+	}
+)
+
+// NewVisibilityStores returns a new instance of VisibilityStores
+func NewVisibilityStores(params *resource.Params, cfg *VisibilityStoresConfig) *VisibilityStores {
+	return &VisibilityStores{
+		cfg: cfg,
+		params: &persistenceClient.Params{
+			PersistenceConfig: params.PersistenceConfig,
+			MetricsClient:     params.MetricsClient,
+			ESClient:          params.ESClient,
+			ESConfig:          params.ESConfig,
+		},
+		clusterName:        params.ClusterMetadata.GetCurrentClusterName(),
+		metricsClient:      params.MetricsClient,
+		logger:             params.Logger,
+		visibilityManagers: make(map[string]persistence.VisibilityManager),
+	}
+}
+
+// IsConfigured returns whether the visibility store is configured for the cluster
+func (s *VisibilityStores) IsConfigured(store string) bool {
+	persistenceConfig := s.params.PersistenceConfig
+	switch store {
+	case VisibilityStoreES:
+		return persistenceConfig.AdvancedVisibilityStore != "" && s.params.ESClient != nil && s.params.ESConfig != nil
+	case VisibilityStoreDB:
+		return persistenceConfig.VisibilityStore != ""
+	default:
+		return false
+	}
+}
+
+// NumHistoryShards returns the number of history shards of the cluster
+func (s *VisibilityStores) NumHistoryShards() int {
+	return s.params.PersistenceConfig.NumHistoryShards
+}
+
+// GetVisibilityManager returns the visibility manager reading from and writing to only the given store,
+// it's created on first use so that the worker doesn't connect to the stores unless they are needed
+func (s *VisibilityStores) GetVisibilityManager(store string) (persistence.VisibilityManager, error) {
+	return s.getVisibilityManager(store, "")
+}
+
+// GetESVisibilityManager returns the visibility manager reading from and writing to only the given
+// ElasticSearch index, instead of the visibility index of the ElasticSearch config
+func (s *VisibilityStores) GetESVisibilityManager(index string) (persistence.VisibilityManager, error) {
+	if index == "" {
+		return nil, errors.New(ErrMsgESIndexNotSet)
+	}
+	return s.getVisibilityManager(VisibilityStoreES, index)
+}
+
+func (s *VisibilityStores) getVisibilityManager(store string, esIndex string) (persistence.VisibilityManager, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	key := store
+	if esIndex != "" {
+		key = store + "/" + esIndex
+	}
+	if visibilityManager, ok := s.visibilityManagers[key]; ok {
+		return visibilityManager, nil
+	}
+
+	var writeMode string
+	switch store {
+	case VisibilityStoreES:
+		writeMode = common.AdvancedVisibilityWritingModeOn
+	case VisibilityStoreDB:
+		writeMode = common.AdvancedVisibilityWritingModeOff
+	default:
+		return nil, errors.New(ErrMsgUnknownVisibilityStore)
+	}
+	if !s.IsConfigured(store) {
+		return nil, errors.New(ErrMsgVisibilityStoreNotConfigured)
+	}
+
+	if s.persistenceFactory == nil {
+		s.persistenceFactory = persistenceClient.NewFactory(
+			&s.params.PersistenceConfig,
+			s.cfg.PersistenceMaxQPS.AsFloat64(),
+			s.clusterName,
+			s.metricsClient,
+			s.logger,
+		)
+	}
+	params := s.params
+	if esIndex != "" {
+		params = s.paramsWithESIndex(esIndex)
+	}
+	visibilityManager, err := s.persistenceFactory.NewVisibilityManager(params, &service.Config{
+		PersistenceMaxQPS:                           s.cfg.PersistenceMaxQPS,
+		EnableReadVisibilityFromES:                  dynamicconfig.GetBoolPropertyFnFilteredByDomain(store == VisibilityStoreES),
+		AdvancedVisibilityWritingMode:               dynamicconfig.GetStringPropertyFn(writeMode),
+		EnableReadDBVisibilityFromClosedExecutionV2: s.cfg.EnableReadFromClosedExecutionV2,
+		ESIndexMaxResultWindow:                      s.cfg.ESIndexMaxResultWindow,
+		ValidSearchAttributes:                       s.cfg.ValidSearchAttributes,
+
+		// always write to ElasticSearch directly in bulks, instead of going through Kafka
+		EnableESVisibilityDirectWrite:        dynamicconfig.GetBoolPropertyFn(true),
+		ESVisibilityDirectWriteNumOfWorkers:  dynamicconfig.GetIntPropertyFn(1),
+		ESVisibilityDirectWriteBulkActions:   dynamicconfig.GetIntPropertyFn(esBulkActions),
+		ESVisibilityDirectWriteFlushInterval: dynamicconfig.GetDurationPropertyFn(esFlushInterval),
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.visibilityManagers[key] = visibilityManager
+	return visibilityManager, nil
+}
+
+// paramsWithESIndex returns a copy of the persistence params with the visibility index replaced
+func (s *VisibilityStores) paramsWithESIndex(index string) *persistenceClient.Params {
+	esConfig := *s.params.ESConfig
+	esConfig.Indices = make(map[string]string, len(s.params.ESConfig.Indices))
+	for app, appIndex := range s.params.ESConfig.Indices {
+		esConfig.Indices[app] = appIndex
+	}
+	esConfig.Indices[common.VisibilityAppName] = index
+
+	params := *s.params
+	params.ESConfig = &esConfig
+	return &params
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/reconciliation/invariant"
+	"github.com/uber/cadence/service/worker/reindexer"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 )
 
@@ -143,6 +144,24 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminMaintainCorruptWorkflow(c)
 			},
 		},
+		{
+			Name:    "restore",
+			Aliases: []string{"rs"},
+			Usage:   "Restore a deleted workflow run from its archived history",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRestoreWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -643,6 +662,44 @@ func newAdminElasticSearchCommands() []cli.Command {
 				GenerateReport(c)
 			},
 		},
+		{
+			Name:    "reindex",
+			Aliases: []string{"rind"},
+			Usage:   "Rebuild visibility records from the executions in history shards",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTarget,
+					Usage: "Visibility store to write, es or db",
+					Value: reindexer.TargetES,
+				},
+				cli.StringFlag{
+					Name:  FlagIndex,
+					Usage: "Optional ElasticSearch index to write when target is es, the visibility index of the server config if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainWithAlias,
+					Usage: "Optional domain name, only workflows of the domain are reindexed if set",
+				},
+				cli.IntFlag{
+					Name:  FlagLowerShardBound,
+					Usage: "lower bound of shard to reindex (inclusive)",
+					Value: 0,
+				},
+				cli.IntFlag{
+					Name:  FlagUpperShardBound,
+					Usage: "upper bound of shard to reindex (inclusive), the last shard if negative",
+					Value: -1,
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Number of workflows read from a shard at a time",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReindex(c)
+			},
+		},
 	}
 }
 
@@ -968,6 +1025,59 @@ func newDBCommands() []cli.Command {
 				AdminDBDataDecodeThrift(c)
 			},
 		},
+		{
+			Name:        "reshard",
+			Usage:       "move history shards to the target placement of a resharding SQL persistence",
+			Subcommands: newDBReshardCommands(),
+		},
+	}
+}
+
+func newDBReshardCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "start",
+			Usage: "start the resharding workflows of a range of history shards",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:     FlagLowerShardBound,
+					Usage:    "the first history shard to reshard",
+					Required: true,
+				},
+				cli.IntFlag{
+					Name:     FlagUpperShardBound,
+					Usage:    "the last history shard to reshard",
+					Required: true,
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "number of rows read from the database at a time",
+					Value: 500,
+				},
+				cli.IntFlag{
+					Name:  FlagCutoverWaitTime,
+					Usage: "seconds waited after the cutover of a shard is observed, must be longer than the dynamic config refresh interval of the history hosts",
+					Value: 120,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBReshardStart(c)
+			},
+		},
+		{
+			Name:  "describe",
+			Usage: "show the progress of the resharding workflow of a history shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:     FlagShardIDWithAlias,
+					Usage:    "shardID",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDBReshardDescribe(c)
+			},
+		},
 	}
 }
 
@@ -1288,3 +1398,125 @@ func newAdminConfigStoreCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminAuditCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the audit records of mutating API calls from the audit log files of the file audit sink or from the topic of the kafka audit sink",
+			Description: "The records are read from the files or the kafka topic directly, no request is sent to the cluster. " +
+				"With multiple frontend hosts, each host writes its own file, pass the files of all hosts to list the records of the cluster. " +
+				"The records of all sources are ordered by their time.",
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  FlagAuditFileWithAlias,
+					Usage: "Path of an audit log file written by the file audit sink, can be passed multiple times. Either this or " + FlagAuditKafkaBrokers + " is required",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagAuditKafkaBrokers,
+					Usage: "Address of a broker of the kafka cluster the kafka audit sink publishes to, can be passed multiple times. Either this or " + FlagAuditFile + " is required",
+				},
+				cli.StringFlag{
+					Name:  FlagTopic,
+					Usage: "Topic of the kafka audit sink, required with " + FlagAuditKafkaBrokers,
+				},
+				cli.StringFlag{
+					Name:  FlagDomainWithAlias,
+					Usage: "Optional. Only list the records of this domain",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Optional. Only list the records of this workflow ID",
+				},
+				cli.StringFlag{
+					Name:  FlagAuditAPI,
+					Usage: "Optional. Only list the records of this API, e.g. TerminateWorkflowExecution",
+				},
+				cli.StringFlag{
+					Name:  FlagAuditActor,
+					Usage: "Optional. Only list the records of this actor, matched against the subject and the name of the caller's JWT",
+				},
+				cli.StringFlag{
+					Name:  FlagResult,
+					Usage: "Optional. Only list the records with this result: success, failure or unauthorized",
+				},
+				cli.StringFlag{
+					Name: FlagEarliestTimeWithAlias,
+					Usage: "Optional. Only list the records after this time. Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional. Only list the records before this time. Supported formats are the same as " + FlagEarliestTime,
+				},
+				cli.IntFlag{
+					Name:  FlagLimit,
+					Value: defaultAuditListLimit,
+					Usage: "Maximum number of records to list, 0 means no limit",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print the records in JSON format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminAuditList(c)
+			},
+		},
+	}
+}
+
+func newAdminArchivalCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "backfill",
+			Aliases: []string{"bf"},
+			Usage:   "Archive the existing closed workflows of a domain which closed before archival was enabled for the domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name: FlagEarliestTimeWithAlias,
+					Usage: "Optional. Only archive the workflows closed after this time. Supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes.",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "Optional. Only archive the workflows closed before this time. Supported formats are the same as " + FlagEarliestTime,
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Usage: "Number of closed workflows read from visibility at a time",
+					Value: 100,
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminArchivalBackfill(c)
+			},
+		},
+		{
+			Name:  "search",
+			Usage: "Search the archived histories of a domain by the events they contain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name: FlagListQueryWithAlias,
+					Usage: "SQL like query on the indexed event fields, only '=' and 'and' are supported. " +
+						"e.g. 'SignalName = \"sig\" and FailureReason = \"timeout\"'. Indexed fields are SignalName, ActivityType and FailureReason",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Count of archived histories included in a single page",
+				},
+				cli.BoolFlag{
+					Name:  FlagAllWithAlias,
+					Usage: "List all pages",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminArchivalSearch(c)
+			},
+		},
+	}
+}
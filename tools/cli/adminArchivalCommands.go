@@ -0,0 +1,143 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/archivalbackfill"
+)
+
+const (
+	defaultArchivalBackfillWorkflowTimeoutInSeconds = 7 * 24 * 60 * 60
+)
+
+// AdminArchivalBackfill starts the workflow submitting the existing closed workflows of a domain for archival
+func AdminArchivalBackfill(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	earliestTime := parseTime(c.String(FlagEarliestTime), 0)
+	latestTime := parseTime(c.String(FlagLatestTime), time.Now().UnixNano())
+	if earliestTime > latestTime {
+		ErrorAndExit(fmt.Sprintf("Earliest time %v is after latest time %v", earliestTime, latestTime), nil)
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+	input, err := json.Marshal(archivalbackfill.BackfillParams{
+		DomainName:   domain,
+		EarliestTime: earliestTime,
+		LatestTime:   latestTime,
+		PageSize:     c.Int(FlagPageSize),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize archival backfill params", err)
+	}
+
+	workflowID := archivalbackfill.WorkflowIDPrefix + domain
+	wf, err := client.StartWorkflowExecution(tcCtx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: archivalbackfill.TaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(defaultArchivalBackfillWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: archivalbackfill.WorkflowTypeName},
+		Input:                               input,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start archival backfill workflow", err)
+	}
+	fmt.Printf("Archival backfill workflow started in domain %v, wid: %v, rid: %v\n", common.SystemLocalDomainName, workflowID, wf.GetRunID())
+}
+
+// ArchivedHistoryRow is used to render the archived histories matching a search query
+type ArchivedHistoryRow struct {
+	WorkflowID string `header:"Workflow ID"`
+	RunID      string `header:"Run ID"`
+}
+
+// AdminArchivalSearch searches the archived histories of a domain by the events they contain
+func AdminArchivalSearch(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	query := getRequiredOption(c, FlagListQuery)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+	printAll := c.Bool(FlagAll)
+
+	contextTimeout := defaultContextTimeoutForListArchivedWorkflow
+	if c.GlobalIsSet(FlagContextTimeout) {
+		contextTimeout = time.Duration(c.GlobalInt(FlagContextTimeout)) * time.Second
+	}
+
+	var nextPageToken []byte
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), contextTimeout)
+		resp, err := adminClient.SearchArchivedHistory(ctx, &types.AdminSearchArchivedHistoryRequest{
+			Domain:        domain,
+			PageSize:      int32(pageSize),
+			Query:         query,
+			NextPageToken: nextPageToken,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to search archived history.", err)
+		}
+
+		table := make([]ArchivedHistoryRow, 0, len(resp.Executions))
+		for _, execution := range resp.Executions {
+			table = append(table, ArchivedHistoryRow{
+				WorkflowID: execution.GetExecution().GetWorkflowID(),
+				RunID:      execution.GetExecution().GetRunID(),
+			})
+		}
+		RenderTable(os.Stdout, table, TableOptions{Color: true})
+
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+		if !printAll && !showNextPage() {
+			break
+		}
+	}
+}
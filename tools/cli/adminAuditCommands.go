@@ -0,0 +1,132 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/audit"
+)
+
+const (
+	defaultAuditListLimit = 100
+)
+
+type auditRecordRow struct {
+	Time       time.Time `header:"Time"`
+	Actor      string    `header:"Actor"`
+	Caller     string    `header:"Caller"`
+	APIName    string    `header:"API"`
+	DomainName string    `header:"Domain"`
+	WorkflowID string    `header:"Workflow ID"`
+	Result     string    `header:"Result"`
+	Reason     string    `header:"Reason" maxLength:"32"`
+	Details    string    `header:"Details" maxLength:"32"`
+	Error      string    `header:"Error" maxLength:"64"`
+}
+
+// AdminAuditList lists the audit records matching the filters from the audit log files written by the file sink
+// or from the topic the kafka sink publishes to.
+func AdminAuditList(c *cli.Context) {
+	paths := c.StringSlice(FlagAuditFile)
+	brokers := c.StringSlice(FlagAuditKafkaBrokers)
+	if (len(paths) == 0) == (len(brokers) == 0) {
+		ErrorAndExit(fmt.Sprintf("Exactly one of %v and %v is required", FlagAuditFile, FlagAuditKafkaBrokers), nil)
+	}
+	filter := &audit.Filter{
+		Actor:      c.String(FlagAuditActor),
+		APIName:    c.String(FlagAuditAPI),
+		DomainName: c.String(FlagDomain),
+		WorkflowID: c.String(FlagWorkflowID),
+		Result:     c.String(FlagResult),
+	}
+	switch filter.Result {
+	case "", audit.ResultSuccess, audit.ResultFailure, audit.ResultUnauthorized:
+	default:
+		ErrorAndExit(fmt.Sprintf("Invalid result %q, valid values are %v, %v and %v",
+			filter.Result, audit.ResultSuccess, audit.ResultFailure, audit.ResultUnauthorized), nil)
+	}
+	if c.IsSet(FlagEarliestTime) {
+		filter.StartTime = time.Unix(0, parseTime(c.String(FlagEarliestTime), 0))
+	}
+	if c.IsSet(FlagLatestTime) {
+		filter.EndTime = time.Unix(0, parseTime(c.String(FlagLatestTime), time.Now().UnixNano()))
+	}
+
+	var records []*audit.Record
+	if len(brokers) > 0 {
+		topic := getRequiredOption(c, FlagTopic)
+		var err error
+		records, err = audit.ReadKafkaRecords(brokers, topic, filter, c.Int(FlagLimit))
+		if err != nil {
+			ErrorAndExit("Failed to read audit records from kafka", err)
+		}
+	} else {
+		records = readAuditFiles(paths, filter, c.Int(FlagLimit))
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		prettyPrintJSONObject(records)
+		return
+	}
+	table := make([]auditRecordRow, 0, len(records))
+	for _, record := range records {
+		actor := record.Actor
+		if record.ActorName != "" {
+			actor = record.ActorName
+		}
+		table = append(table, auditRecordRow{
+			Time:       record.Timestamp,
+			Actor:      actor,
+			Caller:     record.Caller,
+			APIName:    record.APIName,
+			DomainName: record.DomainName,
+			WorkflowID: record.WorkflowID,
+			Result:     record.Result,
+			Reason:     record.Reason,
+			Details:    record.Details,
+			Error:      record.Error,
+		})
+	}
+	RenderTable(os.Stdout, table, TableOptions{Color: true, Border: true, PrintDateTime: true})
+}
+
+func readAuditFiles(paths []string, filter *audit.Filter, limit int) []*audit.Record {
+	files := make([][]*audit.Record, 0, len(paths))
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to open audit log file %v", path), err)
+		}
+		// each file is ordered by time, so its first limit records are enough
+		records, err := audit.ReadRecords(file, filter, limit)
+		file.Close()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to read audit log file %v", path), err)
+		}
+		files = append(files, records)
+	}
+	return audit.MergeRecords(limit, files...)
+}
@@ -574,6 +574,35 @@ func AdminRefreshWorkflowTasks(c *cli.Context) {
 	}
 }
 
+// AdminRestoreWorkflow re-creates a deleted workflow run from the domain's history archival
+func AdminRestoreWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := getRequiredOption(c, FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	execution := &types.WorkflowExecution{
+		WorkflowID: wid,
+		RunID:      rid,
+	}
+	err := adminClient.RestoreWorkflow(ctx, &types.AdminRestoreWorkflowRequest{
+		Domain:    domain,
+		Execution: execution,
+	})
+	if err != nil {
+		ErrorAndExit("Restore workflow failed", err)
+	}
+	prettyPrintJSONObject(map[string]interface{}{
+		"msg":       "workflow is restored from the archived history",
+		"domain":    domain,
+		"execution": execution,
+	})
+}
+
 // AdminResetQueue resets task processing queue states
 func AdminResetQueue(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
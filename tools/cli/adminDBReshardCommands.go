@@ -0,0 +1,118 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/resharder"
+)
+
+const (
+	defaultReshardingWorkflowTimeoutInSeconds = 7 * 24 * 60 * 60
+)
+
+// AdminDBReshardStart starts the resharding workflows of a range of history shards
+func AdminDBReshardStart(c *cli.Context) {
+	lowerShardBound := c.Int(FlagLowerShardBound)
+	upperShardBound := c.Int(FlagUpperShardBound)
+	if lowerShardBound < 0 || upperShardBound < lowerShardBound {
+		ErrorAndExit(fmt.Sprintf("Invalid shard range [%v, %v]", lowerShardBound, upperShardBound), nil)
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+
+	for shardID := lowerShardBound; shardID <= upperShardBound; shardID++ {
+		input, err := json.Marshal(resharder.ReshardingParams{
+			ShardID:         shardID,
+			PageSize:        c.Int(FlagPageSize),
+			CutoverWaitTime: time.Duration(c.Int(FlagCutoverWaitTime)) * time.Second,
+		})
+		if err != nil {
+			ErrorAndExit("Failed to serialize resharding params", err)
+		}
+		workflowID := getReshardingWorkflowID(shardID)
+		wf, err := client.StartWorkflowExecution(tcCtx, &types.StartWorkflowExecutionRequest{
+			Domain:                              common.SystemLocalDomainName,
+			RequestID:                           uuid.New(),
+			WorkflowID:                          workflowID,
+			WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicateFailedOnly.Ptr(),
+			TaskList:                            &types.TaskList{Name: resharder.TaskListName},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(defaultReshardingWorkflowTimeoutInSeconds),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+			Memo:                                memo,
+			WorkflowType:                        &types.WorkflowType{Name: resharder.WorkflowTypeName},
+			Input:                               input,
+		})
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to start resharding workflow of shard %v", shardID), err)
+		}
+		fmt.Printf("Resharding workflow started, wid: %v, rid: %v\n", workflowID, wf.GetRunID())
+	}
+}
+
+// AdminDBReshardDescribe shows the progress of the resharding workflow of a history shard
+func AdminDBReshardDescribe(c *cli.Context) {
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+
+	queryResp, err := client.QueryWorkflow(tcCtx, &types.QueryWorkflowRequest{
+		Domain: common.SystemLocalDomainName,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: getReshardingWorkflowID(c.Int(FlagShardID)),
+		},
+		Query: &types.WorkflowQuery{
+			QueryType: resharder.QueryType,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to query resharding workflow", err)
+	}
+	if queryResp.GetQueryResult() == nil {
+		ErrorAndExit("QueryResult has no value", nil)
+	}
+	var queryResult resharder.QueryResult
+	if err := json.Unmarshal(queryResp.GetQueryResult(), &queryResult); err != nil {
+		ErrorAndExit("Unable to deserialize QueryResult", err)
+	}
+	prettyPrintJSONObject(queryResult)
+}
+
+func getReshardingWorkflowID(shardID int) string {
+	return resharder.WorkflowIDPrefix + strconv.Itoa(shardID)
+}
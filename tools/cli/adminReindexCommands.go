@@ -0,0 +1,97 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/reindexer"
+)
+
+const (
+	defaultReindexWorkflowTimeoutInSeconds = 7 * 24 * 60 * 60
+)
+
+// AdminReindex starts the workflow rebuilding the visibility records of the executions in history shards
+func AdminReindex(c *cli.Context) {
+	target := c.String(FlagTarget)
+	if target != reindexer.TargetES && target != reindexer.TargetDB {
+		ErrorAndExit(fmt.Sprintf("Unknown target %v, should be %v or %v", target, reindexer.TargetES, reindexer.TargetDB), nil)
+	}
+	index := c.String(FlagIndex)
+	if index != "" && target != reindexer.TargetES {
+		ErrorAndExit(fmt.Sprintf("Index can only be set when target is %v", reindexer.TargetES), nil)
+	}
+	lowerShardBound := c.Int(FlagLowerShardBound)
+	upperShardBound := c.Int(FlagUpperShardBound)
+	if lowerShardBound < 0 || (upperShardBound >= 0 && upperShardBound < lowerShardBound) {
+		ErrorAndExit(fmt.Sprintf("Invalid shard bounds [%v, %v]", lowerShardBound, upperShardBound), nil)
+	}
+
+	client := getCadenceClient(c)
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+	input, err := json.Marshal(reindexer.ReindexParams{
+		Target:          target,
+		Index:           index,
+		DomainName:      c.String(FlagDomain),
+		LowerShardBound: lowerShardBound,
+		UpperShardBound: upperShardBound,
+		PageSize:        c.Int(FlagPageSize),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize reindex params", err)
+	}
+
+	workflowID := reindexer.WorkflowIDPrefix + target
+	if index != "" {
+		workflowID += "-" + index
+	}
+	wf, err := client.StartWorkflowExecution(tcCtx, &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          workflowID,
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: reindexer.TaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(defaultReindexWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: reindexer.WorkflowTypeName},
+		Input:                               input,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start reindex workflow", err)
+	}
+	fmt.Printf("Reindex workflow started in domain %v, wid: %v, rid: %v\n", common.SystemLocalDomainName, workflowID, wf.GetRunID())
+	fmt.Printf("Query type %q returns the progress of the workflow\n", reindexer.QueryTypeProgress)
+}
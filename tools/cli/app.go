@@ -176,6 +176,18 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "audit",
+					Aliases:     []string{"au"},
+					Usage:       "Run admin operation on the audit log files of the file audit sink",
+					Subcommands: newAdminAuditCommands(),
+				},
+				{
+					Name:        "archival",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operation on archival",
+					Subcommands: newAdminArchivalCommands(),
+				},
 			},
 		},
 		{
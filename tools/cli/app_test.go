@@ -584,6 +584,21 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestAdminRestoreWorkflow() {
+	s.serverAdminClient.EXPECT().RestoreWorkflow(gomock.Any(), &types.AdminRestoreWorkflowRequest{
+		Domain:    domainName,
+		Execution: &types.WorkflowExecution{WorkflowID: "test-wf-id", RunID: "test-run-id"},
+	}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "wf", "restore", "-w", "test-wf-id", "-r", "test-run-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminRestoreWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().RestoreWorkflow(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "admin", "wf", "restore", "-w", "test-wf-id", "-r", "test-run-id"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
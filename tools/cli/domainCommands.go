@@ -137,6 +137,7 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 		HistoryArchivalURI:                     c.String(FlagHistoryArchivalURI),
 		VisibilityArchivalStatus:               archivalStatus(c, FlagVisibilityArchivalStatus),
 		VisibilityArchivalURI:                  c.String(FlagVisibilityArchivalURI),
+		ArchivalRetentionPeriodInDays:          int32(c.Int(FlagArchivalRetentionDays)),
 		IsGlobalDomain:                         isGlobalDomain,
 	}
 
@@ -244,6 +245,11 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			badBinaryToDelete = common.StringPtr(c.String(FlagRemoveBadBinary))
 		}
 
+		var archivalRetentionDays *int32
+		if c.IsSet(FlagArchivalRetentionDays) {
+			archivalRetentionDays = common.Int32Ptr(int32(c.Int(FlagArchivalRetentionDays)))
+		}
+
 		updateRequest = &types.UpdateDomainRequest{
 			Name:                                   domainName,
 			Description:                            common.StringPtr(description),
@@ -255,6 +261,7 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			HistoryArchivalURI:                     common.StringPtr(c.String(FlagHistoryArchivalURI)),
 			VisibilityArchivalStatus:               archivalStatus(c, FlagVisibilityArchivalStatus),
 			VisibilityArchivalURI:                  common.StringPtr(c.String(FlagVisibilityArchivalURI)),
+			ArchivalRetentionPeriodInDays:          archivalRetentionDays,
 			BadBinaries:                            binBinaries,
 			Clusters:                               clusters,
 			DeleteBadBinary:                        badBinaryToDelete,
@@ -449,6 +456,10 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		formatStr = formatStr + "VisibilityArchivalURI: %v\n"
 		descValues = append(descValues, resp.Configuration.GetVisibilityArchivalURI())
 	}
+	if resp.Configuration.GetArchivalRetentionPeriodInDays() != 0 {
+		formatStr = formatStr + "ArchivalRetentionInDays: %v\n"
+		descValues = append(descValues, resp.Configuration.GetArchivalRetentionPeriodInDays())
+	}
 	fmt.Printf(formatStr, descValues...)
 	if resp.Configuration.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
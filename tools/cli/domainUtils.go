@@ -104,6 +104,10 @@ var (
 			Name:  FlagVisibilityArchivalURIWithAlias,
 			Usage: "Optionally specify visibility archival URI (cannot be changed after first time archival is enabled)",
 		},
+		cli.IntFlag{
+			Name:  FlagArchivalRetentionDaysWithAlias,
+			Usage: "Optionally specify archived history and visibility retention in days, 0 means archived data is kept forever",
+		},
 	}
 
 	updateDomainFlags = []cli.Flag{
@@ -155,6 +159,10 @@ var (
 			Name:  FlagVisibilityArchivalURIWithAlias,
 			Usage: "Optionally specify visibility archival URI (cannot be changed after first time archival is enabled)",
 		},
+		cli.IntFlag{
+			Name:  FlagArchivalRetentionDaysWithAlias,
+			Usage: "Optionally specify archived history and visibility retention in days, 0 means archived data is kept forever",
+		},
 		cli.StringFlag{
 			Name:  FlagAddBadBinary,
 			Usage: "Binary checksum to add for resetting workflow",
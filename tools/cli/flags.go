@@ -155,6 +155,8 @@ const (
 	FlagVisibilityArchivalStatusWithAlias = FlagVisibilityArchivalStatus + ", vas"
 	FlagVisibilityArchivalURI             = "visibility_uri"
 	FlagVisibilityArchivalURIWithAlias    = FlagVisibilityArchivalURI + ", vuri"
+	FlagArchivalRetentionDays             = "archival_retention"
+	FlagArchivalRetentionDaysWithAlias    = FlagArchivalRetentionDays + ", ard"
 	FlagName                              = "name"
 	FlagNameWithAlias                     = FlagName + ", n"
 	FlagOutputFilename                    = "output_filename"
@@ -250,6 +252,7 @@ const (
 	FlagUpperShardBound                   = "upper_shard_bound"
 	FlagInputDirectory                    = "input_directory"
 	FlagSkipHistoryChecks                 = "skip_history_checks"
+	FlagTarget                            = "target"
 	FlagFailoverType                      = "failover_type"
 	FlagFailoverTypeWithAlias             = FlagFailoverType + ", ft"
 	FlagFailoverTimeout                   = "failover_timeout_seconds"
@@ -288,6 +291,13 @@ const (
 	FlagDynamicConfigValue                = "dynamic_config_value"
 	FlagTransport                         = "transport"
 	FlagTransportWithAlias                = FlagTransport + ", t"
+	FlagCutoverWaitTime                   = "cutover_wait_time_seconds"
+	FlagAuditFile                         = "audit_file"
+	FlagAuditFileWithAlias                = FlagAuditFile + ", af"
+	FlagAuditKafkaBrokers                 = "kafka_brokers"
+	FlagAuditAPI                          = "api"
+	FlagAuditActor                        = "actor"
+	FlagLimit                             = "limit"
 )
 
 var flagsForExecution = []cli.Flag{